@@ -6,10 +6,26 @@ set -o pipefail
 
 case $1 in
   "cloud-init")
-    temp=$(mktemp -d)
+    # the data is kept next to the image, so that virt-prerunner can rebuild
+    # it with generated network data
+    temp=$(dirname $5)/cloud-init
+    mkdir -p $temp
     echo "$2" | base64 -d > $temp/meta-data
 
+    # SSH public keys are authorized by cloud-init from the meta data
+    if [ -n "${6:-}" ]; then
+      printf "\npublic-keys:\n" >> $temp/meta-data
+      for file in $6/*/*; do
+        { tr -d '\r' < $file; echo; } | sed -e "/^[[:space:]]*$/d" -e "s/'/''/g" -e "s/^/  - '/" -e "s/$/'/" >> $temp/meta-data
+      done
+    fi
+
     if [[ "$3" =~ ^/.* ]]; then
+      # bootstrap data secrets of Cluster API have the format of the data
+      if [ -f $(dirname $3)/format ] && [ "$(cat $(dirname $3)/format)" != "cloud-config" ]; then
+        echo "unsupported user data format: $(cat $(dirname $3)/format)" >&2
+        exit 1
+      fi
       cp $3 $temp/user-data
     else
       echo "$3" | base64 -d > $temp/user-data
@@ -23,4 +39,83 @@ case $1 in
 
     genisoimage -volid cidata -joliet -rock -output $5 $temp
     ;;
+  "sysprep")
+    # Windows Setup searches the root of removable media for autounattend.xml
+    temp=$(mktemp -d)
+    cp -L $2/* $temp/
+    genisoimage -volid SYSPREP -joliet -rock -output $3 $temp
+    ;;
+  "config-disk")
+    # the keys of config maps and secrets are symlinks into a hidden directory
+    temp=$(mktemp -d)
+    if [ -n "$(ls $4)" ]; then
+      cp -L $4/* $temp/
+    fi
+    case $2 in
+      "fat")
+        # the image has room for the files and the FAT metadata
+        size=$(du -sk $temp | cut -f1)
+        mkfs.vfat -C -n "$3" $5 $((size + size / 10 + 1024))
+        if [ -n "$(ls $temp)" ]; then
+          mcopy -s -i $5 $temp/* ::/
+        fi
+        ;;
+      *)
+        genisoimage -volid "$3" -joliet -rock -output $5 $temp
+        ;;
+    esac
+    ;;
+  "http")
+    # The image is downloaded to the scratch directory in the background, and
+    # the progress is logged so that it shows in the logs of the container.
+    image=$4/$(basename "${2%%\?*}")
+    total=$(curl --silent --show-error --location --head "$2" | tr -d '\r' | sed -n 's/^[Cc]ontent-[Ll]ength: *//p' | tail -n 1) || total=""
+    curl --silent --show-error --location --fail --retry 3 --output "$image" "$2" &
+    pid=$!
+    while kill -0 $pid 2> /dev/null; do
+      sleep 10
+      downloaded=$(stat -c %s "$image" 2> /dev/null || echo 0)
+      if [ -n "$total" ] && [ "$total" -gt 0 ]; then
+        echo "downloaded $downloaded of $total bytes ($((downloaded * 100 / total))%)"
+      else
+        echo "downloaded $downloaded bytes"
+      fi
+    done
+    wait $pid
+    echo "downloaded $(stat -c %s "$image") bytes"
+
+    if [ -n "$3" ]; then
+      echo "${3#*:}  $image" | ${3%%:*}sum -c -
+    fi
+
+    case $image in
+      *.gz)
+        gunzip "$image"
+        image=${image%.gz}
+        ;;
+      *.xz)
+        xz -d "$image"
+        image=${image%.xz}
+        ;;
+    esac
+
+    # The maximum size of the disk is given when it's written to a Filesystem
+    # mode PVC, of which the filesystem overhead is reserved.
+    if [ -n "${6:-}" ]; then
+      size=$(qemu-img info --output json "$image" | sed -n 's/.*"virtual-size": \([0-9]*\).*/\1/p' | head -n 1)
+      if [ "$size" -gt "$6" ]; then
+        echo "disk of $size bytes does not fit in $6 bytes of the PVC" >&2
+        exit 1
+      fi
+    fi
+
+    # The block device of a PVC exists already, and is written in place.
+    if [ -b $5 ]; then
+      qemu-img convert -n -O raw "$image" $5
+    else
+      qemu-img convert -O raw "$image" $5
+    fi
+    rm -f "$image"
+    echo "imported $2"
+    ;;
 esac
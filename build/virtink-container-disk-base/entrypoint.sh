@@ -4,4 +4,20 @@ set -o errexit
 set -o nounset
 set -o pipefail
 
-qemu-img convert /disk $1
+# The maximum size of the disk is given when it's written to a Filesystem mode
+# PVC, of which the filesystem overhead is reserved.
+if [ -n "${2:-}" ]; then
+  size=$(qemu-img info --output json /disk | sed -n 's/.*"virtual-size": \([0-9]*\).*/\1/p' | head -n 1)
+  if [ "$size" -gt "$2" ]; then
+    echo "disk of $size bytes does not fit in $2 bytes of the PVC" >&2
+    exit 1
+  fi
+fi
+
+# The disk is converted to raw, whatever its format is. The block device of a
+# PVC exists already, and is written in place.
+if [ -b $1 ]; then
+  qemu-img convert -n -O raw /disk $1
+else
+  qemu-img convert -O raw /disk $1
+fi
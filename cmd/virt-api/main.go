@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/logging"
+	"github.com/smartxworks/virtink/pkg/tracing"
+	"github.com/smartxworks/virtink/pkg/virtapi"
+)
+
+var (
+	scheme   = runtime.NewScheme()
+	setupLog = ctrl.Log.WithName("setup")
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(virtv1beta1.AddToScheme(scheme))
+}
+
+func main() {
+	var metricsAddr string
+	var probeAddr string
+	var serverAddr string
+	var daemonNamespace string
+	var daemonPort int
+	var otlpEndpoint string
+	var otlpInsecure bool
+	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
+	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	flag.StringVar(&serverAddr, "server-bind-address", ":8443", "The address the API binds to.")
+	flag.StringVar(&daemonNamespace, "daemon-namespace", "virtink-system", "The namespace of virt-daemon pods.")
+	flag.IntVar(&daemonPort, "daemon-port", 8443, "The port virt-daemon serves the virt-daemon API on.")
+	flag.StringVar(&otlpEndpoint, "otlp-endpoint", "", "The OTLP gRPC endpoint spans are exported to. Tracing is disabled if empty.")
+	flag.BoolVar(&otlpInsecure, "otlp-insecure", false, "Connect to the OTLP endpoint without TLS.")
+	opts := zap.Options{
+		Development: true,
+	}
+	opts.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	logger, logLevel := logging.NewLogger(&opts)
+	ctrl.SetLogger(logger)
+
+	shutdownTracing, err := tracing.Setup(context.Background(), "virt-api", otlpEndpoint, otlpInsecure)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+		Scheme:                 scheme,
+		MetricsBindAddress:     metricsAddr,
+		HealthProbeBindAddress: probeAddr,
+	})
+	if err != nil {
+		setupLog.Error(err, "unable to create manager")
+		os.Exit(1)
+	}
+
+	if err = mgr.Add(&virtapi.Server{
+		Client:            mgr.GetClient(),
+		APIReader:         mgr.GetAPIReader(),
+		Config:            mgr.GetConfig(),
+		Addr:              serverAddr,
+		CertDirPath:       "/var/lib/virtink/api/cert",
+		DaemonCertDirPath: "/var/lib/virtink/api/daemon-cert",
+		DaemonNamespace:   daemonNamespace,
+		DaemonPort:        daemonPort,
+		Namespace:         os.Getenv("POD_NAMESPACE"),
+	}); err != nil {
+		setupLog.Error(err, "unable to create server")
+		os.Exit(1)
+	}
+
+	if err = mgr.Add(&logging.LevelUpdater{
+		Client:       mgr.GetClient(),
+		Level:        logLevel,
+		DefaultLevel: logLevel.Level(),
+	}); err != nil {
+		setupLog.Error(err, "unable to add log level updater")
+		os.Exit(1)
+	}
+
+	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
+		setupLog.Error(err, "unable to set up health check")
+		os.Exit(1)
+	}
+	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
+		setupLog.Error(err, "unable to set up ready check")
+		os.Exit(1)
+	}
+
+	setupLog.Info("starting manager")
+	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+		setupLog.Error(err, "problem running manager")
+		os.Exit(1)
+	}
+	if err := shutdownTracing(context.Background()); err != nil {
+		setupLog.Error(err, "unable to flush spans")
+	}
+}
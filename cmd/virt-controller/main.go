@@ -1,21 +1,32 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
+	"time"
+	// VM schedules may name any time zone, while the image has no zoneinfo
+	_ "time/tzdata"
 
 	netv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	"golang.org/x/time/rate"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/workqueue"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
 
 	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
 	"github.com/smartxworks/virtink/pkg/controller"
+	"github.com/smartxworks/virtink/pkg/logging"
+	"github.com/smartxworks/virtink/pkg/tracing"
 )
 
 var (
@@ -27,6 +38,7 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(virtv1beta1.AddToScheme(scheme))
 	utilruntime.Must(cdiv1beta1.AddToScheme(scheme))
 	utilruntime.Must(netv1.AddToScheme(scheme))
 }
@@ -38,19 +50,61 @@ func init() {
 func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
+	var leaseDuration time.Duration
+	var renewDeadline time.Duration
+	var retryPeriod time.Duration
 	var probeAddr string
+	var vmWorkers int
+	var vmmWorkers int
+	var vmeWorkers int
+	var vmaWorkers int
+	var syncPeriod time.Duration
+	var batchPeriod time.Duration
+	var rateLimiterBaseDelay time.Duration
+	var rateLimiterMaxDelay time.Duration
+	var rateLimiterQPS float64
+	var rateLimiterBurst int
+	var otlpEndpoint string
+	var otlpInsecure bool
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", true,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.DurationVar(&leaseDuration, "leader-elect-lease-duration", 15*time.Second,
+		"The duration that non-leader candidates will wait to force acquire leadership.")
+	flag.DurationVar(&renewDeadline, "leader-elect-renew-deadline", 10*time.Second,
+		"The duration that the acting leader will retry refreshing leadership before giving up.")
+	flag.DurationVar(&retryPeriod, "leader-elect-retry-period", 2*time.Second,
+		"The duration the clients should wait between attempting acquisition and renewal of leadership.")
+	flag.IntVar(&vmWorkers, "vm-workers", 1, "The number of VMs that may be reconciled concurrently.")
+	flag.IntVar(&vmmWorkers, "vmm-workers", 1, "The number of VMMs that may be reconciled concurrently.")
+	flag.IntVar(&vmeWorkers, "vme-workers", 1, "The number of VMEs that may be reconciled concurrently.")
+	flag.IntVar(&vmaWorkers, "vma-workers", 1, "The number of VMAs that may be reconciled concurrently.")
+	flag.DurationVar(&syncPeriod, "sync-period", 10*time.Hour, "The minimum interval at which all watched objects are reconciled.")
+	flag.DurationVar(&batchPeriod, "reconcile-batch-period", 0,
+		"The duration reconciliations triggered by changes of dependent objects, e.g. VM pods, are delayed for, "+
+			"so that bursts of changes result in a single status update.")
+	flag.DurationVar(&rateLimiterBaseDelay, "rate-limiter-base-delay", 5*time.Millisecond, "The initial delay of retrying a failed reconciliation.")
+	flag.DurationVar(&rateLimiterMaxDelay, "rate-limiter-max-delay", 1000*time.Second, "The maximum delay of retrying a failed reconciliation.")
+	flag.Float64Var(&rateLimiterQPS, "rate-limiter-qps", 10, "The overall rate of retries per controller.")
+	flag.IntVar(&rateLimiterBurst, "rate-limiter-burst", 100, "The overall burst of retries per controller.")
+	flag.StringVar(&otlpEndpoint, "otlp-endpoint", "", "The OTLP gRPC endpoint spans are exported to. Tracing is disabled if empty.")
+	flag.BoolVar(&otlpInsecure, "otlp-insecure", false, "Connect to the OTLP endpoint without TLS.")
 	opts := zap.Options{
 		Development: true,
 	}
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
-	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+	logger, logLevel := logging.NewLogger(&opts)
+	ctrl.SetLogger(logger)
+
+	shutdownTracing, err := tracing.Setup(context.Background(), "virt-controller", otlpEndpoint, otlpInsecure)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
 
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
@@ -59,34 +113,194 @@ func main() {
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "controller.virtink.smartx.com",
+		// let a standby replica take over right away on graceful shutdown
+		LeaderElectionReleaseOnCancel: true,
+		LeaseDuration:                 &leaseDuration,
+		RenewDeadline:                 &renewDeadline,
+		RetryPeriod:                   &retryPeriod,
+		SyncPeriod:                    &syncPeriod,
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to create manager")
 		os.Exit(1)
 	}
 
+	newRateLimiter := func() ratelimiter.RateLimiter {
+		return workqueue.NewMaxOfRateLimiter(
+			workqueue.NewItemExponentialFailureRateLimiter(rateLimiterBaseDelay, rateLimiterMaxDelay),
+			&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(rateLimiterQPS), rateLimiterBurst)},
+		)
+	}
+
 	if err = (&controller.VMReconciler{
 		Client:             mgr.GetClient(),
 		Scheme:             mgr.GetScheme(),
 		Recorder:           mgr.GetEventRecorderFor("virt-controller"),
 		PrerunnerImageName: os.Getenv("PRERUNNER_IMAGE"),
+
+		MaxConcurrentReconciles: vmWorkers,
+		RateLimiter:             newRateLimiter(),
+		BatchPeriod:             batchPeriod,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "VM")
 		os.Exit(1)
 	}
 
 	if err = (&controller.VMMReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Recorder:  mgr.GetEventRecorderFor("virt-controller"),
+		Namespace: os.Getenv("POD_NAMESPACE"),
+
+		MaxConcurrentReconciles: vmmWorkers,
+		RateLimiter:             newRateLimiter(),
+		BatchPeriod:             batchPeriod,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "VMM")
+		os.Exit(1)
+	}
+
+	if err = (&controller.VMEReconciler{
+		Client:            mgr.GetClient(),
+		Scheme:            mgr.GetScheme(),
+		Recorder:          mgr.GetEventRecorderFor("virt-controller"),
+		ExporterImageName: os.Getenv("EXPORTER_IMAGE"),
+
+		MaxConcurrentReconciles: vmeWorkers,
+		RateLimiter:             newRateLimiter(),
+		BatchPeriod:             batchPeriod,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "VME")
+		os.Exit(1)
+	}
+
+	if err = (&controller.VMQuotaReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+
+		RateLimiter: newRateLimiter(),
+		BatchPeriod: batchPeriod,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "VMQuota")
+		os.Exit(1)
+	}
+
+	if err = (&controller.VMAReconciler{
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
 		Recorder: mgr.GetEventRecorderFor("virt-controller"),
+
+		MaxConcurrentReconciles: vmaWorkers,
+		RateLimiter:             newRateLimiter(),
+		BatchPeriod:             batchPeriod,
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "VMM")
+		setupLog.Error(err, "unable to create controller", "controller", "VMA")
+		os.Exit(1)
+	}
+
+	if err = (&controller.VMTIReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("virt-controller"),
+
+		RateLimiter: newRateLimiter(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "VMTI")
 		os.Exit(1)
 	}
 
-	mgr.GetWebhookServer().Register("/mutate-v1alpha1-virtualmachine", &webhook.Admission{Handler: &controller.VMMutator{}})
-	mgr.GetWebhookServer().Register("/validate-v1alpha1-virtualmachine", &webhook.Admission{Handler: &controller.VMValidator{}})
+	if err = (&controller.VMPoolReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("virt-controller"),
+
+		RateLimiter: newRateLimiter(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "VMPool")
+		os.Exit(1)
+	}
+
+	if err = (&controller.VMRollingRestartReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("virt-controller"),
+
+		RateLimiter: newRateLimiter(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "VMRollingRestart")
+		os.Exit(1)
+	}
+
+	if err = (&controller.VMScheduleReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("virt-controller"),
+
+		RateLimiter: newRateLimiter(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "VMSchedule")
+		os.Exit(1)
+	}
+
+	if err = (&controller.NodePressureReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("virt-controller"),
+
+		RateLimiter: newRateLimiter(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NodePressure")
+		os.Exit(1)
+	}
+
+	if err = (&controller.NodeFailureReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("virt-controller"),
+
+		RateLimiter: newRateLimiter(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NodeFailure")
+		os.Exit(1)
+	}
+
+	if err = mgr.Add(&controller.Rebalancer{
+		Client:   mgr.GetClient(),
+		Recorder: mgr.GetEventRecorderFor("virt-controller"),
+	}); err != nil {
+		setupLog.Error(err, "unable to add rebalancer")
+		os.Exit(1)
+	}
+
+	if err = mgr.Add(&controller.UsageAccountant{
+		Client: mgr.GetClient(),
+	}); err != nil {
+		setupLog.Error(err, "unable to add usage accountant")
+		os.Exit(1)
+	}
+
+	mgr.GetWebhookServer().Register("/mutate-v1alpha1-virtualmachine", &webhook.Admission{Handler: &controller.VMMutator{Client: mgr.GetClient()}})
+	mgr.GetWebhookServer().Register("/validate-v1alpha1-virtualmachine", &webhook.Admission{Handler: &controller.VMValidator{Client: mgr.GetClient()}})
 	mgr.GetWebhookServer().Register("/validate-v1alpha1-virtualmachinemigration", &webhook.Admission{Handler: &controller.VMMValidator{Client: mgr.GetClient()}})
+	mgr.GetWebhookServer().Register("/validate-v1alpha1-virtualmachineexport", &webhook.Admission{Handler: &controller.VMEValidator{Client: mgr.GetClient()}})
+	mgr.GetWebhookServer().Register("/mutate-v1beta1-virtualmachineaction", &webhook.Admission{Handler: &controller.VMAMutator{}})
+	mgr.GetWebhookServer().Register("/validate-v1beta1-virtualmachineaction", &webhook.Admission{Handler: &controller.VMAValidator{Client: mgr.GetClient()}})
+	mgr.GetWebhookServer().Register("/validate-v1beta1-virtualmachinerollingrestart", &webhook.Admission{Handler: &controller.VMRollingRestartValidator{Client: mgr.GetClient()}})
+	mgr.GetWebhookServer().Register("/validate-v1beta1-virtualmachinesecuritygroup", &webhook.Admission{Handler: &controller.VMSecurityGroupValidator{}})
+	mgr.GetWebhookServer().Register("/mutate-v1beta1-virtualmachinetemplateinstance", &webhook.Admission{Handler: &controller.VMTIMutator{}})
+	mgr.GetWebhookServer().Register("/validate-v1beta1-virtualmachinetemplateinstance", &webhook.Admission{Handler: &controller.VMTIValidator{Client: mgr.GetClient()}})
+	mgr.GetWebhookServer().Register("/validate-v1beta1-virtinkconfig", &webhook.Admission{Handler: &controller.VirtinkConfigValidator{}})
+	mgr.GetWebhookServer().Register("/validate-v1beta1-virtinknamespaceconfig", &webhook.Admission{Handler: &controller.VirtinkNamespaceConfigValidator{}})
+	mgr.GetWebhookServer().Register("/convert", &conversion.Webhook{})
+
+	if err = mgr.Add(&logging.LevelUpdater{
+		Client:       mgr.GetClient(),
+		Level:        logLevel,
+		DefaultLevel: logLevel.Level(),
+	}); err != nil {
+		setupLog.Error(err, "unable to add log level updater")
+		os.Exit(1)
+	}
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
@@ -96,10 +310,19 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	// webhooks are served by every replica, not only the leader, so the
+	// webhook service must only route to replicas that have started serving
+	if err := mgr.AddReadyzCheck("webhook", mgr.GetWebhookServer().StartedChecker()); err != nil {
+		setupLog.Error(err, "unable to set up webhook ready check")
+		os.Exit(1)
+	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
+	if err := shutdownTracing(context.Background()); err != nil {
+		setupLog.Error(err, "unable to flush spans")
+	}
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
 
@@ -8,13 +9,17 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
 	"github.com/smartxworks/virtink/pkg/daemon"
 	"github.com/smartxworks/virtink/pkg/daemon/deviceplugin"
 	"github.com/smartxworks/virtink/pkg/daemon/tcpproxy"
+	"github.com/smartxworks/virtink/pkg/logging"
+	"github.com/smartxworks/virtink/pkg/tracing"
 )
 
 var (
@@ -26,20 +31,40 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(virtv1beta1.AddToScheme(scheme))
 }
 
 func main() {
 	var metricsAddr string
 	var probeAddr string
+	var serverAddr string
+	var debugAddr string
+	var otlpEndpoint string
+	var otlpInsecure bool
+	var usbDevices string
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	flag.StringVar(&serverAddr, "server-bind-address", ":8443", "The address the endpoints for other Virtink components bind to.")
+	flag.StringVar(&debugAddr, "debug-bind-address", "", "The address the pprof, expvar and VM state debug endpoints bind to, "+
+		"e.g. 127.0.0.1:6060. Clients must present certificates unless it's a loopback address. Disabled if empty.")
+	flag.StringVar(&otlpEndpoint, "otlp-endpoint", "", "The OTLP gRPC endpoint spans are exported to. Tracing is disabled if empty.")
+	flag.BoolVar(&otlpInsecure, "otlp-insecure", false, "Connect to the OTLP endpoint without TLS.")
+	flag.StringVar(&usbDevices, "usb-devices", "", "The host USB devices published as devices.virtink.io/usb-<name> resources for passthrough, "+
+		"as comma separated <name>=<vendor ID>:<product ID>, e.g. dongle=0529:0001.")
 	opts := zap.Options{
 		Development: true,
 	}
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
-	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+	logger, logLevel := logging.NewLogger(&opts)
+	ctrl.SetLogger(logger)
+
+	shutdownTracing, err := tracing.Setup(context.Background(), "virt-daemon", otlpEndpoint, otlpInsecure)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
 
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
@@ -51,23 +76,83 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err = (&daemon.VMReconciler{
-		Client:        mgr.GetClient(),
-		Scheme:        mgr.GetScheme(),
-		Recorder:      mgr.GetEventRecorderFor("virt-daemon"),
-		NodeName:      os.Getenv("NODE_NAME"),
-		NodeIP:        os.Getenv("NODE_IP"),
-		RelayProvider: tcpproxy.NewRelayProvider(),
-	}).SetupWithManager(mgr); err != nil {
+	leaseClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme(), Mapper: mgr.GetRESTMapper()})
+	if err != nil {
+		setupLog.Error(err, "unable to create lease client")
+		os.Exit(1)
+	}
+
+	consoleLogs := daemon.NewConsoleLogs()
+	vmReconciler := &daemon.VMReconciler{
+		Client:          mgr.GetClient(),
+		Scheme:          mgr.GetScheme(),
+		Recorder:        mgr.GetEventRecorderFor("virt-daemon"),
+		NodeName:        os.Getenv("NODE_NAME"),
+		NodeIP:          os.Getenv("NODE_IP"),
+		RelayProvider:   tcpproxy.NewRelayProvider(),
+		ConsoleLogs:     consoleLogs,
+		DownwardMetrics: daemon.NewDownwardMetrics(os.Getenv("NODE_NAME")),
+		StateDirPath:    "/var/lib/virtink/daemon/state",
+		LeaseClient:     leaseClient,
+		APIReader:       mgr.GetAPIReader(),
+		Namespace:       os.Getenv("POD_NAMESPACE"),
+	}
+	if err = vmReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "VM")
 		os.Exit(1)
 	}
 
-	if err = mgr.Add(deviceplugin.NewDevicePluginManager()); err != nil {
+	if err = mgr.Add(&daemon.Server{
+		Client:      mgr.GetClient(),
+		NodeName:    os.Getenv("NODE_NAME"),
+		Addr:        serverAddr,
+		CertDirPath: "/var/lib/virtink/daemon/cert",
+		ConsoleLogs: consoleLogs,
+	}); err != nil {
+		setupLog.Error(err, "unable to create server")
+		os.Exit(1)
+	}
+
+	if debugAddr != "" {
+		if err = mgr.Add(&daemon.DebugServer{
+			Addr:        debugAddr,
+			CertDirPath: "/var/lib/virtink/daemon/cert",
+			Reconciler:  vmReconciler,
+		}); err != nil {
+			setupLog.Error(err, "unable to create debug server")
+			os.Exit(1)
+		}
+	}
+
+	usbDeviceModels, err := deviceplugin.ParseUSBDeviceModels(usbDevices)
+	if err != nil {
+		setupLog.Error(err, "invalid --usb-devices")
+		os.Exit(1)
+	}
+	devicePluginManager := deviceplugin.NewDevicePluginManager(usbDeviceModels)
+	if err = mgr.Add(devicePluginManager); err != nil {
 		setupLog.Error(err, "unable to create device plugin manager")
 		os.Exit(1)
 	}
 
+	if err = mgr.Add(&daemon.VCPUResourcePublisher{
+		Client:        mgr.GetClient(),
+		NodeName:      os.Getenv("NODE_NAME"),
+		DevicePlugins: devicePluginManager,
+	}); err != nil {
+		setupLog.Error(err, "unable to create vCPU resource publisher")
+		os.Exit(1)
+	}
+
+	if err = mgr.Add(&logging.LevelUpdater{
+		Client:       mgr.GetClient(),
+		Level:        logLevel,
+		DefaultLevel: logLevel.Level(),
+	}); err != nil {
+		setupLog.Error(err, "unable to add log level updater")
+		os.Exit(1)
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -82,4 +167,7 @@ func main() {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
+	if err := shutdownTracing(context.Background()); err != nil {
+		setupLog.Error(err, "unable to flush spans")
+	}
 }
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"crypto/subtle"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/smartxworks/virtink/pkg/tlsutil"
+)
+
+const workDirPath = "/var/lib/virtink/export"
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatalf("Usage: %s serve|push [flags]", os.Args[0])
+	}
+
+	switch os.Args[1] {
+	case "serve":
+		serve(os.Args[2:])
+	case "push":
+		push(os.Args[2:])
+	default:
+		log.Fatalf("Unknown command: %s", os.Args[1])
+	}
+}
+
+func serve(args []string) {
+	var diskPath string
+	var format string
+	var certDirPath string
+	var tokenFilePath string
+	var addr string
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	flags.StringVar(&diskPath, "disk", diskPath, "Path to the exported disk")
+	flags.StringVar(&format, "format", "raw", "Format of the served disk image")
+	flags.StringVar(&certDirPath, "cert-dir", certDirPath, "Directory containing tls.crt and tls.key")
+	flags.StringVar(&tokenFilePath, "token-file", tokenFilePath, "File containing the bearer token")
+	flags.StringVar(&addr, "addr", ":8443", "The address the HTTPS endpoint binds to")
+	flags.Parse(args)
+
+	imagePath, err := prepareDiskImage(diskPath, format)
+	if err != nil {
+		log.Fatalf("Failed to prepare disk image: %s", err)
+	}
+
+	tokenData, err := os.ReadFile(tokenFilePath)
+	if err != nil {
+		log.Fatalf("Failed to read token: %s", err)
+	}
+	token := strings.TrimSpace(string(tokenData))
+
+	imageName := "disk.img"
+	if format == "qcow2" {
+		imageName = "disk.qcow2"
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/"+imageName, func(w http.ResponseWriter, r *http.Request) {
+		requestToken := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(requestToken), []byte(token)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		image, err := os.Open(imagePath)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer image.Close()
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", imageName))
+		http.ServeContent(w, r, imageName, time.Time{}, image)
+	})
+
+	server := &http.Server{
+		Addr:    addr,
+		Handler: mux,
+		TLSConfig: &tls.Config{
+			GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+				return tlsutil.LoadCert(certDirPath)
+			},
+		},
+	}
+	log.Printf("Serving %s on %s", imageName, addr)
+	if err := server.ListenAndServeTLS("", ""); err != nil {
+		log.Fatalf("Failed to serve: %s", err)
+	}
+}
+
+func push(args []string) {
+	var diskPath string
+	var format string
+	var image string
+	var baseImage string
+	var authFilePath string
+	flags := flag.NewFlagSet("push", flag.ExitOnError)
+	flags.StringVar(&diskPath, "disk", diskPath, "Path to the exported disk")
+	flags.StringVar(&format, "format", "raw", "Format of the disk image in the container image")
+	flags.StringVar(&image, "image", image, "The container image to push to")
+	flags.StringVar(&baseImage, "base-image", "smartxworks/virtink-container-disk-base", "The base image of the container image")
+	flags.StringVar(&authFilePath, "auth-file", authFilePath, "Docker config file containing the registry credentials")
+	flags.Parse(args)
+
+	imagePath, err := prepareDiskImage(diskPath, format)
+	if err != nil {
+		log.Fatalf("Failed to prepare disk image: %s", err)
+	}
+
+	layoutPath := filepath.Join(workDirPath, "oci")
+	if err := runCommand("skopeo", "copy", "docker://"+baseImage, "oci:"+layoutPath+":base"); err != nil {
+		log.Fatalf("Failed to pull base image: %s", err)
+	}
+
+	if err := appendDiskLayer(layoutPath, "base", "disk", imagePath); err != nil {
+		log.Fatalf("Failed to append disk layer: %s", err)
+	}
+
+	pushArgs := []string{"copy"}
+	if authFilePath != "" {
+		pushArgs = append(pushArgs, "--dest-authfile", authFilePath)
+	}
+	pushArgs = append(pushArgs, "oci:"+layoutPath+":disk", "docker://"+image)
+	if err := runCommand("skopeo", pushArgs...); err != nil {
+		log.Fatalf("Failed to push image: %s", err)
+	}
+	log.Printf("Pushed %s", image)
+}
+
+// prepareDiskImage returns the path of the disk image in the requested
+// format. Disks are stored as raw images, so only qcow2 needs a conversion.
+func prepareDiskImage(diskPath string, format string) (string, error) {
+	switch format {
+	case "raw":
+		return diskPath, nil
+	case "qcow2":
+		imagePath := filepath.Join(workDirPath, "disk.qcow2")
+		if err := runCommand("qemu-img", "convert", "-p", "-f", "raw", "-O", "qcow2", "-c", diskPath, imagePath); err != nil {
+			return "", fmt.Errorf("convert disk: %s", err)
+		}
+		return imagePath, nil
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+func runCommand(name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
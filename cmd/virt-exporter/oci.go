@@ -0,0 +1,222 @@
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	ociRefNameAnnotation = "org.opencontainers.image.ref.name"
+	ociLayerMediaType    = "application/vnd.oci.image.layer.v1.tar+gzip"
+)
+
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type ociIndex struct {
+	SchemaVersion int             `json:"schemaVersion"`
+	MediaType     string          `json:"mediaType,omitempty"`
+	Manifests     []ociDescriptor `json:"manifests"`
+}
+
+type ociManifest struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	MediaType     string            `json:"mediaType,omitempty"`
+	Config        ociDescriptor     `json:"config"`
+	Layers        []ociDescriptor   `json:"layers"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+}
+
+// appendDiskLayer adds a layer containing the disk image at /disk on top of
+// the image tagged baseRef in the OCI layout, and tags the result as ref.
+func appendDiskLayer(layoutPath string, baseRef string, ref string, imagePath string) error {
+	var index ociIndex
+	if err := readJSON(filepath.Join(layoutPath, "index.json"), &index); err != nil {
+		return fmt.Errorf("read index: %s", err)
+	}
+
+	var baseManifestDescriptor *ociDescriptor
+	for i := range index.Manifests {
+		if index.Manifests[i].Annotations[ociRefNameAnnotation] == baseRef {
+			baseManifestDescriptor = &index.Manifests[i]
+			break
+		}
+	}
+	if baseManifestDescriptor == nil {
+		return fmt.Errorf("base image not found: %s", baseRef)
+	}
+
+	var manifest ociManifest
+	if err := readJSON(blobPath(layoutPath, baseManifestDescriptor.Digest), &manifest); err != nil {
+		return fmt.Errorf("read base manifest: %s", err)
+	}
+
+	var config map[string]interface{}
+	if err := readJSON(blobPath(layoutPath, manifest.Config.Digest), &config); err != nil {
+		return fmt.Errorf("read base config: %s", err)
+	}
+
+	layerDescriptor, diffID, err := writeDiskLayer(layoutPath, imagePath)
+	if err != nil {
+		return fmt.Errorf("write disk layer: %s", err)
+	}
+
+	rootfs, _ := config["rootfs"].(map[string]interface{})
+	if rootfs == nil {
+		return fmt.Errorf("invalid base config: missing rootfs")
+	}
+	diffIDs, _ := rootfs["diff_ids"].([]interface{})
+	rootfs["diff_ids"] = append(diffIDs, diffID)
+	history, _ := config["history"].([]interface{})
+	config["history"] = append(history, map[string]interface{}{
+		"created":    time.Now().UTC().Format(time.RFC3339),
+		"created_by": "virt-exporter",
+	})
+
+	configDescriptor, err := writeJSONBlob(layoutPath, manifest.Config.MediaType, config)
+	if err != nil {
+		return fmt.Errorf("write config: %s", err)
+	}
+	manifest.Config = *configDescriptor
+	manifest.Layers = append(manifest.Layers, *layerDescriptor)
+
+	manifestDescriptor, err := writeJSONBlob(layoutPath, baseManifestDescriptor.MediaType, manifest)
+	if err != nil {
+		return fmt.Errorf("write manifest: %s", err)
+	}
+	manifestDescriptor.Annotations = map[string]string{ociRefNameAnnotation: ref}
+	index.Manifests = append(index.Manifests, *manifestDescriptor)
+
+	if err := writeJSON(filepath.Join(layoutPath, "index.json"), index); err != nil {
+		return fmt.Errorf("write index: %s", err)
+	}
+	return nil
+}
+
+// writeDiskLayer writes a gzipped tar layer containing the disk image as a
+// blob, and returns its descriptor along with its uncompressed digest.
+func writeDiskLayer(layoutPath string, imagePath string) (*ociDescriptor, string, error) {
+	image, err := os.Open(imagePath)
+	if err != nil {
+		return nil, "", err
+	}
+	defer image.Close()
+
+	imageSize, err := image.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := image.Seek(0, io.SeekStart); err != nil {
+		return nil, "", err
+	}
+
+	blob, err := os.CreateTemp(filepath.Join(layoutPath, "blobs", "sha256"), "layer")
+	if err != nil {
+		return nil, "", err
+	}
+	defer os.Remove(blob.Name())
+	defer blob.Close()
+
+	blobDigester := sha256.New()
+	blobSize := &countingWriter{}
+	gzipWriter := gzip.NewWriter(io.MultiWriter(blob, blobDigester, blobSize))
+	diffIDDigester := sha256.New()
+	tarWriter := tar.NewWriter(io.MultiWriter(gzipWriter, diffIDDigester))
+
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     "disk",
+		Mode:     0644,
+		Size:     imageSize,
+		ModTime:  time.Now(),
+	}); err != nil {
+		return nil, "", err
+	}
+	if _, err := io.Copy(tarWriter, image); err != nil {
+		return nil, "", err
+	}
+	if err := tarWriter.Close(); err != nil {
+		return nil, "", err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return nil, "", err
+	}
+	if err := blob.Close(); err != nil {
+		return nil, "", err
+	}
+
+	digest := formatDigest(blobDigester)
+	if err := os.Rename(blob.Name(), blobPath(layoutPath, digest)); err != nil {
+		return nil, "", err
+	}
+	return &ociDescriptor{
+		MediaType: ociLayerMediaType,
+		Digest:    digest,
+		Size:      blobSize.n,
+	}, formatDigest(diffIDDigester), nil
+}
+
+func writeJSONBlob(layoutPath string, mediaType string, v interface{}) (*ociDescriptor, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	digester := sha256.New()
+	digester.Write(data)
+	digest := formatDigest(digester)
+	if err := os.WriteFile(blobPath(layoutPath, digest), data, 0644); err != nil {
+		return nil, err
+	}
+	return &ociDescriptor{
+		MediaType: mediaType,
+		Digest:    digest,
+		Size:      int64(len(data)),
+	}, nil
+}
+
+func readJSON(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func writeJSON(path string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+func blobPath(layoutPath string, digest string) string {
+	return filepath.Join(layoutPath, "blobs", "sha256", strings.TrimPrefix(digest, "sha256:"))
+}
+
+func formatDigest(digester hash.Hash) string {
+	return "sha256:" + hex.EncodeToString(digester.Sum(nil))
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
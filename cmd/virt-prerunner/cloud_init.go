@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/yaml"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/cloudhypervisor"
+)
+
+// networkConfig is the network data of version 2 of cloud-init, a subset of
+// the netplan configuration.
+type networkConfig struct {
+	Version   int                              `json:"version"`
+	Ethernets map[string]networkConfigEthernet `json:"ethernets,omitempty"`
+	Bonds     map[string]networkConfigBond     `json:"bonds,omitempty"`
+}
+
+type networkConfigEthernet struct {
+	Match networkConfigMatch `json:"match"`
+	networkConfigAddressing
+	MTU int `json:"mtu,omitempty"`
+}
+
+type networkConfigMatch struct {
+	MACAddress string `json:"macaddress"`
+}
+
+type networkConfigBond struct {
+	Interfaces []string                    `json:"interfaces"`
+	Parameters networkConfigBondParameters `json:"parameters"`
+	networkConfigAddressing
+}
+
+type networkConfigBondParameters struct {
+	Mode               string `json:"mode"`
+	Primary            string `json:"primary,omitempty"`
+	MIIMonitorInterval uint32 `json:"mii-monitor-interval,omitempty"`
+	FailOverMACPolicy  string `json:"fail-over-mac-policy,omitempty"`
+}
+
+type networkConfigAddressing struct {
+	DHCP4     bool                 `json:"dhcp4,omitempty"`
+	Addresses []string             `json:"addresses,omitempty"`
+	Routes    []networkConfigRoute `json:"routes,omitempty"`
+}
+
+type networkConfigRoute struct {
+	To     string `json:"to"`
+	Via    string `json:"via"`
+	Metric uint32 `json:"metric,omitempty"`
+}
+
+// generateCloudInitNetworkData rebuilds the image of the cloudInit volume
+// with network data generated from the interfaces of the VM. The MACs of
+// guest NICs are only known once their networks are set up, so virt-init-volume
+// leaves the cloud-init data next to the image for it to be rebuilt.
+func generateCloudInitNetworkData(volumeName string, vm *virtv1alpha1.VirtualMachine, vmConfig *cloudhypervisor.VmConfig, sriovMACs map[string]string) error {
+	config, err := renderNetworkConfig(vm.Spec.Instance.Interfaces, vm.Spec.Instance.Bonds, vmConfig.Net, sriovMACs)
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("marshal network config: %s", err)
+	}
+
+	dataDirPath := filepath.Join("/mnt", volumeName, "cloud-init")
+	if err := os.WriteFile(filepath.Join(dataDirPath, "network-config"), data, 0644); err != nil {
+		return fmt.Errorf("write network config: %s", err)
+	}
+	if _, err := executeCommand("genisoimage", "-volid", "cidata", "-joliet", "-rock", "-output", filepath.Join("/mnt", volumeName, "cloud-init.iso"), dataDirPath); err != nil {
+		return fmt.Errorf("build cloud-init image: %s", err)
+	}
+	return nil
+}
+
+// renderNetworkConfig matches each interface by the MAC of its guest NIC. SR-IOV
+// interfaces of which the MAC is not reported are left out, and so are bonds
+// without interfaces.
+func renderNetworkConfig(ifaces []virtv1alpha1.Interface, bonds []virtv1alpha1.Bond, netConfigs []*cloudhypervisor.NetConfig, sriovMACs map[string]string) (*networkConfig, error) {
+	config := networkConfig{
+		Version:   2,
+		Ethernets: map[string]networkConfigEthernet{},
+	}
+	bondedIfaces := map[string]bool{}
+	for _, bond := range bonds {
+		for _, ifaceName := range bond.Interfaces {
+			bondedIfaces[ifaceName] = true
+		}
+	}
+
+	for _, iface := range ifaces {
+		var ethernet networkConfigEthernet
+		for _, netConfig := range netConfigs {
+			if netConfig.Id == iface.Name {
+				ethernet.Match.MACAddress = netConfig.Mac
+				ethernet.MTU = netConfig.Mtu
+				break
+			}
+		}
+		if iface.SRIOV != nil {
+			ethernet.Match.MACAddress = sriovMACs[iface.Name]
+		}
+		if ethernet.Match.MACAddress == "" {
+			continue
+		}
+
+		// the addresses of bonded interfaces belong to their bonds
+		if !bondedIfaces[iface.Name] {
+			addressing, err := renderNetworkConfigAddressing(iface.Addresses, iface.Routes)
+			if err != nil {
+				return nil, err
+			}
+			ethernet.networkConfigAddressing = *addressing
+		}
+		config.Ethernets[iface.Name] = ethernet
+	}
+
+	for _, bond := range bonds {
+		var bondIfaceNames []string
+		for _, ifaceName := range bond.Interfaces {
+			if _, ok := config.Ethernets[ifaceName]; ok {
+				bondIfaceNames = append(bondIfaceNames, ifaceName)
+			}
+		}
+		if len(bondIfaceNames) == 0 {
+			continue
+		}
+
+		addressing, err := renderNetworkConfigAddressing(bond.Addresses, bond.Routes)
+		if err != nil {
+			return nil, err
+		}
+		networkBond := networkConfigBond{
+			Interfaces: bondIfaceNames,
+			Parameters: networkConfigBondParameters{
+				Mode:               string(bond.Mode),
+				Primary:            bond.Primary,
+				MIIMonitorInterval: bond.MIIMonitorInterval,
+			},
+			networkConfigAddressing: *addressing,
+		}
+		if bond.Mode == virtv1alpha1.BondModeActiveBackup {
+			// the networks of the interfaces only pass frames of the MACs
+			// of their own guest NICs
+			networkBond.Parameters.FailOverMACPolicy = "active"
+		}
+		if config.Bonds == nil {
+			config.Bonds = map[string]networkConfigBond{}
+		}
+		config.Bonds[bond.Name] = networkBond
+	}
+	return &config, nil
+}
+
+// renderNetworkConfigAddressing configures the static addresses and routes,
+// and DHCP for IPv4 if none of the addresses is IPv4.
+func renderNetworkConfigAddressing(addresses []string, routes []virtv1alpha1.InterfaceRoute) (*networkConfigAddressing, error) {
+	addressing := networkConfigAddressing{
+		DHCP4: true,
+	}
+	for _, address := range addresses {
+		ip, _, err := net.ParseCIDR(address)
+		if err != nil {
+			return nil, fmt.Errorf("parse address %q: %s", address, err)
+		}
+		if ip.To4() != nil {
+			addressing.DHCP4 = false
+		}
+		addressing.Addresses = append(addressing.Addresses, address)
+	}
+	for _, route := range routes {
+		addressing.Routes = append(addressing.Routes, networkConfigRoute{
+			To:     route.To,
+			Via:    route.Via,
+			Metric: route.Metric,
+		})
+	}
+	return &addressing, nil
+}
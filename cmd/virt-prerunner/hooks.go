@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/cloudhypervisor"
+	"github.com/smartxworks/virtink/pkg/hooks"
+)
+
+// hookedVMConfigPath is where the config mutated by hooks is left for
+// virt-daemon to create the VM with.
+const hookedVMConfigPath = "/var/run/virtink/vm-config.json"
+
+// hooksTimeout bounds the time to wait for hook sidecars to start serving and
+// to mutate the config.
+const hooksTimeout = 5 * time.Minute
+
+// callHooks calls the hook sidecars in order, passing each the config
+// returned by the previous one, and returns the final config.
+func callHooks(ctx context.Context, vm *virtv1alpha1.VirtualMachine, vmConfig *cloudhypervisor.VmConfig, numHooks int) (*cloudhypervisor.VmConfig, error) {
+	log := logr.FromContextOrDiscard(ctx)
+	ctx, cancel := context.WithTimeout(ctx, hooksTimeout)
+	defer cancel()
+
+	// the same console and serial as booting with arguments
+	vmConfig.Console = &cloudhypervisor.ConsoleConfig{Mode: "Pty"}
+	vmConfig.Serial = &cloudhypervisor.ConsoleConfig{Mode: "Tty"}
+
+	for i := 0; i < numHooks; i++ {
+		start := time.Now()
+		newVMConfig, err := hooks.OnDefineVM(ctx, hooks.SocketPath(i), vm, vmConfig)
+		if err != nil {
+			return nil, fmt.Errorf("call hook %d: %s", i, err)
+		}
+		if newVMConfig.Payload == nil || newVMConfig.Cpus == nil || newVMConfig.Memory == nil {
+			return nil, fmt.Errorf("hook %d returned a config without payload, CPUs or memory", i)
+		}
+		vmConfig = newVMConfig
+		log.Info("called hook", "hook", i, "duration", time.Since(start))
+	}
+	return vmConfig, nil
+}
+
+// saveHookedVMConfig saves the config mutated by hooks for virt-daemon.
+func saveHookedVMConfig(vmConfig *cloudhypervisor.VmConfig) error {
+	vmConfigJSON, err := json.Marshal(vmConfig)
+	if err != nil {
+		return fmt.Errorf("marshal VM config: %s", err)
+	}
+	if err := os.WriteFile(hookedVMConfigPath, vmConfigJSON, 0644); err != nil {
+		return fmt.Errorf("write VM config: %s", err)
+	}
+	return nil
+}
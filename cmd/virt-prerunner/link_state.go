@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+
+	"github.com/go-logr/logr"
+	"github.com/vishvananda/netlink"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/cloudhypervisor"
+	"github.com/smartxworks/virtink/pkg/linkstate"
+)
+
+// getInterfaceLinks returns the links by which interfaces are cut off from
+// their networks. Taps of bridge and masquerade interfaces are ports of
+// bridges, which drop frames of ports without a carrier, while the frames of
+// OVS interfaces are redirected between their taps and pod links, which are
+// set down instead.
+func getInterfaceLinks(vm *virtv1alpha1.VirtualMachine, vmConfig *cloudhypervisor.VmConfig) map[string]linkstate.Link {
+	links := map[string]linkstate.Link{}
+	for _, iface := range vm.Spec.Instance.Interfaces {
+		for networkIndex, network := range vm.Spec.Networks {
+			if network.Name != iface.Name {
+				continue
+			}
+
+			switch {
+			case iface.Bridge != nil || iface.Masquerade != nil:
+				for _, netConfig := range vmConfig.Net {
+					if netConfig.Id == iface.Name && netConfig.Tap != "" {
+						links[iface.Name] = linkstate.Link{Name: netConfig.Tap, Carrier: true}
+					}
+				}
+			case iface.OVS != nil:
+				linkName := "eth0"
+				if network.Multus != nil {
+					linkName = fmt.Sprintf("net%d", networkIndex)
+				}
+				links[iface.Name] = linkstate.Link{Name: linkName}
+			}
+		}
+	}
+	return links
+}
+
+// startLinkStateService serves the states of the links of interfaces to
+// virt-daemon from a process of its own which outlives the prerunner, and
+// keeps the links of interfaces that are down from being set up when the
+// hypervisor opens their taps.
+func startLinkStateService(vmData string, links map[string]linkstate.Link) error {
+	linksJSON, err := json.Marshal(links)
+	if err != nil {
+		return fmt.Errorf("marshal interface links: %s", err)
+	}
+
+	// stdout is left out, as it is read by the entrypoint until it's closed
+	cmd := exec.Command(os.Args[0], "--serve-link-states", "--vm-data", vmData, "--interface-links", base64.StdEncoding.EncodeToString(linksJSON))
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("start link state service: %s", err)
+	}
+	return nil
+}
+
+// serveLinkStates serves the states of the links of interfaces until the
+// context is done. Interfaces start in the states of their spec.
+func serveLinkStates(ctx context.Context, vm *virtv1alpha1.VirtualMachine, linksData string) error {
+	log := logr.FromContextOrDiscard(ctx)
+
+	linksJSON, err := base64.StdEncoding.DecodeString(linksData)
+	if err != nil {
+		return fmt.Errorf("decode interface links: %s", err)
+	}
+	var links map[string]linkstate.Link
+	if err := json.Unmarshal(linksJSON, &links); err != nil {
+		return fmt.Errorf("unmarshal interface links: %s", err)
+	}
+
+	states := map[string]bool{}
+	for _, iface := range vm.Spec.Instance.Interfaces {
+		states[iface.Name] = iface.State != virtv1alpha1.InterfaceStateDown
+	}
+	server := linkstate.NewServer(netlinkLinks{}, links, states)
+
+	// links are enforced on every change, as the hypervisor turns on the
+	// carrier of a tap whenever it opens it
+	linkUpdates := make(chan netlink.LinkUpdate)
+	if err := netlink.LinkSubscribe(linkUpdates, ctx.Done()); err != nil {
+		return fmt.Errorf("subscribe to link updates: %s", err)
+	}
+	if err := server.Enforce(); err != nil {
+		return fmt.Errorf("enforce link states: %s", err)
+	}
+	go func() {
+		for range linkUpdates {
+			if err := server.Enforce(); err != nil {
+				log.Error(err, "enforce link states")
+			}
+		}
+	}()
+
+	socketPath := filepath.Join("/var/run/virtink", linkstate.SocketName)
+	if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove socket: %s", err)
+	}
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return fmt.Errorf("listen: %s", err)
+	}
+	httpServer := &http.Server{Handler: server}
+	go func() {
+		<-ctx.Done()
+		httpServer.Close()
+	}()
+	if err := httpServer.Serve(listener); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// netlinkLinks sets the states of links in the network namespace of the VM
+// pod.
+type netlinkLinks struct{}
+
+func (netlinkLinks) IsUp(link linkstate.Link) (bool, error) {
+	l, err := netlink.LinkByName(link.Name)
+	if err != nil {
+		return false, fmt.Errorf("get link: %s", err)
+	}
+	if link.Carrier {
+		return l.Attrs().RawFlags&syscall.IFF_RUNNING != 0, nil
+	}
+	return l.Attrs().Flags&net.FlagUp != 0, nil
+}
+
+func (netlinkLinks) SetUp(link linkstate.Link, up bool) error {
+	if link.Carrier {
+		carrier := "off"
+		if up {
+			carrier = "on"
+		}
+		_, err := executeCommand("ip", "link", "set", "dev", link.Name, "carrier", carrier)
+		return err
+	}
+
+	l, err := netlink.LinkByName(link.Name)
+	if err != nil {
+		return fmt.Errorf("get link: %s", err)
+	}
+	if up {
+		return netlink.LinkSetUp(l)
+	}
+	return netlink.LinkSetDown(l)
+}
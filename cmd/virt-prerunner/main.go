@@ -7,128 +7,332 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
-	"log"
+	"io"
 	"net"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
 	"runtime"
+	"sort"
+	"strconv"
 	"strings"
+	"syscall"
 	"text/template"
+	"time"
 
 	"github.com/docker/libnetwork/resolvconf"
 	"github.com/docker/libnetwork/types"
+	"github.com/go-logr/logr"
 	netv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	"github.com/subgraph/libmacouflage"
 	"github.com/vishvananda/netlink"
+	"go.uber.org/zap/zapcore"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
 	"github.com/smartxworks/virtink/pkg/cloudhypervisor"
 	"github.com/smartxworks/virtink/pkg/cpuset"
+	"github.com/smartxworks/virtink/pkg/daemon/deviceplugin"
+	"github.com/smartxworks/virtink/pkg/disklease"
+	"github.com/smartxworks/virtink/pkg/hooks"
+	"github.com/smartxworks/virtink/pkg/logging"
+	"github.com/smartxworks/virtink/pkg/vmm"
 )
 
+// hibernationDirPath is where the hibernation PVC is mounted. Cloud Hypervisor
+// does not overwrite snapshots, so each VM pod snapshots to a directory named
+// after the pod.
+const hibernationDirPath = "/mnt/virtink-hibernation"
+
 func main() {
 	var vmData string
 	var receiveMigration bool
+	var restoreSnapshot string
+	var warmInterval time.Duration
+	var serveMetadataService bool
+	var serveLinkStateService bool
+	var interfaceLinksData string
+	var waitDiskLeases bool
+	var securityGroupsData string
+	var serveSecurityGroupService bool
+	var securityGroupTapsData string
 	extraVFIOMemoryLockSize := resource.QuantityValue{Quantity: resource.MustParse("1Gi")}
 	flag.StringVar(&vmData, "vm-data", vmData, "Base64 encoded VM json data")
 	flag.BoolVar(&receiveMigration, "receive-migration", receiveMigration, "Receive migration instead of starting a new VM")
+	flag.StringVar(&restoreSnapshot, "restore-snapshot", restoreSnapshot, "Restore the VM from the named snapshot on the hibernation PVC instead of starting a new VM")
 	flag.Var(&extraVFIOMemoryLockSize, "extra-vfio-memory-lock-size", "The extra memory lock size for VFIO devices")
+	filesystemOverheads := map[string]float64{}
+	flag.Func("filesystem-overhead", "The filesystem overhead of a Filesystem mode PVC volume as <volume>=<overhead>, which can be repeated", func(value string) error {
+		name, overhead, ok := strings.Cut(value, "=")
+		if !ok {
+			return fmt.Errorf("invalid filesystem overhead %q", value)
+		}
+		overheadValue, err := strconv.ParseFloat(overhead, 64)
+		if err != nil {
+			return err
+		}
+		filesystemOverheads[name] = overheadValue
+		return nil
+	})
+	flag.DurationVar(&warmInterval, "warm-interval", 0, "Keep the binaries and firmware VM pods start with in the page cache by reading them at this interval, instead of preparing a VM")
+	flag.BoolVar(&serveMetadataService, "serve-metadata", false, "Serve the metadata service of the VM, instead of preparing it")
+	flag.BoolVar(&serveLinkStateService, "serve-link-states", false, "Serve the states of the links of interfaces of the VM, instead of preparing it")
+	flag.StringVar(&interfaceLinksData, "interface-links", "", "Base64 encoded json data of the links of interfaces of the VM, of which the states are served")
+	flag.BoolVar(&waitDiskLeases, "wait-disk-leases", false, "Wait for virt-daemon to acquire the leases of the PVCs of the VM before preparing it, unless receiving migration")
+	flag.StringVar(&securityGroupsData, "security-groups", "", "Base64 encoded json data of the security group rules of each interface of the VM")
+	flag.BoolVar(&serveSecurityGroupService, "serve-security-groups", false, "Serve the security group rules of interfaces of the VM, instead of preparing it")
+	flag.StringVar(&securityGroupTapsData, "security-group-taps", "", "Base64 encoded json data of the taps of interfaces of the VM, of which the security group rules are served")
+	opts := zap.Options{
+		Development: true,
+		TimeEncoder: zapcore.ISO8601TimeEncoder,
+	}
+	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
+	// Logs go to stderr, as stdout is reserved for the VMM command.
+	log, _ := logging.NewLogger(&opts)
+	start := time.Now()
+
+	if warmInterval > 0 {
+		ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		defer cancel()
+		warm(logr.NewContext(ctx, log), warmInterval)
+		return
+	}
+
 	vmJSON, err := base64.StdEncoding.DecodeString(vmData)
 	if err != nil {
-		log.Fatalf("Failed to decode VM data: %s", err)
+		log.Error(err, "decode VM data")
+		os.Exit(1)
 	}
 
 	var vm virtv1alpha1.VirtualMachine
 	if err := json.Unmarshal(vmJSON, &vm); err != nil {
-		log.Fatalf("Failed to unmarshal VM: %s", err)
+		log.Error(err, "unmarshal VM")
+		os.Exit(1)
 	}
+	log = log.WithValues(logging.VMKeysAndValues(&vm)...)
 
-	vmConfig, err := buildVMConfig(context.Background(), &vm)
+	if serveMetadataService {
+		ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		defer cancel()
+		if err := serveMetadata(logr.NewContext(ctx, log), &vm); err != nil {
+			log.Error(err, "serve metadata")
+			os.Exit(1)
+		}
+		return
+	}
+
+	if serveLinkStateService {
+		ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		defer cancel()
+		if err := serveLinkStates(logr.NewContext(ctx, log), &vm, interfaceLinksData); err != nil {
+			log.Error(err, "serve link states")
+			os.Exit(1)
+		}
+		return
+	}
+
+	securityGroups := map[string]virtv1beta1.VirtualMachineSecurityGroupSpec{}
+	if securityGroupsData != "" {
+		securityGroupsJSON, err := base64.StdEncoding.DecodeString(securityGroupsData)
+		if err != nil {
+			log.Error(err, "decode security groups data")
+			os.Exit(1)
+		}
+		if err := json.Unmarshal(securityGroupsJSON, &securityGroups); err != nil {
+			log.Error(err, "unmarshal security groups")
+			os.Exit(1)
+		}
+	}
+
+	if serveSecurityGroupService {
+		ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		defer cancel()
+		if err := serveSecurityGroups(logr.NewContext(ctx, log), securityGroupTapsData, securityGroups); err != nil {
+			log.Error(err, "serve security groups")
+			os.Exit(1)
+		}
+		return
+	}
+
+	if waitDiskLeases && !receiveMigration {
+		// disk images may be grown while the VM config is built, so no
+		// other VM pod may be writing to them by then
+		ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		err := waitForDiskLeases(ctx)
+		cancel()
+		if err != nil {
+			log.Error(err, "wait for disk leases")
+			os.Exit(1)
+		}
+		log.Info("acquired disk leases", "duration", time.Since(start))
+	}
+
+	vmConfig, err := buildVMConfig(logr.NewContext(context.Background(), log), &vm, filesystemOverheads, securityGroups)
 	if err != nil {
-		log.Fatalf("Failed to build VM config: %s", err)
+		log.Error(err, "build VM config")
+		os.Exit(1)
+	}
+	log.Info("built VM config", "duration", time.Since(start))
+
+	if vm.Spec.MetadataService != nil {
+		if err := startMetadataService(vmData); err != nil {
+			log.Error(err, "start metadata service")
+			os.Exit(1)
+		}
+	}
+
+	if interfaceLinks := getInterfaceLinks(&vm, vmConfig); len(interfaceLinks) > 0 {
+		if err := startLinkStateService(vmData, interfaceLinks); err != nil {
+			log.Error(err, "start link state service")
+			os.Exit(1)
+		}
+	}
+
+	if securityGroupTaps := getSecurityGroupTaps(vmConfig, securityGroups); len(securityGroupTaps) > 0 {
+		if err := startSecurityGroupService(vmData, securityGroupTaps, securityGroupsData); err != nil {
+			log.Error(err, "start security group service")
+			os.Exit(1)
+		}
 	}
+
+	if vm.Spec.Hibernation != nil {
+		// the source VM pod of a migration may still hibernate if the
+		// migration fails, so its snapshot directory is kept
+		if err := prepareHibernationDir(os.Getenv("POD_UID"), restoreSnapshot, !receiveMigration); err != nil {
+			log.Error(err, "prepare hibernation PVC")
+			os.Exit(1)
+		}
+	}
+
 	if receiveMigration {
 		cloudHypervisorCmd := []string{"cloud-hypervisor", "--api-socket", "/var/run/virtink/ch.sock"}
 		fmt.Println(strings.Join(cloudHypervisorCmd, " "))
 		return
 	}
 
-	cloudHypervisorCmd := []string{"cloud-hypervisor", "--api-socket", "/var/run/virtink/ch.sock", "--console", "pty", "--serial", "tty"}
-	cloudHypervisorCmd = append(cloudHypervisorCmd, "--kernel", vmConfig.Payload.Kernel)
-	if vmConfig.Payload.Cmdline != "" {
-		cloudHypervisorCmd = append(cloudHypervisorCmd, "--cmdline", fmt.Sprintf("'%s'", vmConfig.Payload.Cmdline))
+	if restoreSnapshot != "" {
+		cloudHypervisorCmd := []string{"cloud-hypervisor", "--api-socket", "/var/run/virtink/ch.sock", "--restore", "source_url=file://" + filepath.Join(hibernationDirPath, restoreSnapshot)}
+		fmt.Println(strings.Join(cloudHypervisorCmd, " "))
+		return
 	}
 
-	vcpuToPCPU := []string{}
-	for _, affinity := range vmConfig.Cpus.Affinity {
-		vcpuToPCPU = append(vcpuToPCPU, fmt.Sprintf("%d@[%d]", affinity.Vcpu, affinity.HostCpus[0]))
+	hookSidecars, err := hooks.GetHookSidecars(vm.Annotations)
+	if err != nil {
+		log.Error(err, "get hook sidecars")
+		os.Exit(1)
 	}
-	cpuAffinity := ""
-	if len(vcpuToPCPU) > 0 {
-		cpuAffinity = fmt.Sprintf("[%s]", strings.Join(vcpuToPCPU, ","))
+	if len(hookSidecars) > 0 {
+		vmConfig, err = callHooks(logr.NewContext(context.Background(), log), &vm, vmConfig, len(hookSidecars))
+		if err != nil {
+			log.Error(err, "call hooks")
+			os.Exit(1)
+		}
 	}
-	cloudHypervisorCmd = append(cloudHypervisorCmd, "--cpus", fmt.Sprintf("boot=%d,topology=%d:%d:%d:%d,affinity=%s",
-		vmConfig.Cpus.BootVcpus, vmConfig.Cpus.Topology.ThreadsPerCore, vmConfig.Cpus.Topology.CoresPerDie,
-		vmConfig.Cpus.Topology.DiesPerPackage, vmConfig.Cpus.Topology.Packages, cpuAffinity))
 
-	memoryArg := fmt.Sprintf("size=%d", vmConfig.Memory.Size)
-	if vmConfig.Memory.Shared {
-		memoryArg = memoryArg + ",shared=on"
-	}
-	if vmConfig.Memory.Hugepages {
-		memoryArg = memoryArg + ",hugepages=true"
+	var vmmCmd []string
+	driver := vmm.GetDriver(&vm)
+	if qemu, ok := driver.(vmm.QEMU); ok {
+		qemu.HostUSBDevices, err = getHostUSBDevices(&vm)
+		if err != nil {
+			log.Error(err, "get host USB devices")
+			os.Exit(1)
+		}
+		driver = qemu
+	}
+	if _, ok := driver.(vmm.CloudHypervisor); ok && len(hookSidecars) > 0 {
+		// the VM is created by virt-daemon with the config mutated by hooks,
+		// as the config may have devices not expressible by the arguments
+		if err := saveHookedVMConfig(vmConfig); err != nil {
+			log.Error(err, "save hooked VM config")
+			os.Exit(1)
+		}
+		vmmCmd = []string{"cloud-hypervisor", "--api-socket", "/var/run/virtink/ch.sock"}
+	} else {
+		vmmCmd, err = driver.Command("/var/run/virtink", &vm, vmConfig)
+		if err != nil {
+			log.Error(err, "build VMM command")
+			os.Exit(1)
+		}
 	}
-	cloudHypervisorCmd = append(cloudHypervisorCmd, "--memory", memoryArg)
 
-	if len(vmConfig.Disks) > 0 {
-		cloudHypervisorCmd = append(cloudHypervisorCmd, "--disk")
-		for _, disk := range vmConfig.Disks {
-			arg := fmt.Sprintf("id=%s,path=%s", disk.Id, disk.Path)
-			if disk.Readonly {
-				arg = arg + ",readonly=on"
-			}
-			if disk.Direct {
-				arg = arg + ",direct=on"
-			}
-			cloudHypervisorCmd = append(cloudHypervisorCmd, arg)
+	if vm.Spec.Instance.Realtime != nil {
+		priority := strconv.Itoa(int(vm.Spec.Instance.Realtime.Priority))
+		if _, err := executeCommand("chrt", "--fifo", priority, "true"); err != nil {
+			log.Info("SCHED_FIFO is not permitted, VMM threads will use the default scheduling policy", "error", err.Error())
+		} else {
+			vmmCmd = append([]string{"chrt", "--fifo", priority}, vmmCmd...)
 		}
 	}
 
-	if len(vmConfig.Fs) > 0 {
-		cloudHypervisorCmd = append(cloudHypervisorCmd, "--fs")
-		for _, fs := range vmConfig.Fs {
-			arg := fmt.Sprintf("id=%s,socket=%s,tag=%s", fs.Id, fs.Socket, fs.Tag)
-			cloudHypervisorCmd = append(cloudHypervisorCmd, arg)
-		}
+	switch {
+	case len(vmConfig.Devices) > 0:
+		vmmCmd = append([]string{"prlimit", fmt.Sprintf("--memlock=%v", vmConfig.Memory.Size+extraVFIOMemoryLockSize.Value())}, vmmCmd...)
+	case vm.Spec.Instance.Realtime != nil:
+		vmmCmd = append([]string{"prlimit", "--memlock=unlimited"}, vmmCmd...)
 	}
 
-	if len(vmConfig.Net) > 0 {
-		cloudHypervisorCmd = append(cloudHypervisorCmd, "--net")
-		for _, net := range vmConfig.Net {
-			if net.VhostUser {
-				cloudHypervisorCmd = append(cloudHypervisorCmd, fmt.Sprintf("id=%s,mac=%s,mtu=%d,vhost_user=true,vhost_mode=server,socket=%s", net.Id, net.Mac, net.Mtu, net.VhostSocket))
-			} else {
-				cloudHypervisorCmd = append(cloudHypervisorCmd, fmt.Sprintf("id=%s,mac=%s,tap=%s,mtu=%d", net.Id, net.Mac, net.Tap, net.Mtu))
+	log.V(1).Info("built VMM command", "command", vmmCmd)
+	fmt.Println(strings.Join(vmmCmd, " "))
+}
+
+// getHostUSBDevices returns the addresses of the host USB devices of the VM,
+// which are assigned the devices each device plugin allocates to the VM pod in
+// order.
+func getHostUSBDevices(vm *virtv1alpha1.VirtualMachine) (map[string]vmm.USBDeviceAddress, error) {
+	if vm.Spec.Instance.Devices == nil {
+		return nil, nil
+	}
+
+	allocatedDevices := map[string][]string{}
+	hostUSBDevices := map[string]vmm.USBDeviceAddress{}
+	for _, hostUSBDevice := range vm.Spec.Instance.Devices.HostUSBDevices {
+		devices, ok := allocatedDevices[hostUSBDevice.ResourceName]
+		if !ok {
+			if env := os.Getenv(deviceplugin.USBDeviceEnvName(hostUSBDevice.ResourceName)); env != "" {
+				devices = strings.Split(env, ",")
 			}
 		}
-	}
+		if len(devices) == 0 {
+			return nil, fmt.Errorf("no %s device is allocated for host USB device %q", hostUSBDevice.ResourceName, hostUSBDevice.Name)
+		}
 
-	if len(vmConfig.Devices) > 0 {
-		cloudHypervisorCmd = append([]string{"prlimit", fmt.Sprintf("--memlock=%v", vmConfig.Memory.Size+extraVFIOMemoryLockSize.Value())}, cloudHypervisorCmd...)
-		cloudHypervisorCmd = append(cloudHypervisorCmd, "--device")
-		for _, device := range vmConfig.Devices {
-			cloudHypervisorCmd = append(cloudHypervisorCmd, fmt.Sprintf("id=%s,path=%s", device.Id, device.Path))
+		var addr vmm.USBDeviceAddress
+		if _, err := fmt.Sscanf(devices[0], "%d:%d", &addr.Bus, &addr.Addr); err != nil {
+			return nil, fmt.Errorf("invalid %s device %q: %s", hostUSBDevice.ResourceName, devices[0], err)
 		}
+		hostUSBDevices[hostUSBDevice.Name] = addr
+		allocatedDevices[hostUSBDevice.ResourceName] = devices[1:]
 	}
+	return hostUSBDevices, nil
+}
+
+// waitForDiskLeases waits for virt-daemon to acquire the leases of the PVCs
+// of the VM, which takes until the leases held by any other VM pod expire.
+func waitForDiskLeases(ctx context.Context) error {
+	acquiredFilePath := filepath.Join("/var/run/virtink", disklease.AcquiredFileName)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		if _, err := os.Stat(acquiredFilePath); err == nil {
+			return nil
+		} else if !os.IsNotExist(err) {
+			return err
+		}
 
-	fmt.Println(strings.Join(cloudHypervisorCmd, " "))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
 }
 
-func buildVMConfig(ctx context.Context, vm *virtv1alpha1.VirtualMachine) (*cloudhypervisor.VmConfig, error) {
+func buildVMConfig(ctx context.Context, vm *virtv1alpha1.VirtualMachine, filesystemOverheads map[string]float64, securityGroups map[string]virtv1beta1.VirtualMachineSecurityGroupSpec) (*cloudhypervisor.VmConfig, error) {
 	vmConfig := cloudhypervisor.VmConfig{
 		Payload: &cloudhypervisor.PayloadConfig{
 			Kernel: "/var/lib/cloud-hypervisor/hypervisor-fw",
@@ -147,7 +351,7 @@ func buildVMConfig(ctx context.Context, vm *virtv1alpha1.VirtualMachine) (*cloud
 		},
 	}
 
-	if runtime.GOARCH == "arm64" {
+	if runtime.GOARCH == "arm64" || (vm.Spec.Instance.Firmware != nil && vm.Spec.Instance.Firmware.EFI != nil) {
 		vmConfig.Payload.Kernel = "/var/lib/cloud-hypervisor/CLOUDHV_EFI.fd"
 	}
 
@@ -179,45 +383,134 @@ func buildVMConfig(ctx context.Context, vm *virtv1alpha1.VirtualMachine) (*cloud
 
 	if vm.Spec.Instance.Memory.Hugepages != nil {
 		vmConfig.Memory.Hugepages = true
+	} else if vm.Spec.Instance.Realtime == nil {
+		// KSM only merges memory marked mergeable, and only runs if enabled
+		vmConfig.Memory.Mergeable = true
+	}
+
+	if vm.Spec.Instance.Realtime != nil {
+		vmConfig.Memory.Prefault = true
+	}
+
+	if vm.Spec.Instance.Watchdog != nil {
+		vmConfig.Watchdog = true
+	}
+
+	if rng := vm.Spec.Instance.RNG; rng == nil || !rng.Disabled {
+		vmConfig.Rng = &cloudhypervisor.RngConfig{
+			Src: "/dev/urandom",
+		}
+		if rng != nil && rng.Source != "" {
+			vmConfig.Rng.Src = rng.Source
+		}
+	}
+
+	if vm.Spec.Instance.Vsock != nil {
+		vmConfig.Vsock = &cloudhypervisor.VsockConfig{
+			Cid:    int64(vm.Spec.Instance.Vsock.CID),
+			Socket: "/var/run/virtink/vsock.sock",
+		}
+	}
+
+	if vm.Spec.Instance.Memory.Balloon != nil {
+		// the guest gives memory back from the balloon before it runs out
+		vmConfig.Balloon = &cloudhypervisor.BalloonConfig{
+			DeflateOnOom:      true,
+			FreePageReporting: !vm.Spec.Instance.Memory.Balloon.DisableFreePageReporting,
+		}
+	}
+
+	if vm.Spec.Instance.Downward != nil && vm.Spec.Instance.Downward.SMBIOS {
+		vmConfig.Platform = &cloudhypervisor.PlatformConfig{
+			OemStrings: []string{
+				"virtink.io/namespace=" + vm.Namespace,
+				"virtink.io/name=" + vm.Name,
+				"virtink.io/uid=" + string(vm.UID),
+			},
+		}
 	}
 
-	for _, disk := range vm.Spec.Instance.Disks {
+	for _, volume := range vm.Spec.Volumes {
+		if volume.EmptyDisk != nil {
+			if err := createEmptyDiskImage(fmt.Sprintf("/mnt/%s/disk.img", volume.Name), volume.EmptyDisk.Capacity.Value()); err != nil {
+				return nil, fmt.Errorf("create image of empty disk %q: %s", volume.Name, err)
+			}
+		}
+	}
+
+	// The firmware tries devices in the order they are attached
+	disks := append([]virtv1alpha1.Disk{}, vm.Spec.Instance.Disks...)
+	sort.SliceStable(disks, func(i, j int) bool {
+		return bootOrderLess(disks[i].BootOrder, disks[j].BootOrder)
+	})
+
+	hasCDROMDisks := false
+	for _, disk := range disks {
+		if disk.Type == virtv1alpha1.DiskTypePmem {
+			pmemConfig, err := buildPmemConfig(vm, &disk, filesystemOverheads)
+			if err != nil {
+				return nil, err
+			}
+			vmConfig.Pmem = append(vmConfig.Pmem, pmemConfig)
+			continue
+		}
+
+		if disk.Type == virtv1alpha1.DiskTypeCDROM {
+			hasCDROMDisks = true
+			diskConfig, err := buildCDROMDiskConfig(vm, &disk)
+			if err != nil {
+				return nil, err
+			}
+			vmConfig.Disks = append(vmConfig.Disks, diskConfig)
+			continue
+		}
+
 		for _, volume := range vm.Spec.Volumes {
 			if volume.Name == disk.Name {
+				// disks of VMs created before the cache option bypass the page cache
 				diskConfig := cloudhypervisor.DiskConfig{
-					Id:     disk.Name,
-					Direct: true,
+					Id:        disk.Name,
+					Direct:    disk.Cache == "" || disk.Cache == virtv1alpha1.DiskCacheNone,
+					NumQueues: int(disk.Queues),
+				}
+				path, err := getVolumeDiskPath(&volume)
+				if err != nil {
+					return nil, err
 				}
-				switch {
-				case volume.ContainerDisk != nil:
-					diskConfig.Path = fmt.Sprintf("/mnt/%s/disk.raw", volume.Name)
-				case volume.CloudInit != nil:
-					diskConfig.Path = fmt.Sprintf("/mnt/%s/cloud-init.iso", volume.Name)
-				case volume.ContainerRootfs != nil:
-					diskConfig.Path = fmt.Sprintf("/mnt/%s/rootfs.raw", volume.Name)
-				case volume.PersistentVolumeClaim != nil, volume.DataVolume != nil:
-					diskConfig.Path = fmt.Sprintf("/mnt/%s", volume.Name)
-					fileInfo, err := os.Stat(diskConfig.Path)
+				diskConfig.Path = path
+				if disk.Ephemeral {
+					overlayPath, err := createEphemeralOverlay(disk.Name, path)
 					if err != nil {
-						return nil, err
+						return nil, fmt.Errorf("create overlay of ephemeral disk %q: %s", disk.Name, err)
 					}
-					if fileInfo.IsDir() {
-						diskConfig.Path = filepath.Join(diskConfig.Path, "disk.img")
+					diskConfig.Path = overlayPath
+				} else if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.Populate != nil && volume.PersistentVolumeClaim.Populate.Grow &&
+					path == filepath.Join("/mnt", volume.Name, "disk.img") {
+					if err := growDiskImage(path, filesystemOverheads[volume.Name]); err != nil {
+						return nil, fmt.Errorf("grow disk image of volume %q: %s", volume.Name, err)
 					}
-				default:
-					return nil, fmt.Errorf("invalid source of volume %q", volume.Name)
 				}
 
 				if disk.ReadOnly != nil && *disk.ReadOnly {
 					diskConfig.Readonly = true
 				}
 
+				if disk.RateLimit != nil {
+					diskConfig.RateLimiterConfig = cloudhypervisor.NewDiskRateLimiterConfig(disk.RateLimit)
+				}
+
 				vmConfig.Disks = append(vmConfig.Disks, &diskConfig)
 				break
 			}
 		}
 	}
 
+	if hasCDROMDisks {
+		if err := saveMediumPaths(vm); err != nil {
+			return nil, fmt.Errorf("save medium paths: %s", err)
+		}
+	}
+
 	for _, fs := range vm.Spec.Instance.FileSystems {
 		vmConfig.Memory.Shared = true
 
@@ -250,7 +543,23 @@ func buildVMConfig(ctx context.Context, vm *virtv1alpha1.VirtualMachine) (*cloud
 		}
 	}
 
-	for _, iface := range vm.Spec.Instance.Interfaces {
+	// the hostname is only offered by DHCP if set explicitly, otherwise the
+	// guest keeps its own
+	var guestHostname string
+	if vm.Spec.Hostname != "" {
+		guestHostname = vm.Spec.Hostname
+	} else if vm.Spec.Subdomain != "" {
+		guestHostname = vm.Name
+	}
+
+	// the MACs of VFs are reported by the SR-IOV CNI plugin
+	sriovMACs := map[string]string{}
+	ifaces := append([]virtv1alpha1.Interface{}, vm.Spec.Instance.Interfaces...)
+	sort.SliceStable(ifaces, func(i, j int) bool {
+		return bootOrderLess(ifaces[i].BootOrder, ifaces[j].BootOrder)
+	})
+
+	for _, iface := range ifaces {
 		for networkIndex, network := range vm.Spec.Networks {
 			if network.Name != iface.Name {
 				continue
@@ -270,19 +579,50 @@ func buildVMConfig(ctx context.Context, vm *virtv1alpha1.VirtualMachine) (*cloud
 			case iface.Bridge != nil:
 				netConfig := cloudhypervisor.NetConfig{
 					Id: iface.Name,
+					// Each queue pair consists of a RX queue and a TX queue
+					NumQueues: 2 * int(iface.Queues),
+					QueueSize: int(iface.RXQueueSize),
 				}
-				if err := setupBridgeNetwork(linkName, fmt.Sprintf("169.254.%d.1/30", 200+networkIndex), &netConfig); err != nil {
+				if err := setupBridgeNetwork(linkName, fmt.Sprintf("169.254.%d.1/30", 200+networkIndex), &iface, guestHostname, &netConfig); err != nil {
 					return nil, fmt.Errorf("setup bridge network: %s", err)
 				}
+				if err := setupTrafficShaping(netConfig.Tap, iface.RateLimit); err != nil {
+					return nil, fmt.Errorf("setup traffic shaping: %s", err)
+				}
+				if securityGroup, ok := securityGroups[iface.Name]; ok {
+					if err := setupSecurityGroups(netConfig.Tap, &securityGroup); err != nil {
+						return nil, fmt.Errorf("setup security groups: %s", err)
+					}
+				}
 				vmConfig.Net = append(vmConfig.Net, &netConfig)
 			case iface.Masquerade != nil:
 				netConfig := cloudhypervisor.NetConfig{
-					Id:  iface.Name,
-					Mac: iface.MAC,
+					Id:        iface.Name,
+					Mac:       iface.MAC,
+					NumQueues: 2 * int(iface.Queues),
+					QueueSize: int(iface.RXQueueSize),
 				}
-				if err := setupMasqueradeNetwork(linkName, iface.Masquerade.CIDR, &netConfig); err != nil {
+				if err := setupMasqueradeNetwork(linkName, iface.Masquerade.CIDR, &iface, vm.Annotations[istioInjectAnnotation] == "true", guestHostname, &netConfig); err != nil {
 					return nil, fmt.Errorf("setup masquerade network: %s", err)
 				}
+				if err := setupTrafficShaping(netConfig.Tap, iface.RateLimit); err != nil {
+					return nil, fmt.Errorf("setup traffic shaping: %s", err)
+				}
+				if securityGroup, ok := securityGroups[iface.Name]; ok {
+					if err := setupSecurityGroups(netConfig.Tap, &securityGroup); err != nil {
+						return nil, fmt.Errorf("setup security groups: %s", err)
+					}
+				}
+				vmConfig.Net = append(vmConfig.Net, &netConfig)
+			case iface.OVS != nil:
+				netConfig := cloudhypervisor.NetConfig{
+					Id:        iface.Name,
+					NumQueues: 2 * int(iface.Queues),
+					QueueSize: int(iface.RXQueueSize),
+				}
+				if err := setupOVSNetwork(linkName, &iface, &netConfig); err != nil {
+					return nil, fmt.Errorf("setup OVS network: %s", err)
+				}
 				vmConfig.Net = append(vmConfig.Net, &netConfig)
 			case iface.SRIOV != nil:
 				for _, networkStatus := range networkStatusList {
@@ -292,6 +632,7 @@ func buildVMConfig(ctx context.Context, vm *virtv1alpha1.VirtualMachine) (*cloud
 							Path: fmt.Sprintf("/sys/bus/pci/devices/%s", networkStatus.DeviceInfo.Pci.PciAddress),
 						}
 						vmConfig.Devices = append(vmConfig.Devices, &sriovDeviceConfig)
+						sriovMACs[iface.Name] = networkStatus.Mac
 					}
 				}
 			case iface.VhostUser != nil:
@@ -303,13 +644,18 @@ func buildVMConfig(ctx context.Context, vm *virtv1alpha1.VirtualMachine) (*cloud
 				if err != nil {
 					return nil, fmt.Errorf("get link: %s", err)
 				}
+				mtu, err := getInterfaceMTU(&iface, link)
+				if err != nil {
+					return nil, err
+				}
 				netConfig := cloudhypervisor.NetConfig{
 					Id:          iface.Name,
 					Mac:         iface.MAC,
-					Mtu:         link.Attrs().MTU,
+					Mtu:         mtu,
 					VhostUser:   true,
 					VhostMode:   "server",
 					VhostSocket: socket,
+					QueueSize:   int(iface.RXQueueSize),
 				}
 				vmConfig.Net = append(vmConfig.Net, &netConfig)
 				vmConfig.Memory.Shared = true
@@ -317,10 +663,205 @@ func buildVMConfig(ctx context.Context, vm *virtv1alpha1.VirtualMachine) (*cloud
 		}
 	}
 
+	for _, volume := range vm.Spec.Volumes {
+		if volume.CloudInit != nil && volume.CloudInit.GenerateNetworkData {
+			if err := generateCloudInitNetworkData(volume.Name, vm, &vmConfig, sriovMACs); err != nil {
+				return nil, fmt.Errorf("generate cloud-init network data: %s", err)
+			}
+		}
+	}
+
 	return &vmConfig, nil
 }
 
-func setupBridgeNetwork(linkName string, cidr string, netConfig *cloudhypervisor.NetConfig) error {
+// bootOrderLess reports whether a device of boot order a is tried before one of
+// boot order b. Devices without a boot order are tried last.
+func bootOrderLess(a uint32, b uint32) bool {
+	if a == 0 {
+		return false
+	}
+	return b == 0 || a < b
+}
+
+// growDiskImage grows the raw disk image to the capacity of its file system
+// less the filesystem overhead, aligned down to 1MiB. The image is never
+// shrunk.
+// getVolumeDiskPath returns the path of the disk image or block device of the
+// volume in the VM pod.
+func getVolumeDiskPath(volume *virtv1alpha1.Volume) (string, error) {
+	switch {
+	case volume.ContainerDisk != nil, volume.HTTPDisk != nil:
+		return fmt.Sprintf("/mnt/%s/disk.raw", volume.Name), nil
+	case volume.CloudInit != nil, volume.ClusterAPIBootstrap != nil:
+		return fmt.Sprintf("/mnt/%s/cloud-init.iso", volume.Name), nil
+	case volume.ContainerRootfs != nil:
+		return fmt.Sprintf("/mnt/%s/rootfs.raw", volume.Name), nil
+	case volume.EmptyDisk != nil:
+		return fmt.Sprintf("/mnt/%s/disk.img", volume.Name), nil
+	case volume.Sysprep != nil:
+		return fmt.Sprintf("/mnt/%s/sysprep.iso", volume.Name), nil
+	case volume.ConfigMap != nil, volume.Secret != nil, volume.ServiceAccountToken != nil:
+		return fmt.Sprintf("/mnt/%s/config.img", volume.Name), nil
+	case volume.PersistentVolumeClaim != nil, volume.DataVolume != nil:
+		path := fmt.Sprintf("/mnt/%s", volume.Name)
+		fileInfo, err := os.Stat(path)
+		if err != nil {
+			return "", err
+		}
+		if fileInfo.IsDir() {
+			path = filepath.Join(path, "disk.img")
+		}
+		return path, nil
+	default:
+		return "", fmt.Errorf("invalid source of volume %q", volume.Name)
+	}
+}
+
+// buildCDROMDiskConfig builds the config of the cdrom disk with the medium
+// inserted, or with no path if the disk is ejected.
+func buildCDROMDiskConfig(vm *virtv1alpha1.VirtualMachine, disk *virtv1alpha1.Disk) (*cloudhypervisor.DiskConfig, error) {
+	diskConfig := cloudhypervisor.DiskConfig{
+		Id:       disk.Name,
+		Readonly: true,
+		Direct:   disk.Cache == "" || disk.Cache == virtv1alpha1.DiskCacheNone,
+	}
+	if disk.Ejected {
+		return &diskConfig, nil
+	}
+
+	medium := disk.Medium
+	if medium == "" {
+		medium = disk.Name
+	}
+	for _, volume := range vm.Spec.Volumes {
+		if volume.Name == medium {
+			path, err := getVolumeDiskPath(&volume)
+			if err != nil {
+				return nil, err
+			}
+			diskConfig.Path = path
+			return &diskConfig, nil
+		}
+	}
+	return nil, fmt.Errorf("medium volume %q of disk %q not found", medium, disk.Name)
+}
+
+// buildPmemConfig builds the virtio-pmem device of the pmem disk, the size of
+// which is the size of its image.
+func buildPmemConfig(vm *virtv1alpha1.VirtualMachine, disk *virtv1alpha1.Disk, filesystemOverheads map[string]float64) (*cloudhypervisor.PmemConfig, error) {
+	for _, volume := range vm.Spec.Volumes {
+		if volume.Name != disk.Name {
+			continue
+		}
+
+		path, err := getVolumeDiskPath(&volume)
+		if err != nil {
+			return nil, err
+		}
+		if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.Populate != nil && volume.PersistentVolumeClaim.Populate.Grow &&
+			path == filepath.Join("/mnt", volume.Name, "disk.img") {
+			if err := growDiskImage(path, filesystemOverheads[volume.Name]); err != nil {
+				return nil, fmt.Errorf("grow disk image of volume %q: %s", volume.Name, err)
+			}
+		}
+
+		// the size of block devices is only found by seeking to their end
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("open pmem disk image: %s", err)
+		}
+		defer file.Close()
+		size, err := file.Seek(0, io.SeekEnd)
+		if err != nil {
+			return nil, fmt.Errorf("get size of pmem disk image: %s", err)
+		}
+		if size == 0 || size%(2<<20) != 0 {
+			return nil, fmt.Errorf("size of the image of pmem disk %q must be a positive multiple of 2Mi, got %d", disk.Name, size)
+		}
+
+		return &cloudhypervisor.PmemConfig{
+			Id:   disk.Name,
+			File: path,
+			Size: size,
+			// writes to read-only pmem disks are kept in guest memory only
+			DiscardWrites: disk.ReadOnly != nil && *disk.ReadOnly,
+		}, nil
+	}
+	return nil, fmt.Errorf("volume of disk %q not found", disk.Name)
+}
+
+// saveMediumPaths saves the paths of the volumes that can be inserted into
+// cdrom disks to the VM socket dir, for virt-daemon to change media with.
+func saveMediumPaths(vm *virtv1alpha1.VirtualMachine) error {
+	mediumPaths := map[string]string{}
+	for _, volume := range vm.Spec.Volumes {
+		if volume.ContainerDisk == nil && volume.HTTPDisk == nil && volume.PersistentVolumeClaim == nil && volume.DataVolume == nil && volume.Sysprep == nil && volume.ConfigMap == nil && volume.Secret == nil {
+			continue
+		}
+		path, err := getVolumeDiskPath(&volume)
+		if err != nil {
+			return err
+		}
+		mediumPaths[volume.Name] = path
+	}
+
+	mediumPathsJSON, err := json.Marshal(mediumPaths)
+	if err != nil {
+		return fmt.Errorf("marshal medium paths: %s", err)
+	}
+	if err := os.WriteFile("/var/run/virtink/medium-paths.json", mediumPathsJSON, 0644); err != nil {
+		return fmt.Errorf("write medium paths: %s", err)
+	}
+	return nil
+}
+
+func growDiskImage(path string, filesystemOverhead float64) error {
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("stat disk image: %s", err)
+	}
+	var fsStat syscall.Statfs_t
+	if err := syscall.Statfs(filepath.Dir(path), &fsStat); err != nil {
+		return fmt.Errorf("stat file system: %s", err)
+	}
+
+	capacity := float64(fsStat.Blocks) * float64(fsStat.Bsize)
+	size := int64(capacity*(1-filesystemOverhead)) &^ (1<<20 - 1)
+	if size <= fileInfo.Size() {
+		return nil
+	}
+	return os.Truncate(path, size)
+}
+
+// createEmptyDiskImage creates the sparse raw image of the empty disk, unless
+// it exists already, e.g. when the VM container restarts.
+func createEmptyDiskImage(path string, size int64) error {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		if os.IsExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer file.Close()
+	return file.Truncate(size)
+}
+
+// createEphemeralOverlay creates the qcow2 overlay of the ephemeral disk on top
+// of the base image, which is only read by the VMM. The overlay is created in
+// the VM pod, so it's discarded when the VM stops.
+func createEphemeralOverlay(diskName string, basePath string) (string, error) {
+	if err := os.MkdirAll("/var/lib/virtink/ephemeral", 0755); err != nil {
+		return "", fmt.Errorf("create overlay dir: %s", err)
+	}
+	overlayPath := filepath.Join("/var/lib/virtink/ephemeral", diskName+".qcow2")
+	if _, err := executeCommand("qemu-img", "create", "-f", "qcow2", "-F", "raw", "-b", basePath, overlayPath); err != nil {
+		return "", err
+	}
+	return overlayPath, nil
+}
+
+func setupBridgeNetwork(linkName string, cidr string, iface *virtv1alpha1.Interface, hostname string, netConfig *cloudhypervisor.NetConfig) error {
 	_, subnet, err := net.ParseCIDR(cidr)
 	if err != nil {
 		return fmt.Errorf("parse CIDR: %s", err)
@@ -339,7 +880,11 @@ func setupBridgeNetwork(linkName string, cidr string, netConfig *cloudhypervisor
 	if err != nil {
 		return fmt.Errorf("get link: %s", err)
 	}
-	netConfig.Mtu = link.Attrs().MTU
+	mtu, err := getInterfaceMTU(iface, link)
+	if err != nil {
+		return err
+	}
+	netConfig.Mtu = mtu
 
 	bridgeName := fmt.Sprintf("br-%s", linkName)
 	bridge, err := createBridge(bridgeName, &bridgeIPNet, link.Attrs().MTU)
@@ -411,11 +956,17 @@ func setupBridgeNetwork(linkName string, cidr string, netConfig *cloudhypervisor
 	}
 
 	tapName := fmt.Sprintf("tap-%s", linkName)
-	if _, err := createTap(bridge, tapName, link.Attrs().MTU); err != nil {
+	if _, err := createTap(bridge, tapName, mtu, netConfig.NumQueues > 2); err != nil {
 		return fmt.Errorf("create tap: %s", err)
 	}
 	netConfig.Tap = tapName
 
+	if iface.VLAN != nil {
+		if err := setupBridgeVLANs(bridgeName, newLinkName, tapName, iface.VLAN); err != nil {
+			return fmt.Errorf("setup VLANs: %s", err)
+		}
+	}
+
 	if linkAddr != nil {
 		var linkGateway net.IP
 		var routes []netlink.Route
@@ -428,14 +979,14 @@ func setupBridgeNetwork(linkName string, cidr string, netConfig *cloudhypervisor
 			}
 			routes = append(routes, route)
 		}
-		if err := startDHCPServer(bridgeName, linkMAC, linkAddr, linkGateway, routes); err != nil {
+		if err := startDHCPServer(bridgeName, linkMAC, linkAddr, linkGateway, routes, netConfig.Mtu, hostname, iface.DHCPOptions); err != nil {
 			return fmt.Errorf("start DHCP server: %s", err)
 		}
 	}
 	return nil
 }
 
-func setupMasqueradeNetwork(linkName string, cidr string, netConfig *cloudhypervisor.NetConfig) error {
+func setupMasqueradeNetwork(linkName string, cidr string, iface *virtv1alpha1.Interface, istio bool, hostname string, netConfig *cloudhypervisor.NetConfig) error {
 	_, subnet, err := net.ParseCIDR(cidr)
 	if err != nil {
 		return fmt.Errorf("parse CIDR: %s", err)
@@ -454,10 +1005,14 @@ func setupMasqueradeNetwork(linkName string, cidr string, netConfig *cloudhyperv
 	if err != nil {
 		return fmt.Errorf("get link: %s", err)
 	}
-	netConfig.Mtu = link.Attrs().MTU
+	mtu, err := getInterfaceMTU(iface, link)
+	if err != nil {
+		return err
+	}
+	netConfig.Mtu = mtu
 
 	bridgeName := fmt.Sprintf("br-%s", linkName)
-	bridge, err := createBridge(bridgeName, &bridgeIPNet, link.Attrs().MTU)
+	bridge, err := createBridge(bridgeName, &bridgeIPNet, mtu)
 	if err != nil {
 		return fmt.Errorf("create bridge: %s", err)
 	}
@@ -471,15 +1026,29 @@ func setupMasqueradeNetwork(linkName string, cidr string, netConfig *cloudhyperv
 		Mask: subnet.Mask,
 	}
 
-	if _, err := executeCommand("iptables", "-t", "nat", "-A", "POSTROUTING", "-o", linkName, "-j", "MASQUERADE"); err != nil {
-		return fmt.Errorf("add masquerade rule: %s", err)
+	masqueradeConfig := masqueradeConfig{
+		LinkName:   linkName,
+		BridgeName: bridgeName,
+		BridgeIP:   bridgeIP,
+		VMIP:       vmIP,
+		Istio:      istio,
+	}
+	if istio {
+		linkAddrs, err := netlink.AddrList(link, netlink.FAMILY_V4)
+		if err != nil {
+			return fmt.Errorf("list link addrs: %s", err)
+		}
+		if len(linkAddrs) == 0 {
+			return fmt.Errorf("pod IP not found on link %q", linkName)
+		}
+		masqueradeConfig.PodIP = linkAddrs[0].IP
 	}
-	if _, err := executeCommand("iptables", "-t", "nat", "-A", "PREROUTING", "-i", linkName, "-j", "DNAT", "--to-destination", vmIP.String()); err != nil {
-		return fmt.Errorf("add prerouting rule: %s", err)
+	if err := detectNATBackend(istio).setupMasquerade(&masqueradeConfig); err != nil {
+		return fmt.Errorf("setup NAT: %s", err)
 	}
 
 	tapName := fmt.Sprintf("tap-%s", linkName)
-	if _, err := createTap(bridge, tapName, link.Attrs().MTU); err != nil {
+	if _, err := createTap(bridge, tapName, mtu, netConfig.NumQueues > 2); err != nil {
 		return fmt.Errorf("create tap: %s", err)
 	}
 	netConfig.Tap = tapName
@@ -489,12 +1058,103 @@ func setupMasqueradeNetwork(linkName string, cidr string, netConfig *cloudhyperv
 		return fmt.Errorf("parse VM MAC: %s", err)
 	}
 
-	if err := startDHCPServer(bridgeName, vmMAC, vmIPNet, bridgeIP, nil); err != nil {
+	if err := startDHCPServer(bridgeName, vmMAC, vmIPNet, bridgeIP, nil, netConfig.Mtu, hostname, iface.DHCPOptions); err != nil {
 		return fmt.Errorf("start DHCP server: %s", err)
 	}
 	return nil
 }
 
+// getInterfaceMTU returns the MTU of the guest interface, which is the MTU of
+// the pod link unless overridden. The pod link MTU accounts for the overhead
+// of overlay networks, such as VXLAN or WireGuard, so it is never exceeded.
+// setupBridgeVLANs turns on VLAN filtering of the bridge, and places the tap
+// device in the VLANs. Frames of the VLANs are tagged on the link, while
+// untagged frames of the link stay in VLAN 1, the default VLAN of the bridge,
+// in which the DHCP server offers the pod address.
+func setupBridgeVLANs(bridgeName string, linkName string, tapName string, vlan *virtv1alpha1.InterfaceVLAN) error {
+	if _, err := executeCommand("ip", "link", "set", "dev", bridgeName, "type", "bridge", "vlan_filtering", "1"); err != nil {
+		return fmt.Errorf("enable VLAN filtering: %s", err)
+	}
+
+	if vlan.ID != 0 {
+		if _, err := executeCommand("bridge", "vlan", "del", "dev", tapName, "vid", "1"); err != nil {
+			return fmt.Errorf("remove tap from default VLAN: %s", err)
+		}
+		if _, err := executeCommand("bridge", "vlan", "add", "dev", tapName, "vid", strconv.Itoa(int(vlan.ID)), "pvid", "untagged"); err != nil {
+			return fmt.Errorf("add tap to VLAN %d: %s", vlan.ID, err)
+		}
+		if _, err := executeCommand("bridge", "vlan", "add", "dev", linkName, "vid", strconv.Itoa(int(vlan.ID))); err != nil {
+			return fmt.Errorf("add link to VLAN %d: %s", vlan.ID, err)
+		}
+	}
+
+	for _, trunk := range vlan.Trunks {
+		vid := strconv.Itoa(int(trunk.ID))
+		if trunk.EndID > trunk.ID {
+			vid = fmt.Sprintf("%d-%d", trunk.ID, trunk.EndID)
+		}
+		for _, dev := range []string{tapName, linkName} {
+			if _, err := executeCommand("bridge", "vlan", "add", "dev", dev, "vid", vid); err != nil {
+				return fmt.Errorf("add %s to VLANs %s: %s", dev, vid, err)
+			}
+		}
+	}
+	return nil
+}
+
+func getInterfaceMTU(iface *virtv1alpha1.Interface, link netlink.Link) (int, error) {
+	linkMTU := link.Attrs().MTU
+	if iface.MTU == 0 {
+		return linkMTU, nil
+	}
+	if int(iface.MTU) > linkMTU {
+		return 0, fmt.Errorf("MTU %d of interface %q exceeds MTU %d of link %q", iface.MTU, iface.Name, linkMTU, link.Attrs().Name)
+	}
+	return int(iface.MTU), nil
+}
+
+// setupTrafficShaping limits the traffic on the tap device of an interface.
+// Traffic received by the guest leaves the tap device and is shaped by a TBF
+// qdisc, while traffic sent by the guest enters the tap device and is policed.
+func setupTrafficShaping(tapName string, rateLimit *virtv1alpha1.InterfaceRateLimit) error {
+	if rateLimit == nil {
+		return nil
+	}
+
+	if rateLimit.RX != nil {
+		rate, burst := bandwidthLimitArgs(rateLimit.RX)
+		if _, err := executeCommand("tc", "qdisc", "add", "dev", tapName, "root", "tbf", "rate", rate, "burst", burst, "latency", "50ms"); err != nil {
+			return fmt.Errorf("add rx qdisc: %s", err)
+		}
+	}
+
+	if rateLimit.TX != nil {
+		rate, burst := bandwidthLimitArgs(rateLimit.TX)
+		if _, err := executeCommand("tc", "qdisc", "add", "dev", tapName, "handle", "ffff:", "ingress"); err != nil {
+			return fmt.Errorf("add ingress qdisc: %s", err)
+		}
+		if _, err := executeCommand("tc", "filter", "add", "dev", tapName, "parent", "ffff:", "protocol", "all", "u32", "match", "u32", "0", "0",
+			"police", "rate", rate, "burst", burst, "drop", "flowid", ":1"); err != nil {
+			return fmt.Errorf("add tx police filter: %s", err)
+		}
+	}
+	return nil
+}
+
+func bandwidthLimitArgs(limit *virtv1alpha1.BandwidthLimit) (string, string) {
+	rate := limit.Bandwidth.Value()
+	// Default to 100ms worth of traffic, but no less than 32KiB to stay above
+	// the minimal burst required by the kernel timer resolution.
+	burst := rate / 8 / 10
+	if burst < 32*1024 {
+		burst = 32 * 1024
+	}
+	if limit.Burst != nil {
+		burst = limit.Burst.Value()
+	}
+	return fmt.Sprintf("%dbit", rate), fmt.Sprintf("%db", burst)
+}
+
 func nextIP(ip net.IP, subnet *net.IPNet) (net.IP, error) {
 	nextIP := make(net.IP, len(ip))
 	copy(nextIP, ip)
@@ -531,7 +1191,7 @@ func createBridge(bridgeName string, bridgeIPNet *net.IPNet, mtu int) (netlink.L
 	return bridge, nil
 }
 
-func createTap(bridge netlink.Link, tapName string, mtu int) (netlink.Link, error) {
+func createTap(bridge netlink.Link, tapName string, mtu int, multiQueue bool) (netlink.Link, error) {
 	tap := &netlink.Tuntap{
 		LinkAttrs: netlink.LinkAttrs{
 			Name: tapName,
@@ -540,12 +1200,23 @@ func createTap(bridge netlink.Link, tapName string, mtu int) (netlink.Link, erro
 		Mode:  netlink.TUNTAP_MODE_TAP,
 		Flags: netlink.TUNTAP_DEFAULTS,
 	}
+	if multiQueue {
+		// Cloud Hypervisor opens a multi-queue tap device once per queue pair
+		tap.Flags = netlink.TUNTAP_MULTI_QUEUE_DEFAULTS
+		tap.Queues = 1
+	}
 	if err := netlink.LinkAdd(tap); err != nil {
 		return nil, err
 	}
+	for _, fd := range tap.Fds {
+		fd.Close()
+	}
 
-	if err := netlink.LinkSetMaster(tap, bridge); err != nil {
-		return nil, fmt.Errorf("add tap to bridge: %s", err)
+	// the taps of OVS interfaces are not on a bridge
+	if bridge != nil {
+		if err := netlink.LinkSetMaster(tap, bridge); err != nil {
+			return nil, fmt.Errorf("add tap to bridge: %s", err)
+		}
 	}
 
 	if err := netlink.LinkSetUp(tap); err != nil {
@@ -562,7 +1233,7 @@ func createTap(bridge netlink.Link, tapName string, mtu int) (netlink.Link, erro
 //go:embed dnsmasq.conf
 var dnsmasqConf string
 
-func startDHCPServer(ifaceName string, mac net.HardwareAddr, ipNet *net.IPNet, gateway net.IP, routes []netlink.Route) error {
+func startDHCPServer(ifaceName string, mac net.HardwareAddr, ipNet *net.IPNet, gateway net.IP, routes []netlink.Route, mtu int, hostname string, options *virtv1alpha1.InterfaceDHCPOptions) error {
 	rc, err := resolvconf.Get()
 	if err != nil {
 		return fmt.Errorf("get resolvconf: %s", err)
@@ -598,6 +1269,31 @@ func startDHCPServer(ifaceName string, mac net.HardwareAddr, ipNet *net.IPNet, g
 		data["gateway"] = gateway.String()
 	}
 
+	if mtu > 0 {
+		data["mtu"] = strconv.Itoa(mtu)
+	}
+
+	if hostname != "" {
+		data["hostname"] = hostname
+		domain, err := getHostDomain(hostname)
+		if err != nil {
+			return fmt.Errorf("get host domain: %s", err)
+		}
+		data["domain"] = domain
+	}
+
+	if options != nil {
+		if len(options.DNSServers) > 0 {
+			data["dnsServer"] = strings.Join(options.DNSServers, ",")
+		}
+		if len(options.SearchDomains) > 0 {
+			data["domainSearch"] = strings.Join(options.SearchDomains, ",")
+		}
+		if len(options.NTPServers) > 0 {
+			data["ntpServer"] = strings.Join(options.NTPServers, ",")
+		}
+	}
+
 	if err := template.Must(template.New("dnsmasq.conf").Parse(dnsmasqConf)).Execute(dnsmasqConfFile, data); err != nil {
 		return fmt.Errorf("write dnsmasq config file: %s", err)
 	}
@@ -608,6 +1304,30 @@ func startDHCPServer(ifaceName string, mac net.HardwareAddr, ipNet *net.IPNet, g
 	return nil
 }
 
+// getHostDomain returns the domain of the FQDN of the hostname in the hosts
+// file, which kubelet adds for pods with a subdomain, or "" if there's none.
+func getHostDomain(hostname string) (string, error) {
+	hosts, err := os.ReadFile("/etc/hosts")
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(hosts), "\n") {
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		for _, name := range fields[1:] {
+			if strings.HasPrefix(name, hostname+".") {
+				return strings.TrimPrefix(name, hostname+"."), nil
+			}
+		}
+	}
+	return "", nil
+}
+
 func sortAndFormatRoutes(routes []netlink.Route) string {
 	var sortedRoutes []netlink.Route
 	var defaultRoutes []netlink.Route
@@ -644,3 +1364,29 @@ func executeCommand(name string, arg ...string) (string, error) {
 	}
 	return string(output), nil
 }
+
+// prepareHibernationDir creates the snapshot directory of the VM pod on the
+// hibernation PVC. If removeSnapshots is set, it also removes the snapshots of
+// earlier VM pods except the one being restored from.
+func prepareHibernationDir(podUID string, restoreSnapshot string, removeSnapshots bool) error {
+	if podUID == "" {
+		return fmt.Errorf("pod UID is unknown")
+	}
+	if !removeSnapshots {
+		return os.MkdirAll(filepath.Join(hibernationDirPath, podUID), 0755)
+	}
+
+	entries, err := os.ReadDir(hibernationDirPath)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.Name() == restoreSnapshot || entry.Name() == "lost+found" {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(hibernationDirPath, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return os.MkdirAll(filepath.Join(hibernationDirPath, podUID), 0755)
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/vishvananda/netlink"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/metadata"
+)
+
+// The secrets of the metadata service are mounted in the VM pod by the VM
+// controller.
+const (
+	metadataUserDataPath      = "/mnt/virtink-metadata-user-data/value"
+	metadataSSHPublicKeysPath = "/mnt/virtink-metadata-ssh-public-keys"
+)
+
+// startMetadataService adds the address of the metadata service to the
+// loopback link, so that it is reached by the guest through the bridge of
+// any masquerade interface, and serves it from a process of its own which
+// outlives the prerunner.
+func startMetadataService(vmData string) error {
+	lo, err := netlink.LinkByName("lo")
+	if err != nil {
+		return fmt.Errorf("get loopback link: %s", err)
+	}
+	addr := &netlink.Addr{IPNet: &net.IPNet{IP: net.ParseIP(metadata.Address), Mask: net.CIDRMask(32, 32)}}
+	if err := netlink.AddrAdd(lo, addr); err != nil && !errors.Is(err, syscall.EEXIST) {
+		return fmt.Errorf("add metadata service address: %s", err)
+	}
+
+	// stdout is left out, as it is read by the entrypoint until it's closed
+	cmd := exec.Command(os.Args[0], "--serve-metadata", "--vm-data", vmData)
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("start metadata service: %s", err)
+	}
+	return nil
+}
+
+// serveMetadata serves the metadata of the VM until the context is done.
+func serveMetadata(ctx context.Context, vm *virtv1alpha1.VirtualMachine) error {
+	log := logr.FromContextOrDiscard(ctx)
+	server := &http.Server{
+		Addr: net.JoinHostPort(metadata.Address, "80"),
+		Handler: metadata.NewHandler(func() (*metadata.Metadata, error) {
+			md, err := loadMetadata(vm)
+			if err != nil {
+				log.Error(err, "load metadata")
+			}
+			return md, err
+		}),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func loadMetadata(vm *virtv1alpha1.VirtualMachine) (*metadata.Metadata, error) {
+	md := metadata.Metadata{
+		InstanceID: string(vm.UID),
+		Hostname:   vm.Name,
+	}
+	if vm.Spec.Hostname != "" {
+		md.Hostname = vm.Spec.Hostname
+	}
+
+	var err error
+	metadataService := vm.Spec.MetadataService
+	switch {
+	case metadataService.UserData != "":
+		md.UserData = []byte(metadataService.UserData)
+	case metadataService.UserDataBase64 != "":
+		if md.UserData, err = base64.StdEncoding.DecodeString(metadataService.UserDataBase64); err != nil {
+			return nil, fmt.Errorf("decode user data: %s", err)
+		}
+	case metadataService.UserDataSecretName != "":
+		if md.UserData, err = os.ReadFile(metadataUserDataPath); err != nil {
+			return nil, fmt.Errorf("read user data: %s", err)
+		}
+	}
+
+	for i := range vm.Spec.SSHPublicKeys {
+		keys, err := readSSHPublicKeys(filepath.Join(metadataSSHPublicKeysPath, fmt.Sprint(i)))
+		if err != nil {
+			return nil, fmt.Errorf("read SSH public keys: %s", err)
+		}
+		md.PublicKeys = append(md.PublicKeys, keys...)
+	}
+	return &md, nil
+}
+
+// readSSHPublicKeys reads the keys in every value of a mounted secret, one
+// per line.
+func readSSHPublicKeys(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, entry := range entries {
+		// the values are symlinks to a hidden directory of the volume
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			if key := strings.TrimSpace(line); key != "" {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys, nil
+}
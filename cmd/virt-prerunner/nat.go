@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// istioInjectAnnotation enables the Istio mode of masquerade interfaces when
+// set to "true" on the VM, which also has the Istio sidecar injected.
+const istioInjectAnnotation = "sidecar.istio.io/inject"
+
+// istioPorts are the ports of the Istio sidecar in the VM pod, which are not
+// forwarded to the guest.
+var istioPorts = []string{"15000", "15001", "15004", "15006", "15008", "15009", "15020", "15021", "15053", "15090"}
+
+// istioInboundPassthroughIP is the source IP of connections forwarded by the
+// Istio sidecar to the workload.
+const istioInboundPassthroughIP = "127.0.0.6"
+
+type masqueradeConfig struct {
+	LinkName   string
+	BridgeName string
+	BridgeIP   net.IP
+	VMIP       net.IP
+	// Istio forwards the connections the Istio sidecar receives for the VM
+	// pod to the guest, rather than all connections to the VM pod.
+	Istio bool
+	PodIP net.IP
+}
+
+// natBackend sets up the NAT rules of masquerade interfaces. Rules are kept in
+// chains of their own, so that they don't conflict with rules added by others
+// in the VM pod, such as init containers of service meshes.
+type natBackend interface {
+	setupMasquerade(config *masqueradeConfig) error
+}
+
+// detectNATBackend prefers nftables, which is the only backend on hosts
+// without the legacy iptables kernel modules. Legacy iptables is used if
+// nftables isn't supported by the kernel, or if legacy iptables rules exist
+// already, since NAT rules of both backends should not be mixed. Rules of
+// Istio are always added with iptables, so are those of the Istio mode.
+func detectNATBackend(istio bool) natBackend {
+	if istio {
+		return &iptablesNATBackend{}
+	}
+	if _, err := executeCommand("nft", "list", "tables"); err != nil {
+		return &iptablesNATBackend{}
+	}
+	if output, err := executeCommand("iptables-legacy", "-t", "nat", "-S"); err == nil && strings.Contains(output, "\n-A ") {
+		return &iptablesNATBackend{}
+	}
+	return &nftablesNATBackend{}
+}
+
+type iptablesNATBackend struct{}
+
+func (b *iptablesNATBackend) setupMasquerade(config *masqueradeConfig) error {
+	chains := []string{"PREROUTING", "POSTROUTING"}
+	if config.Istio {
+		chains = append(chains, "OUTPUT")
+	}
+	for _, chain := range chains {
+		if err := b.ensureChain(chain, "VIRTINK-"+chain); err != nil {
+			return err
+		}
+	}
+
+	var rules [][]string
+	if config.Istio {
+		istioPortList := strings.Join(istioPorts, ",")
+		rules = append(rules,
+			[]string{"VIRTINK-PREROUTING", "-i", config.LinkName, "-p", "tcp", "-m", "multiport", "--dports", istioPortList, "-j", "RETURN"},
+			[]string{"VIRTINK-OUTPUT", "-d", config.PodIP.String(), "-p", "tcp", "-m", "multiport", "!", "--dports", istioPortList, "-j", "DNAT", "--to-destination", config.VMIP.String()},
+			[]string{"VIRTINK-POSTROUTING", "-s", istioInboundPassthroughIP, "-o", config.BridgeName, "-j", "SNAT", "--to-source", config.BridgeIP.String()},
+		)
+	}
+	rules = append(rules,
+		[]string{"VIRTINK-POSTROUTING", "-o", config.LinkName, "-j", "MASQUERADE"},
+		[]string{"VIRTINK-PREROUTING", "-i", config.LinkName, "-j", "DNAT", "--to-destination", config.VMIP.String()},
+	)
+
+	for _, rule := range rules {
+		if _, err := executeCommand("iptables", append([]string{"-t", "nat", "-A"}, rule...)...); err != nil {
+			return fmt.Errorf("add rule to chain %q: %s", rule[0], err)
+		}
+	}
+	return nil
+}
+
+// ensureChain creates the chain in the nat table, which is jumped to from the
+// built-in chain.
+func (b *iptablesNATBackend) ensureChain(builtinChain string, chain string) error {
+	if _, err := executeCommand("iptables", "-t", "nat", "-S", chain); err == nil {
+		return nil
+	}
+	if _, err := executeCommand("iptables", "-t", "nat", "-N", chain); err != nil {
+		return fmt.Errorf("create chain %q: %s", chain, err)
+	}
+	if _, err := executeCommand("iptables", "-t", "nat", "-A", builtinChain, "-j", chain); err != nil {
+		return fmt.Errorf("add jump rule to chain %q: %s", chain, err)
+	}
+	return nil
+}
+
+type nftablesNATBackend struct{}
+
+const nftablesMasqueradeRules = `table ip virtink {
+	chain prerouting {
+		type nat hook prerouting priority dstnat; policy accept;
+		iifname "%[1]s" dnat to %[2]s
+	}
+	chain postrouting {
+		type nat hook postrouting priority srcnat; policy accept;
+		oifname "%[1]s" masquerade
+	}
+}
+`
+
+func (b *nftablesNATBackend) setupMasquerade(config *masqueradeConfig) error {
+	if config.Istio {
+		return fmt.Errorf("nftables backend does not support Istio mode")
+	}
+
+	rulesPath := fmt.Sprintf("/var/run/virtink/nftables/%s.nft", config.LinkName)
+	if err := os.MkdirAll(filepath.Dir(rulesPath), 0755); err != nil {
+		return fmt.Errorf("create nftables rules dir: %s", err)
+	}
+	if err := os.WriteFile(rulesPath, []byte(fmt.Sprintf(nftablesMasqueradeRules, config.LinkName, config.VMIP.String())), 0644); err != nil {
+		return fmt.Errorf("write nftables rules file: %s", err)
+	}
+	if _, err := executeCommand("nft", "-f", rulesPath); err != nil {
+		return fmt.Errorf("add nftables rules: %s", err)
+	}
+	return nil
+}
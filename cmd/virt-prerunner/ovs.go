@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/vishvananda/netlink"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/cloudhypervisor"
+)
+
+// setupOVSNetwork connects the guest to the OVS port of which the link is the
+// pod end. Rather than being bridged, frames are redirected between the link
+// and the tap device by tc, so that they pass unmodified, including their
+// VLAN tags, and the guest takes over the MAC address of the link.
+func setupOVSNetwork(linkName string, iface *virtv1alpha1.Interface, netConfig *cloudhypervisor.NetConfig) error {
+	link, err := netlink.LinkByName(linkName)
+	if err != nil {
+		return fmt.Errorf("get link: %s", err)
+	}
+	veth, ok := link.(*netlink.Veth)
+	if !ok {
+		return fmt.Errorf("link %q is not a veth of an OVS port", linkName)
+	}
+	mtu, err := getInterfaceMTU(iface, link)
+	if err != nil {
+		return err
+	}
+	netConfig.Mtu = mtu
+	netConfig.Mac = link.Attrs().HardwareAddr.String()
+
+	peerIndex, err := netlink.VethPeerIndex(veth)
+	if err != nil {
+		return fmt.Errorf("get veth peer index: %s", err)
+	}
+	ovsIfaceName, err := getOVSInterfaceName(peerIndex)
+	if err != nil {
+		return err
+	}
+	if err := setupOVSPort(ovsIfaceName, iface.OVS, iface.RateLimit); err != nil {
+		return err
+	}
+
+	// addresses assigned to the pod by the CNI plugin now belong to the guest
+	linkAddrs, err := netlink.AddrList(link, netlink.FAMILY_ALL)
+	if err != nil {
+		return fmt.Errorf("list link addrs: %s", err)
+	}
+	for i := range linkAddrs {
+		if err := netlink.AddrDel(link, &linkAddrs[i]); err != nil {
+			return fmt.Errorf("delete link address: %s", err)
+		}
+	}
+
+	tapName := fmt.Sprintf("tap-%s", linkName)
+	if _, err := createTap(nil, tapName, mtu, netConfig.NumQueues > 2); err != nil {
+		return fmt.Errorf("create tap: %s", err)
+	}
+	netConfig.Tap = tapName
+
+	for _, devs := range [][2]string{{linkName, tapName}, {tapName, linkName}} {
+		if _, err := executeCommand("tc", "qdisc", "add", "dev", devs[0], "handle", "ffff:", "ingress"); err != nil {
+			return fmt.Errorf("add ingress qdisc: %s", err)
+		}
+		if _, err := executeCommand("tc", "filter", "add", "dev", devs[0], "parent", "ffff:", "protocol", "all", "u32", "match", "u32", "0", "0",
+			"action", "mirred", "egress", "redirect", "dev", devs[1]); err != nil {
+			return fmt.Errorf("add redirect filter: %s", err)
+		}
+	}
+
+	// traffic sent by the guest is policed by OVS instead, as the ingress
+	// qdisc of the tap device redirects it
+	if iface.RateLimit != nil && iface.RateLimit.RX != nil {
+		if err := setupTrafficShaping(tapName, &virtv1alpha1.InterfaceRateLimit{RX: iface.RateLimit.RX}); err != nil {
+			return fmt.Errorf("setup traffic shaping: %s", err)
+		}
+	}
+	return nil
+}
+
+// getOVSInterfaceName returns the name of the OVS interface of the network
+// device on the node.
+func getOVSInterfaceName(ifindex int) (string, error) {
+	output, err := executeCommand("ovs-vsctl", "--timeout=10", "--bare", "--columns=name", "find", "Interface", fmt.Sprintf("ifindex=%d", ifindex))
+	if err != nil {
+		return "", fmt.Errorf("find OVS interface: %s", err)
+	}
+	name := strings.TrimSpace(output)
+	if name == "" {
+		return "", fmt.Errorf("OVS interface of ifindex %d not found", ifindex)
+	}
+	return name, nil
+}
+
+// setupOVSPort sets the VLANs of the OVS port, and polices the traffic it
+// receives from the guest.
+func setupOVSPort(name string, ovs *virtv1alpha1.InterfaceOVS, rateLimit *virtv1alpha1.InterfaceRateLimit) error {
+	var portArgs []string
+	switch {
+	case ovs.VLAN != nil && len(ovs.Trunks) > 0:
+		portArgs = append(portArgs, fmt.Sprintf("tag=%d", *ovs.VLAN), "trunks="+formatOVSTrunks(ovs.Trunks), "vlan_mode=native-untagged")
+	case ovs.VLAN != nil:
+		portArgs = append(portArgs, fmt.Sprintf("tag=%d", *ovs.VLAN), "vlan_mode=access")
+	case len(ovs.Trunks) > 0:
+		portArgs = append(portArgs, "trunks="+formatOVSTrunks(ovs.Trunks), "vlan_mode=trunk")
+	}
+	if len(portArgs) > 0 {
+		if _, err := executeCommand("ovs-vsctl", append([]string{"--timeout=10", "set", "Port", name}, portArgs...)...); err != nil {
+			return fmt.Errorf("set VLANs of OVS port: %s", err)
+		}
+	}
+
+	if rateLimit != nil && rateLimit.TX != nil {
+		rate := rateLimit.TX.Bandwidth.Value() / 1000
+		// OVS defaults to a burst of 80% of the rate
+		burst := rate * 8 / 10
+		if rateLimit.TX.Burst != nil {
+			burst = rateLimit.TX.Burst.Value() * 8 / 1000
+		}
+		if _, err := executeCommand("ovs-vsctl", "--timeout=10", "set", "Interface", name,
+			fmt.Sprintf("ingress_policing_rate=%d", rate), fmt.Sprintf("ingress_policing_burst=%d", burst)); err != nil {
+			return fmt.Errorf("set ingress policing of OVS interface: %s", err)
+		}
+	}
+	return nil
+}
+
+func formatOVSTrunks(trunks []int32) string {
+	var values []string
+	for _, trunk := range trunks {
+		values = append(values, strconv.Itoa(int(trunk)))
+	}
+	return strings.Join(values, ",")
+}
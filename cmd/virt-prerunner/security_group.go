@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/cloudhypervisor"
+	"github.com/smartxworks/virtink/pkg/securitygroup"
+)
+
+// nftablesSecurityGroupRules filter the traffic of a tap device in a bridge
+// table of its own. Traffic from the guest is matched on the way in and
+// traffic to the guest on the way out of the bridge, so that both traffic
+// forwarded to the pod link and traffic to the masquerade bridge IP are
+// filtered. Replies to allowed traffic, ARP, DHCP and IPv6 neighbor discovery
+// are always allowed, and anything else not allowed by a rule is dropped. The
+// table is deleted and added again in the same transaction, so that the rules
+// are replaced atomically when the security groups change.
+const nftablesSecurityGroupRules = `add table bridge virtink-sg-%[1]s
+delete table bridge virtink-sg-%[1]s
+table bridge virtink-sg-%[1]s {
+	chain prerouting {
+		type filter hook prerouting priority filter; policy accept;
+		iifname "%[1]s" jump egress
+	}
+	chain postrouting {
+		type filter hook postrouting priority filter; policy accept;
+		oifname "%[1]s" jump ingress
+	}
+	chain egress {
+		ct state established,related accept
+		ct state invalid drop
+		ether type arp accept
+		udp sport 68 udp dport 67 accept
+		icmpv6 type { nd-router-solicit, nd-neighbor-solicit, nd-neighbor-advert } accept
+%[2]s		drop
+	}
+	chain ingress {
+		ct state established,related accept
+		ct state invalid drop
+		ether type arp accept
+		udp sport 67 udp dport 68 accept
+		icmpv6 type { nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert } accept
+%[3]s		drop
+	}
+}
+`
+
+// setupSecurityGroups filters the traffic of the tap device by the rules of
+// the security groups of its interface.
+func setupSecurityGroups(tapName string, securityGroup *virtv1beta1.VirtualMachineSecurityGroupSpec) error {
+	rules, err := renderSecurityGroupRules(tapName, securityGroup)
+	if err != nil {
+		return err
+	}
+
+	rulesPath := fmt.Sprintf("/var/run/virtink/nftables/sg-%s.nft", tapName)
+	if err := os.MkdirAll(filepath.Dir(rulesPath), 0755); err != nil {
+		return fmt.Errorf("create nftables rules dir: %s", err)
+	}
+	if err := os.WriteFile(rulesPath, []byte(rules), 0644); err != nil {
+		return fmt.Errorf("write nftables rules file: %s", err)
+	}
+	if _, err := executeCommand("nft", "-f", rulesPath); err != nil {
+		return fmt.Errorf("add nftables rules: %s", err)
+	}
+	return nil
+}
+
+// getSecurityGroupTaps returns the taps of the interfaces with security groups,
+// by interface name.
+func getSecurityGroupTaps(vmConfig *cloudhypervisor.VmConfig, securityGroups map[string]virtv1beta1.VirtualMachineSecurityGroupSpec) map[string]string {
+	taps := map[string]string{}
+	for _, netConfig := range vmConfig.Net {
+		if _, ok := securityGroups[netConfig.Id]; ok && netConfig.Tap != "" {
+			taps[netConfig.Id] = netConfig.Tap
+		}
+	}
+	return taps
+}
+
+// startSecurityGroupService serves the rules of the security groups of
+// interfaces to virt-daemon from a process of its own which outlives the
+// prerunner, so that virt-daemon can replace them as the security groups
+// change.
+func startSecurityGroupService(vmData string, taps map[string]string, securityGroupsData string) error {
+	tapsJSON, err := json.Marshal(taps)
+	if err != nil {
+		return fmt.Errorf("marshal security group taps: %s", err)
+	}
+
+	// stdout is left out, as it is read by the entrypoint until it's closed
+	cmd := exec.Command(os.Args[0], "--serve-security-groups", "--vm-data", vmData, "--security-group-taps", base64.StdEncoding.EncodeToString(tapsJSON), "--security-groups", securityGroupsData)
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("start security group service: %s", err)
+	}
+	return nil
+}
+
+// serveSecurityGroups serves the rules of the security groups of interfaces
+// until the context is done. Interfaces start with the rules applied by the
+// prerunner.
+func serveSecurityGroups(ctx context.Context, tapsData string, securityGroups map[string]virtv1beta1.VirtualMachineSecurityGroupSpec) error {
+	tapsJSON, err := base64.StdEncoding.DecodeString(tapsData)
+	if err != nil {
+		return fmt.Errorf("decode security group taps: %s", err)
+	}
+	var taps map[string]string
+	if err := json.Unmarshal(tapsJSON, &taps); err != nil {
+		return fmt.Errorf("unmarshal security group taps: %s", err)
+	}
+	server := securitygroup.NewServer(nftablesRules{}, taps, securityGroups)
+
+	socketPath := filepath.Join("/var/run/virtink", securitygroup.SocketName)
+	if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove socket: %s", err)
+	}
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return fmt.Errorf("listen: %s", err)
+	}
+	httpServer := &http.Server{Handler: server}
+	go func() {
+		<-ctx.Done()
+		httpServer.Close()
+	}()
+	if err := httpServer.Serve(listener); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// nftablesRules replaces the nftables rules of taps in the network namespace
+// of the VM pod.
+type nftablesRules struct{}
+
+func (nftablesRules) Apply(tap string, securityGroup *virtv1beta1.VirtualMachineSecurityGroupSpec) error {
+	return setupSecurityGroups(tap, securityGroup)
+}
+
+func renderSecurityGroupRules(tapName string, securityGroup *virtv1beta1.VirtualMachineSecurityGroupSpec) (string, error) {
+	var egressRules strings.Builder
+	for _, rule := range securityGroup.Egress {
+		if err := renderSecurityGroupRule(&egressRules, &rule, "daddr"); err != nil {
+			return "", err
+		}
+	}
+	var ingressRules strings.Builder
+	for _, rule := range securityGroup.Ingress {
+		if err := renderSecurityGroupRule(&ingressRules, &rule, "saddr"); err != nil {
+			return "", err
+		}
+	}
+	return fmt.Sprintf(nftablesSecurityGroupRules, tapName, egressRules.String(), ingressRules.String()), nil
+}
+
+// renderSecurityGroupRule writes the statements accepting the traffic allowed
+// by the rule, matching CIDRs against the remote address of the guest. IPv4
+// and IPv6 CIDRs are matched by statements of their own.
+func renderSecurityGroupRule(b *strings.Builder, rule *virtv1beta1.SecurityGroupRule, addrSelector string) error {
+	var protocolMatch string
+	switch rule.Protocol {
+	case "", virtv1beta1.SecurityGroupProtocolAll:
+	case virtv1beta1.SecurityGroupProtocolTCP:
+		protocolMatch = "meta l4proto tcp"
+	case virtv1beta1.SecurityGroupProtocolUDP:
+		protocolMatch = "meta l4proto udp"
+	case virtv1beta1.SecurityGroupProtocolICMP:
+		protocolMatch = "meta l4proto icmp"
+	case virtv1beta1.SecurityGroupProtocolICMPv6:
+		protocolMatch = "meta l4proto ipv6-icmp"
+	default:
+		return fmt.Errorf("unsupported security group protocol %q", rule.Protocol)
+	}
+	if len(rule.Ports) > 0 {
+		var ports []string
+		for _, port := range rule.Ports {
+			if port.EndPort > port.Port {
+				ports = append(ports, fmt.Sprintf("%d-%d", port.Port, port.EndPort))
+			} else {
+				ports = append(ports, fmt.Sprintf("%d", port.Port))
+			}
+		}
+		protocolMatch += fmt.Sprintf(" th dport { %s }", strings.Join(ports, ", "))
+	}
+
+	var addrMatches []string
+	if len(rule.CIDRs) == 0 {
+		addrMatches = append(addrMatches, "")
+	} else {
+		var ipv4CIDRs, ipv6CIDRs []string
+		for _, cidr := range rule.CIDRs {
+			_, ipNet, err := net.ParseCIDR(cidr)
+			if err != nil {
+				return fmt.Errorf("parse CIDR %q: %s", cidr, err)
+			}
+			if ipNet.IP.To4() != nil {
+				ipv4CIDRs = append(ipv4CIDRs, ipNet.String())
+			} else {
+				ipv6CIDRs = append(ipv6CIDRs, ipNet.String())
+			}
+		}
+		if len(ipv4CIDRs) > 0 {
+			addrMatches = append(addrMatches, fmt.Sprintf("ip %s { %s }", addrSelector, strings.Join(ipv4CIDRs, ", ")))
+		}
+		if len(ipv6CIDRs) > 0 {
+			addrMatches = append(addrMatches, fmt.Sprintf("ip6 %s { %s }", addrSelector, strings.Join(ipv6CIDRs, ", ")))
+		}
+	}
+
+	for _, addrMatch := range addrMatches {
+		var matches []string
+		for _, match := range []string{addrMatch, protocolMatch} {
+			if match != "" {
+				matches = append(matches, match)
+			}
+		}
+		matches = append(matches, "accept")
+		fmt.Fprintf(b, "\t\t%s\n", strings.Join(matches, " "))
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// warmPaths are the files VM pods read before the guest boots: the
+// binaries run by the VM pod and the firmware loaded by Cloud Hypervisor.
+var warmPaths = []string{
+	"/usr/bin/virt-prerunner",
+	"/usr/bin/cloud-hypervisor",
+	"/var/lib/cloud-hypervisor",
+}
+
+// warm keeps warmPaths in the page cache of the node by reading them every
+// interval. It runs in a container of virt-daemon using the prerunner image,
+// which shares the image layers, and so the cached pages, with VM pods.
+func warm(ctx context.Context, interval time.Duration) {
+	log := logr.FromContextOrDiscard(ctx)
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		start := time.Now()
+		var size int64
+		for _, warmPath := range warmPaths {
+			if err := filepath.WalkDir(warmPath, func(path string, entry fs.DirEntry, err error) error {
+				if err != nil || entry.IsDir() {
+					return err
+				}
+				n, err := readFile(path)
+				size += n
+				return err
+			}); err != nil {
+				log.Error(err, "read file", "path", warmPath)
+			}
+		}
+		log.V(1).Info("warmed page cache", "bytes", size, "duration", time.Since(start))
+	}, interval)
+}
+
+func readFile(path string) (int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	return io.Copy(io.Discard, file)
+}
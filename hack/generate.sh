@@ -4,13 +4,37 @@ set -o errexit
 set -o nounset
 set -o pipefail
 
-bash $GOPATH/src/k8s.io/code-generator/generate-groups.sh "deepcopy,client,informer,lister" \
+bash $GOPATH/src/k8s.io/code-generator/generate-groups.sh "deepcopy,informer,lister" \
   github.com/smartxworks/virtink/pkg/generated github.com/smartxworks/virtink/pkg/apis \
-  virt:v1alpha1 \
+  virt:v1alpha1,v1beta1 \
+  --go-header-file ./hack/boilerplate.go.txt
+
+applyconfiguration-gen --input-dirs github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1,github.com/smartxworks/virtink/pkg/apis/virt/v1beta1 \
+  --external-applyconfigurations k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta:k8s.io/client-go/applyconfigurations/meta/v1,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta:k8s.io/client-go/applyconfigurations/meta/v1,k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference:k8s.io/client-go/applyconfigurations/meta/v1,k8s.io/apimachinery/pkg/apis/meta/v1.Condition:k8s.io/client-go/applyconfigurations/meta/v1,k8s.io/api/core/v1.Affinity:k8s.io/client-go/applyconfigurations/core/v1,k8s.io/api/core/v1.Toleration:k8s.io/client-go/applyconfigurations/core/v1,k8s.io/api/core/v1.ResourceRequirements:k8s.io/client-go/applyconfigurations/core/v1,k8s.io/api/core/v1.Probe:k8s.io/client-go/applyconfigurations/core/v1,k8s.io/api/core/v1.PodDNSConfig:k8s.io/client-go/applyconfigurations/core/v1,k8s.io/api/core/v1.PersistentVolumeClaim:k8s.io/client-go/applyconfigurations/core/v1 \
+  --output-package github.com/smartxworks/virtink/pkg/generated/applyconfiguration \
+  --output-base $GOPATH/src \
+  --go-header-file ./hack/boilerplate.go.txt
+
+# generate-groups.sh doesn't support generating Apply methods, so run client-gen on its own
+client-gen --clientset-name versioned \
+  --input-base "" \
+  --input github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1,github.com/smartxworks/virtink/pkg/apis/virt/v1beta1 \
+  --apply-configuration-package github.com/smartxworks/virtink/pkg/generated/applyconfiguration \
+  --output-package github.com/smartxworks/virtink/pkg/generated/clientset \
+  --output-base $GOPATH/src \
+  --go-header-file ./hack/boilerplate.go.txt
+
+conversion-gen --input-dirs github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1 \
+  -O zz_generated.conversion \
+  --output-base $GOPATH/src \
   --go-header-file ./hack/boilerplate.go.txt
 
 controller-gen paths=./pkg/apis/... crd output:crd:artifacts:config=deploy/crd
 controller-gen paths=./cmd/virt-controller/... paths=./pkg/controller/... rbac:roleName=virt-controller output:rbac:artifacts:config=deploy/virt-controller webhook output:webhook:artifacts:config=deploy/virt-controller
 controller-gen paths=./cmd/virt-daemon/... paths=./pkg/daemon/... rbac:roleName=virt-daemon output:rbac:artifacts:config=deploy/virt-daemon
+controller-gen paths=./cmd/virt-api/... paths=./pkg/virtapi/... rbac:roleName=virt-api output:rbac:artifacts:config=deploy/virt-api
+for role in view edit admin; do
+  controller-gen paths=./pkg/rbac/$role rbac:roleName=virtink-$role output:rbac:artifacts:config=deploy/rbac/$role
+done
 
 go generate ./...
@@ -0,0 +1,122 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/conversion"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+var _ ctrlconversion.Convertible = &VirtualMachine{}
+var _ ctrlconversion.Convertible = &VirtualMachineMigration{}
+var _ ctrlconversion.Convertible = &VirtualMachineExport{}
+
+func (src *VirtualMachine) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	return Convert_v1alpha1_VirtualMachine_To_v1beta1_VirtualMachine(src, dstRaw.(*v1beta1.VirtualMachine), nil)
+}
+
+func (dst *VirtualMachine) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	return Convert_v1beta1_VirtualMachine_To_v1alpha1_VirtualMachine(srcRaw.(*v1beta1.VirtualMachine), dst, nil)
+}
+
+func (src *VirtualMachineMigration) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	return Convert_v1alpha1_VirtualMachineMigration_To_v1beta1_VirtualMachineMigration(src, dstRaw.(*v1beta1.VirtualMachineMigration), nil)
+}
+
+func (dst *VirtualMachineMigration) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	return Convert_v1beta1_VirtualMachineMigration_To_v1alpha1_VirtualMachineMigration(srcRaw.(*v1beta1.VirtualMachineMigration), dst, nil)
+}
+
+func (src *VirtualMachineExport) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	return Convert_v1alpha1_VirtualMachineExport_To_v1beta1_VirtualMachineExport(src, dstRaw.(*v1beta1.VirtualMachineExport), nil)
+}
+
+func (dst *VirtualMachineExport) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	return Convert_v1beta1_VirtualMachineExport_To_v1alpha1_VirtualMachineExport(srcRaw.(*v1beta1.VirtualMachineExport), dst, nil)
+}
+
+func Convert_v1alpha1_DataVolumeVolumeSource_To_v1beta1_DataVolumeVolumeSource(in *DataVolumeVolumeSource, out *v1beta1.DataVolumeVolumeSource, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_DataVolumeVolumeSource_To_v1beta1_DataVolumeVolumeSource(in, out, s); err != nil {
+		return err
+	}
+	out.Name = in.VolumeName
+	return nil
+}
+
+func Convert_v1beta1_DataVolumeVolumeSource_To_v1alpha1_DataVolumeVolumeSource(in *v1beta1.DataVolumeVolumeSource, out *DataVolumeVolumeSource, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_DataVolumeVolumeSource_To_v1alpha1_DataVolumeVolumeSource(in, out, s); err != nil {
+		return err
+	}
+	out.VolumeName = in.Name
+	return nil
+}
+
+func Convert_v1alpha1_VirtualMachineStatus_To_v1beta1_VirtualMachineStatus(in *VirtualMachineStatus, out *v1beta1.VirtualMachineStatus, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_VirtualMachineStatus_To_v1beta1_VirtualMachineStatus(in, out, s); err != nil {
+		return err
+	}
+	out.PodName = in.VMPodName
+	out.PodUID = in.VMPodUID
+	out.PodIP = in.VMPodIP
+	return nil
+}
+
+func Convert_v1beta1_VirtualMachineStatus_To_v1alpha1_VirtualMachineStatus(in *v1beta1.VirtualMachineStatus, out *VirtualMachineStatus, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_VirtualMachineStatus_To_v1alpha1_VirtualMachineStatus(in, out, s); err != nil {
+		return err
+	}
+	out.VMPodName = in.PodName
+	out.VMPodUID = in.PodUID
+	out.VMPodIP = in.PodIP
+	return nil
+}
+
+func Convert_v1alpha1_VirtualMachineStatusMigration_To_v1beta1_VirtualMachineStatusMigration(in *VirtualMachineStatusMigration, out *v1beta1.VirtualMachineStatusMigration, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_VirtualMachineStatusMigration_To_v1beta1_VirtualMachineStatusMigration(in, out, s); err != nil {
+		return err
+	}
+	out.TargetPodName = in.TargetVMPodName
+	out.TargetPodUID = in.TargetVMPodUID
+	return nil
+}
+
+func Convert_v1beta1_VirtualMachineStatusMigration_To_v1alpha1_VirtualMachineStatusMigration(in *v1beta1.VirtualMachineStatusMigration, out *VirtualMachineStatusMigration, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_VirtualMachineStatusMigration_To_v1alpha1_VirtualMachineStatusMigration(in, out, s); err != nil {
+		return err
+	}
+	out.TargetVMPodName = in.TargetPodName
+	out.TargetVMPodUID = in.TargetPodUID
+	return nil
+}
+
+func Convert_v1alpha1_VirtualMachineMigrationSpec_To_v1beta1_VirtualMachineMigrationSpec(in *VirtualMachineMigrationSpec, out *v1beta1.VirtualMachineMigrationSpec, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_VirtualMachineMigrationSpec_To_v1beta1_VirtualMachineMigrationSpec(in, out, s); err != nil {
+		return err
+	}
+	out.VirtualMachineName = in.VMName
+	return nil
+}
+
+func Convert_v1beta1_VirtualMachineMigrationSpec_To_v1alpha1_VirtualMachineMigrationSpec(in *v1beta1.VirtualMachineMigrationSpec, out *VirtualMachineMigrationSpec, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_VirtualMachineMigrationSpec_To_v1alpha1_VirtualMachineMigrationSpec(in, out, s); err != nil {
+		return err
+	}
+	out.VMName = in.VirtualMachineName
+	return nil
+}
+
+func Convert_v1alpha1_VirtualMachineExportSpec_To_v1beta1_VirtualMachineExportSpec(in *VirtualMachineExportSpec, out *v1beta1.VirtualMachineExportSpec, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_VirtualMachineExportSpec_To_v1beta1_VirtualMachineExportSpec(in, out, s); err != nil {
+		return err
+	}
+	out.VirtualMachineName = in.VMName
+	return nil
+}
+
+func Convert_v1beta1_VirtualMachineExportSpec_To_v1alpha1_VirtualMachineExportSpec(in *v1beta1.VirtualMachineExportSpec, out *VirtualMachineExportSpec, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_VirtualMachineExportSpec_To_v1alpha1_VirtualMachineExportSpec(in, out, s); err != nil {
+		return err
+	}
+	out.VMName = in.VirtualMachineName
+	return nil
+}
@@ -0,0 +1,87 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+func TestVMConversion(t *testing.T) {
+	vm := &VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-vm",
+		},
+		Spec: VirtualMachineSpec{
+			Volumes: []Volume{{
+				Name: "data",
+				VolumeSource: VolumeSource{
+					DataVolume: &DataVolumeVolumeSource{
+						VolumeName: "test-dv",
+					},
+				},
+			}},
+		},
+		Status: VirtualMachineStatus{
+			Phase:     VirtualMachineRunning,
+			VMPodName: "vm-test-vm-abcde",
+			VMPodUID:  "e3c2f1a4-3b7e-4d2a-9f1c-8a6b5d4c3e2f",
+			VMPodIP:   "10.244.0.10",
+			Migration: &VirtualMachineStatusMigration{
+				TargetVMPodName: "vm-test-vm-fghij",
+				TargetVMPodUID:  "7f6e5d4c-3b2a-4190-8f7e-6d5c4b3a2918",
+			},
+		},
+	}
+
+	var hub v1beta1.VirtualMachine
+	assert.NoError(t, vm.ConvertTo(&hub))
+	assert.Equal(t, "test-dv", hub.Spec.Volumes[0].DataVolume.Name)
+	assert.Equal(t, vm.Status.VMPodName, hub.Status.PodName)
+	assert.Equal(t, vm.Status.VMPodUID, hub.Status.PodUID)
+	assert.Equal(t, vm.Status.VMPodIP, hub.Status.PodIP)
+	assert.Equal(t, vm.Status.Migration.TargetVMPodName, hub.Status.Migration.TargetPodName)
+	assert.Equal(t, vm.Status.Migration.TargetVMPodUID, hub.Status.Migration.TargetPodUID)
+
+	var converted VirtualMachine
+	assert.NoError(t, converted.ConvertFrom(&hub))
+	assert.Equal(t, vm, &converted)
+}
+
+func TestVMMConversion(t *testing.T) {
+	vmm := &VirtualMachineMigration{
+		Spec: VirtualMachineMigrationSpec{
+			VMName: "test-vm",
+		},
+	}
+
+	var hub v1beta1.VirtualMachineMigration
+	assert.NoError(t, vmm.ConvertTo(&hub))
+	assert.Equal(t, "test-vm", hub.Spec.VirtualMachineName)
+
+	var converted VirtualMachineMigration
+	assert.NoError(t, converted.ConvertFrom(&hub))
+	assert.Equal(t, vmm, &converted)
+}
+
+func TestVMEConversion(t *testing.T) {
+	vme := &VirtualMachineExport{
+		Spec: VirtualMachineExportSpec{
+			VMName:     "test-vm",
+			VolumeName: "data",
+			VirtualMachineExportTarget: VirtualMachineExportTarget{
+				HTTP: &VirtualMachineExportHTTPTarget{},
+			},
+		},
+	}
+
+	var hub v1beta1.VirtualMachineExport
+	assert.NoError(t, vme.ConvertTo(&hub))
+	assert.Equal(t, "test-vm", hub.Spec.VirtualMachineName)
+
+	var converted VirtualMachineExport
+	assert.NoError(t, converted.ConvertFrom(&hub))
+	assert.Equal(t, vme, &converted)
+}
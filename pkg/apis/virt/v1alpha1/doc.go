@@ -1,4 +1,5 @@
 // +k8s:deepcopy-gen=package
+// +k8s:conversion-gen=github.com/smartxworks/virtink/pkg/apis/virt/v1beta1
 // +groupName=virt.virtink.smartx.com
 
 // Package v1alpha1 is the v1alpha1 version of the API.
@@ -0,0 +1,3202 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	unsafe "unsafe"
+
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	types "k8s.io/apimachinery/pkg/types"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*BandwidthLimit)(nil), (*v1beta1.BandwidthLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BandwidthLimit_To_v1beta1_BandwidthLimit(a.(*BandwidthLimit), b.(*v1beta1.BandwidthLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.BandwidthLimit)(nil), (*BandwidthLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BandwidthLimit_To_v1alpha1_BandwidthLimit(a.(*v1beta1.BandwidthLimit), b.(*BandwidthLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Bond)(nil), (*v1beta1.Bond)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Bond_To_v1beta1_Bond(a.(*Bond), b.(*v1beta1.Bond), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Bond)(nil), (*Bond)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Bond_To_v1alpha1_Bond(a.(*v1beta1.Bond), b.(*Bond), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CPU)(nil), (*v1beta1.CPU)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CPU_To_v1beta1_CPU(a.(*CPU), b.(*v1beta1.CPU), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CPU)(nil), (*CPU)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CPU_To_v1alpha1_CPU(a.(*v1beta1.CPU), b.(*CPU), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Clock)(nil), (*v1beta1.Clock)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Clock_To_v1beta1_Clock(a.(*Clock), b.(*v1beta1.Clock), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Clock)(nil), (*Clock)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Clock_To_v1alpha1_Clock(a.(*v1beta1.Clock), b.(*Clock), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClockSync)(nil), (*v1beta1.ClockSync)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClockSync_To_v1beta1_ClockSync(a.(*ClockSync), b.(*v1beta1.ClockSync), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.ClockSync)(nil), (*ClockSync)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClockSync_To_v1alpha1_ClockSync(a.(*v1beta1.ClockSync), b.(*ClockSync), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CloudInitVolumeSource)(nil), (*v1beta1.CloudInitVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudInitVolumeSource_To_v1beta1_CloudInitVolumeSource(a.(*CloudInitVolumeSource), b.(*v1beta1.CloudInitVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CloudInitVolumeSource)(nil), (*CloudInitVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CloudInitVolumeSource_To_v1alpha1_CloudInitVolumeSource(a.(*v1beta1.CloudInitVolumeSource), b.(*CloudInitVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterAPIBootstrapVolumeSource)(nil), (*v1beta1.ClusterAPIBootstrapVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterAPIBootstrapVolumeSource_To_v1beta1_ClusterAPIBootstrapVolumeSource(a.(*ClusterAPIBootstrapVolumeSource), b.(*v1beta1.ClusterAPIBootstrapVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.ClusterAPIBootstrapVolumeSource)(nil), (*ClusterAPIBootstrapVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClusterAPIBootstrapVolumeSource_To_v1alpha1_ClusterAPIBootstrapVolumeSource(a.(*v1beta1.ClusterAPIBootstrapVolumeSource), b.(*ClusterAPIBootstrapVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ConfigMapVolumeSource)(nil), (*v1beta1.ConfigMapVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ConfigMapVolumeSource_To_v1beta1_ConfigMapVolumeSource(a.(*ConfigMapVolumeSource), b.(*v1beta1.ConfigMapVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.ConfigMapVolumeSource)(nil), (*ConfigMapVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ConfigMapVolumeSource_To_v1alpha1_ConfigMapVolumeSource(a.(*v1beta1.ConfigMapVolumeSource), b.(*ConfigMapVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ContainerDiskVolumeSource)(nil), (*v1beta1.ContainerDiskVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ContainerDiskVolumeSource_To_v1beta1_ContainerDiskVolumeSource(a.(*ContainerDiskVolumeSource), b.(*v1beta1.ContainerDiskVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.ContainerDiskVolumeSource)(nil), (*ContainerDiskVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ContainerDiskVolumeSource_To_v1alpha1_ContainerDiskVolumeSource(a.(*v1beta1.ContainerDiskVolumeSource), b.(*ContainerDiskVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ContainerRootfsVolumeSource)(nil), (*v1beta1.ContainerRootfsVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ContainerRootfsVolumeSource_To_v1beta1_ContainerRootfsVolumeSource(a.(*ContainerRootfsVolumeSource), b.(*v1beta1.ContainerRootfsVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.ContainerRootfsVolumeSource)(nil), (*ContainerRootfsVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ContainerRootfsVolumeSource_To_v1alpha1_ContainerRootfsVolumeSource(a.(*v1beta1.ContainerRootfsVolumeSource), b.(*ContainerRootfsVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Devices)(nil), (*v1beta1.Devices)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Devices_To_v1beta1_Devices(a.(*Devices), b.(*v1beta1.Devices), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Devices)(nil), (*Devices)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Devices_To_v1alpha1_Devices(a.(*v1beta1.Devices), b.(*Devices), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Disk)(nil), (*v1beta1.Disk)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Disk_To_v1beta1_Disk(a.(*Disk), b.(*v1beta1.Disk), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Disk)(nil), (*Disk)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Disk_To_v1alpha1_Disk(a.(*v1beta1.Disk), b.(*Disk), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiskDiscard)(nil), (*v1beta1.DiskDiscard)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiskDiscard_To_v1beta1_DiskDiscard(a.(*DiskDiscard), b.(*v1beta1.DiskDiscard), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.DiskDiscard)(nil), (*DiskDiscard)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DiskDiscard_To_v1alpha1_DiskDiscard(a.(*v1beta1.DiskDiscard), b.(*DiskDiscard), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiskEncryption)(nil), (*v1beta1.DiskEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiskEncryption_To_v1beta1_DiskEncryption(a.(*DiskEncryption), b.(*v1beta1.DiskEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.DiskEncryption)(nil), (*DiskEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DiskEncryption_To_v1alpha1_DiskEncryption(a.(*v1beta1.DiskEncryption), b.(*DiskEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiskRateLimit)(nil), (*v1beta1.DiskRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiskRateLimit_To_v1beta1_DiskRateLimit(a.(*DiskRateLimit), b.(*v1beta1.DiskRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.DiskRateLimit)(nil), (*DiskRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DiskRateLimit_To_v1alpha1_DiskRateLimit(a.(*v1beta1.DiskRateLimit), b.(*DiskRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Display)(nil), (*v1beta1.Display)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Display_To_v1beta1_Display(a.(*Display), b.(*v1beta1.Display), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Display)(nil), (*Display)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Display_To_v1alpha1_Display(a.(*v1beta1.Display), b.(*Display), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Downward)(nil), (*v1beta1.Downward)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Downward_To_v1beta1_Downward(a.(*Downward), b.(*v1beta1.Downward), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Downward)(nil), (*Downward)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Downward_To_v1alpha1_Downward(a.(*v1beta1.Downward), b.(*Downward), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EFIFirmware)(nil), (*v1beta1.EFIFirmware)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EFIFirmware_To_v1beta1_EFIFirmware(a.(*EFIFirmware), b.(*v1beta1.EFIFirmware), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.EFIFirmware)(nil), (*EFIFirmware)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EFIFirmware_To_v1alpha1_EFIFirmware(a.(*v1beta1.EFIFirmware), b.(*EFIFirmware), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EmptyDiskVolumeClaim)(nil), (*v1beta1.EmptyDiskVolumeClaim)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EmptyDiskVolumeClaim_To_v1beta1_EmptyDiskVolumeClaim(a.(*EmptyDiskVolumeClaim), b.(*v1beta1.EmptyDiskVolumeClaim), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.EmptyDiskVolumeClaim)(nil), (*EmptyDiskVolumeClaim)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EmptyDiskVolumeClaim_To_v1alpha1_EmptyDiskVolumeClaim(a.(*v1beta1.EmptyDiskVolumeClaim), b.(*EmptyDiskVolumeClaim), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EmptyDiskVolumeSource)(nil), (*v1beta1.EmptyDiskVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EmptyDiskVolumeSource_To_v1beta1_EmptyDiskVolumeSource(a.(*EmptyDiskVolumeSource), b.(*v1beta1.EmptyDiskVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.EmptyDiskVolumeSource)(nil), (*EmptyDiskVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EmptyDiskVolumeSource_To_v1alpha1_EmptyDiskVolumeSource(a.(*v1beta1.EmptyDiskVolumeSource), b.(*EmptyDiskVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FileSystem)(nil), (*v1beta1.FileSystem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FileSystem_To_v1beta1_FileSystem(a.(*FileSystem), b.(*v1beta1.FileSystem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.FileSystem)(nil), (*FileSystem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_FileSystem_To_v1alpha1_FileSystem(a.(*v1beta1.FileSystem), b.(*FileSystem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Firmware)(nil), (*v1beta1.Firmware)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Firmware_To_v1beta1_Firmware(a.(*Firmware), b.(*v1beta1.Firmware), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Firmware)(nil), (*Firmware)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Firmware_To_v1alpha1_Firmware(a.(*v1beta1.Firmware), b.(*Firmware), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GuestMetrics)(nil), (*v1beta1.GuestMetrics)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GuestMetrics_To_v1beta1_GuestMetrics(a.(*GuestMetrics), b.(*v1beta1.GuestMetrics), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GuestMetrics)(nil), (*GuestMetrics)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GuestMetrics_To_v1alpha1_GuestMetrics(a.(*v1beta1.GuestMetrics), b.(*GuestMetrics), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HTTPDiskVolumeSource)(nil), (*v1beta1.HTTPDiskVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HTTPDiskVolumeSource_To_v1beta1_HTTPDiskVolumeSource(a.(*HTTPDiskVolumeSource), b.(*v1beta1.HTTPDiskVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.HTTPDiskVolumeSource)(nil), (*HTTPDiskVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HTTPDiskVolumeSource_To_v1alpha1_HTTPDiskVolumeSource(a.(*v1beta1.HTTPDiskVolumeSource), b.(*HTTPDiskVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Hibernation)(nil), (*v1beta1.Hibernation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Hibernation_To_v1beta1_Hibernation(a.(*Hibernation), b.(*v1beta1.Hibernation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Hibernation)(nil), (*Hibernation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Hibernation_To_v1alpha1_Hibernation(a.(*v1beta1.Hibernation), b.(*Hibernation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HostUSBDevice)(nil), (*v1beta1.HostUSBDevice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HostUSBDevice_To_v1beta1_HostUSBDevice(a.(*HostUSBDevice), b.(*v1beta1.HostUSBDevice), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.HostUSBDevice)(nil), (*HostUSBDevice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HostUSBDevice_To_v1alpha1_HostUSBDevice(a.(*v1beta1.HostUSBDevice), b.(*HostUSBDevice), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Hugepages)(nil), (*v1beta1.Hugepages)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Hugepages_To_v1beta1_Hugepages(a.(*Hugepages), b.(*v1beta1.Hugepages), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Hugepages)(nil), (*Hugepages)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Hugepages_To_v1alpha1_Hugepages(a.(*v1beta1.Hugepages), b.(*Hugepages), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Instance)(nil), (*v1beta1.Instance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Instance_To_v1beta1_Instance(a.(*Instance), b.(*v1beta1.Instance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Instance)(nil), (*Instance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Instance_To_v1alpha1_Instance(a.(*v1beta1.Instance), b.(*Instance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Interface)(nil), (*v1beta1.Interface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Interface_To_v1beta1_Interface(a.(*Interface), b.(*v1beta1.Interface), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Interface)(nil), (*Interface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Interface_To_v1alpha1_Interface(a.(*v1beta1.Interface), b.(*Interface), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InterfaceBindingMethod)(nil), (*v1beta1.InterfaceBindingMethod)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InterfaceBindingMethod_To_v1beta1_InterfaceBindingMethod(a.(*InterfaceBindingMethod), b.(*v1beta1.InterfaceBindingMethod), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.InterfaceBindingMethod)(nil), (*InterfaceBindingMethod)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_InterfaceBindingMethod_To_v1alpha1_InterfaceBindingMethod(a.(*v1beta1.InterfaceBindingMethod), b.(*InterfaceBindingMethod), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InterfaceBridge)(nil), (*v1beta1.InterfaceBridge)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InterfaceBridge_To_v1beta1_InterfaceBridge(a.(*InterfaceBridge), b.(*v1beta1.InterfaceBridge), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.InterfaceBridge)(nil), (*InterfaceBridge)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_InterfaceBridge_To_v1alpha1_InterfaceBridge(a.(*v1beta1.InterfaceBridge), b.(*InterfaceBridge), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InterfaceDHCPOptions)(nil), (*v1beta1.InterfaceDHCPOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InterfaceDHCPOptions_To_v1beta1_InterfaceDHCPOptions(a.(*InterfaceDHCPOptions), b.(*v1beta1.InterfaceDHCPOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.InterfaceDHCPOptions)(nil), (*InterfaceDHCPOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_InterfaceDHCPOptions_To_v1alpha1_InterfaceDHCPOptions(a.(*v1beta1.InterfaceDHCPOptions), b.(*InterfaceDHCPOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InterfaceMasquerade)(nil), (*v1beta1.InterfaceMasquerade)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InterfaceMasquerade_To_v1beta1_InterfaceMasquerade(a.(*InterfaceMasquerade), b.(*v1beta1.InterfaceMasquerade), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.InterfaceMasquerade)(nil), (*InterfaceMasquerade)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_InterfaceMasquerade_To_v1alpha1_InterfaceMasquerade(a.(*v1beta1.InterfaceMasquerade), b.(*InterfaceMasquerade), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InterfaceOVS)(nil), (*v1beta1.InterfaceOVS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InterfaceOVS_To_v1beta1_InterfaceOVS(a.(*InterfaceOVS), b.(*v1beta1.InterfaceOVS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.InterfaceOVS)(nil), (*InterfaceOVS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_InterfaceOVS_To_v1alpha1_InterfaceOVS(a.(*v1beta1.InterfaceOVS), b.(*InterfaceOVS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InterfaceOffloads)(nil), (*v1beta1.InterfaceOffloads)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InterfaceOffloads_To_v1beta1_InterfaceOffloads(a.(*InterfaceOffloads), b.(*v1beta1.InterfaceOffloads), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.InterfaceOffloads)(nil), (*InterfaceOffloads)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_InterfaceOffloads_To_v1alpha1_InterfaceOffloads(a.(*v1beta1.InterfaceOffloads), b.(*InterfaceOffloads), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InterfaceRateLimit)(nil), (*v1beta1.InterfaceRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InterfaceRateLimit_To_v1beta1_InterfaceRateLimit(a.(*InterfaceRateLimit), b.(*v1beta1.InterfaceRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.InterfaceRateLimit)(nil), (*InterfaceRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_InterfaceRateLimit_To_v1alpha1_InterfaceRateLimit(a.(*v1beta1.InterfaceRateLimit), b.(*InterfaceRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InterfaceRoute)(nil), (*v1beta1.InterfaceRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InterfaceRoute_To_v1beta1_InterfaceRoute(a.(*InterfaceRoute), b.(*v1beta1.InterfaceRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.InterfaceRoute)(nil), (*InterfaceRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_InterfaceRoute_To_v1alpha1_InterfaceRoute(a.(*v1beta1.InterfaceRoute), b.(*InterfaceRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InterfaceSRIOV)(nil), (*v1beta1.InterfaceSRIOV)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InterfaceSRIOV_To_v1beta1_InterfaceSRIOV(a.(*InterfaceSRIOV), b.(*v1beta1.InterfaceSRIOV), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.InterfaceSRIOV)(nil), (*InterfaceSRIOV)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_InterfaceSRIOV_To_v1alpha1_InterfaceSRIOV(a.(*v1beta1.InterfaceSRIOV), b.(*InterfaceSRIOV), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InterfaceVLAN)(nil), (*v1beta1.InterfaceVLAN)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InterfaceVLAN_To_v1beta1_InterfaceVLAN(a.(*InterfaceVLAN), b.(*v1beta1.InterfaceVLAN), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.InterfaceVLAN)(nil), (*InterfaceVLAN)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_InterfaceVLAN_To_v1alpha1_InterfaceVLAN(a.(*v1beta1.InterfaceVLAN), b.(*InterfaceVLAN), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InterfaceVhostUser)(nil), (*v1beta1.InterfaceVhostUser)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InterfaceVhostUser_To_v1beta1_InterfaceVhostUser(a.(*InterfaceVhostUser), b.(*v1beta1.InterfaceVhostUser), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.InterfaceVhostUser)(nil), (*InterfaceVhostUser)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_InterfaceVhostUser_To_v1alpha1_InterfaceVhostUser(a.(*v1beta1.InterfaceVhostUser), b.(*InterfaceVhostUser), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Kernel)(nil), (*v1beta1.Kernel)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Kernel_To_v1beta1_Kernel(a.(*Kernel), b.(*v1beta1.Kernel), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Kernel)(nil), (*Kernel)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Kernel_To_v1alpha1_Kernel(a.(*v1beta1.Kernel), b.(*Kernel), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Memory)(nil), (*v1beta1.Memory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Memory_To_v1beta1_Memory(a.(*Memory), b.(*v1beta1.Memory), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Memory)(nil), (*Memory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Memory_To_v1alpha1_Memory(a.(*v1beta1.Memory), b.(*Memory), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MemoryBalloon)(nil), (*v1beta1.MemoryBalloon)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MemoryBalloon_To_v1beta1_MemoryBalloon(a.(*MemoryBalloon), b.(*v1beta1.MemoryBalloon), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.MemoryBalloon)(nil), (*MemoryBalloon)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MemoryBalloon_To_v1alpha1_MemoryBalloon(a.(*v1beta1.MemoryBalloon), b.(*MemoryBalloon), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MemoryDump)(nil), (*v1beta1.MemoryDump)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MemoryDump_To_v1beta1_MemoryDump(a.(*MemoryDump), b.(*v1beta1.MemoryDump), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.MemoryDump)(nil), (*MemoryDump)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MemoryDump_To_v1alpha1_MemoryDump(a.(*v1beta1.MemoryDump), b.(*MemoryDump), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MemorySwap)(nil), (*v1beta1.MemorySwap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MemorySwap_To_v1beta1_MemorySwap(a.(*MemorySwap), b.(*v1beta1.MemorySwap), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.MemorySwap)(nil), (*MemorySwap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MemorySwap_To_v1alpha1_MemorySwap(a.(*v1beta1.MemorySwap), b.(*MemorySwap), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetadataService)(nil), (*v1beta1.MetadataService)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MetadataService_To_v1beta1_MetadataService(a.(*MetadataService), b.(*v1beta1.MetadataService), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.MetadataService)(nil), (*MetadataService)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MetadataService_To_v1alpha1_MetadataService(a.(*v1beta1.MetadataService), b.(*MetadataService), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultusNetworkSource)(nil), (*v1beta1.MultusNetworkSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MultusNetworkSource_To_v1beta1_MultusNetworkSource(a.(*MultusNetworkSource), b.(*v1beta1.MultusNetworkSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.MultusNetworkSource)(nil), (*MultusNetworkSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MultusNetworkSource_To_v1alpha1_MultusNetworkSource(a.(*v1beta1.MultusNetworkSource), b.(*MultusNetworkSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Network)(nil), (*v1beta1.Network)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Network_To_v1beta1_Network(a.(*Network), b.(*v1beta1.Network), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Network)(nil), (*Network)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Network_To_v1alpha1_Network(a.(*v1beta1.Network), b.(*Network), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkSource)(nil), (*v1beta1.NetworkSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkSource_To_v1beta1_NetworkSource(a.(*NetworkSource), b.(*v1beta1.NetworkSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.NetworkSource)(nil), (*NetworkSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NetworkSource_To_v1alpha1_NetworkSource(a.(*v1beta1.NetworkSource), b.(*NetworkSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PersistentVolumeClaimPopulateSource)(nil), (*v1beta1.PersistentVolumeClaimPopulateSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PersistentVolumeClaimPopulateSource_To_v1beta1_PersistentVolumeClaimPopulateSource(a.(*PersistentVolumeClaimPopulateSource), b.(*v1beta1.PersistentVolumeClaimPopulateSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.PersistentVolumeClaimPopulateSource)(nil), (*PersistentVolumeClaimPopulateSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PersistentVolumeClaimPopulateSource_To_v1alpha1_PersistentVolumeClaimPopulateSource(a.(*v1beta1.PersistentVolumeClaimPopulateSource), b.(*PersistentVolumeClaimPopulateSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PersistentVolumeClaimVolumeSource)(nil), (*v1beta1.PersistentVolumeClaimVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PersistentVolumeClaimVolumeSource_To_v1beta1_PersistentVolumeClaimVolumeSource(a.(*PersistentVolumeClaimVolumeSource), b.(*v1beta1.PersistentVolumeClaimVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.PersistentVolumeClaimVolumeSource)(nil), (*PersistentVolumeClaimVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PersistentVolumeClaimVolumeSource_To_v1alpha1_PersistentVolumeClaimVolumeSource(a.(*v1beta1.PersistentVolumeClaimVolumeSource), b.(*PersistentVolumeClaimVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PodNetworkSource)(nil), (*v1beta1.PodNetworkSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodNetworkSource_To_v1beta1_PodNetworkSource(a.(*PodNetworkSource), b.(*v1beta1.PodNetworkSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.PodNetworkSource)(nil), (*PodNetworkSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PodNetworkSource_To_v1alpha1_PodNetworkSource(a.(*v1beta1.PodNetworkSource), b.(*PodNetworkSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RNG)(nil), (*v1beta1.RNG)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RNG_To_v1beta1_RNG(a.(*RNG), b.(*v1beta1.RNG), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.RNG)(nil), (*RNG)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RNG_To_v1alpha1_RNG(a.(*v1beta1.RNG), b.(*RNG), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Realtime)(nil), (*v1beta1.Realtime)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Realtime_To_v1beta1_Realtime(a.(*Realtime), b.(*v1beta1.Realtime), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Realtime)(nil), (*Realtime)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Realtime_To_v1alpha1_Realtime(a.(*v1beta1.Realtime), b.(*Realtime), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SSHPublicKey)(nil), (*v1beta1.SSHPublicKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SSHPublicKey_To_v1beta1_SSHPublicKey(a.(*SSHPublicKey), b.(*v1beta1.SSHPublicKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.SSHPublicKey)(nil), (*SSHPublicKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SSHPublicKey_To_v1alpha1_SSHPublicKey(a.(*v1beta1.SSHPublicKey), b.(*SSHPublicKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Schedule)(nil), (*v1beta1.Schedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Schedule_To_v1beta1_Schedule(a.(*Schedule), b.(*v1beta1.Schedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Schedule)(nil), (*Schedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Schedule_To_v1alpha1_Schedule(a.(*v1beta1.Schedule), b.(*Schedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretVolumeSource)(nil), (*v1beta1.SecretVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretVolumeSource_To_v1beta1_SecretVolumeSource(a.(*SecretVolumeSource), b.(*v1beta1.SecretVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.SecretVolumeSource)(nil), (*SecretVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecretVolumeSource_To_v1alpha1_SecretVolumeSource(a.(*v1beta1.SecretVolumeSource), b.(*SecretVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ServiceAccountTokenVolumeSource)(nil), (*v1beta1.ServiceAccountTokenVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServiceAccountTokenVolumeSource_To_v1beta1_ServiceAccountTokenVolumeSource(a.(*ServiceAccountTokenVolumeSource), b.(*v1beta1.ServiceAccountTokenVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.ServiceAccountTokenVolumeSource)(nil), (*ServiceAccountTokenVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ServiceAccountTokenVolumeSource_To_v1alpha1_ServiceAccountTokenVolumeSource(a.(*v1beta1.ServiceAccountTokenVolumeSource), b.(*ServiceAccountTokenVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Sound)(nil), (*v1beta1.Sound)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Sound_To_v1beta1_Sound(a.(*Sound), b.(*v1beta1.Sound), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Sound)(nil), (*Sound)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Sound_To_v1alpha1_Sound(a.(*v1beta1.Sound), b.(*Sound), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SysprepVolumeSource)(nil), (*v1beta1.SysprepVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SysprepVolumeSource_To_v1beta1_SysprepVolumeSource(a.(*SysprepVolumeSource), b.(*v1beta1.SysprepVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.SysprepVolumeSource)(nil), (*SysprepVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SysprepVolumeSource_To_v1alpha1_SysprepVolumeSource(a.(*v1beta1.SysprepVolumeSource), b.(*SysprepVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Tablet)(nil), (*v1beta1.Tablet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Tablet_To_v1beta1_Tablet(a.(*Tablet), b.(*v1beta1.Tablet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Tablet)(nil), (*Tablet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Tablet_To_v1alpha1_Tablet(a.(*v1beta1.Tablet), b.(*Tablet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*USBController)(nil), (*v1beta1.USBController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_USBController_To_v1beta1_USBController(a.(*USBController), b.(*v1beta1.USBController), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.USBController)(nil), (*USBController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_USBController_To_v1alpha1_USBController(a.(*v1beta1.USBController), b.(*USBController), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VLANRange)(nil), (*v1beta1.VLANRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VLANRange_To_v1beta1_VLANRange(a.(*VLANRange), b.(*v1beta1.VLANRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VLANRange)(nil), (*VLANRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VLANRange_To_v1alpha1_VLANRange(a.(*v1beta1.VLANRange), b.(*VLANRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualMachine)(nil), (*v1beta1.VirtualMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachine_To_v1beta1_VirtualMachine(a.(*VirtualMachine), b.(*v1beta1.VirtualMachine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VirtualMachine)(nil), (*VirtualMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachine_To_v1alpha1_VirtualMachine(a.(*v1beta1.VirtualMachine), b.(*VirtualMachine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualMachineAddress)(nil), (*v1beta1.VirtualMachineAddress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineAddress_To_v1beta1_VirtualMachineAddress(a.(*VirtualMachineAddress), b.(*v1beta1.VirtualMachineAddress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VirtualMachineAddress)(nil), (*VirtualMachineAddress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineAddress_To_v1alpha1_VirtualMachineAddress(a.(*v1beta1.VirtualMachineAddress), b.(*VirtualMachineAddress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualMachineExport)(nil), (*v1beta1.VirtualMachineExport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineExport_To_v1beta1_VirtualMachineExport(a.(*VirtualMachineExport), b.(*v1beta1.VirtualMachineExport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VirtualMachineExport)(nil), (*VirtualMachineExport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineExport_To_v1alpha1_VirtualMachineExport(a.(*v1beta1.VirtualMachineExport), b.(*VirtualMachineExport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualMachineExportHTTPTarget)(nil), (*v1beta1.VirtualMachineExportHTTPTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineExportHTTPTarget_To_v1beta1_VirtualMachineExportHTTPTarget(a.(*VirtualMachineExportHTTPTarget), b.(*v1beta1.VirtualMachineExportHTTPTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VirtualMachineExportHTTPTarget)(nil), (*VirtualMachineExportHTTPTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineExportHTTPTarget_To_v1alpha1_VirtualMachineExportHTTPTarget(a.(*v1beta1.VirtualMachineExportHTTPTarget), b.(*VirtualMachineExportHTTPTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualMachineExportList)(nil), (*v1beta1.VirtualMachineExportList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineExportList_To_v1beta1_VirtualMachineExportList(a.(*VirtualMachineExportList), b.(*v1beta1.VirtualMachineExportList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VirtualMachineExportList)(nil), (*VirtualMachineExportList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineExportList_To_v1alpha1_VirtualMachineExportList(a.(*v1beta1.VirtualMachineExportList), b.(*VirtualMachineExportList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualMachineExportOCITarget)(nil), (*v1beta1.VirtualMachineExportOCITarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineExportOCITarget_To_v1beta1_VirtualMachineExportOCITarget(a.(*VirtualMachineExportOCITarget), b.(*v1beta1.VirtualMachineExportOCITarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VirtualMachineExportOCITarget)(nil), (*VirtualMachineExportOCITarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineExportOCITarget_To_v1alpha1_VirtualMachineExportOCITarget(a.(*v1beta1.VirtualMachineExportOCITarget), b.(*VirtualMachineExportOCITarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualMachineExportStatus)(nil), (*v1beta1.VirtualMachineExportStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineExportStatus_To_v1beta1_VirtualMachineExportStatus(a.(*VirtualMachineExportStatus), b.(*v1beta1.VirtualMachineExportStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VirtualMachineExportStatus)(nil), (*VirtualMachineExportStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineExportStatus_To_v1alpha1_VirtualMachineExportStatus(a.(*v1beta1.VirtualMachineExportStatus), b.(*VirtualMachineExportStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualMachineExportTarget)(nil), (*v1beta1.VirtualMachineExportTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineExportTarget_To_v1beta1_VirtualMachineExportTarget(a.(*VirtualMachineExportTarget), b.(*v1beta1.VirtualMachineExportTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VirtualMachineExportTarget)(nil), (*VirtualMachineExportTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineExportTarget_To_v1alpha1_VirtualMachineExportTarget(a.(*v1beta1.VirtualMachineExportTarget), b.(*VirtualMachineExportTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualMachineList)(nil), (*v1beta1.VirtualMachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineList_To_v1beta1_VirtualMachineList(a.(*VirtualMachineList), b.(*v1beta1.VirtualMachineList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VirtualMachineList)(nil), (*VirtualMachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineList_To_v1alpha1_VirtualMachineList(a.(*v1beta1.VirtualMachineList), b.(*VirtualMachineList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualMachineMigration)(nil), (*v1beta1.VirtualMachineMigration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineMigration_To_v1beta1_VirtualMachineMigration(a.(*VirtualMachineMigration), b.(*v1beta1.VirtualMachineMigration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VirtualMachineMigration)(nil), (*VirtualMachineMigration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineMigration_To_v1alpha1_VirtualMachineMigration(a.(*v1beta1.VirtualMachineMigration), b.(*VirtualMachineMigration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualMachineMigrationList)(nil), (*v1beta1.VirtualMachineMigrationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineMigrationList_To_v1beta1_VirtualMachineMigrationList(a.(*VirtualMachineMigrationList), b.(*v1beta1.VirtualMachineMigrationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VirtualMachineMigrationList)(nil), (*VirtualMachineMigrationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineMigrationList_To_v1alpha1_VirtualMachineMigrationList(a.(*v1beta1.VirtualMachineMigrationList), b.(*VirtualMachineMigrationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualMachineMigrationStatus)(nil), (*v1beta1.VirtualMachineMigrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineMigrationStatus_To_v1beta1_VirtualMachineMigrationStatus(a.(*VirtualMachineMigrationStatus), b.(*v1beta1.VirtualMachineMigrationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VirtualMachineMigrationStatus)(nil), (*VirtualMachineMigrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineMigrationStatus_To_v1alpha1_VirtualMachineMigrationStatus(a.(*v1beta1.VirtualMachineMigrationStatus), b.(*VirtualMachineMigrationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualMachineSpec)(nil), (*v1beta1.VirtualMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineSpec_To_v1beta1_VirtualMachineSpec(a.(*VirtualMachineSpec), b.(*v1beta1.VirtualMachineSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VirtualMachineSpec)(nil), (*VirtualMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineSpec_To_v1alpha1_VirtualMachineSpec(a.(*v1beta1.VirtualMachineSpec), b.(*VirtualMachineSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualMachineStatusHibernation)(nil), (*v1beta1.VirtualMachineStatusHibernation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineStatusHibernation_To_v1beta1_VirtualMachineStatusHibernation(a.(*VirtualMachineStatusHibernation), b.(*v1beta1.VirtualMachineStatusHibernation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VirtualMachineStatusHibernation)(nil), (*VirtualMachineStatusHibernation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineStatusHibernation_To_v1alpha1_VirtualMachineStatusHibernation(a.(*v1beta1.VirtualMachineStatusHibernation), b.(*VirtualMachineStatusHibernation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualMachineStatusMemoryDump)(nil), (*v1beta1.VirtualMachineStatusMemoryDump)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineStatusMemoryDump_To_v1beta1_VirtualMachineStatusMemoryDump(a.(*VirtualMachineStatusMemoryDump), b.(*v1beta1.VirtualMachineStatusMemoryDump), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VirtualMachineStatusMemoryDump)(nil), (*VirtualMachineStatusMemoryDump)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineStatusMemoryDump_To_v1alpha1_VirtualMachineStatusMemoryDump(a.(*v1beta1.VirtualMachineStatusMemoryDump), b.(*VirtualMachineStatusMemoryDump), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualMachineStatusMigrationVolume)(nil), (*v1beta1.VirtualMachineStatusMigrationVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineStatusMigrationVolume_To_v1beta1_VirtualMachineStatusMigrationVolume(a.(*VirtualMachineStatusMigrationVolume), b.(*v1beta1.VirtualMachineStatusMigrationVolume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VirtualMachineStatusMigrationVolume)(nil), (*VirtualMachineStatusMigrationVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineStatusMigrationVolume_To_v1alpha1_VirtualMachineStatusMigrationVolume(a.(*v1beta1.VirtualMachineStatusMigrationVolume), b.(*VirtualMachineStatusMigrationVolume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualMachineStatusSchedule)(nil), (*v1beta1.VirtualMachineStatusSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineStatusSchedule_To_v1beta1_VirtualMachineStatusSchedule(a.(*VirtualMachineStatusSchedule), b.(*v1beta1.VirtualMachineStatusSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VirtualMachineStatusSchedule)(nil), (*VirtualMachineStatusSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineStatusSchedule_To_v1alpha1_VirtualMachineStatusSchedule(a.(*v1beta1.VirtualMachineStatusSchedule), b.(*VirtualMachineStatusSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Volume)(nil), (*v1beta1.Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Volume_To_v1beta1_Volume(a.(*Volume), b.(*v1beta1.Volume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Volume)(nil), (*Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Volume_To_v1alpha1_Volume(a.(*v1beta1.Volume), b.(*Volume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VolumeSource)(nil), (*v1beta1.VolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSource_To_v1beta1_VolumeSource(a.(*VolumeSource), b.(*v1beta1.VolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.VolumeSource)(nil), (*VolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VolumeSource_To_v1alpha1_VolumeSource(a.(*v1beta1.VolumeSource), b.(*VolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Vsock)(nil), (*v1beta1.Vsock)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Vsock_To_v1beta1_Vsock(a.(*Vsock), b.(*v1beta1.Vsock), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Vsock)(nil), (*Vsock)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Vsock_To_v1alpha1_Vsock(a.(*v1beta1.Vsock), b.(*Vsock), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Watchdog)(nil), (*v1beta1.Watchdog)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Watchdog_To_v1beta1_Watchdog(a.(*Watchdog), b.(*v1beta1.Watchdog), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Watchdog)(nil), (*Watchdog)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Watchdog_To_v1alpha1_Watchdog(a.(*v1beta1.Watchdog), b.(*Watchdog), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*DataVolumeVolumeSource)(nil), (*v1beta1.DataVolumeVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DataVolumeVolumeSource_To_v1beta1_DataVolumeVolumeSource(a.(*DataVolumeVolumeSource), b.(*v1beta1.DataVolumeVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*VirtualMachineExportSpec)(nil), (*v1beta1.VirtualMachineExportSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineExportSpec_To_v1beta1_VirtualMachineExportSpec(a.(*VirtualMachineExportSpec), b.(*v1beta1.VirtualMachineExportSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*VirtualMachineMigrationSpec)(nil), (*v1beta1.VirtualMachineMigrationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineMigrationSpec_To_v1beta1_VirtualMachineMigrationSpec(a.(*VirtualMachineMigrationSpec), b.(*v1beta1.VirtualMachineMigrationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*VirtualMachineStatusMigration)(nil), (*v1beta1.VirtualMachineStatusMigration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineStatusMigration_To_v1beta1_VirtualMachineStatusMigration(a.(*VirtualMachineStatusMigration), b.(*v1beta1.VirtualMachineStatusMigration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*VirtualMachineStatus)(nil), (*v1beta1.VirtualMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualMachineStatus_To_v1beta1_VirtualMachineStatus(a.(*VirtualMachineStatus), b.(*v1beta1.VirtualMachineStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.DataVolumeVolumeSource)(nil), (*DataVolumeVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DataVolumeVolumeSource_To_v1alpha1_DataVolumeVolumeSource(a.(*v1beta1.DataVolumeVolumeSource), b.(*DataVolumeVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.VirtualMachineExportSpec)(nil), (*VirtualMachineExportSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineExportSpec_To_v1alpha1_VirtualMachineExportSpec(a.(*v1beta1.VirtualMachineExportSpec), b.(*VirtualMachineExportSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.VirtualMachineMigrationSpec)(nil), (*VirtualMachineMigrationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineMigrationSpec_To_v1alpha1_VirtualMachineMigrationSpec(a.(*v1beta1.VirtualMachineMigrationSpec), b.(*VirtualMachineMigrationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.VirtualMachineStatusMigration)(nil), (*VirtualMachineStatusMigration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineStatusMigration_To_v1alpha1_VirtualMachineStatusMigration(a.(*v1beta1.VirtualMachineStatusMigration), b.(*VirtualMachineStatusMigration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.VirtualMachineStatus)(nil), (*VirtualMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineStatus_To_v1alpha1_VirtualMachineStatus(a.(*v1beta1.VirtualMachineStatus), b.(*VirtualMachineStatus), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_BandwidthLimit_To_v1beta1_BandwidthLimit(in *BandwidthLimit, out *v1beta1.BandwidthLimit, s conversion.Scope) error {
+	out.Bandwidth = in.Bandwidth
+	out.Burst = (*resource.Quantity)(unsafe.Pointer(in.Burst))
+	return nil
+}
+
+// Convert_v1alpha1_BandwidthLimit_To_v1beta1_BandwidthLimit is an autogenerated conversion function.
+func Convert_v1alpha1_BandwidthLimit_To_v1beta1_BandwidthLimit(in *BandwidthLimit, out *v1beta1.BandwidthLimit, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BandwidthLimit_To_v1beta1_BandwidthLimit(in, out, s)
+}
+
+func autoConvert_v1beta1_BandwidthLimit_To_v1alpha1_BandwidthLimit(in *v1beta1.BandwidthLimit, out *BandwidthLimit, s conversion.Scope) error {
+	out.Bandwidth = in.Bandwidth
+	out.Burst = (*resource.Quantity)(unsafe.Pointer(in.Burst))
+	return nil
+}
+
+// Convert_v1beta1_BandwidthLimit_To_v1alpha1_BandwidthLimit is an autogenerated conversion function.
+func Convert_v1beta1_BandwidthLimit_To_v1alpha1_BandwidthLimit(in *v1beta1.BandwidthLimit, out *BandwidthLimit, s conversion.Scope) error {
+	return autoConvert_v1beta1_BandwidthLimit_To_v1alpha1_BandwidthLimit(in, out, s)
+}
+
+func autoConvert_v1alpha1_Bond_To_v1beta1_Bond(in *Bond, out *v1beta1.Bond, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Interfaces = *(*[]string)(unsafe.Pointer(&in.Interfaces))
+	out.Mode = v1beta1.BondMode(in.Mode)
+	out.Primary = in.Primary
+	out.MIIMonitorInterval = in.MIIMonitorInterval
+	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	out.Routes = *(*[]v1beta1.InterfaceRoute)(unsafe.Pointer(&in.Routes))
+	return nil
+}
+
+// Convert_v1alpha1_Bond_To_v1beta1_Bond is an autogenerated conversion function.
+func Convert_v1alpha1_Bond_To_v1beta1_Bond(in *Bond, out *v1beta1.Bond, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Bond_To_v1beta1_Bond(in, out, s)
+}
+
+func autoConvert_v1beta1_Bond_To_v1alpha1_Bond(in *v1beta1.Bond, out *Bond, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Interfaces = *(*[]string)(unsafe.Pointer(&in.Interfaces))
+	out.Mode = BondMode(in.Mode)
+	out.Primary = in.Primary
+	out.MIIMonitorInterval = in.MIIMonitorInterval
+	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	out.Routes = *(*[]InterfaceRoute)(unsafe.Pointer(&in.Routes))
+	return nil
+}
+
+// Convert_v1beta1_Bond_To_v1alpha1_Bond is an autogenerated conversion function.
+func Convert_v1beta1_Bond_To_v1alpha1_Bond(in *v1beta1.Bond, out *Bond, s conversion.Scope) error {
+	return autoConvert_v1beta1_Bond_To_v1alpha1_Bond(in, out, s)
+}
+
+func autoConvert_v1alpha1_CPU_To_v1beta1_CPU(in *CPU, out *v1beta1.CPU, s conversion.Scope) error {
+	out.Sockets = in.Sockets
+	out.CoresPerSocket = in.CoresPerSocket
+	out.DedicatedCPUPlacement = in.DedicatedCPUPlacement
+	return nil
+}
+
+// Convert_v1alpha1_CPU_To_v1beta1_CPU is an autogenerated conversion function.
+func Convert_v1alpha1_CPU_To_v1beta1_CPU(in *CPU, out *v1beta1.CPU, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CPU_To_v1beta1_CPU(in, out, s)
+}
+
+func autoConvert_v1beta1_CPU_To_v1alpha1_CPU(in *v1beta1.CPU, out *CPU, s conversion.Scope) error {
+	out.Sockets = in.Sockets
+	out.CoresPerSocket = in.CoresPerSocket
+	out.DedicatedCPUPlacement = in.DedicatedCPUPlacement
+	return nil
+}
+
+// Convert_v1beta1_CPU_To_v1alpha1_CPU is an autogenerated conversion function.
+func Convert_v1beta1_CPU_To_v1alpha1_CPU(in *v1beta1.CPU, out *CPU, s conversion.Scope) error {
+	return autoConvert_v1beta1_CPU_To_v1alpha1_CPU(in, out, s)
+}
+
+func autoConvert_v1alpha1_Clock_To_v1beta1_Clock(in *Clock, out *v1beta1.Clock, s conversion.Scope) error {
+	out.Offset = v1beta1.ClockOffset(in.Offset)
+	out.Timezone = in.Timezone
+	out.DisableKVMClock = in.DisableKVMClock
+	out.Sync = (*v1beta1.ClockSync)(unsafe.Pointer(in.Sync))
+	return nil
+}
+
+// Convert_v1alpha1_Clock_To_v1beta1_Clock is an autogenerated conversion function.
+func Convert_v1alpha1_Clock_To_v1beta1_Clock(in *Clock, out *v1beta1.Clock, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Clock_To_v1beta1_Clock(in, out, s)
+}
+
+func autoConvert_v1beta1_Clock_To_v1alpha1_Clock(in *v1beta1.Clock, out *Clock, s conversion.Scope) error {
+	out.Offset = ClockOffset(in.Offset)
+	out.Timezone = in.Timezone
+	out.DisableKVMClock = in.DisableKVMClock
+	out.Sync = (*ClockSync)(unsafe.Pointer(in.Sync))
+	return nil
+}
+
+// Convert_v1beta1_Clock_To_v1alpha1_Clock is an autogenerated conversion function.
+func Convert_v1beta1_Clock_To_v1alpha1_Clock(in *v1beta1.Clock, out *Clock, s conversion.Scope) error {
+	return autoConvert_v1beta1_Clock_To_v1alpha1_Clock(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClockSync_To_v1beta1_ClockSync(in *ClockSync, out *v1beta1.ClockSync, s conversion.Scope) error {
+	out.GuestAgentPort = in.GuestAgentPort
+	return nil
+}
+
+// Convert_v1alpha1_ClockSync_To_v1beta1_ClockSync is an autogenerated conversion function.
+func Convert_v1alpha1_ClockSync_To_v1beta1_ClockSync(in *ClockSync, out *v1beta1.ClockSync, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClockSync_To_v1beta1_ClockSync(in, out, s)
+}
+
+func autoConvert_v1beta1_ClockSync_To_v1alpha1_ClockSync(in *v1beta1.ClockSync, out *ClockSync, s conversion.Scope) error {
+	out.GuestAgentPort = in.GuestAgentPort
+	return nil
+}
+
+// Convert_v1beta1_ClockSync_To_v1alpha1_ClockSync is an autogenerated conversion function.
+func Convert_v1beta1_ClockSync_To_v1alpha1_ClockSync(in *v1beta1.ClockSync, out *ClockSync, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClockSync_To_v1alpha1_ClockSync(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudInitVolumeSource_To_v1beta1_CloudInitVolumeSource(in *CloudInitVolumeSource, out *v1beta1.CloudInitVolumeSource, s conversion.Scope) error {
+	out.UserData = in.UserData
+	out.UserDataBase64 = in.UserDataBase64
+	out.UserDataSecretName = in.UserDataSecretName
+	out.NetworkData = in.NetworkData
+	out.NetworkDataBase64 = in.NetworkDataBase64
+	out.NetworkDataSecretName = in.NetworkDataSecretName
+	out.GenerateNetworkData = in.GenerateNetworkData
+	return nil
+}
+
+// Convert_v1alpha1_CloudInitVolumeSource_To_v1beta1_CloudInitVolumeSource is an autogenerated conversion function.
+func Convert_v1alpha1_CloudInitVolumeSource_To_v1beta1_CloudInitVolumeSource(in *CloudInitVolumeSource, out *v1beta1.CloudInitVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudInitVolumeSource_To_v1beta1_CloudInitVolumeSource(in, out, s)
+}
+
+func autoConvert_v1beta1_CloudInitVolumeSource_To_v1alpha1_CloudInitVolumeSource(in *v1beta1.CloudInitVolumeSource, out *CloudInitVolumeSource, s conversion.Scope) error {
+	out.UserData = in.UserData
+	out.UserDataBase64 = in.UserDataBase64
+	out.UserDataSecretName = in.UserDataSecretName
+	out.NetworkData = in.NetworkData
+	out.NetworkDataBase64 = in.NetworkDataBase64
+	out.NetworkDataSecretName = in.NetworkDataSecretName
+	out.GenerateNetworkData = in.GenerateNetworkData
+	return nil
+}
+
+// Convert_v1beta1_CloudInitVolumeSource_To_v1alpha1_CloudInitVolumeSource is an autogenerated conversion function.
+func Convert_v1beta1_CloudInitVolumeSource_To_v1alpha1_CloudInitVolumeSource(in *v1beta1.CloudInitVolumeSource, out *CloudInitVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_CloudInitVolumeSource_To_v1alpha1_CloudInitVolumeSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterAPIBootstrapVolumeSource_To_v1beta1_ClusterAPIBootstrapVolumeSource(in *ClusterAPIBootstrapVolumeSource, out *v1beta1.ClusterAPIBootstrapVolumeSource, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_v1alpha1_ClusterAPIBootstrapVolumeSource_To_v1beta1_ClusterAPIBootstrapVolumeSource is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterAPIBootstrapVolumeSource_To_v1beta1_ClusterAPIBootstrapVolumeSource(in *ClusterAPIBootstrapVolumeSource, out *v1beta1.ClusterAPIBootstrapVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterAPIBootstrapVolumeSource_To_v1beta1_ClusterAPIBootstrapVolumeSource(in, out, s)
+}
+
+func autoConvert_v1beta1_ClusterAPIBootstrapVolumeSource_To_v1alpha1_ClusterAPIBootstrapVolumeSource(in *v1beta1.ClusterAPIBootstrapVolumeSource, out *ClusterAPIBootstrapVolumeSource, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_v1beta1_ClusterAPIBootstrapVolumeSource_To_v1alpha1_ClusterAPIBootstrapVolumeSource is an autogenerated conversion function.
+func Convert_v1beta1_ClusterAPIBootstrapVolumeSource_To_v1alpha1_ClusterAPIBootstrapVolumeSource(in *v1beta1.ClusterAPIBootstrapVolumeSource, out *ClusterAPIBootstrapVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterAPIBootstrapVolumeSource_To_v1alpha1_ClusterAPIBootstrapVolumeSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_ConfigMapVolumeSource_To_v1beta1_ConfigMapVolumeSource(in *ConfigMapVolumeSource, out *v1beta1.ConfigMapVolumeSource, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Format = v1beta1.ConfigDiskFormat(in.Format)
+	out.VolumeLabel = in.VolumeLabel
+	return nil
+}
+
+// Convert_v1alpha1_ConfigMapVolumeSource_To_v1beta1_ConfigMapVolumeSource is an autogenerated conversion function.
+func Convert_v1alpha1_ConfigMapVolumeSource_To_v1beta1_ConfigMapVolumeSource(in *ConfigMapVolumeSource, out *v1beta1.ConfigMapVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ConfigMapVolumeSource_To_v1beta1_ConfigMapVolumeSource(in, out, s)
+}
+
+func autoConvert_v1beta1_ConfigMapVolumeSource_To_v1alpha1_ConfigMapVolumeSource(in *v1beta1.ConfigMapVolumeSource, out *ConfigMapVolumeSource, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Format = ConfigDiskFormat(in.Format)
+	out.VolumeLabel = in.VolumeLabel
+	return nil
+}
+
+// Convert_v1beta1_ConfigMapVolumeSource_To_v1alpha1_ConfigMapVolumeSource is an autogenerated conversion function.
+func Convert_v1beta1_ConfigMapVolumeSource_To_v1alpha1_ConfigMapVolumeSource(in *v1beta1.ConfigMapVolumeSource, out *ConfigMapVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_ConfigMapVolumeSource_To_v1alpha1_ConfigMapVolumeSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_ContainerDiskVolumeSource_To_v1beta1_ContainerDiskVolumeSource(in *ContainerDiskVolumeSource, out *v1beta1.ContainerDiskVolumeSource, s conversion.Scope) error {
+	out.Image = in.Image
+	out.ImagePullPolicy = v1.PullPolicy(in.ImagePullPolicy)
+	return nil
+}
+
+// Convert_v1alpha1_ContainerDiskVolumeSource_To_v1beta1_ContainerDiskVolumeSource is an autogenerated conversion function.
+func Convert_v1alpha1_ContainerDiskVolumeSource_To_v1beta1_ContainerDiskVolumeSource(in *ContainerDiskVolumeSource, out *v1beta1.ContainerDiskVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ContainerDiskVolumeSource_To_v1beta1_ContainerDiskVolumeSource(in, out, s)
+}
+
+func autoConvert_v1beta1_ContainerDiskVolumeSource_To_v1alpha1_ContainerDiskVolumeSource(in *v1beta1.ContainerDiskVolumeSource, out *ContainerDiskVolumeSource, s conversion.Scope) error {
+	out.Image = in.Image
+	out.ImagePullPolicy = v1.PullPolicy(in.ImagePullPolicy)
+	return nil
+}
+
+// Convert_v1beta1_ContainerDiskVolumeSource_To_v1alpha1_ContainerDiskVolumeSource is an autogenerated conversion function.
+func Convert_v1beta1_ContainerDiskVolumeSource_To_v1alpha1_ContainerDiskVolumeSource(in *v1beta1.ContainerDiskVolumeSource, out *ContainerDiskVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_ContainerDiskVolumeSource_To_v1alpha1_ContainerDiskVolumeSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_ContainerRootfsVolumeSource_To_v1beta1_ContainerRootfsVolumeSource(in *ContainerRootfsVolumeSource, out *v1beta1.ContainerRootfsVolumeSource, s conversion.Scope) error {
+	out.Image = in.Image
+	out.ImagePullPolicy = v1.PullPolicy(in.ImagePullPolicy)
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_v1alpha1_ContainerRootfsVolumeSource_To_v1beta1_ContainerRootfsVolumeSource is an autogenerated conversion function.
+func Convert_v1alpha1_ContainerRootfsVolumeSource_To_v1beta1_ContainerRootfsVolumeSource(in *ContainerRootfsVolumeSource, out *v1beta1.ContainerRootfsVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ContainerRootfsVolumeSource_To_v1beta1_ContainerRootfsVolumeSource(in, out, s)
+}
+
+func autoConvert_v1beta1_ContainerRootfsVolumeSource_To_v1alpha1_ContainerRootfsVolumeSource(in *v1beta1.ContainerRootfsVolumeSource, out *ContainerRootfsVolumeSource, s conversion.Scope) error {
+	out.Image = in.Image
+	out.ImagePullPolicy = v1.PullPolicy(in.ImagePullPolicy)
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_v1beta1_ContainerRootfsVolumeSource_To_v1alpha1_ContainerRootfsVolumeSource is an autogenerated conversion function.
+func Convert_v1beta1_ContainerRootfsVolumeSource_To_v1alpha1_ContainerRootfsVolumeSource(in *v1beta1.ContainerRootfsVolumeSource, out *ContainerRootfsVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_ContainerRootfsVolumeSource_To_v1alpha1_ContainerRootfsVolumeSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_DataVolumeVolumeSource_To_v1beta1_DataVolumeVolumeSource(in *DataVolumeVolumeSource, out *v1beta1.DataVolumeVolumeSource, s conversion.Scope) error {
+	// WARNING: in.VolumeName requires manual conversion: does not exist in peer-type
+	return nil
+}
+
+func autoConvert_v1beta1_DataVolumeVolumeSource_To_v1alpha1_DataVolumeVolumeSource(in *v1beta1.DataVolumeVolumeSource, out *DataVolumeVolumeSource, s conversion.Scope) error {
+	// WARNING: in.Name requires manual conversion: does not exist in peer-type
+	return nil
+}
+
+func autoConvert_v1alpha1_Devices_To_v1beta1_Devices(in *Devices, out *v1beta1.Devices, s conversion.Scope) error {
+	out.Display = (*v1beta1.Display)(unsafe.Pointer(in.Display))
+	out.USB = (*v1beta1.USBController)(unsafe.Pointer(in.USB))
+	out.Tablet = (*v1beta1.Tablet)(unsafe.Pointer(in.Tablet))
+	out.Sound = (*v1beta1.Sound)(unsafe.Pointer(in.Sound))
+	out.HostUSBDevices = *(*[]v1beta1.HostUSBDevice)(unsafe.Pointer(&in.HostUSBDevices))
+	return nil
+}
+
+// Convert_v1alpha1_Devices_To_v1beta1_Devices is an autogenerated conversion function.
+func Convert_v1alpha1_Devices_To_v1beta1_Devices(in *Devices, out *v1beta1.Devices, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Devices_To_v1beta1_Devices(in, out, s)
+}
+
+func autoConvert_v1beta1_Devices_To_v1alpha1_Devices(in *v1beta1.Devices, out *Devices, s conversion.Scope) error {
+	out.Display = (*Display)(unsafe.Pointer(in.Display))
+	out.USB = (*USBController)(unsafe.Pointer(in.USB))
+	out.Tablet = (*Tablet)(unsafe.Pointer(in.Tablet))
+	out.Sound = (*Sound)(unsafe.Pointer(in.Sound))
+	out.HostUSBDevices = *(*[]HostUSBDevice)(unsafe.Pointer(&in.HostUSBDevices))
+	return nil
+}
+
+// Convert_v1beta1_Devices_To_v1alpha1_Devices is an autogenerated conversion function.
+func Convert_v1beta1_Devices_To_v1alpha1_Devices(in *v1beta1.Devices, out *Devices, s conversion.Scope) error {
+	return autoConvert_v1beta1_Devices_To_v1alpha1_Devices(in, out, s)
+}
+
+func autoConvert_v1alpha1_Disk_To_v1beta1_Disk(in *Disk, out *v1beta1.Disk, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ReadOnly = (*bool)(unsafe.Pointer(in.ReadOnly))
+	out.RateLimit = (*v1beta1.DiskRateLimit)(unsafe.Pointer(in.RateLimit))
+	out.Queues = in.Queues
+	out.BootOrder = in.BootOrder
+	out.Bus = v1beta1.DiskBus(in.Bus)
+	out.IOEngine = v1beta1.DiskIOEngine(in.IOEngine)
+	out.Cache = v1beta1.DiskCache(in.Cache)
+	out.Discard = (*v1beta1.DiskDiscard)(unsafe.Pointer(in.Discard))
+	out.Encryption = (*v1beta1.DiskEncryption)(unsafe.Pointer(in.Encryption))
+	out.Shareable = in.Shareable
+	out.Type = v1beta1.DiskType(in.Type)
+	out.Medium = in.Medium
+	out.Ejected = in.Ejected
+	out.Ephemeral = in.Ephemeral
+	return nil
+}
+
+// Convert_v1alpha1_Disk_To_v1beta1_Disk is an autogenerated conversion function.
+func Convert_v1alpha1_Disk_To_v1beta1_Disk(in *Disk, out *v1beta1.Disk, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Disk_To_v1beta1_Disk(in, out, s)
+}
+
+func autoConvert_v1beta1_Disk_To_v1alpha1_Disk(in *v1beta1.Disk, out *Disk, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ReadOnly = (*bool)(unsafe.Pointer(in.ReadOnly))
+	out.RateLimit = (*DiskRateLimit)(unsafe.Pointer(in.RateLimit))
+	out.Queues = in.Queues
+	out.BootOrder = in.BootOrder
+	out.Bus = DiskBus(in.Bus)
+	out.IOEngine = DiskIOEngine(in.IOEngine)
+	out.Cache = DiskCache(in.Cache)
+	out.Discard = (*DiskDiscard)(unsafe.Pointer(in.Discard))
+	out.Encryption = (*DiskEncryption)(unsafe.Pointer(in.Encryption))
+	out.Shareable = in.Shareable
+	out.Type = DiskType(in.Type)
+	out.Medium = in.Medium
+	out.Ejected = in.Ejected
+	out.Ephemeral = in.Ephemeral
+	return nil
+}
+
+// Convert_v1beta1_Disk_To_v1alpha1_Disk is an autogenerated conversion function.
+func Convert_v1beta1_Disk_To_v1alpha1_Disk(in *v1beta1.Disk, out *Disk, s conversion.Scope) error {
+	return autoConvert_v1beta1_Disk_To_v1alpha1_Disk(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiskDiscard_To_v1beta1_DiskDiscard(in *DiskDiscard, out *v1beta1.DiskDiscard, s conversion.Scope) error {
+	out.DetectZeroes = in.DetectZeroes
+	return nil
+}
+
+// Convert_v1alpha1_DiskDiscard_To_v1beta1_DiskDiscard is an autogenerated conversion function.
+func Convert_v1alpha1_DiskDiscard_To_v1beta1_DiskDiscard(in *DiskDiscard, out *v1beta1.DiskDiscard, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskDiscard_To_v1beta1_DiskDiscard(in, out, s)
+}
+
+func autoConvert_v1beta1_DiskDiscard_To_v1alpha1_DiskDiscard(in *v1beta1.DiskDiscard, out *DiskDiscard, s conversion.Scope) error {
+	out.DetectZeroes = in.DetectZeroes
+	return nil
+}
+
+// Convert_v1beta1_DiskDiscard_To_v1alpha1_DiskDiscard is an autogenerated conversion function.
+func Convert_v1beta1_DiskDiscard_To_v1alpha1_DiskDiscard(in *v1beta1.DiskDiscard, out *DiskDiscard, s conversion.Scope) error {
+	return autoConvert_v1beta1_DiskDiscard_To_v1alpha1_DiskDiscard(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiskEncryption_To_v1beta1_DiskEncryption(in *DiskEncryption, out *v1beta1.DiskEncryption, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_v1alpha1_DiskEncryption_To_v1beta1_DiskEncryption is an autogenerated conversion function.
+func Convert_v1alpha1_DiskEncryption_To_v1beta1_DiskEncryption(in *DiskEncryption, out *v1beta1.DiskEncryption, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskEncryption_To_v1beta1_DiskEncryption(in, out, s)
+}
+
+func autoConvert_v1beta1_DiskEncryption_To_v1alpha1_DiskEncryption(in *v1beta1.DiskEncryption, out *DiskEncryption, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_v1beta1_DiskEncryption_To_v1alpha1_DiskEncryption is an autogenerated conversion function.
+func Convert_v1beta1_DiskEncryption_To_v1alpha1_DiskEncryption(in *v1beta1.DiskEncryption, out *DiskEncryption, s conversion.Scope) error {
+	return autoConvert_v1beta1_DiskEncryption_To_v1alpha1_DiskEncryption(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiskRateLimit_To_v1beta1_DiskRateLimit(in *DiskRateLimit, out *v1beta1.DiskRateLimit, s conversion.Scope) error {
+	out.Bandwidth = (*resource.Quantity)(unsafe.Pointer(in.Bandwidth))
+	out.BandwidthBurst = (*resource.Quantity)(unsafe.Pointer(in.BandwidthBurst))
+	out.IOPS = in.IOPS
+	out.IOPSBurst = in.IOPSBurst
+	return nil
+}
+
+// Convert_v1alpha1_DiskRateLimit_To_v1beta1_DiskRateLimit is an autogenerated conversion function.
+func Convert_v1alpha1_DiskRateLimit_To_v1beta1_DiskRateLimit(in *DiskRateLimit, out *v1beta1.DiskRateLimit, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskRateLimit_To_v1beta1_DiskRateLimit(in, out, s)
+}
+
+func autoConvert_v1beta1_DiskRateLimit_To_v1alpha1_DiskRateLimit(in *v1beta1.DiskRateLimit, out *DiskRateLimit, s conversion.Scope) error {
+	out.Bandwidth = (*resource.Quantity)(unsafe.Pointer(in.Bandwidth))
+	out.BandwidthBurst = (*resource.Quantity)(unsafe.Pointer(in.BandwidthBurst))
+	out.IOPS = in.IOPS
+	out.IOPSBurst = in.IOPSBurst
+	return nil
+}
+
+// Convert_v1beta1_DiskRateLimit_To_v1alpha1_DiskRateLimit is an autogenerated conversion function.
+func Convert_v1beta1_DiskRateLimit_To_v1alpha1_DiskRateLimit(in *v1beta1.DiskRateLimit, out *DiskRateLimit, s conversion.Scope) error {
+	return autoConvert_v1beta1_DiskRateLimit_To_v1alpha1_DiskRateLimit(in, out, s)
+}
+
+func autoConvert_v1alpha1_Display_To_v1beta1_Display(in *Display, out *v1beta1.Display, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha1_Display_To_v1beta1_Display is an autogenerated conversion function.
+func Convert_v1alpha1_Display_To_v1beta1_Display(in *Display, out *v1beta1.Display, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Display_To_v1beta1_Display(in, out, s)
+}
+
+func autoConvert_v1beta1_Display_To_v1alpha1_Display(in *v1beta1.Display, out *Display, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1beta1_Display_To_v1alpha1_Display is an autogenerated conversion function.
+func Convert_v1beta1_Display_To_v1alpha1_Display(in *v1beta1.Display, out *Display, s conversion.Scope) error {
+	return autoConvert_v1beta1_Display_To_v1alpha1_Display(in, out, s)
+}
+
+func autoConvert_v1alpha1_Downward_To_v1beta1_Downward(in *Downward, out *v1beta1.Downward, s conversion.Scope) error {
+	out.SMBIOS = in.SMBIOS
+	out.MetricsPort = in.MetricsPort
+	return nil
+}
+
+// Convert_v1alpha1_Downward_To_v1beta1_Downward is an autogenerated conversion function.
+func Convert_v1alpha1_Downward_To_v1beta1_Downward(in *Downward, out *v1beta1.Downward, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Downward_To_v1beta1_Downward(in, out, s)
+}
+
+func autoConvert_v1beta1_Downward_To_v1alpha1_Downward(in *v1beta1.Downward, out *Downward, s conversion.Scope) error {
+	out.SMBIOS = in.SMBIOS
+	out.MetricsPort = in.MetricsPort
+	return nil
+}
+
+// Convert_v1beta1_Downward_To_v1alpha1_Downward is an autogenerated conversion function.
+func Convert_v1beta1_Downward_To_v1alpha1_Downward(in *v1beta1.Downward, out *Downward, s conversion.Scope) error {
+	return autoConvert_v1beta1_Downward_To_v1alpha1_Downward(in, out, s)
+}
+
+func autoConvert_v1alpha1_EFIFirmware_To_v1beta1_EFIFirmware(in *EFIFirmware, out *v1beta1.EFIFirmware, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha1_EFIFirmware_To_v1beta1_EFIFirmware is an autogenerated conversion function.
+func Convert_v1alpha1_EFIFirmware_To_v1beta1_EFIFirmware(in *EFIFirmware, out *v1beta1.EFIFirmware, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EFIFirmware_To_v1beta1_EFIFirmware(in, out, s)
+}
+
+func autoConvert_v1beta1_EFIFirmware_To_v1alpha1_EFIFirmware(in *v1beta1.EFIFirmware, out *EFIFirmware, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1beta1_EFIFirmware_To_v1alpha1_EFIFirmware is an autogenerated conversion function.
+func Convert_v1beta1_EFIFirmware_To_v1alpha1_EFIFirmware(in *v1beta1.EFIFirmware, out *EFIFirmware, s conversion.Scope) error {
+	return autoConvert_v1beta1_EFIFirmware_To_v1alpha1_EFIFirmware(in, out, s)
+}
+
+func autoConvert_v1alpha1_EmptyDiskVolumeClaim_To_v1beta1_EmptyDiskVolumeClaim(in *EmptyDiskVolumeClaim, out *v1beta1.EmptyDiskVolumeClaim, s conversion.Scope) error {
+	out.StorageClassName = (*string)(unsafe.Pointer(in.StorageClassName))
+	return nil
+}
+
+// Convert_v1alpha1_EmptyDiskVolumeClaim_To_v1beta1_EmptyDiskVolumeClaim is an autogenerated conversion function.
+func Convert_v1alpha1_EmptyDiskVolumeClaim_To_v1beta1_EmptyDiskVolumeClaim(in *EmptyDiskVolumeClaim, out *v1beta1.EmptyDiskVolumeClaim, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EmptyDiskVolumeClaim_To_v1beta1_EmptyDiskVolumeClaim(in, out, s)
+}
+
+func autoConvert_v1beta1_EmptyDiskVolumeClaim_To_v1alpha1_EmptyDiskVolumeClaim(in *v1beta1.EmptyDiskVolumeClaim, out *EmptyDiskVolumeClaim, s conversion.Scope) error {
+	out.StorageClassName = (*string)(unsafe.Pointer(in.StorageClassName))
+	return nil
+}
+
+// Convert_v1beta1_EmptyDiskVolumeClaim_To_v1alpha1_EmptyDiskVolumeClaim is an autogenerated conversion function.
+func Convert_v1beta1_EmptyDiskVolumeClaim_To_v1alpha1_EmptyDiskVolumeClaim(in *v1beta1.EmptyDiskVolumeClaim, out *EmptyDiskVolumeClaim, s conversion.Scope) error {
+	return autoConvert_v1beta1_EmptyDiskVolumeClaim_To_v1alpha1_EmptyDiskVolumeClaim(in, out, s)
+}
+
+func autoConvert_v1alpha1_EmptyDiskVolumeSource_To_v1beta1_EmptyDiskVolumeSource(in *EmptyDiskVolumeSource, out *v1beta1.EmptyDiskVolumeSource, s conversion.Scope) error {
+	out.Capacity = in.Capacity
+	out.VolumeClaim = (*v1beta1.EmptyDiskVolumeClaim)(unsafe.Pointer(in.VolumeClaim))
+	return nil
+}
+
+// Convert_v1alpha1_EmptyDiskVolumeSource_To_v1beta1_EmptyDiskVolumeSource is an autogenerated conversion function.
+func Convert_v1alpha1_EmptyDiskVolumeSource_To_v1beta1_EmptyDiskVolumeSource(in *EmptyDiskVolumeSource, out *v1beta1.EmptyDiskVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EmptyDiskVolumeSource_To_v1beta1_EmptyDiskVolumeSource(in, out, s)
+}
+
+func autoConvert_v1beta1_EmptyDiskVolumeSource_To_v1alpha1_EmptyDiskVolumeSource(in *v1beta1.EmptyDiskVolumeSource, out *EmptyDiskVolumeSource, s conversion.Scope) error {
+	out.Capacity = in.Capacity
+	out.VolumeClaim = (*EmptyDiskVolumeClaim)(unsafe.Pointer(in.VolumeClaim))
+	return nil
+}
+
+// Convert_v1beta1_EmptyDiskVolumeSource_To_v1alpha1_EmptyDiskVolumeSource is an autogenerated conversion function.
+func Convert_v1beta1_EmptyDiskVolumeSource_To_v1alpha1_EmptyDiskVolumeSource(in *v1beta1.EmptyDiskVolumeSource, out *EmptyDiskVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_EmptyDiskVolumeSource_To_v1alpha1_EmptyDiskVolumeSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_FileSystem_To_v1beta1_FileSystem(in *FileSystem, out *v1beta1.FileSystem, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_FileSystem_To_v1beta1_FileSystem is an autogenerated conversion function.
+func Convert_v1alpha1_FileSystem_To_v1beta1_FileSystem(in *FileSystem, out *v1beta1.FileSystem, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FileSystem_To_v1beta1_FileSystem(in, out, s)
+}
+
+func autoConvert_v1beta1_FileSystem_To_v1alpha1_FileSystem(in *v1beta1.FileSystem, out *FileSystem, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_FileSystem_To_v1alpha1_FileSystem is an autogenerated conversion function.
+func Convert_v1beta1_FileSystem_To_v1alpha1_FileSystem(in *v1beta1.FileSystem, out *FileSystem, s conversion.Scope) error {
+	return autoConvert_v1beta1_FileSystem_To_v1alpha1_FileSystem(in, out, s)
+}
+
+func autoConvert_v1alpha1_Firmware_To_v1beta1_Firmware(in *Firmware, out *v1beta1.Firmware, s conversion.Scope) error {
+	out.EFI = (*v1beta1.EFIFirmware)(unsafe.Pointer(in.EFI))
+	return nil
+}
+
+// Convert_v1alpha1_Firmware_To_v1beta1_Firmware is an autogenerated conversion function.
+func Convert_v1alpha1_Firmware_To_v1beta1_Firmware(in *Firmware, out *v1beta1.Firmware, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Firmware_To_v1beta1_Firmware(in, out, s)
+}
+
+func autoConvert_v1beta1_Firmware_To_v1alpha1_Firmware(in *v1beta1.Firmware, out *Firmware, s conversion.Scope) error {
+	out.EFI = (*EFIFirmware)(unsafe.Pointer(in.EFI))
+	return nil
+}
+
+// Convert_v1beta1_Firmware_To_v1alpha1_Firmware is an autogenerated conversion function.
+func Convert_v1beta1_Firmware_To_v1alpha1_Firmware(in *v1beta1.Firmware, out *Firmware, s conversion.Scope) error {
+	return autoConvert_v1beta1_Firmware_To_v1alpha1_Firmware(in, out, s)
+}
+
+func autoConvert_v1alpha1_GuestMetrics_To_v1beta1_GuestMetrics(in *GuestMetrics, out *v1beta1.GuestMetrics, s conversion.Scope) error {
+	out.Port = in.Port
+	return nil
+}
+
+// Convert_v1alpha1_GuestMetrics_To_v1beta1_GuestMetrics is an autogenerated conversion function.
+func Convert_v1alpha1_GuestMetrics_To_v1beta1_GuestMetrics(in *GuestMetrics, out *v1beta1.GuestMetrics, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GuestMetrics_To_v1beta1_GuestMetrics(in, out, s)
+}
+
+func autoConvert_v1beta1_GuestMetrics_To_v1alpha1_GuestMetrics(in *v1beta1.GuestMetrics, out *GuestMetrics, s conversion.Scope) error {
+	out.Port = in.Port
+	return nil
+}
+
+// Convert_v1beta1_GuestMetrics_To_v1alpha1_GuestMetrics is an autogenerated conversion function.
+func Convert_v1beta1_GuestMetrics_To_v1alpha1_GuestMetrics(in *v1beta1.GuestMetrics, out *GuestMetrics, s conversion.Scope) error {
+	return autoConvert_v1beta1_GuestMetrics_To_v1alpha1_GuestMetrics(in, out, s)
+}
+
+func autoConvert_v1alpha1_HTTPDiskVolumeSource_To_v1beta1_HTTPDiskVolumeSource(in *HTTPDiskVolumeSource, out *v1beta1.HTTPDiskVolumeSource, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Checksum = in.Checksum
+	return nil
+}
+
+// Convert_v1alpha1_HTTPDiskVolumeSource_To_v1beta1_HTTPDiskVolumeSource is an autogenerated conversion function.
+func Convert_v1alpha1_HTTPDiskVolumeSource_To_v1beta1_HTTPDiskVolumeSource(in *HTTPDiskVolumeSource, out *v1beta1.HTTPDiskVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HTTPDiskVolumeSource_To_v1beta1_HTTPDiskVolumeSource(in, out, s)
+}
+
+func autoConvert_v1beta1_HTTPDiskVolumeSource_To_v1alpha1_HTTPDiskVolumeSource(in *v1beta1.HTTPDiskVolumeSource, out *HTTPDiskVolumeSource, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Checksum = in.Checksum
+	return nil
+}
+
+// Convert_v1beta1_HTTPDiskVolumeSource_To_v1alpha1_HTTPDiskVolumeSource is an autogenerated conversion function.
+func Convert_v1beta1_HTTPDiskVolumeSource_To_v1alpha1_HTTPDiskVolumeSource(in *v1beta1.HTTPDiskVolumeSource, out *HTTPDiskVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_HTTPDiskVolumeSource_To_v1alpha1_HTTPDiskVolumeSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_Hibernation_To_v1beta1_Hibernation(in *Hibernation, out *v1beta1.Hibernation, s conversion.Scope) error {
+	out.ClaimName = in.ClaimName
+	return nil
+}
+
+// Convert_v1alpha1_Hibernation_To_v1beta1_Hibernation is an autogenerated conversion function.
+func Convert_v1alpha1_Hibernation_To_v1beta1_Hibernation(in *Hibernation, out *v1beta1.Hibernation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Hibernation_To_v1beta1_Hibernation(in, out, s)
+}
+
+func autoConvert_v1beta1_Hibernation_To_v1alpha1_Hibernation(in *v1beta1.Hibernation, out *Hibernation, s conversion.Scope) error {
+	out.ClaimName = in.ClaimName
+	return nil
+}
+
+// Convert_v1beta1_Hibernation_To_v1alpha1_Hibernation is an autogenerated conversion function.
+func Convert_v1beta1_Hibernation_To_v1alpha1_Hibernation(in *v1beta1.Hibernation, out *Hibernation, s conversion.Scope) error {
+	return autoConvert_v1beta1_Hibernation_To_v1alpha1_Hibernation(in, out, s)
+}
+
+func autoConvert_v1alpha1_HostUSBDevice_To_v1beta1_HostUSBDevice(in *HostUSBDevice, out *v1beta1.HostUSBDevice, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceName = in.ResourceName
+	return nil
+}
+
+// Convert_v1alpha1_HostUSBDevice_To_v1beta1_HostUSBDevice is an autogenerated conversion function.
+func Convert_v1alpha1_HostUSBDevice_To_v1beta1_HostUSBDevice(in *HostUSBDevice, out *v1beta1.HostUSBDevice, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HostUSBDevice_To_v1beta1_HostUSBDevice(in, out, s)
+}
+
+func autoConvert_v1beta1_HostUSBDevice_To_v1alpha1_HostUSBDevice(in *v1beta1.HostUSBDevice, out *HostUSBDevice, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceName = in.ResourceName
+	return nil
+}
+
+// Convert_v1beta1_HostUSBDevice_To_v1alpha1_HostUSBDevice is an autogenerated conversion function.
+func Convert_v1beta1_HostUSBDevice_To_v1alpha1_HostUSBDevice(in *v1beta1.HostUSBDevice, out *HostUSBDevice, s conversion.Scope) error {
+	return autoConvert_v1beta1_HostUSBDevice_To_v1alpha1_HostUSBDevice(in, out, s)
+}
+
+func autoConvert_v1alpha1_Hugepages_To_v1beta1_Hugepages(in *Hugepages, out *v1beta1.Hugepages, s conversion.Scope) error {
+	out.PageSize = in.PageSize
+	return nil
+}
+
+// Convert_v1alpha1_Hugepages_To_v1beta1_Hugepages is an autogenerated conversion function.
+func Convert_v1alpha1_Hugepages_To_v1beta1_Hugepages(in *Hugepages, out *v1beta1.Hugepages, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Hugepages_To_v1beta1_Hugepages(in, out, s)
+}
+
+func autoConvert_v1beta1_Hugepages_To_v1alpha1_Hugepages(in *v1beta1.Hugepages, out *Hugepages, s conversion.Scope) error {
+	out.PageSize = in.PageSize
+	return nil
+}
+
+// Convert_v1beta1_Hugepages_To_v1alpha1_Hugepages is an autogenerated conversion function.
+func Convert_v1beta1_Hugepages_To_v1alpha1_Hugepages(in *v1beta1.Hugepages, out *Hugepages, s conversion.Scope) error {
+	return autoConvert_v1beta1_Hugepages_To_v1alpha1_Hugepages(in, out, s)
+}
+
+func autoConvert_v1alpha1_Instance_To_v1beta1_Instance(in *Instance, out *v1beta1.Instance, s conversion.Scope) error {
+	if err := Convert_v1alpha1_CPU_To_v1beta1_CPU(&in.CPU, &out.CPU, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_Memory_To_v1beta1_Memory(&in.Memory, &out.Memory, s); err != nil {
+		return err
+	}
+	out.Kernel = (*v1beta1.Kernel)(unsafe.Pointer(in.Kernel))
+	out.Disks = *(*[]v1beta1.Disk)(unsafe.Pointer(&in.Disks))
+	out.FileSystems = *(*[]v1beta1.FileSystem)(unsafe.Pointer(&in.FileSystems))
+	out.Interfaces = *(*[]v1beta1.Interface)(unsafe.Pointer(&in.Interfaces))
+	out.Bonds = *(*[]v1beta1.Bond)(unsafe.Pointer(&in.Bonds))
+	out.Realtime = (*v1beta1.Realtime)(unsafe.Pointer(in.Realtime))
+	out.Watchdog = (*v1beta1.Watchdog)(unsafe.Pointer(in.Watchdog))
+	out.Devices = (*v1beta1.Devices)(unsafe.Pointer(in.Devices))
+	out.Firmware = (*v1beta1.Firmware)(unsafe.Pointer(in.Firmware))
+	out.Hypervisor = v1beta1.Hypervisor(in.Hypervisor)
+	out.Vsock = (*v1beta1.Vsock)(unsafe.Pointer(in.Vsock))
+	out.RNG = (*v1beta1.RNG)(unsafe.Pointer(in.RNG))
+	out.Downward = (*v1beta1.Downward)(unsafe.Pointer(in.Downward))
+	out.Clock = (*v1beta1.Clock)(unsafe.Pointer(in.Clock))
+	out.GuestMetrics = (*v1beta1.GuestMetrics)(unsafe.Pointer(in.GuestMetrics))
+	return nil
+}
+
+// Convert_v1alpha1_Instance_To_v1beta1_Instance is an autogenerated conversion function.
+func Convert_v1alpha1_Instance_To_v1beta1_Instance(in *Instance, out *v1beta1.Instance, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Instance_To_v1beta1_Instance(in, out, s)
+}
+
+func autoConvert_v1beta1_Instance_To_v1alpha1_Instance(in *v1beta1.Instance, out *Instance, s conversion.Scope) error {
+	if err := Convert_v1beta1_CPU_To_v1alpha1_CPU(&in.CPU, &out.CPU, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_Memory_To_v1alpha1_Memory(&in.Memory, &out.Memory, s); err != nil {
+		return err
+	}
+	out.Kernel = (*Kernel)(unsafe.Pointer(in.Kernel))
+	out.Disks = *(*[]Disk)(unsafe.Pointer(&in.Disks))
+	out.FileSystems = *(*[]FileSystem)(unsafe.Pointer(&in.FileSystems))
+	out.Interfaces = *(*[]Interface)(unsafe.Pointer(&in.Interfaces))
+	out.Bonds = *(*[]Bond)(unsafe.Pointer(&in.Bonds))
+	out.Realtime = (*Realtime)(unsafe.Pointer(in.Realtime))
+	out.Watchdog = (*Watchdog)(unsafe.Pointer(in.Watchdog))
+	out.Devices = (*Devices)(unsafe.Pointer(in.Devices))
+	out.Firmware = (*Firmware)(unsafe.Pointer(in.Firmware))
+	out.Hypervisor = Hypervisor(in.Hypervisor)
+	out.Vsock = (*Vsock)(unsafe.Pointer(in.Vsock))
+	out.RNG = (*RNG)(unsafe.Pointer(in.RNG))
+	out.Downward = (*Downward)(unsafe.Pointer(in.Downward))
+	out.Clock = (*Clock)(unsafe.Pointer(in.Clock))
+	out.GuestMetrics = (*GuestMetrics)(unsafe.Pointer(in.GuestMetrics))
+	return nil
+}
+
+// Convert_v1beta1_Instance_To_v1alpha1_Instance is an autogenerated conversion function.
+func Convert_v1beta1_Instance_To_v1alpha1_Instance(in *v1beta1.Instance, out *Instance, s conversion.Scope) error {
+	return autoConvert_v1beta1_Instance_To_v1alpha1_Instance(in, out, s)
+}
+
+func autoConvert_v1alpha1_Interface_To_v1beta1_Interface(in *Interface, out *v1beta1.Interface, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MAC = in.MAC
+	if err := Convert_v1alpha1_InterfaceBindingMethod_To_v1beta1_InterfaceBindingMethod(&in.InterfaceBindingMethod, &out.InterfaceBindingMethod, s); err != nil {
+		return err
+	}
+	out.RateLimit = (*v1beta1.InterfaceRateLimit)(unsafe.Pointer(in.RateLimit))
+	out.Queues = in.Queues
+	out.BootOrder = in.BootOrder
+	out.DHCPOptions = (*v1beta1.InterfaceDHCPOptions)(unsafe.Pointer(in.DHCPOptions))
+	out.MTU = in.MTU
+	out.Offloads = (*v1beta1.InterfaceOffloads)(unsafe.Pointer(in.Offloads))
+	out.Vhost = in.Vhost
+	out.RXQueueSize = in.RXQueueSize
+	out.TXQueueSize = in.TXQueueSize
+	out.SecurityGroups = *(*[]string)(unsafe.Pointer(&in.SecurityGroups))
+	out.VLAN = (*v1beta1.InterfaceVLAN)(unsafe.Pointer(in.VLAN))
+	out.State = v1beta1.InterfaceState(in.State)
+	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	out.Routes = *(*[]v1beta1.InterfaceRoute)(unsafe.Pointer(&in.Routes))
+	return nil
+}
+
+// Convert_v1alpha1_Interface_To_v1beta1_Interface is an autogenerated conversion function.
+func Convert_v1alpha1_Interface_To_v1beta1_Interface(in *Interface, out *v1beta1.Interface, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Interface_To_v1beta1_Interface(in, out, s)
+}
+
+func autoConvert_v1beta1_Interface_To_v1alpha1_Interface(in *v1beta1.Interface, out *Interface, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MAC = in.MAC
+	if err := Convert_v1beta1_InterfaceBindingMethod_To_v1alpha1_InterfaceBindingMethod(&in.InterfaceBindingMethod, &out.InterfaceBindingMethod, s); err != nil {
+		return err
+	}
+	out.RateLimit = (*InterfaceRateLimit)(unsafe.Pointer(in.RateLimit))
+	out.Queues = in.Queues
+	out.BootOrder = in.BootOrder
+	out.DHCPOptions = (*InterfaceDHCPOptions)(unsafe.Pointer(in.DHCPOptions))
+	out.MTU = in.MTU
+	out.Offloads = (*InterfaceOffloads)(unsafe.Pointer(in.Offloads))
+	out.Vhost = in.Vhost
+	out.RXQueueSize = in.RXQueueSize
+	out.TXQueueSize = in.TXQueueSize
+	out.SecurityGroups = *(*[]string)(unsafe.Pointer(&in.SecurityGroups))
+	out.VLAN = (*InterfaceVLAN)(unsafe.Pointer(in.VLAN))
+	out.State = InterfaceState(in.State)
+	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	out.Routes = *(*[]InterfaceRoute)(unsafe.Pointer(&in.Routes))
+	return nil
+}
+
+// Convert_v1beta1_Interface_To_v1alpha1_Interface is an autogenerated conversion function.
+func Convert_v1beta1_Interface_To_v1alpha1_Interface(in *v1beta1.Interface, out *Interface, s conversion.Scope) error {
+	return autoConvert_v1beta1_Interface_To_v1alpha1_Interface(in, out, s)
+}
+
+func autoConvert_v1alpha1_InterfaceBindingMethod_To_v1beta1_InterfaceBindingMethod(in *InterfaceBindingMethod, out *v1beta1.InterfaceBindingMethod, s conversion.Scope) error {
+	out.Bridge = (*v1beta1.InterfaceBridge)(unsafe.Pointer(in.Bridge))
+	out.Masquerade = (*v1beta1.InterfaceMasquerade)(unsafe.Pointer(in.Masquerade))
+	out.SRIOV = (*v1beta1.InterfaceSRIOV)(unsafe.Pointer(in.SRIOV))
+	out.VhostUser = (*v1beta1.InterfaceVhostUser)(unsafe.Pointer(in.VhostUser))
+	out.OVS = (*v1beta1.InterfaceOVS)(unsafe.Pointer(in.OVS))
+	return nil
+}
+
+// Convert_v1alpha1_InterfaceBindingMethod_To_v1beta1_InterfaceBindingMethod is an autogenerated conversion function.
+func Convert_v1alpha1_InterfaceBindingMethod_To_v1beta1_InterfaceBindingMethod(in *InterfaceBindingMethod, out *v1beta1.InterfaceBindingMethod, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InterfaceBindingMethod_To_v1beta1_InterfaceBindingMethod(in, out, s)
+}
+
+func autoConvert_v1beta1_InterfaceBindingMethod_To_v1alpha1_InterfaceBindingMethod(in *v1beta1.InterfaceBindingMethod, out *InterfaceBindingMethod, s conversion.Scope) error {
+	out.Bridge = (*InterfaceBridge)(unsafe.Pointer(in.Bridge))
+	out.Masquerade = (*InterfaceMasquerade)(unsafe.Pointer(in.Masquerade))
+	out.SRIOV = (*InterfaceSRIOV)(unsafe.Pointer(in.SRIOV))
+	out.VhostUser = (*InterfaceVhostUser)(unsafe.Pointer(in.VhostUser))
+	out.OVS = (*InterfaceOVS)(unsafe.Pointer(in.OVS))
+	return nil
+}
+
+// Convert_v1beta1_InterfaceBindingMethod_To_v1alpha1_InterfaceBindingMethod is an autogenerated conversion function.
+func Convert_v1beta1_InterfaceBindingMethod_To_v1alpha1_InterfaceBindingMethod(in *v1beta1.InterfaceBindingMethod, out *InterfaceBindingMethod, s conversion.Scope) error {
+	return autoConvert_v1beta1_InterfaceBindingMethod_To_v1alpha1_InterfaceBindingMethod(in, out, s)
+}
+
+func autoConvert_v1alpha1_InterfaceBridge_To_v1beta1_InterfaceBridge(in *InterfaceBridge, out *v1beta1.InterfaceBridge, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha1_InterfaceBridge_To_v1beta1_InterfaceBridge is an autogenerated conversion function.
+func Convert_v1alpha1_InterfaceBridge_To_v1beta1_InterfaceBridge(in *InterfaceBridge, out *v1beta1.InterfaceBridge, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InterfaceBridge_To_v1beta1_InterfaceBridge(in, out, s)
+}
+
+func autoConvert_v1beta1_InterfaceBridge_To_v1alpha1_InterfaceBridge(in *v1beta1.InterfaceBridge, out *InterfaceBridge, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1beta1_InterfaceBridge_To_v1alpha1_InterfaceBridge is an autogenerated conversion function.
+func Convert_v1beta1_InterfaceBridge_To_v1alpha1_InterfaceBridge(in *v1beta1.InterfaceBridge, out *InterfaceBridge, s conversion.Scope) error {
+	return autoConvert_v1beta1_InterfaceBridge_To_v1alpha1_InterfaceBridge(in, out, s)
+}
+
+func autoConvert_v1alpha1_InterfaceDHCPOptions_To_v1beta1_InterfaceDHCPOptions(in *InterfaceDHCPOptions, out *v1beta1.InterfaceDHCPOptions, s conversion.Scope) error {
+	out.DNSServers = *(*[]string)(unsafe.Pointer(&in.DNSServers))
+	out.SearchDomains = *(*[]string)(unsafe.Pointer(&in.SearchDomains))
+	out.NTPServers = *(*[]string)(unsafe.Pointer(&in.NTPServers))
+	return nil
+}
+
+// Convert_v1alpha1_InterfaceDHCPOptions_To_v1beta1_InterfaceDHCPOptions is an autogenerated conversion function.
+func Convert_v1alpha1_InterfaceDHCPOptions_To_v1beta1_InterfaceDHCPOptions(in *InterfaceDHCPOptions, out *v1beta1.InterfaceDHCPOptions, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InterfaceDHCPOptions_To_v1beta1_InterfaceDHCPOptions(in, out, s)
+}
+
+func autoConvert_v1beta1_InterfaceDHCPOptions_To_v1alpha1_InterfaceDHCPOptions(in *v1beta1.InterfaceDHCPOptions, out *InterfaceDHCPOptions, s conversion.Scope) error {
+	out.DNSServers = *(*[]string)(unsafe.Pointer(&in.DNSServers))
+	out.SearchDomains = *(*[]string)(unsafe.Pointer(&in.SearchDomains))
+	out.NTPServers = *(*[]string)(unsafe.Pointer(&in.NTPServers))
+	return nil
+}
+
+// Convert_v1beta1_InterfaceDHCPOptions_To_v1alpha1_InterfaceDHCPOptions is an autogenerated conversion function.
+func Convert_v1beta1_InterfaceDHCPOptions_To_v1alpha1_InterfaceDHCPOptions(in *v1beta1.InterfaceDHCPOptions, out *InterfaceDHCPOptions, s conversion.Scope) error {
+	return autoConvert_v1beta1_InterfaceDHCPOptions_To_v1alpha1_InterfaceDHCPOptions(in, out, s)
+}
+
+func autoConvert_v1alpha1_InterfaceMasquerade_To_v1beta1_InterfaceMasquerade(in *InterfaceMasquerade, out *v1beta1.InterfaceMasquerade, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	return nil
+}
+
+// Convert_v1alpha1_InterfaceMasquerade_To_v1beta1_InterfaceMasquerade is an autogenerated conversion function.
+func Convert_v1alpha1_InterfaceMasquerade_To_v1beta1_InterfaceMasquerade(in *InterfaceMasquerade, out *v1beta1.InterfaceMasquerade, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InterfaceMasquerade_To_v1beta1_InterfaceMasquerade(in, out, s)
+}
+
+func autoConvert_v1beta1_InterfaceMasquerade_To_v1alpha1_InterfaceMasquerade(in *v1beta1.InterfaceMasquerade, out *InterfaceMasquerade, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	return nil
+}
+
+// Convert_v1beta1_InterfaceMasquerade_To_v1alpha1_InterfaceMasquerade is an autogenerated conversion function.
+func Convert_v1beta1_InterfaceMasquerade_To_v1alpha1_InterfaceMasquerade(in *v1beta1.InterfaceMasquerade, out *InterfaceMasquerade, s conversion.Scope) error {
+	return autoConvert_v1beta1_InterfaceMasquerade_To_v1alpha1_InterfaceMasquerade(in, out, s)
+}
+
+func autoConvert_v1alpha1_InterfaceOVS_To_v1beta1_InterfaceOVS(in *InterfaceOVS, out *v1beta1.InterfaceOVS, s conversion.Scope) error {
+	out.VLAN = (*int32)(unsafe.Pointer(in.VLAN))
+	out.Trunks = *(*[]int32)(unsafe.Pointer(&in.Trunks))
+	return nil
+}
+
+// Convert_v1alpha1_InterfaceOVS_To_v1beta1_InterfaceOVS is an autogenerated conversion function.
+func Convert_v1alpha1_InterfaceOVS_To_v1beta1_InterfaceOVS(in *InterfaceOVS, out *v1beta1.InterfaceOVS, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InterfaceOVS_To_v1beta1_InterfaceOVS(in, out, s)
+}
+
+func autoConvert_v1beta1_InterfaceOVS_To_v1alpha1_InterfaceOVS(in *v1beta1.InterfaceOVS, out *InterfaceOVS, s conversion.Scope) error {
+	out.VLAN = (*int32)(unsafe.Pointer(in.VLAN))
+	out.Trunks = *(*[]int32)(unsafe.Pointer(&in.Trunks))
+	return nil
+}
+
+// Convert_v1beta1_InterfaceOVS_To_v1alpha1_InterfaceOVS is an autogenerated conversion function.
+func Convert_v1beta1_InterfaceOVS_To_v1alpha1_InterfaceOVS(in *v1beta1.InterfaceOVS, out *InterfaceOVS, s conversion.Scope) error {
+	return autoConvert_v1beta1_InterfaceOVS_To_v1alpha1_InterfaceOVS(in, out, s)
+}
+
+func autoConvert_v1alpha1_InterfaceOffloads_To_v1beta1_InterfaceOffloads(in *InterfaceOffloads, out *v1beta1.InterfaceOffloads, s conversion.Scope) error {
+	out.DisableChecksum = in.DisableChecksum
+	out.DisableTSO = in.DisableTSO
+	out.DisableUFO = in.DisableUFO
+	return nil
+}
+
+// Convert_v1alpha1_InterfaceOffloads_To_v1beta1_InterfaceOffloads is an autogenerated conversion function.
+func Convert_v1alpha1_InterfaceOffloads_To_v1beta1_InterfaceOffloads(in *InterfaceOffloads, out *v1beta1.InterfaceOffloads, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InterfaceOffloads_To_v1beta1_InterfaceOffloads(in, out, s)
+}
+
+func autoConvert_v1beta1_InterfaceOffloads_To_v1alpha1_InterfaceOffloads(in *v1beta1.InterfaceOffloads, out *InterfaceOffloads, s conversion.Scope) error {
+	out.DisableChecksum = in.DisableChecksum
+	out.DisableTSO = in.DisableTSO
+	out.DisableUFO = in.DisableUFO
+	return nil
+}
+
+// Convert_v1beta1_InterfaceOffloads_To_v1alpha1_InterfaceOffloads is an autogenerated conversion function.
+func Convert_v1beta1_InterfaceOffloads_To_v1alpha1_InterfaceOffloads(in *v1beta1.InterfaceOffloads, out *InterfaceOffloads, s conversion.Scope) error {
+	return autoConvert_v1beta1_InterfaceOffloads_To_v1alpha1_InterfaceOffloads(in, out, s)
+}
+
+func autoConvert_v1alpha1_InterfaceRateLimit_To_v1beta1_InterfaceRateLimit(in *InterfaceRateLimit, out *v1beta1.InterfaceRateLimit, s conversion.Scope) error {
+	out.RX = (*v1beta1.BandwidthLimit)(unsafe.Pointer(in.RX))
+	out.TX = (*v1beta1.BandwidthLimit)(unsafe.Pointer(in.TX))
+	return nil
+}
+
+// Convert_v1alpha1_InterfaceRateLimit_To_v1beta1_InterfaceRateLimit is an autogenerated conversion function.
+func Convert_v1alpha1_InterfaceRateLimit_To_v1beta1_InterfaceRateLimit(in *InterfaceRateLimit, out *v1beta1.InterfaceRateLimit, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InterfaceRateLimit_To_v1beta1_InterfaceRateLimit(in, out, s)
+}
+
+func autoConvert_v1beta1_InterfaceRateLimit_To_v1alpha1_InterfaceRateLimit(in *v1beta1.InterfaceRateLimit, out *InterfaceRateLimit, s conversion.Scope) error {
+	out.RX = (*BandwidthLimit)(unsafe.Pointer(in.RX))
+	out.TX = (*BandwidthLimit)(unsafe.Pointer(in.TX))
+	return nil
+}
+
+// Convert_v1beta1_InterfaceRateLimit_To_v1alpha1_InterfaceRateLimit is an autogenerated conversion function.
+func Convert_v1beta1_InterfaceRateLimit_To_v1alpha1_InterfaceRateLimit(in *v1beta1.InterfaceRateLimit, out *InterfaceRateLimit, s conversion.Scope) error {
+	return autoConvert_v1beta1_InterfaceRateLimit_To_v1alpha1_InterfaceRateLimit(in, out, s)
+}
+
+func autoConvert_v1alpha1_InterfaceRoute_To_v1beta1_InterfaceRoute(in *InterfaceRoute, out *v1beta1.InterfaceRoute, s conversion.Scope) error {
+	out.To = in.To
+	out.Via = in.Via
+	out.Metric = in.Metric
+	return nil
+}
+
+// Convert_v1alpha1_InterfaceRoute_To_v1beta1_InterfaceRoute is an autogenerated conversion function.
+func Convert_v1alpha1_InterfaceRoute_To_v1beta1_InterfaceRoute(in *InterfaceRoute, out *v1beta1.InterfaceRoute, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InterfaceRoute_To_v1beta1_InterfaceRoute(in, out, s)
+}
+
+func autoConvert_v1beta1_InterfaceRoute_To_v1alpha1_InterfaceRoute(in *v1beta1.InterfaceRoute, out *InterfaceRoute, s conversion.Scope) error {
+	out.To = in.To
+	out.Via = in.Via
+	out.Metric = in.Metric
+	return nil
+}
+
+// Convert_v1beta1_InterfaceRoute_To_v1alpha1_InterfaceRoute is an autogenerated conversion function.
+func Convert_v1beta1_InterfaceRoute_To_v1alpha1_InterfaceRoute(in *v1beta1.InterfaceRoute, out *InterfaceRoute, s conversion.Scope) error {
+	return autoConvert_v1beta1_InterfaceRoute_To_v1alpha1_InterfaceRoute(in, out, s)
+}
+
+func autoConvert_v1alpha1_InterfaceSRIOV_To_v1beta1_InterfaceSRIOV(in *InterfaceSRIOV, out *v1beta1.InterfaceSRIOV, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha1_InterfaceSRIOV_To_v1beta1_InterfaceSRIOV is an autogenerated conversion function.
+func Convert_v1alpha1_InterfaceSRIOV_To_v1beta1_InterfaceSRIOV(in *InterfaceSRIOV, out *v1beta1.InterfaceSRIOV, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InterfaceSRIOV_To_v1beta1_InterfaceSRIOV(in, out, s)
+}
+
+func autoConvert_v1beta1_InterfaceSRIOV_To_v1alpha1_InterfaceSRIOV(in *v1beta1.InterfaceSRIOV, out *InterfaceSRIOV, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1beta1_InterfaceSRIOV_To_v1alpha1_InterfaceSRIOV is an autogenerated conversion function.
+func Convert_v1beta1_InterfaceSRIOV_To_v1alpha1_InterfaceSRIOV(in *v1beta1.InterfaceSRIOV, out *InterfaceSRIOV, s conversion.Scope) error {
+	return autoConvert_v1beta1_InterfaceSRIOV_To_v1alpha1_InterfaceSRIOV(in, out, s)
+}
+
+func autoConvert_v1alpha1_InterfaceVLAN_To_v1beta1_InterfaceVLAN(in *InterfaceVLAN, out *v1beta1.InterfaceVLAN, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Trunks = *(*[]v1beta1.VLANRange)(unsafe.Pointer(&in.Trunks))
+	return nil
+}
+
+// Convert_v1alpha1_InterfaceVLAN_To_v1beta1_InterfaceVLAN is an autogenerated conversion function.
+func Convert_v1alpha1_InterfaceVLAN_To_v1beta1_InterfaceVLAN(in *InterfaceVLAN, out *v1beta1.InterfaceVLAN, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InterfaceVLAN_To_v1beta1_InterfaceVLAN(in, out, s)
+}
+
+func autoConvert_v1beta1_InterfaceVLAN_To_v1alpha1_InterfaceVLAN(in *v1beta1.InterfaceVLAN, out *InterfaceVLAN, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Trunks = *(*[]VLANRange)(unsafe.Pointer(&in.Trunks))
+	return nil
+}
+
+// Convert_v1beta1_InterfaceVLAN_To_v1alpha1_InterfaceVLAN is an autogenerated conversion function.
+func Convert_v1beta1_InterfaceVLAN_To_v1alpha1_InterfaceVLAN(in *v1beta1.InterfaceVLAN, out *InterfaceVLAN, s conversion.Scope) error {
+	return autoConvert_v1beta1_InterfaceVLAN_To_v1alpha1_InterfaceVLAN(in, out, s)
+}
+
+func autoConvert_v1alpha1_InterfaceVhostUser_To_v1beta1_InterfaceVhostUser(in *InterfaceVhostUser, out *v1beta1.InterfaceVhostUser, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha1_InterfaceVhostUser_To_v1beta1_InterfaceVhostUser is an autogenerated conversion function.
+func Convert_v1alpha1_InterfaceVhostUser_To_v1beta1_InterfaceVhostUser(in *InterfaceVhostUser, out *v1beta1.InterfaceVhostUser, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InterfaceVhostUser_To_v1beta1_InterfaceVhostUser(in, out, s)
+}
+
+func autoConvert_v1beta1_InterfaceVhostUser_To_v1alpha1_InterfaceVhostUser(in *v1beta1.InterfaceVhostUser, out *InterfaceVhostUser, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1beta1_InterfaceVhostUser_To_v1alpha1_InterfaceVhostUser is an autogenerated conversion function.
+func Convert_v1beta1_InterfaceVhostUser_To_v1alpha1_InterfaceVhostUser(in *v1beta1.InterfaceVhostUser, out *InterfaceVhostUser, s conversion.Scope) error {
+	return autoConvert_v1beta1_InterfaceVhostUser_To_v1alpha1_InterfaceVhostUser(in, out, s)
+}
+
+func autoConvert_v1alpha1_Kernel_To_v1beta1_Kernel(in *Kernel, out *v1beta1.Kernel, s conversion.Scope) error {
+	out.Image = in.Image
+	out.ImagePullPolicy = v1.PullPolicy(in.ImagePullPolicy)
+	out.Cmdline = in.Cmdline
+	return nil
+}
+
+// Convert_v1alpha1_Kernel_To_v1beta1_Kernel is an autogenerated conversion function.
+func Convert_v1alpha1_Kernel_To_v1beta1_Kernel(in *Kernel, out *v1beta1.Kernel, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Kernel_To_v1beta1_Kernel(in, out, s)
+}
+
+func autoConvert_v1beta1_Kernel_To_v1alpha1_Kernel(in *v1beta1.Kernel, out *Kernel, s conversion.Scope) error {
+	out.Image = in.Image
+	out.ImagePullPolicy = v1.PullPolicy(in.ImagePullPolicy)
+	out.Cmdline = in.Cmdline
+	return nil
+}
+
+// Convert_v1beta1_Kernel_To_v1alpha1_Kernel is an autogenerated conversion function.
+func Convert_v1beta1_Kernel_To_v1alpha1_Kernel(in *v1beta1.Kernel, out *Kernel, s conversion.Scope) error {
+	return autoConvert_v1beta1_Kernel_To_v1alpha1_Kernel(in, out, s)
+}
+
+func autoConvert_v1alpha1_Memory_To_v1beta1_Memory(in *Memory, out *v1beta1.Memory, s conversion.Scope) error {
+	out.Size = in.Size
+	out.Hugepages = (*v1beta1.Hugepages)(unsafe.Pointer(in.Hugepages))
+	out.Balloon = (*v1beta1.MemoryBalloon)(unsafe.Pointer(in.Balloon))
+	out.Swap = (*v1beta1.MemorySwap)(unsafe.Pointer(in.Swap))
+	return nil
+}
+
+// Convert_v1alpha1_Memory_To_v1beta1_Memory is an autogenerated conversion function.
+func Convert_v1alpha1_Memory_To_v1beta1_Memory(in *Memory, out *v1beta1.Memory, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Memory_To_v1beta1_Memory(in, out, s)
+}
+
+func autoConvert_v1beta1_Memory_To_v1alpha1_Memory(in *v1beta1.Memory, out *Memory, s conversion.Scope) error {
+	out.Size = in.Size
+	out.Hugepages = (*Hugepages)(unsafe.Pointer(in.Hugepages))
+	out.Balloon = (*MemoryBalloon)(unsafe.Pointer(in.Balloon))
+	out.Swap = (*MemorySwap)(unsafe.Pointer(in.Swap))
+	return nil
+}
+
+// Convert_v1beta1_Memory_To_v1alpha1_Memory is an autogenerated conversion function.
+func Convert_v1beta1_Memory_To_v1alpha1_Memory(in *v1beta1.Memory, out *Memory, s conversion.Scope) error {
+	return autoConvert_v1beta1_Memory_To_v1alpha1_Memory(in, out, s)
+}
+
+func autoConvert_v1alpha1_MemoryBalloon_To_v1beta1_MemoryBalloon(in *MemoryBalloon, out *v1beta1.MemoryBalloon, s conversion.Scope) error {
+	out.MaxReclaimPercent = in.MaxReclaimPercent
+	out.DisableFreePageReporting = in.DisableFreePageReporting
+	return nil
+}
+
+// Convert_v1alpha1_MemoryBalloon_To_v1beta1_MemoryBalloon is an autogenerated conversion function.
+func Convert_v1alpha1_MemoryBalloon_To_v1beta1_MemoryBalloon(in *MemoryBalloon, out *v1beta1.MemoryBalloon, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MemoryBalloon_To_v1beta1_MemoryBalloon(in, out, s)
+}
+
+func autoConvert_v1beta1_MemoryBalloon_To_v1alpha1_MemoryBalloon(in *v1beta1.MemoryBalloon, out *MemoryBalloon, s conversion.Scope) error {
+	out.MaxReclaimPercent = in.MaxReclaimPercent
+	out.DisableFreePageReporting = in.DisableFreePageReporting
+	return nil
+}
+
+// Convert_v1beta1_MemoryBalloon_To_v1alpha1_MemoryBalloon is an autogenerated conversion function.
+func Convert_v1beta1_MemoryBalloon_To_v1alpha1_MemoryBalloon(in *v1beta1.MemoryBalloon, out *MemoryBalloon, s conversion.Scope) error {
+	return autoConvert_v1beta1_MemoryBalloon_To_v1alpha1_MemoryBalloon(in, out, s)
+}
+
+func autoConvert_v1alpha1_MemoryDump_To_v1beta1_MemoryDump(in *MemoryDump, out *v1beta1.MemoryDump, s conversion.Scope) error {
+	out.ClaimName = in.ClaimName
+	out.OnCrash = in.OnCrash
+	return nil
+}
+
+// Convert_v1alpha1_MemoryDump_To_v1beta1_MemoryDump is an autogenerated conversion function.
+func Convert_v1alpha1_MemoryDump_To_v1beta1_MemoryDump(in *MemoryDump, out *v1beta1.MemoryDump, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MemoryDump_To_v1beta1_MemoryDump(in, out, s)
+}
+
+func autoConvert_v1beta1_MemoryDump_To_v1alpha1_MemoryDump(in *v1beta1.MemoryDump, out *MemoryDump, s conversion.Scope) error {
+	out.ClaimName = in.ClaimName
+	out.OnCrash = in.OnCrash
+	return nil
+}
+
+// Convert_v1beta1_MemoryDump_To_v1alpha1_MemoryDump is an autogenerated conversion function.
+func Convert_v1beta1_MemoryDump_To_v1alpha1_MemoryDump(in *v1beta1.MemoryDump, out *MemoryDump, s conversion.Scope) error {
+	return autoConvert_v1beta1_MemoryDump_To_v1alpha1_MemoryDump(in, out, s)
+}
+
+func autoConvert_v1alpha1_MemorySwap_To_v1beta1_MemorySwap(in *MemorySwap, out *v1beta1.MemorySwap, s conversion.Scope) error {
+	out.Max = (*resource.Quantity)(unsafe.Pointer(in.Max))
+	out.DisableZswap = in.DisableZswap
+	return nil
+}
+
+// Convert_v1alpha1_MemorySwap_To_v1beta1_MemorySwap is an autogenerated conversion function.
+func Convert_v1alpha1_MemorySwap_To_v1beta1_MemorySwap(in *MemorySwap, out *v1beta1.MemorySwap, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MemorySwap_To_v1beta1_MemorySwap(in, out, s)
+}
+
+func autoConvert_v1beta1_MemorySwap_To_v1alpha1_MemorySwap(in *v1beta1.MemorySwap, out *MemorySwap, s conversion.Scope) error {
+	out.Max = (*resource.Quantity)(unsafe.Pointer(in.Max))
+	out.DisableZswap = in.DisableZswap
+	return nil
+}
+
+// Convert_v1beta1_MemorySwap_To_v1alpha1_MemorySwap is an autogenerated conversion function.
+func Convert_v1beta1_MemorySwap_To_v1alpha1_MemorySwap(in *v1beta1.MemorySwap, out *MemorySwap, s conversion.Scope) error {
+	return autoConvert_v1beta1_MemorySwap_To_v1alpha1_MemorySwap(in, out, s)
+}
+
+func autoConvert_v1alpha1_MetadataService_To_v1beta1_MetadataService(in *MetadataService, out *v1beta1.MetadataService, s conversion.Scope) error {
+	out.UserData = in.UserData
+	out.UserDataBase64 = in.UserDataBase64
+	out.UserDataSecretName = in.UserDataSecretName
+	return nil
+}
+
+// Convert_v1alpha1_MetadataService_To_v1beta1_MetadataService is an autogenerated conversion function.
+func Convert_v1alpha1_MetadataService_To_v1beta1_MetadataService(in *MetadataService, out *v1beta1.MetadataService, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetadataService_To_v1beta1_MetadataService(in, out, s)
+}
+
+func autoConvert_v1beta1_MetadataService_To_v1alpha1_MetadataService(in *v1beta1.MetadataService, out *MetadataService, s conversion.Scope) error {
+	out.UserData = in.UserData
+	out.UserDataBase64 = in.UserDataBase64
+	out.UserDataSecretName = in.UserDataSecretName
+	return nil
+}
+
+// Convert_v1beta1_MetadataService_To_v1alpha1_MetadataService is an autogenerated conversion function.
+func Convert_v1beta1_MetadataService_To_v1alpha1_MetadataService(in *v1beta1.MetadataService, out *MetadataService, s conversion.Scope) error {
+	return autoConvert_v1beta1_MetadataService_To_v1alpha1_MetadataService(in, out, s)
+}
+
+func autoConvert_v1alpha1_MultusNetworkSource_To_v1beta1_MultusNetworkSource(in *MultusNetworkSource, out *v1beta1.MultusNetworkSource, s conversion.Scope) error {
+	out.NetworkName = in.NetworkName
+	return nil
+}
+
+// Convert_v1alpha1_MultusNetworkSource_To_v1beta1_MultusNetworkSource is an autogenerated conversion function.
+func Convert_v1alpha1_MultusNetworkSource_To_v1beta1_MultusNetworkSource(in *MultusNetworkSource, out *v1beta1.MultusNetworkSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MultusNetworkSource_To_v1beta1_MultusNetworkSource(in, out, s)
+}
+
+func autoConvert_v1beta1_MultusNetworkSource_To_v1alpha1_MultusNetworkSource(in *v1beta1.MultusNetworkSource, out *MultusNetworkSource, s conversion.Scope) error {
+	out.NetworkName = in.NetworkName
+	return nil
+}
+
+// Convert_v1beta1_MultusNetworkSource_To_v1alpha1_MultusNetworkSource is an autogenerated conversion function.
+func Convert_v1beta1_MultusNetworkSource_To_v1alpha1_MultusNetworkSource(in *v1beta1.MultusNetworkSource, out *MultusNetworkSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_MultusNetworkSource_To_v1alpha1_MultusNetworkSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_Network_To_v1beta1_Network(in *Network, out *v1beta1.Network, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1alpha1_NetworkSource_To_v1beta1_NetworkSource(&in.NetworkSource, &out.NetworkSource, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Network_To_v1beta1_Network is an autogenerated conversion function.
+func Convert_v1alpha1_Network_To_v1beta1_Network(in *Network, out *v1beta1.Network, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Network_To_v1beta1_Network(in, out, s)
+}
+
+func autoConvert_v1beta1_Network_To_v1alpha1_Network(in *v1beta1.Network, out *Network, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1beta1_NetworkSource_To_v1alpha1_NetworkSource(&in.NetworkSource, &out.NetworkSource, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_Network_To_v1alpha1_Network is an autogenerated conversion function.
+func Convert_v1beta1_Network_To_v1alpha1_Network(in *v1beta1.Network, out *Network, s conversion.Scope) error {
+	return autoConvert_v1beta1_Network_To_v1alpha1_Network(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkSource_To_v1beta1_NetworkSource(in *NetworkSource, out *v1beta1.NetworkSource, s conversion.Scope) error {
+	out.Pod = (*v1beta1.PodNetworkSource)(unsafe.Pointer(in.Pod))
+	out.Multus = (*v1beta1.MultusNetworkSource)(unsafe.Pointer(in.Multus))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkSource_To_v1beta1_NetworkSource is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkSource_To_v1beta1_NetworkSource(in *NetworkSource, out *v1beta1.NetworkSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkSource_To_v1beta1_NetworkSource(in, out, s)
+}
+
+func autoConvert_v1beta1_NetworkSource_To_v1alpha1_NetworkSource(in *v1beta1.NetworkSource, out *NetworkSource, s conversion.Scope) error {
+	out.Pod = (*PodNetworkSource)(unsafe.Pointer(in.Pod))
+	out.Multus = (*MultusNetworkSource)(unsafe.Pointer(in.Multus))
+	return nil
+}
+
+// Convert_v1beta1_NetworkSource_To_v1alpha1_NetworkSource is an autogenerated conversion function.
+func Convert_v1beta1_NetworkSource_To_v1alpha1_NetworkSource(in *v1beta1.NetworkSource, out *NetworkSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_NetworkSource_To_v1alpha1_NetworkSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_PersistentVolumeClaimPopulateSource_To_v1beta1_PersistentVolumeClaimPopulateSource(in *PersistentVolumeClaimPopulateSource, out *v1beta1.PersistentVolumeClaimPopulateSource, s conversion.Scope) error {
+	out.ContainerDisk = (*v1beta1.ContainerDiskVolumeSource)(unsafe.Pointer(in.ContainerDisk))
+	out.HTTPDisk = (*v1beta1.HTTPDiskVolumeSource)(unsafe.Pointer(in.HTTPDisk))
+	out.Grow = in.Grow
+	return nil
+}
+
+// Convert_v1alpha1_PersistentVolumeClaimPopulateSource_To_v1beta1_PersistentVolumeClaimPopulateSource is an autogenerated conversion function.
+func Convert_v1alpha1_PersistentVolumeClaimPopulateSource_To_v1beta1_PersistentVolumeClaimPopulateSource(in *PersistentVolumeClaimPopulateSource, out *v1beta1.PersistentVolumeClaimPopulateSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PersistentVolumeClaimPopulateSource_To_v1beta1_PersistentVolumeClaimPopulateSource(in, out, s)
+}
+
+func autoConvert_v1beta1_PersistentVolumeClaimPopulateSource_To_v1alpha1_PersistentVolumeClaimPopulateSource(in *v1beta1.PersistentVolumeClaimPopulateSource, out *PersistentVolumeClaimPopulateSource, s conversion.Scope) error {
+	out.ContainerDisk = (*ContainerDiskVolumeSource)(unsafe.Pointer(in.ContainerDisk))
+	out.HTTPDisk = (*HTTPDiskVolumeSource)(unsafe.Pointer(in.HTTPDisk))
+	out.Grow = in.Grow
+	return nil
+}
+
+// Convert_v1beta1_PersistentVolumeClaimPopulateSource_To_v1alpha1_PersistentVolumeClaimPopulateSource is an autogenerated conversion function.
+func Convert_v1beta1_PersistentVolumeClaimPopulateSource_To_v1alpha1_PersistentVolumeClaimPopulateSource(in *v1beta1.PersistentVolumeClaimPopulateSource, out *PersistentVolumeClaimPopulateSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_PersistentVolumeClaimPopulateSource_To_v1alpha1_PersistentVolumeClaimPopulateSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_PersistentVolumeClaimVolumeSource_To_v1beta1_PersistentVolumeClaimVolumeSource(in *PersistentVolumeClaimVolumeSource, out *v1beta1.PersistentVolumeClaimVolumeSource, s conversion.Scope) error {
+	out.ClaimName = in.ClaimName
+	out.Populate = (*v1beta1.PersistentVolumeClaimPopulateSource)(unsafe.Pointer(in.Populate))
+	return nil
+}
+
+// Convert_v1alpha1_PersistentVolumeClaimVolumeSource_To_v1beta1_PersistentVolumeClaimVolumeSource is an autogenerated conversion function.
+func Convert_v1alpha1_PersistentVolumeClaimVolumeSource_To_v1beta1_PersistentVolumeClaimVolumeSource(in *PersistentVolumeClaimVolumeSource, out *v1beta1.PersistentVolumeClaimVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PersistentVolumeClaimVolumeSource_To_v1beta1_PersistentVolumeClaimVolumeSource(in, out, s)
+}
+
+func autoConvert_v1beta1_PersistentVolumeClaimVolumeSource_To_v1alpha1_PersistentVolumeClaimVolumeSource(in *v1beta1.PersistentVolumeClaimVolumeSource, out *PersistentVolumeClaimVolumeSource, s conversion.Scope) error {
+	out.ClaimName = in.ClaimName
+	out.Populate = (*PersistentVolumeClaimPopulateSource)(unsafe.Pointer(in.Populate))
+	return nil
+}
+
+// Convert_v1beta1_PersistentVolumeClaimVolumeSource_To_v1alpha1_PersistentVolumeClaimVolumeSource is an autogenerated conversion function.
+func Convert_v1beta1_PersistentVolumeClaimVolumeSource_To_v1alpha1_PersistentVolumeClaimVolumeSource(in *v1beta1.PersistentVolumeClaimVolumeSource, out *PersistentVolumeClaimVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_PersistentVolumeClaimVolumeSource_To_v1alpha1_PersistentVolumeClaimVolumeSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_PodNetworkSource_To_v1beta1_PodNetworkSource(in *PodNetworkSource, out *v1beta1.PodNetworkSource, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha1_PodNetworkSource_To_v1beta1_PodNetworkSource is an autogenerated conversion function.
+func Convert_v1alpha1_PodNetworkSource_To_v1beta1_PodNetworkSource(in *PodNetworkSource, out *v1beta1.PodNetworkSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PodNetworkSource_To_v1beta1_PodNetworkSource(in, out, s)
+}
+
+func autoConvert_v1beta1_PodNetworkSource_To_v1alpha1_PodNetworkSource(in *v1beta1.PodNetworkSource, out *PodNetworkSource, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1beta1_PodNetworkSource_To_v1alpha1_PodNetworkSource is an autogenerated conversion function.
+func Convert_v1beta1_PodNetworkSource_To_v1alpha1_PodNetworkSource(in *v1beta1.PodNetworkSource, out *PodNetworkSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_PodNetworkSource_To_v1alpha1_PodNetworkSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_RNG_To_v1beta1_RNG(in *RNG, out *v1beta1.RNG, s conversion.Scope) error {
+	out.Source = in.Source
+	out.Disabled = in.Disabled
+	return nil
+}
+
+// Convert_v1alpha1_RNG_To_v1beta1_RNG is an autogenerated conversion function.
+func Convert_v1alpha1_RNG_To_v1beta1_RNG(in *RNG, out *v1beta1.RNG, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RNG_To_v1beta1_RNG(in, out, s)
+}
+
+func autoConvert_v1beta1_RNG_To_v1alpha1_RNG(in *v1beta1.RNG, out *RNG, s conversion.Scope) error {
+	out.Source = in.Source
+	out.Disabled = in.Disabled
+	return nil
+}
+
+// Convert_v1beta1_RNG_To_v1alpha1_RNG is an autogenerated conversion function.
+func Convert_v1beta1_RNG_To_v1alpha1_RNG(in *v1beta1.RNG, out *RNG, s conversion.Scope) error {
+	return autoConvert_v1beta1_RNG_To_v1alpha1_RNG(in, out, s)
+}
+
+func autoConvert_v1alpha1_Realtime_To_v1beta1_Realtime(in *Realtime, out *v1beta1.Realtime, s conversion.Scope) error {
+	out.Priority = in.Priority
+	return nil
+}
+
+// Convert_v1alpha1_Realtime_To_v1beta1_Realtime is an autogenerated conversion function.
+func Convert_v1alpha1_Realtime_To_v1beta1_Realtime(in *Realtime, out *v1beta1.Realtime, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Realtime_To_v1beta1_Realtime(in, out, s)
+}
+
+func autoConvert_v1beta1_Realtime_To_v1alpha1_Realtime(in *v1beta1.Realtime, out *Realtime, s conversion.Scope) error {
+	out.Priority = in.Priority
+	return nil
+}
+
+// Convert_v1beta1_Realtime_To_v1alpha1_Realtime is an autogenerated conversion function.
+func Convert_v1beta1_Realtime_To_v1alpha1_Realtime(in *v1beta1.Realtime, out *Realtime, s conversion.Scope) error {
+	return autoConvert_v1beta1_Realtime_To_v1alpha1_Realtime(in, out, s)
+}
+
+func autoConvert_v1alpha1_SSHPublicKey_To_v1beta1_SSHPublicKey(in *SSHPublicKey, out *v1beta1.SSHPublicKey, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_v1alpha1_SSHPublicKey_To_v1beta1_SSHPublicKey is an autogenerated conversion function.
+func Convert_v1alpha1_SSHPublicKey_To_v1beta1_SSHPublicKey(in *SSHPublicKey, out *v1beta1.SSHPublicKey, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SSHPublicKey_To_v1beta1_SSHPublicKey(in, out, s)
+}
+
+func autoConvert_v1beta1_SSHPublicKey_To_v1alpha1_SSHPublicKey(in *v1beta1.SSHPublicKey, out *SSHPublicKey, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_v1beta1_SSHPublicKey_To_v1alpha1_SSHPublicKey is an autogenerated conversion function.
+func Convert_v1beta1_SSHPublicKey_To_v1alpha1_SSHPublicKey(in *v1beta1.SSHPublicKey, out *SSHPublicKey, s conversion.Scope) error {
+	return autoConvert_v1beta1_SSHPublicKey_To_v1alpha1_SSHPublicKey(in, out, s)
+}
+
+func autoConvert_v1alpha1_Schedule_To_v1beta1_Schedule(in *Schedule, out *v1beta1.Schedule, s conversion.Scope) error {
+	out.Start = in.Start
+	out.Stop = in.Stop
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1alpha1_Schedule_To_v1beta1_Schedule is an autogenerated conversion function.
+func Convert_v1alpha1_Schedule_To_v1beta1_Schedule(in *Schedule, out *v1beta1.Schedule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Schedule_To_v1beta1_Schedule(in, out, s)
+}
+
+func autoConvert_v1beta1_Schedule_To_v1alpha1_Schedule(in *v1beta1.Schedule, out *Schedule, s conversion.Scope) error {
+	out.Start = in.Start
+	out.Stop = in.Stop
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1beta1_Schedule_To_v1alpha1_Schedule is an autogenerated conversion function.
+func Convert_v1beta1_Schedule_To_v1alpha1_Schedule(in *v1beta1.Schedule, out *Schedule, s conversion.Scope) error {
+	return autoConvert_v1beta1_Schedule_To_v1alpha1_Schedule(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecretVolumeSource_To_v1beta1_SecretVolumeSource(in *SecretVolumeSource, out *v1beta1.SecretVolumeSource, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.Format = v1beta1.ConfigDiskFormat(in.Format)
+	out.VolumeLabel = in.VolumeLabel
+	return nil
+}
+
+// Convert_v1alpha1_SecretVolumeSource_To_v1beta1_SecretVolumeSource is an autogenerated conversion function.
+func Convert_v1alpha1_SecretVolumeSource_To_v1beta1_SecretVolumeSource(in *SecretVolumeSource, out *v1beta1.SecretVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretVolumeSource_To_v1beta1_SecretVolumeSource(in, out, s)
+}
+
+func autoConvert_v1beta1_SecretVolumeSource_To_v1alpha1_SecretVolumeSource(in *v1beta1.SecretVolumeSource, out *SecretVolumeSource, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.Format = ConfigDiskFormat(in.Format)
+	out.VolumeLabel = in.VolumeLabel
+	return nil
+}
+
+// Convert_v1beta1_SecretVolumeSource_To_v1alpha1_SecretVolumeSource is an autogenerated conversion function.
+func Convert_v1beta1_SecretVolumeSource_To_v1alpha1_SecretVolumeSource(in *v1beta1.SecretVolumeSource, out *SecretVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecretVolumeSource_To_v1alpha1_SecretVolumeSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_ServiceAccountTokenVolumeSource_To_v1beta1_ServiceAccountTokenVolumeSource(in *ServiceAccountTokenVolumeSource, out *v1beta1.ServiceAccountTokenVolumeSource, s conversion.Scope) error {
+	out.ServiceAccountName = in.ServiceAccountName
+	out.Audience = in.Audience
+	out.ExpirationSeconds = (*int64)(unsafe.Pointer(in.ExpirationSeconds))
+	return nil
+}
+
+// Convert_v1alpha1_ServiceAccountTokenVolumeSource_To_v1beta1_ServiceAccountTokenVolumeSource is an autogenerated conversion function.
+func Convert_v1alpha1_ServiceAccountTokenVolumeSource_To_v1beta1_ServiceAccountTokenVolumeSource(in *ServiceAccountTokenVolumeSource, out *v1beta1.ServiceAccountTokenVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServiceAccountTokenVolumeSource_To_v1beta1_ServiceAccountTokenVolumeSource(in, out, s)
+}
+
+func autoConvert_v1beta1_ServiceAccountTokenVolumeSource_To_v1alpha1_ServiceAccountTokenVolumeSource(in *v1beta1.ServiceAccountTokenVolumeSource, out *ServiceAccountTokenVolumeSource, s conversion.Scope) error {
+	out.ServiceAccountName = in.ServiceAccountName
+	out.Audience = in.Audience
+	out.ExpirationSeconds = (*int64)(unsafe.Pointer(in.ExpirationSeconds))
+	return nil
+}
+
+// Convert_v1beta1_ServiceAccountTokenVolumeSource_To_v1alpha1_ServiceAccountTokenVolumeSource is an autogenerated conversion function.
+func Convert_v1beta1_ServiceAccountTokenVolumeSource_To_v1alpha1_ServiceAccountTokenVolumeSource(in *v1beta1.ServiceAccountTokenVolumeSource, out *ServiceAccountTokenVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceAccountTokenVolumeSource_To_v1alpha1_ServiceAccountTokenVolumeSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_Sound_To_v1beta1_Sound(in *Sound, out *v1beta1.Sound, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha1_Sound_To_v1beta1_Sound is an autogenerated conversion function.
+func Convert_v1alpha1_Sound_To_v1beta1_Sound(in *Sound, out *v1beta1.Sound, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Sound_To_v1beta1_Sound(in, out, s)
+}
+
+func autoConvert_v1beta1_Sound_To_v1alpha1_Sound(in *v1beta1.Sound, out *Sound, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1beta1_Sound_To_v1alpha1_Sound is an autogenerated conversion function.
+func Convert_v1beta1_Sound_To_v1alpha1_Sound(in *v1beta1.Sound, out *Sound, s conversion.Scope) error {
+	return autoConvert_v1beta1_Sound_To_v1alpha1_Sound(in, out, s)
+}
+
+func autoConvert_v1alpha1_SysprepVolumeSource_To_v1beta1_SysprepVolumeSource(in *SysprepVolumeSource, out *v1beta1.SysprepVolumeSource, s conversion.Scope) error {
+	out.ConfigMapName = in.ConfigMapName
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_v1alpha1_SysprepVolumeSource_To_v1beta1_SysprepVolumeSource is an autogenerated conversion function.
+func Convert_v1alpha1_SysprepVolumeSource_To_v1beta1_SysprepVolumeSource(in *SysprepVolumeSource, out *v1beta1.SysprepVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SysprepVolumeSource_To_v1beta1_SysprepVolumeSource(in, out, s)
+}
+
+func autoConvert_v1beta1_SysprepVolumeSource_To_v1alpha1_SysprepVolumeSource(in *v1beta1.SysprepVolumeSource, out *SysprepVolumeSource, s conversion.Scope) error {
+	out.ConfigMapName = in.ConfigMapName
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_v1beta1_SysprepVolumeSource_To_v1alpha1_SysprepVolumeSource is an autogenerated conversion function.
+func Convert_v1beta1_SysprepVolumeSource_To_v1alpha1_SysprepVolumeSource(in *v1beta1.SysprepVolumeSource, out *SysprepVolumeSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_SysprepVolumeSource_To_v1alpha1_SysprepVolumeSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_Tablet_To_v1beta1_Tablet(in *Tablet, out *v1beta1.Tablet, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha1_Tablet_To_v1beta1_Tablet is an autogenerated conversion function.
+func Convert_v1alpha1_Tablet_To_v1beta1_Tablet(in *Tablet, out *v1beta1.Tablet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Tablet_To_v1beta1_Tablet(in, out, s)
+}
+
+func autoConvert_v1beta1_Tablet_To_v1alpha1_Tablet(in *v1beta1.Tablet, out *Tablet, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1beta1_Tablet_To_v1alpha1_Tablet is an autogenerated conversion function.
+func Convert_v1beta1_Tablet_To_v1alpha1_Tablet(in *v1beta1.Tablet, out *Tablet, s conversion.Scope) error {
+	return autoConvert_v1beta1_Tablet_To_v1alpha1_Tablet(in, out, s)
+}
+
+func autoConvert_v1alpha1_USBController_To_v1beta1_USBController(in *USBController, out *v1beta1.USBController, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha1_USBController_To_v1beta1_USBController is an autogenerated conversion function.
+func Convert_v1alpha1_USBController_To_v1beta1_USBController(in *USBController, out *v1beta1.USBController, s conversion.Scope) error {
+	return autoConvert_v1alpha1_USBController_To_v1beta1_USBController(in, out, s)
+}
+
+func autoConvert_v1beta1_USBController_To_v1alpha1_USBController(in *v1beta1.USBController, out *USBController, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1beta1_USBController_To_v1alpha1_USBController is an autogenerated conversion function.
+func Convert_v1beta1_USBController_To_v1alpha1_USBController(in *v1beta1.USBController, out *USBController, s conversion.Scope) error {
+	return autoConvert_v1beta1_USBController_To_v1alpha1_USBController(in, out, s)
+}
+
+func autoConvert_v1alpha1_VLANRange_To_v1beta1_VLANRange(in *VLANRange, out *v1beta1.VLANRange, s conversion.Scope) error {
+	out.ID = in.ID
+	out.EndID = in.EndID
+	return nil
+}
+
+// Convert_v1alpha1_VLANRange_To_v1beta1_VLANRange is an autogenerated conversion function.
+func Convert_v1alpha1_VLANRange_To_v1beta1_VLANRange(in *VLANRange, out *v1beta1.VLANRange, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VLANRange_To_v1beta1_VLANRange(in, out, s)
+}
+
+func autoConvert_v1beta1_VLANRange_To_v1alpha1_VLANRange(in *v1beta1.VLANRange, out *VLANRange, s conversion.Scope) error {
+	out.ID = in.ID
+	out.EndID = in.EndID
+	return nil
+}
+
+// Convert_v1beta1_VLANRange_To_v1alpha1_VLANRange is an autogenerated conversion function.
+func Convert_v1beta1_VLANRange_To_v1alpha1_VLANRange(in *v1beta1.VLANRange, out *VLANRange, s conversion.Scope) error {
+	return autoConvert_v1beta1_VLANRange_To_v1alpha1_VLANRange(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualMachine_To_v1beta1_VirtualMachine(in *VirtualMachine, out *v1beta1.VirtualMachine, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_VirtualMachineSpec_To_v1beta1_VirtualMachineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_VirtualMachineStatus_To_v1beta1_VirtualMachineStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VirtualMachine_To_v1beta1_VirtualMachine is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualMachine_To_v1beta1_VirtualMachine(in *VirtualMachine, out *v1beta1.VirtualMachine, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualMachine_To_v1beta1_VirtualMachine(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachine_To_v1alpha1_VirtualMachine(in *v1beta1.VirtualMachine, out *VirtualMachine, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_VirtualMachineSpec_To_v1alpha1_VirtualMachineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_VirtualMachineStatus_To_v1alpha1_VirtualMachineStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_VirtualMachine_To_v1alpha1_VirtualMachine is an autogenerated conversion function.
+func Convert_v1beta1_VirtualMachine_To_v1alpha1_VirtualMachine(in *v1beta1.VirtualMachine, out *VirtualMachine, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualMachine_To_v1alpha1_VirtualMachine(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualMachineAddress_To_v1beta1_VirtualMachineAddress(in *VirtualMachineAddress, out *v1beta1.VirtualMachineAddress, s conversion.Scope) error {
+	out.Type = v1beta1.VirtualMachineAddressType(in.Type)
+	out.Address = in.Address
+	return nil
+}
+
+// Convert_v1alpha1_VirtualMachineAddress_To_v1beta1_VirtualMachineAddress is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualMachineAddress_To_v1beta1_VirtualMachineAddress(in *VirtualMachineAddress, out *v1beta1.VirtualMachineAddress, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualMachineAddress_To_v1beta1_VirtualMachineAddress(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachineAddress_To_v1alpha1_VirtualMachineAddress(in *v1beta1.VirtualMachineAddress, out *VirtualMachineAddress, s conversion.Scope) error {
+	out.Type = VirtualMachineAddressType(in.Type)
+	out.Address = in.Address
+	return nil
+}
+
+// Convert_v1beta1_VirtualMachineAddress_To_v1alpha1_VirtualMachineAddress is an autogenerated conversion function.
+func Convert_v1beta1_VirtualMachineAddress_To_v1alpha1_VirtualMachineAddress(in *v1beta1.VirtualMachineAddress, out *VirtualMachineAddress, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualMachineAddress_To_v1alpha1_VirtualMachineAddress(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualMachineExport_To_v1beta1_VirtualMachineExport(in *VirtualMachineExport, out *v1beta1.VirtualMachineExport, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_VirtualMachineExportSpec_To_v1beta1_VirtualMachineExportSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_VirtualMachineExportStatus_To_v1beta1_VirtualMachineExportStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VirtualMachineExport_To_v1beta1_VirtualMachineExport is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualMachineExport_To_v1beta1_VirtualMachineExport(in *VirtualMachineExport, out *v1beta1.VirtualMachineExport, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualMachineExport_To_v1beta1_VirtualMachineExport(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachineExport_To_v1alpha1_VirtualMachineExport(in *v1beta1.VirtualMachineExport, out *VirtualMachineExport, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_VirtualMachineExportSpec_To_v1alpha1_VirtualMachineExportSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_VirtualMachineExportStatus_To_v1alpha1_VirtualMachineExportStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_VirtualMachineExport_To_v1alpha1_VirtualMachineExport is an autogenerated conversion function.
+func Convert_v1beta1_VirtualMachineExport_To_v1alpha1_VirtualMachineExport(in *v1beta1.VirtualMachineExport, out *VirtualMachineExport, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualMachineExport_To_v1alpha1_VirtualMachineExport(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualMachineExportHTTPTarget_To_v1beta1_VirtualMachineExportHTTPTarget(in *VirtualMachineExportHTTPTarget, out *v1beta1.VirtualMachineExportHTTPTarget, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha1_VirtualMachineExportHTTPTarget_To_v1beta1_VirtualMachineExportHTTPTarget is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualMachineExportHTTPTarget_To_v1beta1_VirtualMachineExportHTTPTarget(in *VirtualMachineExportHTTPTarget, out *v1beta1.VirtualMachineExportHTTPTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualMachineExportHTTPTarget_To_v1beta1_VirtualMachineExportHTTPTarget(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachineExportHTTPTarget_To_v1alpha1_VirtualMachineExportHTTPTarget(in *v1beta1.VirtualMachineExportHTTPTarget, out *VirtualMachineExportHTTPTarget, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1beta1_VirtualMachineExportHTTPTarget_To_v1alpha1_VirtualMachineExportHTTPTarget is an autogenerated conversion function.
+func Convert_v1beta1_VirtualMachineExportHTTPTarget_To_v1alpha1_VirtualMachineExportHTTPTarget(in *v1beta1.VirtualMachineExportHTTPTarget, out *VirtualMachineExportHTTPTarget, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualMachineExportHTTPTarget_To_v1alpha1_VirtualMachineExportHTTPTarget(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualMachineExportList_To_v1beta1_VirtualMachineExportList(in *VirtualMachineExportList, out *v1beta1.VirtualMachineExportList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta1.VirtualMachineExport, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_VirtualMachineExport_To_v1beta1_VirtualMachineExport(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VirtualMachineExportList_To_v1beta1_VirtualMachineExportList is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualMachineExportList_To_v1beta1_VirtualMachineExportList(in *VirtualMachineExportList, out *v1beta1.VirtualMachineExportList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualMachineExportList_To_v1beta1_VirtualMachineExportList(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachineExportList_To_v1alpha1_VirtualMachineExportList(in *v1beta1.VirtualMachineExportList, out *VirtualMachineExportList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineExport, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_VirtualMachineExport_To_v1alpha1_VirtualMachineExport(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_VirtualMachineExportList_To_v1alpha1_VirtualMachineExportList is an autogenerated conversion function.
+func Convert_v1beta1_VirtualMachineExportList_To_v1alpha1_VirtualMachineExportList(in *v1beta1.VirtualMachineExportList, out *VirtualMachineExportList, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualMachineExportList_To_v1alpha1_VirtualMachineExportList(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualMachineExportOCITarget_To_v1beta1_VirtualMachineExportOCITarget(in *VirtualMachineExportOCITarget, out *v1beta1.VirtualMachineExportOCITarget, s conversion.Scope) error {
+	out.Image = in.Image
+	out.PushSecretName = in.PushSecretName
+	return nil
+}
+
+// Convert_v1alpha1_VirtualMachineExportOCITarget_To_v1beta1_VirtualMachineExportOCITarget is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualMachineExportOCITarget_To_v1beta1_VirtualMachineExportOCITarget(in *VirtualMachineExportOCITarget, out *v1beta1.VirtualMachineExportOCITarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualMachineExportOCITarget_To_v1beta1_VirtualMachineExportOCITarget(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachineExportOCITarget_To_v1alpha1_VirtualMachineExportOCITarget(in *v1beta1.VirtualMachineExportOCITarget, out *VirtualMachineExportOCITarget, s conversion.Scope) error {
+	out.Image = in.Image
+	out.PushSecretName = in.PushSecretName
+	return nil
+}
+
+// Convert_v1beta1_VirtualMachineExportOCITarget_To_v1alpha1_VirtualMachineExportOCITarget is an autogenerated conversion function.
+func Convert_v1beta1_VirtualMachineExportOCITarget_To_v1alpha1_VirtualMachineExportOCITarget(in *v1beta1.VirtualMachineExportOCITarget, out *VirtualMachineExportOCITarget, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualMachineExportOCITarget_To_v1alpha1_VirtualMachineExportOCITarget(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualMachineExportSpec_To_v1beta1_VirtualMachineExportSpec(in *VirtualMachineExportSpec, out *v1beta1.VirtualMachineExportSpec, s conversion.Scope) error {
+	// WARNING: in.VMName requires manual conversion: does not exist in peer-type
+	out.VolumeName = in.VolumeName
+	out.Format = v1beta1.VirtualMachineExportFormat(in.Format)
+	if err := Convert_v1alpha1_VirtualMachineExportTarget_To_v1beta1_VirtualMachineExportTarget(&in.VirtualMachineExportTarget, &out.VirtualMachineExportTarget, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1beta1_VirtualMachineExportSpec_To_v1alpha1_VirtualMachineExportSpec(in *v1beta1.VirtualMachineExportSpec, out *VirtualMachineExportSpec, s conversion.Scope) error {
+	// WARNING: in.VirtualMachineName requires manual conversion: does not exist in peer-type
+	out.VolumeName = in.VolumeName
+	out.Format = VirtualMachineExportFormat(in.Format)
+	if err := Convert_v1beta1_VirtualMachineExportTarget_To_v1alpha1_VirtualMachineExportTarget(&in.VirtualMachineExportTarget, &out.VirtualMachineExportTarget, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_VirtualMachineExportStatus_To_v1beta1_VirtualMachineExportStatus(in *VirtualMachineExportStatus, out *v1beta1.VirtualMachineExportStatus, s conversion.Scope) error {
+	out.Phase = v1beta1.VirtualMachineExportPhase(in.Phase)
+	out.ClaimName = in.ClaimName
+	out.SecretName = in.SecretName
+	out.URL = in.URL
+	return nil
+}
+
+// Convert_v1alpha1_VirtualMachineExportStatus_To_v1beta1_VirtualMachineExportStatus is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualMachineExportStatus_To_v1beta1_VirtualMachineExportStatus(in *VirtualMachineExportStatus, out *v1beta1.VirtualMachineExportStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualMachineExportStatus_To_v1beta1_VirtualMachineExportStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachineExportStatus_To_v1alpha1_VirtualMachineExportStatus(in *v1beta1.VirtualMachineExportStatus, out *VirtualMachineExportStatus, s conversion.Scope) error {
+	out.Phase = VirtualMachineExportPhase(in.Phase)
+	out.ClaimName = in.ClaimName
+	out.SecretName = in.SecretName
+	out.URL = in.URL
+	return nil
+}
+
+// Convert_v1beta1_VirtualMachineExportStatus_To_v1alpha1_VirtualMachineExportStatus is an autogenerated conversion function.
+func Convert_v1beta1_VirtualMachineExportStatus_To_v1alpha1_VirtualMachineExportStatus(in *v1beta1.VirtualMachineExportStatus, out *VirtualMachineExportStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualMachineExportStatus_To_v1alpha1_VirtualMachineExportStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualMachineExportTarget_To_v1beta1_VirtualMachineExportTarget(in *VirtualMachineExportTarget, out *v1beta1.VirtualMachineExportTarget, s conversion.Scope) error {
+	out.HTTP = (*v1beta1.VirtualMachineExportHTTPTarget)(unsafe.Pointer(in.HTTP))
+	out.OCI = (*v1beta1.VirtualMachineExportOCITarget)(unsafe.Pointer(in.OCI))
+	return nil
+}
+
+// Convert_v1alpha1_VirtualMachineExportTarget_To_v1beta1_VirtualMachineExportTarget is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualMachineExportTarget_To_v1beta1_VirtualMachineExportTarget(in *VirtualMachineExportTarget, out *v1beta1.VirtualMachineExportTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualMachineExportTarget_To_v1beta1_VirtualMachineExportTarget(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachineExportTarget_To_v1alpha1_VirtualMachineExportTarget(in *v1beta1.VirtualMachineExportTarget, out *VirtualMachineExportTarget, s conversion.Scope) error {
+	out.HTTP = (*VirtualMachineExportHTTPTarget)(unsafe.Pointer(in.HTTP))
+	out.OCI = (*VirtualMachineExportOCITarget)(unsafe.Pointer(in.OCI))
+	return nil
+}
+
+// Convert_v1beta1_VirtualMachineExportTarget_To_v1alpha1_VirtualMachineExportTarget is an autogenerated conversion function.
+func Convert_v1beta1_VirtualMachineExportTarget_To_v1alpha1_VirtualMachineExportTarget(in *v1beta1.VirtualMachineExportTarget, out *VirtualMachineExportTarget, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualMachineExportTarget_To_v1alpha1_VirtualMachineExportTarget(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualMachineList_To_v1beta1_VirtualMachineList(in *VirtualMachineList, out *v1beta1.VirtualMachineList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta1.VirtualMachine, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_VirtualMachine_To_v1beta1_VirtualMachine(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VirtualMachineList_To_v1beta1_VirtualMachineList is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualMachineList_To_v1beta1_VirtualMachineList(in *VirtualMachineList, out *v1beta1.VirtualMachineList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualMachineList_To_v1beta1_VirtualMachineList(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachineList_To_v1alpha1_VirtualMachineList(in *v1beta1.VirtualMachineList, out *VirtualMachineList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachine, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_VirtualMachine_To_v1alpha1_VirtualMachine(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_VirtualMachineList_To_v1alpha1_VirtualMachineList is an autogenerated conversion function.
+func Convert_v1beta1_VirtualMachineList_To_v1alpha1_VirtualMachineList(in *v1beta1.VirtualMachineList, out *VirtualMachineList, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualMachineList_To_v1alpha1_VirtualMachineList(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualMachineMigration_To_v1beta1_VirtualMachineMigration(in *VirtualMachineMigration, out *v1beta1.VirtualMachineMigration, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_VirtualMachineMigrationSpec_To_v1beta1_VirtualMachineMigrationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_VirtualMachineMigrationStatus_To_v1beta1_VirtualMachineMigrationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VirtualMachineMigration_To_v1beta1_VirtualMachineMigration is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualMachineMigration_To_v1beta1_VirtualMachineMigration(in *VirtualMachineMigration, out *v1beta1.VirtualMachineMigration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualMachineMigration_To_v1beta1_VirtualMachineMigration(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachineMigration_To_v1alpha1_VirtualMachineMigration(in *v1beta1.VirtualMachineMigration, out *VirtualMachineMigration, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_VirtualMachineMigrationSpec_To_v1alpha1_VirtualMachineMigrationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_VirtualMachineMigrationStatus_To_v1alpha1_VirtualMachineMigrationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_VirtualMachineMigration_To_v1alpha1_VirtualMachineMigration is an autogenerated conversion function.
+func Convert_v1beta1_VirtualMachineMigration_To_v1alpha1_VirtualMachineMigration(in *v1beta1.VirtualMachineMigration, out *VirtualMachineMigration, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualMachineMigration_To_v1alpha1_VirtualMachineMigration(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualMachineMigrationList_To_v1beta1_VirtualMachineMigrationList(in *VirtualMachineMigrationList, out *v1beta1.VirtualMachineMigrationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta1.VirtualMachineMigration, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_VirtualMachineMigration_To_v1beta1_VirtualMachineMigration(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VirtualMachineMigrationList_To_v1beta1_VirtualMachineMigrationList is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualMachineMigrationList_To_v1beta1_VirtualMachineMigrationList(in *VirtualMachineMigrationList, out *v1beta1.VirtualMachineMigrationList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualMachineMigrationList_To_v1beta1_VirtualMachineMigrationList(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachineMigrationList_To_v1alpha1_VirtualMachineMigrationList(in *v1beta1.VirtualMachineMigrationList, out *VirtualMachineMigrationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineMigration, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_VirtualMachineMigration_To_v1alpha1_VirtualMachineMigration(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_VirtualMachineMigrationList_To_v1alpha1_VirtualMachineMigrationList is an autogenerated conversion function.
+func Convert_v1beta1_VirtualMachineMigrationList_To_v1alpha1_VirtualMachineMigrationList(in *v1beta1.VirtualMachineMigrationList, out *VirtualMachineMigrationList, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualMachineMigrationList_To_v1alpha1_VirtualMachineMigrationList(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualMachineMigrationSpec_To_v1beta1_VirtualMachineMigrationSpec(in *VirtualMachineMigrationSpec, out *v1beta1.VirtualMachineMigrationSpec, s conversion.Scope) error {
+	// WARNING: in.VMName requires manual conversion: does not exist in peer-type
+	out.Type = v1beta1.VirtualMachineMigrationType(in.Type)
+	out.TTLSecondsAfterFinished = (*int32)(unsafe.Pointer(in.TTLSecondsAfterFinished))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Affinity = (*v1.Affinity)(unsafe.Pointer(in.Affinity))
+	out.DryRun = in.DryRun
+	return nil
+}
+
+func autoConvert_v1beta1_VirtualMachineMigrationSpec_To_v1alpha1_VirtualMachineMigrationSpec(in *v1beta1.VirtualMachineMigrationSpec, out *VirtualMachineMigrationSpec, s conversion.Scope) error {
+	// WARNING: in.VirtualMachineName requires manual conversion: does not exist in peer-type
+	out.Type = VirtualMachineMigrationType(in.Type)
+	out.TTLSecondsAfterFinished = (*int32)(unsafe.Pointer(in.TTLSecondsAfterFinished))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Affinity = (*v1.Affinity)(unsafe.Pointer(in.Affinity))
+	out.DryRun = in.DryRun
+	return nil
+}
+
+func autoConvert_v1alpha1_VirtualMachineMigrationStatus_To_v1beta1_VirtualMachineMigrationStatus(in *VirtualMachineMigrationStatus, out *v1beta1.VirtualMachineMigrationStatus, s conversion.Scope) error {
+	out.Phase = v1beta1.VirtualMachineMigrationPhase(in.Phase)
+	out.SourceNodeName = in.SourceNodeName
+	out.TargetNodeName = in.TargetNodeName
+	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.CompletionTime = (*metav1.Time)(unsafe.Pointer(in.CompletionTime))
+	return nil
+}
+
+// Convert_v1alpha1_VirtualMachineMigrationStatus_To_v1beta1_VirtualMachineMigrationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualMachineMigrationStatus_To_v1beta1_VirtualMachineMigrationStatus(in *VirtualMachineMigrationStatus, out *v1beta1.VirtualMachineMigrationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualMachineMigrationStatus_To_v1beta1_VirtualMachineMigrationStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachineMigrationStatus_To_v1alpha1_VirtualMachineMigrationStatus(in *v1beta1.VirtualMachineMigrationStatus, out *VirtualMachineMigrationStatus, s conversion.Scope) error {
+	out.Phase = VirtualMachineMigrationPhase(in.Phase)
+	out.SourceNodeName = in.SourceNodeName
+	out.TargetNodeName = in.TargetNodeName
+	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.CompletionTime = (*metav1.Time)(unsafe.Pointer(in.CompletionTime))
+	return nil
+}
+
+// Convert_v1beta1_VirtualMachineMigrationStatus_To_v1alpha1_VirtualMachineMigrationStatus is an autogenerated conversion function.
+func Convert_v1beta1_VirtualMachineMigrationStatus_To_v1alpha1_VirtualMachineMigrationStatus(in *v1beta1.VirtualMachineMigrationStatus, out *VirtualMachineMigrationStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualMachineMigrationStatus_To_v1alpha1_VirtualMachineMigrationStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualMachineSpec_To_v1beta1_VirtualMachineSpec(in *VirtualMachineSpec, out *v1beta1.VirtualMachineSpec, s conversion.Scope) error {
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Affinity = (*v1.Affinity)(unsafe.Pointer(in.Affinity))
+	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.Resources = in.Resources
+	out.LivenessProbe = (*v1.Probe)(unsafe.Pointer(in.LivenessProbe))
+	out.ReadinessProbe = (*v1.Probe)(unsafe.Pointer(in.ReadinessProbe))
+	out.PriorityClassName = in.PriorityClassName
+	out.Hostname = in.Hostname
+	out.Subdomain = in.Subdomain
+	out.DNSPolicy = v1.DNSPolicy(in.DNSPolicy)
+	out.DNSConfig = (*v1.PodDNSConfig)(unsafe.Pointer(in.DNSConfig))
+	out.RunPolicy = v1beta1.RunPolicy(in.RunPolicy)
+	out.UpdateStrategy = v1beta1.UpdateStrategy(in.UpdateStrategy)
+	if err := Convert_v1alpha1_Instance_To_v1beta1_Instance(&in.Instance, &out.Instance, s); err != nil {
+		return err
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]v1beta1.Volume, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_Volume_To_v1beta1_Volume(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Volumes = nil
+	}
+	out.Networks = *(*[]v1beta1.Network)(unsafe.Pointer(&in.Networks))
+	out.MemoryDump = (*v1beta1.MemoryDump)(unsafe.Pointer(in.MemoryDump))
+	out.Hibernation = (*v1beta1.Hibernation)(unsafe.Pointer(in.Hibernation))
+	out.SSHPublicKeys = *(*[]v1beta1.SSHPublicKey)(unsafe.Pointer(&in.SSHPublicKeys))
+	out.MetadataService = (*v1beta1.MetadataService)(unsafe.Pointer(in.MetadataService))
+	out.Schedule = (*v1beta1.Schedule)(unsafe.Pointer(in.Schedule))
+	return nil
+}
+
+// Convert_v1alpha1_VirtualMachineSpec_To_v1beta1_VirtualMachineSpec is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualMachineSpec_To_v1beta1_VirtualMachineSpec(in *VirtualMachineSpec, out *v1beta1.VirtualMachineSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualMachineSpec_To_v1beta1_VirtualMachineSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachineSpec_To_v1alpha1_VirtualMachineSpec(in *v1beta1.VirtualMachineSpec, out *VirtualMachineSpec, s conversion.Scope) error {
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Affinity = (*v1.Affinity)(unsafe.Pointer(in.Affinity))
+	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.Resources = in.Resources
+	out.LivenessProbe = (*v1.Probe)(unsafe.Pointer(in.LivenessProbe))
+	out.ReadinessProbe = (*v1.Probe)(unsafe.Pointer(in.ReadinessProbe))
+	out.PriorityClassName = in.PriorityClassName
+	out.Hostname = in.Hostname
+	out.Subdomain = in.Subdomain
+	out.DNSPolicy = v1.DNSPolicy(in.DNSPolicy)
+	out.DNSConfig = (*v1.PodDNSConfig)(unsafe.Pointer(in.DNSConfig))
+	out.RunPolicy = RunPolicy(in.RunPolicy)
+	out.UpdateStrategy = UpdateStrategy(in.UpdateStrategy)
+	if err := Convert_v1beta1_Instance_To_v1alpha1_Instance(&in.Instance, &out.Instance, s); err != nil {
+		return err
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]Volume, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_Volume_To_v1alpha1_Volume(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Volumes = nil
+	}
+	out.Networks = *(*[]Network)(unsafe.Pointer(&in.Networks))
+	out.MemoryDump = (*MemoryDump)(unsafe.Pointer(in.MemoryDump))
+	out.Hibernation = (*Hibernation)(unsafe.Pointer(in.Hibernation))
+	out.SSHPublicKeys = *(*[]SSHPublicKey)(unsafe.Pointer(&in.SSHPublicKeys))
+	out.MetadataService = (*MetadataService)(unsafe.Pointer(in.MetadataService))
+	out.Schedule = (*Schedule)(unsafe.Pointer(in.Schedule))
+	return nil
+}
+
+// Convert_v1beta1_VirtualMachineSpec_To_v1alpha1_VirtualMachineSpec is an autogenerated conversion function.
+func Convert_v1beta1_VirtualMachineSpec_To_v1alpha1_VirtualMachineSpec(in *v1beta1.VirtualMachineSpec, out *VirtualMachineSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualMachineSpec_To_v1alpha1_VirtualMachineSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualMachineStatus_To_v1beta1_VirtualMachineStatus(in *VirtualMachineStatus, out *v1beta1.VirtualMachineStatus, s conversion.Scope) error {
+	out.Phase = v1beta1.VirtualMachinePhase(in.Phase)
+	// WARNING: in.VMPodName requires manual conversion: does not exist in peer-type
+	// WARNING: in.VMPodUID requires manual conversion: does not exist in peer-type
+	// WARNING: in.VMPodIP requires manual conversion: does not exist in peer-type
+	out.NodeName = in.NodeName
+	out.PowerAction = v1beta1.VirtualMachinePowerAction(in.PowerAction)
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(v1beta1.VirtualMachineStatusMigration)
+		if err := Convert_v1alpha1_VirtualMachineStatusMigration_To_v1beta1_VirtualMachineStatusMigration(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Migration = nil
+	}
+	out.MemoryDump = (*v1beta1.VirtualMachineStatusMemoryDump)(unsafe.Pointer(in.MemoryDump))
+	out.Schedule = (*v1beta1.VirtualMachineStatusSchedule)(unsafe.Pointer(in.Schedule))
+	out.Hibernation = (*v1beta1.VirtualMachineStatusHibernation)(unsafe.Pointer(in.Hibernation))
+	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.ProviderID = in.ProviderID
+	out.Addresses = *(*[]v1beta1.VirtualMachineAddress)(unsafe.Pointer(&in.Addresses))
+	return nil
+}
+
+func autoConvert_v1beta1_VirtualMachineStatus_To_v1alpha1_VirtualMachineStatus(in *v1beta1.VirtualMachineStatus, out *VirtualMachineStatus, s conversion.Scope) error {
+	out.Phase = VirtualMachinePhase(in.Phase)
+	// WARNING: in.PodName requires manual conversion: does not exist in peer-type
+	// WARNING: in.PodUID requires manual conversion: does not exist in peer-type
+	// WARNING: in.PodIP requires manual conversion: does not exist in peer-type
+	out.NodeName = in.NodeName
+	out.PowerAction = VirtualMachinePowerAction(in.PowerAction)
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(VirtualMachineStatusMigration)
+		if err := Convert_v1beta1_VirtualMachineStatusMigration_To_v1alpha1_VirtualMachineStatusMigration(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Migration = nil
+	}
+	out.MemoryDump = (*VirtualMachineStatusMemoryDump)(unsafe.Pointer(in.MemoryDump))
+	out.Schedule = (*VirtualMachineStatusSchedule)(unsafe.Pointer(in.Schedule))
+	out.Hibernation = (*VirtualMachineStatusHibernation)(unsafe.Pointer(in.Hibernation))
+	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.ProviderID = in.ProviderID
+	out.Addresses = *(*[]VirtualMachineAddress)(unsafe.Pointer(&in.Addresses))
+	return nil
+}
+
+func autoConvert_v1alpha1_VirtualMachineStatusHibernation_To_v1beta1_VirtualMachineStatusHibernation(in *VirtualMachineStatusHibernation, out *v1beta1.VirtualMachineStatusHibernation, s conversion.Scope) error {
+	out.Phase = v1beta1.VirtualMachineHibernationPhase(in.Phase)
+	out.SnapshotName = in.SnapshotName
+	out.HibernationTime = (*metav1.Time)(unsafe.Pointer(in.HibernationTime))
+	return nil
+}
+
+// Convert_v1alpha1_VirtualMachineStatusHibernation_To_v1beta1_VirtualMachineStatusHibernation is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualMachineStatusHibernation_To_v1beta1_VirtualMachineStatusHibernation(in *VirtualMachineStatusHibernation, out *v1beta1.VirtualMachineStatusHibernation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualMachineStatusHibernation_To_v1beta1_VirtualMachineStatusHibernation(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachineStatusHibernation_To_v1alpha1_VirtualMachineStatusHibernation(in *v1beta1.VirtualMachineStatusHibernation, out *VirtualMachineStatusHibernation, s conversion.Scope) error {
+	out.Phase = VirtualMachineHibernationPhase(in.Phase)
+	out.SnapshotName = in.SnapshotName
+	out.HibernationTime = (*metav1.Time)(unsafe.Pointer(in.HibernationTime))
+	return nil
+}
+
+// Convert_v1beta1_VirtualMachineStatusHibernation_To_v1alpha1_VirtualMachineStatusHibernation is an autogenerated conversion function.
+func Convert_v1beta1_VirtualMachineStatusHibernation_To_v1alpha1_VirtualMachineStatusHibernation(in *v1beta1.VirtualMachineStatusHibernation, out *VirtualMachineStatusHibernation, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualMachineStatusHibernation_To_v1alpha1_VirtualMachineStatusHibernation(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualMachineStatusMemoryDump_To_v1beta1_VirtualMachineStatusMemoryDump(in *VirtualMachineStatusMemoryDump, out *v1beta1.VirtualMachineStatusMemoryDump, s conversion.Scope) error {
+	out.Phase = v1beta1.VirtualMachineMemoryDumpPhase(in.Phase)
+	out.FileName = in.FileName
+	out.CompletionTime = (*metav1.Time)(unsafe.Pointer(in.CompletionTime))
+	return nil
+}
+
+// Convert_v1alpha1_VirtualMachineStatusMemoryDump_To_v1beta1_VirtualMachineStatusMemoryDump is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualMachineStatusMemoryDump_To_v1beta1_VirtualMachineStatusMemoryDump(in *VirtualMachineStatusMemoryDump, out *v1beta1.VirtualMachineStatusMemoryDump, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualMachineStatusMemoryDump_To_v1beta1_VirtualMachineStatusMemoryDump(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachineStatusMemoryDump_To_v1alpha1_VirtualMachineStatusMemoryDump(in *v1beta1.VirtualMachineStatusMemoryDump, out *VirtualMachineStatusMemoryDump, s conversion.Scope) error {
+	out.Phase = VirtualMachineMemoryDumpPhase(in.Phase)
+	out.FileName = in.FileName
+	out.CompletionTime = (*metav1.Time)(unsafe.Pointer(in.CompletionTime))
+	return nil
+}
+
+// Convert_v1beta1_VirtualMachineStatusMemoryDump_To_v1alpha1_VirtualMachineStatusMemoryDump is an autogenerated conversion function.
+func Convert_v1beta1_VirtualMachineStatusMemoryDump_To_v1alpha1_VirtualMachineStatusMemoryDump(in *v1beta1.VirtualMachineStatusMemoryDump, out *VirtualMachineStatusMemoryDump, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualMachineStatusMemoryDump_To_v1alpha1_VirtualMachineStatusMemoryDump(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualMachineStatusMigration_To_v1beta1_VirtualMachineStatusMigration(in *VirtualMachineStatusMigration, out *v1beta1.VirtualMachineStatusMigration, s conversion.Scope) error {
+	out.UID = types.UID(in.UID)
+	out.Type = v1beta1.VirtualMachineMigrationType(in.Type)
+	out.Phase = v1beta1.VirtualMachineMigrationPhase(in.Phase)
+	out.TargetNodeName = in.TargetNodeName
+	out.TargetNodeIP = in.TargetNodeIP
+	out.TargetNodePort = in.TargetNodePort
+	// WARNING: in.TargetVMPodName requires manual conversion: does not exist in peer-type
+	// WARNING: in.TargetVMPodUID requires manual conversion: does not exist in peer-type
+	out.TargetStoragePort = in.TargetStoragePort
+	out.Volumes = *(*[]v1beta1.VirtualMachineStatusMigrationVolume)(unsafe.Pointer(&in.Volumes))
+	out.TargetNodeSelector = *(*map[string]string)(unsafe.Pointer(&in.TargetNodeSelector))
+	out.TargetAffinity = (*v1.Affinity)(unsafe.Pointer(in.TargetAffinity))
+	return nil
+}
+
+func autoConvert_v1beta1_VirtualMachineStatusMigration_To_v1alpha1_VirtualMachineStatusMigration(in *v1beta1.VirtualMachineStatusMigration, out *VirtualMachineStatusMigration, s conversion.Scope) error {
+	out.UID = types.UID(in.UID)
+	out.Type = VirtualMachineMigrationType(in.Type)
+	out.Phase = VirtualMachineMigrationPhase(in.Phase)
+	out.TargetNodeName = in.TargetNodeName
+	out.TargetNodeIP = in.TargetNodeIP
+	out.TargetNodePort = in.TargetNodePort
+	// WARNING: in.TargetPodName requires manual conversion: does not exist in peer-type
+	// WARNING: in.TargetPodUID requires manual conversion: does not exist in peer-type
+	out.TargetStoragePort = in.TargetStoragePort
+	out.Volumes = *(*[]VirtualMachineStatusMigrationVolume)(unsafe.Pointer(&in.Volumes))
+	out.TargetNodeSelector = *(*map[string]string)(unsafe.Pointer(&in.TargetNodeSelector))
+	out.TargetAffinity = (*v1.Affinity)(unsafe.Pointer(in.TargetAffinity))
+	return nil
+}
+
+func autoConvert_v1alpha1_VirtualMachineStatusMigrationVolume_To_v1beta1_VirtualMachineStatusMigrationVolume(in *VirtualMachineStatusMigrationVolume, out *v1beta1.VirtualMachineStatusMigrationVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	out.SourceClaimName = in.SourceClaimName
+	out.TargetClaimName = in.TargetClaimName
+	return nil
+}
+
+// Convert_v1alpha1_VirtualMachineStatusMigrationVolume_To_v1beta1_VirtualMachineStatusMigrationVolume is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualMachineStatusMigrationVolume_To_v1beta1_VirtualMachineStatusMigrationVolume(in *VirtualMachineStatusMigrationVolume, out *v1beta1.VirtualMachineStatusMigrationVolume, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualMachineStatusMigrationVolume_To_v1beta1_VirtualMachineStatusMigrationVolume(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachineStatusMigrationVolume_To_v1alpha1_VirtualMachineStatusMigrationVolume(in *v1beta1.VirtualMachineStatusMigrationVolume, out *VirtualMachineStatusMigrationVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	out.SourceClaimName = in.SourceClaimName
+	out.TargetClaimName = in.TargetClaimName
+	return nil
+}
+
+// Convert_v1beta1_VirtualMachineStatusMigrationVolume_To_v1alpha1_VirtualMachineStatusMigrationVolume is an autogenerated conversion function.
+func Convert_v1beta1_VirtualMachineStatusMigrationVolume_To_v1alpha1_VirtualMachineStatusMigrationVolume(in *v1beta1.VirtualMachineStatusMigrationVolume, out *VirtualMachineStatusMigrationVolume, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualMachineStatusMigrationVolume_To_v1alpha1_VirtualMachineStatusMigrationVolume(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualMachineStatusSchedule_To_v1beta1_VirtualMachineStatusSchedule(in *VirtualMachineStatusSchedule, out *v1beta1.VirtualMachineStatusSchedule, s conversion.Scope) error {
+	out.LastScheduleTime = (*metav1.Time)(unsafe.Pointer(in.LastScheduleTime))
+	return nil
+}
+
+// Convert_v1alpha1_VirtualMachineStatusSchedule_To_v1beta1_VirtualMachineStatusSchedule is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualMachineStatusSchedule_To_v1beta1_VirtualMachineStatusSchedule(in *VirtualMachineStatusSchedule, out *v1beta1.VirtualMachineStatusSchedule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualMachineStatusSchedule_To_v1beta1_VirtualMachineStatusSchedule(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachineStatusSchedule_To_v1alpha1_VirtualMachineStatusSchedule(in *v1beta1.VirtualMachineStatusSchedule, out *VirtualMachineStatusSchedule, s conversion.Scope) error {
+	out.LastScheduleTime = (*metav1.Time)(unsafe.Pointer(in.LastScheduleTime))
+	return nil
+}
+
+// Convert_v1beta1_VirtualMachineStatusSchedule_To_v1alpha1_VirtualMachineStatusSchedule is an autogenerated conversion function.
+func Convert_v1beta1_VirtualMachineStatusSchedule_To_v1alpha1_VirtualMachineStatusSchedule(in *v1beta1.VirtualMachineStatusSchedule, out *VirtualMachineStatusSchedule, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualMachineStatusSchedule_To_v1alpha1_VirtualMachineStatusSchedule(in, out, s)
+}
+
+func autoConvert_v1alpha1_Volume_To_v1beta1_Volume(in *Volume, out *v1beta1.Volume, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1alpha1_VolumeSource_To_v1beta1_VolumeSource(&in.VolumeSource, &out.VolumeSource, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Volume_To_v1beta1_Volume is an autogenerated conversion function.
+func Convert_v1alpha1_Volume_To_v1beta1_Volume(in *Volume, out *v1beta1.Volume, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Volume_To_v1beta1_Volume(in, out, s)
+}
+
+func autoConvert_v1beta1_Volume_To_v1alpha1_Volume(in *v1beta1.Volume, out *Volume, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1beta1_VolumeSource_To_v1alpha1_VolumeSource(&in.VolumeSource, &out.VolumeSource, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_Volume_To_v1alpha1_Volume is an autogenerated conversion function.
+func Convert_v1beta1_Volume_To_v1alpha1_Volume(in *v1beta1.Volume, out *Volume, s conversion.Scope) error {
+	return autoConvert_v1beta1_Volume_To_v1alpha1_Volume(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeSource_To_v1beta1_VolumeSource(in *VolumeSource, out *v1beta1.VolumeSource, s conversion.Scope) error {
+	out.ContainerDisk = (*v1beta1.ContainerDiskVolumeSource)(unsafe.Pointer(in.ContainerDisk))
+	out.HTTPDisk = (*v1beta1.HTTPDiskVolumeSource)(unsafe.Pointer(in.HTTPDisk))
+	out.EmptyDisk = (*v1beta1.EmptyDiskVolumeSource)(unsafe.Pointer(in.EmptyDisk))
+	out.CloudInit = (*v1beta1.CloudInitVolumeSource)(unsafe.Pointer(in.CloudInit))
+	out.ContainerRootfs = (*v1beta1.ContainerRootfsVolumeSource)(unsafe.Pointer(in.ContainerRootfs))
+	out.PersistentVolumeClaim = (*v1beta1.PersistentVolumeClaimVolumeSource)(unsafe.Pointer(in.PersistentVolumeClaim))
+	if in.DataVolume != nil {
+		in, out := &in.DataVolume, &out.DataVolume
+		*out = new(v1beta1.DataVolumeVolumeSource)
+		if err := Convert_v1alpha1_DataVolumeVolumeSource_To_v1beta1_DataVolumeVolumeSource(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DataVolume = nil
+	}
+	out.ClusterAPIBootstrap = (*v1beta1.ClusterAPIBootstrapVolumeSource)(unsafe.Pointer(in.ClusterAPIBootstrap))
+	out.Sysprep = (*v1beta1.SysprepVolumeSource)(unsafe.Pointer(in.Sysprep))
+	out.ConfigMap = (*v1beta1.ConfigMapVolumeSource)(unsafe.Pointer(in.ConfigMap))
+	out.Secret = (*v1beta1.SecretVolumeSource)(unsafe.Pointer(in.Secret))
+	out.ServiceAccountToken = (*v1beta1.ServiceAccountTokenVolumeSource)(unsafe.Pointer(in.ServiceAccountToken))
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSource_To_v1beta1_VolumeSource is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSource_To_v1beta1_VolumeSource(in *VolumeSource, out *v1beta1.VolumeSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSource_To_v1beta1_VolumeSource(in, out, s)
+}
+
+func autoConvert_v1beta1_VolumeSource_To_v1alpha1_VolumeSource(in *v1beta1.VolumeSource, out *VolumeSource, s conversion.Scope) error {
+	out.ContainerDisk = (*ContainerDiskVolumeSource)(unsafe.Pointer(in.ContainerDisk))
+	out.HTTPDisk = (*HTTPDiskVolumeSource)(unsafe.Pointer(in.HTTPDisk))
+	out.EmptyDisk = (*EmptyDiskVolumeSource)(unsafe.Pointer(in.EmptyDisk))
+	out.CloudInit = (*CloudInitVolumeSource)(unsafe.Pointer(in.CloudInit))
+	out.ContainerRootfs = (*ContainerRootfsVolumeSource)(unsafe.Pointer(in.ContainerRootfs))
+	out.PersistentVolumeClaim = (*PersistentVolumeClaimVolumeSource)(unsafe.Pointer(in.PersistentVolumeClaim))
+	if in.DataVolume != nil {
+		in, out := &in.DataVolume, &out.DataVolume
+		*out = new(DataVolumeVolumeSource)
+		if err := Convert_v1beta1_DataVolumeVolumeSource_To_v1alpha1_DataVolumeVolumeSource(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DataVolume = nil
+	}
+	out.ClusterAPIBootstrap = (*ClusterAPIBootstrapVolumeSource)(unsafe.Pointer(in.ClusterAPIBootstrap))
+	out.Sysprep = (*SysprepVolumeSource)(unsafe.Pointer(in.Sysprep))
+	out.ConfigMap = (*ConfigMapVolumeSource)(unsafe.Pointer(in.ConfigMap))
+	out.Secret = (*SecretVolumeSource)(unsafe.Pointer(in.Secret))
+	out.ServiceAccountToken = (*ServiceAccountTokenVolumeSource)(unsafe.Pointer(in.ServiceAccountToken))
+	return nil
+}
+
+// Convert_v1beta1_VolumeSource_To_v1alpha1_VolumeSource is an autogenerated conversion function.
+func Convert_v1beta1_VolumeSource_To_v1alpha1_VolumeSource(in *v1beta1.VolumeSource, out *VolumeSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_VolumeSource_To_v1alpha1_VolumeSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_Vsock_To_v1beta1_Vsock(in *Vsock, out *v1beta1.Vsock, s conversion.Scope) error {
+	out.CID = in.CID
+	return nil
+}
+
+// Convert_v1alpha1_Vsock_To_v1beta1_Vsock is an autogenerated conversion function.
+func Convert_v1alpha1_Vsock_To_v1beta1_Vsock(in *Vsock, out *v1beta1.Vsock, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Vsock_To_v1beta1_Vsock(in, out, s)
+}
+
+func autoConvert_v1beta1_Vsock_To_v1alpha1_Vsock(in *v1beta1.Vsock, out *Vsock, s conversion.Scope) error {
+	out.CID = in.CID
+	return nil
+}
+
+// Convert_v1beta1_Vsock_To_v1alpha1_Vsock is an autogenerated conversion function.
+func Convert_v1beta1_Vsock_To_v1alpha1_Vsock(in *v1beta1.Vsock, out *Vsock, s conversion.Scope) error {
+	return autoConvert_v1beta1_Vsock_To_v1alpha1_Vsock(in, out, s)
+}
+
+func autoConvert_v1alpha1_Watchdog_To_v1beta1_Watchdog(in *Watchdog, out *v1beta1.Watchdog, s conversion.Scope) error {
+	out.Action = v1beta1.WatchdogAction(in.Action)
+	return nil
+}
+
+// Convert_v1alpha1_Watchdog_To_v1beta1_Watchdog is an autogenerated conversion function.
+func Convert_v1alpha1_Watchdog_To_v1beta1_Watchdog(in *Watchdog, out *v1beta1.Watchdog, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Watchdog_To_v1beta1_Watchdog(in, out, s)
+}
+
+func autoConvert_v1beta1_Watchdog_To_v1alpha1_Watchdog(in *v1beta1.Watchdog, out *Watchdog, s conversion.Scope) error {
+	out.Action = WatchdogAction(in.Action)
+	return nil
+}
+
+// Convert_v1beta1_Watchdog_To_v1alpha1_Watchdog is an autogenerated conversion function.
+func Convert_v1beta1_Watchdog_To_v1alpha1_Watchdog(in *v1beta1.Watchdog, out *Watchdog, s conversion.Scope) error {
+	return autoConvert_v1beta1_Watchdog_To_v1alpha1_Watchdog(in, out, s)
+}
@@ -6,11 +6,64 @@
 package v1alpha1
 
 import (
-	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimit) DeepCopyInto(out *BandwidthLimit) {
+	*out = *in
+	out.Bandwidth = in.Bandwidth.DeepCopy()
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimit.
+func (in *BandwidthLimit) DeepCopy() *BandwidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Bond) DeepCopyInto(out *Bond) {
+	*out = *in
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]InterfaceRoute, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bond.
+func (in *Bond) DeepCopy() *Bond {
+	if in == nil {
+		return nil
+	}
+	out := new(Bond)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CPU) DeepCopyInto(out *CPU) {
 	*out = *in
@@ -27,6 +80,43 @@ func (in *CPU) DeepCopy() *CPU {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Clock) DeepCopyInto(out *Clock) {
+	*out = *in
+	if in.Sync != nil {
+		in, out := &in.Sync, &out.Sync
+		*out = new(ClockSync)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Clock.
+func (in *Clock) DeepCopy() *Clock {
+	if in == nil {
+		return nil
+	}
+	out := new(Clock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClockSync) DeepCopyInto(out *ClockSync) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClockSync.
+func (in *ClockSync) DeepCopy() *ClockSync {
+	if in == nil {
+		return nil
+	}
+	out := new(ClockSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudInitVolumeSource) DeepCopyInto(out *CloudInitVolumeSource) {
 	*out = *in
@@ -43,6 +133,38 @@ func (in *CloudInitVolumeSource) DeepCopy() *CloudInitVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAPIBootstrapVolumeSource) DeepCopyInto(out *ClusterAPIBootstrapVolumeSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAPIBootstrapVolumeSource.
+func (in *ClusterAPIBootstrapVolumeSource) DeepCopy() *ClusterAPIBootstrapVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAPIBootstrapVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapVolumeSource) DeepCopyInto(out *ConfigMapVolumeSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapVolumeSource.
+func (in *ConfigMapVolumeSource) DeepCopy() *ConfigMapVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerDiskVolumeSource) DeepCopyInto(out *ContainerDiskVolumeSource) {
 	*out = *in
@@ -92,6 +214,47 @@ func (in *DataVolumeVolumeSource) DeepCopy() *DataVolumeVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Devices) DeepCopyInto(out *Devices) {
+	*out = *in
+	if in.Display != nil {
+		in, out := &in.Display, &out.Display
+		*out = new(Display)
+		**out = **in
+	}
+	if in.USB != nil {
+		in, out := &in.USB, &out.USB
+		*out = new(USBController)
+		**out = **in
+	}
+	if in.Tablet != nil {
+		in, out := &in.Tablet, &out.Tablet
+		*out = new(Tablet)
+		**out = **in
+	}
+	if in.Sound != nil {
+		in, out := &in.Sound, &out.Sound
+		*out = new(Sound)
+		**out = **in
+	}
+	if in.HostUSBDevices != nil {
+		in, out := &in.HostUSBDevices, &out.HostUSBDevices
+		*out = make([]HostUSBDevice, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Devices.
+func (in *Devices) DeepCopy() *Devices {
+	if in == nil {
+		return nil
+	}
+	out := new(Devices)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Disk) DeepCopyInto(out *Disk) {
 	*out = *in
@@ -100,6 +263,21 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(DiskRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Discard != nil {
+		in, out := &in.Discard, &out.Discard
+		*out = new(DiskDiscard)
+		**out = **in
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(DiskEncryption)
+		**out = **in
+	}
 	return
 }
 
@@ -114,353 +292,1274 @@ func (in *Disk) DeepCopy() *Disk {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FileSystem) DeepCopyInto(out *FileSystem) {
+func (in *DiskDiscard) DeepCopyInto(out *DiskDiscard) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSystem.
-func (in *FileSystem) DeepCopy() *FileSystem {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskDiscard.
+func (in *DiskDiscard) DeepCopy() *DiskDiscard {
 	if in == nil {
 		return nil
 	}
-	out := new(FileSystem)
+	out := new(DiskDiscard)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Hugepages) DeepCopyInto(out *Hugepages) {
+func (in *DiskEncryption) DeepCopyInto(out *DiskEncryption) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hugepages.
-func (in *Hugepages) DeepCopy() *Hugepages {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskEncryption.
+func (in *DiskEncryption) DeepCopy() *DiskEncryption {
 	if in == nil {
 		return nil
 	}
-	out := new(Hugepages)
+	out := new(DiskEncryption)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Instance) DeepCopyInto(out *Instance) {
+func (in *DiskRateLimit) DeepCopyInto(out *DiskRateLimit) {
 	*out = *in
-	out.CPU = in.CPU
-	in.Memory.DeepCopyInto(&out.Memory)
-	if in.Kernel != nil {
-		in, out := &in.Kernel, &out.Kernel
-		*out = new(Kernel)
-		**out = **in
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		x := (*in).DeepCopy()
+		*out = &x
 	}
-	if in.Disks != nil {
-		in, out := &in.Disks, &out.Disks
-		*out = make([]Disk, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.BandwidthBurst != nil {
+		in, out := &in.BandwidthBurst, &out.BandwidthBurst
+		x := (*in).DeepCopy()
+		*out = &x
 	}
-	if in.FileSystems != nil {
-		in, out := &in.FileSystems, &out.FileSystems
-		*out = make([]FileSystem, len(*in))
-		copy(*out, *in)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskRateLimit.
+func (in *DiskRateLimit) DeepCopy() *DiskRateLimit {
+	if in == nil {
+		return nil
 	}
-	if in.Interfaces != nil {
-		in, out := &in.Interfaces, &out.Interfaces
-		*out = make([]Interface, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out := new(DiskRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Display) DeepCopyInto(out *Display) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Display.
+func (in *Display) DeepCopy() *Display {
+	if in == nil {
+		return nil
 	}
+	out := new(Display)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Downward) DeepCopyInto(out *Downward) {
+	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instance.
-func (in *Instance) DeepCopy() *Instance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Downward.
+func (in *Downward) DeepCopy() *Downward {
 	if in == nil {
 		return nil
 	}
-	out := new(Instance)
+	out := new(Downward)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Interface) DeepCopyInto(out *Interface) {
+func (in *EFIFirmware) DeepCopyInto(out *EFIFirmware) {
 	*out = *in
-	in.InterfaceBindingMethod.DeepCopyInto(&out.InterfaceBindingMethod)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Interface.
-func (in *Interface) DeepCopy() *Interface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EFIFirmware.
+func (in *EFIFirmware) DeepCopy() *EFIFirmware {
 	if in == nil {
 		return nil
 	}
-	out := new(Interface)
+	out := new(EFIFirmware)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
+func (in *EmptyDiskVolumeClaim) DeepCopyInto(out *EmptyDiskVolumeClaim) {
 	*out = *in
-	if in.Bridge != nil {
-		in, out := &in.Bridge, &out.Bridge
-		*out = new(InterfaceBridge)
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
 		**out = **in
 	}
-	if in.Masquerade != nil {
-		in, out := &in.Masquerade, &out.Masquerade
-		*out = new(InterfaceMasquerade)
-		**out = **in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmptyDiskVolumeClaim.
+func (in *EmptyDiskVolumeClaim) DeepCopy() *EmptyDiskVolumeClaim {
+	if in == nil {
+		return nil
 	}
-	if in.SRIOV != nil {
-		in, out := &in.SRIOV, &out.SRIOV
-		*out = new(InterfaceSRIOV)
-		**out = **in
+	out := new(EmptyDiskVolumeClaim)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmptyDiskVolumeSource) DeepCopyInto(out *EmptyDiskVolumeSource) {
+	*out = *in
+	out.Capacity = in.Capacity.DeepCopy()
+	if in.VolumeClaim != nil {
+		in, out := &in.VolumeClaim, &out.VolumeClaim
+		*out = new(EmptyDiskVolumeClaim)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.VhostUser != nil {
-		in, out := &in.VhostUser, &out.VhostUser
-		*out = new(InterfaceVhostUser)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmptyDiskVolumeSource.
+func (in *EmptyDiskVolumeSource) DeepCopy() *EmptyDiskVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(EmptyDiskVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSystem) DeepCopyInto(out *FileSystem) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSystem.
+func (in *FileSystem) DeepCopy() *FileSystem {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSystem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Firmware) DeepCopyInto(out *Firmware) {
+	*out = *in
+	if in.EFI != nil {
+		in, out := &in.EFI, &out.EFI
+		*out = new(EFIFirmware)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBindingMethod.
-func (in *InterfaceBindingMethod) DeepCopy() *InterfaceBindingMethod {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Firmware.
+func (in *Firmware) DeepCopy() *Firmware {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceBindingMethod)
+	out := new(Firmware)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceBridge) DeepCopyInto(out *InterfaceBridge) {
+func (in *GuestMetrics) DeepCopyInto(out *GuestMetrics) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBridge.
-func (in *InterfaceBridge) DeepCopy() *InterfaceBridge {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestMetrics.
+func (in *GuestMetrics) DeepCopy() *GuestMetrics {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceBridge)
+	out := new(GuestMetrics)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceMasquerade) DeepCopyInto(out *InterfaceMasquerade) {
+func (in *HTTPDiskVolumeSource) DeepCopyInto(out *HTTPDiskVolumeSource) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceMasquerade.
-func (in *InterfaceMasquerade) DeepCopy() *InterfaceMasquerade {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPDiskVolumeSource.
+func (in *HTTPDiskVolumeSource) DeepCopy() *HTTPDiskVolumeSource {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceMasquerade)
+	out := new(HTTPDiskVolumeSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSRIOV) DeepCopyInto(out *InterfaceSRIOV) {
+func (in *Hibernation) DeepCopyInto(out *Hibernation) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSRIOV.
-func (in *InterfaceSRIOV) DeepCopy() *InterfaceSRIOV {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hibernation.
+func (in *Hibernation) DeepCopy() *Hibernation {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSRIOV)
+	out := new(Hibernation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceVhostUser) DeepCopyInto(out *InterfaceVhostUser) {
+func (in *HostUSBDevice) DeepCopyInto(out *HostUSBDevice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostUSBDevice.
+func (in *HostUSBDevice) DeepCopy() *HostUSBDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(HostUSBDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hugepages) DeepCopyInto(out *Hugepages) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hugepages.
+func (in *Hugepages) DeepCopy() *Hugepages {
+	if in == nil {
+		return nil
+	}
+	out := new(Hugepages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Instance) DeepCopyInto(out *Instance) {
+	*out = *in
+	out.CPU = in.CPU
+	in.Memory.DeepCopyInto(&out.Memory)
+	if in.Kernel != nil {
+		in, out := &in.Kernel, &out.Kernel
+		*out = new(Kernel)
+		**out = **in
+	}
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]Disk, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FileSystems != nil {
+		in, out := &in.FileSystems, &out.FileSystems
+		*out = make([]FileSystem, len(*in))
+		copy(*out, *in)
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]Interface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Bonds != nil {
+		in, out := &in.Bonds, &out.Bonds
+		*out = make([]Bond, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Realtime != nil {
+		in, out := &in.Realtime, &out.Realtime
+		*out = new(Realtime)
+		**out = **in
+	}
+	if in.Watchdog != nil {
+		in, out := &in.Watchdog, &out.Watchdog
+		*out = new(Watchdog)
+		**out = **in
+	}
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = new(Devices)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Firmware != nil {
+		in, out := &in.Firmware, &out.Firmware
+		*out = new(Firmware)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Vsock != nil {
+		in, out := &in.Vsock, &out.Vsock
+		*out = new(Vsock)
+		**out = **in
+	}
+	if in.RNG != nil {
+		in, out := &in.RNG, &out.RNG
+		*out = new(RNG)
+		**out = **in
+	}
+	if in.Downward != nil {
+		in, out := &in.Downward, &out.Downward
+		*out = new(Downward)
+		**out = **in
+	}
+	if in.Clock != nil {
+		in, out := &in.Clock, &out.Clock
+		*out = new(Clock)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestMetrics != nil {
+		in, out := &in.GuestMetrics, &out.GuestMetrics
+		*out = new(GuestMetrics)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instance.
+func (in *Instance) DeepCopy() *Instance {
+	if in == nil {
+		return nil
+	}
+	out := new(Instance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Interface) DeepCopyInto(out *Interface) {
+	*out = *in
+	in.InterfaceBindingMethod.DeepCopyInto(&out.InterfaceBindingMethod)
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(InterfaceRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DHCPOptions != nil {
+		in, out := &in.DHCPOptions, &out.DHCPOptions
+		*out = new(InterfaceDHCPOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Offloads != nil {
+		in, out := &in.Offloads, &out.Offloads
+		*out = new(InterfaceOffloads)
+		**out = **in
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VLAN != nil {
+		in, out := &in.VLAN, &out.VLAN
+		*out = new(InterfaceVLAN)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]InterfaceRoute, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Interface.
+func (in *Interface) DeepCopy() *Interface {
+	if in == nil {
+		return nil
+	}
+	out := new(Interface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
+	*out = *in
+	if in.Bridge != nil {
+		in, out := &in.Bridge, &out.Bridge
+		*out = new(InterfaceBridge)
+		**out = **in
+	}
+	if in.Masquerade != nil {
+		in, out := &in.Masquerade, &out.Masquerade
+		*out = new(InterfaceMasquerade)
+		**out = **in
+	}
+	if in.SRIOV != nil {
+		in, out := &in.SRIOV, &out.SRIOV
+		*out = new(InterfaceSRIOV)
+		**out = **in
+	}
+	if in.VhostUser != nil {
+		in, out := &in.VhostUser, &out.VhostUser
+		*out = new(InterfaceVhostUser)
+		**out = **in
+	}
+	if in.OVS != nil {
+		in, out := &in.OVS, &out.OVS
+		*out = new(InterfaceOVS)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBindingMethod.
+func (in *InterfaceBindingMethod) DeepCopy() *InterfaceBindingMethod {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBindingMethod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBridge) DeepCopyInto(out *InterfaceBridge) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBridge.
+func (in *InterfaceBridge) DeepCopy() *InterfaceBridge {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBridge)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceDHCPOptions) DeepCopyInto(out *InterfaceDHCPOptions) {
+	*out = *in
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SearchDomains != nil {
+		in, out := &in.SearchDomains, &out.SearchDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NTPServers != nil {
+		in, out := &in.NTPServers, &out.NTPServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceDHCPOptions.
+func (in *InterfaceDHCPOptions) DeepCopy() *InterfaceDHCPOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceDHCPOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceMasquerade) DeepCopyInto(out *InterfaceMasquerade) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceMasquerade.
+func (in *InterfaceMasquerade) DeepCopy() *InterfaceMasquerade {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceMasquerade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceOVS) DeepCopyInto(out *InterfaceOVS) {
+	*out = *in
+	if in.VLAN != nil {
+		in, out := &in.VLAN, &out.VLAN
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Trunks != nil {
+		in, out := &in.Trunks, &out.Trunks
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceOVS.
+func (in *InterfaceOVS) DeepCopy() *InterfaceOVS {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceOVS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceOffloads) DeepCopyInto(out *InterfaceOffloads) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceOffloads.
+func (in *InterfaceOffloads) DeepCopy() *InterfaceOffloads {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceOffloads)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceRateLimit) DeepCopyInto(out *InterfaceRateLimit) {
+	*out = *in
+	if in.RX != nil {
+		in, out := &in.RX, &out.RX
+		*out = new(BandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TX != nil {
+		in, out := &in.TX, &out.TX
+		*out = new(BandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceRateLimit.
+func (in *InterfaceRateLimit) DeepCopy() *InterfaceRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceRoute) DeepCopyInto(out *InterfaceRoute) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceRoute.
+func (in *InterfaceRoute) DeepCopy() *InterfaceRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceSRIOV) DeepCopyInto(out *InterfaceSRIOV) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSRIOV.
+func (in *InterfaceSRIOV) DeepCopy() *InterfaceSRIOV {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceSRIOV)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceVLAN) DeepCopyInto(out *InterfaceVLAN) {
+	*out = *in
+	if in.Trunks != nil {
+		in, out := &in.Trunks, &out.Trunks
+		*out = make([]VLANRange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceVLAN.
+func (in *InterfaceVLAN) DeepCopy() *InterfaceVLAN {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceVLAN)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceVhostUser) DeepCopyInto(out *InterfaceVhostUser) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceVhostUser.
+func (in *InterfaceVhostUser) DeepCopy() *InterfaceVhostUser {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceVhostUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Kernel) DeepCopyInto(out *Kernel) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kernel.
+func (in *Kernel) DeepCopy() *Kernel {
+	if in == nil {
+		return nil
+	}
+	out := new(Kernel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Memory) DeepCopyInto(out *Memory) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+	if in.Hugepages != nil {
+		in, out := &in.Hugepages, &out.Hugepages
+		*out = new(Hugepages)
+		**out = **in
+	}
+	if in.Balloon != nil {
+		in, out := &in.Balloon, &out.Balloon
+		*out = new(MemoryBalloon)
+		**out = **in
+	}
+	if in.Swap != nil {
+		in, out := &in.Swap, &out.Swap
+		*out = new(MemorySwap)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Memory.
+func (in *Memory) DeepCopy() *Memory {
+	if in == nil {
+		return nil
+	}
+	out := new(Memory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryBalloon) DeepCopyInto(out *MemoryBalloon) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryBalloon.
+func (in *MemoryBalloon) DeepCopy() *MemoryBalloon {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryBalloon)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryDump) DeepCopyInto(out *MemoryDump) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryDump.
+func (in *MemoryDump) DeepCopy() *MemoryDump {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryDump)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemorySwap) DeepCopyInto(out *MemorySwap) {
+	*out = *in
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemorySwap.
+func (in *MemorySwap) DeepCopy() *MemorySwap {
+	if in == nil {
+		return nil
+	}
+	out := new(MemorySwap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataService) DeepCopyInto(out *MetadataService) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataService.
+func (in *MetadataService) DeepCopy() *MetadataService {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultusNetworkSource) DeepCopyInto(out *MultusNetworkSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultusNetworkSource.
+func (in *MultusNetworkSource) DeepCopy() *MultusNetworkSource {
+	if in == nil {
+		return nil
+	}
+	out := new(MultusNetworkSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Network) DeepCopyInto(out *Network) {
+	*out = *in
+	in.NetworkSource.DeepCopyInto(&out.NetworkSource)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Network.
+func (in *Network) DeepCopy() *Network {
+	if in == nil {
+		return nil
+	}
+	out := new(Network)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkSource) DeepCopyInto(out *NetworkSource) {
+	*out = *in
+	if in.Pod != nil {
+		in, out := &in.Pod, &out.Pod
+		*out = new(PodNetworkSource)
+		**out = **in
+	}
+	if in.Multus != nil {
+		in, out := &in.Multus, &out.Multus
+		*out = new(MultusNetworkSource)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSource.
+func (in *NetworkSource) DeepCopy() *NetworkSource {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimPopulateSource) DeepCopyInto(out *PersistentVolumeClaimPopulateSource) {
+	*out = *in
+	if in.ContainerDisk != nil {
+		in, out := &in.ContainerDisk, &out.ContainerDisk
+		*out = new(ContainerDiskVolumeSource)
+		**out = **in
+	}
+	if in.HTTPDisk != nil {
+		in, out := &in.HTTPDisk, &out.HTTPDisk
+		*out = new(HTTPDiskVolumeSource)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimPopulateSource.
+func (in *PersistentVolumeClaimPopulateSource) DeepCopy() *PersistentVolumeClaimPopulateSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimPopulateSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimVolumeSource) DeepCopyInto(out *PersistentVolumeClaimVolumeSource) {
+	*out = *in
+	if in.Populate != nil {
+		in, out := &in.Populate, &out.Populate
+		*out = new(PersistentVolumeClaimPopulateSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimVolumeSource.
+func (in *PersistentVolumeClaimVolumeSource) DeepCopy() *PersistentVolumeClaimVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodNetworkSource) DeepCopyInto(out *PodNetworkSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodNetworkSource.
+func (in *PodNetworkSource) DeepCopy() *PodNetworkSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PodNetworkSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RNG) DeepCopyInto(out *RNG) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RNG.
+func (in *RNG) DeepCopy() *RNG {
+	if in == nil {
+		return nil
+	}
+	out := new(RNG)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Realtime) DeepCopyInto(out *Realtime) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Realtime.
+func (in *Realtime) DeepCopy() *Realtime {
+	if in == nil {
+		return nil
+	}
+	out := new(Realtime)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHPublicKey) DeepCopyInto(out *SSHPublicKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHPublicKey.
+func (in *SSHPublicKey) DeepCopy() *SSHPublicKey {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHPublicKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Schedule) DeepCopyInto(out *Schedule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schedule.
+func (in *Schedule) DeepCopy() *Schedule {
+	if in == nil {
+		return nil
+	}
+	out := new(Schedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretVolumeSource) DeepCopyInto(out *SecretVolumeSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretVolumeSource.
+func (in *SecretVolumeSource) DeepCopy() *SecretVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountTokenVolumeSource) DeepCopyInto(out *ServiceAccountTokenVolumeSource) {
+	*out = *in
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountTokenVolumeSource.
+func (in *ServiceAccountTokenVolumeSource) DeepCopy() *ServiceAccountTokenVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountTokenVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Sound) DeepCopyInto(out *Sound) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Sound.
+func (in *Sound) DeepCopy() *Sound {
+	if in == nil {
+		return nil
+	}
+	out := new(Sound)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SysprepVolumeSource) DeepCopyInto(out *SysprepVolumeSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SysprepVolumeSource.
+func (in *SysprepVolumeSource) DeepCopy() *SysprepVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SysprepVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tablet) DeepCopyInto(out *Tablet) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tablet.
+func (in *Tablet) DeepCopy() *Tablet {
+	if in == nil {
+		return nil
+	}
+	out := new(Tablet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *USBController) DeepCopyInto(out *USBController) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new USBController.
+func (in *USBController) DeepCopy() *USBController {
+	if in == nil {
+		return nil
+	}
+	out := new(USBController)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VLANRange) DeepCopyInto(out *VLANRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLANRange.
+func (in *VLANRange) DeepCopy() *VLANRange {
+	if in == nil {
+		return nil
+	}
+	out := new(VLANRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachine) DeepCopyInto(out *VirtualMachine) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceVhostUser.
-func (in *InterfaceVhostUser) DeepCopy() *InterfaceVhostUser {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachine.
+func (in *VirtualMachine) DeepCopy() *VirtualMachine {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceVhostUser)
+	out := new(VirtualMachine)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachine) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Kernel) DeepCopyInto(out *Kernel) {
+func (in *VirtualMachineAddress) DeepCopyInto(out *VirtualMachineAddress) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kernel.
-func (in *Kernel) DeepCopy() *Kernel {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineAddress.
+func (in *VirtualMachineAddress) DeepCopy() *VirtualMachineAddress {
 	if in == nil {
 		return nil
 	}
-	out := new(Kernel)
+	out := new(VirtualMachineAddress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Memory) DeepCopyInto(out *Memory) {
+func (in *VirtualMachineExport) DeepCopyInto(out *VirtualMachineExport) {
 	*out = *in
-	out.Size = in.Size.DeepCopy()
-	if in.Hugepages != nil {
-		in, out := &in.Hugepages, &out.Hugepages
-		*out = new(Hugepages)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Memory.
-func (in *Memory) DeepCopy() *Memory {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExport.
+func (in *VirtualMachineExport) DeepCopy() *VirtualMachineExport {
 	if in == nil {
 		return nil
 	}
-	out := new(Memory)
+	out := new(VirtualMachineExport)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineExport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MultusNetworkSource) DeepCopyInto(out *MultusNetworkSource) {
+func (in *VirtualMachineExportHTTPTarget) DeepCopyInto(out *VirtualMachineExportHTTPTarget) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultusNetworkSource.
-func (in *MultusNetworkSource) DeepCopy() *MultusNetworkSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportHTTPTarget.
+func (in *VirtualMachineExportHTTPTarget) DeepCopy() *VirtualMachineExportHTTPTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(MultusNetworkSource)
+	out := new(VirtualMachineExportHTTPTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Network) DeepCopyInto(out *Network) {
+func (in *VirtualMachineExportList) DeepCopyInto(out *VirtualMachineExportList) {
 	*out = *in
-	in.NetworkSource.DeepCopyInto(&out.NetworkSource)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineExport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Network.
-func (in *Network) DeepCopy() *Network {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportList.
+func (in *VirtualMachineExportList) DeepCopy() *VirtualMachineExportList {
 	if in == nil {
 		return nil
 	}
-	out := new(Network)
+	out := new(VirtualMachineExportList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineExportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkSource) DeepCopyInto(out *NetworkSource) {
+func (in *VirtualMachineExportOCITarget) DeepCopyInto(out *VirtualMachineExportOCITarget) {
 	*out = *in
-	if in.Pod != nil {
-		in, out := &in.Pod, &out.Pod
-		*out = new(PodNetworkSource)
-		**out = **in
-	}
-	if in.Multus != nil {
-		in, out := &in.Multus, &out.Multus
-		*out = new(MultusNetworkSource)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSource.
-func (in *NetworkSource) DeepCopy() *NetworkSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportOCITarget.
+func (in *VirtualMachineExportOCITarget) DeepCopy() *VirtualMachineExportOCITarget {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkSource)
+	out := new(VirtualMachineExportOCITarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PersistentVolumeClaimVolumeSource) DeepCopyInto(out *PersistentVolumeClaimVolumeSource) {
+func (in *VirtualMachineExportSpec) DeepCopyInto(out *VirtualMachineExportSpec) {
 	*out = *in
+	in.VirtualMachineExportTarget.DeepCopyInto(&out.VirtualMachineExportTarget)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimVolumeSource.
-func (in *PersistentVolumeClaimVolumeSource) DeepCopy() *PersistentVolumeClaimVolumeSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportSpec.
+func (in *VirtualMachineExportSpec) DeepCopy() *VirtualMachineExportSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PersistentVolumeClaimVolumeSource)
+	out := new(VirtualMachineExportSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodNetworkSource) DeepCopyInto(out *PodNetworkSource) {
+func (in *VirtualMachineExportStatus) DeepCopyInto(out *VirtualMachineExportStatus) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodNetworkSource.
-func (in *PodNetworkSource) DeepCopy() *PodNetworkSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportStatus.
+func (in *VirtualMachineExportStatus) DeepCopy() *VirtualMachineExportStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PodNetworkSource)
+	out := new(VirtualMachineExportStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachine) DeepCopyInto(out *VirtualMachine) {
+func (in *VirtualMachineExportTarget) DeepCopyInto(out *VirtualMachineExportTarget) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(VirtualMachineExportHTTPTarget)
+		**out = **in
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(VirtualMachineExportOCITarget)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachine.
-func (in *VirtualMachine) DeepCopy() *VirtualMachine {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportTarget.
+func (in *VirtualMachineExportTarget) DeepCopy() *VirtualMachineExportTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachine)
+	out := new(VirtualMachineExportTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachine) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineList) DeepCopyInto(out *VirtualMachineList) {
 	*out = *in
@@ -499,8 +1598,8 @@ func (in *VirtualMachineMigration) DeepCopyInto(out *VirtualMachineMigration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -558,6 +1657,23 @@ func (in *VirtualMachineMigrationList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineMigrationSpec) DeepCopyInto(out *VirtualMachineMigrationSpec) {
 	*out = *in
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -574,6 +1690,17 @@ func (in *VirtualMachineMigrationSpec) DeepCopy() *VirtualMachineMigrationSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineMigrationStatus) DeepCopyInto(out *VirtualMachineMigrationStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -599,12 +1726,12 @@ func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 	}
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
+		*out = new(corev1.Affinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+		*out = make([]corev1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -612,12 +1739,17 @@ func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 	in.Resources.DeepCopyInto(&out.Resources)
 	if in.LivenessProbe != nil {
 		in, out := &in.LivenessProbe, &out.LivenessProbe
-		*out = new(v1.Probe)
+		*out = new(corev1.Probe)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ReadinessProbe != nil {
 		in, out := &in.ReadinessProbe, &out.ReadinessProbe
-		*out = new(v1.Probe)
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSConfig != nil {
+		in, out := &in.DNSConfig, &out.DNSConfig
+		*out = new(corev1.PodDNSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	in.Instance.DeepCopyInto(&out.Instance)
@@ -635,6 +1767,31 @@ func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MemoryDump != nil {
+		in, out := &in.MemoryDump, &out.MemoryDump
+		*out = new(MemoryDump)
+		**out = **in
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(Hibernation)
+		**out = **in
+	}
+	if in.SSHPublicKeys != nil {
+		in, out := &in.SSHPublicKeys, &out.SSHPublicKeys
+		*out = make([]SSHPublicKey, len(*in))
+		copy(*out, *in)
+	}
+	if in.MetadataService != nil {
+		in, out := &in.MetadataService, &out.MetadataService
+		*out = new(MetadataService)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(Schedule)
+		**out = **in
+	}
 	return
 }
 
@@ -654,15 +1811,35 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 	if in.Migration != nil {
 		in, out := &in.Migration, &out.Migration
 		*out = new(VirtualMachineStatusMigration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MemoryDump != nil {
+		in, out := &in.MemoryDump, &out.MemoryDump
+		*out = new(VirtualMachineStatusMemoryDump)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(VirtualMachineStatusSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(VirtualMachineStatusHibernation)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]VirtualMachineAddress, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -676,9 +1853,66 @@ func (in *VirtualMachineStatus) DeepCopy() *VirtualMachineStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStatusHibernation) DeepCopyInto(out *VirtualMachineStatusHibernation) {
+	*out = *in
+	if in.HibernationTime != nil {
+		in, out := &in.HibernationTime, &out.HibernationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStatusHibernation.
+func (in *VirtualMachineStatusHibernation) DeepCopy() *VirtualMachineStatusHibernation {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStatusHibernation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStatusMemoryDump) DeepCopyInto(out *VirtualMachineStatusMemoryDump) {
+	*out = *in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStatusMemoryDump.
+func (in *VirtualMachineStatusMemoryDump) DeepCopy() *VirtualMachineStatusMemoryDump {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStatusMemoryDump)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineStatusMigration) DeepCopyInto(out *VirtualMachineStatusMigration) {
 	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VirtualMachineStatusMigrationVolume, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetNodeSelector != nil {
+		in, out := &in.TargetNodeSelector, &out.TargetNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TargetAffinity != nil {
+		in, out := &in.TargetAffinity, &out.TargetAffinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -692,6 +1926,42 @@ func (in *VirtualMachineStatusMigration) DeepCopy() *VirtualMachineStatusMigrati
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStatusMigrationVolume) DeepCopyInto(out *VirtualMachineStatusMigrationVolume) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStatusMigrationVolume.
+func (in *VirtualMachineStatusMigrationVolume) DeepCopy() *VirtualMachineStatusMigrationVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStatusMigrationVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStatusSchedule) DeepCopyInto(out *VirtualMachineStatusSchedule) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStatusSchedule.
+func (in *VirtualMachineStatusSchedule) DeepCopy() *VirtualMachineStatusSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStatusSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Volume) DeepCopyInto(out *Volume) {
 	*out = *in
@@ -717,6 +1987,16 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 		*out = new(ContainerDiskVolumeSource)
 		**out = **in
 	}
+	if in.HTTPDisk != nil {
+		in, out := &in.HTTPDisk, &out.HTTPDisk
+		*out = new(HTTPDiskVolumeSource)
+		**out = **in
+	}
+	if in.EmptyDisk != nil {
+		in, out := &in.EmptyDisk, &out.EmptyDisk
+		*out = new(EmptyDiskVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CloudInit != nil {
 		in, out := &in.CloudInit, &out.CloudInit
 		*out = new(CloudInitVolumeSource)
@@ -730,13 +2010,38 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 	if in.PersistentVolumeClaim != nil {
 		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
 		*out = new(PersistentVolumeClaimVolumeSource)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.DataVolume != nil {
 		in, out := &in.DataVolume, &out.DataVolume
 		*out = new(DataVolumeVolumeSource)
 		**out = **in
 	}
+	if in.ClusterAPIBootstrap != nil {
+		in, out := &in.ClusterAPIBootstrap, &out.ClusterAPIBootstrap
+		*out = new(ClusterAPIBootstrapVolumeSource)
+		**out = **in
+	}
+	if in.Sysprep != nil {
+		in, out := &in.Sysprep, &out.Sysprep
+		*out = new(SysprepVolumeSource)
+		**out = **in
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(ConfigMapVolumeSource)
+		**out = **in
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(SecretVolumeSource)
+		**out = **in
+	}
+	if in.ServiceAccountToken != nil {
+		in, out := &in.ServiceAccountToken, &out.ServiceAccountToken
+		*out = new(ServiceAccountTokenVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -749,3 +2054,35 @@ func (in *VolumeSource) DeepCopy() *VolumeSource {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Vsock) DeepCopyInto(out *Vsock) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Vsock.
+func (in *Vsock) DeepCopy() *Vsock {
+	if in == nil {
+		return nil
+	}
+	out := new(Vsock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Watchdog) DeepCopyInto(out *Watchdog) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Watchdog.
+func (in *Watchdog) DeepCopy() *Watchdog {
+	if in == nil {
+		return nil
+	}
+	out := new(Watchdog)
+	in.DeepCopyInto(out)
+	return out
+}
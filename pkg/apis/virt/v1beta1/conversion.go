@@ -0,0 +1,10 @@
+package v1beta1
+
+// Hub marks this type as a conversion hub.
+func (*VirtualMachine) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*VirtualMachineMigration) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*VirtualMachineExport) Hub() {}
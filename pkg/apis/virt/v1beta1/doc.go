@@ -0,0 +1,5 @@
+// +k8s:deepcopy-gen=package
+// +groupName=virt.virtink.smartx.com
+
+// Package v1beta1 is the v1beta1 version of the API.
+package v1beta1 // import "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
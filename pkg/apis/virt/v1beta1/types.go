@@ -0,0 +1,2255 @@
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:shortName=vm,categories=all;virtink
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Node",type=string,JSONPath=`.status.nodeName`
+// +kubebuilder:printcolumn:name="IP",type=string,JSONPath=`.status.podIP`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// VirtualMachine is a specification for a VirtualMachine resource
+type VirtualMachine struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineSpec   `json:"spec"`
+	Status VirtualMachineStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSpec is the spec for a VirtualMachine resource
+// +kubebuilder:validation:XValidation:rule="!has(self.instance.interfaces) || self.instance.interfaces.all(i, has(self.networks) && self.networks.exists(n, n.name == i.name))",message="every interface must have a network of the same name"
+type VirtualMachineSpec struct {
+	NodeSelector   map[string]string           `json:"nodeSelector,omitempty"`
+	Affinity       *corev1.Affinity            `json:"affinity,omitempty"`
+	Tolerations    []corev1.Toleration         `json:"tolerations,omitempty"`
+	Resources      corev1.ResourceRequirements `json:"resources,omitempty"`
+	LivenessProbe  *corev1.Probe               `json:"livenessProbe,omitempty"`
+	ReadinessProbe *corev1.Probe               `json:"readinessProbe,omitempty"`
+	// PriorityClassName is the priority class of the VM pod. Under node
+	// pressure, VMs of lower priority are moved off the node first.
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// Hostname is the hostname of the guest, which is the name of the VM by
+	// default. It's offered to the guest by DHCP and cloud-init.
+	Hostname string `json:"hostname,omitempty"`
+	// Subdomain makes the FQDN of the guest
+	// "<hostname>.<subdomain>.<namespace>.svc.<cluster domain>", resolvable
+	// if a headless Service of the same name selects the VM pod.
+	Subdomain string `json:"subdomain,omitempty"`
+	// DNSPolicy and DNSConfig work as those of pods. Name servers and search
+	// domains of the VM pod are offered to the guest by DHCP.
+	DNSPolicy corev1.DNSPolicy     `json:"dnsPolicy,omitempty"`
+	DNSConfig *corev1.PodDNSConfig `json:"dnsConfig,omitempty"`
+
+	// +kubebuilder:default=Once
+	RunPolicy RunPolicy `json:"runPolicy,omitempty"`
+	// UpdateStrategy is how changes of the spec of a running VM are applied.
+	// Changes which can't be applied to the running VM are listed by the
+	// RestartRequired condition until the VM is restarted.
+	// +kubebuilder:default=LiveUpdateIfPossible
+	UpdateStrategy UpdateStrategy `json:"updateStrategy,omitempty"`
+
+	Instance Instance `json:"instance"`
+	// +kubebuilder:validation:MaxItems=32
+	Volumes []Volume `json:"volumes,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="networks are immutable"
+	// +kubebuilder:validation:MaxItems=32
+	Networks []Network `json:"networks,omitempty"`
+
+	MemoryDump *MemoryDump `json:"memoryDump,omitempty"`
+	// Hibernation configures where the state of the VM is saved by the
+	// Hibernate power action.
+	Hibernation *Hibernation `json:"hibernation,omitempty"`
+
+	// SSHPublicKeys are authorized for the default user of the guest through
+	// the cloud-init volume or the metadata service, one of which is required.
+	SSHPublicKeys []SSHPublicKey `json:"sshPublicKeys,omitempty"`
+
+	// MetadataService serves the metadata of the VM to the guest on masquerade
+	// interfaces, at 169.254.169.254 as the EC2 and OpenStack metadata services
+	// do.
+	MetadataService *MetadataService `json:"metadataService,omitempty"`
+
+	// Schedule starts and stops the VM automatically. It may not be used
+	// with the Always and Halted run policies.
+	Schedule *Schedule `json:"schedule,omitempty"`
+}
+
+// MetadataService provides the instance ID, hostname, SSH public keys and
+// user data of the VM to cloud images which look for the EC2 or OpenStack
+// datasource rather than a cloud-init volume.
+type MetadataService struct {
+	UserData           string `json:"userData,omitempty"`
+	UserDataBase64     string `json:"userDataBase64,omitempty"`
+	UserDataSecretName string `json:"userDataSecretName,omitempty"`
+}
+
+// Schedule starts and stops a VM at the times given by cron expressions in
+// the standard five-field format, e.g. "0 20 * * 1-5" to stop development VMs
+// on weekday evenings.
+type Schedule struct {
+	// Start is when the VM is powered on.
+	Start string `json:"start,omitempty"`
+	// Stop is when the VM is powered off.
+	Stop string `json:"stop,omitempty"`
+	// TimeZone is the IANA name of the time zone of the cron expressions,
+	// e.g. "Asia/Shanghai". Defaults to UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+type SSHPublicKey struct {
+	// SecretName is the name of the secret of which every value holds SSH
+	// public keys, one per line.
+	// +kubebuilder:validation:MinLength=1
+	SecretName string `json:"secretName"`
+}
+
+// MemoryDump configures where guest memory dumps are stored. A dump is taken
+// on demand by setting the phase of status.memoryDump to Requested.
+type MemoryDump struct {
+	// +kubebuilder:validation:MinLength=1
+	ClaimName string `json:"claimName"`
+	// OnCrash takes a dump when a guest kernel panic is seen on the serial
+	// console.
+	OnCrash bool `json:"onCrash,omitempty"`
+}
+
+// Hibernation configures the PVC the memory and device state of a hibernated
+// VM is saved to. The VM is restored from it when powered on again, on any
+// node the PVC can be attached to.
+type Hibernation struct {
+	// +kubebuilder:validation:MinLength=1
+	ClaimName string `json:"claimName"`
+}
+
+// +kubebuilder:validation:Enum=Always;RerunOnFailure;Once;Manual;Halted
+
+type RunPolicy string
+
+const (
+	RunPolicyAlways         RunPolicy = "Always"
+	RunPolicyRerunOnFailure RunPolicy = "RerunOnFailure"
+	RunPolicyOnce           RunPolicy = "Once"
+	RunPolicyManual         RunPolicy = "Manual"
+	RunPolicyHalted         RunPolicy = "Halted"
+)
+
+// +kubebuilder:validation:Enum=LiveUpdateIfPossible;RestartOnChange
+
+type UpdateStrategy string
+
+const (
+	// UpdateStrategyLiveUpdateIfPossible applies changes of live updatable
+	// fields to the running VM, while other changes take effect the next time
+	// the VM is started.
+	UpdateStrategyLiveUpdateIfPossible UpdateStrategy = "LiveUpdateIfPossible"
+	// UpdateStrategyRestartOnChange power cycles the running VM as soon as it
+	// has changes which need a restart.
+	UpdateStrategyRestartOnChange UpdateStrategy = "RestartOnChange"
+)
+
+type Instance struct {
+	CPU CPU `json:"cpu,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!has(self.hugepages) || !has(self.size) || (type(self.size) == int ? self.size % (self.hugepages.pageSize == '2Mi' ? 2097152 : 1073741824) == 0 : self.hugepages.pageSize != '2Mi' || !string(self.size).endsWith('Mi') || ['0Mi', '2Mi', '4Mi', '6Mi', '8Mi'].exists(suffix, string(self.size).endsWith(suffix)))",message="size must be a multiple of the hugepages page size"
+	Memory Memory  `json:"memory,omitempty"`
+	Kernel *Kernel `json:"kernel,omitempty"`
+	// +kubebuilder:validation:MaxItems=32
+	Disks []Disk `json:"disks,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="fileSystems are immutable"
+	// +kubebuilder:validation:MaxItems=32
+	FileSystems []FileSystem `json:"fileSystems,omitempty"`
+	// Interfaces are immutable but for their state.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:XValidation:rule="self.map(i, i.name) == oldSelf.map(i, i.name)",message="may not add, remove or reorder interfaces"
+	// +kubebuilder:validation:MaxItems=32
+	Interfaces []Interface `json:"interfaces,omitempty"`
+	// Bonds aggregate interfaces in the guest, configured by the network data
+	// generated for cloudInit volumes.
+	// +kubebuilder:validation:MaxItems=16
+	Bonds    []Bond    `json:"bonds,omitempty"`
+	Realtime *Realtime `json:"realtime,omitempty"`
+	Watchdog *Watchdog `json:"watchdog,omitempty"`
+	Devices  *Devices  `json:"devices,omitempty"`
+	Firmware *Firmware `json:"firmware,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="hypervisor is immutable"
+	Hypervisor Hypervisor `json:"hypervisor,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vsock is immutable"
+	Vsock *Vsock `json:"vsock,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="rng is immutable"
+	RNG *RNG `json:"rng,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="downward is immutable"
+	Downward *Downward `json:"downward,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="clock is immutable"
+	Clock        *Clock        `json:"clock,omitempty"`
+	GuestMetrics *GuestMetrics `json:"guestMetrics,omitempty"`
+}
+
+// Clock configures the clock of the guest.
+type Clock struct {
+	// Offset is what the real-time clock of the guest keeps: utc for UTC, or
+	// localtime for the local time of Timezone, as Windows expects. Defaults
+	// to utc. localtime is only supported by QEMU.
+	Offset ClockOffset `json:"offset,omitempty"`
+	// Timezone is the IANA time zone of the real-time clock with the localtime
+	// offset, e.g. Asia/Shanghai.
+	Timezone string `json:"timezone,omitempty"`
+	// DisableKVMClock hides the kvmclock paravirtual clock source from the
+	// guest, which falls back to the TSC or HPET. Only supported by QEMU.
+	DisableKVMClock bool `json:"disableKVMClock,omitempty"`
+	// Sync sets the guest clock to the time of the node through the QEMU
+	// guest agent after the VM is resumed, restored from hibernation or live
+	// migrated, as the guest clock stands still while the VM is paused.
+	Sync *ClockSync `json:"sync,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=utc;localtime
+type ClockOffset string
+
+const (
+	ClockOffsetUTC       ClockOffset = "utc"
+	ClockOffsetLocaltime ClockOffset = "localtime"
+)
+
+type ClockSync struct {
+	// GuestAgentPort is the vsock port the QEMU guest agent listens on, over
+	// which it is reached with Cloud Hypervisor and Firecracker. Requires
+	// vsock. QEMU reaches the agent over its virtio-serial channel instead.
+	// +kubebuilder:validation:Minimum=1
+	GuestAgentPort uint32 `json:"guestAgentPort,omitempty"`
+}
+
+// Downward exposes the Kubernetes identity of the VM, and metrics of the node
+// it runs on, to agents in the guest.
+type Downward struct {
+	// SMBIOS sets SMBIOS OEM strings of the VM to its namespace, name and UID,
+	// as virtink.io/namespace=<namespace>, virtink.io/name=<name> and
+	// virtink.io/uid=<uid>. Not supported by Firecracker.
+	SMBIOS bool `json:"smbios,omitempty"`
+	// MetricsPort is the vsock port on the host (CID 2) on which virt-daemon
+	// serves metrics of the node and the VM in JSON. Requires vsock.
+	// +kubebuilder:validation:Minimum=1
+	MetricsPort uint32 `json:"metricsPort,omitempty"`
+}
+
+// GuestMetrics configures the collection of metrics from an agent in the
+// guest, which virt-daemon exports to Prometheus, so that VMs can be scaled
+// on the load in the guest rather than the load of the VM pod.
+type GuestMetrics struct {
+	// Port is the vsock port on which the agent in the guest serves a JSON
+	// document of its metrics to each connection. Requires vsock.
+	// +kubebuilder:validation:Minimum=1
+	Port uint32 `json:"port"`
+}
+
+// RNG configures the virtio-rng device that feeds the guest entropy from the
+// host, without which guests such as Windows and minimal Linux may hang on low
+// entropy. VMs have a virtio-rng device sourced from /dev/urandom by default.
+type RNG struct {
+	// Source is the host device entropy is read from. Defaults to /dev/urandom.
+	// +kubebuilder:validation:Enum="/dev/urandom";"/dev/random"
+	Source string `json:"source,omitempty"`
+	// Disabled removes the virtio-rng device. Cloud Hypervisor always adds
+	// the device, so it can only be disabled on other hypervisors.
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// Vsock adds a virtio-vsock device to the VM, through which Virtink components
+// connect to agents listening on vsock ports in the guest without networking.
+type Vsock struct {
+	// CID is the context ID of the guest. Defaults to 3. Each VM has its own
+	// vsock device, so CIDs don't need to be unique across VMs.
+	// +kubebuilder:validation:Minimum=3
+	CID uint32 `json:"cid,omitempty"`
+}
+
+// Hypervisor is the VMM the VM runs on. Defaults to CloudHypervisor. QEMU
+// supports guests that need devices Cloud Hypervisor lacks, such as legacy
+// BIOS and IDE disks. Firecracker runs microVMs with direct kernel boot at
+// minimal overhead. VMs on either can't be migrated or hibernated.
+// +kubebuilder:validation:Enum=CloudHypervisor;QEMU;Firecracker
+type Hypervisor string
+
+const (
+	HypervisorCloudHypervisor Hypervisor = "CloudHypervisor"
+	HypervisorQEMU            Hypervisor = "QEMU"
+	HypervisorFirecracker     Hypervisor = "Firecracker"
+)
+
+// Firmware selects the firmware the VM boots with when no kernel is specified.
+// Defaults to rust-hypervisor-firmware on x86_64.
+type Firmware struct {
+	// EFI boots the VM with the EDK2 UEFI firmware, which supports network
+	// boot. VMs on arm64 always boot with EFI.
+	EFI *EFIFirmware `json:"efi,omitempty"`
+}
+
+type EFIFirmware struct{}
+
+type CPU struct {
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	Sockets uint32 `json:"sockets,omitempty"`
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	CoresPerSocket        uint32 `json:"coresPerSocket,omitempty"`
+	DedicatedCPUPlacement bool   `json:"dedicatedCPUPlacement,omitempty"`
+}
+
+type Memory struct {
+	Size      resource.Quantity `json:"size,omitempty"`
+	Hugepages *Hugepages        `json:"hugepages,omitempty"`
+	// Balloon adds a virtio-balloon device, which virt-daemon inflates to
+	// reclaim guest memory while the node is under memory pressure. The guest
+	// needs the virtio-balloon driver.
+	Balloon *MemoryBalloon `json:"balloon,omitempty"`
+	// Swap lets the node swap out memory of the VM pod. It requires cgroup v2
+	// and swap enabled on the node.
+	Swap *MemorySwap `json:"swap,omitempty"`
+}
+
+type MemoryBalloon struct {
+	// MaxReclaimPercent is the most guest memory, in percent of its size,
+	// virt-daemon reclaims by inflating the balloon. Defaults to 50.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=90
+	MaxReclaimPercent int `json:"maxReclaimPercent,omitempty"`
+	// DisableFreePageReporting stops the guest from reporting the memory it
+	// frees, which the VMM gives back to the node. Free page reporting needs
+	// Linux 5.7 or later in the guest, and is not supported by Firecracker.
+	DisableFreePageReporting bool `json:"disableFreePageReporting,omitempty"`
+}
+
+type MemorySwap struct {
+	// Max limits the memory of the VM pod swapped out. Unlimited if unset.
+	Max *resource.Quantity `json:"max,omitempty"`
+	// DisableZswap writes memory of the VM pod to the swap device directly,
+	// instead of compressing it in memory with zswap first.
+	DisableZswap bool `json:"disableZswap,omitempty"`
+}
+
+type Hugepages struct {
+	// +kubebuilder:default="1Gi"
+	// +kubebuilder:validation:Enum="2Mi";"1Gi"
+	PageSize string `json:"pageSize,omitempty"`
+}
+
+// Realtime tunes the VM for low-latency workloads. vCPUs are pinned to
+// dedicated pCPUs, guest memory is prefaulted, and the VMM threads
+// are scheduled with SCHED_FIFO where permitted by the host. Guest memory
+// is not locked, as Cloud Hypervisor doesn't support it.
+type Realtime struct {
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	Priority int32 `json:"priority,omitempty"`
+}
+
+// Devices adds optional devices to the VM. Only supported by QEMU.
+type Devices struct {
+	// Display adds a VGA display to the VM, which is served over VNC by the
+	// vnc subresource of virt-api.
+	Display *Display `json:"display,omitempty"`
+	// USB adds an xHCI USB controller to the VM, to which the tablet and USB
+	// host devices are attached.
+	USB *USBController `json:"usb,omitempty"`
+	// Tablet adds a USB tablet to the VM, which reports absolute positions,
+	// so that the pointer of the guest follows the one of the VNC client.
+	// Requires usb.
+	Tablet *Tablet `json:"tablet,omitempty"`
+	// Sound adds a virtio-snd sound card to the VM. Its output is discarded,
+	// as the VM has no audio backend, but guests that require a sound card
+	// can run.
+	Sound *Sound `json:"sound,omitempty"`
+	// HostUSBDevices passes host USB devices through to the VM, attached to
+	// the USB controller. Requires usb.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	HostUSBDevices []HostUSBDevice `json:"hostUSBDevices,omitempty"`
+}
+
+type Display struct{}
+
+type USBController struct{}
+
+type Tablet struct{}
+
+type Sound struct{}
+
+// HostUSBDevice is a host USB device allocated to the VM pod by a device
+// plugin, which lists the bus and address of the devices it allocates in the
+// USB_DEVICE_<resource name> environment variable.
+type HostUSBDevice struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+	// ResourceName is the resource the device is allocated from, e.g.
+	// devices.virtink.io/usb-dongle published by virt-daemon.
+	// +kubebuilder:validation:MinLength=1
+	ResourceName string `json:"resourceName"`
+}
+
+// Watchdog adds a virtio-watchdog device to the VM. Cloud Hypervisor resets
+// the guest when the watchdog expires, and Action is performed afterwards.
+type Watchdog struct {
+	// +kubebuilder:default=Reset
+	Action WatchdogAction `json:"action,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Reset;PowerOff;None
+
+type WatchdogAction string
+
+const (
+	WatchdogReset    WatchdogAction = "Reset"
+	WatchdogPowerOff WatchdogAction = "PowerOff"
+	WatchdogNone     WatchdogAction = "None"
+)
+
+type Kernel struct {
+	// +kubebuilder:validation:MinLength=1
+	Image           string            `json:"image"`
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// +kubebuilder:validation:MinLength=1
+	Cmdline string `json:"cmdline"`
+}
+
+type Disk struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name      string         `json:"name"`
+	ReadOnly  *bool          `json:"readOnly,omitempty"`
+	RateLimit *DiskRateLimit `json:"rateLimit,omitempty"`
+	// Queues is the number of virtqueues of the disk. Defaults to the number of vCPUs.
+	// +kubebuilder:validation:Minimum=1
+	Queues uint32 `json:"queues,omitempty"`
+	// BootOrder is the position of the disk in the boot order, lowest first.
+	// Disks without a boot order are tried after the ones with.
+	// +kubebuilder:validation:Minimum=1
+	BootOrder uint32 `json:"bootOrder,omitempty"`
+	// Bus is the bus the disk is attached to. Defaults to virtio. Other buses
+	// are only supported by QEMU.
+	Bus DiskBus `json:"bus,omitempty"`
+	// IOEngine is how the VMM submits I/O of the disk to the host. Cloud
+	// Hypervisor uses io_uring if the host kernel supports it, while QEMU and
+	// Firecracker use threads, unless set.
+	IOEngine DiskIOEngine `json:"ioEngine,omitempty"`
+	// Cache is how the host caches I/O of the disk. none bypasses the page
+	// cache of the host with O_DIRECT, writeback caches writes until the guest
+	// flushes them, and writethrough only caches reads, which is only
+	// supported by QEMU. Defaults to none, or writeback for cloud-init and
+	// sysprep volumes and on Firecracker, which doesn't support O_DIRECT.
+	Cache DiskCache `json:"cache,omitempty"`
+	// Discard passes TRIM requests of the guest to the volume, so that
+	// thin-provisioned storage is reclaimed. Only supported by QEMU.
+	Discard *DiskDiscard `json:"discard,omitempty"`
+	// Encryption decrypts the disk, of which the volume holds a LUKS image,
+	// so that its data is encrypted at rest. Only supported by QEMU.
+	Encryption *DiskEncryption `json:"encryption,omitempty"`
+	// Shareable allows the disk to be attached to other VMs at the same time,
+	// e.g. as the quorum disk of a cluster file system. Its volume must be a
+	// ReadWriteMany PVC, and its cache must be none.
+	Shareable bool `json:"shareable,omitempty"`
+	// Type is the type of the device the disk is presented as. Defaults to
+	// disk. cdrom disks are read-only, and the medium in them can be changed
+	// while the VM is running. Only supported by QEMU, on the sata or ide bus.
+	// pmem disks are virtio-pmem devices, which guests with DAX map into their
+	// memory instead of caching them. The size of their images must be a
+	// multiple of 2Mi. Not supported by Firecracker.
+	Type DiskType `json:"type,omitempty"`
+	// Medium is the name of the volume inserted into the cdrom disk, which can
+	// be changed while the VM is running. Defaults to the volume of the disk.
+	Medium string `json:"medium,omitempty"`
+	// Ejected empties the cdrom disk, which can be changed while the VM is
+	// running.
+	Ejected bool `json:"ejected,omitempty"`
+	// Ephemeral writes to a copy-on-write qcow2 overlay in the VM pod, which
+	// is discarded when the VM stops, instead of to the volume, which is only
+	// read as the base image. Many VMs can thus share one golden image on a
+	// ReadOnlyMany PVC. The volume must be a containerDisk, PVC or data
+	// volume. Not supported by Firecracker.
+	Ephemeral bool `json:"ephemeral,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=disk;cdrom;pmem
+type DiskType string
+
+const (
+	DiskTypeDisk  DiskType = "disk"
+	DiskTypeCDROM DiskType = "cdrom"
+	DiskTypePmem  DiskType = "pmem"
+)
+
+type DiskEncryption struct {
+	// SecretName is the name of the secret with the LUKS passphrase in the
+	// passphrase key.
+	// +kubebuilder:validation:MinLength=1
+	SecretName string `json:"secretName"`
+}
+
+// DiskDiscard discards blocks of block devices, and punches holes in disk
+// image files, when the guest trims them.
+type DiskDiscard struct {
+	// DetectZeroes also discards blocks the guest writes zeroes to, for
+	// guests that zero unused blocks instead of trimming them.
+	DetectZeroes bool `json:"detectZeroes,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=none;writeback;writethrough
+type DiskCache string
+
+const (
+	DiskCacheNone         DiskCache = "none"
+	DiskCacheWriteback    DiskCache = "writeback"
+	DiskCacheWritethrough DiskCache = "writethrough"
+)
+
+// +kubebuilder:validation:Enum=io_uring;threads
+type DiskIOEngine string
+
+const (
+	DiskIOEngineIOURing DiskIOEngine = "io_uring"
+	DiskIOEngineThreads DiskIOEngine = "threads"
+)
+
+// +kubebuilder:validation:Enum=virtio;sata;ide
+type DiskBus string
+
+const (
+	DiskBusVirtio DiskBus = "virtio"
+	DiskBusSATA   DiskBus = "sata"
+	DiskBusIDE    DiskBus = "ide"
+)
+
+type DiskRateLimit struct {
+	// Bandwidth is the sustained bandwidth limit in bytes per second.
+	Bandwidth *resource.Quantity `json:"bandwidth,omitempty"`
+	// BandwidthBurst is the one-time burst in bytes allowed above the bandwidth limit.
+	BandwidthBurst *resource.Quantity `json:"bandwidthBurst,omitempty"`
+	// IOPS is the sustained limit of I/O operations per second.
+	// +kubebuilder:validation:Minimum=0
+	IOPS int64 `json:"iops,omitempty"`
+	// IOPSBurst is the one-time burst of I/O operations allowed above the IOPS limit.
+	// +kubebuilder:validation:Minimum=0
+	IOPSBurst int64 `json:"iopsBurst,omitempty"`
+}
+
+type FileSystem struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+}
+
+// +kubebuilder:validation:XValidation:rule="[has(self.bridge), has(self.masquerade), has(self.sriov), has(self.vhostUser), has(self.ovs)].filter(x, x).size() <= 1",message="may not specify more than 1 binding method"
+type Interface struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+	// +kubebuilder:validation:Pattern=`^([0-9A-Fa-f]{2}[:-]){5}[0-9A-Fa-f]{2}$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="mac is immutable"
+	MAC                    string `json:"mac,omitempty"`
+	InterfaceBindingMethod `json:",inline"`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="rateLimit is immutable"
+	RateLimit *InterfaceRateLimit `json:"rateLimit,omitempty"`
+	// Queues is the number of RX/TX queue pairs of the interface. Defaults to the number
+	// of vCPUs for bridge and masquerade interfaces.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="queues is immutable"
+	Queues uint32 `json:"queues,omitempty"`
+	// BootOrder enables network boot from the interface at the position in the
+	// boot order, which requires EFI firmware. Interfaces are always tried
+	// after disks.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="bootOrder is immutable"
+	BootOrder uint32 `json:"bootOrder,omitempty"`
+	// DHCPOptions customizes the options offered by the DHCP server of bridge
+	// and masquerade interfaces.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dhcpOptions is immutable"
+	DHCPOptions *InterfaceDHCPOptions `json:"dhcpOptions,omitempty"`
+	// MTU of the guest interface. Defaults to the MTU of the network interface
+	// of the VM pod, which it may not exceed.
+	// +kubebuilder:validation:Minimum=68
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="mtu is immutable"
+	MTU int32 `json:"mtu,omitempty"`
+	// Offloads turns off offloads of the virtio-net device, which some guests
+	// and nested environments need for working networking. Only supported by
+	// QEMU.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="offloads is immutable"
+	Offloads *InterfaceOffloads `json:"offloads,omitempty"`
+	// Vhost moves the datapath of bridge and masquerade interfaces into the
+	// vhost-net module of the host kernel, which requires /dev/vhost-net on
+	// the node. Only supported by QEMU.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vhost is immutable"
+	Vhost bool `json:"vhost,omitempty"`
+	// RXQueueSize is the number of descriptors of each RX queue, a power of 2.
+	// Defaults to 256.
+	// +kubebuilder:validation:Minimum=256
+	// +kubebuilder:validation:Maximum=1024
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="rxQueueSize is immutable"
+	RXQueueSize uint32 `json:"rxQueueSize,omitempty"`
+	// TXQueueSize is the number of descriptors of each TX queue, a power of 2.
+	// Defaults to 256. QEMU only supports larger TX queues for vhost-user
+	// interfaces, and Cloud Hypervisor only the same size as RX queues.
+	// +kubebuilder:validation:Minimum=256
+	// +kubebuilder:validation:Maximum=1024
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="txQueueSize is immutable"
+	TXQueueSize uint32 `json:"txQueueSize,omitempty"`
+	// SecurityGroups are the names of VirtualMachineSecurityGroups in the
+	// namespace of the VM that filter the traffic of bridge and masquerade
+	// interfaces. Traffic is only allowed if a rule of any of them allows
+	// it. Not filtered if empty.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="securityGroups is immutable"
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+	// VLAN places the guest in VLANs of the multus network of a bridge
+	// interface.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vlan is immutable"
+	VLAN *InterfaceVLAN `json:"vlan,omitempty"`
+	// State is the administrative state of the link of the guest NIC, which
+	// may be changed while the VM is running, e.g. to test how the guest
+	// copes with network failures. Defaults to up.
+	State InterfaceState `json:"state,omitempty"`
+	// Addresses are static IP addresses of the interface in CIDR notation,
+	// configured in the guest by the network data generated for cloudInit
+	// volumes. The guest uses DHCP for IPv4 if none of them is IPv4.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="addresses is immutable"
+	Addresses []string `json:"addresses,omitempty"`
+	// Routes are static routes of the guest through the interface,
+	// configured like addresses.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="routes is immutable"
+	Routes []InterfaceRoute `json:"routes,omitempty"`
+}
+
+// Bond is a bond of interfaces in the guest, which holds the addresses and
+// routes of its members.
+type Bond struct {
+	// Name is the name of the bond in the guest.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=15
+	Name string `json:"name"`
+	// Interfaces are the names of the bridge, SR-IOV or OVS interfaces of the
+	// bond.
+	// +kubebuilder:validation:MinItems=2
+	Interfaces []string `json:"interfaces"`
+	// Mode is the bonding mode. Defaults to active-backup.
+	Mode BondMode `json:"mode,omitempty"`
+	// Primary is the interface preferred by the active-backup mode.
+	Primary string `json:"primary,omitempty"`
+	// MIIMonitorInterval is the interval in milliseconds of checking the links
+	// of the interfaces. Defaults to 100.
+	MIIMonitorInterval uint32 `json:"miiMonitorInterval,omitempty"`
+	// Addresses are static IP addresses of the bond in CIDR notation. The
+	// guest uses DHCP for IPv4 if none of them is IPv4.
+	Addresses []string `json:"addresses,omitempty"`
+	// Routes are static routes of the guest through the bond.
+	Routes []InterfaceRoute `json:"routes,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=active-backup;balance-rr;balance-xor;broadcast;"802.3ad";balance-tlb;balance-alb
+type BondMode string
+
+const (
+	BondModeActiveBackup BondMode = "active-backup"
+	BondModeBalanceRR    BondMode = "balance-rr"
+	BondModeBalanceXOR   BondMode = "balance-xor"
+	BondModeBroadcast    BondMode = "broadcast"
+	BondMode8023AD       BondMode = "802.3ad"
+	BondModeBalanceTLB   BondMode = "balance-tlb"
+	BondModeBalanceALB   BondMode = "balance-alb"
+)
+
+// InterfaceRoute is a route of the guest to the destination through the
+// gateway.
+type InterfaceRoute struct {
+	// To is the destination of the route in CIDR notation, or "default".
+	To string `json:"to"`
+	// Via is the IP address of the gateway.
+	Via string `json:"via"`
+	// +optional
+	Metric uint32 `json:"metric,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=up;down
+type InterfaceState string
+
+const (
+	InterfaceStateUp   InterfaceState = "up"
+	InterfaceStateDown InterfaceState = "down"
+)
+
+// InterfaceVLAN sets the VLANs of the tap device on the bridge in the VM pod,
+// of which the frames are tagged on the link of the network.
+type InterfaceVLAN struct {
+	// ID is the VLAN of untagged frames of the guest. Untagged frames stay
+	// untagged on the link if not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4094
+	ID int32 `json:"id,omitempty"`
+	// Trunks are the VLANs of which the guest sends and receives tagged
+	// frames.
+	Trunks []VLANRange `json:"trunks,omitempty"`
+}
+
+type VLANRange struct {
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4094
+	ID int32 `json:"id"`
+	// EndID is the last VLAN of the range starting at ID.
+	// +optional
+	EndID int32 `json:"endID,omitempty"`
+}
+
+// InterfaceOffloads turns off offloads of the virtio-net device of an
+// interface, which are all on by default.
+type InterfaceOffloads struct {
+	// DisableChecksum turns off checksum offload, and TSO and UFO with it, as
+	// they require it.
+	DisableChecksum bool `json:"disableChecksum,omitempty"`
+	// DisableTSO turns off TCP segmentation offload.
+	DisableTSO bool `json:"disableTSO,omitempty"`
+	// DisableUFO turns off UDP fragmentation offload.
+	DisableUFO bool `json:"disableUFO,omitempty"`
+}
+
+// InterfaceDHCPOptions overrides the DNS settings of the VM pod, which are
+// offered to the guest by default.
+type InterfaceDHCPOptions struct {
+	// DNSServers are IPv4 addresses of DNS servers.
+	DNSServers    []string `json:"dnsServers,omitempty"`
+	SearchDomains []string `json:"searchDomains,omitempty"`
+	// NTPServers are IPv4 addresses of NTP servers.
+	NTPServers []string `json:"ntpServers,omitempty"`
+}
+
+// InterfaceRateLimit shapes the traffic of an interface, as seen by the guest.
+type InterfaceRateLimit struct {
+	RX *BandwidthLimit `json:"rx,omitempty"`
+	TX *BandwidthLimit `json:"tx,omitempty"`
+}
+
+type BandwidthLimit struct {
+	// Bandwidth is the sustained rate in bits per second.
+	Bandwidth resource.Quantity `json:"bandwidth"`
+	// Burst is the amount of bytes that can be sent at once above the bandwidth.
+	Burst *resource.Quantity `json:"burst,omitempty"`
+}
+
+type InterfaceBindingMethod struct {
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="bridge is immutable"
+	Bridge *InterfaceBridge `json:"bridge,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="masquerade is immutable"
+	Masquerade *InterfaceMasquerade `json:"masquerade,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="sriov is immutable"
+	SRIOV *InterfaceSRIOV `json:"sriov,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vhostUser is immutable"
+	VhostUser *InterfaceVhostUser `json:"vhostUser,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ovs is immutable"
+	OVS *InterfaceOVS `json:"ovs,omitempty"`
+}
+
+type InterfaceBridge struct {
+}
+
+type InterfaceMasquerade struct {
+	CIDR string `json:"cidr,omitempty"`
+}
+
+type InterfaceSRIOV struct {
+}
+
+type InterfaceVhostUser struct {
+}
+
+// InterfaceOVS connects the guest to the Open vSwitch port of a multus
+// network, such as one of OVS CNI or a kube-ovn provider network, in place
+// of the VM pod.
+type InterfaceOVS struct {
+	// VLAN is the VLAN of untagged frames of the guest, which is set as the
+	// tag of the OVS port.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4094
+	VLAN *int32 `json:"vlan,omitempty"`
+	// Trunks are the VLANs of which the guest sends and receives tagged
+	// frames, which are set as the trunks of the OVS port.
+	Trunks []int32 `json:"trunks,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="[has(self.containerDisk), has(self.httpDisk), has(self.emptyDisk), has(self.cloudInit), has(self.containerRootfs), has(self.persistentVolumeClaim), has(self.dataVolume), has(self.clusterAPIBootstrap), has(self.configMap), has(self.secret), has(self.serviceAccountToken), has(self.sysprep)].filter(x, x).size() == 1",message="must specify exactly 1 volume source"
+type Volume struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name         string `json:"name"`
+	VolumeSource `json:",inline"`
+}
+
+type VolumeSource struct {
+	ContainerDisk         *ContainerDiskVolumeSource         `json:"containerDisk,omitempty"`
+	HTTPDisk              *HTTPDiskVolumeSource              `json:"httpDisk,omitempty"`
+	EmptyDisk             *EmptyDiskVolumeSource             `json:"emptyDisk,omitempty"`
+	CloudInit             *CloudInitVolumeSource             `json:"cloudInit,omitempty"`
+	ContainerRootfs       *ContainerRootfsVolumeSource       `json:"containerRootfs,omitempty"`
+	PersistentVolumeClaim *PersistentVolumeClaimVolumeSource `json:"persistentVolumeClaim,omitempty"`
+	DataVolume            *DataVolumeVolumeSource            `json:"dataVolume,omitempty"`
+	ClusterAPIBootstrap   *ClusterAPIBootstrapVolumeSource   `json:"clusterAPIBootstrap,omitempty"`
+	Sysprep               *SysprepVolumeSource               `json:"sysprep,omitempty"`
+	ConfigMap             *ConfigMapVolumeSource             `json:"configMap,omitempty"`
+	Secret                *SecretVolumeSource                `json:"secret,omitempty"`
+	ServiceAccountToken   *ServiceAccountTokenVolumeSource   `json:"serviceAccountToken,omitempty"`
+}
+
+// ServiceAccountTokenVolumeSource presents a token of a service account,
+// bound to the VM pod and scoped to the audience, to the guest as the token
+// file, along with the ca.crt and namespace files, so that workloads in the
+// guest can authenticate to the Kubernetes API. The files are on an ISO image
+// created when the VM starts, or on a virtio-fs file system, on which the
+// kubelet rotates the token, if a file system of the VM uses the volume.
+type ServiceAccountTokenVolumeSource struct {
+	// ServiceAccountName is the name of the service account. The VM pod runs
+	// as the service account, so all service account token volumes of a VM
+	// must be of the same service account.
+	// +kubebuilder:validation:MinLength=1
+	ServiceAccountName string `json:"serviceAccountName"`
+	// Audience is the intended audience of the token. Defaults to the
+	// audience of the API server.
+	Audience string `json:"audience,omitempty"`
+	// ExpirationSeconds is the requested lifetime of the token. Defaults to 1
+	// hour.
+	// +kubebuilder:validation:Minimum=600
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+}
+
+// ConfigMapVolumeSource presents the keys of a config map as files to the
+// guest, on a small disk image created when the VM starts, or on a virtio-fs
+// file system if a file system of the VM uses the volume.
+type ConfigMapVolumeSource struct {
+	// Name is the name of the config map.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Format is the format of the disk image. Defaults to iso.
+	Format ConfigDiskFormat `json:"format,omitempty"`
+	// VolumeLabel is the label of the file system on the disk image, by which
+	// the guest finds it. Defaults to the name of the volume, truncated to 32
+	// characters for iso images and 11 characters for fat images.
+	VolumeLabel string `json:"volumeLabel,omitempty"`
+}
+
+// SecretVolumeSource presents the keys of a secret as files to the guest, the
+// same way as a ConfigMapVolumeSource, e.g. for certificates.
+type SecretVolumeSource struct {
+	// SecretName is the name of the secret.
+	// +kubebuilder:validation:MinLength=1
+	SecretName string `json:"secretName"`
+	// Format is the format of the disk image. Defaults to iso.
+	Format ConfigDiskFormat `json:"format,omitempty"`
+	// VolumeLabel is the label of the file system on the disk image, by which
+	// the guest finds it. Defaults to the name of the volume, truncated to 32
+	// characters for iso images and 11 characters for fat images.
+	VolumeLabel string `json:"volumeLabel,omitempty"`
+}
+
+// ConfigDiskFormat is the format of the disk image of a config map or secret.
+// iso images are read-only ISO 9660 file systems with Joliet and Rock Ridge
+// extensions, while fat images are FAT file systems, which the guest can
+// write to, although the writes are discarded when the VM stops.
+// +kubebuilder:validation:Enum=iso;fat
+type ConfigDiskFormat string
+
+const (
+	ConfigDiskFormatISO ConfigDiskFormat = "iso"
+	ConfigDiskFormatFAT ConfigDiskFormat = "fat"
+)
+
+// SysprepVolumeSource is an ISO image with the files of a config map or
+// secret, such as autounattend.xml, for Windows Setup to provision the guest
+// with. Its disk must be a cdrom disk.
+// +kubebuilder:validation:XValidation:rule="[has(self.configMapName), has(self.secretName)].filter(x, x).size() == 1",message="must specify exactly 1 of configMapName and secretName"
+type SysprepVolumeSource struct {
+	// ConfigMapName is the name of the config map, of which each key is a
+	// file of the image.
+	ConfigMapName string `json:"configMapName,omitempty"`
+	// SecretName is the name of the secret, of which each key is a file of
+	// the image, for unattend files with passwords.
+	SecretName string `json:"secretName,omitempty"`
+}
+
+type ContainerDiskVolumeSource struct {
+	// +kubebuilder:validation:MinLength=1
+	Image           string            `json:"image"`
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+}
+
+// HTTPDiskVolumeSource is a disk image downloaded from an HTTP(S) server by an
+// init container of the VM pod, for clusters without CDI. The image is
+// converted to raw, whatever its format is.
+type HTTPDiskVolumeSource struct {
+	// URL is the HTTP(S) URL of the image, of any format qemu-img reads, such
+	// as raw or qcow2, optionally compressed with gzip or xz.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+	// Checksum is the checksum of the image as downloaded, in the form of
+	// <algorithm>:<hex digest>, where the algorithm is sha256 or sha512. The
+	// image is rejected if it doesn't match.
+	// +kubebuilder:validation:Pattern=`^(sha256:[0-9a-f]{64}|sha512:[0-9a-f]{128})$`
+	Checksum string `json:"checksum,omitempty"`
+}
+
+// EmptyDiskVolumeSource is a blank sparse raw image created when the VM
+// starts, for swap and temporary data. The disk is discarded when the VM
+// stops.
+type EmptyDiskVolumeSource struct {
+	// Capacity is the size of the disk.
+	Capacity resource.Quantity `json:"capacity"`
+	// VolumeClaim creates the disk on a generic ephemeral PVC, which is
+	// deleted along with the VM pod, instead of in the ephemeral storage of
+	// the VM pod.
+	VolumeClaim *EmptyDiskVolumeClaim `json:"volumeClaim,omitempty"`
+}
+
+type EmptyDiskVolumeClaim struct {
+	// StorageClassName is the storage class of the PVC. Defaults to the
+	// default storage class of the cluster.
+	StorageClassName *string `json:"storageClassName,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="[has(self.userData), has(self.userDataBase64), has(self.userDataSecretName)].filter(x, x).size() <= 1",message="may not specify more than 1 user data"
+// +kubebuilder:validation:XValidation:rule="[has(self.networkData), has(self.networkDataBase64), has(self.networkDataSecretName), has(self.generateNetworkData) && self.generateNetworkData].filter(x, x).size() <= 1",message="may not specify more than 1 network data"
+type CloudInitVolumeSource struct {
+	UserData              string `json:"userData,omitempty"`
+	UserDataBase64        string `json:"userDataBase64,omitempty"`
+	UserDataSecretName    string `json:"userDataSecretName,omitempty"`
+	NetworkData           string `json:"networkData,omitempty"`
+	NetworkDataBase64     string `json:"networkDataBase64,omitempty"`
+	NetworkDataSecretName string `json:"networkDataSecretName,omitempty"`
+	// GenerateNetworkData generates network data of version 2 from the
+	// interfaces of the VM, matching guest NICs by their MAC addresses.
+	GenerateNetworkData bool `json:"generateNetworkData,omitempty"`
+}
+
+// ClusterAPIBootstrapVolumeSource is a cloud-init volume of which the user
+// data is the bootstrap data of a Cluster API machine.
+type ClusterAPIBootstrapVolumeSource struct {
+	// SecretName is the name of the bootstrap data secret of the machine,
+	// which must be of the cloud-config format.
+	// +kubebuilder:validation:MinLength=1
+	SecretName string `json:"secretName"`
+}
+
+type ContainerRootfsVolumeSource struct {
+	// +kubebuilder:validation:MinLength=1
+	Image           string            `json:"image"`
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	Size            resource.Quantity `json:"size"`
+}
+
+type PersistentVolumeClaimVolumeSource struct {
+	// +kubebuilder:validation:MinLength=1
+	ClaimName string `json:"claimName"`
+	// Populate populates the PVC before the VM is started for the first time.
+	Populate *PersistentVolumeClaimPopulateSource `json:"populate,omitempty"`
+}
+
+// PersistentVolumeClaimPopulateSource is the image that an empty PVC is
+// populated with, from either a container disk or an HTTP(S) server. The image
+// is converted to raw, whatever its format is.
+// +kubebuilder:validation:XValidation:rule="[has(self.containerDisk), has(self.httpDisk)].filter(x, x).size() == 1",message="must specify exactly 1 of containerDisk and httpDisk"
+type PersistentVolumeClaimPopulateSource struct {
+	ContainerDisk *ContainerDiskVolumeSource `json:"containerDisk,omitempty"`
+	HTTPDisk      *HTTPDiskVolumeSource      `json:"httpDisk,omitempty"`
+	// Grow grows the disk image in a Filesystem mode PVC to the capacity of the
+	// PVC on every start of the VM, so that the disk follows expansions of the
+	// PVC. Partitions and file systems in the disk are grown by the guest.
+	Grow bool `json:"grow,omitempty"`
+}
+
+type DataVolumeVolumeSource struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+}
+
+type Network struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name          string `json:"name"`
+	NetworkSource `json:",inline"`
+}
+
+type NetworkSource struct {
+	Pod    *PodNetworkSource    `json:"pod,omitempty"`
+	Multus *MultusNetworkSource `json:"multus,omitempty"`
+}
+
+type PodNetworkSource struct {
+}
+
+type MultusNetworkSource struct {
+	NetworkName string `json:"networkName"`
+}
+
+// VirtualMachineStatus is the status for a VirtualMachine resource
+type VirtualMachineStatus struct {
+	Phase       VirtualMachinePhase              `json:"phase,omitempty"`
+	PodName     string                           `json:"podName,omitempty"`
+	PodUID      types.UID                        `json:"podUID,omitempty"`
+	PodIP       string                           `json:"podIP,omitempty"`
+	NodeName    string                           `json:"nodeName,omitempty"`
+	PowerAction VirtualMachinePowerAction        `json:"powerAction,omitempty"`
+	Migration   *VirtualMachineStatusMigration   `json:"migration,omitempty"`
+	MemoryDump  *VirtualMachineStatusMemoryDump  `json:"memoryDump,omitempty"`
+	Schedule    *VirtualMachineStatusSchedule    `json:"schedule,omitempty"`
+	Hibernation *VirtualMachineStatusHibernation `json:"hibernation,omitempty"`
+	Conditions  []metav1.Condition               `json:"conditions,omitempty"`
+	// ProviderID identifies the VM as the instance of a Kubernetes node, in
+	// the form of virtink://<uid>.
+	ProviderID string                  `json:"providerID,omitempty"`
+	Addresses  []VirtualMachineAddress `json:"addresses,omitempty"`
+}
+
+type VirtualMachineAddress struct {
+	Type    VirtualMachineAddressType `json:"type"`
+	Address string                    `json:"address"`
+}
+
+// +kubebuilder:validation:Enum=Hostname;InternalIP
+
+type VirtualMachineAddressType string
+
+const (
+	VirtualMachineHostname   VirtualMachineAddressType = "Hostname"
+	VirtualMachineInternalIP VirtualMachineAddressType = "InternalIP"
+)
+
+type VirtualMachineStatusHibernation struct {
+	Phase VirtualMachineHibernationPhase `json:"phase,omitempty"`
+	// SnapshotName is the directory on the hibernation PVC the state of the
+	// VM is saved in.
+	SnapshotName string `json:"snapshotName,omitempty"`
+	// HibernationTime is when the state of the VM was saved.
+	HibernationTime *metav1.Time `json:"hibernationTime,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Hibernated
+
+type VirtualMachineHibernationPhase string
+
+const (
+	// VirtualMachineHibernated means the VM is restored from the hibernation
+	// PVC the next time it is powered on.
+	VirtualMachineHibernated VirtualMachineHibernationPhase = "Hibernated"
+)
+
+type VirtualMachineStatusSchedule struct {
+	// LastScheduleTime is the time of the last scheduled start or stop, or
+	// when the schedule was first seen. Earlier ones are not taken.
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+}
+
+type VirtualMachineStatusMemoryDump struct {
+	Phase          VirtualMachineMemoryDumpPhase `json:"phase,omitempty"`
+	FileName       string                        `json:"fileName,omitempty"`
+	CompletionTime *metav1.Time                  `json:"completionTime,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Requested;Completed;Failed
+
+type VirtualMachineMemoryDumpPhase string
+
+const (
+	VirtualMachineMemoryDumpRequested VirtualMachineMemoryDumpPhase = "Requested"
+	VirtualMachineMemoryDumpCompleted VirtualMachineMemoryDumpPhase = "Completed"
+	VirtualMachineMemoryDumpFailed    VirtualMachineMemoryDumpPhase = "Failed"
+)
+
+// +kubebuilder:validation:Enum=Pending;Scheduling;Scheduled;Running;Succeeded;Failed;Unknown
+
+type VirtualMachinePhase string
+
+const (
+	VirtualMachinePending    VirtualMachinePhase = "Pending"
+	VirtualMachineScheduling VirtualMachinePhase = "Scheduling"
+	VirtualMachineScheduled  VirtualMachinePhase = "Scheduled"
+	VirtualMachineRunning    VirtualMachinePhase = "Running"
+	VirtualMachineSucceeded  VirtualMachinePhase = "Succeeded"
+	VirtualMachineFailed     VirtualMachinePhase = "Failed"
+	VirtualMachineUnknown    VirtualMachinePhase = "Unknown"
+)
+
+// +kubebuilder:validation:Enum=PowerOn;PowerOff;Shutdown;Reset;Reboot;Pause;Resume;Hibernate;PowerCycle
+
+type VirtualMachinePowerAction string
+
+const (
+	VirtualMachinePowerOn  VirtualMachinePowerAction = "PowerOn"
+	VirtualMachinePowerOff VirtualMachinePowerAction = "PowerOff"
+	VirtualMachineShutdown VirtualMachinePowerAction = "Shutdown"
+	VirtualMachineReset    VirtualMachinePowerAction = "Reset"
+	VirtualMachineReboot   VirtualMachinePowerAction = "Reboot"
+	VirtualMachinePause    VirtualMachinePowerAction = "Pause"
+	VirtualMachineResume   VirtualMachinePowerAction = "Resume"
+	// VirtualMachineHibernate saves the state of the VM to the hibernation
+	// PVC and powers it off.
+	VirtualMachineHibernate VirtualMachinePowerAction = "Hibernate"
+	// VirtualMachinePowerCycle powers off the VM and powers it on again in a
+	// new VM pod, so that all changes of the VM spec take effect.
+	VirtualMachinePowerCycle VirtualMachinePowerAction = "PowerCycle"
+)
+
+type VirtualMachineStatusMigration struct {
+	UID               types.UID                             `json:"uid,omitempty"`
+	Type              VirtualMachineMigrationType           `json:"type,omitempty"`
+	Phase             VirtualMachineMigrationPhase          `json:"phase,omitempty"`
+	TargetNodeName    string                                `json:"targetNodeName,omitempty"`
+	TargetNodeIP      string                                `json:"targetNodeIP,omitempty"`
+	TargetNodePort    int                                   `json:"targetNodePort,omitempty"`
+	TargetPodName     string                                `json:"targetPodName,omitempty"`
+	TargetPodUID      types.UID                             `json:"targetPodUID,omitempty"`
+	TargetStoragePort int                                   `json:"targetStoragePort,omitempty"`
+	Volumes           []VirtualMachineStatusMigrationVolume `json:"volumes,omitempty"`
+	// TargetNodeSelector and TargetAffinity are the constraints on the target
+	// node of the VMM.
+	TargetNodeSelector map[string]string `json:"targetNodeSelector,omitempty"`
+	TargetAffinity     *corev1.Affinity  `json:"targetAffinity,omitempty"`
+}
+
+// VirtualMachineStatusMigrationVolume is a node-local volume copied to the target node during migration.
+type VirtualMachineStatusMigrationVolume struct {
+	Name            string `json:"name"`
+	SourceClaimName string `json:"sourceClaimName"`
+	TargetClaimName string `json:"targetClaimName"`
+}
+
+type VirtualMachineConditionType string
+
+const (
+	VirtualMachineReady            VirtualMachineConditionType = "Ready"
+	VirtualMachinePaused           VirtualMachineConditionType = "Paused"
+	VirtualMachineLiveMigratable   VirtualMachineConditionType = "LiveMigratable"
+	VirtualMachineDataVolumesReady VirtualMachineConditionType = "DataVolumesReady"
+	// VirtualMachineVolumesPopulated tells whether the PVCs of the VM with a
+	// populate source have been populated.
+	VirtualMachineVolumesPopulated VirtualMachineConditionType = "VolumesPopulated"
+	// VirtualMachineOfflineMigratable tells whether the VM can be migrated
+	// by an Offline VMM.
+	VirtualMachineOfflineMigratable VirtualMachineConditionType = "OfflineMigratable"
+	// VirtualMachineSynchronized is False when virt-controller fails to
+	// reconcile the VM, with the error as the message.
+	VirtualMachineSynchronized VirtualMachineConditionType = "Synchronized"
+	// VirtualMachineSchedulable is False while the VM pod can't be
+	// scheduled, telling why no node can run the VM if none can.
+	VirtualMachineSchedulable VirtualMachineConditionType = "Schedulable"
+	// VirtualMachineRestartRequired is True while the spec of the running VM
+	// has changes which take effect when it is restarted, listing the changed
+	// fields in the message.
+	VirtualMachineRestartRequired VirtualMachineConditionType = "RestartRequired"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VirtualMachineList is a list of VirtualMachine resources
+type VirtualMachineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VirtualMachine `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:shortName=vmm,categories=all;virtink
+// +kubebuilder:printcolumn:name="VM",type=string,JSONPath=`.spec.virtualMachineName`
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+// +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.status.sourceNodeName`
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.status.targetNodeName`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+type VirtualMachineMigration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineMigrationSpec   `json:"spec,omitempty"`
+	Status VirtualMachineMigrationStatus `json:"status,omitempty"`
+}
+
+type VirtualMachineMigrationSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	// Type is Live by default. An Offline migration pauses the VM, copies
+	// its snapshot and node-local disks to the target node, and restores it
+	// there, so that VMs which can't be live migrated can still be moved.
+	// +optional
+	Type VirtualMachineMigrationType `json:"type,omitempty"`
+
+	// TTLSecondsAfterFinished is how long the VMM is kept after it succeeds
+	// or fails, before it's deleted. Defaults to ttlSecondsAfterFinished of
+	// migration in the Virtink config, and kept forever if neither is set.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// NodeSelector and Affinity constrain the target node on top of the node
+	// selector and affinity of the VM. The migration fails right away with the
+	// TargetFeasible condition False if no node can run the VM.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// DryRun checks whether the VM can be migrated without migrating it. The
+	// VMM succeeds if it can, and fails otherwise, with the reasons in its
+	// Migratable and TargetFeasible conditions.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Live;Offline
+
+type VirtualMachineMigrationType string
+
+const (
+	VirtualMachineMigrationLive    VirtualMachineMigrationType = "Live"
+	VirtualMachineMigrationOffline VirtualMachineMigrationType = "Offline"
+)
+
+type VirtualMachineMigrationStatus struct {
+	Phase          VirtualMachineMigrationPhase `json:"phase,omitempty"`
+	SourceNodeName string                       `json:"sourceNodeName,omitempty"`
+	TargetNodeName string                       `json:"targetNodeName,omitempty"`
+	Conditions     []metav1.Condition           `json:"conditions,omitempty"`
+	// CompletionTime is when the VMM was found to have succeeded or failed.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+type VirtualMachineMigrationConditionType string
+
+const (
+	// VirtualMachineMigrationSynchronized is False when virt-controller fails
+	// to reconcile the VMM, with the error as the message.
+	VirtualMachineMigrationSynchronized VirtualMachineMigrationConditionType = "Synchronized"
+	// VirtualMachineMigrationTargetFeasible tells whether any node can be the
+	// target of the VMM, checked before the migration starts.
+	VirtualMachineMigrationTargetFeasible VirtualMachineMigrationConditionType = "TargetFeasible"
+	// VirtualMachineMigrationMigratable mirrors the LiveMigratable or
+	// OfflineMigratable condition of the VM when a dry-run VMM is checked.
+	VirtualMachineMigrationMigratable VirtualMachineMigrationConditionType = "Migratable"
+)
+
+// +kubebuilder:validation:Enum=Pending;Scheduling;Scheduled;TargetReady;CopyingStorage;Running;Sent;Succeeded;Failed
+
+type VirtualMachineMigrationPhase string
+
+const (
+	VirtualMachineMigrationPending        VirtualMachineMigrationPhase = "Pending"
+	VirtualMachineMigrationScheduling     VirtualMachineMigrationPhase = "Scheduling"
+	VirtualMachineMigrationScheduled      VirtualMachineMigrationPhase = "Scheduled"
+	VirtualMachineMigrationTargetReady    VirtualMachineMigrationPhase = "TargetReady"
+	VirtualMachineMigrationCopyingStorage VirtualMachineMigrationPhase = "CopyingStorage"
+	VirtualMachineMigrationRunning        VirtualMachineMigrationPhase = "Running"
+	VirtualMachineMigrationSent           VirtualMachineMigrationPhase = "Sent"
+	VirtualMachineMigrationSucceeded      VirtualMachineMigrationPhase = "Succeeded"
+	VirtualMachineMigrationFailed         VirtualMachineMigrationPhase = "Failed"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VirtualMachineMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VirtualMachineMigration `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:shortName=vmexport,categories=all;virtink
+// +kubebuilder:printcolumn:name="VM",type=string,JSONPath=`.spec.virtualMachineName`
+// +kubebuilder:printcolumn:name="Volume",type=string,JSONPath=`.spec.volumeName`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// VirtualMachineExport exports a volume of a VM out of the cluster, either as
+// a downloadable disk image or as a container disk image in an OCI registry.
+type VirtualMachineExport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineExportSpec   `json:"spec,omitempty"`
+	Status VirtualMachineExportStatus `json:"status,omitempty"`
+}
+
+type VirtualMachineExportSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	VirtualMachineName string `json:"virtualMachineName"`
+	VolumeName         string `json:"volumeName"`
+
+	// +kubebuilder:default=raw
+	Format VirtualMachineExportFormat `json:"format,omitempty"`
+
+	VirtualMachineExportTarget `json:",inline"`
+}
+
+// +kubebuilder:validation:Enum=raw;qcow2
+
+type VirtualMachineExportFormat string
+
+const (
+	VirtualMachineExportRaw   VirtualMachineExportFormat = "raw"
+	VirtualMachineExportQCOW2 VirtualMachineExportFormat = "qcow2"
+)
+
+type VirtualMachineExportTarget struct {
+	HTTP *VirtualMachineExportHTTPTarget `json:"http,omitempty"`
+	OCI  *VirtualMachineExportOCITarget  `json:"oci,omitempty"`
+}
+
+// VirtualMachineExportHTTPTarget serves the disk image over HTTPS. Requests
+// must carry the token stored in the export Secret as a bearer token.
+type VirtualMachineExportHTTPTarget struct {
+}
+
+// VirtualMachineExportOCITarget pushes the disk image to an OCI registry as
+// a container disk image, which can be used by containerDisk volumes.
+type VirtualMachineExportOCITarget struct {
+	Image string `json:"image"`
+	// PushSecretName is the name of a kubernetes.io/dockerconfigjson Secret
+	// holding the credentials of the registry.
+	PushSecretName string `json:"pushSecretName,omitempty"`
+}
+
+type VirtualMachineExportStatus struct {
+	Phase VirtualMachineExportPhase `json:"phase,omitempty"`
+	// ClaimName is the name of the PVC cloned from the exported volume.
+	ClaimName string `json:"claimName,omitempty"`
+	// SecretName is the name of the Secret holding the token and the CA
+	// certificate of the HTTPS endpoint.
+	SecretName string `json:"secretName,omitempty"`
+	URL        string `json:"url,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Pending;Exporting;Ready;Succeeded;Failed
+
+type VirtualMachineExportPhase string
+
+const (
+	VirtualMachineExportPending   VirtualMachineExportPhase = "Pending"
+	VirtualMachineExportExporting VirtualMachineExportPhase = "Exporting"
+	VirtualMachineExportReady     VirtualMachineExportPhase = "Ready"
+	VirtualMachineExportSucceeded VirtualMachineExportPhase = "Succeeded"
+	VirtualMachineExportFailed    VirtualMachineExportPhase = "Failed"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VirtualMachineExportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VirtualMachineExport `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=vmaction,categories=all;virtink
+// +kubebuilder:printcolumn:name="VM",type=string,JSONPath=`.spec.virtualMachineName`
+// +kubebuilder:printcolumn:name="Action",type=string,JSONPath=`.spec.action`
+// +kubebuilder:printcolumn:name="Requester",type=string,JSONPath=`.status.requester`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// VirtualMachineAction requests a power action on a VM. Unlike patching
+// status.powerAction of the VM, creating a VirtualMachineAction can be granted
+// separately from editing VMs, and the requester is recorded.
+type VirtualMachineAction struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineActionSpec   `json:"spec,omitempty"`
+	Status VirtualMachineActionStatus `json:"status,omitempty"`
+}
+
+type VirtualMachineActionSpec struct {
+	VirtualMachineName string                   `json:"virtualMachineName"`
+	Action             VirtualMachineActionType `json:"action"`
+}
+
+// +kubebuilder:validation:Enum=Start;Stop;Restart;Pause;Resume
+
+type VirtualMachineActionType string
+
+const (
+	VirtualMachineActionStart   VirtualMachineActionType = "Start"
+	VirtualMachineActionStop    VirtualMachineActionType = "Stop"
+	VirtualMachineActionRestart VirtualMachineActionType = "Restart"
+	VirtualMachineActionPause   VirtualMachineActionType = "Pause"
+	VirtualMachineActionResume  VirtualMachineActionType = "Resume"
+)
+
+type VirtualMachineActionStatus struct {
+	Phase VirtualMachineActionPhase `json:"phase,omitempty"`
+	// Requester is the name of the user who created the action, as
+	// authenticated by the API server.
+	Requester string `json:"requester,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Pending;Running;Succeeded;Failed
+
+type VirtualMachineActionPhase string
+
+const (
+	VirtualMachineActionPending   VirtualMachineActionPhase = "Pending"
+	VirtualMachineActionRunning   VirtualMachineActionPhase = "Running"
+	VirtualMachineActionSucceeded VirtualMachineActionPhase = "Succeeded"
+	VirtualMachineActionFailed    VirtualMachineActionPhase = "Failed"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VirtualMachineActionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VirtualMachineAction `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=vmquota,categories=all;virtink
+// +kubebuilder:printcolumn:name="VMs",type=string,JSONPath=`.status.used.virtualMachines`
+// +kubebuilder:printcolumn:name="vCPUs",type=string,JSONPath=`.status.used.vcpus`
+// +kubebuilder:printcolumn:name="Memory",type=string,JSONPath=`.status.used.memory`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// VirtualMachineQuota limits the VMs in a namespace. Unlike a ResourceQuota,
+// which limits the resources of VM pods, it limits what the guests are given.
+// Creating a VM that would exceed any quota in its namespace is denied.
+type VirtualMachineQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineQuotaSpec   `json:"spec,omitempty"`
+	Status VirtualMachineQuotaStatus `json:"status,omitempty"`
+}
+
+type VirtualMachineQuotaSpec struct {
+	// Hard is the limits of the namespace. Unset limits are unlimited.
+	Hard VirtualMachineQuotaResources `json:"hard,omitempty"`
+}
+
+type VirtualMachineQuotaResources struct {
+	// VirtualMachines is the number of VMs.
+	// +kubebuilder:validation:Minimum=0
+	VirtualMachines *int64 `json:"virtualMachines,omitempty"`
+	// VCPUs is the total number of vCPUs of the VMs.
+	// +kubebuilder:validation:Minimum=0
+	VCPUs *int64 `json:"vcpus,omitempty"`
+	// Memory is the total guest memory size of the VMs.
+	Memory *resource.Quantity `json:"memory,omitempty"`
+}
+
+type VirtualMachineQuotaStatus struct {
+	// Used is the current usage of the namespace.
+	Used VirtualMachineQuotaResources `json:"used,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VirtualMachineQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VirtualMachineQuota `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=vmtemplate,categories=all;virtink
+// +kubebuilder:printcolumn:name="Description",type=string,JSONPath=`.spec.description`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// VirtualMachineTemplate is a parameterized VM offered in a catalog. VMs are
+// instantiated from it by creating VirtualMachineTemplateInstances, which
+// only requires the permission to create VMs.
+type VirtualMachineTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineTemplateSpec `json:"spec,omitempty"`
+}
+
+type VirtualMachineTemplateSpec struct {
+	// Description tells users what the template is for.
+	Description string `json:"description,omitempty"`
+	// Parameters are substituted for their references in the VM: ${NAME}
+	// within a string is replaced with the value, and the string
+	// "${{NAME}}" is replaced with the value as JSON, e.g. a number.
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=map
+	// +listMapKey=name
+	Parameters []VirtualMachineTemplateParameter `json:"parameters,omitempty"`
+	// VirtualMachine is the VM to instantiate. Its apiVersion defaults to
+	// virt.virtink.smartx.com/v1beta1 and its name to the one of the
+	// instance. It is always created in the namespace of the instance.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	VirtualMachine runtime.RawExtension `json:"virtualMachine"`
+}
+
+type VirtualMachineTemplateParameter struct {
+	// +kubebuilder:validation:Pattern=`^[A-Z_][A-Z0-9_]*$`
+	// +kubebuilder:validation:MaxLength=63
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Default is the value used when the instance does not give one.
+	Default string `json:"default,omitempty"`
+	// Required parameters must have a non-empty value.
+	Required bool `json:"required,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VirtualMachineTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VirtualMachineTemplate `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=vmti,categories=all;virtink
+// +kubebuilder:printcolumn:name="Template",type=string,JSONPath=`.spec.templateName`
+// +kubebuilder:printcolumn:name="VM",type=string,JSONPath=`.status.virtualMachineName`
+// +kubebuilder:printcolumn:name="Requester",type=string,JSONPath=`.status.requester`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// VirtualMachineTemplateInstance requests a VM instantiated from a
+// VirtualMachineTemplate in the same namespace with the given parameters.
+type VirtualMachineTemplateInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineTemplateInstanceSpec   `json:"spec,omitempty"`
+	Status VirtualMachineTemplateInstanceStatus `json:"status,omitempty"`
+}
+
+type VirtualMachineTemplateInstanceSpec struct {
+	TemplateName string            `json:"templateName"`
+	Parameters   map[string]string `json:"parameters,omitempty"`
+}
+
+type VirtualMachineTemplateInstanceStatus struct {
+	Phase VirtualMachineTemplateInstancePhase `json:"phase,omitempty"`
+	// Requester is the name of the user who created the instance, as
+	// authenticated by the API server.
+	Requester          string `json:"requester,omitempty"`
+	VirtualMachineName string `json:"virtualMachineName,omitempty"`
+	Message            string `json:"message,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Succeeded;Failed
+
+type VirtualMachineTemplateInstancePhase string
+
+const (
+	VirtualMachineTemplateInstanceSucceeded VirtualMachineTemplateInstancePhase = "Succeeded"
+	VirtualMachineTemplateInstanceFailed    VirtualMachineTemplateInstancePhase = "Failed"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VirtualMachineTemplateInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VirtualMachineTemplateInstance `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas,selectorpath=.status.selector
+// +kubebuilder:resource:shortName=vmpool,categories=all;virtink
+// +kubebuilder:printcolumn:name="Replicas",type=integer,JSONPath=`.spec.replicas`
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.readyReplicas`
+// +kubebuilder:printcolumn:name="Service",type=string,JSONPath=`.status.serviceName`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 52",message="name must be no more than 52 characters"
+
+// VirtualMachinePool keeps a number of VMs created from a template with
+// stable identities, like the pods of a StatefulSet. The VM of ordinal i is
+// named "<pool name>-<i>", and keeps its name, FQDN and PVCs when recreated.
+type VirtualMachinePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachinePoolSpec   `json:"spec,omitempty"`
+	Status VirtualMachinePoolStatus `json:"status,omitempty"`
+}
+
+type VirtualMachinePoolSpec struct {
+	// Replicas is the number of VMs. VMs of ordinals from 0 to replicas-1 are
+	// created, and the ones of higher ordinals are deleted.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	Replicas *int32 `json:"replicas,omitempty"`
+	// ServiceName is the name of the headless Service selecting the VMs,
+	// which is created unless it exists. It defaults to the name of the pool.
+	// Each VM is resolvable as "<VM name>.<service name>".
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	ServiceName string `json:"serviceName,omitempty"`
+	// Template is the VM created for each ordinal. Changes only apply to VMs
+	// created afterwards.
+	Template VirtualMachinePoolTemplate `json:"template"`
+	// VolumeClaimTemplates are PVCs created for each VM, named
+	// "<template name>-<VM name>". They replace the persistentVolumeClaim
+	// volumes of the VM whose claimName is the template name, and are kept
+	// when the VM is deleted.
+	// +kubebuilder:validation:MaxItems=32
+	VolumeClaimTemplates []corev1.PersistentVolumeClaim `json:"volumeClaimTemplates,omitempty"`
+}
+
+type VirtualMachinePoolTemplate struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSpec `json:"spec"`
+}
+
+type VirtualMachinePoolStatus struct {
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Replicas is the number of VMs of the pool, and ReadyReplicas the number
+	// of them that are ready.
+	Replicas      int32  `json:"replicas,omitempty"`
+	ReadyReplicas int32  `json:"readyReplicas,omitempty"`
+	ServiceName   string `json:"serviceName,omitempty"`
+	// Selector is the label selector of the VM pods of the pool, for the
+	// scale subresource to be used by HorizontalPodAutoscalers.
+	Selector string `json:"selector,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VirtualMachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VirtualMachinePool `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=vmrr,categories=all;virtink
+// +kubebuilder:printcolumn:name="VMs",type=integer,JSONPath=`.status.virtualMachines`
+// +kubebuilder:printcolumn:name="Restarted",type=integer,JSONPath=`.status.restartedVirtualMachines`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// VirtualMachineRollingRestart restarts the running VMs selected by its
+// selector a few at a time, so that they pick up new hypervisor or guest
+// images. A VM is restarted once it runs in a VM pod created after the
+// rolling restart, either by live migrating it or by power cycling it.
+type VirtualMachineRollingRestart struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineRollingRestartSpec   `json:"spec,omitempty"`
+	Status VirtualMachineRollingRestartStatus `json:"status,omitempty"`
+}
+
+type VirtualMachineRollingRestartSpec struct {
+	// Selector selects the VMs to restart in the namespace of the rolling
+	// restart. The VMs of a pool are selected by "virtink.io/pool: <pool name>".
+	Selector *metav1.LabelSelector `json:"selector"`
+	// MaxUnavailable is the number or percentage of the selected VMs that may
+	// be restarting at the same time, rounded down but at least 1. VMs being
+	// live migrated count too.
+	// +kubebuilder:default=1
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// Strategy is LiveMigrateIfPossible by default, which live migrates the
+	// VMs that are live migratable and power cycles the others. Restart power
+	// cycles all VMs.
+	// +kubebuilder:default=LiveMigrateIfPossible
+	Strategy RollingRestartStrategy `json:"strategy,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=LiveMigrateIfPossible;Restart
+
+type RollingRestartStrategy string
+
+const (
+	RollingRestartStrategyLiveMigrateIfPossible RollingRestartStrategy = "LiveMigrateIfPossible"
+	RollingRestartStrategyRestart               RollingRestartStrategy = "Restart"
+)
+
+type VirtualMachineRollingRestartStatus struct {
+	Phase VirtualMachineRollingRestartPhase `json:"phase,omitempty"`
+	// VirtualMachines is the number of running or restarting VMs selected,
+	// and RestartedVirtualMachines the number of them that are restarted.
+	VirtualMachines          int32 `json:"virtualMachines,omitempty"`
+	RestartedVirtualMachines int32 `json:"restartedVirtualMachines,omitempty"`
+	// CompletionTime is when all selected VMs were restarted.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Running;Succeeded
+
+type VirtualMachineRollingRestartPhase string
+
+const (
+	VirtualMachineRollingRestartRunning   VirtualMachineRollingRestartPhase = "Running"
+	VirtualMachineRollingRestartSucceeded VirtualMachineRollingRestartPhase = "Succeeded"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VirtualMachineRollingRestartList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VirtualMachineRollingRestart `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=vmsg,categories=all;virtink
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// VirtualMachineSecurityGroup is a set of rules allowing traffic to and from
+// the interfaces of VMs that reference it, like security groups of clouds.
+// Rules are enforced by virt-prerunner with nftables on the tap devices of the
+// interfaces, regardless of the CNI plugin and its support of NetworkPolicies.
+type VirtualMachineSecurityGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSecurityGroupSpec `json:"spec,omitempty"`
+}
+
+// VirtualMachineSecurityGroupSpec allows traffic of the VMs. Replies of
+// allowed connections are always allowed, as are ARP, DHCP and IPv6 neighbor
+// discovery.
+type VirtualMachineSecurityGroupSpec struct {
+	// Ingress rules allow traffic to the VMs.
+	Ingress []SecurityGroupRule `json:"ingress,omitempty"`
+	// Egress rules allow traffic from the VMs.
+	Egress []SecurityGroupRule `json:"egress,omitempty"`
+}
+
+// SecurityGroupRule allows traffic matching all of its fields. An empty rule
+// allows all traffic.
+type SecurityGroupRule struct {
+	// Protocol is the protocol of the traffic. Defaults to All.
+	// +kubebuilder:validation:Enum=All;TCP;UDP;ICMP;ICMPv6
+	Protocol SecurityGroupProtocol `json:"protocol,omitempty"`
+	// Ports are the destination ports of TCP and UDP traffic, i.e. the ports
+	// of the VMs for ingress rules, and the ports of the remote hosts for
+	// egress rules. All ports if empty.
+	Ports []SecurityGroupPortRange `json:"ports,omitempty"`
+	// CIDRs are the addresses of the remote hosts, i.e. the sources of
+	// ingress traffic and the destinations of egress traffic, e.g.
+	// 10.0.0.0/8 or 2001:db8::/32. All addresses if empty.
+	CIDRs []string `json:"cidrs,omitempty"`
+}
+
+type SecurityGroupProtocol string
+
+const (
+	SecurityGroupProtocolAll    SecurityGroupProtocol = "All"
+	SecurityGroupProtocolTCP    SecurityGroupProtocol = "TCP"
+	SecurityGroupProtocolUDP    SecurityGroupProtocol = "UDP"
+	SecurityGroupProtocolICMP   SecurityGroupProtocol = "ICMP"
+	SecurityGroupProtocolICMPv6 SecurityGroupProtocol = "ICMPv6"
+)
+
+// SecurityGroupPortRange is a port, or a range of ports from Port to EndPort.
+type SecurityGroupPortRange struct {
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	EndPort int32 `json:"endPort,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VirtualMachineSecurityGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VirtualMachineSecurityGroup `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=vmusage,categories=virtink
+// +kubebuilder:printcolumn:name="VM",type=string,JSONPath=`.status.virtualMachineName`
+// +kubebuilder:printcolumn:name="Running Seconds",type=integer,JSONPath=`.status.runningSeconds`
+// +kubebuilder:printcolumn:name="Last Flush",type=date,JSONPath=`.status.lastFlushTime`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// VirtualMachineUsage records what a VM has consumed since it was created, for
+// billing. virt-controller creates one for every VM while usage accounting is
+// enabled, and keeps it for a while after the VM is deleted, so that the last
+// of its usage can still be collected.
+type VirtualMachineUsage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status VirtualMachineUsageStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineUsageStatus holds counters that only grow, so that usage over
+// a billing period is the difference between two readings.
+type VirtualMachineUsageStatus struct {
+	VirtualMachineName string    `json:"virtualMachineName,omitempty"`
+	VirtualMachineUID  types.UID `json:"virtualMachineUID,omitempty"`
+	// StartTime is when accounting of the VM began.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// LastFlushTime is when the counters were last updated.
+	LastFlushTime *metav1.Time `json:"lastFlushTime,omitempty"`
+	// EndTime is when the VM was found deleted. The counters no longer grow
+	// after it.
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+	// Running is whether the VM was running at the last flush. Time between
+	// two flushes the VM was running at is counted as running time.
+	Running bool `json:"running,omitempty"`
+	// RunningSeconds is how long the VM has been running, including paused.
+	RunningSeconds int64 `json:"runningSeconds,omitempty"`
+	// VCPUSeconds is the vCPUs of the VM multiplied by the seconds it has run
+	// with them.
+	VCPUSeconds int64 `json:"vcpuSeconds,omitempty"`
+	// MemoryMiBSeconds is the guest memory size of the VM in MiB multiplied by
+	// the seconds it has run with it.
+	MemoryMiBSeconds int64 `json:"memoryMiBSeconds,omitempty"`
+	// Volumes is how long each volume has been attached to the running VM.
+	// +listType=map
+	// +listMapKey=name
+	Volumes []VirtualMachineUsageVolume `json:"volumes,omitempty"`
+}
+
+type VirtualMachineUsageVolume struct {
+	Name string `json:"name"`
+	// ClaimName is the PVC of the volume, if any.
+	ClaimName       string `json:"claimName,omitempty"`
+	AttachedSeconds int64  `json:"attachedSeconds,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VirtualMachineUsageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VirtualMachineUsage `json:"items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Cluster,categories=virtink
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// VirtinkConfig is the cluster-wide configuration of Virtink. Only the
+// VirtinkConfig named "virtink" takes effect.
+type VirtinkConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtinkConfigSpec `json:"spec,omitempty"`
+}
+
+type VirtinkConfigSpec struct {
+	// FeatureGates enables or disables features by name. Features not listed
+	// keep their default.
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+
+	// Fencing configures recovering VMs from failed nodes, by fencing the
+	// nodes before the VMs are restarted on other nodes.
+	Fencing VirtinkConfigFencing `json:"fencing,omitempty"`
+	// IdleSuspend configures pausing or powering off VMs whose guests are
+	// idle.
+	IdleSuspend VirtinkConfigIdleSuspend `json:"idleSuspend,omitempty"`
+	Images      VirtinkConfigImages      `json:"images,omitempty"`
+	// KSM configures kernel samepage merging on the nodes, which merges
+	// identical guest memory pages of VMs.
+	KSM     VirtinkConfigKSM     `json:"ksm,omitempty"`
+	Logging VirtinkConfigLogging `json:"logging,omitempty"`
+	// MemoryOvercommit configures running VMs with less memory than their
+	// guest memory.
+	MemoryOvercommit VirtinkConfigMemoryOvercommit `json:"memoryOvercommit,omitempty"`
+	Migration        VirtinkConfigMigration        `json:"migration,omitempty"`
+	Network          VirtinkConfigNetwork          `json:"network,omitempty"`
+	// NodePressure configures how VMs are moved off nodes under pressure.
+	NodePressure VirtinkConfigNodePressure `json:"nodePressure,omitempty"`
+	// Rebalance configures spreading VMs across nodes by live migration.
+	Rebalance VirtinkConfigRebalance `json:"rebalance,omitempty"`
+	Storage   VirtinkConfigStorage   `json:"storage,omitempty"`
+	// UsageAccounting configures recording what VMs consume for billing.
+	UsageAccounting VirtinkConfigUsageAccounting `json:"usageAccounting,omitempty"`
+	// VCPUResource configures accounting the vCPUs of VMs as the
+	// virtink.io/vcpu extended resource.
+	VCPUResource VirtinkConfigVCPUResource `json:"vcpuResource,omitempty"`
+}
+
+type VirtinkConfigFencing struct {
+	// Mode is how a node that has been NotReady for NodeNotReadySeconds and
+	// has VMs is fenced, i.e. made sure to be powered off. Once fenced, the VM
+	// pods on the node are deleted, so that the VMs are restarted on other
+	// nodes according to their run policies. Webhook calls the fencing
+	// webhook, and Manual waits for the node to be annotated with
+	// virtink.io/fenced=true by an administrator or an external agent.
+	// Defaults to None, which leaves the VMs on the node until it recovers.
+	// +kubebuilder:validation:Enum=None;Webhook;Manual
+	Mode FencingMode `json:"mode,omitempty"`
+	// NodeNotReadySeconds is how long a node must be NotReady before it is
+	// fenced. Defaults to 300.
+	// +kubebuilder:validation:Minimum=30
+	NodeNotReadySeconds int `json:"nodeNotReadySeconds,omitempty"`
+	// Webhook is the fencing webhook of the Webhook mode.
+	Webhook *VirtinkConfigFencingWebhook `json:"webhook,omitempty"`
+}
+
+type FencingMode string
+
+const (
+	FencingNone    FencingMode = "None"
+	FencingWebhook FencingMode = "Webhook"
+	FencingManual  FencingMode = "Manual"
+)
+
+// VirtinkConfigFencingWebhook is an endpoint which powers off nodes, e.g.
+// through IPMI or the API of the cloud provider.
+type VirtinkConfigFencingWebhook struct {
+	// URL is the HTTP(S) URL a fence request of a node is posted to. The
+	// webhook must only respond with a 2xx status once the node is powered
+	// off.
+	URL string `json:"url"`
+	// CABundle is the PEM encoded CA bundle verifying the certificate of the
+	// webhook. The system CAs are used if unset.
+	CABundle []byte `json:"caBundle,omitempty"`
+	// TimeoutSeconds is how long to wait for the webhook to respond. Defaults
+	// to 60.
+	// +kubebuilder:validation:Minimum=1
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+}
+
+type VirtinkConfigIdleSuspend struct {
+	// Action is what virt-daemon does with a VM that has been idle for
+	// IdleMinutes: Pause pauses it and PowerOff powers it off. Defaults to
+	// None, which disables idle detection.
+	// +kubebuilder:validation:Enum=None;Pause;PowerOff
+	Action IdleSuspendAction `json:"action,omitempty"`
+	// CPUThresholdPercent is the CPU usage of a VM pod, relative to the
+	// vCPUs of the VM, below which the VM is idle. Defaults to 5.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	CPUThresholdPercent int `json:"cpuThresholdPercent,omitempty"`
+	// IdleMinutes is how long a VM must stay idle before the action is
+	// taken. Defaults to 30.
+	// +kubebuilder:validation:Minimum=1
+	IdleMinutes int `json:"idleMinutes,omitempty"`
+}
+
+type IdleSuspendAction string
+
+const (
+	IdleSuspendNone     IdleSuspendAction = "None"
+	IdleSuspendPause    IdleSuspendAction = "Pause"
+	IdleSuspendPowerOff IdleSuspendAction = "PowerOff"
+)
+
+// VirtinkConfigImages overrides the images virt-controller runs in VM and
+// export pods. The images built into virt-controller are used if unset.
+type VirtinkConfigImages struct {
+	Prerunner string `json:"prerunner,omitempty"`
+	Exporter  string `json:"exporter,omitempty"`
+}
+
+type VirtinkConfigKSM struct {
+	// Policy is when virt-daemon runs KSM on the nodes: Always runs it all the
+	// time, and OnPressure runs it while the memory pressure of the node is
+	// above PressureThresholdPercent. Defaults to None, which leaves KSM as
+	// configured on the nodes.
+	// +kubebuilder:validation:Enum=None;Always;OnPressure
+	Policy KSMPolicy `json:"policy,omitempty"`
+	// NodeSelector selects the nodes the policy applies to. It applies to
+	// all nodes if unset.
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+	// PagesToScan is the number of pages KSM scans before it sleeps.
+	// Defaults to 100.
+	// +kubebuilder:validation:Minimum=1
+	PagesToScan int `json:"pagesToScan,omitempty"`
+	// SleepMilliseconds is how long KSM sleeps between scans. Defaults to 20.
+	// +kubebuilder:validation:Minimum=1
+	SleepMilliseconds int `json:"sleepMilliseconds,omitempty"`
+	// PressureThresholdPercent is the memory pressure of a node, the share of
+	// time in the last 10 seconds some tasks were stalled on memory, above
+	// which KSM runs with the OnPressure policy. It's stopped again once the
+	// pressure stays below half of it. Defaults to 5.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	PressureThresholdPercent int `json:"pressureThresholdPercent,omitempty"`
+}
+
+type KSMPolicy string
+
+const (
+	KSMNone       KSMPolicy = "None"
+	KSMAlways     KSMPolicy = "Always"
+	KSMOnPressure KSMPolicy = "OnPressure"
+)
+
+type VirtinkConfigLogging struct {
+	// Level is the verbosity of virt-controller and virt-daemon, and of VM
+	// pods created after the change. It is one of debug, info and error, or a
+	// positive number for more verbose debug logs. The --zap-log-level flag
+	// applies if unset.
+	// +kubebuilder:validation:Pattern=`^(debug|info|error|[1-9][0-9]*)$`
+	Level string `json:"level,omitempty"`
+	// Format is the output format of VM pods created after the change, either
+	// console or json. virt-controller and virt-daemon take it from the
+	// --zap-encoder flag. Defaults to console.
+	// +kubebuilder:validation:Enum=console;json
+	Format string `json:"format,omitempty"`
+}
+
+type VirtinkConfigMemoryOvercommit struct {
+	// Percent is the guest memory of VMs with a balloon or swap relative to
+	// the memory their VM pods request, e.g. 150 for VM pods to request two
+	// thirds of the guest memory besides the overhead. It applies to VMs
+	// created without a memory request after the change. Defaults to 100,
+	// which disables overcommit.
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=400
+	Percent int `json:"percent,omitempty"`
+	// PressureThresholdPercent is the memory pressure of a node, the share of
+	// time in the last 10 seconds some tasks were stalled on memory, above
+	// which virt-daemon inflates the balloons of VMs on the node. They are
+	// deflated again while it stays below half of it. Defaults to 10.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	PressureThresholdPercent int `json:"pressureThresholdPercent,omitempty"`
+}
+
+type VirtinkConfigMigration struct {
+	// ParallelMigrationsPerCluster limits the number of migrations in progress
+	// in the cluster. Other migrations stay Pending. Unlimited if unset.
+	// +kubebuilder:validation:Minimum=1
+	ParallelMigrationsPerCluster int `json:"parallelMigrationsPerCluster,omitempty"`
+	// ParallelOutboundMigrationsPerNode limits the number of migrations in
+	// progress from a single node. Unlimited if unset.
+	// +kubebuilder:validation:Minimum=1
+	ParallelOutboundMigrationsPerNode int `json:"parallelOutboundMigrationsPerNode,omitempty"`
+	// TTLSecondsAfterFinished is how long VMMs are kept after they succeed or
+	// fail, unless they set their own. Kept forever if unset.
+	// +kubebuilder:validation:Minimum=0
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+	// HistoryLimit is the number of finished VMMs kept for each VM. Older
+	// ones are deleted. Unlimited if unset.
+	// +kubebuilder:validation:Minimum=1
+	HistoryLimit int `json:"historyLimit,omitempty"`
+}
+
+type VirtinkConfigNetwork struct {
+	// DefaultInterfaceBinding is the binding method of interfaces created
+	// without one. Defaults to bridge.
+	// +kubebuilder:validation:Enum=bridge;masquerade
+	DefaultInterfaceBinding string `json:"defaultInterfaceBinding,omitempty"`
+	// DefaultMasqueradeCIDR is the CIDR of masquerade interfaces created
+	// without one. Defaults to 10.0.2.0/30.
+	DefaultMasqueradeCIDR string `json:"defaultMasqueradeCIDR,omitempty"`
+}
+
+type VirtinkConfigNodePressure struct {
+	// Policy is what virt-controller does when a node reports memory, disk
+	// or PID pressure. One VM is handled at a time, from the lowest priority:
+	// Migrate live migrates it, Shutdown shuts it down gracefully, and
+	// MigrateOrShutdown shuts it down if it is not live migratable. Defaults
+	// to None, which leaves evictions to the kubelet.
+	// +kubebuilder:validation:Enum=None;Migrate;Shutdown;MigrateOrShutdown
+	Policy NodePressurePolicy `json:"policy,omitempty"`
+}
+
+type VirtinkConfigRebalance struct {
+	// CPUThresholdPercent is the CPU utilization of a node, as reported by the
+	// metrics API, above which VMs are migrated off it. Disabled if unset.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	CPUThresholdPercent int `json:"cpuThresholdPercent,omitempty"`
+	// MemoryThresholdPercent is the memory utilization of a node above which
+	// VMs are migrated off it. Disabled if unset.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	MemoryThresholdPercent int `json:"memoryThresholdPercent,omitempty"`
+}
+
+// VCPUResourceName is the extended resource VM pods request a unit of per
+// vCPU when the vCPU resource is enabled.
+const VCPUResourceName = "virtink.io/vcpu"
+
+type VirtinkConfigVCPUResource struct {
+	// Enabled makes VM pods created after the change request a
+	// virtink.io/vcpu per vCPU, and virt-daemon publish the capacity of the
+	// nodes, so that VMs are packed by vCPUs regardless of the CPU requests
+	// of their VM pods.
+	Enabled bool `json:"enabled,omitempty"`
+	// OvercommitPercent is the vCPUs a node can run relative to its
+	// allocatable CPUs, e.g. 400 for 4 vCPUs per CPU. Defaults to 100.
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=2000
+	OvercommitPercent int `json:"overcommitPercent,omitempty"`
+}
+
+type VirtinkConfigUsageAccounting struct {
+	// Enabled makes virt-controller record the usage of every VM in a
+	// VirtualMachineUsage named after it.
+	Enabled bool `json:"enabled,omitempty"`
+	// FlushIntervalSeconds is how often usage is recorded. Defaults to 60.
+	// +kubebuilder:validation:Minimum=10
+	FlushIntervalSeconds int `json:"flushIntervalSeconds,omitempty"`
+	// SinkURL is an HTTP(S) URL the updated usages are posted to as a
+	// VirtualMachineUsageList after every flush, in addition to being stored.
+	SinkURL string `json:"sinkURL,omitempty"`
+	// RetentionDays is how long the usages of deleted VMs are kept. Defaults
+	// to 30.
+	// +kubebuilder:validation:Minimum=1
+	RetentionDays int `json:"retentionDays,omitempty"`
+}
+
+type VirtinkConfigStorage struct {
+	// FilesystemOverhead is the fraction of Filesystem mode PVCs reserved for
+	// the file system, which disk images don't grow into.
+	FilesystemOverhead VirtinkConfigFilesystemOverhead `json:"filesystemOverhead,omitempty"`
+	// DiskLeases keeps two VM pods from writing to the same PVC at the same
+	// time, e.g. when a VM is restarted on another node while its old VM pod
+	// is still running on a node cut off from the API server.
+	DiskLeases VirtinkConfigDiskLeases `json:"diskLeases,omitempty"`
+}
+
+type VirtinkConfigDiskLeases struct {
+	// Enabled makes virt-daemon hold a Lease for every PVC a VM writes to
+	// while the VM runs, and VM pods created after the change wait for the
+	// leases before starting the VMM. A VMM whose leases can't be renewed
+	// is powered off before they expire.
+	Enabled bool `json:"enabled,omitempty"`
+	// DurationSeconds is how long a lease is held without being renewed
+	// before another VM pod may take it over. Defaults to 60.
+	// +kubebuilder:validation:Minimum=30
+	DurationSeconds int `json:"durationSeconds,omitempty"`
+}
+
+// VirtinkConfigFilesystemOverhead is a fraction between 0 and 1, e.g. 0.055
+// for 5.5%.
+type VirtinkConfigFilesystemOverhead struct {
+	// Global applies to storage classes not listed in StorageClasses.
+	// Defaults to 0.055.
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]{1,3})?|1)$`
+	Global string `json:"global,omitempty"`
+	// StorageClasses overrides Global by storage class name.
+	StorageClasses map[string]string `json:"storageClasses,omitempty"`
+}
+
+type NodePressurePolicy string
+
+const (
+	NodePressureNone              NodePressurePolicy = "None"
+	NodePressureMigrate           NodePressurePolicy = "Migrate"
+	NodePressureShutdown          NodePressurePolicy = "Shutdown"
+	NodePressureMigrateOrShutdown NodePressurePolicy = "MigrateOrShutdown"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VirtinkConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VirtinkConfig `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories=virtink
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// VirtinkNamespaceConfig is the configuration of VMs in a namespace, which
+// overrides the VirtinkConfig for them. Only the VirtinkNamespaceConfig named
+// "virtink" takes effect. Its defaults are set by the mutating webhook when a
+// VM is created, so they don't apply to existing VMs, and fields set in the VM
+// are never overridden.
+type VirtinkNamespaceConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtinkNamespaceConfigSpec `json:"spec,omitempty"`
+}
+
+type VirtinkNamespaceConfigSpec struct {
+	// CloudInit configures the defaults of cloud-init volumes.
+	CloudInit VirtinkNamespaceConfigCloudInit `json:"cloudInit,omitempty"`
+	// Network overrides the network defaults of the VirtinkConfig. Unset
+	// fields fall back to the VirtinkConfig.
+	Network VirtinkConfigNetwork `json:"network,omitempty"`
+	// Resources configures the defaults of the resources of VM pods.
+	Resources VirtinkNamespaceConfigResources `json:"resources,omitempty"`
+	// Storage configures the defaults of PVCs created by Virtink.
+	Storage VirtinkNamespaceConfigStorage `json:"storage,omitempty"`
+}
+
+type VirtinkNamespaceConfigCloudInit struct {
+	// DefaultUserData is the user data of cloud-init volumes created without
+	// any, e.g. to add the SSH keys of the team to every VM.
+	DefaultUserData string `json:"defaultUserData,omitempty"`
+}
+
+type VirtinkNamespaceConfigResources struct {
+	// MemoryOverhead is the memory used by the VM pod besides the guest
+	// memory. VMs created without a memory request get a memory request of
+	// their guest memory plus MemoryOverhead. VMs with dedicated CPUs,
+	// hugepages, a balloon or swap are sized by the built-in defaults and
+	// memory overcommit instead.
+	MemoryOverhead *resource.Quantity `json:"memoryOverhead,omitempty"`
+}
+
+type VirtinkNamespaceConfigStorage struct {
+	// DefaultStorageClassName is the storage class of PVCs created from the
+	// volume claim templates of VM pools without one. PVCs referred to by
+	// VMs are created by users, so they are not affected.
+	DefaultStorageClassName *string `json:"defaultStorageClassName,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VirtinkNamespaceConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VirtinkNamespaceConfig `json:"items"`
+}
@@ -0,0 +1,3497 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimit) DeepCopyInto(out *BandwidthLimit) {
+	*out = *in
+	out.Bandwidth = in.Bandwidth.DeepCopy()
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimit.
+func (in *BandwidthLimit) DeepCopy() *BandwidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Bond) DeepCopyInto(out *Bond) {
+	*out = *in
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]InterfaceRoute, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bond.
+func (in *Bond) DeepCopy() *Bond {
+	if in == nil {
+		return nil
+	}
+	out := new(Bond)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPU) DeepCopyInto(out *CPU) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPU.
+func (in *CPU) DeepCopy() *CPU {
+	if in == nil {
+		return nil
+	}
+	out := new(CPU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Clock) DeepCopyInto(out *Clock) {
+	*out = *in
+	if in.Sync != nil {
+		in, out := &in.Sync, &out.Sync
+		*out = new(ClockSync)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Clock.
+func (in *Clock) DeepCopy() *Clock {
+	if in == nil {
+		return nil
+	}
+	out := new(Clock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClockSync) DeepCopyInto(out *ClockSync) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClockSync.
+func (in *ClockSync) DeepCopy() *ClockSync {
+	if in == nil {
+		return nil
+	}
+	out := new(ClockSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudInitVolumeSource) DeepCopyInto(out *CloudInitVolumeSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudInitVolumeSource.
+func (in *CloudInitVolumeSource) DeepCopy() *CloudInitVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudInitVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAPIBootstrapVolumeSource) DeepCopyInto(out *ClusterAPIBootstrapVolumeSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAPIBootstrapVolumeSource.
+func (in *ClusterAPIBootstrapVolumeSource) DeepCopy() *ClusterAPIBootstrapVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAPIBootstrapVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapVolumeSource) DeepCopyInto(out *ConfigMapVolumeSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapVolumeSource.
+func (in *ConfigMapVolumeSource) DeepCopy() *ConfigMapVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerDiskVolumeSource) DeepCopyInto(out *ContainerDiskVolumeSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerDiskVolumeSource.
+func (in *ContainerDiskVolumeSource) DeepCopy() *ContainerDiskVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerDiskVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerRootfsVolumeSource) DeepCopyInto(out *ContainerRootfsVolumeSource) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerRootfsVolumeSource.
+func (in *ContainerRootfsVolumeSource) DeepCopy() *ContainerRootfsVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerRootfsVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataVolumeVolumeSource) DeepCopyInto(out *DataVolumeVolumeSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataVolumeVolumeSource.
+func (in *DataVolumeVolumeSource) DeepCopy() *DataVolumeVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DataVolumeVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Devices) DeepCopyInto(out *Devices) {
+	*out = *in
+	if in.Display != nil {
+		in, out := &in.Display, &out.Display
+		*out = new(Display)
+		**out = **in
+	}
+	if in.USB != nil {
+		in, out := &in.USB, &out.USB
+		*out = new(USBController)
+		**out = **in
+	}
+	if in.Tablet != nil {
+		in, out := &in.Tablet, &out.Tablet
+		*out = new(Tablet)
+		**out = **in
+	}
+	if in.Sound != nil {
+		in, out := &in.Sound, &out.Sound
+		*out = new(Sound)
+		**out = **in
+	}
+	if in.HostUSBDevices != nil {
+		in, out := &in.HostUSBDevices, &out.HostUSBDevices
+		*out = make([]HostUSBDevice, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Devices.
+func (in *Devices) DeepCopy() *Devices {
+	if in == nil {
+		return nil
+	}
+	out := new(Devices)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Disk) DeepCopyInto(out *Disk) {
+	*out = *in
+	if in.ReadOnly != nil {
+		in, out := &in.ReadOnly, &out.ReadOnly
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(DiskRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Discard != nil {
+		in, out := &in.Discard, &out.Discard
+		*out = new(DiskDiscard)
+		**out = **in
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(DiskEncryption)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Disk.
+func (in *Disk) DeepCopy() *Disk {
+	if in == nil {
+		return nil
+	}
+	out := new(Disk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskDiscard) DeepCopyInto(out *DiskDiscard) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskDiscard.
+func (in *DiskDiscard) DeepCopy() *DiskDiscard {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskDiscard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskEncryption) DeepCopyInto(out *DiskEncryption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskEncryption.
+func (in *DiskEncryption) DeepCopy() *DiskEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskRateLimit) DeepCopyInto(out *DiskRateLimit) {
+	*out = *in
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.BandwidthBurst != nil {
+		in, out := &in.BandwidthBurst, &out.BandwidthBurst
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskRateLimit.
+func (in *DiskRateLimit) DeepCopy() *DiskRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Display) DeepCopyInto(out *Display) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Display.
+func (in *Display) DeepCopy() *Display {
+	if in == nil {
+		return nil
+	}
+	out := new(Display)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Downward) DeepCopyInto(out *Downward) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Downward.
+func (in *Downward) DeepCopy() *Downward {
+	if in == nil {
+		return nil
+	}
+	out := new(Downward)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EFIFirmware) DeepCopyInto(out *EFIFirmware) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EFIFirmware.
+func (in *EFIFirmware) DeepCopy() *EFIFirmware {
+	if in == nil {
+		return nil
+	}
+	out := new(EFIFirmware)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmptyDiskVolumeClaim) DeepCopyInto(out *EmptyDiskVolumeClaim) {
+	*out = *in
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmptyDiskVolumeClaim.
+func (in *EmptyDiskVolumeClaim) DeepCopy() *EmptyDiskVolumeClaim {
+	if in == nil {
+		return nil
+	}
+	out := new(EmptyDiskVolumeClaim)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmptyDiskVolumeSource) DeepCopyInto(out *EmptyDiskVolumeSource) {
+	*out = *in
+	out.Capacity = in.Capacity.DeepCopy()
+	if in.VolumeClaim != nil {
+		in, out := &in.VolumeClaim, &out.VolumeClaim
+		*out = new(EmptyDiskVolumeClaim)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmptyDiskVolumeSource.
+func (in *EmptyDiskVolumeSource) DeepCopy() *EmptyDiskVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(EmptyDiskVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSystem) DeepCopyInto(out *FileSystem) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSystem.
+func (in *FileSystem) DeepCopy() *FileSystem {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSystem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Firmware) DeepCopyInto(out *Firmware) {
+	*out = *in
+	if in.EFI != nil {
+		in, out := &in.EFI, &out.EFI
+		*out = new(EFIFirmware)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Firmware.
+func (in *Firmware) DeepCopy() *Firmware {
+	if in == nil {
+		return nil
+	}
+	out := new(Firmware)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestMetrics) DeepCopyInto(out *GuestMetrics) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestMetrics.
+func (in *GuestMetrics) DeepCopy() *GuestMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPDiskVolumeSource) DeepCopyInto(out *HTTPDiskVolumeSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPDiskVolumeSource.
+func (in *HTTPDiskVolumeSource) DeepCopy() *HTTPDiskVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPDiskVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hibernation) DeepCopyInto(out *Hibernation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hibernation.
+func (in *Hibernation) DeepCopy() *Hibernation {
+	if in == nil {
+		return nil
+	}
+	out := new(Hibernation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostUSBDevice) DeepCopyInto(out *HostUSBDevice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostUSBDevice.
+func (in *HostUSBDevice) DeepCopy() *HostUSBDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(HostUSBDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hugepages) DeepCopyInto(out *Hugepages) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hugepages.
+func (in *Hugepages) DeepCopy() *Hugepages {
+	if in == nil {
+		return nil
+	}
+	out := new(Hugepages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Instance) DeepCopyInto(out *Instance) {
+	*out = *in
+	out.CPU = in.CPU
+	in.Memory.DeepCopyInto(&out.Memory)
+	if in.Kernel != nil {
+		in, out := &in.Kernel, &out.Kernel
+		*out = new(Kernel)
+		**out = **in
+	}
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]Disk, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FileSystems != nil {
+		in, out := &in.FileSystems, &out.FileSystems
+		*out = make([]FileSystem, len(*in))
+		copy(*out, *in)
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]Interface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Bonds != nil {
+		in, out := &in.Bonds, &out.Bonds
+		*out = make([]Bond, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Realtime != nil {
+		in, out := &in.Realtime, &out.Realtime
+		*out = new(Realtime)
+		**out = **in
+	}
+	if in.Watchdog != nil {
+		in, out := &in.Watchdog, &out.Watchdog
+		*out = new(Watchdog)
+		**out = **in
+	}
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = new(Devices)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Firmware != nil {
+		in, out := &in.Firmware, &out.Firmware
+		*out = new(Firmware)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Vsock != nil {
+		in, out := &in.Vsock, &out.Vsock
+		*out = new(Vsock)
+		**out = **in
+	}
+	if in.RNG != nil {
+		in, out := &in.RNG, &out.RNG
+		*out = new(RNG)
+		**out = **in
+	}
+	if in.Downward != nil {
+		in, out := &in.Downward, &out.Downward
+		*out = new(Downward)
+		**out = **in
+	}
+	if in.Clock != nil {
+		in, out := &in.Clock, &out.Clock
+		*out = new(Clock)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestMetrics != nil {
+		in, out := &in.GuestMetrics, &out.GuestMetrics
+		*out = new(GuestMetrics)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instance.
+func (in *Instance) DeepCopy() *Instance {
+	if in == nil {
+		return nil
+	}
+	out := new(Instance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Interface) DeepCopyInto(out *Interface) {
+	*out = *in
+	in.InterfaceBindingMethod.DeepCopyInto(&out.InterfaceBindingMethod)
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(InterfaceRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DHCPOptions != nil {
+		in, out := &in.DHCPOptions, &out.DHCPOptions
+		*out = new(InterfaceDHCPOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Offloads != nil {
+		in, out := &in.Offloads, &out.Offloads
+		*out = new(InterfaceOffloads)
+		**out = **in
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VLAN != nil {
+		in, out := &in.VLAN, &out.VLAN
+		*out = new(InterfaceVLAN)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]InterfaceRoute, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Interface.
+func (in *Interface) DeepCopy() *Interface {
+	if in == nil {
+		return nil
+	}
+	out := new(Interface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
+	*out = *in
+	if in.Bridge != nil {
+		in, out := &in.Bridge, &out.Bridge
+		*out = new(InterfaceBridge)
+		**out = **in
+	}
+	if in.Masquerade != nil {
+		in, out := &in.Masquerade, &out.Masquerade
+		*out = new(InterfaceMasquerade)
+		**out = **in
+	}
+	if in.SRIOV != nil {
+		in, out := &in.SRIOV, &out.SRIOV
+		*out = new(InterfaceSRIOV)
+		**out = **in
+	}
+	if in.VhostUser != nil {
+		in, out := &in.VhostUser, &out.VhostUser
+		*out = new(InterfaceVhostUser)
+		**out = **in
+	}
+	if in.OVS != nil {
+		in, out := &in.OVS, &out.OVS
+		*out = new(InterfaceOVS)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBindingMethod.
+func (in *InterfaceBindingMethod) DeepCopy() *InterfaceBindingMethod {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBindingMethod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBridge) DeepCopyInto(out *InterfaceBridge) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBridge.
+func (in *InterfaceBridge) DeepCopy() *InterfaceBridge {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBridge)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceDHCPOptions) DeepCopyInto(out *InterfaceDHCPOptions) {
+	*out = *in
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SearchDomains != nil {
+		in, out := &in.SearchDomains, &out.SearchDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NTPServers != nil {
+		in, out := &in.NTPServers, &out.NTPServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceDHCPOptions.
+func (in *InterfaceDHCPOptions) DeepCopy() *InterfaceDHCPOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceDHCPOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceMasquerade) DeepCopyInto(out *InterfaceMasquerade) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceMasquerade.
+func (in *InterfaceMasquerade) DeepCopy() *InterfaceMasquerade {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceMasquerade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceOVS) DeepCopyInto(out *InterfaceOVS) {
+	*out = *in
+	if in.VLAN != nil {
+		in, out := &in.VLAN, &out.VLAN
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Trunks != nil {
+		in, out := &in.Trunks, &out.Trunks
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceOVS.
+func (in *InterfaceOVS) DeepCopy() *InterfaceOVS {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceOVS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceOffloads) DeepCopyInto(out *InterfaceOffloads) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceOffloads.
+func (in *InterfaceOffloads) DeepCopy() *InterfaceOffloads {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceOffloads)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceRateLimit) DeepCopyInto(out *InterfaceRateLimit) {
+	*out = *in
+	if in.RX != nil {
+		in, out := &in.RX, &out.RX
+		*out = new(BandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TX != nil {
+		in, out := &in.TX, &out.TX
+		*out = new(BandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceRateLimit.
+func (in *InterfaceRateLimit) DeepCopy() *InterfaceRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceRoute) DeepCopyInto(out *InterfaceRoute) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceRoute.
+func (in *InterfaceRoute) DeepCopy() *InterfaceRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceSRIOV) DeepCopyInto(out *InterfaceSRIOV) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSRIOV.
+func (in *InterfaceSRIOV) DeepCopy() *InterfaceSRIOV {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceSRIOV)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceVLAN) DeepCopyInto(out *InterfaceVLAN) {
+	*out = *in
+	if in.Trunks != nil {
+		in, out := &in.Trunks, &out.Trunks
+		*out = make([]VLANRange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceVLAN.
+func (in *InterfaceVLAN) DeepCopy() *InterfaceVLAN {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceVLAN)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceVhostUser) DeepCopyInto(out *InterfaceVhostUser) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceVhostUser.
+func (in *InterfaceVhostUser) DeepCopy() *InterfaceVhostUser {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceVhostUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Kernel) DeepCopyInto(out *Kernel) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kernel.
+func (in *Kernel) DeepCopy() *Kernel {
+	if in == nil {
+		return nil
+	}
+	out := new(Kernel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Memory) DeepCopyInto(out *Memory) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+	if in.Hugepages != nil {
+		in, out := &in.Hugepages, &out.Hugepages
+		*out = new(Hugepages)
+		**out = **in
+	}
+	if in.Balloon != nil {
+		in, out := &in.Balloon, &out.Balloon
+		*out = new(MemoryBalloon)
+		**out = **in
+	}
+	if in.Swap != nil {
+		in, out := &in.Swap, &out.Swap
+		*out = new(MemorySwap)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Memory.
+func (in *Memory) DeepCopy() *Memory {
+	if in == nil {
+		return nil
+	}
+	out := new(Memory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryBalloon) DeepCopyInto(out *MemoryBalloon) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryBalloon.
+func (in *MemoryBalloon) DeepCopy() *MemoryBalloon {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryBalloon)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryDump) DeepCopyInto(out *MemoryDump) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryDump.
+func (in *MemoryDump) DeepCopy() *MemoryDump {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryDump)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemorySwap) DeepCopyInto(out *MemorySwap) {
+	*out = *in
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemorySwap.
+func (in *MemorySwap) DeepCopy() *MemorySwap {
+	if in == nil {
+		return nil
+	}
+	out := new(MemorySwap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataService) DeepCopyInto(out *MetadataService) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataService.
+func (in *MetadataService) DeepCopy() *MetadataService {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultusNetworkSource) DeepCopyInto(out *MultusNetworkSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultusNetworkSource.
+func (in *MultusNetworkSource) DeepCopy() *MultusNetworkSource {
+	if in == nil {
+		return nil
+	}
+	out := new(MultusNetworkSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Network) DeepCopyInto(out *Network) {
+	*out = *in
+	in.NetworkSource.DeepCopyInto(&out.NetworkSource)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Network.
+func (in *Network) DeepCopy() *Network {
+	if in == nil {
+		return nil
+	}
+	out := new(Network)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkSource) DeepCopyInto(out *NetworkSource) {
+	*out = *in
+	if in.Pod != nil {
+		in, out := &in.Pod, &out.Pod
+		*out = new(PodNetworkSource)
+		**out = **in
+	}
+	if in.Multus != nil {
+		in, out := &in.Multus, &out.Multus
+		*out = new(MultusNetworkSource)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSource.
+func (in *NetworkSource) DeepCopy() *NetworkSource {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimPopulateSource) DeepCopyInto(out *PersistentVolumeClaimPopulateSource) {
+	*out = *in
+	if in.ContainerDisk != nil {
+		in, out := &in.ContainerDisk, &out.ContainerDisk
+		*out = new(ContainerDiskVolumeSource)
+		**out = **in
+	}
+	if in.HTTPDisk != nil {
+		in, out := &in.HTTPDisk, &out.HTTPDisk
+		*out = new(HTTPDiskVolumeSource)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimPopulateSource.
+func (in *PersistentVolumeClaimPopulateSource) DeepCopy() *PersistentVolumeClaimPopulateSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimPopulateSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimVolumeSource) DeepCopyInto(out *PersistentVolumeClaimVolumeSource) {
+	*out = *in
+	if in.Populate != nil {
+		in, out := &in.Populate, &out.Populate
+		*out = new(PersistentVolumeClaimPopulateSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimVolumeSource.
+func (in *PersistentVolumeClaimVolumeSource) DeepCopy() *PersistentVolumeClaimVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodNetworkSource) DeepCopyInto(out *PodNetworkSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodNetworkSource.
+func (in *PodNetworkSource) DeepCopy() *PodNetworkSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PodNetworkSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RNG) DeepCopyInto(out *RNG) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RNG.
+func (in *RNG) DeepCopy() *RNG {
+	if in == nil {
+		return nil
+	}
+	out := new(RNG)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Realtime) DeepCopyInto(out *Realtime) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Realtime.
+func (in *Realtime) DeepCopy() *Realtime {
+	if in == nil {
+		return nil
+	}
+	out := new(Realtime)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHPublicKey) DeepCopyInto(out *SSHPublicKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHPublicKey.
+func (in *SSHPublicKey) DeepCopy() *SSHPublicKey {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHPublicKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Schedule) DeepCopyInto(out *Schedule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schedule.
+func (in *Schedule) DeepCopy() *Schedule {
+	if in == nil {
+		return nil
+	}
+	out := new(Schedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretVolumeSource) DeepCopyInto(out *SecretVolumeSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretVolumeSource.
+func (in *SecretVolumeSource) DeepCopy() *SecretVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupPortRange) DeepCopyInto(out *SecurityGroupPortRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupPortRange.
+func (in *SecurityGroupPortRange) DeepCopy() *SecurityGroupPortRange {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupPortRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRule) DeepCopyInto(out *SecurityGroupRule) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]SecurityGroupPortRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRule.
+func (in *SecurityGroupRule) DeepCopy() *SecurityGroupRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountTokenVolumeSource) DeepCopyInto(out *ServiceAccountTokenVolumeSource) {
+	*out = *in
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountTokenVolumeSource.
+func (in *ServiceAccountTokenVolumeSource) DeepCopy() *ServiceAccountTokenVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountTokenVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Sound) DeepCopyInto(out *Sound) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Sound.
+func (in *Sound) DeepCopy() *Sound {
+	if in == nil {
+		return nil
+	}
+	out := new(Sound)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SysprepVolumeSource) DeepCopyInto(out *SysprepVolumeSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SysprepVolumeSource.
+func (in *SysprepVolumeSource) DeepCopy() *SysprepVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SysprepVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tablet) DeepCopyInto(out *Tablet) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tablet.
+func (in *Tablet) DeepCopy() *Tablet {
+	if in == nil {
+		return nil
+	}
+	out := new(Tablet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *USBController) DeepCopyInto(out *USBController) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new USBController.
+func (in *USBController) DeepCopy() *USBController {
+	if in == nil {
+		return nil
+	}
+	out := new(USBController)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VLANRange) DeepCopyInto(out *VLANRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLANRange.
+func (in *VLANRange) DeepCopy() *VLANRange {
+	if in == nil {
+		return nil
+	}
+	out := new(VLANRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfig) DeepCopyInto(out *VirtinkConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfig.
+func (in *VirtinkConfig) DeepCopy() *VirtinkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtinkConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfigDiskLeases) DeepCopyInto(out *VirtinkConfigDiskLeases) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfigDiskLeases.
+func (in *VirtinkConfigDiskLeases) DeepCopy() *VirtinkConfigDiskLeases {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfigDiskLeases)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfigFencing) DeepCopyInto(out *VirtinkConfigFencing) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(VirtinkConfigFencingWebhook)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfigFencing.
+func (in *VirtinkConfigFencing) DeepCopy() *VirtinkConfigFencing {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfigFencing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfigFencingWebhook) DeepCopyInto(out *VirtinkConfigFencingWebhook) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfigFencingWebhook.
+func (in *VirtinkConfigFencingWebhook) DeepCopy() *VirtinkConfigFencingWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfigFencingWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfigFilesystemOverhead) DeepCopyInto(out *VirtinkConfigFilesystemOverhead) {
+	*out = *in
+	if in.StorageClasses != nil {
+		in, out := &in.StorageClasses, &out.StorageClasses
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfigFilesystemOverhead.
+func (in *VirtinkConfigFilesystemOverhead) DeepCopy() *VirtinkConfigFilesystemOverhead {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfigFilesystemOverhead)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfigIdleSuspend) DeepCopyInto(out *VirtinkConfigIdleSuspend) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfigIdleSuspend.
+func (in *VirtinkConfigIdleSuspend) DeepCopy() *VirtinkConfigIdleSuspend {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfigIdleSuspend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfigImages) DeepCopyInto(out *VirtinkConfigImages) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfigImages.
+func (in *VirtinkConfigImages) DeepCopy() *VirtinkConfigImages {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfigImages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfigKSM) DeepCopyInto(out *VirtinkConfigKSM) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfigKSM.
+func (in *VirtinkConfigKSM) DeepCopy() *VirtinkConfigKSM {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfigKSM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfigList) DeepCopyInto(out *VirtinkConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtinkConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfigList.
+func (in *VirtinkConfigList) DeepCopy() *VirtinkConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtinkConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfigLogging) DeepCopyInto(out *VirtinkConfigLogging) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfigLogging.
+func (in *VirtinkConfigLogging) DeepCopy() *VirtinkConfigLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfigLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfigMemoryOvercommit) DeepCopyInto(out *VirtinkConfigMemoryOvercommit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfigMemoryOvercommit.
+func (in *VirtinkConfigMemoryOvercommit) DeepCopy() *VirtinkConfigMemoryOvercommit {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfigMemoryOvercommit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfigMigration) DeepCopyInto(out *VirtinkConfigMigration) {
+	*out = *in
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfigMigration.
+func (in *VirtinkConfigMigration) DeepCopy() *VirtinkConfigMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfigMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfigNetwork) DeepCopyInto(out *VirtinkConfigNetwork) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfigNetwork.
+func (in *VirtinkConfigNetwork) DeepCopy() *VirtinkConfigNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfigNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfigNodePressure) DeepCopyInto(out *VirtinkConfigNodePressure) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfigNodePressure.
+func (in *VirtinkConfigNodePressure) DeepCopy() *VirtinkConfigNodePressure {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfigNodePressure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfigRebalance) DeepCopyInto(out *VirtinkConfigRebalance) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfigRebalance.
+func (in *VirtinkConfigRebalance) DeepCopy() *VirtinkConfigRebalance {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfigRebalance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfigSpec) DeepCopyInto(out *VirtinkConfigSpec) {
+	*out = *in
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Fencing.DeepCopyInto(&out.Fencing)
+	out.IdleSuspend = in.IdleSuspend
+	out.Images = in.Images
+	in.KSM.DeepCopyInto(&out.KSM)
+	out.Logging = in.Logging
+	out.MemoryOvercommit = in.MemoryOvercommit
+	in.Migration.DeepCopyInto(&out.Migration)
+	out.Network = in.Network
+	out.NodePressure = in.NodePressure
+	out.Rebalance = in.Rebalance
+	in.Storage.DeepCopyInto(&out.Storage)
+	out.UsageAccounting = in.UsageAccounting
+	out.VCPUResource = in.VCPUResource
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfigSpec.
+func (in *VirtinkConfigSpec) DeepCopy() *VirtinkConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfigStorage) DeepCopyInto(out *VirtinkConfigStorage) {
+	*out = *in
+	in.FilesystemOverhead.DeepCopyInto(&out.FilesystemOverhead)
+	out.DiskLeases = in.DiskLeases
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfigStorage.
+func (in *VirtinkConfigStorage) DeepCopy() *VirtinkConfigStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfigStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfigUsageAccounting) DeepCopyInto(out *VirtinkConfigUsageAccounting) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfigUsageAccounting.
+func (in *VirtinkConfigUsageAccounting) DeepCopy() *VirtinkConfigUsageAccounting {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfigUsageAccounting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkConfigVCPUResource) DeepCopyInto(out *VirtinkConfigVCPUResource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkConfigVCPUResource.
+func (in *VirtinkConfigVCPUResource) DeepCopy() *VirtinkConfigVCPUResource {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkConfigVCPUResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkNamespaceConfig) DeepCopyInto(out *VirtinkNamespaceConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkNamespaceConfig.
+func (in *VirtinkNamespaceConfig) DeepCopy() *VirtinkNamespaceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkNamespaceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtinkNamespaceConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkNamespaceConfigCloudInit) DeepCopyInto(out *VirtinkNamespaceConfigCloudInit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkNamespaceConfigCloudInit.
+func (in *VirtinkNamespaceConfigCloudInit) DeepCopy() *VirtinkNamespaceConfigCloudInit {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkNamespaceConfigCloudInit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkNamespaceConfigList) DeepCopyInto(out *VirtinkNamespaceConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtinkNamespaceConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkNamespaceConfigList.
+func (in *VirtinkNamespaceConfigList) DeepCopy() *VirtinkNamespaceConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkNamespaceConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtinkNamespaceConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkNamespaceConfigResources) DeepCopyInto(out *VirtinkNamespaceConfigResources) {
+	*out = *in
+	if in.MemoryOverhead != nil {
+		in, out := &in.MemoryOverhead, &out.MemoryOverhead
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkNamespaceConfigResources.
+func (in *VirtinkNamespaceConfigResources) DeepCopy() *VirtinkNamespaceConfigResources {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkNamespaceConfigResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkNamespaceConfigSpec) DeepCopyInto(out *VirtinkNamespaceConfigSpec) {
+	*out = *in
+	out.CloudInit = in.CloudInit
+	out.Network = in.Network
+	in.Resources.DeepCopyInto(&out.Resources)
+	in.Storage.DeepCopyInto(&out.Storage)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkNamespaceConfigSpec.
+func (in *VirtinkNamespaceConfigSpec) DeepCopy() *VirtinkNamespaceConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkNamespaceConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtinkNamespaceConfigStorage) DeepCopyInto(out *VirtinkNamespaceConfigStorage) {
+	*out = *in
+	if in.DefaultStorageClassName != nil {
+		in, out := &in.DefaultStorageClassName, &out.DefaultStorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtinkNamespaceConfigStorage.
+func (in *VirtinkNamespaceConfigStorage) DeepCopy() *VirtinkNamespaceConfigStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtinkNamespaceConfigStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachine) DeepCopyInto(out *VirtualMachine) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachine.
+func (in *VirtualMachine) DeepCopy() *VirtualMachine {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachine) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineAction) DeepCopyInto(out *VirtualMachineAction) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineAction.
+func (in *VirtualMachineAction) DeepCopy() *VirtualMachineAction {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineAction) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineActionList) DeepCopyInto(out *VirtualMachineActionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineActionList.
+func (in *VirtualMachineActionList) DeepCopy() *VirtualMachineActionList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineActionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineActionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineActionSpec) DeepCopyInto(out *VirtualMachineActionSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineActionSpec.
+func (in *VirtualMachineActionSpec) DeepCopy() *VirtualMachineActionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineActionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineActionStatus) DeepCopyInto(out *VirtualMachineActionStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineActionStatus.
+func (in *VirtualMachineActionStatus) DeepCopy() *VirtualMachineActionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineActionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineAddress) DeepCopyInto(out *VirtualMachineAddress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineAddress.
+func (in *VirtualMachineAddress) DeepCopy() *VirtualMachineAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExport) DeepCopyInto(out *VirtualMachineExport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExport.
+func (in *VirtualMachineExport) DeepCopy() *VirtualMachineExport {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineExport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportHTTPTarget) DeepCopyInto(out *VirtualMachineExportHTTPTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportHTTPTarget.
+func (in *VirtualMachineExportHTTPTarget) DeepCopy() *VirtualMachineExportHTTPTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportHTTPTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportList) DeepCopyInto(out *VirtualMachineExportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineExport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportList.
+func (in *VirtualMachineExportList) DeepCopy() *VirtualMachineExportList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineExportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportOCITarget) DeepCopyInto(out *VirtualMachineExportOCITarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportOCITarget.
+func (in *VirtualMachineExportOCITarget) DeepCopy() *VirtualMachineExportOCITarget {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportOCITarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportSpec) DeepCopyInto(out *VirtualMachineExportSpec) {
+	*out = *in
+	in.VirtualMachineExportTarget.DeepCopyInto(&out.VirtualMachineExportTarget)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportSpec.
+func (in *VirtualMachineExportSpec) DeepCopy() *VirtualMachineExportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportStatus) DeepCopyInto(out *VirtualMachineExportStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportStatus.
+func (in *VirtualMachineExportStatus) DeepCopy() *VirtualMachineExportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportTarget) DeepCopyInto(out *VirtualMachineExportTarget) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(VirtualMachineExportHTTPTarget)
+		**out = **in
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(VirtualMachineExportOCITarget)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportTarget.
+func (in *VirtualMachineExportTarget) DeepCopy() *VirtualMachineExportTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineList) DeepCopyInto(out *VirtualMachineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineList.
+func (in *VirtualMachineList) DeepCopy() *VirtualMachineList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineMigration) DeepCopyInto(out *VirtualMachineMigration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineMigration.
+func (in *VirtualMachineMigration) DeepCopy() *VirtualMachineMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineMigration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineMigrationList) DeepCopyInto(out *VirtualMachineMigrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineMigrationList.
+func (in *VirtualMachineMigrationList) DeepCopy() *VirtualMachineMigrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineMigrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineMigrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineMigrationSpec) DeepCopyInto(out *VirtualMachineMigrationSpec) {
+	*out = *in
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineMigrationSpec.
+func (in *VirtualMachineMigrationSpec) DeepCopy() *VirtualMachineMigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineMigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineMigrationStatus) DeepCopyInto(out *VirtualMachineMigrationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineMigrationStatus.
+func (in *VirtualMachineMigrationStatus) DeepCopy() *VirtualMachineMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePool) DeepCopyInto(out *VirtualMachinePool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePool.
+func (in *VirtualMachinePool) DeepCopy() *VirtualMachinePool {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachinePool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolList) DeepCopyInto(out *VirtualMachinePoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachinePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolList.
+func (in *VirtualMachinePoolList) DeepCopy() *VirtualMachinePoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachinePoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolSpec) DeepCopyInto(out *VirtualMachinePoolSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.VolumeClaimTemplates != nil {
+		in, out := &in.VolumeClaimTemplates, &out.VolumeClaimTemplates
+		*out = make([]corev1.PersistentVolumeClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolSpec.
+func (in *VirtualMachinePoolSpec) DeepCopy() *VirtualMachinePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolStatus) DeepCopyInto(out *VirtualMachinePoolStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolStatus.
+func (in *VirtualMachinePoolStatus) DeepCopy() *VirtualMachinePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolTemplate) DeepCopyInto(out *VirtualMachinePoolTemplate) {
+	*out = *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolTemplate.
+func (in *VirtualMachinePoolTemplate) DeepCopy() *VirtualMachinePoolTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineQuota) DeepCopyInto(out *VirtualMachineQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineQuota.
+func (in *VirtualMachineQuota) DeepCopy() *VirtualMachineQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineQuotaList) DeepCopyInto(out *VirtualMachineQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineQuotaList.
+func (in *VirtualMachineQuotaList) DeepCopy() *VirtualMachineQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineQuotaResources) DeepCopyInto(out *VirtualMachineQuotaResources) {
+	*out = *in
+	if in.VirtualMachines != nil {
+		in, out := &in.VirtualMachines, &out.VirtualMachines
+		*out = new(int64)
+		**out = **in
+	}
+	if in.VCPUs != nil {
+		in, out := &in.VCPUs, &out.VCPUs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineQuotaResources.
+func (in *VirtualMachineQuotaResources) DeepCopy() *VirtualMachineQuotaResources {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineQuotaResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineQuotaSpec) DeepCopyInto(out *VirtualMachineQuotaSpec) {
+	*out = *in
+	in.Hard.DeepCopyInto(&out.Hard)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineQuotaSpec.
+func (in *VirtualMachineQuotaSpec) DeepCopy() *VirtualMachineQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineQuotaStatus) DeepCopyInto(out *VirtualMachineQuotaStatus) {
+	*out = *in
+	in.Used.DeepCopyInto(&out.Used)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineQuotaStatus.
+func (in *VirtualMachineQuotaStatus) DeepCopy() *VirtualMachineQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineRollingRestart) DeepCopyInto(out *VirtualMachineRollingRestart) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRollingRestart.
+func (in *VirtualMachineRollingRestart) DeepCopy() *VirtualMachineRollingRestart {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineRollingRestart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineRollingRestart) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineRollingRestartList) DeepCopyInto(out *VirtualMachineRollingRestartList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineRollingRestart, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRollingRestartList.
+func (in *VirtualMachineRollingRestartList) DeepCopy() *VirtualMachineRollingRestartList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineRollingRestartList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineRollingRestartList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineRollingRestartSpec) DeepCopyInto(out *VirtualMachineRollingRestartSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRollingRestartSpec.
+func (in *VirtualMachineRollingRestartSpec) DeepCopy() *VirtualMachineRollingRestartSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineRollingRestartSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineRollingRestartStatus) DeepCopyInto(out *VirtualMachineRollingRestartStatus) {
+	*out = *in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRollingRestartStatus.
+func (in *VirtualMachineRollingRestartStatus) DeepCopy() *VirtualMachineRollingRestartStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineRollingRestartStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSecurityGroup) DeepCopyInto(out *VirtualMachineSecurityGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSecurityGroup.
+func (in *VirtualMachineSecurityGroup) DeepCopy() *VirtualMachineSecurityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSecurityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSecurityGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSecurityGroupList) DeepCopyInto(out *VirtualMachineSecurityGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineSecurityGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSecurityGroupList.
+func (in *VirtualMachineSecurityGroupList) DeepCopy() *VirtualMachineSecurityGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSecurityGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSecurityGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSecurityGroupSpec) DeepCopyInto(out *VirtualMachineSecurityGroupSpec) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]SecurityGroupRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]SecurityGroupRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSecurityGroupSpec.
+func (in *VirtualMachineSecurityGroupSpec) DeepCopy() *VirtualMachineSecurityGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSecurityGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSConfig != nil {
+		in, out := &in.DNSConfig, &out.DNSConfig
+		*out = new(corev1.PodDNSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Instance.DeepCopyInto(&out.Instance)
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]Network, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MemoryDump != nil {
+		in, out := &in.MemoryDump, &out.MemoryDump
+		*out = new(MemoryDump)
+		**out = **in
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(Hibernation)
+		**out = **in
+	}
+	if in.SSHPublicKeys != nil {
+		in, out := &in.SSHPublicKeys, &out.SSHPublicKeys
+		*out = make([]SSHPublicKey, len(*in))
+		copy(*out, *in)
+	}
+	if in.MetadataService != nil {
+		in, out := &in.MetadataService, &out.MetadataService
+		*out = new(MetadataService)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(Schedule)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSpec.
+func (in *VirtualMachineSpec) DeepCopy() *VirtualMachineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
+	*out = *in
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(VirtualMachineStatusMigration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MemoryDump != nil {
+		in, out := &in.MemoryDump, &out.MemoryDump
+		*out = new(VirtualMachineStatusMemoryDump)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(VirtualMachineStatusSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(VirtualMachineStatusHibernation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]VirtualMachineAddress, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStatus.
+func (in *VirtualMachineStatus) DeepCopy() *VirtualMachineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStatusHibernation) DeepCopyInto(out *VirtualMachineStatusHibernation) {
+	*out = *in
+	if in.HibernationTime != nil {
+		in, out := &in.HibernationTime, &out.HibernationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStatusHibernation.
+func (in *VirtualMachineStatusHibernation) DeepCopy() *VirtualMachineStatusHibernation {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStatusHibernation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStatusMemoryDump) DeepCopyInto(out *VirtualMachineStatusMemoryDump) {
+	*out = *in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStatusMemoryDump.
+func (in *VirtualMachineStatusMemoryDump) DeepCopy() *VirtualMachineStatusMemoryDump {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStatusMemoryDump)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStatusMigration) DeepCopyInto(out *VirtualMachineStatusMigration) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VirtualMachineStatusMigrationVolume, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetNodeSelector != nil {
+		in, out := &in.TargetNodeSelector, &out.TargetNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TargetAffinity != nil {
+		in, out := &in.TargetAffinity, &out.TargetAffinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStatusMigration.
+func (in *VirtualMachineStatusMigration) DeepCopy() *VirtualMachineStatusMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStatusMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStatusMigrationVolume) DeepCopyInto(out *VirtualMachineStatusMigrationVolume) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStatusMigrationVolume.
+func (in *VirtualMachineStatusMigrationVolume) DeepCopy() *VirtualMachineStatusMigrationVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStatusMigrationVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStatusSchedule) DeepCopyInto(out *VirtualMachineStatusSchedule) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStatusSchedule.
+func (in *VirtualMachineStatusSchedule) DeepCopy() *VirtualMachineStatusSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStatusSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplate) DeepCopyInto(out *VirtualMachineTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplate.
+func (in *VirtualMachineTemplate) DeepCopy() *VirtualMachineTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateInstance) DeepCopyInto(out *VirtualMachineTemplateInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateInstance.
+func (in *VirtualMachineTemplateInstance) DeepCopy() *VirtualMachineTemplateInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineTemplateInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateInstanceList) DeepCopyInto(out *VirtualMachineTemplateInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineTemplateInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateInstanceList.
+func (in *VirtualMachineTemplateInstanceList) DeepCopy() *VirtualMachineTemplateInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineTemplateInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateInstanceSpec) DeepCopyInto(out *VirtualMachineTemplateInstanceSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateInstanceSpec.
+func (in *VirtualMachineTemplateInstanceSpec) DeepCopy() *VirtualMachineTemplateInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateInstanceStatus) DeepCopyInto(out *VirtualMachineTemplateInstanceStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateInstanceStatus.
+func (in *VirtualMachineTemplateInstanceStatus) DeepCopy() *VirtualMachineTemplateInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateList) DeepCopyInto(out *VirtualMachineTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateList.
+func (in *VirtualMachineTemplateList) DeepCopy() *VirtualMachineTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateParameter) DeepCopyInto(out *VirtualMachineTemplateParameter) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateParameter.
+func (in *VirtualMachineTemplateParameter) DeepCopy() *VirtualMachineTemplateParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateSpec) DeepCopyInto(out *VirtualMachineTemplateSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]VirtualMachineTemplateParameter, len(*in))
+		copy(*out, *in)
+	}
+	in.VirtualMachine.DeepCopyInto(&out.VirtualMachine)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateSpec.
+func (in *VirtualMachineTemplateSpec) DeepCopy() *VirtualMachineTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineUsage) DeepCopyInto(out *VirtualMachineUsage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineUsage.
+func (in *VirtualMachineUsage) DeepCopy() *VirtualMachineUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineUsage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineUsageList) DeepCopyInto(out *VirtualMachineUsageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineUsageList.
+func (in *VirtualMachineUsageList) DeepCopy() *VirtualMachineUsageList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineUsageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineUsageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineUsageStatus) DeepCopyInto(out *VirtualMachineUsageStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastFlushTime != nil {
+		in, out := &in.LastFlushTime, &out.LastFlushTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VirtualMachineUsageVolume, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineUsageStatus.
+func (in *VirtualMachineUsageStatus) DeepCopy() *VirtualMachineUsageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineUsageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineUsageVolume) DeepCopyInto(out *VirtualMachineUsageVolume) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineUsageVolume.
+func (in *VirtualMachineUsageVolume) DeepCopy() *VirtualMachineUsageVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineUsageVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Volume) DeepCopyInto(out *Volume) {
+	*out = *in
+	in.VolumeSource.DeepCopyInto(&out.VolumeSource)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Volume.
+func (in *Volume) DeepCopy() *Volume {
+	if in == nil {
+		return nil
+	}
+	out := new(Volume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
+	*out = *in
+	if in.ContainerDisk != nil {
+		in, out := &in.ContainerDisk, &out.ContainerDisk
+		*out = new(ContainerDiskVolumeSource)
+		**out = **in
+	}
+	if in.HTTPDisk != nil {
+		in, out := &in.HTTPDisk, &out.HTTPDisk
+		*out = new(HTTPDiskVolumeSource)
+		**out = **in
+	}
+	if in.EmptyDisk != nil {
+		in, out := &in.EmptyDisk, &out.EmptyDisk
+		*out = new(EmptyDiskVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudInit != nil {
+		in, out := &in.CloudInit, &out.CloudInit
+		*out = new(CloudInitVolumeSource)
+		**out = **in
+	}
+	if in.ContainerRootfs != nil {
+		in, out := &in.ContainerRootfs, &out.ContainerRootfs
+		*out = new(ContainerRootfsVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(PersistentVolumeClaimVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataVolume != nil {
+		in, out := &in.DataVolume, &out.DataVolume
+		*out = new(DataVolumeVolumeSource)
+		**out = **in
+	}
+	if in.ClusterAPIBootstrap != nil {
+		in, out := &in.ClusterAPIBootstrap, &out.ClusterAPIBootstrap
+		*out = new(ClusterAPIBootstrapVolumeSource)
+		**out = **in
+	}
+	if in.Sysprep != nil {
+		in, out := &in.Sysprep, &out.Sysprep
+		*out = new(SysprepVolumeSource)
+		**out = **in
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(ConfigMapVolumeSource)
+		**out = **in
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(SecretVolumeSource)
+		**out = **in
+	}
+	if in.ServiceAccountToken != nil {
+		in, out := &in.ServiceAccountToken, &out.ServiceAccountToken
+		*out = new(ServiceAccountTokenVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSource.
+func (in *VolumeSource) DeepCopy() *VolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Vsock) DeepCopyInto(out *Vsock) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Vsock.
+func (in *Vsock) DeepCopy() *Vsock {
+	if in == nil {
+		return nil
+	}
+	out := new(Vsock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Watchdog) DeepCopyInto(out *Watchdog) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Watchdog.
+func (in *Watchdog) DeepCopy() *Watchdog {
+	if in == nil {
+		return nil
+	}
+	out := new(Watchdog)
+	in.DeepCopyInto(out)
+	return out
+}
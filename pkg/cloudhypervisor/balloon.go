@@ -0,0 +1,32 @@
+package cloudhypervisor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// VmResizeBalloon resizes the balloon of the VM to size bytes. Unlike
+// VmResize, it can deflate the balloon completely, as VmResize omits a zero
+// desired_balloon.
+func (c *Client) VmResizeBalloon(ctx context.Context, size int64) error {
+	reqBody := fmt.Sprintf(`{"desired_balloon":%d}`, size)
+	req, err := http.NewRequestWithContext(ctx, "PUT", "http://localhost/api/v1/vm.resize", bytes.NewBufferString(reqBody))
+	if err != nil {
+		return fmt.Errorf("build request: %s", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("do request: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("request failed: %d %s: %s", resp.StatusCode, http.StatusText(resp.StatusCode), string(body))
+	}
+	return nil
+}
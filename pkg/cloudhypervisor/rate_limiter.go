@@ -0,0 +1,60 @@
+package cloudhypervisor
+
+import (
+	"fmt"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+// NewDiskRateLimiterConfig builds the rate limiter of a disk from its rate
+// limit.
+func NewDiskRateLimiterConfig(rateLimit *virtv1alpha1.DiskRateLimit) *RateLimiterConfig {
+	var bandwidth, bandwidthBurst int64
+	if rateLimit.Bandwidth != nil {
+		bandwidth = rateLimit.Bandwidth.Value()
+	}
+	if rateLimit.BandwidthBurst != nil {
+		bandwidthBurst = rateLimit.BandwidthBurst.Value()
+	}
+	return NewRateLimiterConfig(bandwidth, bandwidthBurst, rateLimit.IOPS, rateLimit.IOPSBurst)
+}
+
+// NewRateLimiterConfig builds a rate limiter refilling its token buckets every
+// second. A zero rate leaves the corresponding bucket unlimited.
+func NewRateLimiterConfig(bandwidth int64, bandwidthBurst int64, ops int64, opsBurst int64) *RateLimiterConfig {
+	if bandwidth <= 0 && ops <= 0 {
+		return nil
+	}
+
+	var config RateLimiterConfig
+	if bandwidth > 0 {
+		config.Bandwidth = &TokenBucket{
+			Size:         bandwidth,
+			OneTimeBurst: bandwidthBurst,
+			RefillTime:   1000,
+		}
+	}
+	if ops > 0 {
+		config.Ops = &TokenBucket{
+			Size:         ops,
+			OneTimeBurst: opsBurst,
+			RefillTime:   1000,
+		}
+	}
+	return &config
+}
+
+// Args renders the rate limiter as Cloud Hypervisor command line parameters.
+func (c *RateLimiterConfig) Args() []string {
+	var args []string
+	if c == nil {
+		return args
+	}
+	if c.Bandwidth != nil {
+		args = append(args, fmt.Sprintf("bw_size=%d", c.Bandwidth.Size), fmt.Sprintf("bw_one_time_burst=%d", c.Bandwidth.OneTimeBurst), fmt.Sprintf("bw_refill_time=%d", c.Bandwidth.RefillTime))
+	}
+	if c.Ops != nil {
+		args = append(args, fmt.Sprintf("ops_size=%d", c.Ops.Size), fmt.Sprintf("ops_one_time_burst=%d", c.Ops.OneTimeBurst), fmt.Sprintf("ops_refill_time=%d", c.Ops.RefillTime))
+	}
+	return args
+}
@@ -0,0 +1,80 @@
+package conditions
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Reasons shared by the conditions of Virtink objects.
+const (
+	ReasonReconcileSucceeded = "ReconcileSucceeded"
+	ReasonReconcileFailed    = "ReconcileFailed"
+
+	ReasonPaused = "Paused"
+
+	ReasonAllDataVolumesReady = "AllDataVolumesReady"
+	ReasonDataVolumeNotReady  = "DataVolumeNotReady"
+
+	ReasonAllVolumesPopulated  = "AllVolumesPopulated"
+	ReasonVolumePopulating     = "VolumePopulating"
+	ReasonVolumePopulateFailed = "VolumePopulateFailed"
+
+	ReasonMigratable              = "Migratable"
+	ReasonHypervisorNotMigratable = "HypervisorNotMigratable"
+	ReasonCPUNotMigratable        = "CPUNotMigratable"
+	ReasonInterfaceNotMigratable  = "InterfaceNotMigratable"
+	ReasonDeviceNotMigratable     = "DeviceNotMigratable"
+	ReasonVolumeNotMigratable     = "VolumeNotMigratable"
+	ReasonRestartRequired         = "RestartRequired"
+
+	ReasonFeasibleTargetFound = "FeasibleTargetFound"
+	ReasonNoFeasibleTarget    = "NoFeasibleTarget"
+
+	ReasonScheduled      = "Scheduled"
+	ReasonUnschedulable  = "Unschedulable"
+	ReasonNoFeasibleNode = "NoFeasibleNode"
+
+	ReasonSpecChanged = "SpecChanged"
+
+	ReasonVMNotRunning        = "VMNotRunning"
+	ReasonMigrationInProgress = "MigrationInProgress"
+)
+
+func Get(conditions []metav1.Condition, conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(conditions, conditionType)
+}
+
+func IsTrue(conditions []metav1.Condition, conditionType string) bool {
+	return meta.IsStatusConditionTrue(conditions, conditionType)
+}
+
+func IsFalse(conditions []metav1.Condition, conditionType string) bool {
+	return meta.IsStatusConditionFalse(conditions, conditionType)
+}
+
+// MarkTrue sets the condition to True. The last transition time is only
+// updated when the status changes.
+func MarkTrue(conditions *[]metav1.Condition, conditionType string, reason string) {
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:   conditionType,
+		Status: metav1.ConditionTrue,
+		Reason: reason,
+	})
+}
+
+// MarkFalse sets the condition to False. The last transition time is only
+// updated when the status changes.
+func MarkFalse(conditions *[]metav1.Condition, conditionType string, reason string, messageFormat string, messageArgs ...interface{}) {
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: fmt.Sprintf(messageFormat, messageArgs...),
+	})
+}
+
+func Remove(conditions *[]metav1.Condition, conditionType string) {
+	meta.RemoveStatusCondition(conditions, conditionType)
+}
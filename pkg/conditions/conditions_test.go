@@ -0,0 +1,33 @@
+package conditions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/smartxworks/virtink/pkg/conditions"
+)
+
+func TestConditions(t *testing.T) {
+	var cs []metav1.Condition
+
+	conditions.MarkFalse(&cs, "Ready", "NotReady", "waiting for %s", "pod")
+	assert.True(t, conditions.IsFalse(cs, "Ready"))
+	assert.Equal(t, "waiting for pod", conditions.Get(cs, "Ready").Message)
+	lastTransitionTime := conditions.Get(cs, "Ready").LastTransitionTime
+	assert.False(t, lastTransitionTime.IsZero())
+
+	conditions.MarkFalse(&cs, "Ready", "NotReady", "waiting for %s", "disk")
+	assert.Equal(t, "waiting for disk", conditions.Get(cs, "Ready").Message)
+	assert.Equal(t, lastTransitionTime, conditions.Get(cs, "Ready").LastTransitionTime)
+
+	conditions.MarkTrue(&cs, "Ready", "Ready")
+	assert.True(t, conditions.IsTrue(cs, "Ready"))
+	assert.Empty(t, conditions.Get(cs, "Ready").Message)
+
+	conditions.Remove(&cs, "Ready")
+	assert.Nil(t, conditions.Get(cs, "Ready"))
+	assert.False(t, conditions.IsTrue(cs, "Ready"))
+	assert.False(t, conditions.IsFalse(cs, "Ready"))
+}
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+// batchingEventHandler delays the requests enqueued by the wrapped handler by
+// the batch period. Requests for the same object enqueued within the period
+// are merged by the work queue, so a burst of events, e.g. status changes of a
+// VM pod during startup, results in a single reconciliation and status update.
+type batchingEventHandler struct {
+	handler.EventHandler
+	BatchPeriod time.Duration
+}
+
+var _ handler.EventHandler = &batchingEventHandler{}
+var _ inject.Injector = &batchingEventHandler{}
+
+// InjectFunc passes dependencies, e.g. the scheme, on to the wrapped handler.
+func (h *batchingEventHandler) InjectFunc(f inject.Func) error {
+	return f(h.EventHandler)
+}
+
+func (h *batchingEventHandler) Create(e event.CreateEvent, q workqueue.RateLimitingInterface) {
+	h.EventHandler.Create(e, &batchingQueue{RateLimitingInterface: q, batchPeriod: h.BatchPeriod})
+}
+
+func (h *batchingEventHandler) Update(e event.UpdateEvent, q workqueue.RateLimitingInterface) {
+	h.EventHandler.Update(e, &batchingQueue{RateLimitingInterface: q, batchPeriod: h.BatchPeriod})
+}
+
+func (h *batchingEventHandler) Delete(e event.DeleteEvent, q workqueue.RateLimitingInterface) {
+	h.EventHandler.Delete(e, &batchingQueue{RateLimitingInterface: q, batchPeriod: h.BatchPeriod})
+}
+
+func (h *batchingEventHandler) Generic(e event.GenericEvent, q workqueue.RateLimitingInterface) {
+	h.EventHandler.Generic(e, &batchingQueue{RateLimitingInterface: q, batchPeriod: h.BatchPeriod})
+}
+
+type batchingQueue struct {
+	workqueue.RateLimitingInterface
+	batchPeriod time.Duration
+}
+
+func (q *batchingQueue) Add(item interface{}) {
+	q.AddAfter(item, q.batchPeriod)
+}
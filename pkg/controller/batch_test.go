@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+func TestBatchingEventHandler(t *testing.T) {
+	q := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer q.ShutDown()
+
+	h := &batchingEventHandler{
+		EventHandler: &handler.EnqueueRequestForObject{},
+		BatchPeriod:  100 * time.Millisecond,
+	}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-pod"}}
+	for i := 0; i < 3; i++ {
+		h.Update(event.UpdateEvent{ObjectOld: pod, ObjectNew: pod}, q)
+	}
+	assert.Equal(t, 0, q.Len())
+
+	time.Sleep(200 * time.Millisecond)
+	assert.Equal(t, 1, q.Len())
+}
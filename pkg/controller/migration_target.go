@@ -0,0 +1,281 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	netv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+// nfdCPUFeatureLabelPrefix is the prefix of the labels Node Feature Discovery
+// sets on nodes for the CPUID flags of their CPUs. VMs see the CPU features of
+// the node they were started on, so the target node must have all of them.
+const nfdCPUFeatureLabelPrefix = "feature.node.kubernetes.io/cpu-cpuid."
+
+// findMigrationTargets returns the nodes the VM can be migrated to under the
+// constraints of the VMM, and why the other nodes can't be. Only what the
+// scheduler can't tell is checked besides the constraints of the target VM
+// pod: the CPU features and architecture of the source node, the node
+// affinity of shared PVs and the devices of network attachments.
+func findMigrationTargets(ctx context.Context, c client.Reader, vm *virtv1alpha1.VirtualMachine, vmm *virtv1alpha1.VirtualMachineMigration) ([]string, []string, error) {
+	var sourceNode corev1.Node
+	if err := c.Get(ctx, client.ObjectKey{Name: vm.Status.NodeName}, &sourceNode); err != nil {
+		return nil, nil, fmt.Errorf("get source node: %s", err)
+	}
+
+	nodeSelector := map[string]string{}
+	for key, value := range vm.Spec.NodeSelector {
+		nodeSelector[key] = value
+	}
+	for key, value := range vmm.Spec.NodeSelector {
+		nodeSelector[key] = value
+	}
+	affinity := mergeAffinity(vm.Spec.Affinity, vmm.Spec.Affinity)
+
+	// node-local PVs are copied to the target node instead
+	var pvs []*corev1.PersistentVolume
+	for _, volume := range vm.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+		var pvc corev1.PersistentVolumeClaim
+		if err := c.Get(ctx, client.ObjectKey{Namespace: vm.Namespace, Name: volume.PersistentVolumeClaim.ClaimName}, &pvc); err != nil {
+			return nil, nil, fmt.Errorf("get PVC: %s", err)
+		}
+		if pvc.Spec.VolumeName == "" {
+			continue
+		}
+		var pv corev1.PersistentVolume
+		if err := c.Get(ctx, client.ObjectKey{Name: pvc.Spec.VolumeName}, &pv); err != nil {
+			return nil, nil, fmt.Errorf("get PV: %s", err)
+		}
+		if !isNodeLocalPV(&pv) && pv.Spec.NodeAffinity != nil && pv.Spec.NodeAffinity.Required != nil {
+			pvs = append(pvs, &pv)
+		}
+	}
+
+	var resourceNames []string
+	for _, network := range vm.Spec.Networks {
+		if network.Multus == nil {
+			continue
+		}
+		var nad netv1.NetworkAttachmentDefinition
+		if err := c.Get(ctx, client.ObjectKey{Namespace: vm.Namespace, Name: network.Multus.NetworkName}, &nad); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, []string{fmt.Sprintf("NAD %q not found", network.Multus.NetworkName)}, nil
+			}
+			return nil, nil, fmt.Errorf("get NAD: %s", err)
+		}
+		if resourceName := nad.Annotations["k8s.v1.cni.cncf.io/resourceName"]; resourceName != "" {
+			resourceNames = append(resourceNames, resourceName)
+		}
+	}
+
+	var nodeList corev1.NodeList
+	if err := c.List(ctx, &nodeList); err != nil {
+		return nil, nil, fmt.Errorf("list nodes: %s", err)
+	}
+	sort.Slice(nodeList.Items, func(i, j int) bool {
+		return nodeList.Items[i].Name < nodeList.Items[j].Name
+	})
+
+	var targetNodes, reasons []string
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		if node.Name == sourceNode.Name {
+			continue
+		}
+
+		reason := func() string {
+			if reason := checkNodeConstraints(node, nodeSelector, affinity, vm.Spec.Tolerations); reason != "" {
+				return reason
+			}
+			if arch := sourceNode.Labels[corev1.LabelArchStable]; node.Labels[corev1.LabelArchStable] != arch {
+				return fmt.Sprintf("architecture differs from %s of the source node", arch)
+			}
+			if missingFeatures := getMissingCPUFeatures(&sourceNode, node); len(missingFeatures) > 0 {
+				return fmt.Sprintf("missing CPU features %s", strings.Join(missingFeatures, ", "))
+			}
+			for _, pv := range pvs {
+				if !matchNodeSelectorTerms(node, pv.Spec.NodeAffinity.Required.NodeSelectorTerms) {
+					return fmt.Sprintf("PV %q not accessible", pv.Name)
+				}
+			}
+			for _, resourceName := range resourceNames {
+				if quantity := node.Status.Allocatable[corev1.ResourceName(resourceName)]; quantity.IsZero() {
+					return fmt.Sprintf("no allocatable %s", resourceName)
+				}
+			}
+			return ""
+		}()
+		if reason == "" {
+			targetNodes = append(targetNodes, node.Name)
+		} else {
+			reasons = append(reasons, fmt.Sprintf("node %q: %s", node.Name, reason))
+		}
+	}
+	if len(targetNodes) == 0 && len(reasons) == 0 {
+		reasons = append(reasons, "no other nodes")
+	}
+	return targetNodes, reasons, nil
+}
+
+// checkNodeConstraints returns why a pod with the node selector, affinity
+// and tolerations can't be scheduled to the node, or empty if it can.
+func checkNodeConstraints(node *corev1.Node, nodeSelector map[string]string, affinity *corev1.Affinity, tolerations []corev1.Toleration) string {
+	if node.Spec.Unschedulable {
+		return "unschedulable"
+	}
+	if !isNodeReady(node) {
+		return "not ready"
+	}
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule || toleratesTaint(tolerations, taint) {
+			continue
+		}
+		return fmt.Sprintf("untolerated taint %s", taint.ToString())
+	}
+	if !labels.SelectorFromSet(nodeSelector).Matches(labels.Set(node.Labels)) {
+		return "node selector mismatch"
+	}
+	if affinity != nil && affinity.NodeAffinity != nil && affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil &&
+		!matchNodeSelectorTerms(node, affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms) {
+		return "node affinity mismatch"
+	}
+	return ""
+}
+
+func isNodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func toleratesTaint(tolerations []corev1.Toleration, taint *corev1.Taint) bool {
+	for i := range tolerations {
+		if tolerations[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
+
+// getMissingCPUFeatures returns the CPU features labelled by Node Feature
+// Discovery on the source node but not on the target node.
+func getMissingCPUFeatures(sourceNode *corev1.Node, targetNode *corev1.Node) []string {
+	var missingFeatures []string
+	for key, value := range sourceNode.Labels {
+		if !strings.HasPrefix(key, nfdCPUFeatureLabelPrefix) || value != "true" {
+			continue
+		}
+		if targetNode.Labels[key] != "true" {
+			missingFeatures = append(missingFeatures, strings.TrimPrefix(key, nfdCPUFeatureLabelPrefix))
+		}
+	}
+	sort.Strings(missingFeatures)
+	return missingFeatures
+}
+
+// matchNodeSelectorTerms reports whether the node matches any of the terms,
+// as the scheduler does for required node affinity.
+func matchNodeSelectorTerms(node *corev1.Node, terms []corev1.NodeSelectorTerm) bool {
+	for _, term := range terms {
+		if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+			continue
+		}
+		if matchNodeSelectorRequirements(labels.Set(node.Labels), term.MatchExpressions) &&
+			matchNodeSelectorRequirements(labels.Set{"metadata.name": node.Name}, term.MatchFields) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchNodeSelectorRequirements(set labels.Set, requirements []corev1.NodeSelectorRequirement) bool {
+	operators := map[corev1.NodeSelectorOperator]selection.Operator{
+		corev1.NodeSelectorOpIn:           selection.In,
+		corev1.NodeSelectorOpNotIn:        selection.NotIn,
+		corev1.NodeSelectorOpExists:       selection.Exists,
+		corev1.NodeSelectorOpDoesNotExist: selection.DoesNotExist,
+		corev1.NodeSelectorOpGt:           selection.GreaterThan,
+		corev1.NodeSelectorOpLt:           selection.LessThan,
+	}
+	for _, requirement := range requirements {
+		operator, ok := operators[requirement.Operator]
+		if !ok {
+			return false
+		}
+		r, err := labels.NewRequirement(requirement.Key, operator, requirement.Values)
+		if err != nil || !r.Matches(set) {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeAffinity returns an affinity satisfied only when both are. Required
+// node selector terms are ORed, so each term of one is combined with each
+// term of the other.
+func mergeAffinity(a *corev1.Affinity, b *corev1.Affinity) *corev1.Affinity {
+	if a == nil {
+		return b.DeepCopy()
+	}
+	merged := a.DeepCopy()
+	if b == nil {
+		return merged
+	}
+
+	if b.NodeAffinity != nil {
+		if merged.NodeAffinity == nil {
+			merged.NodeAffinity = &corev1.NodeAffinity{}
+		}
+		nodeAffinity := merged.NodeAffinity
+		if required := b.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil {
+			if nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+				nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = required.DeepCopy()
+			} else {
+				var terms []corev1.NodeSelectorTerm
+				for _, termA := range nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+					for _, termB := range required.NodeSelectorTerms {
+						term := termA.DeepCopy()
+						term.MatchExpressions = append(term.MatchExpressions, termB.MatchExpressions...)
+						term.MatchFields = append(term.MatchFields, termB.MatchFields...)
+						terms = append(terms, *term)
+					}
+				}
+				nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms = terms
+			}
+		}
+		nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution, b.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution...)
+	}
+
+	if b.PodAffinity != nil {
+		if merged.PodAffinity == nil {
+			merged.PodAffinity = &corev1.PodAffinity{}
+		}
+		merged.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(merged.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution, b.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+		merged.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(merged.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution, b.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution...)
+	}
+
+	if b.PodAntiAffinity != nil {
+		if merged.PodAntiAffinity == nil {
+			merged.PodAntiAffinity = &corev1.PodAntiAffinity{}
+		}
+		merged.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(merged.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, b.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+		merged.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(merged.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution, b.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution...)
+	}
+	return merged
+}
@@ -0,0 +1,292 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	netv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+func TestFindMigrationTargets(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+
+	newNode := func(name string, zone string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					corev1.LabelArchStable:            "amd64",
+					corev1.LabelTopologyZone:          zone,
+					nfdCPUFeatureLabelPrefix + "AVX2": "true",
+				},
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{
+					Type:   corev1.NodeReady,
+					Status: corev1.ConditionTrue,
+				}},
+			},
+		}
+	}
+
+	sourceNode := newNode("node-0", "a")
+	readyNode := newNode("node-1", "a")
+	otherZoneNode := newNode("node-2", "b")
+	taintedNode := newNode("node-3", "a")
+	taintedNode.Spec.Taints = []corev1.Taint{{
+		Key:    "dedicated",
+		Value:  "db",
+		Effect: corev1.TaintEffectNoSchedule,
+	}}
+	oldCPUNode := newNode("node-4", "a")
+	delete(oldCPUNode.Labels, nfdCPUFeatureLabelPrefix+"AVX2")
+	notReadyNode := newNode("node-5", "a")
+	notReadyNode.Status.Conditions[0].Status = corev1.ConditionFalse
+	armNode := newNode("node-6", "a")
+	armNode.Labels[corev1.LabelArchStable] = "arm64"
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "data",
+			Namespace: "default",
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			VolumeName: "pv-data",
+		},
+	}
+	pv := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pv-data",
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			NodeAffinity: &corev1.VolumeNodeAffinity{
+				Required: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+						MatchExpressions: []corev1.NodeSelectorRequirement{{
+							Key:      corev1.LabelTopologyZone,
+							Operator: corev1.NodeSelectorOpIn,
+							Values:   []string{"a"},
+						}},
+					}},
+				},
+			},
+		},
+	}
+
+	vm := &virtv1alpha1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vm",
+			Namespace: "default",
+		},
+		Spec: virtv1alpha1.VirtualMachineSpec{
+			Volumes: []virtv1alpha1.Volume{{
+				Name: "data",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					PersistentVolumeClaim: &virtv1alpha1.PersistentVolumeClaimVolumeSource{
+						ClaimName: "data",
+					},
+				},
+			}},
+		},
+		Status: virtv1alpha1.VirtualMachineStatus{
+			NodeName: "node-0",
+		},
+	}
+	vmm := &virtv1alpha1.VirtualMachineMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vmm",
+			Namespace: "default",
+		},
+		Spec: virtv1alpha1.VirtualMachineMigrationSpec{
+			VMName: "vm",
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(sourceNode, readyNode, otherZoneNode, taintedNode, oldCPUNode, notReadyNode, armNode, pvc, pv).Build()
+	targetNodes, reasons, err := findMigrationTargets(context.Background(), c, vm, vmm)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"node-1"}, targetNodes)
+	assert.Equal(t, []string{
+		`node "node-2": PV "pv-data" not accessible`,
+		`node "node-3": untolerated taint dedicated=db:NoSchedule`,
+		`node "node-4": missing CPU features AVX2`,
+		`node "node-5": not ready`,
+		`node "node-6": architecture differs from amd64 of the source node`,
+	}, reasons)
+
+	vmm.Spec.NodeSelector = map[string]string{corev1.LabelTopologyZone: "b"}
+	targetNodes, reasons, err = findMigrationTargets(context.Background(), c, vm, vmm)
+	require.NoError(t, err)
+	assert.Empty(t, targetNodes)
+	assert.Contains(t, reasons, `node "node-1": node selector mismatch`)
+}
+
+func TestFindMigrationTargetsForSRIOV(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(netv1.AddToScheme(scheme))
+
+	sourceNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-0",
+		},
+	}
+	targetNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-1",
+		},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{
+				Type:   corev1.NodeReady,
+				Status: corev1.ConditionTrue,
+			}},
+			Allocatable: corev1.ResourceList{
+				"intel.com/sriov": resource.MustParse("0"),
+			},
+		},
+	}
+
+	vm := &virtv1alpha1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vm",
+			Namespace: "default",
+		},
+		Spec: virtv1alpha1.VirtualMachineSpec{
+			Networks: []virtv1alpha1.Network{{
+				Name: "sriov",
+				NetworkSource: virtv1alpha1.NetworkSource{
+					Multus: &virtv1alpha1.MultusNetworkSource{
+						NetworkName: "sriov",
+					},
+				},
+			}},
+		},
+		Status: virtv1alpha1.VirtualMachineStatus{
+			NodeName: "node-0",
+		},
+	}
+	vmm := &virtv1alpha1.VirtualMachineMigration{}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(sourceNode, targetNode).Build()
+	targetNodes, reasons, err := findMigrationTargets(context.Background(), c, vm, vmm)
+	require.NoError(t, err)
+	assert.Empty(t, targetNodes)
+	assert.Equal(t, []string{`NAD "sriov" not found`}, reasons)
+
+	nad := &netv1.NetworkAttachmentDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "sriov",
+			Namespace:   "default",
+			Annotations: map[string]string{"k8s.v1.cni.cncf.io/resourceName": "intel.com/sriov"},
+		},
+	}
+	require.NoError(t, c.Create(context.Background(), nad))
+	targetNodes, reasons, err = findMigrationTargets(context.Background(), c, vm, vmm)
+	require.NoError(t, err)
+	assert.Empty(t, targetNodes)
+	assert.Equal(t, []string{`node "node-1": no allocatable intel.com/sriov`}, reasons)
+}
+
+func TestMatchNodeSelectorTerms(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node-1",
+			Labels: map[string]string{"zone": "a", "cores": "16"},
+		},
+	}
+
+	tests := []struct {
+		terms   []corev1.NodeSelectorTerm
+		matched bool
+	}{{
+		terms: []corev1.NodeSelectorTerm{{
+			MatchExpressions: []corev1.NodeSelectorRequirement{{
+				Key:      "zone",
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{"a", "b"},
+			}},
+		}},
+		matched: true,
+	}, {
+		terms: []corev1.NodeSelectorTerm{{
+			MatchExpressions: []corev1.NodeSelectorRequirement{{
+				Key:      "zone",
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{"b"},
+			}},
+		}, {
+			MatchFields: []corev1.NodeSelectorRequirement{{
+				Key:      "metadata.name",
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{"node-1"},
+			}},
+		}},
+		matched: true,
+	}, {
+		terms: []corev1.NodeSelectorTerm{{
+			MatchExpressions: []corev1.NodeSelectorRequirement{{
+				Key:      "cores",
+				Operator: corev1.NodeSelectorOpGt,
+				Values:   []string{"32"},
+			}},
+		}},
+	}, {
+		terms: []corev1.NodeSelectorTerm{{}},
+	}, {
+		terms: []corev1.NodeSelectorTerm{{
+			MatchExpressions: []corev1.NodeSelectorRequirement{{
+				Key:      "gpu",
+				Operator: corev1.NodeSelectorOpDoesNotExist,
+			}},
+		}},
+		matched: true,
+	}}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.matched, matchNodeSelectorTerms(node, tc.terms), i)
+	}
+}
+
+func TestMergeAffinity(t *testing.T) {
+	newAffinity := func(terms ...corev1.NodeSelectorTerm) *corev1.Affinity {
+		return &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: terms,
+				},
+			},
+		}
+	}
+	newTerm := func(key string) corev1.NodeSelectorTerm {
+		return corev1.NodeSelectorTerm{
+			MatchExpressions: []corev1.NodeSelectorRequirement{{
+				Key:      key,
+				Operator: corev1.NodeSelectorOpExists,
+			}},
+		}
+	}
+
+	assert.Nil(t, mergeAffinity(nil, nil))
+	assert.Equal(t, newAffinity(newTerm("a")), mergeAffinity(nil, newAffinity(newTerm("a"))))
+	assert.Equal(t, newAffinity(newTerm("a")), mergeAffinity(newAffinity(newTerm("a")), nil))
+
+	merged := mergeAffinity(newAffinity(newTerm("a"), newTerm("b")), newAffinity(newTerm("c")))
+	terms := merged.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	require.Len(t, terms, 2)
+	assert.Equal(t, append(newTerm("a").MatchExpressions, newTerm("c").MatchExpressions...), terms[0].MatchExpressions)
+	assert.Equal(t, append(newTerm("b").MatchExpressions, newTerm("c").MatchExpressions...), terms[1].MatchExpressions)
+}
@@ -0,0 +1,241 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/fencing"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+// defaultNodeNotReadyTimeout is how long a node must be NotReady before it's
+// fenced if not configured. It matches the default toleration of pods for
+// NotReady nodes, after which their pods are evicted.
+const defaultNodeNotReadyTimeout = 5 * time.Minute
+
+// outOfServiceTaint is added to fenced nodes, so that volumes are detached
+// from them by the attach/detach controller and nothing else is scheduled to
+// them until they are ready again.
+var outOfServiceTaint = corev1.Taint{
+	Key:    corev1.TaintNodeOutOfService,
+	Value:  "nodeshutdown",
+	Effect: corev1.TaintEffectNoExecute,
+}
+
+// NodeFailureReconciler recovers VMs from nodes that have been NotReady for
+// too long, according to the fencing config of the Virtink config. VM pods are
+// only deleted once the node is fenced, so that a VM never runs on two nodes.
+type NodeFailureReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	RateLimiter ratelimiter.RateLimiter
+}
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtinkconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
+
+func (r *NodeFailureReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var node corev1.Node
+	if err := r.Get(ctx, req.NamespacedName, &node); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if isNodeReady(&node) {
+		if err := r.unfenceNode(ctx, &node); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	config, err := virtinkconfig.Get(ctx, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("get Virtink config: %s", err)
+	}
+	fencingConfig := config.Spec.Fencing
+	if fencingConfig.Mode == "" || fencingConfig.Mode == virtv1beta1.FencingNone {
+		return ctrl.Result{}, nil
+	}
+
+	vms, err := r.getNodeVMs(ctx, node.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(vms) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	if node.Annotations[fencing.FencedAnnotation] != "true" {
+		timeout := defaultNodeNotReadyTimeout
+		if fencingConfig.NodeNotReadySeconds > 0 {
+			timeout = time.Duration(fencingConfig.NodeNotReadySeconds) * time.Second
+		}
+		if notReadyDuration := getNodeNotReadyDuration(&node); notReadyDuration < timeout {
+			return ctrl.Result{RequeueAfter: timeout - notReadyDuration}, nil
+		}
+
+		switch fencingConfig.Mode {
+		case virtv1beta1.FencingWebhook:
+			if fencingConfig.Webhook == nil {
+				return ctrl.Result{}, nil
+			}
+			fencer, err := fencing.NewWebhookFencer(fencingConfig.Webhook)
+			if err != nil {
+				return ctrl.Result{}, fmt.Errorf("create fencer: %s", err)
+			}
+			if err := r.fenceNode(ctx, &node, fencer); err != nil {
+				return ctrl.Result{}, err
+			}
+		default:
+			// wait for the node to be annotated as fenced
+			return ctrl.Result{}, nil
+		}
+	}
+
+	if err := r.recoverVMs(ctx, &node, vms); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// getNodeVMs returns the VMs whose VM pods are on the node.
+func (r *NodeFailureReconciler) getNodeVMs(ctx context.Context, nodeName string) ([]*virtv1alpha1.VirtualMachine, error) {
+	var vmList virtv1alpha1.VirtualMachineList
+	if err := r.List(ctx, &vmList); err != nil {
+		return nil, fmt.Errorf("list VMs: %s", err)
+	}
+
+	var vms []*virtv1alpha1.VirtualMachine
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+		if vm.Status.NodeName != nodeName || vm.Status.VMPodName == "" {
+			continue
+		}
+		switch vm.Status.Phase {
+		case virtv1alpha1.VirtualMachineScheduled, virtv1alpha1.VirtualMachineRunning, virtv1alpha1.VirtualMachineUnknown:
+			vms = append(vms, vm)
+		}
+	}
+	return vms, nil
+}
+
+func (r *NodeFailureReconciler) fenceNode(ctx context.Context, node *corev1.Node, fencer fencing.Fencer) error {
+	r.Recorder.Eventf(node, corev1.EventTypeNormal, "Fencing", "Fencing node NotReady for %s", getNodeNotReadyDuration(node).Round(time.Second))
+	if err := fencer.Fence(ctx, node); err != nil {
+		r.Recorder.Eventf(node, corev1.EventTypeWarning, "FailedFence", "Failed to fence node: %s", err)
+		return fmt.Errorf("fence node: %s", err)
+	}
+
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+	node.Annotations[fencing.FencedAnnotation] = "true"
+	if err := r.Update(ctx, node); err != nil {
+		return fmt.Errorf("annotate node as fenced: %s", err)
+	}
+	r.Recorder.Eventf(node, corev1.EventTypeNormal, "Fenced", "Fenced node")
+	return nil
+}
+
+// recoverVMs taints the fenced node out of service and deletes the VM pods on
+// it, which can't be deleted gracefully without the kubelet. The VMs then
+// fail, and are restarted on other nodes according to their run policies.
+func (r *NodeFailureReconciler) recoverVMs(ctx context.Context, node *corev1.Node, vms []*virtv1alpha1.VirtualMachine) error {
+	if !hasTaint(node, &outOfServiceTaint) {
+		node.Spec.Taints = append(node.Spec.Taints, outOfServiceTaint)
+		if err := r.Update(ctx, node); err != nil {
+			return fmt.Errorf("taint node out of service: %s", err)
+		}
+	}
+
+	for _, vm := range vms {
+		var vmPod corev1.Pod
+		vmPodKey := types.NamespacedName{
+			Name:      vm.Status.VMPodName,
+			Namespace: vm.Namespace,
+		}
+		if err := r.Get(ctx, vmPodKey, &vmPod); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("get VM pod: %s", err)
+		}
+		if vmPod.Spec.NodeName != node.Name {
+			continue
+		}
+		if err := r.Delete(ctx, &vmPod, client.GracePeriodSeconds(0)); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("delete VM pod %q: %s", vmPod.Name, err)
+		}
+		r.Recorder.Eventf(vm, corev1.EventTypeWarning, "NodeFenced", "Deleted VM pod %q on fenced node %q", vmPod.Name, node.Name)
+	}
+	return nil
+}
+
+// unfenceNode removes the fenced annotation and the out of service taint from
+// a node that is ready again.
+func (r *NodeFailureReconciler) unfenceNode(ctx context.Context, node *corev1.Node) error {
+	if _, ok := node.Annotations[fencing.FencedAnnotation]; !ok {
+		return nil
+	}
+
+	delete(node.Annotations, fencing.FencedAnnotation)
+	var taints []corev1.Taint
+	for _, taint := range node.Spec.Taints {
+		if !taint.MatchTaint(&outOfServiceTaint) {
+			taints = append(taints, taint)
+		}
+	}
+	node.Spec.Taints = taints
+	if err := r.Update(ctx, node); err != nil {
+		return fmt.Errorf("unfence node: %s", err)
+	}
+	r.Recorder.Eventf(node, corev1.EventTypeNormal, "Unfenced", "Node is ready again")
+	return nil
+}
+
+// getNodeNotReadyDuration returns how long the node has not been ready, since
+// its ready condition last changed.
+func getNodeNotReadyDuration(node *corev1.Node) time.Duration {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return time.Since(condition.LastTransitionTime.Time)
+		}
+	}
+	return time.Since(node.CreationTimestamp.Time)
+}
+
+func hasTaint(node *corev1.Node, taint *corev1.Taint) bool {
+	for _, t := range node.Spec.Taints {
+		if t.MatchTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *NodeFailureReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("nodefailure").
+		For(&corev1.Node{}).
+		WithOptions(controller.Options{
+			RateLimiter: r.RateLimiter,
+		}).
+		Complete(r)
+}
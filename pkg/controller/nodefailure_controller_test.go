@@ -0,0 +1,158 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/fencing"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+func TestNodeFailureReconciler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(virtv1beta1.AddToScheme(scheme))
+
+	var fencedNodeNames []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fencedNodeNames = append(fencedNodeNames, "node-1")
+	}))
+	defer server.Close()
+
+	newNode := func(mode virtv1beta1.FencingMode, notReadyFor time.Duration) (*corev1.Node, client.Client) {
+		config := &virtv1beta1.VirtinkConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: virtinkconfig.Name,
+			},
+			Spec: virtv1beta1.VirtinkConfigSpec{
+				Fencing: virtv1beta1.VirtinkConfigFencing{
+					Mode:                mode,
+					NodeNotReadySeconds: 60,
+					Webhook: &virtv1beta1.VirtinkConfigFencingWebhook{
+						URL: server.URL,
+					},
+				},
+			},
+		}
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "node-1",
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{
+					Type:               corev1.NodeReady,
+					Status:             corev1.ConditionUnknown,
+					LastTransitionTime: metav1.NewTime(time.Now().Add(-notReadyFor)),
+				}},
+			},
+		}
+		var objs []client.Object
+		for _, nodeName := range []string{"node-1", "node-2"} {
+			vm := &virtv1alpha1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "vm-on-" + nodeName,
+					Namespace: "default",
+				},
+				Spec: virtv1alpha1.VirtualMachineSpec{
+					RunPolicy: virtv1alpha1.RunPolicyAlways,
+				},
+				Status: virtv1alpha1.VirtualMachineStatus{
+					Phase:     virtv1alpha1.VirtualMachineRunning,
+					NodeName:  nodeName,
+					VMPodName: "vm-pod-on-" + nodeName,
+				},
+			}
+			vmPod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      vm.Status.VMPodName,
+					Namespace: "default",
+				},
+				Spec: corev1.PodSpec{
+					NodeName: nodeName,
+				},
+			}
+			objs = append(objs, vm, vmPod)
+		}
+		objs = append(objs, config, node)
+		return node, fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	}
+
+	reconcile := func(c client.Client, node *corev1.Node) (ctrl.Result, error) {
+		r := &NodeFailureReconciler{
+			Client:   c,
+			Scheme:   scheme,
+			Recorder: record.NewFakeRecorder(100),
+		}
+		return r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+	}
+	isVMPodDeleted := func(c client.Client, nodeName string) bool {
+		var vmPod corev1.Pod
+		err := c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "vm-pod-on-" + nodeName}, &vmPod)
+		return apierrors.IsNotFound(err)
+	}
+
+	// not NotReady for long enough
+	node, c := newNode(virtv1beta1.FencingWebhook, 30*time.Second)
+	result, err := reconcile(c, node)
+	require.NoError(t, err)
+	assert.True(t, result.RequeueAfter > 0 && result.RequeueAfter <= 30*time.Second)
+	assert.Empty(t, fencedNodeNames)
+	assert.False(t, isVMPodDeleted(c, "node-1"))
+
+	node, c = newNode(virtv1beta1.FencingWebhook, 2*time.Minute)
+	_, err = reconcile(c, node)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"node-1"}, fencedNodeNames)
+	assert.True(t, isVMPodDeleted(c, "node-1"))
+	assert.False(t, isVMPodDeleted(c, "node-2"))
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(node), node))
+	assert.Equal(t, "true", node.Annotations[fencing.FencedAnnotation])
+	assert.True(t, hasTaint(node, &outOfServiceTaint))
+
+	node.Status.Conditions[0].Status = corev1.ConditionTrue
+	require.NoError(t, c.Update(context.Background(), node))
+	_, err = reconcile(c, node)
+	require.NoError(t, err)
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(node), node))
+	assert.NotContains(t, node.Annotations, fencing.FencedAnnotation)
+	assert.False(t, hasTaint(node, &outOfServiceTaint))
+
+	// the Manual mode waits for the node to be annotated as fenced
+	fencedNodeNames = nil
+	node, c = newNode(virtv1beta1.FencingManual, 2*time.Minute)
+	_, err = reconcile(c, node)
+	require.NoError(t, err)
+	assert.False(t, isVMPodDeleted(c, "node-1"))
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(node), node))
+	node.Annotations = map[string]string{fencing.FencedAnnotation: "true"}
+	require.NoError(t, c.Update(context.Background(), node))
+	_, err = reconcile(c, node)
+	require.NoError(t, err)
+	assert.Empty(t, fencedNodeNames)
+	assert.True(t, isVMPodDeleted(c, "node-1"))
+
+	// no fencing by default
+	node, c = newNode(virtv1beta1.FencingNone, time.Hour)
+	_, err = reconcile(c, node)
+	require.NoError(t, err)
+	assert.False(t, isVMPodDeleted(c, "node-1"))
+}
@@ -0,0 +1,215 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/conditions"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+// nodePressureCooldown is how long to wait for the pressure of a node to be
+// relieved before handling another VM on it. It matches the default eviction
+// pressure transition period of the kubelet, which is how long a pressure
+// condition stays true after the pressure is gone.
+const nodePressureCooldown = 5 * time.Minute
+
+// NodePressureReconciler moves VMs off nodes under pressure, from the lowest
+// priority, according to the node pressure policy of the Virtink config.
+type NodePressureReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	RateLimiter ratelimiter.RateLimiter
+
+	mutex           sync.Mutex
+	lastActionTimes map[string]time.Time
+}
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinemigrations,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtinkconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
+
+func (r *NodePressureReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var node corev1.Node
+	if err := r.Get(ctx, req.NamespacedName, &node); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !isNodeUnderPressure(&node) {
+		return ctrl.Result{}, nil
+	}
+
+	config, err := virtinkconfig.Get(ctx, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("get Virtink config: %s", err)
+	}
+	policy := config.Spec.NodePressure.Policy
+	if policy == "" || policy == virtv1beta1.NodePressureNone {
+		return ctrl.Result{}, nil
+	}
+
+	r.mutex.Lock()
+	lastActionTime, ok := r.lastActionTimes[node.Name]
+	r.mutex.Unlock()
+	if ok && time.Since(lastActionTime) < nodePressureCooldown {
+		return ctrl.Result{RequeueAfter: nodePressureCooldown - time.Since(lastActionTime)}, nil
+	}
+
+	var vmList virtv1alpha1.VirtualMachineList
+	if err := r.List(ctx, &vmList, client.MatchingFields{".status.nodeName": node.Name}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("list VMs: %s", err)
+	}
+
+	var vmmList virtv1alpha1.VirtualMachineMigrationList
+	if err := r.List(ctx, &vmmList); err != nil {
+		return ctrl.Result{}, fmt.Errorf("list VMMs: %s", err)
+	}
+	migratingVMs := map[client.ObjectKey]bool{}
+	for _, vmm := range vmmList.Items {
+		if !vmm.Spec.DryRun && vmm.Status.Phase != virtv1alpha1.VirtualMachineMigrationSucceeded && vmm.Status.Phase != virtv1alpha1.VirtualMachineMigrationFailed {
+			migratingVMs[client.ObjectKey{Namespace: vmm.Namespace, Name: vmm.Spec.VMName}] = true
+		}
+	}
+
+	var candidates []*virtv1alpha1.VirtualMachine
+	priorities := map[*virtv1alpha1.VirtualMachine]int32{}
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+		if vm.Status.Phase != virtv1alpha1.VirtualMachineRunning {
+			continue
+		}
+		if migratingVMs[client.ObjectKeyFromObject(vm)] || vm.Status.PowerAction != "" ||
+			(vm.Status.Migration != nil && vm.Status.Migration.Phase != virtv1alpha1.VirtualMachineMigrationSucceeded && vm.Status.Migration.Phase != virtv1alpha1.VirtualMachineMigrationFailed) {
+			// wait for the VM handled previously to leave the node
+			return ctrl.Result{RequeueAfter: nodePressureCooldown}, nil
+		}
+
+		priority, err := getVMPriority(ctx, r.Client, vm)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("get VM priority: %s", err)
+		}
+		priorities[vm] = priority
+		candidates = append(candidates, vm)
+	}
+	if len(candidates) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	sortVMsByEvictionOrder(candidates, priorities)
+	vm := candidates[0]
+	migratable := conditions.IsTrue(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineLiveMigratable)) &&
+		virtinkconfig.FeatureGateEnabled(config, virtinkconfig.LiveMigration)
+
+	switch {
+	case migratable && (policy == virtv1beta1.NodePressureMigrate || policy == virtv1beta1.NodePressureMigrateOrShutdown):
+		vmm := virtv1alpha1.VirtualMachineMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    vm.Namespace,
+				GenerateName: vm.Name + "-",
+			},
+			Spec: virtv1alpha1.VirtualMachineMigrationSpec{
+				VMName: vm.Name,
+			},
+		}
+		if err := r.Create(ctx, &vmm); err != nil {
+			return ctrl.Result{}, fmt.Errorf("create VMM: %s", err)
+		}
+		r.Recorder.Eventf(vm, corev1.EventTypeNormal, "NodePressureMigration", "Created VMM %q to move VM off node %q under pressure", vmm.Name, node.Name)
+	case policy == virtv1beta1.NodePressureShutdown || policy == virtv1beta1.NodePressureMigrateOrShutdown:
+		vm.Status.PowerAction = virtv1alpha1.VirtualMachineShutdown
+		if err := r.Status().Update(ctx, vm); err != nil {
+			if apierrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("update VM status: %s", err)
+		}
+		r.Recorder.Eventf(vm, corev1.EventTypeNormal, "NodePressureShutdown", "Shutting down VM on node %q under pressure", node.Name)
+	default:
+		// the VM can not be migrated and may not be shut down, leave it to the kubelet
+		return ctrl.Result{}, nil
+	}
+
+	r.mutex.Lock()
+	r.lastActionTimes[node.Name] = time.Now()
+	r.mutex.Unlock()
+	return ctrl.Result{RequeueAfter: nodePressureCooldown}, nil
+}
+
+// getVMPriority returns the priority of the VM pod, which is resolved from
+// the priority class by the API server.
+func getVMPriority(ctx context.Context, c client.Reader, vm *virtv1alpha1.VirtualMachine) (int32, error) {
+	var vmPod corev1.Pod
+	if err := c.Get(ctx, client.ObjectKey{Namespace: vm.Namespace, Name: vm.Status.VMPodName}, &vmPod); err != nil {
+		if apierrors.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if vmPod.Spec.Priority == nil {
+		return 0, nil
+	}
+	return *vmPod.Spec.Priority, nil
+}
+
+func isNodeUnderPressure(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		switch condition.Type {
+		case corev1.NodeMemoryPressure, corev1.NodeDiskPressure, corev1.NodePIDPressure:
+			if condition.Status == corev1.ConditionTrue {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sortVMsByEvictionOrder sorts VMs by priority, lowest first. VMs of the same
+// priority are sorted from the most recently created, which have lost the
+// least by being moved.
+func sortVMsByEvictionOrder(vms []*virtv1alpha1.VirtualMachine, priorities map[*virtv1alpha1.VirtualMachine]int32) {
+	sort.SliceStable(vms, func(i, j int) bool {
+		if priorities[vms[i]] != priorities[vms[j]] {
+			return priorities[vms[i]] < priorities[vms[j]]
+		}
+		return vms[j].CreationTimestamp.Before(&vms[i].CreationTimestamp)
+	})
+}
+
+func (r *NodePressureReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.lastActionTimes = map[string]time.Time{}
+
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &virtv1alpha1.VirtualMachine{}, ".status.nodeName", func(obj client.Object) []string {
+		vm := obj.(*virtv1alpha1.VirtualMachine)
+		return []string{vm.Status.NodeName}
+	}); err != nil {
+		return fmt.Errorf("index VMs by node name: %s", err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("nodepressure").
+		For(&corev1.Node{}).
+		WithOptions(controller.Options{
+			RateLimiter: r.RateLimiter,
+		}).
+		Complete(r)
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+func TestIsNodeUnderPressure(t *testing.T) {
+	tests := []struct {
+		conditions []corev1.NodeCondition
+		expected   bool
+	}{{
+		conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+	}, {
+		conditions: []corev1.NodeCondition{{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionFalse}},
+	}, {
+		conditions: []corev1.NodeCondition{{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionTrue}},
+		expected:   true,
+	}, {
+		conditions: []corev1.NodeCondition{{Type: corev1.NodeDiskPressure, Status: corev1.ConditionTrue}},
+		expected:   true,
+	}, {
+		conditions: []corev1.NodeCondition{{Type: corev1.NodePIDPressure, Status: corev1.ConditionTrue}},
+		expected:   true,
+	}}
+
+	for _, tc := range tests {
+		node := &corev1.Node{Status: corev1.NodeStatus{Conditions: tc.conditions}}
+		assert.Equal(t, tc.expected, isNodeUnderPressure(node))
+	}
+}
+
+func TestSortVMsByEvictionOrder(t *testing.T) {
+	now := time.Now()
+	newVM := func(name string, age time.Duration) *virtv1alpha1.VirtualMachine {
+		return &virtv1alpha1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+		}
+	}
+
+	high := newVM("high", time.Minute)
+	lowOld := newVM("low-old", time.Hour)
+	lowNew := newVM("low-new", time.Minute)
+	vms := []*virtv1alpha1.VirtualMachine{high, lowOld, lowNew}
+	priorities := map[*virtv1alpha1.VirtualMachine]int32{
+		high:   1000,
+		lowOld: 0,
+		lowNew: 0,
+	}
+
+	sortVMsByEvictionOrder(vms, priorities)
+	var names []string
+	for _, vm := range vms {
+		names = append(names, vm.Name)
+	}
+	assert.Equal(t, []string{"low-new", "low-old", "high"}, names)
+}
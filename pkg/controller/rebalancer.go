@@ -0,0 +1,244 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/conditions"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+const (
+	// rebalanceInterval is how often node utilization is checked. It leaves
+	// time for migrations to finish and for the metrics to catch up.
+	rebalanceInterval = 5 * time.Minute
+	// rebalanceOptOutAnnotation keeps a VM from being migrated by the
+	// rebalancer when set to "true".
+	rebalanceOptOutAnnotation = "virtink.io/rebalance-opt-out"
+)
+
+var nodeMetricsListGVK = schema.GroupVersionKind{Group: "metrics.k8s.io", Version: "v1beta1", Kind: "NodeMetricsList"}
+
+// Rebalancer spreads VMs across nodes by migrating VMs off nodes whose
+// utilization exceeds the rebalance thresholds of the Virtink config. It runs
+// on the leader only.
+type Rebalancer struct {
+	client.Client
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=metrics.k8s.io,resources=nodes,verbs=get;list
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinemigrations,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtinkconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
+
+func (r *Rebalancer) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, r.rebalance, rebalanceInterval)
+	return nil
+}
+
+func (r *Rebalancer) rebalance(ctx context.Context) {
+	log := ctrl.LoggerFrom(ctx).WithName("rebalancer")
+
+	config, err := virtinkconfig.Get(ctx, r.Client)
+	if err != nil {
+		log.Error(err, "get Virtink config")
+		return
+	}
+	rebalanceConfig := config.Spec.Rebalance
+	if rebalanceConfig.CPUThresholdPercent == 0 && rebalanceConfig.MemoryThresholdPercent == 0 {
+		return
+	}
+	if !virtinkconfig.FeatureGateEnabled(config, virtinkconfig.LiveMigration) {
+		return
+	}
+
+	utilizations, err := r.getNodeUtilizations(ctx)
+	if err != nil {
+		log.Error(err, "get node utilizations")
+		return
+	}
+
+	var overloadedNodeNames []string
+	hasUnderloadedNode := false
+	for nodeName, utilization := range utilizations {
+		if isNodeOverloaded(utilization, &rebalanceConfig) {
+			overloadedNodeNames = append(overloadedNodeNames, nodeName)
+		} else {
+			hasUnderloadedNode = true
+		}
+	}
+	if len(overloadedNodeNames) == 0 || !hasUnderloadedNode {
+		return
+	}
+
+	var vmList virtv1alpha1.VirtualMachineList
+	if err := r.List(ctx, &vmList); err != nil {
+		log.Error(err, "list VMs")
+		return
+	}
+	var vmmList virtv1alpha1.VirtualMachineMigrationList
+	if err := r.List(ctx, &vmmList); err != nil {
+		log.Error(err, "list VMMs")
+		return
+	}
+	migratingVMs := map[client.ObjectKey]bool{}
+	for _, vmm := range vmmList.Items {
+		if !vmm.Spec.DryRun && vmm.Status.Phase != virtv1alpha1.VirtualMachineMigrationSucceeded && vmm.Status.Phase != virtv1alpha1.VirtualMachineMigrationFailed {
+			migratingVMs[client.ObjectKey{Namespace: vmm.Namespace, Name: vmm.Spec.VMName}] = true
+		}
+	}
+
+	for _, nodeName := range overloadedNodeNames {
+		vm, err := r.selectVMToMigrate(ctx, nodeName, vmList.Items, migratingVMs)
+		if err != nil {
+			log.Error(err, "select VM to migrate", "node", nodeName)
+			continue
+		}
+		if vm == nil {
+			continue
+		}
+
+		vmm := virtv1alpha1.VirtualMachineMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    vm.Namespace,
+				GenerateName: vm.Name + "-",
+			},
+			Spec: virtv1alpha1.VirtualMachineMigrationSpec{
+				VMName: vm.Name,
+			},
+		}
+		if err := r.Create(ctx, &vmm); err != nil {
+			log.Error(err, "create VMM", "vm", client.ObjectKeyFromObject(vm))
+			continue
+		}
+		log.Info("created VMM to rebalance VM", "vm", client.ObjectKeyFromObject(vm), "vmm", vmm.Name, "node", nodeName)
+		r.Recorder.Eventf(vm, corev1.EventTypeNormal, "Rebalance", "Created VMM %q to move VM off overloaded node %q", vmm.Name, nodeName)
+	}
+}
+
+// selectVMToMigrate returns the VM to migrate off the node, which is the
+// running, live migratable VM of the lowest priority that has not opted out.
+// No VM is returned if any VM on the node is migrating already.
+func (r *Rebalancer) selectVMToMigrate(ctx context.Context, nodeName string, vms []virtv1alpha1.VirtualMachine, migratingVMs map[client.ObjectKey]bool) (*virtv1alpha1.VirtualMachine, error) {
+	var candidates []*virtv1alpha1.VirtualMachine
+	priorities := map[*virtv1alpha1.VirtualMachine]int32{}
+	for i := range vms {
+		vm := &vms[i]
+		if vm.Status.NodeName != nodeName || vm.Status.Phase != virtv1alpha1.VirtualMachineRunning {
+			continue
+		}
+		if migratingVMs[client.ObjectKeyFromObject(vm)] {
+			return nil, nil
+		}
+		if vm.Annotations[rebalanceOptOutAnnotation] == "true" || vm.Status.PowerAction != "" ||
+			!conditions.IsTrue(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineLiveMigratable)) {
+			continue
+		}
+
+		priority, err := getVMPriority(ctx, r.Client, vm)
+		if err != nil {
+			return nil, fmt.Errorf("get VM priority: %s", err)
+		}
+		priorities[vm] = priority
+		candidates = append(candidates, vm)
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	sortVMsByEvictionOrder(candidates, priorities)
+	return candidates[0], nil
+}
+
+type nodeUtilization struct {
+	CPUPercent    int64
+	MemoryPercent int64
+}
+
+// getNodeUtilizations returns the utilization of schedulable nodes from the
+// metrics API, relative to their allocatable resources.
+func (r *Rebalancer) getNodeUtilizations(ctx context.Context) (map[string]nodeUtilization, error) {
+	var nodeList corev1.NodeList
+	if err := r.List(ctx, &nodeList); err != nil {
+		return nil, fmt.Errorf("list nodes: %s", err)
+	}
+
+	// unstructured objects are read from the API server directly
+	var nodeMetricsList unstructured.UnstructuredList
+	nodeMetricsList.SetGroupVersionKind(nodeMetricsListGVK)
+	if err := r.List(ctx, &nodeMetricsList); err != nil {
+		return nil, fmt.Errorf("list node metrics: %s", err)
+	}
+	usages := map[string]corev1.ResourceList{}
+	for _, nodeMetrics := range nodeMetricsList.Items {
+		usage, err := parseNodeMetricsUsage(&nodeMetrics)
+		if err != nil {
+			return nil, fmt.Errorf("parse metrics of node %q: %s", nodeMetrics.GetName(), err)
+		}
+		usages[nodeMetrics.GetName()] = usage
+	}
+
+	utilizations := map[string]nodeUtilization{}
+	for _, node := range nodeList.Items {
+		usage, ok := usages[node.Name]
+		if !ok || node.Spec.Unschedulable {
+			continue
+		}
+		utilizations[node.Name] = getNodeUtilization(usage, node.Status.Allocatable)
+	}
+	return utilizations, nil
+}
+
+func parseNodeMetricsUsage(nodeMetrics *unstructured.Unstructured) (corev1.ResourceList, error) {
+	rawUsage, _, err := unstructured.NestedStringMap(nodeMetrics.Object, "usage")
+	if err != nil {
+		return nil, err
+	}
+	usage := corev1.ResourceList{}
+	for name, value := range rawUsage {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s usage: %s", name, err)
+		}
+		usage[corev1.ResourceName(name)] = quantity
+	}
+	return usage, nil
+}
+
+func getNodeUtilization(usage corev1.ResourceList, allocatable corev1.ResourceList) nodeUtilization {
+	var utilization nodeUtilization
+	if allocatableCPU := allocatable.Cpu().MilliValue(); allocatableCPU > 0 {
+		utilization.CPUPercent = usage.Cpu().MilliValue() * 100 / allocatableCPU
+	}
+	if allocatableMemory := allocatable.Memory().Value(); allocatableMemory > 0 {
+		utilization.MemoryPercent = usage.Memory().Value() * 100 / allocatableMemory
+	}
+	return utilization
+}
+
+func isNodeOverloaded(utilization nodeUtilization, config *virtv1beta1.VirtinkConfigRebalance) bool {
+	if config.CPUThresholdPercent > 0 && utilization.CPUPercent > int64(config.CPUThresholdPercent) {
+		return true
+	}
+	if config.MemoryThresholdPercent > 0 && utilization.MemoryPercent > int64(config.MemoryThresholdPercent) {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+func TestGetNodeUtilization(t *testing.T) {
+	nodeMetrics := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "node-1"},
+		"usage": map[string]interface{}{
+			"cpu":    "3500m",
+			"memory": "12Gi",
+		},
+	}}
+	usage, err := parseNodeMetricsUsage(nodeMetrics)
+	assert.NoError(t, err)
+
+	allocatable := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("4"),
+		corev1.ResourceMemory: resource.MustParse("16Gi"),
+	}
+	utilization := getNodeUtilization(usage, allocatable)
+	assert.Equal(t, nodeUtilization{CPUPercent: 87, MemoryPercent: 75}, utilization)
+
+	assert.False(t, isNodeOverloaded(utilization, &virtv1beta1.VirtinkConfigRebalance{}))
+	assert.True(t, isNodeOverloaded(utilization, &virtv1beta1.VirtinkConfigRebalance{CPUThresholdPercent: 80}))
+	assert.False(t, isNodeOverloaded(utilization, &virtv1beta1.VirtinkConfigRebalance{CPUThresholdPercent: 90}))
+	assert.True(t, isNodeOverloaded(utilization, &virtv1beta1.VirtinkConfigRebalance{CPUThresholdPercent: 90, MemoryThresholdPercent: 70}))
+}
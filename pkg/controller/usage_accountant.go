@@ -0,0 +1,243 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+const (
+	defaultUsageFlushInterval = time.Minute
+	defaultUsageRetentionDays = 30
+	usageSinkTimeout          = 10 * time.Second
+)
+
+// UsageAccountant records what VMs consume in VirtualMachineUsages, and posts
+// the updated usages to the usage sink if any, as configured in the Virtink
+// config. VMs are sampled at every flush, so usage is as accurate as the
+// flush interval. It runs on the leader only.
+type UsageAccountant struct {
+	client.Client
+	// HTTPClient posts usages to the sink. Defaults to a client with a
+	// timeout of usageSinkTimeout.
+	HTTPClient *http.Client
+}
+
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachineusages,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachineusages/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtinkconfigs,verbs=get;list;watch
+
+func (a *UsageAccountant) Start(ctx context.Context) error {
+	if a.HTTPClient == nil {
+		a.HTTPClient = &http.Client{Timeout: usageSinkTimeout}
+	}
+	for {
+		interval := a.flush(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// flush records the usage of VMs since the last flush if usage accounting is
+// enabled, and returns when to flush next.
+func (a *UsageAccountant) flush(ctx context.Context) time.Duration {
+	log := ctrl.LoggerFrom(ctx).WithName("usage-accountant")
+
+	config, err := virtinkconfig.Get(ctx, a.Client)
+	if err != nil {
+		log.Error(err, "get Virtink config")
+		return defaultUsageFlushInterval
+	}
+	usageConfig := config.Spec.UsageAccounting
+	interval := defaultUsageFlushInterval
+	if usageConfig.FlushIntervalSeconds > 0 {
+		interval = time.Duration(usageConfig.FlushIntervalSeconds) * time.Second
+	}
+	if !usageConfig.Enabled {
+		return interval
+	}
+
+	// times are stored in seconds, so that no fraction of a second is lost
+	// between flushes
+	now := time.Now().Truncate(time.Second)
+
+	var vmList virtv1alpha1.VirtualMachineList
+	if err := a.List(ctx, &vmList); err != nil {
+		log.Error(err, "list VMs")
+		return interval
+	}
+	var usageList virtv1beta1.VirtualMachineUsageList
+	if err := a.List(ctx, &usageList); err != nil {
+		log.Error(err, "list VM usages")
+		return interval
+	}
+	usages := map[client.ObjectKey]*virtv1beta1.VirtualMachineUsage{}
+	for i := range usageList.Items {
+		usages[client.ObjectKeyFromObject(&usageList.Items[i])] = &usageList.Items[i]
+	}
+
+	var flushedUsages []virtv1beta1.VirtualMachineUsage
+	vmUIDs := map[types.UID]bool{}
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+		vmUIDs[vm.UID] = true
+
+		usageKey := client.ObjectKey{Namespace: vm.Namespace, Name: getVMUsageName(vm)}
+		usage, ok := usages[usageKey]
+		if !ok {
+			usage = &virtv1beta1.VirtualMachineUsage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: usageKey.Namespace,
+					Name:      usageKey.Name,
+				},
+			}
+			if err := a.Create(ctx, usage); err != nil {
+				log.Error(err, "create VM usage", "usage", usageKey)
+				continue
+			}
+		}
+		accountVMUsage(usage, vm, now)
+		if err := a.Status().Update(ctx, usage); err != nil {
+			log.Error(err, "update VM usage status", "usage", usageKey)
+			continue
+		}
+		flushedUsages = append(flushedUsages, *usage)
+	}
+
+	retentionDays := defaultUsageRetentionDays
+	if usageConfig.RetentionDays > 0 {
+		retentionDays = usageConfig.RetentionDays
+	}
+	for i := range usageList.Items {
+		usage := &usageList.Items[i]
+		if usage.Status.VirtualMachineUID == "" || vmUIDs[usage.Status.VirtualMachineUID] {
+			continue
+		}
+		if usage.Status.EndTime == nil {
+			usage.Status.EndTime = &metav1.Time{Time: now}
+			usage.Status.LastFlushTime = &metav1.Time{Time: now}
+			usage.Status.Running = false
+			if err := a.Status().Update(ctx, usage); err != nil {
+				log.Error(err, "update VM usage status", "usage", client.ObjectKeyFromObject(usage))
+				continue
+			}
+			flushedUsages = append(flushedUsages, *usage)
+		} else if now.Sub(usage.Status.EndTime.Time) > time.Duration(retentionDays)*24*time.Hour {
+			if err := a.Delete(ctx, usage); err != nil && !apierrors.IsNotFound(err) {
+				log.Error(err, "delete VM usage", "usage", client.ObjectKeyFromObject(usage))
+			}
+		}
+	}
+
+	if usageConfig.SinkURL != "" && len(flushedUsages) > 0 {
+		if err := a.postUsages(ctx, usageConfig.SinkURL, flushedUsages); err != nil {
+			log.Error(err, "post VM usages to sink")
+		}
+	}
+	return interval
+}
+
+// getVMUsageName returns the name of the usage of the VM, which tells apart
+// VMs of the same name created one after another.
+func getVMUsageName(vm *virtv1alpha1.VirtualMachine) string {
+	uid := string(vm.UID)
+	if len(uid) > 8 {
+		uid = uid[:8]
+	}
+	return vm.Name + "-" + uid
+}
+
+// accountVMUsage adds the usage of the VM since the last flush to the usage.
+// The time between two flushes the VM was running at is counted as running,
+// including paused, as the resources of the VM are held all the same.
+func accountVMUsage(usage *virtv1beta1.VirtualMachineUsage, vm *virtv1alpha1.VirtualMachine, now time.Time) {
+	status := &usage.Status
+	status.VirtualMachineName = vm.Name
+	status.VirtualMachineUID = vm.UID
+	if status.StartTime == nil {
+		status.StartTime = &metav1.Time{Time: now}
+	}
+
+	running := vm.Status.Phase == virtv1alpha1.VirtualMachineRunning
+	if status.Running && running && status.LastFlushTime != nil {
+		seconds := int64(now.Sub(status.LastFlushTime.Time) / time.Second)
+		if seconds > 0 {
+			cpu := vm.Spec.Instance.CPU
+			status.RunningSeconds += seconds
+			status.VCPUSeconds += seconds * int64(cpu.Sockets*cpu.CoresPerSocket)
+			status.MemoryMiBSeconds += seconds * (vm.Spec.Instance.Memory.Size.Value() >> 20)
+			for _, volume := range vm.Spec.Volumes {
+				accountVolumeUsage(status, &volume, seconds)
+			}
+		}
+	}
+	status.Running = running
+	status.LastFlushTime = &metav1.Time{Time: now}
+}
+
+func accountVolumeUsage(status *virtv1beta1.VirtualMachineUsageStatus, volume *virtv1alpha1.Volume, seconds int64) {
+	var claimName string
+	switch {
+	case volume.PersistentVolumeClaim != nil:
+		claimName = volume.PersistentVolumeClaim.ClaimName
+	case volume.DataVolume != nil:
+		claimName = volume.DataVolume.VolumeName
+	}
+
+	for i := range status.Volumes {
+		if status.Volumes[i].Name == volume.Name {
+			status.Volumes[i].ClaimName = claimName
+			status.Volumes[i].AttachedSeconds += seconds
+			return
+		}
+	}
+	status.Volumes = append(status.Volumes, virtv1beta1.VirtualMachineUsageVolume{
+		Name:            volume.Name,
+		ClaimName:       claimName,
+		AttachedSeconds: seconds,
+	})
+}
+
+// postUsages posts the usages to the sink. The counters of usages only grow,
+// so a sink missing a post catches up with the next one.
+func (a *UsageAccountant) postUsages(ctx context.Context, sinkURL string, usages []virtv1beta1.VirtualMachineUsage) error {
+	usageList := virtv1beta1.VirtualMachineUsageList{
+		TypeMeta: metav1.TypeMeta{Kind: "VirtualMachineUsageList", APIVersion: virtv1beta1.SchemeGroupVersion.String()},
+		Items:    usages,
+	}
+	data, err := json.Marshal(&usageList)
+	if err != nil {
+		return fmt.Errorf("marshal usages: %s", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sinkURL, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("create request: %s", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := a.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %q", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+func newUsageTestVM() *virtv1alpha1.VirtualMachine {
+	return &virtv1alpha1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "vm-1",
+			UID:       "8f0e6f5c-51a4-4c4e-9d8b-3f1f2e0f6a11",
+		},
+		Spec: virtv1alpha1.VirtualMachineSpec{
+			Instance: virtv1alpha1.Instance{
+				CPU: virtv1alpha1.CPU{
+					Sockets:        2,
+					CoresPerSocket: 2,
+				},
+				Memory: virtv1alpha1.Memory{
+					Size: resource.MustParse("2Gi"),
+				},
+			},
+			Volumes: []virtv1alpha1.Volume{{
+				Name: "root",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					PersistentVolumeClaim: &virtv1alpha1.PersistentVolumeClaimVolumeSource{
+						ClaimName: "vm-1-root",
+					},
+				},
+			}},
+		},
+		Status: virtv1alpha1.VirtualMachineStatus{
+			Phase: virtv1alpha1.VirtualMachineRunning,
+		},
+	}
+}
+
+func TestAccountVMUsage(t *testing.T) {
+	vm := newUsageTestVM()
+	var usage virtv1beta1.VirtualMachineUsage
+	start := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	accountVMUsage(&usage, vm, start)
+	assert.Equal(t, start, usage.Status.StartTime.Time)
+	assert.True(t, usage.Status.Running)
+	assert.Equal(t, int64(0), usage.Status.RunningSeconds)
+
+	accountVMUsage(&usage, vm, start.Add(time.Minute))
+	assert.Equal(t, int64(60), usage.Status.RunningSeconds)
+	assert.Equal(t, int64(240), usage.Status.VCPUSeconds)
+	assert.Equal(t, int64(2048*60), usage.Status.MemoryMiBSeconds)
+	assert.Equal(t, []virtv1beta1.VirtualMachineUsageVolume{{
+		Name:            "root",
+		ClaimName:       "vm-1-root",
+		AttachedSeconds: 60,
+	}}, usage.Status.Volumes)
+
+	vm.Status.Phase = virtv1alpha1.VirtualMachineSucceeded
+	accountVMUsage(&usage, vm, start.Add(2*time.Minute))
+	assert.False(t, usage.Status.Running)
+	assert.Equal(t, int64(60), usage.Status.RunningSeconds)
+
+	// not running at the last flush
+	vm.Status.Phase = virtv1alpha1.VirtualMachineRunning
+	accountVMUsage(&usage, vm, start.Add(3*time.Minute))
+	assert.Equal(t, int64(60), usage.Status.RunningSeconds)
+
+	accountVMUsage(&usage, vm, start.Add(4*time.Minute))
+	assert.Equal(t, int64(120), usage.Status.RunningSeconds)
+	assert.Equal(t, int64(120), usage.Status.Volumes[0].AttachedSeconds)
+	assert.Equal(t, start.Add(4*time.Minute), usage.Status.LastFlushTime.Time)
+}
+
+func TestUsageAccountantFlush(t *testing.T) {
+	var posted virtv1beta1.VirtualMachineUsageList
+	sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&posted))
+	}))
+	defer sink.Close()
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(virtv1beta1.AddToScheme(scheme))
+
+	config := &virtv1beta1.VirtinkConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: virtinkconfig.Name,
+		},
+		Spec: virtv1beta1.VirtinkConfigSpec{
+			UsageAccounting: virtv1beta1.VirtinkConfigUsageAccounting{
+				Enabled:              true,
+				FlushIntervalSeconds: 30,
+				SinkURL:              sink.URL,
+			},
+		},
+	}
+	deletedVMUsage := &virtv1beta1.VirtualMachineUsage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "vm-2-0a6c2d1e",
+		},
+		Status: virtv1beta1.VirtualMachineUsageStatus{
+			VirtualMachineName: "vm-2",
+			VirtualMachineUID:  "0a6c2d1e-7b0f-4f0e-8c52-5e0c2b9d7f21",
+			Running:            true,
+			RunningSeconds:     3600,
+		},
+	}
+	expiredUsage := &virtv1beta1.VirtualMachineUsage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "vm-3-5b3e9a70",
+		},
+		Status: virtv1beta1.VirtualMachineUsageStatus{
+			VirtualMachineName: "vm-3",
+			VirtualMachineUID:  "5b3e9a70-1d2c-4a8f-b6e4-7c9d0e1f2a33",
+			EndTime:            &metav1.Time{Time: time.Now().Add(-31 * 24 * time.Hour)},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(config, newUsageTestVM(), deletedVMUsage, expiredUsage).Build()
+	a := &UsageAccountant{
+		Client:     c,
+		HTTPClient: sink.Client(),
+	}
+
+	assert.Equal(t, 30*time.Second, a.flush(context.Background()))
+
+	var usage virtv1beta1.VirtualMachineUsage
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "vm-1-8f0e6f5c"}, &usage))
+	assert.Equal(t, "vm-1", usage.Status.VirtualMachineName)
+	assert.True(t, usage.Status.Running)
+
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(deletedVMUsage), &usage))
+	assert.NotNil(t, usage.Status.EndTime)
+	assert.False(t, usage.Status.Running)
+	assert.Equal(t, int64(3600), usage.Status.RunningSeconds)
+
+	err := c.Get(context.Background(), client.ObjectKeyFromObject(expiredUsage), &usage)
+	assert.True(t, apierrors.IsNotFound(err))
+
+	assert.Equal(t, "VirtualMachineUsageList", posted.Kind)
+	assert.Len(t, posted.Items, 2)
+}
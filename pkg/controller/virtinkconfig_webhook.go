@@ -0,0 +1,193 @@
+package controller
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/logging"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+// +kubebuilder:webhook:path=/validate-v1beta1-virtinkconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=virt.virtink.smartx.com,resources=virtinkconfigs,verbs=create;update,versions=v1beta1,name=validate.virtinkconfig.v1beta1.virt.virtink.smartx.com,admissionReviewVersions={v1,v1beta1}
+
+type VirtinkConfigValidator struct {
+	decoder *admission.Decoder
+}
+
+var _ admission.DecoderInjector = &VirtinkConfigValidator{}
+var _ admission.Handler = &VirtinkConfigValidator{}
+
+func (h *VirtinkConfigValidator) InjectDecoder(decoder *admission.Decoder) error {
+	h.decoder = decoder
+	return nil
+}
+
+func (h *VirtinkConfigValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	var config virtv1beta1.VirtinkConfig
+	if err := h.decoder.Decode(req, &config); err != nil {
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("unmarshal Virtink config: %s", err))
+	}
+
+	var errs field.ErrorList
+	switch req.Operation {
+	case admissionv1.Create, admissionv1.Update:
+		errs = ValidateVirtinkConfig(ctx, &config)
+	default:
+		return admission.Allowed("")
+	}
+
+	if len(errs) > 0 {
+		return webhook.Denied(errs.ToAggregate().Error())
+	}
+	return admission.Allowed("")
+}
+
+func ValidateVirtinkConfig(ctx context.Context, config *virtv1beta1.VirtinkConfig) field.ErrorList {
+	var errs field.ErrorList
+	if config.Name != virtinkconfig.Name {
+		errs = append(errs, field.Invalid(field.NewPath("metadata").Child("name"), config.Name, fmt.Sprintf("must be %q", virtinkconfig.Name)))
+	}
+	errs = append(errs, ValidateVirtinkConfigSpec(ctx, &config.Spec, field.NewPath("spec"))...)
+	return errs
+}
+
+func ValidateVirtinkConfigSpec(ctx context.Context, spec *virtv1beta1.VirtinkConfigSpec, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if spec == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	for featureGate := range spec.FeatureGates {
+		if !virtinkconfig.IsKnownFeatureGate(featureGate) {
+			errs = append(errs, field.NotSupported(fieldPath.Child("featureGates").Key(featureGate), featureGate, nil))
+		}
+	}
+
+	errs = append(errs, ValidateVirtinkConfigFencing(&spec.Fencing, fieldPath.Child("fencing"))...)
+
+	if spec.Logging.Level != "" {
+		if _, err := logging.ParseLevel(spec.Logging.Level); err != nil {
+			errs = append(errs, field.Invalid(fieldPath.Child("logging").Child("level"), spec.Logging.Level, err.Error()))
+		}
+	}
+
+	if spec.Network.DefaultMasqueradeCIDR != "" {
+		errs = append(errs, ValidateCIDR(spec.Network.DefaultMasqueradeCIDR, 4, fieldPath.Child("network").Child("defaultMasqueradeCIDR"))...)
+	}
+
+	filesystemOverheadPath := fieldPath.Child("storage").Child("filesystemOverhead")
+	if spec.Storage.FilesystemOverhead.Global != "" {
+		if _, err := virtinkconfig.ParseFilesystemOverhead(spec.Storage.FilesystemOverhead.Global); err != nil {
+			errs = append(errs, field.Invalid(filesystemOverheadPath.Child("global"), spec.Storage.FilesystemOverhead.Global, err.Error()))
+		}
+	}
+	for storageClassName, overhead := range spec.Storage.FilesystemOverhead.StorageClasses {
+		if _, err := virtinkconfig.ParseFilesystemOverhead(overhead); err != nil {
+			errs = append(errs, field.Invalid(filesystemOverheadPath.Child("storageClasses").Key(storageClassName), overhead, err.Error()))
+		}
+	}
+
+	if sinkURL := spec.UsageAccounting.SinkURL; sinkURL != "" {
+		if u, err := url.Parse(sinkURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, field.Invalid(fieldPath.Child("usageAccounting").Child("sinkURL"), sinkURL, "must be an HTTP or HTTPS URL"))
+		}
+	}
+	return errs
+}
+
+func ValidateVirtinkConfigFencing(fencing *virtv1beta1.VirtinkConfigFencing, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if fencing.Mode != virtv1beta1.FencingWebhook {
+		return errs
+	}
+
+	if fencing.Webhook == nil {
+		errs = append(errs, field.Required(fieldPath.Child("webhook"), "required by the Webhook mode"))
+		return errs
+	}
+	webhookURL := fencing.Webhook.URL
+	if u, err := url.Parse(webhookURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = append(errs, field.Invalid(fieldPath.Child("webhook").Child("url"), webhookURL, "must be an HTTP or HTTPS URL"))
+	}
+	if len(fencing.Webhook.CABundle) > 0 && !x509.NewCertPool().AppendCertsFromPEM(fencing.Webhook.CABundle) {
+		errs = append(errs, field.Invalid(fieldPath.Child("webhook").Child("caBundle"), "", "must contain a PEM encoded certificate"))
+	}
+	return errs
+}
+
+// +kubebuilder:webhook:path=/validate-v1beta1-virtinknamespaceconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=virt.virtink.smartx.com,resources=virtinknamespaceconfigs,verbs=create;update,versions=v1beta1,name=validate.virtinknamespaceconfig.v1beta1.virt.virtink.smartx.com,admissionReviewVersions={v1,v1beta1}
+
+type VirtinkNamespaceConfigValidator struct {
+	decoder *admission.Decoder
+}
+
+var _ admission.DecoderInjector = &VirtinkNamespaceConfigValidator{}
+var _ admission.Handler = &VirtinkNamespaceConfigValidator{}
+
+func (h *VirtinkNamespaceConfigValidator) InjectDecoder(decoder *admission.Decoder) error {
+	h.decoder = decoder
+	return nil
+}
+
+func (h *VirtinkNamespaceConfigValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	var config virtv1beta1.VirtinkNamespaceConfig
+	if err := h.decoder.Decode(req, &config); err != nil {
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("unmarshal Virtink namespace config: %s", err))
+	}
+
+	var errs field.ErrorList
+	switch req.Operation {
+	case admissionv1.Create, admissionv1.Update:
+		errs = ValidateVirtinkNamespaceConfig(ctx, &config)
+	default:
+		return admission.Allowed("")
+	}
+
+	if len(errs) > 0 {
+		return webhook.Denied(errs.ToAggregate().Error())
+	}
+	return admission.Allowed("")
+}
+
+func ValidateVirtinkNamespaceConfig(ctx context.Context, config *virtv1beta1.VirtinkNamespaceConfig) field.ErrorList {
+	var errs field.ErrorList
+	if config.Name != virtinkconfig.Name {
+		errs = append(errs, field.Invalid(field.NewPath("metadata").Child("name"), config.Name, fmt.Sprintf("must be %q", virtinkconfig.Name)))
+	}
+	errs = append(errs, ValidateVirtinkNamespaceConfigSpec(ctx, &config.Spec, field.NewPath("spec"))...)
+	return errs
+}
+
+func ValidateVirtinkNamespaceConfigSpec(ctx context.Context, spec *virtv1beta1.VirtinkNamespaceConfigSpec, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if spec == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if spec.Network.DefaultMasqueradeCIDR != "" {
+		errs = append(errs, ValidateCIDR(spec.Network.DefaultMasqueradeCIDR, 4, fieldPath.Child("network").Child("defaultMasqueradeCIDR"))...)
+	}
+
+	if overhead := spec.Resources.MemoryOverhead; overhead != nil && overhead.Sign() < 0 {
+		errs = append(errs, field.Invalid(fieldPath.Child("resources").Child("memoryOverhead"), overhead.String(), "must not be negative"))
+	}
+
+	if storageClassName := spec.Storage.DefaultStorageClassName; storageClassName != nil {
+		for _, msg := range validation.IsDNS1123Subdomain(*storageClassName) {
+			errs = append(errs, field.Invalid(fieldPath.Child("storage").Child("defaultStorageClassName"), *storageClassName, msg))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,176 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+func TestValidateVirtinkConfig(t *testing.T) {
+	validConfig := &virtv1beta1.VirtinkConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "virtink",
+		},
+		Spec: virtv1beta1.VirtinkConfigSpec{
+			FeatureGates: map[string]bool{
+				"LiveMigration": false,
+			},
+			Logging: virtv1beta1.VirtinkConfigLogging{
+				Level: "2",
+			},
+			Network: virtv1beta1.VirtinkConfigNetwork{
+				DefaultMasqueradeCIDR: "10.0.3.0/30",
+			},
+		},
+	}
+
+	tests := []struct {
+		config        *virtv1beta1.VirtinkConfig
+		invalidFields []string
+	}{{
+		config: validConfig,
+	}, {
+		config: func() *virtv1beta1.VirtinkConfig {
+			config := validConfig.DeepCopy()
+			config.Name = "default"
+			return config
+		}(),
+		invalidFields: []string{"metadata.name"},
+	}, {
+		config: func() *virtv1beta1.VirtinkConfig {
+			config := validConfig.DeepCopy()
+			config.Spec.FeatureGates["Unknown"] = true
+			return config
+		}(),
+		invalidFields: []string{"spec.featureGates[Unknown]"},
+	}, {
+		config: func() *virtv1beta1.VirtinkConfig {
+			config := validConfig.DeepCopy()
+			config.Spec.Network.DefaultMasqueradeCIDR = "10.0.3.0/31"
+			return config
+		}(),
+		invalidFields: []string{"spec.network.defaultMasqueradeCIDR"},
+	}, {
+		config: func() *virtv1beta1.VirtinkConfig {
+			config := validConfig.DeepCopy()
+			config.Spec.Storage.FilesystemOverhead.StorageClasses = map[string]string{"local-path": "5%"}
+			return config
+		}(),
+		invalidFields: []string{"spec.storage.filesystemOverhead.storageClasses[local-path]"},
+	}, {
+		config: func() *virtv1beta1.VirtinkConfig {
+			config := validConfig.DeepCopy()
+			config.Spec.Logging.Level = "verbose"
+			return config
+		}(),
+		invalidFields: []string{"spec.logging.level"},
+	}, {
+		config: func() *virtv1beta1.VirtinkConfig {
+			config := validConfig.DeepCopy()
+			config.Spec.UsageAccounting.SinkURL = "billing.example.com/usages"
+			return config
+		}(),
+		invalidFields: []string{"spec.usageAccounting.sinkURL"},
+	}, {
+		config: func() *virtv1beta1.VirtinkConfig {
+			config := validConfig.DeepCopy()
+			config.Spec.Fencing.Mode = virtv1beta1.FencingWebhook
+			return config
+		}(),
+		invalidFields: []string{"spec.fencing.webhook"},
+	}, {
+		config: func() *virtv1beta1.VirtinkConfig {
+			config := validConfig.DeepCopy()
+			config.Spec.Fencing.Mode = virtv1beta1.FencingWebhook
+			config.Spec.Fencing.Webhook = &virtv1beta1.VirtinkConfigFencingWebhook{
+				URL:      "fencing.example.com",
+				CABundle: []byte("not a certificate"),
+			}
+			return config
+		}(),
+		invalidFields: []string{"spec.fencing.webhook.url", "spec.fencing.webhook.caBundle"},
+	}}
+
+	for _, tc := range tests {
+		errs := ValidateVirtinkConfig(context.Background(), tc.config)
+		var invalidFields []string
+		for _, err := range errs {
+			invalidFields = append(invalidFields, err.Field)
+		}
+		assert.Equal(t, tc.invalidFields, invalidFields)
+	}
+}
+
+func TestValidateVirtinkNamespaceConfig(t *testing.T) {
+	memoryOverhead := resource.MustParse("128Mi")
+	storageClassName := "local-path"
+	validConfig := &virtv1beta1.VirtinkNamespaceConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "virtink",
+		},
+		Spec: virtv1beta1.VirtinkNamespaceConfigSpec{
+			Network: virtv1beta1.VirtinkConfigNetwork{
+				DefaultInterfaceBinding: "masquerade",
+				DefaultMasqueradeCIDR:   "10.0.3.0/30",
+			},
+			Resources: virtv1beta1.VirtinkNamespaceConfigResources{
+				MemoryOverhead: &memoryOverhead,
+			},
+			Storage: virtv1beta1.VirtinkNamespaceConfigStorage{
+				DefaultStorageClassName: &storageClassName,
+			},
+		},
+	}
+
+	tests := []struct {
+		config        *virtv1beta1.VirtinkNamespaceConfig
+		invalidFields []string
+	}{{
+		config: validConfig,
+	}, {
+		config: func() *virtv1beta1.VirtinkNamespaceConfig {
+			config := validConfig.DeepCopy()
+			config.Name = "default"
+			return config
+		}(),
+		invalidFields: []string{"metadata.name"},
+	}, {
+		config: func() *virtv1beta1.VirtinkNamespaceConfig {
+			config := validConfig.DeepCopy()
+			config.Spec.Network.DefaultMasqueradeCIDR = "10.0.3.0/31"
+			return config
+		}(),
+		invalidFields: []string{"spec.network.defaultMasqueradeCIDR"},
+	}, {
+		config: func() *virtv1beta1.VirtinkNamespaceConfig {
+			config := validConfig.DeepCopy()
+			negativeOverhead := resource.MustParse("-1Mi")
+			config.Spec.Resources.MemoryOverhead = &negativeOverhead
+			return config
+		}(),
+		invalidFields: []string{"spec.resources.memoryOverhead"},
+	}, {
+		config: func() *virtv1beta1.VirtinkNamespaceConfig {
+			config := validConfig.DeepCopy()
+			invalidStorageClassName := "Local_Path"
+			config.Spec.Storage.DefaultStorageClassName = &invalidStorageClassName
+			return config
+		}(),
+		invalidFields: []string{"spec.storage.defaultStorageClassName"},
+	}}
+
+	for _, tc := range tests {
+		errs := ValidateVirtinkNamespaceConfig(context.Background(), tc.config)
+		var invalidFields []string
+		for _, err := range errs {
+			invalidFields = append(invalidFields, err.Field)
+		}
+		assert.Equal(t, tc.invalidFields, invalidFields)
+	}
+}
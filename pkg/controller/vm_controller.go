@@ -5,8 +5,10 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"math"
 	"reflect"
 	"strconv"
+	"time"
 
 	netv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -21,9 +23,37 @@ import (
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/conditions"
+	"github.com/smartxworks/virtink/pkg/defaults"
+	"github.com/smartxworks/virtink/pkg/disklease"
+	"github.com/smartxworks/virtink/pkg/hooks"
+	"github.com/smartxworks/virtink/pkg/securitygroup"
+	"github.com/smartxworks/virtink/pkg/tracing"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+// vmProtectionFinalizer keeps a VM until all of its pods are gone.
+const vmProtectionFinalizer = "virtink.io/vm-protection"
+
+// populatedFromAnnotation records the image that a PVC has been populated
+// with, so that it's not populated again on the next start of the VM.
+const populatedFromAnnotation = "virtink.io/populated-from"
+
+const (
+	// istioInjectAnnotation enables the Istio mode of masquerade interfaces
+	// when set to "true" on the VM.
+	istioInjectAnnotation = "sidecar.istio.io/inject"
+	// istioKubevirtInterfacesAnnotation lists the interfaces of which inbound
+	// traffic is redirected by Istio as outbound traffic.
+	istioKubevirtInterfacesAnnotation = "traffic.sidecar.istio.io/kubevirtInterfaces"
 )
 
 type VMReconciler struct {
@@ -32,14 +62,23 @@ type VMReconciler struct {
 	Recorder record.EventRecorder
 
 	PrerunnerImageName string
+
+	MaxConcurrentReconciles int
+	RateLimiter             ratelimiter.RateLimiter
+	// BatchPeriod delays reconciliations triggered by changes of dependent
+	// objects, so that bursts of changes are handled together.
+	BatchPeriod time.Duration
 }
 
 // +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines/finalizers,verbs=update
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtinkconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinesecuritygroups,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=persistentvolumes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cdi.kubevirt.io,resources=datavolumes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=k8s.cni.cncf.io,resources=network-attachment-definitions,verbs=get;list;watch
 
@@ -48,21 +87,49 @@ func (r *VMReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 	if err := r.Get(ctx, req.NamespacedName, &vm); err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
+	ctx = ctrl.LoggerInto(ctx, ctrl.LoggerFrom(ctx).WithValues("uid", vm.UID))
+	ctx, span := tracing.Start(ctx, "ReconcileVM", tracing.VMAttributes(&vm)...)
+	defer span.End()
+
+	if vm.DeletionTimestamp != nil && !vm.DeletionTimestamp.IsZero() {
+		return r.finalize(ctx, &vm)
+	}
+
+	if !controllerutil.ContainsFinalizer(&vm, vmProtectionFinalizer) {
+		controllerutil.AddFinalizer(&vm, vmProtectionFinalizer)
+		if err := r.Update(ctx, &vm); err != nil {
+			if apierrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("add VM finalizer: %s", err)
+		}
+		return ctrl.Result{}, nil
+	}
 
 	status := vm.Status.DeepCopy()
-	if err := r.reconcile(ctx, &vm); err != nil {
-		r.Recorder.Eventf(&vm, corev1.EventTypeWarning, "FailedReconcile", "Failed to reconcile VM: %s", err)
-		return ctrl.Result{}, err
+	reconcileErr := r.reconcile(ctx, &vm)
+	if reconcileErr != nil {
+		span.RecordError(reconcileErr)
+		r.Recorder.Eventf(&vm, corev1.EventTypeWarning, "FailedReconcile", "Failed to reconcile VM: %s", reconcileErr)
+		conditions.MarkFalse(&vm.Status.Conditions, string(virtv1alpha1.VirtualMachineSynchronized), conditions.ReasonReconcileFailed, "%s", reconcileErr)
+	} else {
+		conditions.MarkTrue(&vm.Status.Conditions, string(virtv1alpha1.VirtualMachineSynchronized), conditions.ReasonReconcileSucceeded)
 	}
 
 	if !reflect.DeepEqual(vm.Status, status) {
 		if err := r.Status().Update(ctx, &vm); err != nil {
+			if reconcileErr != nil {
+				return ctrl.Result{}, reconcileErr
+			}
 			if apierrors.IsConflict(err) {
 				return ctrl.Result{Requeue: true}, nil
 			}
 			return ctrl.Result{}, fmt.Errorf("update VM status: %s", err)
 		}
 	}
+	if reconcileErr != nil {
+		return ctrl.Result{}, reconcileErr
+	}
 
 	if err := r.gcVMPods(ctx, &vm); err != nil {
 		return ctrl.Result{}, fmt.Errorf("GC VM Pods: %s", err)
@@ -72,9 +139,7 @@ func (r *VMReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 }
 
 func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMachine) error {
-	if vm.DeletionTimestamp != nil && !vm.DeletionTimestamp.IsZero() {
-		return nil
-	}
+	vm.Status.ProviderID = "virtink://" + string(vm.UID)
 
 	switch vm.Status.Phase {
 	case virtv1alpha1.VirtualMachinePending:
@@ -101,6 +166,14 @@ func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMa
 
 		if vmPodNotFound {
 			if vm.Status.Phase == virtv1alpha1.VirtualMachineScheduling {
+				dataVolumesReady, err := r.reconcileDataVolumesReadyCondition(ctx, vm)
+				if err != nil {
+					return fmt.Errorf("reconcile data volumes ready condition: %s", err)
+				}
+				if !dataVolumesReady {
+					return fmt.Errorf("data volumes are not ready")
+				}
+
 				vmPod, err := r.buildVMPod(ctx, vm)
 				if err != nil {
 					return fmt.Errorf("build VM Pod: %s", err)
@@ -119,10 +192,18 @@ func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMa
 				vm.Status.Phase = virtv1alpha1.VirtualMachineFailed
 			}
 		} else {
-			switch vmPod.Status.Phase {
+			if err := r.reconcileVolumesPopulatedCondition(ctx, vm, &vmPod); err != nil {
+				return fmt.Errorf("reconcile volumes populated condition: %s", err)
+			}
+			if err := r.reconcileSchedulableCondition(ctx, vm, &vmPod); err != nil {
+				return fmt.Errorf("reconcile schedulable condition: %s", err)
+			}
+
+			switch getVMPodPhase(&vmPod) {
 			case corev1.PodRunning:
 				if vm.Status.Phase == virtv1alpha1.VirtualMachineScheduling {
 					vm.Status.VMPodUID = vmPod.UID
+					vm.Status.VMPodIP = vmPod.Status.PodIP
 					vm.Status.NodeName = vmPod.Spec.NodeName
 					vm.Status.Phase = virtv1alpha1.VirtualMachineScheduled
 				}
@@ -157,11 +238,11 @@ func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMa
 		switch {
 		case vmPodNotFound:
 			vm.Status.Phase = virtv1alpha1.VirtualMachineFailed
-		case vmPod.Status.Phase == corev1.PodSucceeded:
+		case getVMPodPhase(&vmPod) == corev1.PodSucceeded:
 			if vm.Status.Migration == nil {
 				vm.Status.Phase = virtv1alpha1.VirtualMachineSucceeded
 			}
-		case vmPod.Status.Phase == corev1.PodFailed:
+		case getVMPodPhase(&vmPod) == corev1.PodFailed:
 			vm.Status.Phase = virtv1alpha1.VirtualMachineFailed
 		case vmPod.Status.Phase == corev1.PodUnknown:
 			vm.Status.Phase = virtv1alpha1.VirtualMachineUnknown
@@ -170,13 +251,27 @@ func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMa
 			return nil
 		}
 
+		vm.Status.VMPodIP = vmPod.Status.PodIP
+		vm.Status.Addresses = getVMAddresses(vm, &vmPod)
 		if err := r.reconcileVMConditions(ctx, vm, &vmPod); err != nil {
 			return err
 		}
 
+		if vm.Spec.UpdateStrategy == virtv1alpha1.UpdateStrategyRestartOnChange && vm.Status.Migration == nil && vm.Status.PowerAction == "" &&
+			conditions.IsTrue(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineRestartRequired)) {
+			vm.Status.PowerAction = virtv1alpha1.VirtualMachinePowerCycle
+			r.Recorder.Eventf(vm, corev1.EventTypeNormal, "RestartingOnChange", "Power cycling VM to apply %s",
+				conditions.Get(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineRestartRequired)).Message)
+		}
+
 		if vm.Status.Migration != nil {
 			switch vm.Status.Migration.Phase {
 			case "", virtv1alpha1.VirtualMachineMigrationPending:
+				migrationVolumes, err := r.buildMigrationVolumes(ctx, vm)
+				if err != nil {
+					return fmt.Errorf("build migration volumes: %s", err)
+				}
+				vm.Status.Migration.Volumes = migrationVolumes
 				vm.Status.Migration.TargetVMPodName = names.SimpleNameGenerator.GenerateName(fmt.Sprintf("vm-%s-", vm.Name))
 				vm.Status.Migration.Phase = virtv1alpha1.VirtualMachineMigrationScheduling
 			case virtv1alpha1.VirtualMachineMigrationScheduling:
@@ -199,6 +294,10 @@ func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMa
 				}
 
 				if targetVMPodNotFound {
+					if err := r.createMigrationTargetPVCs(ctx, vm); err != nil {
+						return fmt.Errorf("create migration target PVCs: %s", err)
+					}
+
 					targetVMPod, err := r.buildTargetVMPod(ctx, vm)
 					if err != nil {
 						return fmt.Errorf("build target VM Pod: %s", err)
@@ -214,7 +313,7 @@ func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMa
 					}
 					r.Recorder.Eventf(vm, corev1.EventTypeNormal, "CreatedTargetVMPod", "Created target VM Pod %q", targetVMPod.Name)
 				} else {
-					switch targetVMPod.Status.Phase {
+					switch getVMPodPhase(&targetVMPod) {
 					case corev1.PodRunning:
 						vm.Status.Migration.TargetVMPodUID = targetVMPod.UID
 						vm.Status.Migration.TargetNodeName = targetVMPod.Spec.NodeName
@@ -241,12 +340,19 @@ func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMa
 			// ignored
 		}
 
+		// a power cycled VM is powered on again regardless of its run policy
+		if vm.Status.PowerAction == virtv1alpha1.VirtualMachinePowerCycle && vm.Spec.RunPolicy != virtv1alpha1.RunPolicyHalted {
+			run = true
+		}
+
 		if run {
 			vm.Status.Phase = virtv1alpha1.VirtualMachinePending
 		}
 
 		vm.Status = virtv1alpha1.VirtualMachineStatus{
-			Phase: vm.Status.Phase,
+			Phase:       vm.Status.Phase,
+			Schedule:    vm.Status.Schedule,
+			Hibernation: vm.Status.Hibernation,
 		}
 	default:
 		// ignored
@@ -254,25 +360,84 @@ func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMa
 	return nil
 }
 
+// getVMPodPhase returns the phase of the VM pod, which ends with its
+// cloud-hypervisor container even if hook sidecars are still running.
+func getVMPodPhase(vmPod *corev1.Pod) corev1.PodPhase {
+	for _, containerStatus := range vmPod.Status.ContainerStatuses {
+		if containerStatus.Name != "cloud-hypervisor" || containerStatus.State.Terminated == nil {
+			continue
+		}
+		if containerStatus.State.Terminated.ExitCode == 0 {
+			return corev1.PodSucceeded
+		}
+		return corev1.PodFailed
+	}
+	return vmPod.Status.Phase
+}
+
 func (r *VMReconciler) buildVMPod(ctx context.Context, vm *virtv1alpha1.VirtualMachine) (*corev1.Pod, error) {
 	vmJSON, err := json.Marshal(vm)
 	if err != nil {
 		return nil, fmt.Errorf("marshal VM: %s", err)
 	}
 
+	config, err := virtinkconfig.Get(ctx, r.Client)
+	if err != nil {
+		return nil, fmt.Errorf("get Virtink config: %s", err)
+	}
+	prerunnerImageName := r.PrerunnerImageName
+	if config.Spec.Images.Prerunner != "" {
+		prerunnerImageName = config.Spec.Images.Prerunner
+	}
+	prerunnerArgs := []string{"--vm-data", base64.StdEncoding.EncodeToString(vmJSON)}
+	if config.Spec.Logging.Level != "" {
+		prerunnerArgs = append(prerunnerArgs, "--zap-log-level", config.Spec.Logging.Level)
+	}
+	if config.Spec.Logging.Format != "" {
+		prerunnerArgs = append(prerunnerArgs, "--zap-encoder", config.Spec.Logging.Format)
+	}
+	if vm.Spec.Hibernation != nil && vm.Status.Hibernation != nil && vm.Status.Hibernation.Phase == virtv1alpha1.VirtualMachineHibernated {
+		prerunnerArgs = append(prerunnerArgs, "--restore-snapshot", vm.Status.Hibernation.SnapshotName)
+	}
+	if config.Spec.Storage.DiskLeases.Enabled && len(disklease.GetClaimNames(vm)) > 0 {
+		prerunnerArgs = append(prerunnerArgs, "--wait-disk-leases")
+	}
+	securityGroups, err := securitygroup.Get(ctx, r.Client, vm)
+	if err != nil {
+		return nil, err
+	}
+	if len(securityGroups) > 0 {
+		securityGroupsJSON, err := json.Marshal(securityGroups)
+		if err != nil {
+			return nil, fmt.Errorf("marshal security groups: %s", err)
+		}
+		prerunnerArgs = append(prerunnerArgs, "--security-groups", base64.StdEncoding.EncodeToString(securityGroupsJSON))
+	}
+
+	// a pod is only given its FQDN with an explicit hostname
+	podHostname := vm.Spec.Hostname
+	if podHostname == "" && vm.Spec.Subdomain != "" {
+		podHostname = vm.Name
+	}
+
 	vmPod := corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			Labels:      vm.Labels,
 			Annotations: vm.Annotations,
 		},
 		Spec: corev1.PodSpec{
-			RestartPolicy: corev1.RestartPolicyNever,
-			NodeSelector:  vm.Spec.NodeSelector,
-			Tolerations:   vm.Spec.Tolerations,
-			Affinity:      vm.Spec.Affinity,
+			RestartPolicy:     corev1.RestartPolicyNever,
+			NodeSelector:      vm.Spec.NodeSelector,
+			Tolerations:       vm.Spec.Tolerations,
+			Affinity:          vm.Spec.Affinity,
+			PriorityClassName: vm.Spec.PriorityClassName,
+			Hostname:          podHostname,
+			Subdomain:         vm.Spec.Subdomain,
+			DNSPolicy:         vm.Spec.DNSPolicy,
+			DNSConfig:         vm.Spec.DNSConfig,
 			Containers: []corev1.Container{{
 				Name:           "cloud-hypervisor",
-				Image:          r.PrerunnerImageName,
+				Image:          prerunnerImageName,
 				Resources:      vm.Spec.Resources,
 				LivenessProbe:  vm.Spec.LivenessProbe,
 				ReadinessProbe: vm.Spec.ReadinessProbe,
@@ -281,7 +446,7 @@ func (r *VMReconciler) buildVMPod(ctx context.Context, vm *virtv1alpha1.VirtualM
 						Add: []corev1.Capability{"SYS_ADMIN", "NET_ADMIN", "SYS_RESOURCE"},
 					},
 				},
-				Args: []string{"--vm-data", base64.StdEncoding.EncodeToString(vmJSON)},
+				Args: prerunnerArgs,
 				VolumeMounts: []corev1.VolumeMount{{
 					Name:      "virtink",
 					MountPath: "/var/run/virtink",
@@ -296,8 +461,26 @@ func (r *VMReconciler) buildVMPod(ctx context.Context, vm *virtv1alpha1.VirtualM
 		},
 	}
 
+	if vm.Spec.Instance.Realtime != nil {
+		vmPod.Spec.Containers[0].SecurityContext.Capabilities.Add = append(vmPod.Spec.Containers[0].SecurityContext.Capabilities.Add, "SYS_NICE", "IPC_LOCK")
+	}
+
 	incrementContainerResource(&vmPod.Spec.Containers[0], "devices.virtink.io/kvm")
 	incrementContainerResource(&vmPod.Spec.Containers[0], "devices.virtink.io/tun")
+	if config.Spec.VCPUResource.Enabled {
+		addContainerResource(&vmPod.Spec.Containers[0], virtv1beta1.VCPUResourceName, int64(vm.Spec.Instance.CPU.Sockets*vm.Spec.Instance.CPU.CoresPerSocket))
+	}
+	for _, iface := range vm.Spec.Instance.Interfaces {
+		if iface.Vhost {
+			incrementContainerResource(&vmPod.Spec.Containers[0], "devices.virtink.io/vhost-net")
+			break
+		}
+	}
+	if devices := vm.Spec.Instance.Devices; devices != nil {
+		for _, hostUSBDevice := range devices.HostUSBDevices {
+			incrementContainerResource(&vmPod.Spec.Containers[0], hostUSBDevice.ResourceName)
+		}
+	}
 
 	if vmPod.Labels == nil {
 		vmPod.Labels = map[string]string{}
@@ -328,6 +511,44 @@ func (r *VMReconciler) buildVMPod(ctx context.Context, vm *virtv1alpha1.VirtualM
 		})
 	}
 
+	for _, disk := range vm.Spec.Instance.Disks {
+		if disk.Encryption == nil {
+			continue
+		}
+		volumeName := "virtink-luks-" + disk.Name
+		vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: disk.Encryption.SecretName,
+				},
+			},
+		})
+		vmPod.Spec.Containers[0].VolumeMounts = append(vmPod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: "/mnt/virtink-luks/" + disk.Name,
+			ReadOnly:  true,
+		})
+	}
+
+	for _, disk := range vm.Spec.Instance.Disks {
+		if !disk.Ephemeral {
+			continue
+		}
+		// the overlays of ephemeral disks are discarded along with the VM pod
+		vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, corev1.Volume{
+			Name: "virtink-ephemeral",
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		})
+		vmPod.Spec.Containers[0].VolumeMounts = append(vmPod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      "virtink-ephemeral",
+			MountPath: "/var/lib/virtink/ephemeral",
+		})
+		break
+	}
+
 	if vm.Spec.Instance.Memory.Hugepages != nil {
 		vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, corev1.Volume{
 			Name: "hugepages",
@@ -344,6 +565,82 @@ func (r *VMReconciler) buildVMPod(ctx context.Context, vm *virtv1alpha1.VirtualM
 		vmPod.Spec.Containers[0].VolumeMounts = append(vmPod.Spec.Containers[0].VolumeMounts, volumeMount)
 	}
 
+	if vm.Spec.MemoryDump != nil {
+		vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, corev1.Volume{
+			Name: "virtink-memory-dump",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: vm.Spec.MemoryDump.ClaimName,
+				},
+			},
+		})
+		volumeMount := corev1.VolumeMount{
+			Name:      "virtink-memory-dump",
+			MountPath: "/mnt/virtink-memory-dump",
+		}
+		vmPod.Spec.Containers[0].VolumeMounts = append(vmPod.Spec.Containers[0].VolumeMounts, volumeMount)
+	}
+
+	if vm.Spec.Hibernation != nil {
+		vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, corev1.Volume{
+			Name: "virtink-hibernation",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: vm.Spec.Hibernation.ClaimName,
+				},
+			},
+		})
+		volumeMount := corev1.VolumeMount{
+			Name:      "virtink-hibernation",
+			MountPath: "/mnt/virtink-hibernation",
+		}
+		vmPod.Spec.Containers[0].VolumeMounts = append(vmPod.Spec.Containers[0].VolumeMounts, volumeMount)
+		vmPod.Spec.Containers[0].Env = append(vmPod.Spec.Containers[0].Env, corev1.EnvVar{
+			Name: "POD_UID",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "metadata.uid",
+				},
+			},
+		})
+	}
+
+	if vm.Spec.MetadataService != nil {
+		// the secrets are read by the metadata service on every request, so
+		// that changes reach the guest
+		if vm.Spec.MetadataService.UserDataSecretName != "" {
+			vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, corev1.Volume{
+				Name: "virtink-metadata-user-data",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: vm.Spec.MetadataService.UserDataSecretName,
+					},
+				},
+			})
+			vmPod.Spec.Containers[0].VolumeMounts = append(vmPod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+				Name:      "virtink-metadata-user-data",
+				MountPath: "/mnt/virtink-metadata-user-data",
+				ReadOnly:  true,
+			})
+		}
+		for i, key := range vm.Spec.SSHPublicKeys {
+			keyVolumeName := fmt.Sprintf("virtink-metadata-ssh-public-keys-%d", i)
+			vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, corev1.Volume{
+				Name: keyVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: key.SecretName,
+					},
+				},
+			})
+			vmPod.Spec.Containers[0].VolumeMounts = append(vmPod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+				Name:      keyVolumeName,
+				MountPath: fmt.Sprintf("/mnt/virtink-metadata-ssh-public-keys/%d", i),
+				ReadOnly:  true,
+			})
+		}
+	}
+
 	for _, volume := range vm.Spec.Volumes {
 		switch {
 		case volume.ContainerDisk != nil:
@@ -368,7 +665,77 @@ func (r *VMReconciler) buildVMPod(ctx context.Context, vm *virtv1alpha1.VirtualM
 				Args:            []string{volumeMount.MountPath + "/disk.raw"},
 				VolumeMounts:    []corev1.VolumeMount{volumeMount},
 			})
-		case volume.CloudInit != nil:
+		case volume.HTTPDisk != nil:
+			vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, corev1.Volume{
+				Name: volume.Name,
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			})
+
+			volumeMount := corev1.VolumeMount{
+				Name:      volume.Name,
+				MountPath: "/mnt/" + volume.Name,
+			}
+			vmPod.Spec.Containers[0].VolumeMounts = append(vmPod.Spec.Containers[0].VolumeMounts, volumeMount)
+
+			importContainer, scratchVolume := buildHTTPDiskImporter(vm, volume.Name, volume.HTTPDisk, prerunnerImageName)
+			importContainer.Args = append(importContainer.Args, volumeMount.MountPath+"/disk.raw")
+			importContainer.VolumeMounts = append(importContainer.VolumeMounts, volumeMount)
+			vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, scratchVolume)
+			vmPod.Spec.InitContainers = append(vmPod.Spec.InitContainers, importContainer)
+		case volume.EmptyDisk != nil:
+			podVolume := corev1.Volume{
+				Name: volume.Name,
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			}
+			if volumeClaim := volume.EmptyDisk.VolumeClaim; volumeClaim != nil {
+				var storageClassName string
+				if volumeClaim.StorageClassName != nil {
+					storageClassName = *volumeClaim.StorageClassName
+				}
+				overhead, err := virtinkconfig.FilesystemOverhead(config, storageClassName)
+				if err != nil {
+					return nil, fmt.Errorf("get filesystem overhead of storage class %q: %s", storageClassName, err)
+				}
+				if overhead >= 1 {
+					return nil, fmt.Errorf("filesystem overhead of storage class %q leaves no space for disks", storageClassName)
+				}
+
+				// the filesystem overhead of the PVC is reserved on top of the capacity of the disk
+				size := int64(math.Ceil(float64(volume.EmptyDisk.Capacity.Value()) / (1 - overhead)))
+				podVolume.VolumeSource = corev1.VolumeSource{
+					Ephemeral: &corev1.EphemeralVolumeSource{
+						VolumeClaimTemplate: &corev1.PersistentVolumeClaimTemplate{
+							Spec: corev1.PersistentVolumeClaimSpec{
+								AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+								StorageClassName: volumeClaim.StorageClassName,
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceStorage: *resource.NewQuantity(size, resource.BinarySI),
+									},
+								},
+							},
+						},
+					},
+				}
+			}
+			vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, podVolume)
+			vmPod.Spec.Containers[0].VolumeMounts = append(vmPod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+				Name:      volume.Name,
+				MountPath: "/mnt/" + volume.Name,
+			})
+		case volume.CloudInit != nil, volume.ClusterAPIBootstrap != nil:
+			cloudInit := volume.CloudInit
+			if volume.ClusterAPIBootstrap != nil {
+				// the bootstrap data secret holds the user data in the same key as user data secrets
+				cloudInit = &virtv1alpha1.CloudInitVolumeSource{
+					UserDataSecretName: volume.ClusterAPIBootstrap.SecretName,
+				}
+			}
+
 			initContainer := corev1.Container{
 				Name:      "init-volume-" + volume.Name,
 				Image:     vmPod.Spec.Containers[0].Image,
@@ -377,21 +744,25 @@ func (r *VMReconciler) buildVMPod(ctx context.Context, vm *virtv1alpha1.VirtualM
 				Args:      []string{"cloud-init"},
 			}
 
-			metaData := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("instance-id: %s\nlocal-hostname: %s", vm.UID, vm.Name)))
+			hostname := vm.Name
+			if vm.Spec.Hostname != "" {
+				hostname = vm.Spec.Hostname
+			}
+			metaData := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("instance-id: %s\nlocal-hostname: %s", vm.UID, hostname)))
 			initContainer.Args = append(initContainer.Args, metaData)
 
 			var userData string
 			switch {
-			case volume.CloudInit.UserData != "":
-				userData = base64.StdEncoding.EncodeToString([]byte(volume.CloudInit.UserData))
-			case volume.CloudInit.UserDataBase64 != "":
-				userData = volume.CloudInit.UserDataBase64
-			case volume.CloudInit.UserDataSecretName != "":
+			case cloudInit.UserData != "":
+				userData = base64.StdEncoding.EncodeToString([]byte(cloudInit.UserData))
+			case cloudInit.UserDataBase64 != "":
+				userData = cloudInit.UserDataBase64
+			case cloudInit.UserDataSecretName != "":
 				vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, corev1.Volume{
 					Name: "virtink-cloud-init-user-data",
 					VolumeSource: corev1.VolumeSource{
 						Secret: &corev1.SecretVolumeSource{
-							SecretName: volume.CloudInit.UserDataSecretName,
+							SecretName: cloudInit.UserDataSecretName,
 						},
 					},
 				})
@@ -407,16 +778,16 @@ func (r *VMReconciler) buildVMPod(ctx context.Context, vm *virtv1alpha1.VirtualM
 
 			var networkData string
 			switch {
-			case volume.CloudInit.NetworkData != "":
-				networkData = base64.StdEncoding.EncodeToString([]byte(volume.CloudInit.NetworkData))
-			case volume.CloudInit.NetworkDataBase64 != "":
-				networkData = volume.CloudInit.NetworkDataBase64
-			case volume.CloudInit.NetworkDataSecretName != "":
+			case cloudInit.NetworkData != "":
+				networkData = base64.StdEncoding.EncodeToString([]byte(cloudInit.NetworkData))
+			case cloudInit.NetworkDataBase64 != "":
+				networkData = cloudInit.NetworkDataBase64
+			case cloudInit.NetworkDataSecretName != "":
 				vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, corev1.Volume{
 					Name: "virtink-cloud-init-network-data",
 					VolumeSource: corev1.VolumeSource{
 						Secret: &corev1.SecretVolumeSource{
-							SecretName: volume.CloudInit.NetworkDataSecretName,
+							SecretName: cloudInit.NetworkDataSecretName,
 						},
 					},
 				})
@@ -430,6 +801,22 @@ func (r *VMReconciler) buildVMPod(ctx context.Context, vm *virtv1alpha1.VirtualM
 			}
 			initContainer.Args = append(initContainer.Args, networkData)
 
+			for i, key := range vm.Spec.SSHPublicKeys {
+				keyVolumeName := fmt.Sprintf("virtink-ssh-public-keys-%d", i)
+				vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, corev1.Volume{
+					Name: keyVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{
+							SecretName: key.SecretName,
+						},
+					},
+				})
+				initContainer.VolumeMounts = append(initContainer.VolumeMounts, corev1.VolumeMount{
+					Name:      keyVolumeName,
+					MountPath: fmt.Sprintf("/mnt/virtink-ssh-public-keys/%d", i),
+				})
+			}
+
 			vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, corev1.Volume{
 				Name: volume.Name,
 				VolumeSource: corev1.VolumeSource{
@@ -444,7 +831,117 @@ func (r *VMReconciler) buildVMPod(ctx context.Context, vm *virtv1alpha1.VirtualM
 			vmPod.Spec.Containers[0].VolumeMounts = append(vmPod.Spec.Containers[0].VolumeMounts, volumeMount)
 			initContainer.VolumeMounts = append(initContainer.VolumeMounts, volumeMount)
 			initContainer.Args = append(initContainer.Args, volumeMount.MountPath+"/cloud-init.iso")
+			if len(vm.Spec.SSHPublicKeys) > 0 {
+				initContainer.Args = append(initContainer.Args, "/mnt/virtink-ssh-public-keys")
+			}
 			vmPod.Spec.InitContainers = append(vmPod.Spec.InitContainers, initContainer)
+		case volume.Sysprep != nil:
+			sysprepVolume := corev1.Volume{
+				Name: "virtink-sysprep-" + volume.Name,
+			}
+			if volume.Sysprep.ConfigMapName != "" {
+				sysprepVolume.ConfigMap = &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: volume.Sysprep.ConfigMapName,
+					},
+				}
+			} else {
+				sysprepVolume.Secret = &corev1.SecretVolumeSource{
+					SecretName: volume.Sysprep.SecretName,
+				}
+			}
+			vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, sysprepVolume, corev1.Volume{
+				Name: volume.Name,
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			})
+
+			sysprepVolumeMount := corev1.VolumeMount{
+				Name:      sysprepVolume.Name,
+				MountPath: "/mnt/virtink-sysprep/" + volume.Name,
+				ReadOnly:  true,
+			}
+			volumeMount := corev1.VolumeMount{
+				Name:      volume.Name,
+				MountPath: "/mnt/" + volume.Name,
+			}
+			vmPod.Spec.Containers[0].VolumeMounts = append(vmPod.Spec.Containers[0].VolumeMounts, volumeMount)
+
+			vmPod.Spec.InitContainers = append(vmPod.Spec.InitContainers, corev1.Container{
+				Name:         "init-volume-" + volume.Name,
+				Image:        vmPod.Spec.Containers[0].Image,
+				Resources:    vm.Spec.Resources,
+				Command:      []string{"virt-init-volume"},
+				Args:         []string{"sysprep", sysprepVolumeMount.MountPath, volumeMount.MountPath + "/sysprep.iso"},
+				VolumeMounts: []corev1.VolumeMount{sysprepVolumeMount, volumeMount},
+			})
+		case volume.ConfigMap != nil, volume.Secret != nil, volume.ServiceAccountToken != nil:
+			configVolume := corev1.Volume{
+				Name: "virtink-config-" + volume.Name,
+			}
+			var format virtv1alpha1.ConfigDiskFormat
+			var volumeLabel string
+			switch {
+			case volume.ConfigMap != nil:
+				configVolume.ConfigMap = &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: volume.ConfigMap.Name,
+					},
+				}
+				format, volumeLabel = volume.ConfigMap.Format, volume.ConfigMap.VolumeLabel
+			case volume.Secret != nil:
+				configVolume.Secret = &corev1.SecretVolumeSource{
+					SecretName: volume.Secret.SecretName,
+				}
+				format, volumeLabel = volume.Secret.Format, volume.Secret.VolumeLabel
+			default:
+				vmPod.Spec.ServiceAccountName = volume.ServiceAccountToken.ServiceAccountName
+				configVolume.Projected = buildServiceAccountTokenProjection(volume.ServiceAccountToken)
+				format, volumeLabel = virtv1alpha1.ConfigDiskFormatISO, volume.Name
+				if maxLen := defaults.ConfigDiskVolumeLabelMaxLen(format); len(volumeLabel) > maxLen {
+					volumeLabel = volumeLabel[:maxLen]
+				}
+			}
+
+			if hasFileSystem(vm, volume.Name) {
+				// virtiofsd serves the files, which are kept updated by the kubelet
+				configVolume.Name = volume.Name
+				vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, configVolume)
+				vmPod.Spec.Containers[0].VolumeMounts = append(vmPod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+					Name:      volume.Name,
+					MountPath: "/mnt/" + volume.Name,
+					ReadOnly:  true,
+				})
+				break
+			}
+
+			vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, configVolume, corev1.Volume{
+				Name: volume.Name,
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			})
+
+			configVolumeMount := corev1.VolumeMount{
+				Name:      configVolume.Name,
+				MountPath: "/mnt/virtink-config/" + volume.Name,
+				ReadOnly:  true,
+			}
+			volumeMount := corev1.VolumeMount{
+				Name:      volume.Name,
+				MountPath: "/mnt/" + volume.Name,
+			}
+			vmPod.Spec.Containers[0].VolumeMounts = append(vmPod.Spec.Containers[0].VolumeMounts, volumeMount)
+
+			vmPod.Spec.InitContainers = append(vmPod.Spec.InitContainers, corev1.Container{
+				Name:         "init-volume-" + volume.Name,
+				Image:        prerunnerImageName,
+				Resources:    vm.Spec.Resources,
+				Command:      []string{"virt-init-volume"},
+				Args:         []string{"config-disk", string(format), volumeLabel, configVolumeMount.MountPath, volumeMount.MountPath + "/config.img"},
+				VolumeMounts: []corev1.VolumeMount{configVolumeMount, volumeMount},
+			})
 		case volume.ContainerRootfs != nil:
 			vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, corev1.Volume{
 				Name: volume.Name,
@@ -493,24 +990,27 @@ func (r *VMReconciler) buildVMPod(ctx context.Context, vm *virtv1alpha1.VirtualM
 				return nil, fmt.Errorf("get PVC: %s", err)
 			}
 
-			if volume.DataVolume != nil {
-				var getDataVolumeFunc = func(name, namespace string) (*cdiv1beta1.DataVolume, error) {
-					var dv cdiv1beta1.DataVolume
-					dvKey := types.NamespacedName{
-						Name:      volume.DataVolume.VolumeName,
-						Namespace: vm.Namespace,
-					}
-					if err := r.Client.Get(ctx, dvKey, &dv); err != nil {
-						return nil, err
-					}
-					return &dv, nil
+			for _, disk := range vm.Spec.Instance.Disks {
+				if disk.Name == volume.Name && disk.Shareable && !hasAccessMode(&pvc, corev1.ReadWriteMany) {
+					return nil, fmt.Errorf("PVC %q of shareable disk %q is not ReadWriteMany", pvcName, disk.Name)
 				}
-				ready, err := cdiv1beta1.IsPopulated(&pvc, getDataVolumeFunc)
-				if err != nil {
-					return nil, err
-				}
-				if !ready {
-					return nil, fmt.Errorf("data volume is not ready: %s", volume.DataVolume.VolumeName)
+			}
+
+			var populateContainer *corev1.Container
+			if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.Populate != nil && pvc.Annotations[populatedFromAnnotation] == "" {
+				if httpDisk := volume.PersistentVolumeClaim.Populate.HTTPDisk; httpDisk != nil {
+					importContainer, scratchVolume := buildHTTPDiskImporter(vm, volume.Name, httpDisk, prerunnerImageName)
+					vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, scratchVolume)
+					populateContainer = &importContainer
+				} else {
+					containerDisk := volume.PersistentVolumeClaim.Populate.ContainerDisk
+					populateContainer = &corev1.Container{
+						Name:                     "init-volume-" + volume.Name,
+						Image:                    containerDisk.Image,
+						ImagePullPolicy:          containerDisk.ImagePullPolicy,
+						Resources:                vm.Spec.Resources,
+						TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+					}
 				}
 			}
 
@@ -520,12 +1020,47 @@ func (r *VMReconciler) buildVMPod(ctx context.Context, vm *virtv1alpha1.VirtualM
 					DevicePath: "/mnt/" + volume.Name,
 				}
 				vmPod.Spec.Containers[0].VolumeDevices = append(vmPod.Spec.Containers[0].VolumeDevices, volumeDevice)
+				if populateContainer != nil {
+					populateContainer.Args = append(populateContainer.Args, volumeDevice.DevicePath)
+					populateContainer.VolumeDevices = append(populateContainer.VolumeDevices, volumeDevice)
+				}
 			} else {
 				volumeMount := corev1.VolumeMount{
 					Name:      volume.Name,
 					MountPath: "/mnt/" + volume.Name,
 				}
-				vmPod.Spec.Containers[0].VolumeMounts = append(vmPod.Spec.Containers[0].VolumeMounts, volumeMount)
+				// the base images of ephemeral disks are never written by the VM
+				vmMount := volumeMount
+				vmMount.ReadOnly = isEphemeralDisk(vm, volume.Name)
+				vmPod.Spec.Containers[0].VolumeMounts = append(vmPod.Spec.Containers[0].VolumeMounts, vmMount)
+				if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.Populate != nil {
+					var storageClassName string
+					if pvc.Spec.StorageClassName != nil {
+						storageClassName = *pvc.Spec.StorageClassName
+					}
+					overhead, err := virtinkconfig.FilesystemOverhead(config, storageClassName)
+					if err != nil {
+						return nil, fmt.Errorf("get filesystem overhead of storage class %q: %s", storageClassName, err)
+					}
+
+					if populateContainer != nil {
+						// the image is checked to fit in the PVC before it's converted
+						capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]
+						if !ok {
+							capacity = pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+						}
+						maxSize := int64(float64(capacity.Value()) * (1 - overhead))
+						populateContainer.Args = append(populateContainer.Args, volumeMount.MountPath+"/disk.img", strconv.FormatInt(maxSize, 10))
+						populateContainer.VolumeMounts = append(populateContainer.VolumeMounts, volumeMount)
+					}
+					if volume.PersistentVolumeClaim.Populate.Grow {
+						vmPod.Spec.Containers[0].Args = append(vmPod.Spec.Containers[0].Args, "--filesystem-overhead", volume.Name+"="+strconv.FormatFloat(overhead, 'f', -1, 64))
+					}
+				}
+			}
+
+			if populateContainer != nil {
+				vmPod.Spec.InitContainers = append(vmPod.Spec.InitContainers, *populateContainer)
 			}
 		default:
 			// ignored
@@ -546,13 +1081,29 @@ func (r *VMReconciler) buildVMPod(ctx context.Context, vm *virtv1alpha1.VirtualM
 		}
 
 		if iface.Masquerade != nil {
+			sysctlCommand := []string{"sysctl", "-w", "net.ipv4.ip_forward=1"}
+			if vm.Annotations[istioInjectAnnotation] == "true" {
+				// connections forwarded by the Istio sidecar to the guest are from a loopback address
+				sysctlCommand = append(sysctlCommand, "net.ipv4.conf.all.route_localnet=1")
+
+				annotations := map[string]string{}
+				for k, v := range vmPod.Annotations {
+					annotations[k] = v
+				}
+				if _, ok := annotations[istioKubevirtInterfacesAnnotation]; !ok {
+					// masquerade interfaces are always on the pod network
+					annotations[istioKubevirtInterfacesAnnotation] = "br-eth0"
+				}
+				vmPod.Annotations = annotations
+			}
+
 			vmPod.Spec.InitContainers = append(vmPod.Spec.InitContainers, corev1.Container{
 				Name:  "enable-ip-forward",
-				Image: r.PrerunnerImageName,
+				Image: prerunnerImageName,
 				SecurityContext: &corev1.SecurityContext{
 					Privileged: &[]bool{true}[0],
 				},
-				Command: []string{"sysctl", "-w", "net.ipv4.ip_forward=1"},
+				Command: sysctlCommand,
 			})
 		}
 
@@ -639,16 +1190,106 @@ func (r *VMReconciler) buildVMPod(ctx context.Context, vm *virtv1alpha1.VirtualM
 		vmPod.Annotations["k8s.v1.cni.cncf.io/networks"] = string(networksJSON)
 	}
 
+	for _, iface := range vm.Spec.Instance.Interfaces {
+		if iface.OVS == nil {
+			continue
+		}
+		// virt-prerunner configures the OVS ports of OVS interfaces through
+		// the Open vSwitch database of the node
+		vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, corev1.Volume{
+			Name: "virtink-openvswitch",
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: "/var/run/openvswitch",
+				},
+			},
+		})
+		vmPod.Spec.Containers[0].VolumeMounts = append(vmPod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      "virtink-openvswitch",
+			MountPath: "/var/run/openvswitch",
+		})
+		break
+	}
+
+	hookSidecars, err := hooks.GetHookSidecars(vm.Annotations)
+	if err != nil {
+		return nil, fmt.Errorf("get hook sidecars: %s", err)
+	}
+	if len(hookSidecars) > 0 {
+		vmPod.Spec.Volumes = append(vmPod.Spec.Volumes, corev1.Volume{
+			Name: "virtink-hooks",
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		})
+		volumeMount := corev1.VolumeMount{
+			Name:      "virtink-hooks",
+			MountPath: hooks.SocketDirPath,
+		}
+		vmPod.Spec.Containers[0].VolumeMounts = append(vmPod.Spec.Containers[0].VolumeMounts, volumeMount)
+
+		for i, hookSidecar := range hookSidecars {
+			resources := hookSidecar.Resources
+			if resources.Limits == nil && resources.Requests == nil {
+				// keep the QoS class of the VM pod Guaranteed for dedicated CPU placement
+				resources.Limits = corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("100m"),
+					corev1.ResourceMemory: resource.MustParse("64Mi"),
+				}
+			}
+			vmPod.Spec.Containers = append(vmPod.Spec.Containers, corev1.Container{
+				Name:            fmt.Sprintf("hook-sidecar-%d", i),
+				Image:           hookSidecar.Image,
+				ImagePullPolicy: hookSidecar.ImagePullPolicy,
+				Args:            hookSidecar.Args,
+				Resources:       resources,
+				Env: []corev1.EnvVar{{
+					Name:  hooks.SocketPathEnv,
+					Value: hooks.SocketPath(i),
+				}},
+				VolumeMounts: []corev1.VolumeMount{volumeMount},
+			})
+		}
+	}
+
+	if traceContext := tracing.Inject(ctx); traceContext != "" {
+		annotations := map[string]string{}
+		for k, v := range vmPod.Annotations {
+			annotations[k] = v
+		}
+		annotations[tracing.TraceContextAnnotation] = traceContext
+		vmPod.Annotations = annotations
+	}
+
 	return &vmPod, nil
 }
 
 func (r *VMReconciler) buildTargetVMPod(ctx context.Context, vm *virtv1alpha1.VirtualMachine) (*corev1.Pod, error) {
-	pod, err := r.buildVMPod(ctx, vm)
+	targetVM := vm.DeepCopy()
+	for _, migrationVolume := range vm.Status.Migration.Volumes {
+		for i := range targetVM.Spec.Volumes {
+			if targetVM.Spec.Volumes[i].Name == migrationVolume.Name {
+				targetVM.Spec.Volumes[i].PersistentVolumeClaim.ClaimName = migrationVolume.TargetClaimName
+			}
+		}
+	}
+
+	pod, err := r.buildVMPod(ctx, targetVM)
 	if err != nil {
 		return nil, err
 	}
 	pod.Spec.Containers[0].Args = append(pod.Spec.Containers[0].Args, "--receive-migration")
 
+	if len(vm.Status.Migration.TargetNodeSelector) > 0 {
+		if pod.Spec.NodeSelector == nil {
+			pod.Spec.NodeSelector = map[string]string{}
+		}
+		for key, value := range vm.Status.Migration.TargetNodeSelector {
+			pod.Spec.NodeSelector[key] = value
+		}
+	}
+	pod.Spec.Affinity = mergeAffinity(pod.Spec.Affinity, vm.Status.Migration.TargetAffinity)
+
 	if pod.Spec.Affinity == nil {
 		pod.Spec.Affinity = &corev1.Affinity{}
 	}
@@ -672,6 +1313,131 @@ func (r *VMReconciler) buildTargetVMPod(ctx context.Context, vm *virtv1alpha1.Vi
 	return pod, nil
 }
 
+// buildMigrationVolumes finds the PVC volumes of VM bound to node-local PVs,
+// which have to be copied to new PVCs on the target node during migration.
+func (r *VMReconciler) buildMigrationVolumes(ctx context.Context, vm *virtv1alpha1.VirtualMachine) ([]virtv1alpha1.VirtualMachineStatusMigrationVolume, error) {
+	var migrationVolumes []virtv1alpha1.VirtualMachineStatusMigrationVolume
+	for _, volume := range vm.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+
+		pvc, pv, err := r.getPVCAndPV(ctx, vm.Namespace, volume.PersistentVolumeClaim.ClaimName)
+		if err != nil {
+			return nil, err
+		}
+		if pv == nil || !isNodeLocalPV(pv) {
+			continue
+		}
+
+		migrationVolumes = append(migrationVolumes, virtv1alpha1.VirtualMachineStatusMigrationVolume{
+			Name:            volume.Name,
+			SourceClaimName: pvc.Name,
+			TargetClaimName: names.SimpleNameGenerator.GenerateName(pvc.Name + "-"),
+		})
+	}
+	return migrationVolumes, nil
+}
+
+func (r *VMReconciler) createMigrationTargetPVCs(ctx context.Context, vm *virtv1alpha1.VirtualMachine) error {
+	for _, migrationVolume := range vm.Status.Migration.Volumes {
+		var targetPVC corev1.PersistentVolumeClaim
+		targetPVCKey := types.NamespacedName{
+			Name:      migrationVolume.TargetClaimName,
+			Namespace: vm.Namespace,
+		}
+		if err := r.Get(ctx, targetPVCKey, &targetPVC); err == nil {
+			continue
+		} else if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("get target PVC: %s", err)
+		}
+
+		var sourcePVC corev1.PersistentVolumeClaim
+		sourcePVCKey := types.NamespacedName{
+			Name:      migrationVolume.SourceClaimName,
+			Namespace: vm.Namespace,
+		}
+		if err := r.Get(ctx, sourcePVCKey, &sourcePVC); err != nil {
+			return fmt.Errorf("get source PVC: %s", err)
+		}
+
+		targetPVC = corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        targetPVCKey.Name,
+				Namespace:   targetPVCKey.Namespace,
+				Labels:      sourcePVC.Labels,
+				Annotations: map[string]string{"virtink.io/migrated-from": sourcePVC.Name},
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes:      sourcePVC.Spec.AccessModes,
+				Resources:        sourcePVC.Spec.Resources,
+				StorageClassName: sourcePVC.Spec.StorageClassName,
+				VolumeMode:       sourcePVC.Spec.VolumeMode,
+			},
+		}
+		// the target PVC is populated by the migration instead
+		if populatedFrom := sourcePVC.Annotations[populatedFromAnnotation]; populatedFrom != "" {
+			targetPVC.Annotations[populatedFromAnnotation] = populatedFrom
+		}
+		if err := controllerutil.SetControllerReference(vm, &targetPVC, r.Scheme); err != nil {
+			return fmt.Errorf("set target PVC controller reference: %s", err)
+		}
+		if err := r.Create(ctx, &targetPVC); err != nil {
+			return fmt.Errorf("create target PVC: %s", err)
+		}
+		r.Recorder.Eventf(vm, corev1.EventTypeNormal, "CreatedTargetPVC", "Created target PVC %q", targetPVC.Name)
+	}
+	return nil
+}
+
+func (r *VMReconciler) getPVCAndPV(ctx context.Context, namespace string, claimName string) (*corev1.PersistentVolumeClaim, *corev1.PersistentVolume, error) {
+	var pvc corev1.PersistentVolumeClaim
+	pvcKey := types.NamespacedName{
+		Name:      claimName,
+		Namespace: namespace,
+	}
+	if err := r.Get(ctx, pvcKey, &pvc); err != nil {
+		return nil, nil, fmt.Errorf("get PVC: %s", err)
+	}
+	if pvc.Spec.VolumeName == "" {
+		return &pvc, nil, nil
+	}
+
+	var pv corev1.PersistentVolume
+	if err := r.Get(ctx, types.NamespacedName{Name: pvc.Spec.VolumeName}, &pv); err != nil {
+		return nil, nil, fmt.Errorf("get PV: %s", err)
+	}
+	return &pvc, &pv, nil
+}
+
+// isNodeLocalPV reports whether the PV is only accessible from a single node,
+// such as local PVs or PVs provisioned by node-local CSI drivers.
+func isNodeLocalPV(pv *corev1.PersistentVolume) bool {
+	if pv.Spec.Local != nil {
+		return true
+	}
+	if pv.Spec.NodeAffinity == nil || pv.Spec.NodeAffinity.Required == nil {
+		return false
+	}
+	for _, term := range pv.Spec.NodeAffinity.Required.NodeSelectorTerms {
+		for _, expr := range term.MatchExpressions {
+			if expr.Key == corev1.LabelHostname && expr.Operator == corev1.NodeSelectorOpIn {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasAccessMode(pvc *corev1.PersistentVolumeClaim, accessMode corev1.PersistentVolumeAccessMode) bool {
+	for _, mode := range pvc.Spec.AccessModes {
+		if mode == accessMode {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *VMReconciler) reconcileVMConditions(ctx context.Context, vm *virtv1alpha1.VirtualMachine, vmPod *corev1.Pod) error {
 	for _, condition := range vmPod.Status.Conditions {
 		if condition.Type == corev1.PodReady {
@@ -688,26 +1454,295 @@ func (r *VMReconciler) reconcileVMConditions(ctx context.Context, vm *virtv1alph
 		}
 	}
 
-	if meta.FindStatusCondition(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineMigratable)) == nil {
-		migratableCondition, err := r.calculateMigratableCondition(ctx, vm)
+	if err := r.reconcileVolumesPopulatedCondition(ctx, vm, vmPod); err != nil {
+		return fmt.Errorf("reconcile volumes populated condition: %s", err)
+	}
+
+	if err := reconcileRestartRequiredCondition(vm, vmPod); err != nil {
+		return fmt.Errorf("reconcile restart required condition: %s", err)
+	}
+
+	// the condition was named Migratable before
+	conditions.Remove(&vm.Status.Conditions, "Migratable")
+	// volumes may be switched to other PVCs, so the conditions are computed
+	// on every reconciliation rather than once
+	liveMigratableCondition, err := r.calculateMigratableCondition(ctx, vm, virtv1alpha1.VirtualMachineMigrationLive)
+	if err != nil {
+		return fmt.Errorf("calculate VM migratable condition: %s", err)
+	}
+	meta.SetStatusCondition(&vm.Status.Conditions, *liveMigratableCondition)
+	offlineMigratableCondition, err := r.calculateMigratableCondition(ctx, vm, virtv1alpha1.VirtualMachineMigrationOffline)
+	if err != nil {
+		return fmt.Errorf("calculate VM offline migratable condition: %s", err)
+	}
+	meta.SetStatusCondition(&vm.Status.Conditions, *offlineMigratableCondition)
+	return nil
+}
+
+// getVMAddresses returns the addresses of the running VM, which are the IPs
+// of the VM pod, since they are owned by or forwarded to the guest.
+func getVMAddresses(vm *virtv1alpha1.VirtualMachine, vmPod *corev1.Pod) []virtv1alpha1.VirtualMachineAddress {
+	addresses := []virtv1alpha1.VirtualMachineAddress{{
+		Type:    virtv1alpha1.VirtualMachineHostname,
+		Address: vm.Name,
+	}}
+	for _, podIP := range vmPod.Status.PodIPs {
+		addresses = append(addresses, virtv1alpha1.VirtualMachineAddress{
+			Type:    virtv1alpha1.VirtualMachineInternalIP,
+			Address: podIP.IP,
+		})
+	}
+	return addresses
+}
+
+// reconcileDataVolumesReadyCondition sets the DataVolumesReady condition of
+// the VM, and returns whether all data volumes of the VM are populated.
+func (r *VMReconciler) reconcileDataVolumesReadyCondition(ctx context.Context, vm *virtv1alpha1.VirtualMachine) (bool, error) {
+	for _, volume := range vm.Spec.Volumes {
+		if volume.DataVolume == nil {
+			continue
+		}
+
+		var pvc corev1.PersistentVolumeClaim
+		pvcKey := types.NamespacedName{
+			Name:      volume.DataVolume.VolumeName,
+			Namespace: vm.Namespace,
+		}
+		if err := r.Client.Get(ctx, pvcKey, &pvc); err != nil {
+			if apierrors.IsNotFound(err) {
+				conditions.MarkFalse(&vm.Status.Conditions, string(virtv1alpha1.VirtualMachineDataVolumesReady), conditions.ReasonDataVolumeNotReady,
+					"PVC of data volume %q is not found", volume.DataVolume.VolumeName)
+				return false, nil
+			}
+			return false, fmt.Errorf("get PVC: %s", err)
+		}
+
+		var getDataVolumeFunc = func(name, namespace string) (*cdiv1beta1.DataVolume, error) {
+			var dv cdiv1beta1.DataVolume
+			dvKey := types.NamespacedName{
+				Name:      name,
+				Namespace: namespace,
+			}
+			if err := r.Client.Get(ctx, dvKey, &dv); err != nil {
+				return nil, err
+			}
+			return &dv, nil
+		}
+		ready, err := cdiv1beta1.IsPopulated(&pvc, getDataVolumeFunc)
 		if err != nil {
-			return fmt.Errorf("calculate VM migratable condition: %s", err)
+			return false, fmt.Errorf("check data volume: %s", err)
+		}
+		if !ready {
+			conditions.MarkFalse(&vm.Status.Conditions, string(virtv1alpha1.VirtualMachineDataVolumesReady), conditions.ReasonDataVolumeNotReady,
+				"data volume %q is not populated", volume.DataVolume.VolumeName)
+			return false, nil
 		}
-		meta.SetStatusCondition(&vm.Status.Conditions, *migratableCondition)
 	}
+
+	conditions.MarkTrue(&vm.Status.Conditions, string(virtv1alpha1.VirtualMachineDataVolumesReady), conditions.ReasonAllDataVolumesReady)
+	return true, nil
+}
+
+// reconcileVolumesPopulatedCondition sets the VolumesPopulated condition of
+// the VM from the init containers that populate its PVCs, and records the
+// populated PVCs so that they are not populated again.
+func (r *VMReconciler) reconcileVolumesPopulatedCondition(ctx context.Context, vm *virtv1alpha1.VirtualMachine, vmPod *corev1.Pod) error {
+	var populateVolumes []virtv1alpha1.Volume
+	for _, volume := range vm.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.Populate != nil {
+			populateVolumes = append(populateVolumes, volume)
+		}
+	}
+
+	for i, volume := range populateVolumes {
+		var pvc corev1.PersistentVolumeClaim
+		pvcKey := types.NamespacedName{
+			Name:      volume.PersistentVolumeClaim.ClaimName,
+			Namespace: vm.Namespace,
+		}
+		if err := r.Client.Get(ctx, pvcKey, &pvc); err != nil {
+			return fmt.Errorf("get PVC: %s", err)
+		}
+		if pvc.Annotations[populatedFromAnnotation] != "" {
+			continue
+		}
+
+		var containerStatus *corev1.ContainerStatus
+		for j := range vmPod.Status.InitContainerStatuses {
+			if vmPod.Status.InitContainerStatuses[j].Name == "init-volume-"+volume.Name {
+				containerStatus = &vmPod.Status.InitContainerStatuses[j]
+				break
+			}
+		}
+
+		switch {
+		case containerStatus != nil && containerStatus.State.Terminated != nil && containerStatus.State.Terminated.ExitCode == 0:
+			image := getPopulateSourceImage(volume.PersistentVolumeClaim.Populate)
+			patch := client.MergeFrom(pvc.DeepCopy())
+			if pvc.Annotations == nil {
+				pvc.Annotations = map[string]string{}
+			}
+			pvc.Annotations[populatedFromAnnotation] = image
+			if err := r.Client.Patch(ctx, &pvc, patch); err != nil {
+				return fmt.Errorf("patch PVC: %s", err)
+			}
+			r.Recorder.Eventf(vm, corev1.EventTypeNormal, "PopulatedVolume", "Populated volume %q with image %q", volume.Name, image)
+		case containerStatus != nil && containerStatus.State.Terminated != nil:
+			conditions.MarkFalse(&vm.Status.Conditions, string(virtv1alpha1.VirtualMachineVolumesPopulated), conditions.ReasonVolumePopulateFailed,
+				"failed to populate volume %q: %s", volume.Name, containerStatus.State.Terminated.Message)
+			return nil
+		case containerStatus != nil && containerStatus.State.Running != nil:
+			conditions.MarkFalse(&vm.Status.Conditions, string(virtv1alpha1.VirtualMachineVolumesPopulated), conditions.ReasonVolumePopulating,
+				"populating volume %q (%d/%d)", volume.Name, i+1, len(populateVolumes))
+			return nil
+		default:
+			conditions.MarkFalse(&vm.Status.Conditions, string(virtv1alpha1.VirtualMachineVolumesPopulated), conditions.ReasonVolumePopulating,
+				"waiting to populate volume %q (%d/%d)", volume.Name, i+1, len(populateVolumes))
+			return nil
+		}
+	}
+
+	conditions.MarkTrue(&vm.Status.Conditions, string(virtv1alpha1.VirtualMachineVolumesPopulated), conditions.ReasonAllVolumesPopulated)
 	return nil
 }
 
-func (r *VMReconciler) calculateMigratableCondition(ctx context.Context, vm *virtv1alpha1.VirtualMachine) (*metav1.Condition, error) {
+// buildServiceAccountTokenProjection projects the token of the service
+// account along with the files of the API server, the same as the kubelet
+// does for the service account of containers.
+func buildServiceAccountTokenProjection(source *virtv1alpha1.ServiceAccountTokenVolumeSource) *corev1.ProjectedVolumeSource {
+	return &corev1.ProjectedVolumeSource{
+		Sources: []corev1.VolumeProjection{{
+			ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+				Audience:          source.Audience,
+				ExpirationSeconds: source.ExpirationSeconds,
+				Path:              "token",
+			},
+		}, {
+			ConfigMap: &corev1.ConfigMapProjection{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: "kube-root-ca.crt",
+				},
+				Items: []corev1.KeyToPath{{
+					Key:  "ca.crt",
+					Path: "ca.crt",
+				}},
+			},
+		}, {
+			DownwardAPI: &corev1.DownwardAPIProjection{
+				Items: []corev1.DownwardAPIVolumeFile{{
+					Path: "namespace",
+					FieldRef: &corev1.ObjectFieldSelector{
+						APIVersion: "v1",
+						FieldPath:  "metadata.namespace",
+					},
+				}},
+			},
+		}},
+	}
+}
+
+// hasFileSystem tells whether a file system of the VM uses the volume.
+func hasFileSystem(vm *virtv1alpha1.VirtualMachine, volumeName string) bool {
+	for _, fs := range vm.Spec.Instance.FileSystems {
+		if fs.Name == volumeName {
+			return true
+		}
+	}
+	return false
+}
+
+// isEphemeralDisk tells whether the disk of the volume is ephemeral.
+func isEphemeralDisk(vm *virtv1alpha1.VirtualMachine, volumeName string) bool {
+	for _, disk := range vm.Spec.Instance.Disks {
+		if disk.Name == volumeName {
+			return disk.Ephemeral
+		}
+	}
+	return false
+}
+
+// getPopulateSourceImage returns the container disk image or the URL of the
+// image the PVC is populated with.
+func getPopulateSourceImage(populate *virtv1alpha1.PersistentVolumeClaimPopulateSource) string {
+	if populate.HTTPDisk != nil {
+		return populate.HTTPDisk.URL
+	}
+	return populate.ContainerDisk.Image
+}
+
+// buildHTTPDiskImporter builds the init container that downloads the HTTP disk
+// image of the volume, along with the scratch volume the image is downloaded to
+// before it's converted. The destination of the image is added by the caller.
+// The importer logs the progress of the download.
+func buildHTTPDiskImporter(vm *virtv1alpha1.VirtualMachine, volumeName string, source *virtv1alpha1.HTTPDiskVolumeSource, prerunnerImageName string) (corev1.Container, corev1.Volume) {
+	scratchVolume := corev1.Volume{
+		Name: "virtink-scratch-" + volumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}
+	return corev1.Container{
+		Name:      "init-volume-" + volumeName,
+		Image:     prerunnerImageName,
+		Resources: vm.Spec.Resources,
+		Command:   []string{"virt-init-volume"},
+		Args:      []string{"http", source.URL, source.Checksum, "/mnt/virtink-scratch"},
+		VolumeMounts: []corev1.VolumeMount{{
+			Name:      scratchVolume.Name,
+			MountPath: "/mnt/virtink-scratch",
+		}},
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+	}, scratchVolume
+}
+
+// calculateMigratableCondition tells whether the VM can be migrated by a VMM
+// of the type. Offline migrations copy container disks along with the VM
+// snapshot, so they are not limited by containerRootfs, containerDisk,
+// httpDisk and emptyDisk volumes in the ephemeral storage of the VM pod.
+func (r *VMReconciler) calculateMigratableCondition(ctx context.Context, vm *virtv1alpha1.VirtualMachine, migrationType virtv1alpha1.VirtualMachineMigrationType) (*metav1.Condition, error) {
+	conditionType := string(virtv1alpha1.VirtualMachineLiveMigratable)
+	if migrationType == virtv1alpha1.VirtualMachineMigrationOffline {
+		conditionType = string(virtv1alpha1.VirtualMachineOfflineMigratable)
+	}
+
+	// the target VM pod would be built from the changed spec
+	if conditions.IsTrue(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineRestartRequired)) {
+		return &metav1.Condition{
+			Type:    conditionType,
+			Status:  metav1.ConditionFalse,
+			Reason:  conditions.ReasonRestartRequired,
+			Message: "VM spec has changes which need a restart",
+		}, nil
+	}
+
+	if !usesCloudHypervisor(&vm.Spec.Instance) {
+		return &metav1.Condition{
+			Type:    conditionType,
+			Status:  metav1.ConditionFalse,
+			Reason:  conditions.ReasonHypervisorNotMigratable,
+			Message: fmt.Sprintf("migration is not supported by %s", vm.Spec.Instance.Hypervisor),
+		}, nil
+	}
+
 	if vm.Spec.Instance.CPU.DedicatedCPUPlacement {
 		return &metav1.Condition{
-			Type:    string(virtv1alpha1.VirtualMachineMigratable),
+			Type:    conditionType,
 			Status:  metav1.ConditionFalse,
-			Reason:  "CPUNotMigratable",
+			Reason:  conditions.ReasonCPUNotMigratable,
 			Message: "migration is disabled when VM has enabled dedicated CPU placement",
 		}, nil
 	}
 
+	// the state of virtiofsd is not migrated along with the VM
+	if len(vm.Spec.Instance.FileSystems) > 0 {
+		return &metav1.Condition{
+			Type:    conditionType,
+			Status:  metav1.ConditionFalse,
+			Reason:  conditions.ReasonDeviceNotMigratable,
+			Message: "migration is disabled when VM has a virtio-fs file system",
+		}, nil
+	}
+
 	for _, network := range vm.Spec.Networks {
 		for _, iface := range vm.Spec.Instance.Interfaces {
 			if iface.Name != network.Name {
@@ -715,54 +1750,134 @@ func (r *VMReconciler) calculateMigratableCondition(ctx context.Context, vm *vir
 			}
 			if network.Pod != nil && iface.Bridge != nil {
 				return &metav1.Condition{
-					Type:    string(virtv1alpha1.VirtualMachineMigratable),
+					Type:    conditionType,
 					Status:  metav1.ConditionFalse,
-					Reason:  "InterfaceNotMigratable",
+					Reason:  conditions.ReasonInterfaceNotMigratable,
 					Message: "migration is disabled when VM has a bridged interface to the pod network",
 				}, nil
 			}
 			if iface.SRIOV != nil {
 				return &metav1.Condition{
-					Type:    string(virtv1alpha1.VirtualMachineMigratable),
+					Type:    conditionType,
 					Status:  metav1.ConditionFalse,
-					Reason:  "InterfaceNotMigratable",
+					Reason:  conditions.ReasonInterfaceNotMigratable,
 					Message: "migration is disabled when VM has a SR-IOV interface",
 				}, nil
 			}
 			if iface.VhostUser != nil {
 				return &metav1.Condition{
-					Type:    string(virtv1alpha1.VirtualMachineMigratable),
+					Type:    conditionType,
 					Status:  metav1.ConditionFalse,
-					Reason:  "InterfaceNotMigratable",
+					Reason:  conditions.ReasonInterfaceNotMigratable,
 					Message: "migration is disable when VM has a vhost-user interface",
 				}, nil
 			}
 		}
 	}
 
+	for _, disk := range vm.Spec.Instance.Disks {
+		// the overlays of ephemeral disks are left behind on the source node
+		if disk.Ephemeral {
+			return &metav1.Condition{
+				Type:    conditionType,
+				Status:  metav1.ConditionFalse,
+				Reason:  conditions.ReasonVolumeNotMigratable,
+				Message: "migration is disabled when VM has an ephemeral disk",
+			}, nil
+		}
+	}
+
 	for _, volume := range vm.Spec.Volumes {
-		if volume.ContainerRootfs != nil {
+		if volume.ContainerRootfs != nil && migrationType != virtv1alpha1.VirtualMachineMigrationOffline {
 			return &metav1.Condition{
-				Type:    string(virtv1alpha1.VirtualMachineMigratable),
+				Type:    conditionType,
 				Status:  metav1.ConditionFalse,
-				Reason:  "VolumeNotMigratable",
+				Reason:  conditions.ReasonVolumeNotMigratable,
 				Message: "migration is disabled when VM has a containerRootfs volume",
 			}, nil
 		}
-		if volume.ContainerDisk != nil {
+		if volume.ContainerDisk != nil && migrationType != virtv1alpha1.VirtualMachineMigrationOffline {
 			return &metav1.Condition{
-				Type:    string(virtv1alpha1.VirtualMachineMigratable),
+				Type:    conditionType,
 				Status:  metav1.ConditionFalse,
-				Reason:  "VolumeNotMigratable",
+				Reason:  conditions.ReasonVolumeNotMigratable,
 				Message: "migration is disabled when VM has a containerDisk volume",
 			}, nil
 		}
+		if volume.HTTPDisk != nil && migrationType != virtv1alpha1.VirtualMachineMigrationOffline {
+			return &metav1.Condition{
+				Type:    conditionType,
+				Status:  metav1.ConditionFalse,
+				Reason:  conditions.ReasonVolumeNotMigratable,
+				Message: "migration is disabled when VM has an httpDisk volume",
+			}, nil
+		}
+		if ((volume.ConfigMap != nil && volume.ConfigMap.Format == virtv1alpha1.ConfigDiskFormatFAT) || (volume.Secret != nil && volume.Secret.Format == virtv1alpha1.ConfigDiskFormatFAT)) &&
+			!hasFileSystem(vm, volume.Name) && migrationType != virtv1alpha1.VirtualMachineMigrationOffline {
+			return &metav1.Condition{
+				Type:    conditionType,
+				Status:  metav1.ConditionFalse,
+				Reason:  conditions.ReasonVolumeNotMigratable,
+				Message: "migration is disabled when VM has a config map or secret volume of fat format",
+			}, nil
+		}
+		if volume.EmptyDisk != nil && (volume.EmptyDisk.VolumeClaim != nil || migrationType != virtv1alpha1.VirtualMachineMigrationOffline) {
+			return &metav1.Condition{
+				Type:    conditionType,
+				Status:  metav1.ConditionFalse,
+				Reason:  conditions.ReasonVolumeNotMigratable,
+				Message: "migration is disabled when VM has an emptyDisk volume",
+			}, nil
+		}
+		if volume.PersistentVolumeClaim != nil || volume.DataVolume != nil {
+			// writes cached by the source node would be missed by the target node
+			for _, disk := range vm.Spec.Instance.Disks {
+				if disk.Name == volume.Name && disk.Cache != "" && disk.Cache != virtv1alpha1.DiskCacheNone && migrationType != virtv1alpha1.VirtualMachineMigrationOffline {
+					return &metav1.Condition{
+						Type:    conditionType,
+						Status:  metav1.ConditionFalse,
+						Reason:  conditions.ReasonVolumeNotMigratable,
+						Message: fmt.Sprintf("migration is disabled when VM has a PVC disk with %s cache", disk.Cache),
+					}, nil
+				}
+			}
+
+			var claimName string
+			if volume.PersistentVolumeClaim != nil {
+				claimName = volume.PersistentVolumeClaim.ClaimName
+			} else {
+				claimName = volume.DataVolume.VolumeName
+			}
+			pvc, pv, err := r.getPVCAndPV(ctx, vm.Namespace, claimName)
+			if err != nil {
+				return nil, err
+			}
+			if pv == nil || !isNodeLocalPV(pv) {
+				continue
+			}
+			if volume.DataVolume != nil {
+				return &metav1.Condition{
+					Type:    conditionType,
+					Status:  metav1.ConditionFalse,
+					Reason:  conditions.ReasonVolumeNotMigratable,
+					Message: "migration is disabled when VM has a node-local dataVolume volume",
+				}, nil
+			}
+			if pvc.Spec.VolumeMode != nil && *pvc.Spec.VolumeMode == corev1.PersistentVolumeBlock {
+				return &metav1.Condition{
+					Type:    conditionType,
+					Status:  metav1.ConditionFalse,
+					Reason:  conditions.ReasonVolumeNotMigratable,
+					Message: "migration is disabled when VM has a node-local block volume",
+				}, nil
+			}
+		}
 	}
 
 	return &metav1.Condition{
-		Type:   string(virtv1alpha1.VirtualMachineMigratable),
+		Type:   conditionType,
 		Status: metav1.ConditionTrue,
-		Reason: "Migratable",
+		Reason: conditions.ReasonMigratable,
 	}, nil
 }
 
@@ -789,19 +1904,62 @@ func (r *VMReconciler) gcVMPods(ctx context.Context, vm *virtv1alpha1.VirtualMac
 	return nil
 }
 
+// finalize deletes all pods of the VM, including the migration target pod, and
+// keeps the VM until they are gone. Tap devices and sockets of the VM live in
+// its pods, so they are released together with them.
+func (r *VMReconciler) finalize(ctx context.Context, vm *virtv1alpha1.VirtualMachine) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(vm, vmProtectionFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	var vmPodList corev1.PodList
+	if err := r.List(ctx, &vmPodList, client.MatchingFields{"vmUID": string(vm.UID)}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("list VM Pods: %s", err)
+	}
+
+	for _, vmPod := range vmPodList.Items {
+		if vmPod.DeletionTimestamp != nil && !vmPod.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := r.Delete(ctx, &vmPod); client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, fmt.Errorf("delete VM Pod: %s", err)
+		}
+		r.Recorder.Eventf(vm, corev1.EventTypeNormal, "DeletedVMPod", "Deleted VM Pod %q", vmPod.Name)
+	}
+	if len(vmPodList.Items) > 0 {
+		// removal of the pods will trigger another reconciliation
+		return ctrl.Result{}, nil
+	}
+
+	controllerutil.RemoveFinalizer(vm, vmProtectionFinalizer)
+	if err := r.Update(ctx, vm); err != nil {
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("remove VM finalizer: %s", err)
+	}
+	return ctrl.Result{}, nil
+}
+
 func incrementContainerResource(container *corev1.Container, resourceName string) {
+	addContainerResource(container, resourceName, 1)
+}
+
+// addContainerResource adds count to the request and limit of the extended
+// resource, of which requests must equal limits.
+func addContainerResource(container *corev1.Container, resourceName string, count int64) {
 	if container.Resources.Requests == nil {
 		container.Resources.Requests = corev1.ResourceList{}
 	}
 	request := container.Resources.Requests[corev1.ResourceName(resourceName)]
-	request = resource.MustParse(strconv.FormatInt(request.Value()+1, 10))
+	request = resource.MustParse(strconv.FormatInt(request.Value()+count, 10))
 	container.Resources.Requests[corev1.ResourceName(resourceName)] = request
 
 	if container.Resources.Limits == nil {
 		container.Resources.Limits = corev1.ResourceList{}
 	}
 	limit := container.Resources.Limits[corev1.ResourceName(resourceName)]
-	limit = resource.MustParse(strconv.FormatInt(limit.Value()+1, 10))
+	limit = resource.MustParse(strconv.FormatInt(limit.Value()+count, 10))
 	container.Resources.Limits[corev1.ResourceName(resourceName)] = limit
 }
 
@@ -819,6 +1977,13 @@ func (r *VMReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&virtv1alpha1.VirtualMachine{}).
-		Owns(&corev1.Pod{}).
+		Watches(&source.Kind{Type: &corev1.Pod{}}, &batchingEventHandler{
+			EventHandler: &handler.EnqueueRequestForOwner{OwnerType: &virtv1alpha1.VirtualMachine{}, IsController: true},
+			BatchPeriod:  r.BatchPeriod,
+		}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+			RateLimiter:             r.RateLimiter,
+		}).
 		Complete(r)
 }
@@ -7,9 +7,11 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
 )
@@ -73,6 +75,40 @@ var _ = Describe("VM controller", func() {
 				return k8sClient.Get(ctx, vmPodKey, &vmPod) == nil
 			}).Should(BeTrue())
 		})
+
+		It("should add the VM protection finalizer", func() {
+			Eventually(func() bool {
+				var vm virtv1alpha1.VirtualMachine
+				Expect(k8sClient.Get(ctx, vmKey, &vm)).To(Succeed())
+				return controllerutil.ContainsFinalizer(&vm, vmProtectionFinalizer)
+			}).Should(BeTrue())
+		})
+
+		It("should delete the VM pod before the VM is removed", func() {
+			var vm virtv1alpha1.VirtualMachine
+			Eventually(func() bool {
+				Expect(k8sClient.Get(ctx, vmKey, &vm)).To(Succeed())
+				return vm.Status.VMPodName != ""
+			}).Should(BeTrue())
+
+			vmPodKey := types.NamespacedName{Name: vm.Status.VMPodName, Namespace: vmKey.Namespace}
+			Eventually(func() bool {
+				var vmPod corev1.Pod
+				return k8sClient.Get(ctx, vmPodKey, &vmPod) == nil
+			}).Should(BeTrue())
+
+			By("deleting the VM")
+			Expect(k8sClient.Delete(ctx, &vm)).To(Succeed())
+
+			Eventually(func() bool {
+				var vmPod corev1.Pod
+				return apierrors.IsNotFound(k8sClient.Get(ctx, vmPodKey, &vmPod))
+			}).Should(BeTrue())
+			Eventually(func() bool {
+				var vm virtv1alpha1.VirtualMachine
+				return apierrors.IsNotFound(k8sClient.Get(ctx, vmKey, &vm))
+			}).Should(BeTrue())
+		})
 	})
 
 	Context("for a Scheduling VM", func() {
@@ -293,6 +329,35 @@ var _ = Describe("VM controller", func() {
 			})
 		})
 	})
+
+	Context("for a VM with a sysprep volume", func() {
+		It("should be created", func() {
+			vm := virtv1alpha1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      uuid.New().String(),
+					Namespace: "default",
+				},
+				Spec: virtv1alpha1.VirtualMachineSpec{
+					RunPolicy: virtv1alpha1.RunPolicyManual,
+					Instance: virtv1alpha1.Instance{
+						Disks: []virtv1alpha1.Disk{{
+							Name: "sysprep",
+							Type: virtv1alpha1.DiskTypeCDROM,
+						}},
+					},
+					Volumes: []virtv1alpha1.Volume{{
+						Name: "sysprep",
+						VolumeSource: virtv1alpha1.VolumeSource{
+							Sysprep: &virtv1alpha1.SysprepVolumeSource{
+								ConfigMapName: "unattend",
+							},
+						},
+					}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, &vm)).To(Succeed())
+		})
+	})
 })
 
 func bindPod(ctx context.Context, podKey types.NamespacedName, nodeName string) error {
@@ -0,0 +1,174 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	netv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/conditions"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+// findVMNodes returns the nodes the VM pod of the VM can be scheduled to, and
+// why the other nodes can't be. Nodes are checked against their allocatable
+// resources rather than what is left of them, so that VMs no node can ever
+// run are told apart from VMs waiting for resources to be freed.
+func findVMNodes(ctx context.Context, c client.Reader, vm *virtv1alpha1.VirtualMachine) ([]string, []string, error) {
+	requests := corev1.ResourceList{}
+	for name, quantity := range vm.Spec.Resources.Requests {
+		requests[name] = quantity.DeepCopy()
+	}
+	addRequest := func(name corev1.ResourceName) {
+		quantity := requests[name]
+		quantity.Add(resource.MustParse("1"))
+		requests[name] = quantity
+	}
+	// the extended resources requested by the VM pod, as buildVMPod does
+	addRequest("devices.virtink.io/kvm")
+	addRequest("devices.virtink.io/tun")
+	for _, iface := range vm.Spec.Instance.Interfaces {
+		if iface.Vhost {
+			addRequest("devices.virtink.io/vhost-net")
+			break
+		}
+	}
+	config, err := virtinkconfig.Get(ctx, c)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get Virtink config: %s", err)
+	}
+	if config.Spec.VCPUResource.Enabled {
+		requests[virtv1beta1.VCPUResourceName] = *resource.NewQuantity(int64(vm.Spec.Instance.CPU.Sockets*vm.Spec.Instance.CPU.CoresPerSocket), resource.DecimalSI)
+	}
+	for _, network := range vm.Spec.Networks {
+		if network.Multus == nil {
+			continue
+		}
+		var nad netv1.NetworkAttachmentDefinition
+		if err := c.Get(ctx, client.ObjectKey{Namespace: vm.Namespace, Name: network.Multus.NetworkName}, &nad); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, []string{fmt.Sprintf("NAD %q not found", network.Multus.NetworkName)}, nil
+			}
+			return nil, nil, fmt.Errorf("get NAD: %s", err)
+		}
+		if resourceName := nad.Annotations["k8s.v1.cni.cncf.io/resourceName"]; resourceName != "" {
+			addRequest(corev1.ResourceName(resourceName))
+		}
+	}
+	var resourceNames []string
+	for name := range requests {
+		resourceNames = append(resourceNames, string(name))
+	}
+	sort.Strings(resourceNames)
+
+	// PVCs not created yet, e.g. by DataVolumes, are left to the scheduler
+	var pvs []*corev1.PersistentVolume
+	for _, volume := range vm.Spec.Volumes {
+		claimName := ""
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			claimName = volume.PersistentVolumeClaim.ClaimName
+		case volume.DataVolume != nil:
+			claimName = volume.DataVolume.VolumeName
+		default:
+			continue
+		}
+		var pvc corev1.PersistentVolumeClaim
+		if err := c.Get(ctx, client.ObjectKey{Namespace: vm.Namespace, Name: claimName}, &pvc); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, nil, fmt.Errorf("get PVC: %s", err)
+		}
+		if pvc.Spec.VolumeName == "" {
+			continue
+		}
+		var pv corev1.PersistentVolume
+		if err := c.Get(ctx, client.ObjectKey{Name: pvc.Spec.VolumeName}, &pv); err != nil {
+			return nil, nil, fmt.Errorf("get PV: %s", err)
+		}
+		if pv.Spec.NodeAffinity != nil && pv.Spec.NodeAffinity.Required != nil {
+			pvs = append(pvs, &pv)
+		}
+	}
+
+	var nodeList corev1.NodeList
+	if err := c.List(ctx, &nodeList); err != nil {
+		return nil, nil, fmt.Errorf("list nodes: %s", err)
+	}
+	sort.Slice(nodeList.Items, func(i, j int) bool {
+		return nodeList.Items[i].Name < nodeList.Items[j].Name
+	})
+
+	var nodes, reasons []string
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		reason := func() string {
+			if reason := checkNodeConstraints(node, vm.Spec.NodeSelector, vm.Spec.Affinity, vm.Spec.Tolerations); reason != "" {
+				return reason
+			}
+			var insufficient []string
+			for _, name := range resourceNames {
+				request := requests[corev1.ResourceName(name)]
+				if allocatable := node.Status.Allocatable[corev1.ResourceName(name)]; allocatable.Cmp(request) < 0 {
+					insufficient = append(insufficient, fmt.Sprintf("%s (%s requested, %s allocatable)", name, request.String(), allocatable.String()))
+				}
+			}
+			if len(insufficient) > 0 {
+				return fmt.Sprintf("insufficient %s", strings.Join(insufficient, ", "))
+			}
+			for _, pv := range pvs {
+				if !matchNodeSelectorTerms(node, pv.Spec.NodeAffinity.Required.NodeSelectorTerms) {
+					return fmt.Sprintf("PV %q not accessible", pv.Name)
+				}
+			}
+			return ""
+		}()
+		if reason == "" {
+			nodes = append(nodes, node.Name)
+		} else {
+			reasons = append(reasons, fmt.Sprintf("node %q: %s", node.Name, reason))
+		}
+	}
+	if len(nodes) == 0 && len(reasons) == 0 {
+		reasons = append(reasons, "no nodes")
+	}
+	return nodes, reasons, nil
+}
+
+// reconcileSchedulableCondition tells why the VM pod is not scheduled. The
+// reasons of each node are only reported if no node can ever run the VM,
+// otherwise the message of the scheduler is.
+func (r *VMReconciler) reconcileSchedulableCondition(ctx context.Context, vm *virtv1alpha1.VirtualMachine, vmPod *corev1.Pod) error {
+	for _, condition := range vmPod.Status.Conditions {
+		if condition.Type != corev1.PodScheduled {
+			continue
+		}
+		if condition.Status == corev1.ConditionTrue {
+			conditions.MarkTrue(&vm.Status.Conditions, string(virtv1alpha1.VirtualMachineSchedulable), conditions.ReasonScheduled)
+			return nil
+		}
+		if condition.Reason != corev1.PodReasonUnschedulable {
+			return nil
+		}
+
+		nodes, reasons, err := findVMNodes(ctx, r.Client, vm)
+		if err != nil {
+			return err
+		}
+		if len(nodes) == 0 {
+			conditions.MarkFalse(&vm.Status.Conditions, string(virtv1alpha1.VirtualMachineSchedulable), conditions.ReasonNoFeasibleNode, "%s", strings.Join(reasons, "; "))
+		} else {
+			conditions.MarkFalse(&vm.Status.Conditions, string(virtv1alpha1.VirtualMachineSchedulable), conditions.ReasonUnschedulable, "%s", condition.Message)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	netv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/conditions"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+func TestFindVMNodes(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(virtv1beta1.AddToScheme(scheme))
+	utilruntime.Must(netv1.AddToScheme(scheme))
+
+	newNode := func(name string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{
+					Type:   corev1.NodeReady,
+					Status: corev1.ConditionTrue,
+				}},
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:       resource.MustParse("8"),
+					corev1.ResourceMemory:    resource.MustParse("16Gi"),
+					"hugepages-1Gi":          resource.MustParse("4Gi"),
+					"devices.virtink.io/kvm": resource.MustParse("1k"),
+					"devices.virtink.io/tun": resource.MustParse("1k"),
+					"intel.com/sriov":        resource.MustParse("4"),
+					"virtink.io/vcpu":        resource.MustParse("16"),
+				},
+			},
+		}
+	}
+
+	readyNode := newNode("node-0")
+	noKVMNode := newNode("node-1")
+	delete(noKVMNode.Status.Allocatable, "devices.virtink.io/kvm")
+	smallNode := newNode("node-2")
+	smallNode.Status.Allocatable["hugepages-1Gi"] = resource.MustParse("1Gi")
+	noSRIOVNode := newNode("node-3")
+	delete(noSRIOVNode.Status.Allocatable, "intel.com/sriov")
+	taintedNode := newNode("node-4")
+	taintedNode.Spec.Taints = []corev1.Taint{{
+		Key:    "dedicated",
+		Value:  "db",
+		Effect: corev1.TaintEffectNoSchedule,
+	}}
+
+	vm := &virtv1alpha1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vm",
+			Namespace: "default",
+		},
+		Spec: virtv1alpha1.VirtualMachineSpec{
+			Instance: virtv1alpha1.Instance{
+				CPU: virtv1alpha1.CPU{
+					Sockets:        1,
+					CoresPerSocket: 4,
+				},
+			},
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("2Gi"),
+					"hugepages-1Gi":       resource.MustParse("2Gi"),
+				},
+			},
+			Networks: []virtv1alpha1.Network{{
+				Name: "sriov",
+				NetworkSource: virtv1alpha1.NetworkSource{
+					Multus: &virtv1alpha1.MultusNetworkSource{
+						NetworkName: "sriov",
+					},
+				},
+			}},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(readyNode, noKVMNode, smallNode, noSRIOVNode, taintedNode).Build()
+	nodes, reasons, err := findVMNodes(context.Background(), c, vm)
+	require.NoError(t, err)
+	assert.Empty(t, nodes)
+	assert.Equal(t, []string{`NAD "sriov" not found`}, reasons)
+
+	nad := &netv1.NetworkAttachmentDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "sriov",
+			Namespace:   "default",
+			Annotations: map[string]string{"k8s.v1.cni.cncf.io/resourceName": "intel.com/sriov"},
+		},
+	}
+	require.NoError(t, c.Create(context.Background(), nad))
+	nodes, reasons, err = findVMNodes(context.Background(), c, vm)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"node-0"}, nodes)
+	assert.Equal(t, []string{
+		`node "node-1": insufficient devices.virtink.io/kvm (1 requested, 0 allocatable)`,
+		`node "node-2": insufficient hugepages-1Gi (2Gi requested, 1Gi allocatable)`,
+		`node "node-3": insufficient intel.com/sriov (1 requested, 0 allocatable)`,
+		`node "node-4": untolerated taint dedicated=db:NoSchedule`,
+	}, reasons)
+
+	config := &virtv1beta1.VirtinkConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: virtinkconfig.Name,
+		},
+		Spec: virtv1beta1.VirtinkConfigSpec{
+			VCPUResource: virtv1beta1.VirtinkConfigVCPUResource{
+				Enabled: true,
+			},
+		},
+	}
+	require.NoError(t, c.Create(context.Background(), config))
+	readyNode.Status.Allocatable[virtv1beta1.VCPUResourceName] = resource.MustParse("2")
+	require.NoError(t, c.Update(context.Background(), readyNode))
+	nodes, reasons, err = findVMNodes(context.Background(), c, vm)
+	require.NoError(t, err)
+	assert.Empty(t, nodes)
+	assert.Contains(t, reasons, `node "node-0": insufficient virtink.io/vcpu (4 requested, 2 allocatable)`)
+}
+
+func TestReconcileSchedulableCondition(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(virtv1beta1.AddToScheme(scheme))
+
+	vm := &virtv1alpha1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vm",
+			Namespace: "default",
+		},
+	}
+	vmPod := &corev1.Pod{
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{
+				Type:    corev1.PodScheduled,
+				Status:  corev1.ConditionFalse,
+				Reason:  corev1.PodReasonUnschedulable,
+				Message: "0/1 nodes are available: 1 Insufficient cpu.",
+			}},
+		},
+	}
+
+	r := &VMReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+	}
+	require.NoError(t, r.reconcileSchedulableCondition(context.Background(), vm, vmPod))
+	condition := conditions.Get(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineSchedulable))
+	require.NotNil(t, condition)
+	assert.Equal(t, conditions.ReasonNoFeasibleNode, condition.Reason)
+	assert.Equal(t, "no nodes", condition.Message)
+
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-0",
+		},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{
+				Type:   corev1.NodeReady,
+				Status: corev1.ConditionTrue,
+			}},
+			Allocatable: corev1.ResourceList{
+				"devices.virtink.io/kvm": resource.MustParse("1k"),
+				"devices.virtink.io/tun": resource.MustParse("1k"),
+			},
+		},
+	}
+	require.NoError(t, r.Create(context.Background(), node))
+	require.NoError(t, r.reconcileSchedulableCondition(context.Background(), vm, vmPod))
+	condition = conditions.Get(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineSchedulable))
+	assert.Equal(t, conditions.ReasonUnschedulable, condition.Reason)
+	assert.Equal(t, "0/1 nodes are available: 1 Insufficient cpu.", condition.Message)
+
+	vmPod.Status.Conditions[0].Status = corev1.ConditionTrue
+	require.NoError(t, r.reconcileSchedulableCondition(context.Background(), vm, vmPod))
+	assert.True(t, conditions.IsTrue(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineSchedulable)))
+}
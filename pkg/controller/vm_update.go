@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/r3labs/diff/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/conditions"
+)
+
+// reconcileRestartRequiredCondition sets the RestartRequired condition of the
+// running VM, by comparing its spec with the spec the VM pod was built from.
+func reconcileRestartRequiredCondition(vm *virtv1alpha1.VirtualMachine, vmPod *corev1.Pod) error {
+	runningVM, err := getVMPodVM(vmPod)
+	if err != nil {
+		return err
+	}
+
+	changedFields, err := getRestartRequiredVMSpecFields(vm, &runningVM.Spec)
+	if err != nil {
+		return err
+	}
+	if len(changedFields) == 0 {
+		conditions.Remove(&vm.Status.Conditions, string(virtv1alpha1.VirtualMachineRestartRequired))
+		return nil
+	}
+	meta.SetStatusCondition(&vm.Status.Conditions, metav1.Condition{
+		Type:    string(virtv1alpha1.VirtualMachineRestartRequired),
+		Status:  metav1.ConditionTrue,
+		Reason:  conditions.ReasonSpecChanged,
+		Message: fmt.Sprintf("changed fields: %s", strings.Join(changedFields, ", ")),
+	})
+	return nil
+}
+
+// getVMPodVM returns the VM the VM pod was built from, which is passed to
+// virt-prerunner.
+func getVMPodVM(vmPod *corev1.Pod) (*virtv1alpha1.VirtualMachine, error) {
+	for _, container := range vmPod.Spec.Containers {
+		if container.Name != "cloud-hypervisor" {
+			continue
+		}
+		for i := 0; i+1 < len(container.Args); i++ {
+			if container.Args[i] != "--vm-data" {
+				continue
+			}
+			vmJSON, err := base64.StdEncoding.DecodeString(container.Args[i+1])
+			if err != nil {
+				return nil, fmt.Errorf("decode VM data of VM Pod: %s", err)
+			}
+			var vm virtv1alpha1.VirtualMachine
+			if err := json.Unmarshal(vmJSON, &vm); err != nil {
+				return nil, fmt.Errorf("unmarshal VM data of VM Pod: %s", err)
+			}
+			return &vm, nil
+		}
+	}
+	return nil, fmt.Errorf("VM data not found in VM Pod")
+}
+
+// getRestartRequiredVMSpecFields returns the fields of the VM spec which have
+// changed since the running spec, other than those updated live. Fields of
+// added or removed list items are reported as the item.
+func getRestartRequiredVMSpecFields(vm *virtv1alpha1.VirtualMachine, runningSpec *virtv1alpha1.VirtualMachineSpec) ([]string, error) {
+	changes, err := diff.Diff(*runningSpec, vm.Spec, diff.SliceOrdering(true))
+	if err != nil {
+		return nil, fmt.Errorf("diff VM spec: %s", err)
+	}
+
+	fieldSet := map[string]bool{}
+	for _, change := range changes {
+		if isLiveUpdatableVMSpecPath(vm, change.Path) {
+			continue
+		}
+		// the VM pod of a succeeded migration uses the target PVCs before
+		// the volumes are switched to them
+		if isMigratedVolumeClaimChange(vm, change) || isMigratedVolumeClaimChange(vm, diff.Change{Path: change.Path, To: change.From}) {
+			continue
+		}
+		fieldSet[formatVMSpecPath(change.Path)] = true
+	}
+
+	var fields []string
+	for field := range fieldSet {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+// formatVMSpecPath formats the path of a diff change of the VM spec with the
+// JSON names of the fields, up to the first list item, such as
+// spec.instance.disks[0].
+func formatVMSpecPath(path []string) string {
+	fieldPath := "spec"
+	typ := reflect.TypeOf(virtv1alpha1.VirtualMachineSpec{})
+	for _, name := range path {
+		for typ.Kind() == reflect.Pointer {
+			typ = typ.Elem()
+		}
+		switch typ.Kind() {
+		case reflect.Struct:
+			structField, ok := typ.FieldByName(name)
+			if !ok {
+				return fieldPath
+			}
+			jsonName, _, _ := strings.Cut(structField.Tag.Get("json"), ",")
+			switch {
+			case jsonName != "":
+				fieldPath += "." + jsonName
+			case !structField.Anonymous:
+				// unexported fields of values such as quantities
+				return fieldPath
+			}
+			typ = structField.Type
+		case reflect.Slice, reflect.Map:
+			return fieldPath + "[" + name + "]"
+		default:
+			return fieldPath
+		}
+	}
+	return fieldPath
+}
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/conditions"
+)
+
+func TestFormatVMSpecPath(t *testing.T) {
+	tests := []struct {
+		path     []string
+		expected string
+	}{{
+		path:     []string{"Instance", "Memory", "Size"},
+		expected: "spec.instance.memory.size",
+	}, {
+		path:     []string{"Instance", "Memory", "Size", "i", "value"},
+		expected: "spec.instance.memory.size",
+	}, {
+		path:     []string{"Instance", "Disks", "1", "Cache"},
+		expected: "spec.instance.disks[1]",
+	}, {
+		path:     []string{"Volumes", "0", "VolumeSource", "ContainerDisk", "Image"},
+		expected: "spec.volumes[0]",
+	}, {
+		path:     []string{"MetadataService", "UserData"},
+		expected: "spec.metadataService.userData",
+	}, {
+		path:     []string{"NodeSelector", "zone"},
+		expected: "spec.nodeSelector[zone]",
+	}}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, formatVMSpecPath(tc.path))
+	}
+}
+
+func TestReconcileRestartRequiredCondition(t *testing.T) {
+	runningVM := &virtv1alpha1.VirtualMachine{
+		Spec: virtv1alpha1.VirtualMachineSpec{
+			RunPolicy: virtv1alpha1.RunPolicyOnce,
+			Instance: virtv1alpha1.Instance{
+				Memory: virtv1alpha1.Memory{
+					Size: resource.MustParse("1Gi"),
+				},
+				Disks: []virtv1alpha1.Disk{{
+					Name: "ubuntu",
+				}},
+			},
+			Volumes: []virtv1alpha1.Volume{{
+				Name: "ubuntu",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					ContainerDisk: &virtv1alpha1.ContainerDiskVolumeSource{
+						Image: "ubuntu:22.04",
+					},
+				},
+			}},
+		},
+	}
+	vmJSON, err := json.Marshal(runningVM)
+	require.NoError(t, err)
+	vmPod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name: "cloud-hypervisor",
+				Args: []string{"--vm-data", base64.StdEncoding.EncodeToString(vmJSON)},
+			}},
+		},
+	}
+
+	vm := runningVM.DeepCopy()
+	vm.Spec.RunPolicy = virtv1alpha1.RunPolicyAlways
+	require.NoError(t, reconcileRestartRequiredCondition(vm, vmPod))
+	assert.Nil(t, conditions.Get(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineRestartRequired)))
+
+	vm.Spec.Volumes[0].ContainerDisk.Image = "ubuntu:24.04"
+	vm.Spec.Hostname = "ubuntu"
+	vm.Spec.Instance.Disks[0].RateLimit = &virtv1alpha1.DiskRateLimit{IOPS: 1000}
+	require.NoError(t, reconcileRestartRequiredCondition(vm, vmPod))
+	condition := conditions.Get(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineRestartRequired))
+	require.NotNil(t, condition)
+	assert.True(t, conditions.IsTrue(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineRestartRequired)))
+	assert.Equal(t, "changed fields: spec.hostname, spec.instance.disks[0], spec.volumes[0]", condition.Message)
+
+	vm.Spec = *runningVM.Spec.DeepCopy()
+	require.NoError(t, reconcileRestartRequiredCondition(vm, vmPod))
+	assert.Nil(t, conditions.Get(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineRestartRequired)))
+
+	vm.Spec.Instance.CPU.Sockets = 2
+	vm.Spec.Instance.Memory.Size = resource.MustParse("2Gi")
+	vm.Spec.Instance.Kernel = &virtv1alpha1.Kernel{Image: "smartxworks/virtink-kernel-5.15.12", Cmdline: "console=ttyS0 root=/dev/vda rw"}
+	vm.Spec.Instance.Firmware = &virtv1alpha1.Firmware{EFI: &virtv1alpha1.EFIFirmware{}}
+	require.NoError(t, reconcileRestartRequiredCondition(vm, vmPod))
+	condition = conditions.Get(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineRestartRequired))
+	require.NotNil(t, condition)
+	assert.Equal(t, "changed fields: spec.instance.cpu.sockets, spec.instance.firmware, spec.instance.kernel, spec.instance.memory.size", condition.Message)
+
+	vm.Spec = *runningVM.Spec.DeepCopy()
+	require.NoError(t, reconcileRestartRequiredCondition(vm, vmPod))
+	assert.Nil(t, conditions.Get(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineRestartRequired)))
+
+	// rate limits of QEMU drives are updated by virt-daemon
+	runningVM.Spec.Instance.Hypervisor = virtv1alpha1.HypervisorQEMU
+	vmJSON, err = json.Marshal(runningVM)
+	require.NoError(t, err)
+	vmPod.Spec.Containers[0].Args[1] = base64.StdEncoding.EncodeToString(vmJSON)
+	vm.Spec = *runningVM.Spec.DeepCopy()
+	vm.Spec.Instance.Disks[0].RateLimit = &virtv1alpha1.DiskRateLimit{IOPS: 1000}
+	require.NoError(t, reconcileRestartRequiredCondition(vm, vmPod))
+	assert.Nil(t, conditions.Get(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineRestartRequired)))
+}
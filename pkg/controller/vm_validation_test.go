@@ -0,0 +1,437 @@
+package controller
+
+import (
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+// The CEL rules of the VM CRD are enforced by kube-apiserver without the
+// validating webhook, which is not run by the test environment.
+var _ = Describe("VM CEL validation", func() {
+	newVM := func() *virtv1alpha1.VirtualMachine {
+		return &virtv1alpha1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      uuid.New().String(),
+				Namespace: "default",
+			},
+			Spec: virtv1alpha1.VirtualMachineSpec{
+				RunPolicy: virtv1alpha1.RunPolicyManual,
+				Instance: virtv1alpha1.Instance{
+					CPU: virtv1alpha1.CPU{
+						Sockets:        1,
+						CoresPerSocket: 1,
+					},
+					Memory: virtv1alpha1.Memory{
+						Size: resource.MustParse("1Gi"),
+					},
+					Disks: []virtv1alpha1.Disk{{
+						Name: "ubuntu",
+					}},
+					Interfaces: []virtv1alpha1.Interface{{
+						Name: "pod",
+						InterfaceBindingMethod: virtv1alpha1.InterfaceBindingMethod{
+							Masquerade: &virtv1alpha1.InterfaceMasquerade{CIDR: "10.0.2.0/30"},
+						},
+					}},
+				},
+				Volumes: []virtv1alpha1.Volume{{
+					Name: "ubuntu",
+					VolumeSource: virtv1alpha1.VolumeSource{
+						ContainerDisk: &virtv1alpha1.ContainerDiskVolumeSource{
+							Image: "smartxworks/virtink-container-disk-ubuntu",
+						},
+					},
+				}},
+				Networks: []virtv1alpha1.Network{{
+					Name: "pod",
+					NetworkSource: virtv1alpha1.NetworkSource{
+						Pod: &virtv1alpha1.PodNetworkSource{},
+					},
+				}},
+			},
+		}
+	}
+
+	expectInvalid := func(err error, message string) {
+		Expect(apierrors.IsInvalid(err)).To(BeTrue(), "%v", err)
+		Expect(err.Error()).To(ContainSubstring(message))
+	}
+
+	// updateVM creates the VM, then patches it with the mutation and returns
+	// the error of the patch. Patches don't conflict with the status updates
+	// of the VM controller.
+	updateVM := func(vm *virtv1alpha1.VirtualMachine, mutate func(vm *virtv1alpha1.VirtualMachine)) error {
+		Expect(k8sClient.Create(ctx, vm)).To(Succeed())
+		patch := client.MergeFrom(vm.DeepCopy())
+		mutate(vm)
+		return k8sClient.Patch(ctx, vm, patch)
+	}
+
+	Context("on create", func() {
+		It("should accept a valid VM", func() {
+			vm := newVM()
+			vm.Spec.Volumes = append(vm.Spec.Volumes, virtv1alpha1.Volume{
+				Name: "cloud-init",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					CloudInit: &virtv1alpha1.CloudInitVolumeSource{
+						UserData:            "#cloud-config",
+						GenerateNetworkData: true,
+					},
+				},
+			})
+			Expect(k8sClient.Create(ctx, vm)).To(Succeed())
+		})
+
+		It("should reject an interface without a network of the same name", func() {
+			vm := newVM()
+			vm.Spec.Networks[0].Name = "multus"
+			expectInvalid(k8sClient.Create(ctx, vm), "every interface must have a network of the same name")
+
+			vm = newVM()
+			vm.Spec.Networks = nil
+			expectInvalid(k8sClient.Create(ctx, vm), "every interface must have a network of the same name")
+		})
+
+		It("should reject an interface with more than 1 binding method", func() {
+			vm := newVM()
+			vm.Spec.Instance.Interfaces[0].Bridge = &virtv1alpha1.InterfaceBridge{}
+			expectInvalid(k8sClient.Create(ctx, vm), "may not specify more than 1 binding method")
+		})
+
+		It("should reject a volume without exactly 1 source", func() {
+			vm := newVM()
+			vm.Spec.Volumes[0].ContainerDisk = nil
+			expectInvalid(k8sClient.Create(ctx, vm), "must specify exactly 1 volume source")
+
+			vm = newVM()
+			vm.Spec.Volumes[0].DataVolume = &virtv1alpha1.DataVolumeVolumeSource{VolumeName: "ubuntu"}
+			expectInvalid(k8sClient.Create(ctx, vm), "must specify exactly 1 volume source")
+		})
+
+		It("should reject a cloudInit volume with more than 1 user data or network data", func() {
+			vm := newVM()
+			vm.Spec.Volumes[0].ContainerDisk = nil
+			vm.Spec.Volumes[0].CloudInit = &virtv1alpha1.CloudInitVolumeSource{
+				UserData:       "#cloud-config",
+				UserDataBase64: "I2Nsb3VkLWNvbmZpZw==",
+			}
+			expectInvalid(k8sClient.Create(ctx, vm), "may not specify more than 1 user data")
+
+			vm = newVM()
+			vm.Spec.Volumes[0].ContainerDisk = nil
+			vm.Spec.Volumes[0].CloudInit = &virtv1alpha1.CloudInitVolumeSource{
+				NetworkData:         "version: 2",
+				GenerateNetworkData: true,
+			}
+			expectInvalid(k8sClient.Create(ctx, vm), "may not specify more than 1 network data")
+		})
+
+		It("should reject a sysprep volume without exactly 1 of configMapName and secretName", func() {
+			vm := newVM()
+			vm.Spec.Volumes[0].ContainerDisk = nil
+			vm.Spec.Volumes[0].Sysprep = &virtv1alpha1.SysprepVolumeSource{}
+			expectInvalid(k8sClient.Create(ctx, vm), "must specify exactly 1 of configMapName and secretName")
+
+			vm = newVM()
+			vm.Spec.Volumes[0].ContainerDisk = nil
+			vm.Spec.Volumes[0].Sysprep = &virtv1alpha1.SysprepVolumeSource{ConfigMapName: "sysprep", SecretName: "sysprep"}
+			expectInvalid(k8sClient.Create(ctx, vm), "must specify exactly 1 of configMapName and secretName")
+
+			vm = newVM()
+			vm.Spec.Volumes[0].ContainerDisk = nil
+			vm.Spec.Volumes[0].Sysprep = &virtv1alpha1.SysprepVolumeSource{SecretName: "sysprep"}
+			Expect(k8sClient.Create(ctx, vm)).To(Succeed())
+		})
+
+		It("should reject a PVC populated from both a container disk and an HTTP disk", func() {
+			vm := newVM()
+			vm.Spec.Volumes[0].ContainerDisk = nil
+			vm.Spec.Volumes[0].PersistentVolumeClaim = &virtv1alpha1.PersistentVolumeClaimVolumeSource{
+				ClaimName: "ubuntu",
+				Populate: &virtv1alpha1.PersistentVolumeClaimPopulateSource{
+					ContainerDisk: &virtv1alpha1.ContainerDiskVolumeSource{Image: "smartxworks/virtink-container-disk-ubuntu"},
+					HTTPDisk:      &virtv1alpha1.HTTPDiskVolumeSource{URL: "https://cloud-images.ubuntu.com/jammy/current/jammy-server-cloudimg-amd64.img"},
+				},
+			}
+			expectInvalid(k8sClient.Create(ctx, vm), "must specify exactly 1 of containerDisk and httpDisk")
+
+			vm.Spec.Volumes[0].PersistentVolumeClaim.Populate.ContainerDisk = nil
+			Expect(k8sClient.Create(ctx, vm)).To(Succeed())
+		})
+
+		It("should reject a memory size that is not a multiple of the hugepages page size", func() {
+			vm := newVM()
+			vm.Spec.Instance.Memory.Size = resource.MustParse("1025Mi")
+			vm.Spec.Instance.Memory.Hugepages = &virtv1alpha1.Hugepages{PageSize: "2Mi"}
+			expectInvalid(k8sClient.Create(ctx, vm), "size must be a multiple of the hugepages page size")
+
+			vm.Spec.Instance.Memory.Size = resource.MustParse("1026Mi")
+			Expect(k8sClient.Create(ctx, vm)).To(Succeed())
+		})
+	})
+
+	Context("on update", func() {
+		It("should accept changes of mutable fields", func() {
+			Expect(updateVM(newVM(), func(vm *virtv1alpha1.VirtualMachine) {
+				vm.Spec.RunPolicy = virtv1alpha1.RunPolicyAlways
+				vm.Spec.Instance.Disks[0].ReadOnly = func() *bool { readOnly := true; return &readOnly }()
+				vm.Spec.Instance.Interfaces[0].State = virtv1alpha1.InterfaceStateDown
+				vm.Spec.Volumes[0].ContainerDisk.Image = "smartxworks/virtink-container-disk-debian"
+			})).To(Succeed())
+		})
+
+		It("should accept changes of the CPU, memory, kernel and firmware, which require a restart", func() {
+			Expect(updateVM(newVM(), func(vm *virtv1alpha1.VirtualMachine) {
+				vm.Spec.Instance.CPU.Sockets = 2
+				vm.Spec.Instance.Memory.Size = resource.MustParse("2Gi")
+				vm.Spec.Instance.Firmware = &virtv1alpha1.Firmware{EFI: &virtv1alpha1.EFIFirmware{}}
+			})).To(Succeed())
+
+			vm := newVM()
+			vm.Spec.Instance.Kernel = &virtv1alpha1.Kernel{Image: "smartxworks/virtink-kernel-5.15.12", Cmdline: "console=ttyS0 root=/dev/vda rw"}
+			Expect(updateVM(vm, func(vm *virtv1alpha1.VirtualMachine) {
+				vm.Spec.Instance.Kernel.Cmdline = "console=ttyS0 root=/dev/vda ro"
+			})).To(Succeed())
+		})
+
+		It("should reject changes of immutable fields of the instance and networks", func() {
+			tests := []struct {
+				message string
+				vm      func() *virtv1alpha1.VirtualMachine
+				mutate  func(vm *virtv1alpha1.VirtualMachine)
+			}{{
+				message: "fileSystems are immutable",
+				vm: func() *virtv1alpha1.VirtualMachine {
+					vm := newVM()
+					vm.Spec.Instance.FileSystems = []virtv1alpha1.FileSystem{{Name: "data"}}
+					return vm
+				},
+				mutate: func(vm *virtv1alpha1.VirtualMachine) {
+					vm.Spec.Instance.FileSystems = append(vm.Spec.Instance.FileSystems, virtv1alpha1.FileSystem{Name: "logs"})
+				},
+			}, {
+				message: "hypervisor is immutable",
+				vm: func() *virtv1alpha1.VirtualMachine {
+					vm := newVM()
+					vm.Spec.Instance.Hypervisor = virtv1alpha1.HypervisorCloudHypervisor
+					return vm
+				},
+				mutate: func(vm *virtv1alpha1.VirtualMachine) {
+					vm.Spec.Instance.Hypervisor = virtv1alpha1.HypervisorQEMU
+				},
+			}, {
+				message: "vsock is immutable",
+				vm: func() *virtv1alpha1.VirtualMachine {
+					vm := newVM()
+					vm.Spec.Instance.Vsock = &virtv1alpha1.Vsock{CID: 3}
+					return vm
+				},
+				mutate: func(vm *virtv1alpha1.VirtualMachine) {
+					vm.Spec.Instance.Vsock.CID = 4
+				},
+			}, {
+				message: "rng is immutable",
+				vm: func() *virtv1alpha1.VirtualMachine {
+					vm := newVM()
+					vm.Spec.Instance.RNG = &virtv1alpha1.RNG{Source: "/dev/urandom"}
+					return vm
+				},
+				mutate: func(vm *virtv1alpha1.VirtualMachine) {
+					vm.Spec.Instance.RNG.Source = "/dev/random"
+				},
+			}, {
+				message: "downward is immutable",
+				vm: func() *virtv1alpha1.VirtualMachine {
+					vm := newVM()
+					vm.Spec.Instance.Downward = &virtv1alpha1.Downward{SMBIOS: true}
+					return vm
+				},
+				mutate: func(vm *virtv1alpha1.VirtualMachine) {
+					vm.Spec.Instance.Downward.MetricsPort = 1024
+				},
+			}, {
+				message: "clock is immutable",
+				vm: func() *virtv1alpha1.VirtualMachine {
+					vm := newVM()
+					vm.Spec.Instance.Clock = &virtv1alpha1.Clock{Offset: virtv1alpha1.ClockOffsetLocaltime}
+					return vm
+				},
+				mutate: func(vm *virtv1alpha1.VirtualMachine) {
+					vm.Spec.Instance.Clock.Timezone = "Asia/Shanghai"
+				},
+			}, {
+				message: "networks are immutable",
+				mutate: func(vm *virtv1alpha1.VirtualMachine) {
+					vm.Spec.Networks = append(vm.Spec.Networks, virtv1alpha1.Network{
+						Name: "multus",
+						NetworkSource: virtv1alpha1.NetworkSource{
+							Multus: &virtv1alpha1.MultusNetworkSource{NetworkName: "macvlan"},
+						},
+					})
+				},
+			}}
+
+			for _, tc := range tests {
+				By(tc.message)
+				newTestVM := newVM
+				if tc.vm != nil {
+					newTestVM = tc.vm
+				}
+				expectInvalid(updateVM(newTestVM(), tc.mutate), tc.message)
+			}
+		})
+
+		It("should reject adding, removing or reordering interfaces", func() {
+			newMultiInterfaceVM := func() *virtv1alpha1.VirtualMachine {
+				vm := newVM()
+				vm.Spec.Instance.Interfaces = append(vm.Spec.Instance.Interfaces, virtv1alpha1.Interface{
+					Name: "multus",
+					InterfaceBindingMethod: virtv1alpha1.InterfaceBindingMethod{
+						Bridge: &virtv1alpha1.InterfaceBridge{},
+					},
+				})
+				vm.Spec.Networks = append(vm.Spec.Networks, virtv1alpha1.Network{
+					Name: "multus",
+					NetworkSource: virtv1alpha1.NetworkSource{
+						Multus: &virtv1alpha1.MultusNetworkSource{NetworkName: "macvlan"},
+					},
+				})
+				return vm
+			}
+
+			expectInvalid(updateVM(newMultiInterfaceVM(), func(vm *virtv1alpha1.VirtualMachine) {
+				vm.Spec.Instance.Interfaces = vm.Spec.Instance.Interfaces[:1]
+			}), "may not add, remove or reorder interfaces")
+			expectInvalid(updateVM(newMultiInterfaceVM(), func(vm *virtv1alpha1.VirtualMachine) {
+				interfaces := vm.Spec.Instance.Interfaces
+				vm.Spec.Instance.Interfaces = []virtv1alpha1.Interface{interfaces[1], interfaces[0]}
+			}), "may not add, remove or reorder interfaces")
+			expectInvalid(updateVM(newVM(), func(vm *virtv1alpha1.VirtualMachine) {
+				vm.Spec.Instance.Interfaces = append(vm.Spec.Instance.Interfaces, virtv1alpha1.Interface{
+					Name: "pod-2",
+					InterfaceBindingMethod: virtv1alpha1.InterfaceBindingMethod{
+						Masquerade: &virtv1alpha1.InterfaceMasquerade{},
+					},
+				})
+			}), "may not add, remove or reorder interfaces")
+		})
+
+		It("should leave adding or removing fields of interfaces to the webhook", func() {
+			// the webhook compares the interfaces without their states, as
+			// CEL rules listing every field of interfaces exceed the cost
+			// budget and miss fields added later
+			Expect(updateVM(newVM(), func(vm *virtv1alpha1.VirtualMachine) {
+				vm.Spec.Instance.Interfaces[0].MTU = 1400
+			})).To(Succeed())
+			Expect(updateVM(newVM(), func(vm *virtv1alpha1.VirtualMachine) {
+				vm.Spec.Instance.Interfaces[0].Vhost = true
+			})).To(Succeed())
+			Expect(updateVM(newVM(), func(vm *virtv1alpha1.VirtualMachine) {
+				vm.Spec.Instance.Interfaces[0].Masquerade = nil
+				vm.Spec.Instance.Interfaces[0].Bridge = &virtv1alpha1.InterfaceBridge{}
+			})).To(Succeed())
+
+			vm := newVM()
+			vm.Spec.Instance.Interfaces[0].State = virtv1alpha1.InterfaceStateDown
+			Expect(updateVM(vm, func(vm *virtv1alpha1.VirtualMachine) {
+				vm.Spec.Instance.Interfaces[0].State = ""
+			})).To(Succeed())
+		})
+
+		It("should reject changes of immutable fields of interfaces", func() {
+			vlanID := int32(100)
+			tests := []struct {
+				message string
+				from    func(iface *virtv1alpha1.Interface)
+				to      func(iface *virtv1alpha1.Interface)
+			}{{
+				message: "mac is immutable",
+				from:    func(iface *virtv1alpha1.Interface) { iface.MAC = "52:54:00:12:34:56" },
+				to:      func(iface *virtv1alpha1.Interface) { iface.MAC = "52:54:00:12:34:57" },
+			}, {
+				message: "masquerade is immutable",
+				from:    func(iface *virtv1alpha1.Interface) {},
+				to:      func(iface *virtv1alpha1.Interface) { iface.Masquerade.CIDR = "10.0.3.0/30" },
+			}, {
+				message: "ovs is immutable",
+				from: func(iface *virtv1alpha1.Interface) {
+					iface.Masquerade = nil
+					iface.OVS = &virtv1alpha1.InterfaceOVS{VLAN: &vlanID}
+				},
+				to: func(iface *virtv1alpha1.Interface) { iface.OVS.Trunks = []int32{200} },
+			}, {
+				message: "rateLimit is immutable",
+				from: func(iface *virtv1alpha1.Interface) {
+					iface.RateLimit = &virtv1alpha1.InterfaceRateLimit{RX: &virtv1alpha1.BandwidthLimit{Bandwidth: resource.MustParse("100M")}}
+				},
+				to: func(iface *virtv1alpha1.Interface) { iface.RateLimit.RX.Bandwidth = resource.MustParse("200M") },
+			}, {
+				message: "queues is immutable",
+				from:    func(iface *virtv1alpha1.Interface) { iface.Queues = 1 },
+				to:      func(iface *virtv1alpha1.Interface) { iface.Queues = 2 },
+			}, {
+				message: "bootOrder is immutable",
+				from:    func(iface *virtv1alpha1.Interface) { iface.BootOrder = 1 },
+				to:      func(iface *virtv1alpha1.Interface) { iface.BootOrder = 2 },
+			}, {
+				message: "dhcpOptions is immutable",
+				from: func(iface *virtv1alpha1.Interface) {
+					iface.DHCPOptions = &virtv1alpha1.InterfaceDHCPOptions{DNSServers: []string{"1.1.1.1"}}
+				},
+				to: func(iface *virtv1alpha1.Interface) { iface.DHCPOptions.DNSServers = []string{"8.8.8.8"} },
+			}, {
+				message: "mtu is immutable",
+				from:    func(iface *virtv1alpha1.Interface) { iface.MTU = 1400 },
+				to:      func(iface *virtv1alpha1.Interface) { iface.MTU = 1450 },
+			}, {
+				message: "offloads is immutable",
+				from: func(iface *virtv1alpha1.Interface) {
+					iface.Offloads = &virtv1alpha1.InterfaceOffloads{DisableChecksum: true}
+				},
+				to: func(iface *virtv1alpha1.Interface) { iface.Offloads.DisableTSO = true },
+			}, {
+				message: "rxQueueSize is immutable",
+				from:    func(iface *virtv1alpha1.Interface) { iface.RXQueueSize = 256 },
+				to:      func(iface *virtv1alpha1.Interface) { iface.RXQueueSize = 512 },
+			}, {
+				message: "txQueueSize is immutable",
+				from:    func(iface *virtv1alpha1.Interface) { iface.TXQueueSize = 256 },
+				to:      func(iface *virtv1alpha1.Interface) { iface.TXQueueSize = 512 },
+			}, {
+				message: "securityGroups is immutable",
+				from:    func(iface *virtv1alpha1.Interface) { iface.SecurityGroups = []string{"web"} },
+				to:      func(iface *virtv1alpha1.Interface) { iface.SecurityGroups = []string{"web", "ssh"} },
+			}, {
+				message: "vlan is immutable",
+				from:    func(iface *virtv1alpha1.Interface) { iface.VLAN = &virtv1alpha1.InterfaceVLAN{ID: 100} },
+				to:      func(iface *virtv1alpha1.Interface) { iface.VLAN.ID = 200 },
+			}, {
+				message: "addresses is immutable",
+				from:    func(iface *virtv1alpha1.Interface) { iface.Addresses = []string{"10.0.0.2/24"} },
+				to:      func(iface *virtv1alpha1.Interface) { iface.Addresses = []string{"10.0.0.3/24"} },
+			}, {
+				message: "routes is immutable",
+				from: func(iface *virtv1alpha1.Interface) {
+					iface.Routes = []virtv1alpha1.InterfaceRoute{{To: "10.1.0.0/16", Via: "10.0.0.1"}}
+				},
+				to: func(iface *virtv1alpha1.Interface) { iface.Routes[0].Via = "10.0.0.254" },
+			}}
+
+			for _, tc := range tests {
+				By(tc.message)
+				vm := newVM()
+				tc.from(&vm.Spec.Instance.Interfaces[0])
+				expectInvalid(updateVM(vm, func(vm *virtv1alpha1.VirtualMachine) {
+					tc.to(&vm.Spec.Instance.Interfaces[0])
+				}), tc.message)
+			}
+		})
+	})
+})
@@ -2,28 +2,41 @@ package controller
 
 import (
 	"context"
-	"crypto/rand"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/r3labs/diff/v2"
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/defaults"
+	"github.com/smartxworks/virtink/pkg/hooks"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
 )
 
 // +kubebuilder:webhook:path=/mutate-v1alpha1-virtualmachine,mutating=true,failurePolicy=fail,sideEffects=None,groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=create;update,versions=v1alpha1,name=mutate.virtualmachine.v1alpha1.virt.virtink.smartx.com,admissionReviewVersions={v1,v1beta1}
-
-var memoryOverhead = "256Mi"
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtinkconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtinknamespaceconfigs,verbs=get;list;watch
 
 type VMMutator struct {
+	client.Client
 	decoder *admission.Decoder
 }
 
@@ -44,13 +57,26 @@ func (h *VMMutator) Handle(ctx context.Context, req admission.Request) admission
 	var err error
 	switch req.Operation {
 	case admissionv1.Create:
-		err = MutateVM(ctx, &vm, nil)
+		var config *virtv1beta1.VirtinkConfig
+		config, err = virtinkconfig.Get(ctx, h.Client)
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, fmt.Errorf("get Virtink config: %s", err))
+		}
+		var namespaceConfig *virtv1beta1.VirtinkNamespaceConfig
+		namespaceConfig, err = virtinkconfig.GetNamespaceConfig(ctx, h.Client, req.Namespace)
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, fmt.Errorf("get Virtink namespace config: %s", err))
+		}
+		defaults.ApplyNamespaceDefaults(&namespaceConfig.Spec, &vm)
+		defaults.ApplyNetworkDefaults(&config.Spec.Network, &vm)
+		defaults.ApplyMemoryOvercommitDefaults(&config.Spec.MemoryOvercommit, &vm)
+		err = defaults.SetVMDefaults(&vm, nil)
 	case admissionv1.Update:
 		var oldVM virtv1alpha1.VirtualMachine
 		if err := h.decoder.DecodeRaw(req.OldObject, &oldVM); err != nil {
 			return admission.Errored(http.StatusBadRequest, fmt.Errorf("unmarshal old VM: %s", err))
 		}
-		err = MutateVM(ctx, &vm, &oldVM)
+		err = defaults.SetVMDefaults(&vm, &oldVM)
 	default:
 		return admission.Allowed("")
 	}
@@ -66,111 +92,10 @@ func (h *VMMutator) Handle(ctx context.Context, req admission.Request) admission
 	return admission.PatchResponseFromRaw(req.Object.Raw, vmJSON)
 }
 
-func MutateVM(ctx context.Context, vm *virtv1alpha1.VirtualMachine, oldVM *virtv1alpha1.VirtualMachine) error {
-	if vm.Spec.RunPolicy == "" {
-		vm.Spec.RunPolicy = virtv1alpha1.RunPolicyOnce
-	}
-
-	if vm.Spec.Instance.CPU.Sockets == 0 {
-		vm.Spec.Instance.CPU.Sockets = 1
-	}
-	if vm.Spec.Instance.CPU.CoresPerSocket == 0 {
-		vm.Spec.Instance.CPU.CoresPerSocket = 1
-	}
-
-	if vm.Spec.Instance.Memory.Size.IsZero() {
-		if !vm.Spec.Resources.Requests.Memory().IsZero() {
-			vm.Spec.Instance.Memory.Size = vm.Spec.Resources.Requests.Memory().DeepCopy()
-		} else {
-			vm.Spec.Instance.Memory.Size = resource.MustParse("1Gi")
-		}
-	}
-
-	if vm.Spec.Instance.CPU.DedicatedCPUPlacement {
-		memSize := resource.MustParse(memoryOverhead)
-		if !vm.Spec.Instance.Memory.Size.IsZero() {
-			if vm.Spec.Instance.Memory.Hugepages == nil {
-				memSize.Add(vm.Spec.Instance.Memory.Size)
-			}
-		}
-		rsList := map[corev1.ResourceName]resource.Quantity{
-			corev1.ResourceCPU:    *resource.NewQuantity(int64(vm.Spec.Instance.CPU.CoresPerSocket*vm.Spec.Instance.CPU.Sockets), resource.DecimalSI),
-			corev1.ResourceMemory: memSize,
-		}
-
-		if vm.Spec.Resources.Requests == nil {
-			vm.Spec.Resources.Requests = rsList
-		} else {
-			if vm.Spec.Resources.Requests.Cpu().IsZero() {
-				vm.Spec.Resources.Requests[corev1.ResourceCPU] = rsList[corev1.ResourceCPU]
-			}
-			if vm.Spec.Resources.Requests.Memory().IsZero() {
-				vm.Spec.Resources.Requests[corev1.ResourceMemory] = rsList[corev1.ResourceMemory]
-			}
-		}
-
-		if vm.Spec.Resources.Limits == nil {
-			vm.Spec.Resources.Limits = rsList
-		} else {
-			if vm.Spec.Resources.Limits.Cpu().IsZero() {
-				vm.Spec.Resources.Limits[corev1.ResourceCPU] = rsList[corev1.ResourceCPU]
-			}
-			if vm.Spec.Resources.Limits.Memory().IsZero() {
-				vm.Spec.Resources.Limits[corev1.ResourceMemory] = rsList[corev1.ResourceMemory]
-			}
-		}
-	}
-
-	if vm.Spec.Instance.Memory.Hugepages != nil {
-		hugepagesSize := fmt.Sprintf("hugepages-%s", vm.Spec.Instance.Memory.Hugepages.PageSize)
-
-		if vm.Spec.Resources.Limits == nil {
-			vm.Spec.Resources.Limits = corev1.ResourceList{}
-		}
-		hugepagesLimit, exist := vm.Spec.Resources.Limits[corev1.ResourceName(hugepagesSize)]
-		if !exist {
-			hugepagesLimit = vm.Spec.Instance.Memory.Size.DeepCopy()
-			vm.Spec.Resources.Limits[corev1.ResourceName(hugepagesSize)] = hugepagesLimit
-		}
-		if vm.Spec.Resources.Requests == nil {
-			vm.Spec.Resources.Requests = corev1.ResourceList{}
-		}
-		if _, exist := vm.Spec.Resources.Requests[corev1.ResourceName(hugepagesSize)]; !exist {
-			vm.Spec.Resources.Requests[corev1.ResourceName(hugepagesSize)] = hugepagesLimit.DeepCopy()
-		}
-
-		if vm.Spec.Resources.Limits.Cpu().IsZero() && vm.Spec.Resources.Limits.Memory().IsZero() && vm.Spec.Resources.Requests.Cpu().IsZero() && vm.Spec.Resources.Requests.Memory().IsZero() {
-			vm.Spec.Resources.Requests[corev1.ResourceMemory] = resource.MustParse(memoryOverhead)
-		}
-	}
-
-	for i := range vm.Spec.Instance.Interfaces {
-		if vm.Spec.Instance.Interfaces[i].MAC == "" {
-			mac, err := generateMAC()
-			if err != nil {
-				return fmt.Errorf("generate MAC: %s", err)
-			}
-			vm.Spec.Instance.Interfaces[i].MAC = mac.String()
-		}
-
-		if vm.Spec.Instance.Interfaces[i].Bridge == nil && vm.Spec.Instance.Interfaces[i].Masquerade == nil && vm.Spec.Instance.Interfaces[i].SRIOV == nil && vm.Spec.Instance.Interfaces[i].VhostUser == nil {
-			vm.Spec.Instance.Interfaces[i].InterfaceBindingMethod = virtv1alpha1.InterfaceBindingMethod{
-				Bridge: &virtv1alpha1.InterfaceBridge{},
-			}
-		}
-
-		if vm.Spec.Instance.Interfaces[i].Masquerade != nil {
-			if vm.Spec.Instance.Interfaces[i].Masquerade.CIDR == "" {
-				vm.Spec.Instance.Interfaces[i].Masquerade.CIDR = "10.0.2.0/30"
-			}
-		}
-	}
-	return nil
-}
-
 // +kubebuilder:webhook:path=/validate-v1alpha1-virtualmachine,mutating=false,failurePolicy=fail,sideEffects=None,groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=create;update,versions=v1alpha1,name=validate.virtualmachine.v1alpha1.virt.virtink.smartx.com,admissionReviewVersions={v1,v1beta1}
 
 type VMValidator struct {
+	client.Client
 	decoder *admission.Decoder
 }
 
@@ -192,22 +117,38 @@ func (h *VMValidator) Handle(ctx context.Context, req admission.Request) admissi
 	switch req.Operation {
 	case admissionv1.Create:
 		errs = ValidateVM(ctx, &vm, nil)
+		if len(errs) == 0 {
+			exceeded, err := checkVMQuotas(ctx, h.Client, &vm)
+			if err != nil {
+				return admission.Errored(http.StatusInternalServerError, fmt.Errorf("check VM quotas: %s", err))
+			}
+			if exceeded != "" {
+				return webhook.Denied(exceeded)
+			}
+		}
+		if len(errs) == 0 {
+			// nodes may be added later, so the VM is only warned about,
+			// which also reports it on server-side dry runs
+			nodes, reasons, err := findVMNodes(ctx, h.Client, &vm)
+			if err != nil {
+				return admission.Errored(http.StatusInternalServerError, fmt.Errorf("find VM nodes: %s", err))
+			}
+			if len(nodes) == 0 {
+				warnings := []string{"no node can run the VM"}
+				warnings = append(warnings, reasons...)
+				return admission.Allowed("").WithWarnings(warnings...)
+			}
+		}
 	case admissionv1.Update:
 		var oldVM virtv1alpha1.VirtualMachine
 		if err := h.decoder.DecodeRaw(req.OldObject, &oldVM); err != nil {
 			return admission.Errored(http.StatusBadRequest, fmt.Errorf("unmarshal old VM: %s", err))
 		}
 		errs = ValidateVM(ctx, &vm, &oldVM)
-
-		changes, err := diff.Diff(oldVM.Spec, vm.Spec, diff.SliceOrdering(true))
-		if err != nil {
-			return admission.Errored(http.StatusInternalServerError, fmt.Errorf("diff VM: %s", err))
-		}
-
-		if len(changes) != 0 {
-			if len(changes) != 1 || changes[0].Path[0] != "RunPolicy" {
-				errs = append(errs, field.Forbidden(field.NewPath("spec"), "VM spec may not be updated except runPolicy"))
-			}
+		// other changes of a running VM take effect when it is restarted, as
+		// told by its RestartRequired condition
+		if hasVMPod(&oldVM) {
+			errs = append(errs, ValidateRunningVMUpdate(ctx, &vm, &oldVM)...)
 		}
 	default:
 		return admission.Allowed("")
@@ -219,12 +160,155 @@ func (h *VMValidator) Handle(ctx context.Context, req admission.Request) admissi
 	return admission.Allowed("")
 }
 
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinequotas,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list;watch
+
+// checkVMQuotas returns the reason for denying the VM if creating it would
+// exceed any VM quota in its namespace. VMs are counted from the cache, so
+// VMs created concurrently may exceed a quota briefly.
+func checkVMQuotas(ctx context.Context, c client.Reader, vm *virtv1alpha1.VirtualMachine) (string, error) {
+	var quotaList virtv1beta1.VirtualMachineQuotaList
+	if err := c.List(ctx, &quotaList, client.InNamespace(vm.Namespace)); err != nil {
+		return "", fmt.Errorf("list VM quotas: %s", err)
+	}
+	if len(quotaList.Items) == 0 {
+		return "", nil
+	}
+
+	used, err := getNamespaceVMQuotaUsage(ctx, c, vm.Namespace)
+	if err != nil {
+		return "", err
+	}
+	addVMQuotaUsage(used, vm)
+
+	for _, quota := range quotaList.Items {
+		var exceeded []string
+		hard := quota.Spec.Hard
+		if hard.VirtualMachines != nil && *used.VirtualMachines > *hard.VirtualMachines {
+			exceeded = append(exceeded, fmt.Sprintf("virtualMachines: %d > %d", *used.VirtualMachines, *hard.VirtualMachines))
+		}
+		if hard.VCPUs != nil && *used.VCPUs > *hard.VCPUs {
+			exceeded = append(exceeded, fmt.Sprintf("vcpus: %d > %d", *used.VCPUs, *hard.VCPUs))
+		}
+		if hard.Memory != nil && used.Memory.Cmp(*hard.Memory) > 0 {
+			exceeded = append(exceeded, fmt.Sprintf("memory: %s > %s", used.Memory.String(), hard.Memory.String()))
+		}
+		if len(exceeded) > 0 {
+			return fmt.Sprintf("exceeded VM quota %q: %s", quota.Name, strings.Join(exceeded, ", ")), nil
+		}
+	}
+	return "", nil
+}
+
+// hasVMPod reports whether the VM is running, or has its VM pod being
+// created.
+func hasVMPod(vm *virtv1alpha1.VirtualMachine) bool {
+	switch vm.Status.Phase {
+	case "", virtv1alpha1.VirtualMachineSucceeded, virtv1alpha1.VirtualMachineFailed:
+		return false
+	default:
+		return true
+	}
+}
+
+// ValidateRunningVMUpdate forbids adding, removing and reordering disks and
+// volumes of a running VM. They can't be hot-plugged, and virt-daemon finds
+// the disks of the running VM by those in the spec.
+func ValidateRunningVMUpdate(ctx context.Context, vm *virtv1alpha1.VirtualMachine, oldVM *virtv1alpha1.VirtualMachine) field.ErrorList {
+	var errs field.ErrorList
+	var diskNames, oldDiskNames []string
+	for _, disk := range vm.Spec.Instance.Disks {
+		diskNames = append(diskNames, disk.Name)
+	}
+	for _, disk := range oldVM.Spec.Instance.Disks {
+		oldDiskNames = append(oldDiskNames, disk.Name)
+	}
+	if !reflect.DeepEqual(diskNames, oldDiskNames) {
+		errs = append(errs, field.Forbidden(field.NewPath("spec").Child("instance").Child("disks"), "may not add, remove or reorder disks of a running VM"))
+	}
+
+	var volumeNames, oldVolumeNames []string
+	for _, volume := range vm.Spec.Volumes {
+		volumeNames = append(volumeNames, volume.Name)
+	}
+	for _, volume := range oldVM.Spec.Volumes {
+		oldVolumeNames = append(oldVolumeNames, volume.Name)
+	}
+	if !reflect.DeepEqual(volumeNames, oldVolumeNames) {
+		errs = append(errs, field.Forbidden(field.NewPath("spec").Child("volumes"), "may not add, remove or reorder volumes of a running VM"))
+	}
+	return errs
+}
+
+// isLiveUpdatableVMSpecPath reports whether changes of the field are applied
+// to the running VM, by virt-controller or virt-daemon.
+func isLiveUpdatableVMSpecPath(vm *virtv1alpha1.VirtualMachine, path []string) bool {
+	switch {
+	case len(path) > 0 && (path[0] == "RunPolicy" || path[0] == "UpdateStrategy" || path[0] == "Schedule"):
+		return true
+	case len(path) > 3 && path[0] == "Instance" && path[1] == "Disks" && path[3] == "RateLimit":
+		// I/O throttling of QEMU drives is set by virt-daemon, while Cloud
+		// Hypervisor can't change the rate limit of an attached disk
+		return vm.Spec.Instance.Hypervisor == virtv1alpha1.HypervisorQEMU
+	case len(path) > 3 && path[0] == "Instance" && path[1] == "Disks" && (path[3] == "Medium" || path[3] == "Ejected"):
+		// media of cdrom disks are changed by virt-daemon
+		return true
+	case len(path) > 3 && path[0] == "Instance" && path[1] == "Interfaces" && path[3] == "State":
+		// links of interfaces are set down and up by virt-daemon
+		return true
+	default:
+		return false
+	}
+}
+
+// isMigratedVolumeClaimChange reports whether the change switches a volume to
+// the PVC it has been copied to by a succeeded migration.
+func isMigratedVolumeClaimChange(oldVM *virtv1alpha1.VirtualMachine, change diff.Change) bool {
+	if oldVM.Status.Migration == nil || oldVM.Status.Migration.Phase != virtv1alpha1.VirtualMachineMigrationSucceeded {
+		return false
+	}
+	if len(change.Path) != 4 || change.Path[0] != "Volumes" || change.Path[2] != "PersistentVolumeClaim" || change.Path[3] != "ClaimName" {
+		return false
+	}
+
+	index, err := strconv.Atoi(change.Path[1])
+	if err != nil || index < 0 || index >= len(oldVM.Spec.Volumes) {
+		return false
+	}
+	for _, migrationVolume := range oldVM.Status.Migration.Volumes {
+		if migrationVolume.Name == oldVM.Spec.Volumes[index].Name && change.To == migrationVolume.TargetClaimName {
+			return true
+		}
+	}
+	return false
+}
+
 func ValidateVM(ctx context.Context, vm *virtv1alpha1.VirtualMachine, oldVM *virtv1alpha1.VirtualMachine) field.ErrorList {
 	var errs field.ErrorList
+	if _, err := hooks.GetHookSidecars(vm.Annotations); err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("metadata", "annotations").Key(hooks.HookSidecarsAnnotation), vm.Annotations[hooks.HookSidecarsAnnotation], err.Error()))
+	}
 	errs = append(errs, ValidateVMSpec(ctx, &vm.Spec, field.NewPath("spec"))...)
+	if vm.Spec.Subdomain != "" && vm.Spec.Hostname == "" && len(validation.IsDNS1123Label(vm.Name)) > 0 {
+		errs = append(errs, field.Required(field.NewPath("spec", "hostname"), "required with subdomain if VM name is not a DNS label"))
+	}
+	if oldVM != nil && !reflect.DeepEqual(withoutInterfaceStates(vm.Spec.Instance.Interfaces), withoutInterfaceStates(oldVM.Spec.Instance.Interfaces)) {
+		errs = append(errs, field.Forbidden(field.NewPath("spec", "instance", "interfaces"), "interfaces are immutable except for their state"))
+	}
 	return errs
 }
 
+// withoutInterfaceStates returns a copy of the interfaces without their
+// states, the only field of interfaces that may change.
+func withoutInterfaceStates(ifaces []virtv1alpha1.Interface) []virtv1alpha1.Interface {
+	var result []virtv1alpha1.Interface
+	for _, iface := range ifaces {
+		iface.State = ""
+		result = append(result, iface)
+	}
+	return result
+}
+
 func ValidateVMSpec(ctx context.Context, spec *virtv1alpha1.VirtualMachineSpec, fieldPath *field.Path) field.ErrorList {
 	var errs field.ErrorList
 	if spec == nil {
@@ -248,7 +332,7 @@ func ValidateVMSpec(ctx context.Context, spec *virtv1alpha1.VirtualMachineSpec,
 		}
 
 		memoryRequestField := fieldPath.Child("resources.requests").Child(string(corev1.ResourceMemory))
-		memRequired := resource.MustParse(memoryOverhead)
+		memRequired := resource.MustParse(defaults.VMMemoryOverhead)
 		if spec.Instance.Memory.Hugepages == nil {
 			memRequired.Add(spec.Instance.Memory.Size)
 		}
@@ -302,162 +386,1068 @@ func ValidateVMSpec(ctx context.Context, spec *virtv1alpha1.VirtualMachineSpec,
 		errs = append(errs, ValidateVolume(ctx, &volume, fieldPath)...)
 	}
 
+	var serviceAccountName string
+	for i, volume := range spec.Volumes {
+		if volume.ServiceAccountToken == nil {
+			continue
+		}
+		// the VM pod runs as the service account
+		if serviceAccountName == "" {
+			serviceAccountName = volume.ServiceAccountToken.ServiceAccountName
+		} else if volume.ServiceAccountToken.ServiceAccountName != serviceAccountName {
+			errs = append(errs, field.Invalid(fieldPath.Child("volumes").Index(i).Child("serviceAccountToken", "serviceAccountName"), volume.ServiceAccountToken.ServiceAccountName, "must be the same as of other service account token volumes"))
+		}
+	}
+
 	networkNames := map[string]struct{}{}
 	for i, network := range spec.Networks {
 		fieldPath := fieldPath.Child("networks").Index(i)
 		if _, ok := networkNames[network.Name]; ok {
 			errs = append(errs, field.Duplicate(fieldPath.Child("name"), network.Name))
 		}
-		networkNames[network.Name] = struct{}{}
-		errs = append(errs, ValidateNetwork(ctx, &network, fieldPath)...)
+		networkNames[network.Name] = struct{}{}
+		errs = append(errs, ValidateNetwork(ctx, &network, fieldPath)...)
+	}
+
+	generatesNetworkData := false
+	for _, volume := range spec.Volumes {
+		if volume.CloudInit != nil && volume.CloudInit.GenerateNetworkData {
+			generatesNetworkData = true
+		}
+	}
+	for i, iface := range spec.Instance.Interfaces {
+		fieldPath := fieldPath.Child("instance", "interfaces").Index(i)
+		for _, network := range spec.Networks {
+			if network.Name != iface.Name || network.Multus != nil {
+				continue
+			}
+			if iface.OVS != nil {
+				errs = append(errs, field.Forbidden(fieldPath.Child("ovs"), "may only be used with multus networks"))
+			}
+			if iface.VLAN != nil {
+				errs = append(errs, field.Forbidden(fieldPath.Child("vlan"), "may only be used with multus networks"))
+			}
+			// addresses of the pod network are assigned by its CNI plugin
+			if len(iface.Addresses) > 0 {
+				errs = append(errs, field.Forbidden(fieldPath.Child("addresses"), "may only be used with multus networks"))
+			}
+		}
+		// only the generated network data configures them in the guest
+		if !generatesNetworkData {
+			if len(iface.Addresses) > 0 {
+				errs = append(errs, field.Forbidden(fieldPath.Child("addresses"), "may only be used with a cloudInit volume generating network data"))
+			}
+			if len(iface.Routes) > 0 {
+				errs = append(errs, field.Forbidden(fieldPath.Child("routes"), "may only be used with a cloudInit volume generating network data"))
+			}
+		}
+	}
+
+	bondNames := map[string]struct{}{}
+	bondedIfaceNames := map[string]struct{}{}
+	for i, bond := range spec.Instance.Bonds {
+		fieldPath := fieldPath.Child("instance", "bonds").Index(i)
+		errs = append(errs, ValidateBond(ctx, &bond, fieldPath)...)
+		if !generatesNetworkData {
+			errs = append(errs, field.Forbidden(fieldPath, "may only be used with a cloudInit volume generating network data"))
+		}
+		if _, ok := bondNames[bond.Name]; ok {
+			errs = append(errs, field.Duplicate(fieldPath.Child("name"), bond.Name))
+		}
+		bondNames[bond.Name] = struct{}{}
+
+		for j, ifaceName := range bond.Interfaces {
+			fieldPath := fieldPath.Child("interfaces").Index(j)
+			if _, ok := bondedIfaceNames[ifaceName]; ok {
+				errs = append(errs, field.Duplicate(fieldPath, ifaceName))
+				continue
+			}
+			bondedIfaceNames[ifaceName] = struct{}{}
+
+			var bondedIface *virtv1alpha1.Interface
+			for k := range spec.Instance.Interfaces {
+				if spec.Instance.Interfaces[k].Name == ifaceName {
+					bondedIface = &spec.Instance.Interfaces[k]
+					break
+				}
+			}
+			switch {
+			case bondedIface == nil:
+				errs = append(errs, field.NotFound(fieldPath, ifaceName))
+			case bondedIface.Bridge == nil && bondedIface.SRIOV == nil && bondedIface.OVS == nil:
+				errs = append(errs, field.Invalid(fieldPath, ifaceName, "must be a bridge, SR-IOV or OVS interface"))
+			case len(bondedIface.Addresses) > 0 || len(bondedIface.Routes) > 0:
+				errs = append(errs, field.Invalid(fieldPath, ifaceName, "may not have addresses or routes of its own"))
+			}
+		}
+	}
+	// bonds and interfaces are configured by their names in the network data
+	for i, iface := range spec.Instance.Interfaces {
+		if _, ok := bondNames[iface.Name]; ok {
+			errs = append(errs, field.Duplicate(fieldPath.Child("instance", "interfaces").Index(i).Child("name"), iface.Name))
+		}
+	}
+
+	for i, disk := range spec.Instance.Disks {
+		if disk.Type != virtv1alpha1.DiskTypeCDROM || disk.Ejected {
+			continue
+		}
+		medium := disk.Medium
+		if medium == "" {
+			medium = disk.Name
+		}
+		mediumFound := false
+		for _, volume := range spec.Volumes {
+			if volume.Name == medium {
+				mediumFound = true
+				if volume.ContainerDisk == nil && volume.HTTPDisk == nil && volume.PersistentVolumeClaim == nil && volume.DataVolume == nil && volume.Sysprep == nil && volume.ConfigMap == nil && volume.Secret == nil {
+					errs = append(errs, field.Forbidden(fieldPath.Child("instance", "disks").Index(i).Child("medium"), "may only insert container disk, HTTP disk, PVC, data volume, sysprep, config map and secret media"))
+				}
+			}
+		}
+		if !mediumFound {
+			errs = append(errs, field.Invalid(fieldPath.Child("instance", "disks").Index(i).Child("medium"), medium, "must match the name of a volume"))
+		}
+	}
+
+	for i, disk := range spec.Instance.Disks {
+		for _, volume := range spec.Volumes {
+			if volume.Name == disk.Name && volume.Sysprep != nil && disk.Type != virtv1alpha1.DiskTypeCDROM {
+				errs = append(errs, field.Invalid(fieldPath.Child("instance", "disks").Index(i).Child("type"), disk.Type, "must be cdrom for sysprep volumes"))
+			}
+			if volume.Name == disk.Name && volume.EmptyDisk != nil && disk.Type == virtv1alpha1.DiskTypePmem {
+				errs = append(errs, field.Invalid(fieldPath.Child("instance", "disks").Index(i).Child("type"), disk.Type, "may not be pmem for empty disk volumes"))
+			}
+			if volume.Name == disk.Name && (volume.ConfigMap != nil || volume.Secret != nil || volume.ServiceAccountToken != nil) && disk.Type == virtv1alpha1.DiskTypePmem {
+				errs = append(errs, field.Invalid(fieldPath.Child("instance", "disks").Index(i).Child("type"), disk.Type, "may not be pmem for config map, secret and service account token volumes"))
+			}
+		}
+	}
+
+	for i, disk := range spec.Instance.Disks {
+		if !disk.Ephemeral {
+			continue
+		}
+		for _, volume := range spec.Volumes {
+			if volume.Name == disk.Name && volume.ContainerDisk == nil && volume.PersistentVolumeClaim == nil && volume.DataVolume == nil {
+				errs = append(errs, field.Forbidden(fieldPath.Child("instance", "disks").Index(i).Child("ephemeral"), "may only layer over container disk, PVC and data volume disks"))
+			}
+		}
+	}
+
+	for i, disk := range spec.Instance.Disks {
+		if !disk.Shareable {
+			continue
+		}
+		for _, volume := range spec.Volumes {
+			if volume.Name == disk.Name && volume.PersistentVolumeClaim == nil && volume.DataVolume == nil {
+				errs = append(errs, field.Forbidden(fieldPath.Child("instance", "disks").Index(i).Child("shareable"), "may only share PVC and data volume disks"))
+			}
+		}
+	}
+
+	for i, iface := range spec.Instance.Interfaces {
+		if _, ok := networkNames[iface.Name]; !ok {
+			errs = append(errs, field.Invalid(fieldPath.Child("instance", "interfaces").Index(i).Child("name"), iface.Name, "must match the name of a network"))
+		}
+	}
+
+	if spec.MemoryDump != nil {
+		errs = append(errs, ValidateMemoryDump(ctx, spec.MemoryDump, fieldPath.Child("memoryDump"))...)
+		if !usesCloudHypervisor(&spec.Instance) {
+			errs = append(errs, field.Forbidden(fieldPath.Child("memoryDump"), fmt.Sprintf("may not be used with %s", spec.Instance.Hypervisor)))
+		}
+	}
+
+	if spec.Hibernation != nil {
+		errs = append(errs, ValidateHibernation(ctx, spec.Hibernation, fieldPath.Child("hibernation"))...)
+		if !usesCloudHypervisor(&spec.Instance) {
+			errs = append(errs, field.Forbidden(fieldPath.Child("hibernation"), fmt.Sprintf("may not be used with %s", spec.Instance.Hypervisor)))
+		}
+		for _, iface := range spec.Instance.Interfaces {
+			if iface.SRIOV != nil {
+				errs = append(errs, field.Forbidden(fieldPath.Child("hibernation"), "may not be used with SR-IOV interfaces"))
+				break
+			}
+		}
+	}
+
+	if spec.Schedule != nil {
+		errs = append(errs, ValidateSchedule(ctx, spec.Schedule, fieldPath.Child("schedule"))...)
+		switch spec.RunPolicy {
+		case virtv1alpha1.RunPolicyAlways, virtv1alpha1.RunPolicyHalted:
+			errs = append(errs, field.Forbidden(fieldPath.Child("schedule"), fmt.Sprintf("may not be used with run policy %s", spec.RunPolicy)))
+		}
+	}
+
+	if spec.MetadataService != nil {
+		errs = append(errs, ValidateMetadataService(ctx, spec.MetadataService, fieldPath.Child("metadataService"))...)
+		hasMasquerade := false
+		for _, iface := range spec.Instance.Interfaces {
+			if iface.Masquerade != nil {
+				hasMasquerade = true
+			}
+		}
+		if !hasMasquerade {
+			errs = append(errs, field.Forbidden(fieldPath.Child("metadataService"), "may not be used without masquerade interface"))
+		}
+	}
+
+	if len(spec.SSHPublicKeys) > 0 {
+		hasCloudInit := spec.MetadataService != nil
+		for _, volume := range spec.Volumes {
+			if volume.CloudInit != nil || volume.ClusterAPIBootstrap != nil {
+				hasCloudInit = true
+			}
+		}
+		if !hasCloudInit {
+			errs = append(errs, field.Forbidden(fieldPath.Child("sshPublicKeys"), "may not use SSH public keys without cloud-init volume or metadata service"))
+		}
+	}
+	for i, key := range spec.SSHPublicKeys {
+		if key.SecretName == "" {
+			errs = append(errs, field.Required(fieldPath.Child("sshPublicKeys").Index(i).Child("secretName"), ""))
+		}
+	}
+
+	if spec.Hostname != "" {
+		for _, msg := range validation.IsDNS1123Label(spec.Hostname) {
+			errs = append(errs, field.Invalid(fieldPath.Child("hostname"), spec.Hostname, msg))
+		}
+	}
+	if spec.Subdomain != "" {
+		for _, msg := range validation.IsDNS1123Label(spec.Subdomain) {
+			errs = append(errs, field.Invalid(fieldPath.Child("subdomain"), spec.Subdomain, msg))
+		}
+	}
+
+	switch spec.DNSPolicy {
+	case "", corev1.DNSClusterFirst, corev1.DNSClusterFirstWithHostNet, corev1.DNSDefault:
+	case corev1.DNSNone:
+		if spec.DNSConfig == nil || len(spec.DNSConfig.Nameservers) == 0 {
+			errs = append(errs, field.Required(fieldPath.Child("dnsConfig", "nameservers"), "required with DNS policy None"))
+		}
+	default:
+		errs = append(errs, field.NotSupported(fieldPath.Child("dnsPolicy"), spec.DNSPolicy, []string{string(corev1.DNSClusterFirst), string(corev1.DNSClusterFirstWithHostNet), string(corev1.DNSDefault), string(corev1.DNSNone)}))
+	}
+	if spec.DNSConfig != nil {
+		for i, server := range spec.DNSConfig.Nameservers {
+			if net.ParseIP(server) == nil {
+				errs = append(errs, field.Invalid(fieldPath.Child("dnsConfig", "nameservers").Index(i), server, "must be a valid IP address"))
+			}
+		}
+		for i, domain := range spec.DNSConfig.Searches {
+			for _, msg := range validation.IsDNS1123Subdomain(strings.TrimSuffix(domain, ".")) {
+				errs = append(errs, field.Invalid(fieldPath.Child("dnsConfig", "searches").Index(i), domain, msg))
+			}
+		}
+	}
+
+	return errs
+}
+
+func ValidateMemoryDump(ctx context.Context, memoryDump *virtv1alpha1.MemoryDump, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if memoryDump == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if memoryDump.ClaimName == "" {
+		errs = append(errs, field.Required(fieldPath.Child("claimName"), ""))
+	}
+	return errs
+}
+
+func ValidateHibernation(ctx context.Context, hibernation *virtv1alpha1.Hibernation, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if hibernation == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if hibernation.ClaimName == "" {
+		errs = append(errs, field.Required(fieldPath.Child("claimName"), ""))
+	}
+	return errs
+}
+
+func ValidateMetadataService(ctx context.Context, metadataService *virtv1alpha1.MetadataService, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if metadataService == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	userDataCnt := 0
+	if metadataService.UserData != "" {
+		userDataCnt++
+		if userDataCnt > 1 {
+			errs = append(errs, field.Forbidden(fieldPath.Child("userData"), "may not specify more than 1 user data"))
+		}
+	}
+	if metadataService.UserDataBase64 != "" {
+		userDataCnt++
+		if userDataCnt > 1 {
+			errs = append(errs, field.Forbidden(fieldPath.Child("userDataBase64"), "may not specify more than 1 user data"))
+		}
+		if _, err := base64.StdEncoding.DecodeString(metadataService.UserDataBase64); err != nil {
+			errs = append(errs, field.Invalid(fieldPath.Child("userDataBase64"), metadataService.UserDataBase64, "invalid base64 data"))
+		}
+	}
+	if metadataService.UserDataSecretName != "" {
+		userDataCnt++
+		if userDataCnt > 1 {
+			errs = append(errs, field.Forbidden(fieldPath.Child("userDataSecretName"), "may not specify more than 1 user data"))
+		}
+	}
+	return errs
+}
+
+func ValidateSchedule(ctx context.Context, schedule *virtv1alpha1.Schedule, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if schedule == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if schedule.Start == "" && schedule.Stop == "" {
+		errs = append(errs, field.Required(fieldPath, "start or stop is required"))
+	}
+	if schedule.TimeZone != "" {
+		if _, err := time.LoadLocation(schedule.TimeZone); err != nil {
+			errs = append(errs, field.Invalid(fieldPath.Child("timeZone"), schedule.TimeZone, "unknown time zone"))
+			return errs
+		}
+	}
+	if _, err := parseVMSchedule(schedule.Start, schedule.TimeZone); err != nil {
+		errs = append(errs, field.Invalid(fieldPath.Child("start"), schedule.Start, err.Error()))
+	}
+	if _, err := parseVMSchedule(schedule.Stop, schedule.TimeZone); err != nil {
+		errs = append(errs, field.Invalid(fieldPath.Child("stop"), schedule.Stop, err.Error()))
+	}
+	return errs
+}
+
+func ValidateInstance(ctx context.Context, instance *virtv1alpha1.Instance, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if instance == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	errs = append(errs, ValidateCPU(ctx, &instance.CPU, fieldPath.Child("cpu"))...)
+	errs = append(errs, ValidateMemory(ctx, &instance.Memory, fieldPath.Child("memory"))...)
+
+	if instance.Kernel != nil {
+		errs = append(errs, ValidateKernel(ctx, instance.Kernel, fieldPath.Child("kernel"))...)
+		if instance.Firmware != nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("firmware"), "may not use firmware with direct kernel boot"))
+		}
+	}
+
+	if !usesCloudHypervisor(instance) && instance.CPU.DedicatedCPUPlacement {
+		errs = append(errs, field.Forbidden(fieldPath.Child("cpu", "dedicatedCPUPlacement"), fmt.Sprintf("may not use dedicated CPU placement with %s", instance.Hypervisor)))
+	}
+
+	if instance.RNG != nil {
+		if instance.RNG.Disabled && usesCloudHypervisor(instance) {
+			errs = append(errs, field.Forbidden(fieldPath.Child("rng", "disabled"), "may not disable RNG with Cloud Hypervisor"))
+		}
+		if instance.RNG.Disabled && instance.RNG.Source != "" {
+			errs = append(errs, field.Forbidden(fieldPath.Child("rng", "source"), "may not set source of disabled RNG"))
+		}
+		if !instance.RNG.Disabled && instance.Hypervisor == virtv1alpha1.HypervisorFirecracker {
+			errs = append(errs, field.Forbidden(fieldPath.Child("rng"), "may not be used with Firecracker"))
+		}
+	}
+
+	if instance.Hypervisor == virtv1alpha1.HypervisorQEMU && instance.Vsock != nil {
+		errs = append(errs, field.Forbidden(fieldPath.Child("vsock"), "may not be used with QEMU"))
+	}
+
+	if instance.Devices != nil {
+		if instance.Hypervisor != virtv1alpha1.HypervisorQEMU {
+			errs = append(errs, field.Forbidden(fieldPath.Child("devices"), "may not be used without QEMU"))
+		}
+		if instance.Devices.Tablet != nil && instance.Devices.USB == nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("devices", "tablet"), "may not add tablet without usb"))
+		}
+		hostUSBDeviceNames := map[string]struct{}{}
+		for i, hostUSBDevice := range instance.Devices.HostUSBDevices {
+			hostUSBDevicePath := fieldPath.Child("devices", "hostUSBDevices").Index(i)
+			if hostUSBDevice.Name == "" {
+				errs = append(errs, field.Required(hostUSBDevicePath.Child("name"), ""))
+			} else if _, ok := hostUSBDeviceNames[hostUSBDevice.Name]; ok {
+				errs = append(errs, field.Duplicate(hostUSBDevicePath.Child("name"), hostUSBDevice.Name))
+			} else {
+				hostUSBDeviceNames[hostUSBDevice.Name] = struct{}{}
+			}
+			if hostUSBDevice.ResourceName == "" {
+				errs = append(errs, field.Required(hostUSBDevicePath.Child("resourceName"), ""))
+			}
+		}
+		if len(instance.Devices.HostUSBDevices) > 0 && instance.Devices.USB == nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("devices", "hostUSBDevices"), "may not add host USB devices without usb"))
+		}
+	}
+
+	if instance.Downward != nil {
+		if instance.Downward.SMBIOS && instance.Hypervisor == virtv1alpha1.HypervisorFirecracker {
+			errs = append(errs, field.Forbidden(fieldPath.Child("downward", "smbios"), "may not be used with Firecracker"))
+		}
+		if instance.Downward.MetricsPort > 0 && instance.Vsock == nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("downward", "metricsPort"), "may not serve metrics without vsock"))
+		}
+	}
+
+	if instance.GuestMetrics != nil && instance.Vsock == nil {
+		errs = append(errs, field.Forbidden(fieldPath.Child("guestMetrics"), "may not collect guest metrics without vsock"))
+	}
+
+	if clock := instance.Clock; clock != nil {
+		if clock.Offset == virtv1alpha1.ClockOffsetLocaltime {
+			if instance.Hypervisor != virtv1alpha1.HypervisorQEMU {
+				errs = append(errs, field.Forbidden(fieldPath.Child("clock", "offset"), "may not use localtime offset without QEMU"))
+			}
+		} else if clock.Timezone != "" {
+			errs = append(errs, field.Forbidden(fieldPath.Child("clock", "timezone"), "may not set timezone without localtime offset"))
+		}
+		if clock.DisableKVMClock && instance.Hypervisor != virtv1alpha1.HypervisorQEMU {
+			errs = append(errs, field.Forbidden(fieldPath.Child("clock", "disableKVMClock"), "may not disable kvmclock without QEMU"))
+		}
+		if clock.Sync != nil {
+			if instance.Hypervisor == virtv1alpha1.HypervisorQEMU {
+				if clock.Sync.GuestAgentPort > 0 {
+					errs = append(errs, field.Forbidden(fieldPath.Child("clock", "sync", "guestAgentPort"), "may not be used with QEMU"))
+				}
+			} else {
+				if clock.Sync.GuestAgentPort == 0 {
+					errs = append(errs, field.Required(fieldPath.Child("clock", "sync", "guestAgentPort"), "required without QEMU"))
+				}
+				if instance.Vsock == nil {
+					errs = append(errs, field.Forbidden(fieldPath.Child("clock", "sync"), "may not sync clock without vsock"))
+				}
+			}
+		}
+	}
+
+	if instance.Hypervisor == virtv1alpha1.HypervisorFirecracker {
+		// Firecracker has no firmware, virtio-fs, watchdog or hugepages
+		if instance.Kernel == nil {
+			errs = append(errs, field.Required(fieldPath.Child("kernel"), "required by Firecracker"))
+		}
+		if len(instance.FileSystems) > 0 {
+			errs = append(errs, field.Forbidden(fieldPath.Child("fileSystems"), "may not be used with Firecracker"))
+		}
+		if instance.Watchdog != nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("watchdog"), "may not be used with Firecracker"))
+		}
+		if instance.Memory.Hugepages != nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("memory", "hugepages"), "may not be used with Firecracker"))
+		}
+		if instance.Memory.Size.Value()%(1<<20) != 0 {
+			errs = append(errs, field.Invalid(fieldPath.Child("memory", "size"), instance.Memory.Size.String(), "must be a multiple of 1Mi with Firecracker"))
+		}
+	}
+
+	if instance.Realtime != nil {
+		if !instance.CPU.DedicatedCPUPlacement {
+			errs = append(errs, field.Forbidden(fieldPath.Child("realtime"), "may not use realtime without dedicated CPU placement"))
+		}
+		if instance.Memory.Swap != nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("memory", "swap"), "may not be used with realtime"))
+		}
+		errs = append(errs, ValidateRealtime(ctx, instance.Realtime, fieldPath.Child("realtime"))...)
+	}
+
+	numVCPUs := instance.CPU.Sockets * instance.CPU.CoresPerSocket
+
+	bootOrders := map[uint32]struct{}{}
+	var maxDiskBootOrder uint32
+	diskNames := map[string]struct{}{}
+	for i, disk := range instance.Disks {
+		fieldPath := fieldPath.Child("disks").Index(i)
+		if _, ok := diskNames[disk.Name]; ok {
+			errs = append(errs, field.Duplicate(fieldPath.Child("name"), disk.Name))
+		}
+		diskNames[disk.Name] = struct{}{}
+		if disk.Queues > numVCPUs {
+			errs = append(errs, field.Invalid(fieldPath.Child("queues"), disk.Queues, "may not be greater than number of vCPUs"))
+		}
+		if instance.Hypervisor == virtv1alpha1.HypervisorFirecracker && disk.RateLimit != nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("rateLimit"), fmt.Sprintf("may not use disk rate limit with %s", instance.Hypervisor)))
+		}
+		if instance.Hypervisor != virtv1alpha1.HypervisorQEMU && disk.Bus != "" && disk.Bus != virtv1alpha1.DiskBusVirtio {
+			errs = append(errs, field.Forbidden(fieldPath.Child("bus"), fmt.Sprintf("may not use %s bus without QEMU", disk.Bus)))
+		}
+		if instance.Hypervisor != virtv1alpha1.HypervisorQEMU && disk.Cache == virtv1alpha1.DiskCacheWritethrough {
+			errs = append(errs, field.Forbidden(fieldPath.Child("cache"), "may not use writethrough cache without QEMU"))
+		}
+		if instance.Hypervisor != virtv1alpha1.HypervisorQEMU && disk.Discard != nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("discard"), "may not discard without QEMU"))
+		}
+		if instance.Hypervisor != virtv1alpha1.HypervisorQEMU && disk.Type == virtv1alpha1.DiskTypeCDROM {
+			errs = append(errs, field.Forbidden(fieldPath.Child("type"), "may not use cdrom disks without QEMU"))
+		}
+		if instance.Hypervisor == virtv1alpha1.HypervisorFirecracker && disk.Type == virtv1alpha1.DiskTypePmem {
+			errs = append(errs, field.Forbidden(fieldPath.Child("type"), "may not use pmem disks with Firecracker"))
+		}
+		if instance.Hypervisor != virtv1alpha1.HypervisorQEMU && disk.Encryption != nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("encryption"), "may not use encrypted disks without QEMU"))
+		}
+		if disk.Shareable && disk.Cache != virtv1alpha1.DiskCacheNone {
+			errs = append(errs, field.Forbidden(fieldPath.Child("shareable"), "may not share disks with the cache of the host"))
+		}
+		if disk.Discard != nil && disk.ReadOnly != nil && *disk.ReadOnly {
+			errs = append(errs, field.Forbidden(fieldPath.Child("discard"), "may not discard on read-only disks"))
+		}
+		if instance.Hypervisor == virtv1alpha1.HypervisorFirecracker && disk.Cache == virtv1alpha1.DiskCacheNone {
+			errs = append(errs, field.Forbidden(fieldPath.Child("cache"), "may not bypass the page cache with Firecracker"))
+		}
+		if disk.Ephemeral {
+			switch {
+			case instance.Hypervisor == virtv1alpha1.HypervisorFirecracker:
+				errs = append(errs, field.Forbidden(fieldPath.Child("ephemeral"), "may not use ephemeral disks with Firecracker"))
+			case disk.Type != "" && disk.Type != virtv1alpha1.DiskTypeDisk:
+				errs = append(errs, field.Forbidden(fieldPath.Child("ephemeral"), fmt.Sprintf("may not be used with %s disks", disk.Type)))
+			case disk.ReadOnly != nil && *disk.ReadOnly:
+				errs = append(errs, field.Forbidden(fieldPath.Child("ephemeral"), "may not be used with read-only disks"))
+			case disk.Shareable:
+				errs = append(errs, field.Forbidden(fieldPath.Child("ephemeral"), "may not be used with shareable disks"))
+			case disk.Encryption != nil:
+				errs = append(errs, field.Forbidden(fieldPath.Child("ephemeral"), "may not be used with encrypted disks"))
+			}
+		}
+		if disk.BootOrder > 0 {
+			if instance.Kernel != nil {
+				errs = append(errs, field.Forbidden(fieldPath.Child("bootOrder"), "may not set boot order with direct kernel boot"))
+			}
+			if _, ok := bootOrders[disk.BootOrder]; ok {
+				errs = append(errs, field.Duplicate(fieldPath.Child("bootOrder"), disk.BootOrder))
+			}
+			bootOrders[disk.BootOrder] = struct{}{}
+			if disk.BootOrder > maxDiskBootOrder {
+				maxDiskBootOrder = disk.BootOrder
+			}
+		}
+		errs = append(errs, ValidateDisk(ctx, &disk, fieldPath)...)
+	}
+
+	for i, fs := range instance.FileSystems {
+		fieldPath := fieldPath.Child("fileSystems").Index(i)
+		if _, ok := diskNames[fs.Name]; ok {
+			errs = append(errs, field.Duplicate(fieldPath.Child("name"), fs.Name))
+		}
+		diskNames[fs.Name] = struct{}{}
+		errs = append(errs, ValidateFileSystem(ctx, &fs, fieldPath)...)
+	}
+
+	ifaceNames := map[string]struct{}{}
+	for i, iface := range instance.Interfaces {
+		fieldPath := fieldPath.Child("interfaces").Index(i)
+		if _, ok := ifaceNames[iface.Name]; ok {
+			errs = append(errs, field.Duplicate(fieldPath.Child("name"), iface.Name))
+		}
+		ifaceNames[iface.Name] = struct{}{}
+		if iface.InterfaceBindingMethod.VhostUser != nil {
+			if !instance.CPU.DedicatedCPUPlacement || instance.Memory.Hugepages == nil {
+				errs = append(errs, field.Forbidden(fieldPath.Child("vhostUser"), "may not use vhost-user interface without dedicated CPU placement and hugepages"))
+			}
+		}
+		if instance.Hypervisor == virtv1alpha1.HypervisorFirecracker && iface.InterfaceBindingMethod.SRIOV != nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("sriov"), "may not use SR-IOV interface with Firecracker"))
+		}
+		if instance.Hypervisor == virtv1alpha1.HypervisorFirecracker && iface.State == virtv1alpha1.InterfaceStateDown {
+			errs = append(errs, field.Forbidden(fieldPath.Child("state"), "may not be down with Firecracker"))
+		}
+		if instance.Hypervisor != virtv1alpha1.HypervisorQEMU {
+			if iface.Offloads != nil {
+				errs = append(errs, field.Forbidden(fieldPath.Child("offloads"), "may not be used without QEMU"))
+			}
+			if iface.Vhost {
+				errs = append(errs, field.Forbidden(fieldPath.Child("vhost"), "may not be used without QEMU"))
+			}
+		}
+		switch {
+		case instance.Hypervisor == virtv1alpha1.HypervisorFirecracker:
+			if iface.RXQueueSize > 0 {
+				errs = append(errs, field.Forbidden(fieldPath.Child("rxQueueSize"), "may not be used with Firecracker"))
+			}
+			if iface.TXQueueSize > 0 {
+				errs = append(errs, field.Forbidden(fieldPath.Child("txQueueSize"), "may not be used with Firecracker"))
+			}
+		case usesCloudHypervisor(instance):
+			// Cloud Hypervisor has a single queue size for RX and TX queues
+			if iface.TXQueueSize > 0 && iface.TXQueueSize != iface.RXQueueSize {
+				errs = append(errs, field.Invalid(fieldPath.Child("txQueueSize"), iface.TXQueueSize, "must be the same as rxQueueSize with Cloud Hypervisor"))
+			}
+		default:
+			if iface.TXQueueSize > 256 && iface.VhostUser == nil {
+				errs = append(errs, field.Invalid(fieldPath.Child("txQueueSize"), iface.TXQueueSize, "may not be greater than 256 without vhost-user with QEMU"))
+			}
+		}
+		if iface.Queues > numVCPUs {
+			errs = append(errs, field.Invalid(fieldPath.Child("queues"), iface.Queues, "may not be greater than number of vCPUs"))
+		}
+		if iface.BootOrder > 0 {
+			switch {
+			case instance.Kernel != nil:
+				errs = append(errs, field.Forbidden(fieldPath.Child("bootOrder"), "may not use network boot with direct kernel boot"))
+			case instance.Firmware == nil || instance.Firmware.EFI == nil:
+				errs = append(errs, field.Forbidden(fieldPath.Child("bootOrder"), "may not use network boot without EFI firmware"))
+			case iface.InterfaceBindingMethod.SRIOV != nil:
+				errs = append(errs, field.Forbidden(fieldPath.Child("bootOrder"), "may not use network boot from SR-IOV interface"))
+			}
+			if _, ok := bootOrders[iface.BootOrder]; ok {
+				errs = append(errs, field.Duplicate(fieldPath.Child("bootOrder"), iface.BootOrder))
+			} else if iface.BootOrder < maxDiskBootOrder {
+				// devices are enumerated by the firmware with disks first
+				errs = append(errs, field.Invalid(fieldPath.Child("bootOrder"), iface.BootOrder, "must be greater than boot orders of disks"))
+			}
+			bootOrders[iface.BootOrder] = struct{}{}
+		}
+		errs = append(errs, ValidateInterface(ctx, &iface, fieldPath)...)
+	}
+
+	return errs
+}
+
+// usesCloudHypervisor tells whether the instance runs on Cloud Hypervisor,
+// which is the only hypervisor supporting migration, hibernation, memory dumps
+// and CPU pinning.
+func usesCloudHypervisor(instance *virtv1alpha1.Instance) bool {
+	return instance.Hypervisor == "" || instance.Hypervisor == virtv1alpha1.HypervisorCloudHypervisor
+}
+
+func ValidateCPU(ctx context.Context, cpu *virtv1alpha1.CPU, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if cpu == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if cpu.Sockets == 0 {
+		errs = append(errs, field.Required(fieldPath.Child("sockets"), ""))
+	}
+	if cpu.CoresPerSocket <= 0 {
+		errs = append(errs, field.Required(fieldPath.Child("coresPerSocket"), ""))
+	}
+	return errs
+}
+
+func ValidateMemory(ctx context.Context, memory *virtv1alpha1.Memory, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if memory == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	memSize := memory.Size.Value()
+	if memSize <= 0 {
+		errs = append(errs, field.Invalid(fieldPath.Child("size"), memSize, "must be greater than 0"))
+	}
+	if memory.Hugepages != nil {
+		q := resource.MustParse(memory.Hugepages.PageSize)
+		hugepagesSize := q.Value()
+		if memSize%hugepagesSize != 0 {
+			errs = append(errs, field.Invalid(fieldPath.Child("size"), memSize, fmt.Sprintf("%d is not positive integer multiple of %s", memSize, memory.Hugepages.PageSize)))
+		}
+		// hugepages are neither reclaimed by the balloon nor swapped out
+		if memory.Balloon != nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("balloon"), "may not be used with hugepages"))
+		}
+		if memory.Swap != nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("swap"), "may not be used with hugepages"))
+		}
+	}
+	if memory.Swap != nil && memory.Swap.Max != nil && memory.Swap.Max.Sign() < 0 {
+		errs = append(errs, field.Invalid(fieldPath.Child("swap", "max"), memory.Swap.Max.String(), "must not be negative"))
+	}
+
+	return errs
+}
+
+func ValidateRealtime(ctx context.Context, realtime *virtv1alpha1.Realtime, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if realtime == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if realtime.Priority < 1 || realtime.Priority > 99 {
+		errs = append(errs, field.Invalid(fieldPath.Child("priority"), realtime.Priority, "must be between 1 and 99"))
+	}
+	return errs
+}
+
+func ValidateKernel(ctx context.Context, kernel *virtv1alpha1.Kernel, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if kernel == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if kernel.Image == "" {
+		errs = append(errs, field.Required(fieldPath.Child("image"), ""))
+	}
+	if kernel.Cmdline == "" {
+		errs = append(errs, field.Required(fieldPath.Child("cmdline"), ""))
+	}
+	return errs
+}
+
+func ValidateDisk(ctx context.Context, disk *virtv1alpha1.Disk, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if disk == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if disk.Name == "" {
+		errs = append(errs, field.Required(fieldPath.Child("name"), ""))
+	}
+	if disk.RateLimit != nil {
+		errs = append(errs, ValidateDiskRateLimit(ctx, disk.RateLimit, fieldPath.Child("rateLimit"))...)
+	}
+	if disk.Encryption != nil && disk.Encryption.SecretName == "" {
+		errs = append(errs, field.Required(fieldPath.Child("encryption").Child("secretName"), ""))
+	}
+	if disk.Type == virtv1alpha1.DiskTypeCDROM {
+		if disk.Bus != virtv1alpha1.DiskBusSATA && disk.Bus != virtv1alpha1.DiskBusIDE {
+			errs = append(errs, field.Invalid(fieldPath.Child("bus"), disk.Bus, "must be sata or ide for cdrom disks"))
+		}
+		if disk.ReadOnly != nil && !*disk.ReadOnly {
+			errs = append(errs, field.Forbidden(fieldPath.Child("readOnly"), "may not write to cdrom disks"))
+		}
+		if disk.Discard != nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("discard"), "may not discard on cdrom disks"))
+		}
+		if disk.Encryption != nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("encryption"), "may not encrypt cdrom disks"))
+		}
+		if disk.Shareable {
+			errs = append(errs, field.Forbidden(fieldPath.Child("shareable"), "may not share cdrom disks"))
+		}
+		if disk.RateLimit != nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("rateLimit"), "may not rate limit cdrom disks"))
+		}
+	} else {
+		if disk.Type == virtv1alpha1.DiskTypePmem {
+			// pmem disks are memory devices rather than block devices
+			if disk.Bus != "" {
+				errs = append(errs, field.Forbidden(fieldPath.Child("bus"), "may not set the bus of pmem disks"))
+			}
+			if disk.Discard != nil {
+				errs = append(errs, field.Forbidden(fieldPath.Child("discard"), "may not discard on pmem disks"))
+			}
+			if disk.Encryption != nil {
+				errs = append(errs, field.Forbidden(fieldPath.Child("encryption"), "may not encrypt pmem disks"))
+			}
+			if disk.Shareable {
+				errs = append(errs, field.Forbidden(fieldPath.Child("shareable"), "may not share pmem disks"))
+			}
+			if disk.RateLimit != nil {
+				errs = append(errs, field.Forbidden(fieldPath.Child("rateLimit"), "may not rate limit pmem disks"))
+			}
+			if disk.BootOrder > 0 {
+				errs = append(errs, field.Forbidden(fieldPath.Child("bootOrder"), "may not boot from pmem disks"))
+			}
+		}
+		if disk.Medium != "" {
+			errs = append(errs, field.Forbidden(fieldPath.Child("medium"), "may only insert media into cdrom disks"))
+		}
+		if disk.Ejected {
+			errs = append(errs, field.Forbidden(fieldPath.Child("ejected"), "may only eject cdrom disks"))
+		}
+	}
+	return errs
+}
+
+func ValidateDiskRateLimit(ctx context.Context, rateLimit *virtv1alpha1.DiskRateLimit, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if rateLimit == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if rateLimit.Bandwidth == nil && rateLimit.IOPS == 0 {
+		errs = append(errs, field.Required(fieldPath, "at least 1 of bandwidth and iops is required"))
+	}
+	if rateLimit.Bandwidth != nil && rateLimit.Bandwidth.Value() <= 0 {
+		errs = append(errs, field.Invalid(fieldPath.Child("bandwidth"), rateLimit.Bandwidth.String(), "must be greater than 0"))
+	}
+	if rateLimit.BandwidthBurst != nil {
+		if rateLimit.Bandwidth == nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("bandwidthBurst"), "may not be specified without bandwidth"))
+		} else if rateLimit.BandwidthBurst.Value() < 0 {
+			errs = append(errs, field.Invalid(fieldPath.Child("bandwidthBurst"), rateLimit.BandwidthBurst.String(), "must not be less than 0"))
+		}
+	}
+	if rateLimit.IOPS < 0 {
+		errs = append(errs, field.Invalid(fieldPath.Child("iops"), rateLimit.IOPS, "must not be less than 0"))
+	}
+	if rateLimit.IOPSBurst != 0 {
+		if rateLimit.IOPS == 0 {
+			errs = append(errs, field.Forbidden(fieldPath.Child("iopsBurst"), "may not be specified without iops"))
+		} else if rateLimit.IOPSBurst < 0 {
+			errs = append(errs, field.Invalid(fieldPath.Child("iopsBurst"), rateLimit.IOPSBurst, "must not be less than 0"))
+		}
+	}
+	return errs
+}
+
+func ValidateFileSystem(ctx context.Context, fs *virtv1alpha1.FileSystem, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if fs == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if fs.Name == "" {
+		errs = append(errs, field.Required(fieldPath.Child("name"), ""))
+	}
+	return errs
+}
+
+func ValidateInterface(ctx context.Context, iface *virtv1alpha1.Interface, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if iface == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if iface.Name == "" {
+		errs = append(errs, field.Required(fieldPath.Child("name"), ""))
+	}
+	errs = append(errs, ValidateMAC(iface.MAC, fieldPath.Child("mac"))...)
+	errs = append(errs, ValidateInterfaceBindingMethod(ctx, &iface.InterfaceBindingMethod, fieldPath)...)
+	// whether nodes have vhost-net is left to the scheduler, as VM pods of
+	// vhost interfaces request the vhost-net device
+	if iface.Queues > 1 && (iface.SRIOV != nil || iface.VhostUser != nil) {
+		errs = append(errs, field.Forbidden(fieldPath.Child("queues"), "may only be used with bridge, masquerade or OVS interfaces"))
+	}
+	if iface.RateLimit != nil {
+		if iface.SRIOV != nil || iface.VhostUser != nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("rateLimit"), "may only be used with bridge, masquerade or OVS interfaces"))
+		}
+		errs = append(errs, ValidateInterfaceRateLimit(ctx, iface.RateLimit, fieldPath.Child("rateLimit"))...)
+	}
+	if iface.MTU != 0 {
+		if iface.SRIOV != nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("mtu"), "may not be used with SR-IOV interfaces"))
+		}
+		if iface.MTU < 68 {
+			errs = append(errs, field.Invalid(fieldPath.Child("mtu"), iface.MTU, "must be at least 68"))
+		}
+	}
+	if iface.DHCPOptions != nil {
+		if iface.SRIOV != nil || iface.VhostUser != nil || iface.OVS != nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("dhcpOptions"), "may only be used with bridge or masquerade interfaces"))
+		}
+		errs = append(errs, ValidateInterfaceDHCPOptions(ctx, iface.DHCPOptions, fieldPath.Child("dhcpOptions"))...)
+	}
+	if iface.Offloads != nil && iface.SRIOV != nil {
+		errs = append(errs, field.Forbidden(fieldPath.Child("offloads"), "may not be used with SR-IOV interfaces"))
+	}
+	if iface.Vhost && (iface.SRIOV != nil || iface.VhostUser != nil) {
+		errs = append(errs, field.Forbidden(fieldPath.Child("vhost"), "may only be used with bridge, masquerade or OVS interfaces"))
+	}
+	errs = append(errs, ValidateQueueSize(iface.RXQueueSize, iface.SRIOV != nil, fieldPath.Child("rxQueueSize"))...)
+	errs = append(errs, ValidateQueueSize(iface.TXQueueSize, iface.SRIOV != nil, fieldPath.Child("txQueueSize"))...)
+	if len(iface.SecurityGroups) > 0 && (iface.SRIOV != nil || iface.VhostUser != nil || iface.OVS != nil) {
+		errs = append(errs, field.Forbidden(fieldPath.Child("securityGroups"), "may only be used with bridge or masquerade interfaces"))
+	}
+	for i, securityGroup := range iface.SecurityGroups {
+		for _, msg := range validation.IsDNS1123Subdomain(securityGroup) {
+			errs = append(errs, field.Invalid(fieldPath.Child("securityGroups").Index(i), securityGroup, msg))
+		}
+	}
+	if iface.VLAN != nil {
+		if iface.Bridge == nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("vlan"), "may only be used with bridge interfaces"))
+		}
+		errs = append(errs, ValidateInterfaceVLAN(ctx, iface.VLAN, fieldPath.Child("vlan"))...)
+	}
+	switch iface.State {
+	case "", virtv1alpha1.InterfaceStateUp:
+	case virtv1alpha1.InterfaceStateDown:
+		if iface.SRIOV != nil || iface.VhostUser != nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("state"), "may not be down for SR-IOV or vhost-user interfaces"))
+		}
+	default:
+		errs = append(errs, field.NotSupported(fieldPath.Child("state"), iface.State, []string{string(virtv1alpha1.InterfaceStateUp), string(virtv1alpha1.InterfaceStateDown)}))
+	}
+	if iface.Masquerade != nil {
+		if len(iface.Addresses) > 0 {
+			errs = append(errs, field.Forbidden(fieldPath.Child("addresses"), "may not be used with masquerade interfaces"))
+		}
+		if len(iface.Routes) > 0 {
+			errs = append(errs, field.Forbidden(fieldPath.Child("routes"), "may not be used with masquerade interfaces"))
+		}
+	}
+	for i, address := range iface.Addresses {
+		if _, _, err := net.ParseCIDR(address); err != nil {
+			errs = append(errs, field.Invalid(fieldPath.Child("addresses").Index(i), address, "must be an IP address in CIDR notation"))
+		}
+	}
+	for i, route := range iface.Routes {
+		errs = append(errs, ValidateInterfaceRoute(ctx, &route, fieldPath.Child("routes").Index(i))...)
 	}
-
 	return errs
 }
 
-func ValidateInstance(ctx context.Context, instance *virtv1alpha1.Instance, fieldPath *field.Path) field.ErrorList {
+func ValidateBond(ctx context.Context, bond *virtv1alpha1.Bond, fieldPath *field.Path) field.ErrorList {
 	var errs field.ErrorList
-	if instance == nil {
+	if bond == nil {
 		errs = append(errs, field.Required(fieldPath, ""))
 		return errs
 	}
 
-	errs = append(errs, ValidateCPU(ctx, &instance.CPU, fieldPath.Child("cpu"))...)
-	errs = append(errs, ValidateMemory(ctx, &instance.Memory, fieldPath.Child("memory"))...)
-
-	if instance.Kernel != nil {
-		errs = append(errs, ValidateKernel(ctx, instance.Kernel, fieldPath.Child("kernel"))...)
+	if bond.Name == "" {
+		errs = append(errs, field.Required(fieldPath.Child("name"), ""))
+	} else if len(bond.Name) > 15 {
+		errs = append(errs, field.TooLong(fieldPath.Child("name"), bond.Name, 15))
 	}
-
-	diskNames := map[string]struct{}{}
-	for i, disk := range instance.Disks {
-		fieldPath := fieldPath.Child("disks").Index(i)
-		if _, ok := diskNames[disk.Name]; ok {
-			errs = append(errs, field.Duplicate(fieldPath.Child("name"), disk.Name))
-		}
-		diskNames[disk.Name] = struct{}{}
-		errs = append(errs, ValidateDisk(ctx, &disk, fieldPath)...)
+	if len(bond.Interfaces) < 2 {
+		errs = append(errs, field.Invalid(fieldPath.Child("interfaces"), bond.Interfaces, "must have at least 2 interfaces"))
 	}
 
-	for i, fs := range instance.FileSystems {
-		fieldPath := fieldPath.Child("fileSystems").Index(i)
-		if _, ok := diskNames[fs.Name]; ok {
-			errs = append(errs, field.Duplicate(fieldPath.Child("name"), fs.Name))
-		}
-		diskNames[fs.Name] = struct{}{}
-		errs = append(errs, ValidateFileSystem(ctx, &fs, fieldPath)...)
+	switch bond.Mode {
+	case "", virtv1alpha1.BondModeActiveBackup, virtv1alpha1.BondModeBalanceRR, virtv1alpha1.BondModeBalanceXOR, virtv1alpha1.BondModeBroadcast,
+		virtv1alpha1.BondMode8023AD, virtv1alpha1.BondModeBalanceTLB, virtv1alpha1.BondModeBalanceALB:
+	default:
+		errs = append(errs, field.NotSupported(fieldPath.Child("mode"), bond.Mode, []string{string(virtv1alpha1.BondModeActiveBackup), string(virtv1alpha1.BondModeBalanceRR),
+			string(virtv1alpha1.BondModeBalanceXOR), string(virtv1alpha1.BondModeBroadcast), string(virtv1alpha1.BondMode8023AD),
+			string(virtv1alpha1.BondModeBalanceTLB), string(virtv1alpha1.BondModeBalanceALB)}))
 	}
-
-	ifaceNames := map[string]struct{}{}
-	for i, iface := range instance.Interfaces {
-		fieldPath := fieldPath.Child("interfaces").Index(i)
-		if _, ok := ifaceNames[iface.Name]; ok {
-			errs = append(errs, field.Duplicate(fieldPath.Child("name"), iface.Name))
+	if bond.Primary != "" {
+		if bond.Mode != "" && bond.Mode != virtv1alpha1.BondModeActiveBackup {
+			errs = append(errs, field.Forbidden(fieldPath.Child("primary"), "may only be used with the active-backup mode"))
 		}
-		ifaceNames[iface.Name] = struct{}{}
-		if iface.InterfaceBindingMethod.VhostUser != nil {
-			if !instance.CPU.DedicatedCPUPlacement || instance.Memory.Hugepages == nil {
-				errs = append(errs, field.Forbidden(fieldPath.Child("vhostUser"), "may not use vhost-user interface without dedicated CPU placement and hugepages"))
+		isMember := false
+		for _, ifaceName := range bond.Interfaces {
+			if ifaceName == bond.Primary {
+				isMember = true
 			}
 		}
-		errs = append(errs, ValidateInterface(ctx, &iface, fieldPath)...)
+		if !isMember {
+			errs = append(errs, field.Invalid(fieldPath.Child("primary"), bond.Primary, "must be an interface of the bond"))
+		}
 	}
 
+	for i, address := range bond.Addresses {
+		if _, _, err := net.ParseCIDR(address); err != nil {
+			errs = append(errs, field.Invalid(fieldPath.Child("addresses").Index(i), address, "must be an IP address in CIDR notation"))
+		}
+	}
+	for i, route := range bond.Routes {
+		errs = append(errs, ValidateInterfaceRoute(ctx, &route, fieldPath.Child("routes").Index(i))...)
+	}
 	return errs
 }
 
-func ValidateCPU(ctx context.Context, cpu *virtv1alpha1.CPU, fieldPath *field.Path) field.ErrorList {
+func ValidateInterfaceRoute(ctx context.Context, route *virtv1alpha1.InterfaceRoute, fieldPath *field.Path) field.ErrorList {
 	var errs field.ErrorList
-	if cpu == nil {
+	if route == nil {
 		errs = append(errs, field.Required(fieldPath, ""))
 		return errs
 	}
 
-	if cpu.Sockets == 0 {
-		errs = append(errs, field.Required(fieldPath.Child("sockets"), ""))
+	via := net.ParseIP(route.Via)
+	if via == nil {
+		errs = append(errs, field.Invalid(fieldPath.Child("via"), route.Via, "must be a valid IP address"))
 	}
-	if cpu.CoresPerSocket <= 0 {
-		errs = append(errs, field.Required(fieldPath.Child("coresPerSocket"), ""))
+	if route.To != "default" {
+		_, to, err := net.ParseCIDR(route.To)
+		switch {
+		case err != nil:
+			errs = append(errs, field.Invalid(fieldPath.Child("to"), route.To, `must be "default" or a CIDR`))
+		case via != nil && (to.IP.To4() == nil) != (via.To4() == nil):
+			errs = append(errs, field.Invalid(fieldPath.Child("via"), route.Via, "must be of the same IP family as to"))
+		}
 	}
 	return errs
 }
 
-func ValidateMemory(ctx context.Context, memory *virtv1alpha1.Memory, fieldPath *field.Path) field.ErrorList {
+// ValidateQueueSize validates the size of virtqueues of an interface, which
+// virtio requires to be a power of 2.
+func ValidateQueueSize(size uint32, sriov bool, fieldPath *field.Path) field.ErrorList {
 	var errs field.ErrorList
-	if memory == nil {
-		errs = append(errs, field.Required(fieldPath, ""))
+	if size == 0 {
 		return errs
 	}
 
-	memSize := memory.Size.Value()
-	if memSize <= 0 {
-		errs = append(errs, field.Invalid(fieldPath.Child("size"), memSize, "must be greater than 0"))
+	if sriov {
+		errs = append(errs, field.Forbidden(fieldPath, "may not be used with SR-IOV interfaces"))
 	}
-	if memory.Hugepages != nil {
-		q := resource.MustParse(memory.Hugepages.PageSize)
-		hugepagesSize := q.Value()
-		if memSize%hugepagesSize != 0 {
-			errs = append(errs, field.Invalid(fieldPath.Child("size"), memSize, fmt.Sprintf("%d is not positive integer multiple of %s", memSize, memory.Hugepages.PageSize)))
-		}
+	if size < 256 || size > 1024 || size&(size-1) != 0 {
+		errs = append(errs, field.Invalid(fieldPath, size, "must be a power of 2 between 256 and 1024"))
 	}
-
 	return errs
 }
 
-func ValidateKernel(ctx context.Context, kernel *virtv1alpha1.Kernel, fieldPath *field.Path) field.ErrorList {
+func ValidateInterfaceDHCPOptions(ctx context.Context, options *virtv1alpha1.InterfaceDHCPOptions, fieldPath *field.Path) field.ErrorList {
 	var errs field.ErrorList
-	if kernel == nil {
+	if options == nil {
 		errs = append(errs, field.Required(fieldPath, ""))
 		return errs
 	}
 
-	if kernel.Image == "" {
-		errs = append(errs, field.Required(fieldPath.Child("image"), ""))
+	for i, server := range options.DNSServers {
+		errs = append(errs, ValidateIPv4(server, fieldPath.Child("dnsServers").Index(i))...)
 	}
-	if kernel.Cmdline == "" {
-		errs = append(errs, field.Required(fieldPath.Child("cmdline"), ""))
+	for i, domain := range options.SearchDomains {
+		for _, msg := range validation.IsDNS1123Subdomain(domain) {
+			errs = append(errs, field.Invalid(fieldPath.Child("searchDomains").Index(i), domain, msg))
+		}
+	}
+	for i, server := range options.NTPServers {
+		errs = append(errs, ValidateIPv4(server, fieldPath.Child("ntpServers").Index(i))...)
 	}
 	return errs
 }
 
-func ValidateDisk(ctx context.Context, disk *virtv1alpha1.Disk, fieldPath *field.Path) field.ErrorList {
+func ValidateIPv4(ip string, fieldPath *field.Path) field.ErrorList {
 	var errs field.ErrorList
-	if disk == nil {
-		errs = append(errs, field.Required(fieldPath, ""))
-		return errs
-	}
-
-	if disk.Name == "" {
-		errs = append(errs, field.Required(fieldPath.Child("name"), ""))
+	if parsedIP := net.ParseIP(ip); parsedIP == nil || parsedIP.To4() == nil {
+		errs = append(errs, field.Invalid(fieldPath, ip, "must be a valid IPv4 address"))
 	}
 	return errs
 }
 
-func ValidateFileSystem(ctx context.Context, fs *virtv1alpha1.FileSystem, fieldPath *field.Path) field.ErrorList {
+func ValidateInterfaceRateLimit(ctx context.Context, rateLimit *virtv1alpha1.InterfaceRateLimit, fieldPath *field.Path) field.ErrorList {
 	var errs field.ErrorList
-	if fs == nil {
+	if rateLimit == nil {
 		errs = append(errs, field.Required(fieldPath, ""))
 		return errs
 	}
 
-	if fs.Name == "" {
-		errs = append(errs, field.Required(fieldPath.Child("name"), ""))
+	if rateLimit.RX == nil && rateLimit.TX == nil {
+		errs = append(errs, field.Required(fieldPath, "at least 1 of rx and tx is required"))
+	}
+	if rateLimit.RX != nil {
+		errs = append(errs, ValidateBandwidthLimit(ctx, rateLimit.RX, fieldPath.Child("rx"))...)
+	}
+	if rateLimit.TX != nil {
+		errs = append(errs, ValidateBandwidthLimit(ctx, rateLimit.TX, fieldPath.Child("tx"))...)
 	}
 	return errs
 }
 
-func ValidateInterface(ctx context.Context, iface *virtv1alpha1.Interface, fieldPath *field.Path) field.ErrorList {
+func ValidateBandwidthLimit(ctx context.Context, limit *virtv1alpha1.BandwidthLimit, fieldPath *field.Path) field.ErrorList {
 	var errs field.ErrorList
-	if iface == nil {
+	if limit == nil {
 		errs = append(errs, field.Required(fieldPath, ""))
 		return errs
 	}
 
-	if iface.Name == "" {
-		errs = append(errs, field.Required(fieldPath.Child("name"), ""))
+	if limit.Bandwidth.Value() <= 0 {
+		errs = append(errs, field.Invalid(fieldPath.Child("bandwidth"), limit.Bandwidth.String(), "must be greater than 0"))
+	}
+	if limit.Burst != nil && limit.Burst.Value() <= 0 {
+		errs = append(errs, field.Invalid(fieldPath.Child("burst"), limit.Burst.String(), "must be greater than 0"))
 	}
-	errs = append(errs, ValidateMAC(iface.MAC, fieldPath.Child("mac"))...)
-	errs = append(errs, ValidateInterfaceBindingMethod(ctx, &iface.InterfaceBindingMethod, fieldPath)...)
 	return errs
 }
 
@@ -506,6 +1496,14 @@ func ValidateInterfaceBindingMethod(ctx context.Context, bindingMethod *virtv1al
 			errs = append(errs, field.Forbidden(fieldPath.Child("vhostUser"), "may not specify more than 1 binding method"))
 		}
 	}
+	if bindingMethod.OVS != nil {
+		cnt++
+		if cnt > 1 {
+			errs = append(errs, field.Forbidden(fieldPath.Child("ovs"), "may not specify more than 1 binding method"))
+		} else {
+			errs = append(errs, ValidateInterfaceOVS(ctx, bindingMethod.OVS, fieldPath.Child("ovs"))...)
+		}
+	}
 
 	if cnt == 0 {
 		errs = append(errs, field.Required(fieldPath, "at least 1 binding method is required"))
@@ -513,6 +1511,55 @@ func ValidateInterfaceBindingMethod(ctx context.Context, bindingMethod *virtv1al
 	return errs
 }
 
+func ValidateInterfaceOVS(ctx context.Context, ovs *virtv1alpha1.InterfaceOVS, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if ovs == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if ovs.VLAN != nil && (*ovs.VLAN < 1 || *ovs.VLAN > 4094) {
+		errs = append(errs, field.Invalid(fieldPath.Child("vlan"), *ovs.VLAN, "must be between 1 and 4094"))
+	}
+	trunks := map[int32]struct{}{}
+	for i, trunk := range ovs.Trunks {
+		if trunk < 1 || trunk > 4094 {
+			errs = append(errs, field.Invalid(fieldPath.Child("trunks").Index(i), trunk, "must be between 1 and 4094"))
+		}
+		if _, ok := trunks[trunk]; ok {
+			errs = append(errs, field.Duplicate(fieldPath.Child("trunks").Index(i), trunk))
+		}
+		trunks[trunk] = struct{}{}
+	}
+	return errs
+}
+
+func ValidateInterfaceVLAN(ctx context.Context, vlan *virtv1alpha1.InterfaceVLAN, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if vlan == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if vlan.ID != 0 && (vlan.ID < 1 || vlan.ID > 4094) {
+		errs = append(errs, field.Invalid(fieldPath.Child("id"), vlan.ID, "must be between 1 and 4094"))
+	}
+	for i, trunk := range vlan.Trunks {
+		fieldPath := fieldPath.Child("trunks").Index(i)
+		if trunk.ID < 1 || trunk.ID > 4094 {
+			errs = append(errs, field.Invalid(fieldPath.Child("id"), trunk.ID, "must be between 1 and 4094"))
+		}
+		if trunk.EndID != 0 && (trunk.EndID < trunk.ID || trunk.EndID > 4094) {
+			errs = append(errs, field.Invalid(fieldPath.Child("endID"), trunk.EndID, "must be between id and 4094"))
+		}
+		// untagged frames of the guest may not be mixed up with tagged ones
+		if vlan.ID != 0 && vlan.ID >= trunk.ID && (vlan.ID == trunk.ID || vlan.ID <= trunk.EndID) {
+			errs = append(errs, field.Invalid(fieldPath, trunk, "may not include id of the VLAN"))
+		}
+	}
+	return errs
+}
+
 func ValidateCIDR(cidr string, capacity int, fieldPath *field.Path) field.ErrorList {
 	var errs field.ErrorList
 	if cidr == "" {
@@ -564,6 +1611,22 @@ func ValidateVolumeSource(ctx context.Context, source *virtv1alpha1.VolumeSource
 			errs = append(errs, ValidateContainerDiskVolumeSource(ctx, source.ContainerDisk, fieldPath.Child("containerDisk"))...)
 		}
 	}
+	if source.HTTPDisk != nil {
+		cnt++
+		if cnt > 1 {
+			errs = append(errs, field.Forbidden(fieldPath.Child("httpDisk"), "may not specify more than 1 volume source"))
+		} else {
+			errs = append(errs, ValidateHTTPDiskVolumeSource(ctx, source.HTTPDisk, fieldPath.Child("httpDisk"))...)
+		}
+	}
+	if source.EmptyDisk != nil {
+		cnt++
+		if cnt > 1 {
+			errs = append(errs, field.Forbidden(fieldPath.Child("emptyDisk"), "may not specify more than 1 volume source"))
+		} else {
+			errs = append(errs, ValidateEmptyDiskVolumeSource(ctx, source.EmptyDisk, fieldPath.Child("emptyDisk"))...)
+		}
+	}
 	if source.CloudInit != nil {
 		cnt++
 		if cnt > 1 {
@@ -596,6 +1659,46 @@ func ValidateVolumeSource(ctx context.Context, source *virtv1alpha1.VolumeSource
 			errs = append(errs, ValidateDataVolumeSource(ctx, source.DataVolume, fieldPath.Child("dataVolume"))...)
 		}
 	}
+	if source.ClusterAPIBootstrap != nil {
+		cnt++
+		if cnt > 1 {
+			errs = append(errs, field.Forbidden(fieldPath.Child("clusterAPIBootstrap"), "may not specify more than 1 volume source"))
+		} else {
+			errs = append(errs, ValidateClusterAPIBootstrapVolumeSource(ctx, source.ClusterAPIBootstrap, fieldPath.Child("clusterAPIBootstrap"))...)
+		}
+	}
+	if source.Sysprep != nil {
+		cnt++
+		if cnt > 1 {
+			errs = append(errs, field.Forbidden(fieldPath.Child("sysprep"), "may not specify more than 1 volume source"))
+		} else {
+			errs = append(errs, ValidateSysprepVolumeSource(ctx, source.Sysprep, fieldPath.Child("sysprep"))...)
+		}
+	}
+	if source.ConfigMap != nil {
+		cnt++
+		if cnt > 1 {
+			errs = append(errs, field.Forbidden(fieldPath.Child("configMap"), "may not specify more than 1 volume source"))
+		} else {
+			errs = append(errs, ValidateConfigMapVolumeSource(ctx, source.ConfigMap, fieldPath.Child("configMap"))...)
+		}
+	}
+	if source.Secret != nil {
+		cnt++
+		if cnt > 1 {
+			errs = append(errs, field.Forbidden(fieldPath.Child("secret"), "may not specify more than 1 volume source"))
+		} else {
+			errs = append(errs, ValidateSecretVolumeSource(ctx, source.Secret, fieldPath.Child("secret"))...)
+		}
+	}
+	if source.ServiceAccountToken != nil {
+		cnt++
+		if cnt > 1 {
+			errs = append(errs, field.Forbidden(fieldPath.Child("serviceAccountToken"), "may not specify more than 1 volume source"))
+		} else {
+			errs = append(errs, ValidateServiceAccountTokenVolumeSource(ctx, source.ServiceAccountToken, fieldPath.Child("serviceAccountToken"))...)
+		}
+	}
 	if cnt == 0 {
 		errs = append(errs, field.Required(fieldPath, "at least 1 volume source is required"))
 	}
@@ -615,6 +1718,46 @@ func ValidateContainerDiskVolumeSource(ctx context.Context, source *virtv1alpha1
 	return errs
 }
 
+var httpDiskChecksumRegexp = regexp.MustCompile(`^(sha256:[0-9a-f]{64}|sha512:[0-9a-f]{128})$`)
+
+func ValidateHTTPDiskVolumeSource(ctx context.Context, source *virtv1alpha1.HTTPDiskVolumeSource, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if source == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if source.URL == "" {
+		errs = append(errs, field.Required(fieldPath.Child("url"), ""))
+	} else if u, err := url.Parse(source.URL); err != nil {
+		errs = append(errs, field.Invalid(fieldPath.Child("url"), source.URL, err.Error()))
+	} else if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = append(errs, field.Invalid(fieldPath.Child("url"), source.URL, "must be an absolute http or https URL"))
+	}
+	if source.Checksum != "" && !httpDiskChecksumRegexp.MatchString(source.Checksum) {
+		errs = append(errs, field.Invalid(fieldPath.Child("checksum"), source.Checksum, "must be a lowercase hex sha256 or sha512 digest prefixed with the algorithm, such as sha256:<digest>"))
+	}
+	return errs
+}
+
+func ValidateEmptyDiskVolumeSource(ctx context.Context, source *virtv1alpha1.EmptyDiskVolumeSource, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if source == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if source.Capacity.Value() <= 0 {
+		errs = append(errs, field.Invalid(fieldPath.Child("capacity"), source.Capacity.String(), "must be greater than 0"))
+	}
+	if source.VolumeClaim != nil && source.VolumeClaim.StorageClassName != nil {
+		for _, msg := range validation.IsDNS1123Subdomain(*source.VolumeClaim.StorageClassName) {
+			errs = append(errs, field.Invalid(fieldPath.Child("volumeClaim", "storageClassName"), *source.VolumeClaim.StorageClassName, msg))
+		}
+	}
+	return errs
+}
+
 func ValidateCloudInitVolumeSource(ctx context.Context, source *virtv1alpha1.CloudInitVolumeSource, fieldPath *field.Path) field.ErrorList {
 	var errs field.ErrorList
 	if source == nil {
@@ -661,6 +1804,12 @@ func ValidateCloudInitVolumeSource(ctx context.Context, source *virtv1alpha1.Clo
 			errs = append(errs, field.Forbidden(fieldPath.Child("networkDataSecretName"), "may not specify more than 1 network data"))
 		}
 	}
+	if source.GenerateNetworkData {
+		networkDataCnt++
+		if networkDataCnt > 1 {
+			errs = append(errs, field.Forbidden(fieldPath.Child("generateNetworkData"), "may not specify more than 1 network data"))
+		}
+	}
 	return errs
 }
 
@@ -690,6 +1839,19 @@ func ValidatePersistentVolumeClaimSource(ctx context.Context, source *virtv1alph
 	if source.ClaimName == "" {
 		errs = append(errs, field.Required(fieldPath.Child("claimName"), ""))
 	}
+	if source.Populate != nil {
+		populatePath := fieldPath.Child("populate")
+		switch {
+		case source.Populate.ContainerDisk != nil && source.Populate.HTTPDisk != nil:
+			errs = append(errs, field.Forbidden(populatePath.Child("httpDisk"), "may not specify more than 1 populate source"))
+		case source.Populate.ContainerDisk != nil:
+			errs = append(errs, ValidateContainerDiskVolumeSource(ctx, source.Populate.ContainerDisk, populatePath.Child("containerDisk"))...)
+		case source.Populate.HTTPDisk != nil:
+			errs = append(errs, ValidateHTTPDiskVolumeSource(ctx, source.Populate.HTTPDisk, populatePath.Child("httpDisk"))...)
+		default:
+			errs = append(errs, field.Required(populatePath, "at least 1 populate source is required"))
+		}
+	}
 	return errs
 }
 
@@ -706,6 +1868,100 @@ func ValidateDataVolumeSource(ctx context.Context, source *virtv1alpha1.DataVolu
 	return errs
 }
 
+func ValidateClusterAPIBootstrapVolumeSource(ctx context.Context, source *virtv1alpha1.ClusterAPIBootstrapVolumeSource, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if source == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if source.SecretName == "" {
+		errs = append(errs, field.Required(fieldPath.Child("secretName"), ""))
+	}
+	return errs
+}
+
+func ValidateConfigMapVolumeSource(ctx context.Context, source *virtv1alpha1.ConfigMapVolumeSource, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if source == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if source.Name == "" {
+		errs = append(errs, field.Required(fieldPath.Child("name"), ""))
+	}
+	errs = append(errs, ValidateConfigDiskVolumeLabel(source.Format, source.VolumeLabel, fieldPath.Child("volumeLabel"))...)
+	return errs
+}
+
+func ValidateSecretVolumeSource(ctx context.Context, source *virtv1alpha1.SecretVolumeSource, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if source == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if source.SecretName == "" {
+		errs = append(errs, field.Required(fieldPath.Child("secretName"), ""))
+	}
+	errs = append(errs, ValidateConfigDiskVolumeLabel(source.Format, source.VolumeLabel, fieldPath.Child("volumeLabel"))...)
+	return errs
+}
+
+func ValidateServiceAccountTokenVolumeSource(ctx context.Context, source *virtv1alpha1.ServiceAccountTokenVolumeSource, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if source == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if source.ServiceAccountName == "" {
+		errs = append(errs, field.Required(fieldPath.Child("serviceAccountName"), ""))
+	} else {
+		for _, msg := range validation.IsDNS1123Subdomain(source.ServiceAccountName) {
+			errs = append(errs, field.Invalid(fieldPath.Child("serviceAccountName"), source.ServiceAccountName, msg))
+		}
+	}
+	// the same bounds as of projected service account tokens of pods
+	if source.ExpirationSeconds != nil && (*source.ExpirationSeconds < 600 || *source.ExpirationSeconds > 1<<32) {
+		errs = append(errs, field.Invalid(fieldPath.Child("expirationSeconds"), *source.ExpirationSeconds, "must be between 600 and 4294967296"))
+	}
+	return errs
+}
+
+// ValidateConfigDiskVolumeLabel validates the label of the file system on the
+// disk image of a config map or secret, which is passed to mkfs.vfat or
+// genisoimage as is.
+func ValidateConfigDiskVolumeLabel(format virtv1alpha1.ConfigDiskFormat, volumeLabel string, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if maxLen := defaults.ConfigDiskVolumeLabelMaxLen(format); len(volumeLabel) > maxLen {
+		errs = append(errs, field.TooLong(fieldPath, volumeLabel, maxLen))
+	}
+	if !configDiskVolumeLabelRegexp.MatchString(volumeLabel) {
+		errs = append(errs, field.Invalid(fieldPath, volumeLabel, "must consist of alphanumeric characters, '-' or '_'"))
+	}
+	return errs
+}
+
+var configDiskVolumeLabelRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]*$`)
+
+func ValidateSysprepVolumeSource(ctx context.Context, source *virtv1alpha1.SysprepVolumeSource, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if source == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	switch {
+	case source.ConfigMapName == "" && source.SecretName == "":
+		errs = append(errs, field.Required(fieldPath, "must specify 1 of configMapName and secretName"))
+	case source.ConfigMapName != "" && source.SecretName != "":
+		errs = append(errs, field.Forbidden(fieldPath.Child("secretName"), "may not specify both configMapName and secretName"))
+	}
+	return errs
+}
+
 func ValidateNetwork(ctx context.Context, network *virtv1alpha1.Network, fieldPath *field.Path) field.ErrorList {
 	var errs field.ErrorList
 	if network == nil {
@@ -771,12 +2027,3 @@ func ValidateMultusNetworkSource(ctx context.Context, source *virtv1alpha1.Multu
 	}
 	return errs
 }
-
-func generateMAC() (net.HardwareAddr, error) {
-	prefix := []byte{0x52, 0x54, 0x00}
-	suffix := make([]byte, 3)
-	if _, err := rand.Read(suffix); err != nil {
-		return nil, fmt.Errorf("rand: %s", err)
-	}
-	return net.HardwareAddr(append(prefix, suffix...)), nil
-}
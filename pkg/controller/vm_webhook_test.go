@@ -7,8 +7,15 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/defaults"
+	"github.com/smartxworks/virtink/pkg/hooks"
 )
 
 func TestValidateVM(t *testing.T) {
@@ -184,7 +191,7 @@ func TestValidateVM(t *testing.T) {
 			}
 			vm.Spec.Resources = corev1.ResourceRequirements{
 				Requests: map[corev1.ResourceName]resource.Quantity{
-					corev1.ResourceMemory: resource.MustParse(memoryOverhead),
+					corev1.ResourceMemory: resource.MustParse(defaults.VMMemoryOverhead),
 					"hugepages-1Gi":       resource.MustParse("1025Mi"),
 				},
 				Limits: map[corev1.ResourceName]resource.Quantity{
@@ -202,7 +209,7 @@ func TestValidateVM(t *testing.T) {
 			}
 			vm.Spec.Resources = corev1.ResourceRequirements{
 				Requests: map[corev1.ResourceName]resource.Quantity{
-					corev1.ResourceMemory: resource.MustParse(memoryOverhead),
+					corev1.ResourceMemory: resource.MustParse(defaults.VMMemoryOverhead),
 					"hugepages-2Mi":       resource.MustParse("1024Mi"),
 				},
 				Limits: map[corev1.ResourceName]resource.Quantity{
@@ -249,7 +256,7 @@ func TestValidateVM(t *testing.T) {
 			}
 			vm.Spec.Resources = corev1.ResourceRequirements{
 				Requests: map[corev1.ResourceName]resource.Quantity{
-					corev1.ResourceMemory: resource.MustParse(memoryOverhead),
+					corev1.ResourceMemory: resource.MustParse(defaults.VMMemoryOverhead),
 					"hugepages-1Gi":       resource.MustParse("1025Mi"),
 				},
 				Limits: map[corev1.ResourceName]resource.Quantity{
@@ -268,7 +275,7 @@ func TestValidateVM(t *testing.T) {
 			}
 			vm.Spec.Resources = corev1.ResourceRequirements{
 				Requests: map[corev1.ResourceName]resource.Quantity{
-					corev1.ResourceMemory: resource.MustParse(memoryOverhead),
+					corev1.ResourceMemory: resource.MustParse(defaults.VMMemoryOverhead),
 					"hugepages-2Mi":       resource.MustParse("511Mi"),
 				},
 				Limits: map[corev1.ResourceName]resource.Quantity{
@@ -361,34 +368,836 @@ func TestValidateVM(t *testing.T) {
 			return vm
 		}(),
 		invalidFields: []string{"spec.instance.interfaces[0].vhostUser"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Interfaces[0].InterfaceBindingMethod = virtv1alpha1.InterfaceBindingMethod{
+				VhostUser: &virtv1alpha1.InterfaceVhostUser{},
+			}
+			vm.Spec.Instance.Interfaces[0].State = virtv1alpha1.InterfaceStateDown
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].vhostUser", "spec.instance.interfaces[0].state"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Interfaces[0].InterfaceBindingMethod = virtv1alpha1.InterfaceBindingMethod{
+				OVS: &virtv1alpha1.InterfaceOVS{},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].ovs"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vlan := int32(4095)
+			vm.Spec.Instance.Interfaces[0].InterfaceBindingMethod = virtv1alpha1.InterfaceBindingMethod{
+				OVS: &virtv1alpha1.InterfaceOVS{
+					VLAN:   &vlan,
+					Trunks: []int32{100, 200, 100},
+				},
+			}
+			vm.Spec.Networks[0].NetworkSource = virtv1alpha1.NetworkSource{
+				Multus: &virtv1alpha1.MultusNetworkSource{NetworkName: "ovs"},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].ovs.vlan", "spec.instance.interfaces[0].ovs.trunks[2]"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Interfaces[0].VLAN = &virtv1alpha1.InterfaceVLAN{
+				ID: 100,
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].vlan"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Interfaces[0].VLAN = &virtv1alpha1.InterfaceVLAN{
+				ID:     100,
+				Trunks: []virtv1alpha1.VLANRange{{ID: 0}, {ID: 300, EndID: 200}, {ID: 50, EndID: 150}, {ID: 200, EndID: 300}},
+			}
+			vm.Spec.Networks[0].NetworkSource = virtv1alpha1.NetworkSource{
+				Multus: &virtv1alpha1.MultusNetworkSource{NetworkName: "provider"},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].vlan.trunks[0].id", "spec.instance.interfaces[0].vlan.trunks[1].endID", "spec.instance.interfaces[0].vlan.trunks[2]"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Interfaces[0].State = "disconnected"
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].state"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Interfaces[0].Addresses = []string{"10.0.0.5/24"}
+			vm.Spec.Instance.Interfaces[0].Routes = []virtv1alpha1.InterfaceRoute{{To: "default", Via: "10.0.0.1"}}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].addresses", "spec.instance.interfaces[0].routes"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Interfaces[0].Addresses = []string{"10.0.0.5/24", "10.0.0.6"}
+			vm.Spec.Instance.Interfaces[0].Routes = []virtv1alpha1.InterfaceRoute{{To: "10.1.0.0/16", Via: "fd00::1"}, {To: "any", Via: "10.0.0.1"}}
+			vm.Spec.Networks[0].NetworkSource = virtv1alpha1.NetworkSource{
+				Multus: &virtv1alpha1.MultusNetworkSource{NetworkName: "provider"},
+			}
+			vm.Spec.Volumes = append(vm.Spec.Volumes, virtv1alpha1.Volume{
+				Name: "cloud-init",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					CloudInit: &virtv1alpha1.CloudInitVolumeSource{
+						NetworkData:         "version: 2",
+						GenerateNetworkData: true,
+					},
+				},
+			})
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].addresses[1]", "spec.instance.interfaces[0].routes[0].via", "spec.instance.interfaces[0].routes[1].to", "spec.volumes[2].cloudInit.generateNetworkData"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Interfaces = append(vm.Spec.Instance.Interfaces, virtv1alpha1.Interface{
+				Name: "net-2",
+				InterfaceBindingMethod: virtv1alpha1.InterfaceBindingMethod{
+					SRIOV: &virtv1alpha1.InterfaceSRIOV{},
+				},
+				MAC: "c6:1c:ba:0a:45:89",
+			})
+			vm.Spec.Networks = append(vm.Spec.Networks, virtv1alpha1.Network{
+				Name: "net-2",
+				NetworkSource: virtv1alpha1.NetworkSource{
+					Multus: &virtv1alpha1.MultusNetworkSource{NetworkName: "sriov"},
+				},
+			})
+			vm.Spec.Instance.Bonds = []virtv1alpha1.Bond{{
+				Name:       "bond0",
+				Interfaces: []string{"net-1", "net-2"},
+				Mode:       virtv1alpha1.BondModeActiveBackup,
+				Primary:    "net-2",
+				Addresses:  []string{"10.0.0.5/24"},
+			}}
+			vm.Spec.Volumes = append(vm.Spec.Volumes, virtv1alpha1.Volume{
+				Name: "cloud-init",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					CloudInit: &virtv1alpha1.CloudInitVolumeSource{
+						GenerateNetworkData: true,
+					},
+				},
+			})
+			return vm
+		}(),
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Interfaces[0].Masquerade = &virtv1alpha1.InterfaceMasquerade{CIDR: "10.0.2.0/30"}
+			vm.Spec.Instance.Interfaces[0].Bridge = nil
+			vm.Spec.Instance.Bonds = []virtv1alpha1.Bond{{
+				Name:       "net-1",
+				Interfaces: []string{"net-1", "net-1", "net-3"},
+				Mode:       virtv1alpha1.BondModeBalanceRR,
+				Primary:    "net-3",
+			}, {
+				Name:       "bond-with-a-long-name",
+				Interfaces: []string{"net-1"},
+				Mode:       "lacp",
+			}}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.bonds[0]", "spec.instance.bonds[0].interfaces[0]", "spec.instance.bonds[0].interfaces[1]", "spec.instance.bonds[0].interfaces[2]", "spec.instance.bonds[0].primary",
+			"spec.instance.bonds[1]", "spec.instance.bonds[1].name", "spec.instance.bonds[1].interfaces", "spec.instance.bonds[1].interfaces[0]", "spec.instance.bonds[1].mode", "spec.instance.interfaces[0].name"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Hypervisor = virtv1alpha1.HypervisorFirecracker
+			vm.Spec.Instance.Kernel = &virtv1alpha1.Kernel{
+				Image:   "smartxworks/virtink-kernel-5.15.12",
+				Cmdline: "console=ttyS0 root=/dev/vda rw",
+			}
+			vm.Spec.Instance.FileSystems = nil
+			vm.Spec.Volumes = vm.Spec.Volumes[:1]
+			vm.Spec.Instance.Interfaces[0].State = virtv1alpha1.InterfaceStateDown
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].state"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Interfaces[0].RateLimit = &virtv1alpha1.InterfaceRateLimit{
+				RX: &virtv1alpha1.BandwidthLimit{
+					Bandwidth: resource.MustParse("0"),
+				},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].rateLimit.rx.bandwidth"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Interfaces[0].Queues = 64
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].queues"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Interfaces[0].DHCPOptions = &virtv1alpha1.InterfaceDHCPOptions{
+				DNSServers:    []string{"10.0.0.10", "fd00::10"},
+				SearchDomains: []string{"example.com", "Example_com"},
+				NTPServers:    []string{"ntp.example.com"},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].dhcpOptions.dnsServers[1]", "spec.instance.interfaces[0].dhcpOptions.searchDomains[1]", "spec.instance.interfaces[0].dhcpOptions.ntpServers[0]"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Interfaces[0].MTU = 64
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].mtu"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Firmware = &virtv1alpha1.Firmware{EFI: &virtv1alpha1.EFIFirmware{}}
+			vm.Spec.Instance.Disks[0].BootOrder = 1
+			vm.Spec.Instance.Interfaces[0].BootOrder = 2
+			return vm
+		}(),
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Interfaces[0].BootOrder = 1
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].bootOrder"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Firmware = &virtv1alpha1.Firmware{EFI: &virtv1alpha1.EFIFirmware{}}
+			vm.Spec.Instance.Disks[0].BootOrder = 1
+			vm.Spec.Instance.Interfaces[0].BootOrder = 1
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].bootOrder"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Firmware = &virtv1alpha1.Firmware{EFI: &virtv1alpha1.EFIFirmware{}}
+			vm.Spec.Instance.Disks[0].BootOrder = 2
+			vm.Spec.Instance.Interfaces[0].BootOrder = 1
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].bootOrder"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Kernel = &virtv1alpha1.Kernel{
+				Image:   "kernel",
+				Cmdline: "console=ttyS0",
+			}
+			vm.Spec.Instance.Firmware = &virtv1alpha1.Firmware{EFI: &virtv1alpha1.EFIFirmware{}}
+			vm.Spec.Instance.Disks[0].BootOrder = 1
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.firmware", "spec.instance.disks[0].bootOrder"},
 	}, {
 		vm: func() *virtv1alpha1.VirtualMachine {
 			vm := validVM.DeepCopy()
 			vm.Spec.Volumes[0].Name = ""
 			return vm
 		}(),
-		invalidFields: []string{"spec.volumes[0].name"},
+		invalidFields: []string{"spec.volumes[0].name"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Volumes[0].VolumeSource.ContainerDisk = nil
+			return vm
+		}(),
+		invalidFields: []string{"spec.volumes[0]"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Volumes[1].PersistentVolumeClaim.Populate = &virtv1alpha1.PersistentVolumeClaimPopulateSource{}
+			return vm
+		}(),
+		invalidFields: []string{"spec.volumes[1].persistentVolumeClaim.populate"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Volumes[1].PersistentVolumeClaim.Populate = &virtv1alpha1.PersistentVolumeClaimPopulateSource{
+				ContainerDisk: &virtv1alpha1.ContainerDiskVolumeSource{},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.volumes[1].persistentVolumeClaim.populate.containerDisk.image"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Volumes[1].PersistentVolumeClaim.Populate = &virtv1alpha1.PersistentVolumeClaimPopulateSource{
+				ContainerDisk: &virtv1alpha1.ContainerDiskVolumeSource{Image: "ubuntu"},
+				HTTPDisk:      &virtv1alpha1.HTTPDiskVolumeSource{URL: "https://example.com/ubuntu.img"},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.volumes[1].persistentVolumeClaim.populate.httpDisk"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Volumes[0].VolumeSource = virtv1alpha1.VolumeSource{
+				HTTPDisk: &virtv1alpha1.HTTPDiskVolumeSource{
+					URL:      "ftp://example.com/ubuntu.img",
+					Checksum: "md5:d41d8cd98f00b204e9800998ecf8427e",
+				},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.volumes[0].httpDisk.url", "spec.volumes[0].httpDisk.checksum"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Disks[0].Type = virtv1alpha1.DiskTypePmem
+			storageClassName := "Local_Path"
+			vm.Spec.Volumes[0].VolumeSource = virtv1alpha1.VolumeSource{
+				EmptyDisk: &virtv1alpha1.EmptyDiskVolumeSource{
+					VolumeClaim: &virtv1alpha1.EmptyDiskVolumeClaim{StorageClassName: &storageClassName},
+				},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.disks[0].type", "spec.volumes[0].emptyDisk.capacity", "spec.volumes[0].emptyDisk.volumeClaim.storageClassName"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Disks[0].Type = virtv1alpha1.DiskTypePmem
+			vm.Spec.Volumes[0].VolumeSource = virtv1alpha1.VolumeSource{
+				Secret: &virtv1alpha1.SecretVolumeSource{
+					Format:      virtv1alpha1.ConfigDiskFormatFAT,
+					VolumeLabel: "certificates",
+				},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.disks[0].type", "spec.volumes[0].secret.secretName", "spec.volumes[0].secret.volumeLabel"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Volumes[0].VolumeSource = virtv1alpha1.VolumeSource{
+				ConfigMap: &virtv1alpha1.ConfigMapVolumeSource{
+					Name:        "app",
+					VolumeLabel: "app config",
+				},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.volumes[0].configMap.volumeLabel"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			expirationSeconds := int64(60)
+			vm.Spec.Volumes[0].VolumeSource = virtv1alpha1.VolumeSource{
+				ServiceAccountToken: &virtv1alpha1.ServiceAccountTokenVolumeSource{
+					ServiceAccountName: "app",
+					ExpirationSeconds:  &expirationSeconds,
+				},
+			}
+			vm.Spec.Volumes = append(vm.Spec.Volumes, virtv1alpha1.Volume{
+				Name: "token",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					ServiceAccountToken: &virtv1alpha1.ServiceAccountTokenVolumeSource{ServiceAccountName: "default"},
+				},
+			})
+			return vm
+		}(),
+		invalidFields: []string{"spec.volumes[0].serviceAccountToken.expirationSeconds", "spec.volumes[2].serviceAccountToken.serviceAccountName"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Volumes[0].HTTPDisk = &virtv1alpha1.HTTPDiskVolumeSource{URL: "https://example.com/ubuntu.img"}
+			return vm
+		}(),
+		invalidFields: []string{"spec.volumes[0].httpDisk"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Disks[0].Ephemeral = true
+			vm.Spec.Instance.Disks[0].Shareable = true
+			vm.Spec.Instance.Disks[0].Cache = virtv1alpha1.DiskCacheNone
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.disks[0].ephemeral", "spec.instance.disks[0].shareable"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Disks[0].Ephemeral = true
+			vm.Spec.Volumes[0].VolumeSource = virtv1alpha1.VolumeSource{
+				HTTPDisk: &virtv1alpha1.HTTPDiskVolumeSource{URL: "https://example.com/ubuntu.img"},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.disks[0].ephemeral"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Disks[0].Cache = virtv1alpha1.DiskCacheWritethrough
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.disks[0].cache"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Disks[0].Discard = &virtv1alpha1.DiskDiscard{}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.disks[0].discard"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Hypervisor = virtv1alpha1.HypervisorQEMU
+			vm.Spec.Instance.Disks[0].Encryption = &virtv1alpha1.DiskEncryption{}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.disks[0].encryption.secretName"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Disks[0].Shareable = true
+			vm.Spec.Instance.Disks[0].Cache = virtv1alpha1.DiskCacheNone
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.disks[0].shareable"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Disks[0].Shareable = true
+			vm.Spec.Instance.Disks[0].Cache = virtv1alpha1.DiskCacheWriteback
+			vm.Spec.Volumes[0].ContainerDisk = nil
+			vm.Spec.Volumes[0].PersistentVolumeClaim = &virtv1alpha1.PersistentVolumeClaimVolumeSource{ClaimName: "vol-1"}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.disks[0].shareable"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Disks[0].Type = virtv1alpha1.DiskTypeCDROM
+			vm.Spec.Instance.Disks[0].Bus = virtv1alpha1.DiskBusSATA
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.disks[0].type", "spec.instance.disks[0].bus"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Hypervisor = virtv1alpha1.HypervisorQEMU
+			vm.Spec.Instance.Disks[0].Type = virtv1alpha1.DiskTypeCDROM
+			vm.Spec.Instance.Disks[0].Medium = "vol-3"
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.disks[0].bus", "spec.instance.disks[0].medium"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Disks[0].Ejected = true
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.disks[0].ejected"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Downward = &virtv1alpha1.Downward{MetricsPort: 1024}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.downward.metricsPort"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.GuestMetrics = &virtv1alpha1.GuestMetrics{Port: 1024}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.guestMetrics"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Interfaces[0].Offloads = &virtv1alpha1.InterfaceOffloads{DisableChecksum: true}
+			vm.Spec.Instance.Interfaces[0].Vhost = true
+			vm.Spec.Instance.Interfaces[0].RXQueueSize = 512
+			vm.Spec.Instance.Interfaces[0].TXQueueSize = 1000
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].offloads", "spec.instance.interfaces[0].vhost", "spec.instance.interfaces[0].txQueueSize"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Hypervisor = virtv1alpha1.HypervisorQEMU
+			vm.Spec.Instance.Interfaces[0].Offloads = &virtv1alpha1.InterfaceOffloads{DisableTSO: true}
+			vm.Spec.Instance.Interfaces[0].Vhost = true
+			vm.Spec.Instance.Interfaces[0].RXQueueSize = 1024
+			vm.Spec.Instance.Interfaces[0].TXQueueSize = 512
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].txQueueSize"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Clock = &virtv1alpha1.Clock{
+				Offset:          virtv1alpha1.ClockOffsetLocaltime,
+				DisableKVMClock: true,
+				Sync:            &virtv1alpha1.ClockSync{},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.clock.offset", "spec.instance.clock.disableKVMClock", "spec.instance.clock.sync.guestAgentPort", "spec.instance.clock.sync"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Hypervisor = virtv1alpha1.HypervisorQEMU
+			vm.Spec.Instance.Clock = &virtv1alpha1.Clock{
+				Timezone: "Asia/Shanghai",
+				Sync:     &virtv1alpha1.ClockSync{GuestAgentPort: 1024},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.clock.timezone", "spec.instance.clock.sync.guestAgentPort"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Devices = &virtv1alpha1.Devices{Display: &virtv1alpha1.Display{}}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.devices"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Hypervisor = virtv1alpha1.HypervisorQEMU
+			vm.Spec.Instance.Devices = &virtv1alpha1.Devices{Tablet: &virtv1alpha1.Tablet{}}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.devices.tablet"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Hypervisor = virtv1alpha1.HypervisorQEMU
+			vm.Spec.Instance.Devices = &virtv1alpha1.Devices{
+				HostUSBDevices: []virtv1alpha1.HostUSBDevice{{
+					Name:         "dongle",
+					ResourceName: "devices.virtink.io/usb-dongle",
+				}},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.devices.hostUSBDevices"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Hypervisor = virtv1alpha1.HypervisorQEMU
+			vm.Spec.Instance.Devices = &virtv1alpha1.Devices{
+				USB: &virtv1alpha1.USBController{},
+				HostUSBDevices: []virtv1alpha1.HostUSBDevice{{
+					Name:         "dongle",
+					ResourceName: "devices.virtink.io/usb-dongle",
+				}, {
+					Name: "dongle",
+				}},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.devices.hostUSBDevices[1].name", "spec.instance.devices.hostUSBDevices[1].resourceName"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Memory.Hugepages = &virtv1alpha1.Hugepages{
+				PageSize: "2Mi",
+			}
+			vm.Spec.Instance.Memory.Balloon = &virtv1alpha1.MemoryBalloon{}
+			vm.Spec.Instance.Memory.Swap = &virtv1alpha1.MemorySwap{}
+			vm.Spec.Resources = corev1.ResourceRequirements{
+				Requests: map[corev1.ResourceName]resource.Quantity{
+					corev1.ResourceMemory: resource.MustParse(defaults.VMMemoryOverhead),
+					"hugepages-2Mi":       resource.MustParse("1Gi"),
+				},
+				Limits: map[corev1.ResourceName]resource.Quantity{
+					"hugepages-2Mi": resource.MustParse("1Gi"),
+				},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.memory.balloon", "spec.instance.memory.swap"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Disks[0].Type = virtv1alpha1.DiskTypePmem
+			vm.Spec.Instance.Disks[0].Bus = virtv1alpha1.DiskBusVirtio
+			vm.Spec.Instance.Disks[0].Shareable = true
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.disks[0].bus", "spec.instance.disks[0].shareable"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Volumes[0].ContainerDisk = nil
+			vm.Spec.Volumes[0].Sysprep = &virtv1alpha1.SysprepVolumeSource{ConfigMapName: "unattend", SecretName: "unattend"}
+			return vm
+		}(),
+		invalidFields: []string{"spec.volumes[0].sysprep.secretName", "spec.instance.disks[0].type"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Volumes[0].VolumeSource.CloudInit = &virtv1alpha1.CloudInitVolumeSource{}
+			return vm
+		}(),
+		invalidFields: []string{"spec.volumes[0].cloudInit"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.SSHPublicKeys = []virtv1alpha1.SSHPublicKey{{SecretName: "ssh-keys"}}
+			return vm
+		}(),
+		invalidFields: []string{"spec.sshPublicKeys"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Volumes[0].VolumeSource = virtv1alpha1.VolumeSource{
+				CloudInit: &virtv1alpha1.CloudInitVolumeSource{
+					UserData: "#cloud-config",
+				},
+			}
+			vm.Spec.SSHPublicKeys = []virtv1alpha1.SSHPublicKey{{SecretName: "ssh-keys"}, {}}
+			return vm
+		}(),
+		invalidFields: []string{"spec.sshPublicKeys[1].secretName"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.MetadataService = &virtv1alpha1.MetadataService{}
+			return vm
+		}(),
+		invalidFields: []string{"spec.metadataService"},
 	}, {
 		vm: func() *virtv1alpha1.VirtualMachine {
 			vm := validVM.DeepCopy()
-			vm.Spec.Volumes[0].VolumeSource.ContainerDisk = nil
+			vm.Spec.Instance.Interfaces[0].InterfaceBindingMethod.Bridge = nil
+			vm.Spec.Instance.Interfaces[0].InterfaceBindingMethod.Masquerade = &virtv1alpha1.InterfaceMasquerade{
+				CIDR: "10.0.2.0/30",
+			}
+			vm.Spec.MetadataService = &virtv1alpha1.MetadataService{
+				UserData:           "#cloud-config",
+				UserDataSecretName: "user-data",
+			}
+			vm.Spec.SSHPublicKeys = []virtv1alpha1.SSHPublicKey{{SecretName: "ssh-keys"}}
 			return vm
 		}(),
-		invalidFields: []string{"spec.volumes[0]"},
+		invalidFields: []string{"spec.metadataService.userDataSecretName"},
 	}, {
 		vm: func() *virtv1alpha1.VirtualMachine {
 			vm := validVM.DeepCopy()
-			vm.Spec.Volumes[0].VolumeSource.CloudInit = &virtv1alpha1.CloudInitVolumeSource{}
+			vm.Spec.RunPolicy = virtv1alpha1.RunPolicyManual
+			vm.Spec.Schedule = &virtv1alpha1.Schedule{
+				Start:    "0 8 * * 1-5",
+				Stop:     "0 20 * * 1-5",
+				TimeZone: "Asia/Shanghai",
+			}
 			return vm
 		}(),
-		invalidFields: []string{"spec.volumes[0].cloudInit"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.RunPolicy = virtv1alpha1.RunPolicyManual
+			vm.Spec.Schedule = &virtv1alpha1.Schedule{
+				Start: "0 8 * *",
+				Stop:  "TZ=Asia/Shanghai 0 20 * * *",
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.schedule.start", "spec.schedule.stop"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.RunPolicy = virtv1alpha1.RunPolicyManual
+			vm.Spec.Schedule = &virtv1alpha1.Schedule{
+				Stop:     "0 20 * * *",
+				TimeZone: "Mars/Olympus_Mons",
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.schedule.timeZone"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.RunPolicy = virtv1alpha1.RunPolicyAlways
+			vm.Spec.Schedule = &virtv1alpha1.Schedule{}
+			return vm
+		}(),
+		invalidFields: []string{"spec.schedule", "spec.schedule"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Networks[0].Name = "net-2"
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.interfaces[0].name"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Volumes[0].VolumeSource = virtv1alpha1.VolumeSource{
+				ClusterAPIBootstrap: &virtv1alpha1.ClusterAPIBootstrapVolumeSource{},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.volumes[0].clusterAPIBootstrap.secretName"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Disks[0].RateLimit = &virtv1alpha1.DiskRateLimit{}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.disks[0].rateLimit"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			bandwidth := resource.MustParse("0")
+			vm.Spec.Instance.Disks[0].RateLimit = &virtv1alpha1.DiskRateLimit{
+				Bandwidth: &bandwidth,
+				IOPSBurst: 100,
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.disks[0].rateLimit.bandwidth", "spec.instance.disks[0].rateLimit.iopsBurst"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Hypervisor = virtv1alpha1.HypervisorQEMU
+			vm.Spec.Instance.Disks[0].Type = virtv1alpha1.DiskTypeCDROM
+			vm.Spec.Instance.Disks[0].Bus = virtv1alpha1.DiskBusSATA
+			vm.Spec.Instance.Disks[0].RateLimit = &virtv1alpha1.DiskRateLimit{IOPS: 1000}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.disks[0].rateLimit"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Realtime = &virtv1alpha1.Realtime{
+				Priority: 100,
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.realtime", "spec.instance.realtime.priority"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.MemoryDump = &virtv1alpha1.MemoryDump{
+				OnCrash: true,
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.memoryDump.claimName"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Hibernation = &virtv1alpha1.Hibernation{}
+			return vm
+		}(),
+		invalidFields: []string{"spec.hibernation.claimName"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Annotations = map[string]string{hooks.HookSidecarsAnnotation: `[{"image":"hook"}]`}
+			return vm
+		}(),
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Annotations = map[string]string{hooks.HookSidecarsAnnotation: `[{"args":["--verbose"]}]`}
+			return vm
+		}(),
+		invalidFields: []string{"metadata.annotations[virtink.io/hook-sidecars]"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Hypervisor = virtv1alpha1.HypervisorQEMU
+			vm.Spec.Instance.Disks[0].Bus = virtv1alpha1.DiskBusIDE
+			return vm
+		}(),
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Disks[0].Bus = virtv1alpha1.DiskBusSATA
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.disks[0].bus"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Hypervisor = virtv1alpha1.HypervisorQEMU
+			vm.Spec.Hibernation = &virtv1alpha1.Hibernation{ClaimName: "hibernation"}
+			return vm
+		}(),
+		invalidFields: []string{"spec.hibernation"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Vsock = &virtv1alpha1.Vsock{CID: 3}
+			return vm
+		}(),
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Hypervisor = virtv1alpha1.HypervisorQEMU
+			vm.Spec.Instance.Vsock = &virtv1alpha1.Vsock{CID: 3}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.vsock"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.RNG = &virtv1alpha1.RNG{Source: "/dev/random"}
+			return vm
+		}(),
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Hypervisor = virtv1alpha1.HypervisorQEMU
+			vm.Spec.Instance.RNG = &virtv1alpha1.RNG{Disabled: true}
+			return vm
+		}(),
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.RNG = &virtv1alpha1.RNG{Disabled: true, Source: "/dev/random"}
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.rng.disabled", "spec.instance.rng.source"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Hypervisor = virtv1alpha1.HypervisorFirecracker
+			vm.Spec.Instance.Kernel = &virtv1alpha1.Kernel{
+				Image:   "smartxworks/virtink-kernel-5.15.12",
+				Cmdline: "console=ttyS0 root=/dev/vda rw",
+			}
+			vm.Spec.Instance.FileSystems = nil
+			vm.Spec.Volumes = vm.Spec.Volumes[:1]
+			return vm
+		}(),
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Instance.Hypervisor = virtv1alpha1.HypervisorFirecracker
+			vm.Spec.Instance.Memory.Size = resource.MustParse("1000Ki")
+			return vm
+		}(),
+		invalidFields: []string{"spec.instance.kernel", "spec.instance.fileSystems", "spec.instance.memory.size"},
 	}, {
 		vm: func() *virtv1alpha1.VirtualMachine {
 			vm := validVM.DeepCopy()
 			vm.Spec.Networks[0].Name = ""
 			return vm
 		}(),
-		invalidFields: []string{"spec.networks[0].name"},
+		invalidFields: []string{"spec.networks[0].name", "spec.instance.interfaces[0].name"},
 	}, {
 		vm: func() *virtv1alpha1.VirtualMachine {
 			vm := validVM.DeepCopy()
@@ -403,6 +1212,31 @@ func TestValidateVM(t *testing.T) {
 			return vm
 		}(),
 		invalidFields: []string{"spec.networks[0].multus"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Hostname = "vm.example"
+			vm.Spec.Subdomain = "Subdomain"
+			return vm
+		}(),
+		invalidFields: []string{"spec.hostname", "spec.subdomain"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.Subdomain = "subdomain"
+			return vm
+		}(),
+		invalidFields: []string{"spec.hostname"},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Spec.DNSPolicy = corev1.DNSNone
+			vm.Spec.DNSConfig = &corev1.PodDNSConfig{
+				Searches: []string{"-example.com"},
+			}
+			return vm
+		}(),
+		invalidFields: []string{"spec.dnsConfig.nameservers", "spec.dnsConfig.searches[0]"},
 	}}
 
 	for _, tc := range tests {
@@ -413,112 +1247,214 @@ func TestValidateVM(t *testing.T) {
 	}
 }
 
-func TestMutateVM(t *testing.T) {
+func TestValidateVMUpdate(t *testing.T) {
 	oldVM := &virtv1alpha1.VirtualMachine{
 		Spec: virtv1alpha1.VirtualMachineSpec{
-			Resources: corev1.ResourceRequirements{
-				Requests: corev1.ResourceList{
-					corev1.ResourceMemory: resource.MustParse("1Gi"),
-				},
-			},
 			Instance: virtv1alpha1.Instance{
+				Hypervisor: virtv1alpha1.HypervisorQEMU,
+				CPU: virtv1alpha1.CPU{
+					Sockets:        1,
+					CoresPerSocket: 1,
+				},
+				Memory: virtv1alpha1.Memory{
+					Size: resource.MustParse("1Gi"),
+				},
+				Kernel: &virtv1alpha1.Kernel{
+					Image:   "kernel",
+					Cmdline: "console=ttyS0",
+				},
 				Interfaces: []virtv1alpha1.Interface{{
 					Name: "pod",
+					MAC:  "52:54:00:12:34:56",
+					InterfaceBindingMethod: virtv1alpha1.InterfaceBindingMethod{
+						Bridge: &virtv1alpha1.InterfaceBridge{},
+					},
 				}},
 			},
+			Networks: []virtv1alpha1.Network{{
+				Name: "pod",
+				NetworkSource: virtv1alpha1.NetworkSource{
+					Pod: &virtv1alpha1.PodNetworkSource{},
+				},
+			}},
 		},
 	}
 
 	tests := []struct {
-		vm     *virtv1alpha1.VirtualMachine
-		assert func(vm *virtv1alpha1.VirtualMachine)
+		vm            *virtv1alpha1.VirtualMachine
+		invalidFields []string
 	}{{
 		vm: func() *virtv1alpha1.VirtualMachine {
-			return oldVM.DeepCopy()
-		}(),
-		assert: func(vm *virtv1alpha1.VirtualMachine) {
-			assert.Equal(t, uint32(1), vm.Spec.Instance.CPU.Sockets)
-		},
-	}, {
-		vm: func() *virtv1alpha1.VirtualMachine {
-			return oldVM.DeepCopy()
+			vm := oldVM.DeepCopy()
+			vm.Spec.Instance.Interfaces[0].State = virtv1alpha1.InterfaceStateDown
+			return vm
 		}(),
-		assert: func(vm *virtv1alpha1.VirtualMachine) {
-			assert.Equal(t, uint32(1), vm.Spec.Instance.CPU.CoresPerSocket)
-		},
 	}, {
 		vm: func() *virtv1alpha1.VirtualMachine {
-			return oldVM.DeepCopy()
+			vm := oldVM.DeepCopy()
+			vm.Spec.Instance.Interfaces[0].State = virtv1alpha1.InterfaceStateDown
+			vm.Spec.Instance.Interfaces[0].MAC = "52:54:00:65:43:21"
+			return vm
 		}(),
-		assert: func(vm *virtv1alpha1.VirtualMachine) {
-			assert.Equal(t, "1Gi", vm.Spec.Instance.Memory.Size.String())
-		},
+		invalidFields: []string{"spec.instance.interfaces"},
 	}, {
 		vm: func() *virtv1alpha1.VirtualMachine {
 			vm := oldVM.DeepCopy()
-			vm.Spec.Resources.Requests = nil
+			vm.Spec.Instance.Interfaces[0].MTU = 1400
 			return vm
 		}(),
-		assert: func(vm *virtv1alpha1.VirtualMachine) {
-			assert.Equal(t, "1Gi", vm.Spec.Instance.Memory.Size.String())
-		},
+		invalidFields: []string{"spec.instance.interfaces"},
 	}, {
 		vm: func() *virtv1alpha1.VirtualMachine {
 			vm := oldVM.DeepCopy()
-			vm.Spec.Instance.CPU.DedicatedCPUPlacement = true
-			vm.Spec.Resources.Requests = nil
-			vm.Spec.Instance.Memory.Size = resource.MustParse("1Gi")
+			vm.Spec.Instance.Interfaces[0].Vhost = true
 			return vm
 		}(),
-		assert: func(vm *virtv1alpha1.VirtualMachine) {
-			assert.Equal(t, "1", vm.Spec.Resources.Requests.Cpu().String())
-			assert.Equal(t, "1", vm.Spec.Resources.Limits.Cpu().String())
-			assert.Equal(t, "1280Mi", vm.Spec.Resources.Requests.Memory().String())
-			assert.Equal(t, "1280Mi", vm.Spec.Resources.Limits.Memory().String())
-		},
+		invalidFields: []string{"spec.instance.interfaces"},
 	}, {
 		vm: func() *virtv1alpha1.VirtualMachine {
 			vm := oldVM.DeepCopy()
-			vm.Spec.Resources.Requests = nil
-			vm.Spec.Instance.Memory.Hugepages = &virtv1alpha1.Hugepages{
-				PageSize: "1Gi",
-			}
+			vm.Spec.Instance.Interfaces[0].Bridge = nil
+			vm.Spec.Instance.Interfaces[0].Masquerade = &virtv1alpha1.InterfaceMasquerade{CIDR: "10.0.2.0/30"}
 			return vm
 		}(),
-		assert: func(vm *virtv1alpha1.VirtualMachine) {
-			assert.True(t, vm.Spec.Resources.Limits["hugepages-1Gi"].Equal(resource.MustParse("1Gi")))
-			assert.True(t, vm.Spec.Resources.Requests["hugepages-1Gi"].Equal(resource.MustParse("1Gi")))
-			assert.True(t, vm.Spec.Resources.Requests[corev1.ResourceMemory].Equal(resource.MustParse(memoryOverhead)))
-		},
+		invalidFields: []string{"spec.instance.interfaces"},
+	}}
+
+	for _, tc := range tests {
+		errs := ValidateVM(context.Background(), tc.vm, oldVM)
+		var invalidFields []string
+		for _, err := range errs {
+			invalidFields = append(invalidFields, err.Field)
+		}
+		assert.Equal(t, tc.invalidFields, invalidFields)
+	}
+}
+
+func TestCheckVMQuotas(t *testing.T) {
+	var scheme = runtime.NewScheme()
+	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(virtv1beta1.AddToScheme(scheme))
+
+	newVM := func(name string, vcpus uint32, memory string) *virtv1alpha1.VirtualMachine {
+		return &virtv1alpha1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+			},
+			Spec: virtv1alpha1.VirtualMachineSpec{
+				Instance: virtv1alpha1.Instance{
+					CPU: virtv1alpha1.CPU{
+						Sockets:        1,
+						CoresPerSocket: vcpus,
+					},
+					Memory: virtv1alpha1.Memory{
+						Size: resource.MustParse(memory),
+					},
+				},
+			},
+		}
+	}
+	newQuota := func(vms int64, vcpus int64, memory string) *virtv1beta1.VirtualMachineQuota {
+		memoryQuantity := resource.MustParse(memory)
+		return &virtv1beta1.VirtualMachineQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "test-quota",
+			},
+			Spec: virtv1beta1.VirtualMachineQuotaSpec{
+				Hard: virtv1beta1.VirtualMachineQuotaResources{
+					VirtualMachines: &vms,
+					VCPUs:           &vcpus,
+					Memory:          &memoryQuantity,
+				},
+			},
+		}
+	}
+
+	existingVM := newVM("existing-vm", 2, "2Gi")
+	tests := []struct {
+		quota    *virtv1beta1.VirtualMachineQuota
+		vm       *virtv1alpha1.VirtualMachine
+		exceeded bool
+	}{{
+		vm: newVM("test-vm", 64, "64Gi"),
 	}, {
-		vm: func() *virtv1alpha1.VirtualMachine {
-			return oldVM.DeepCopy()
-		}(),
-		assert: func(vm *virtv1alpha1.VirtualMachine) {
-			assert.NotEmpty(t, vm.Spec.Instance.Interfaces[0].MAC)
-		},
+		quota: newQuota(2, 4, "4Gi"),
+		vm:    newVM("test-vm", 2, "2Gi"),
+	}, {
+		quota:    newQuota(1, 4, "4Gi"),
+		vm:       newVM("test-vm", 2, "2Gi"),
+		exceeded: true,
 	}, {
+		quota:    newQuota(2, 3, "4Gi"),
+		vm:       newVM("test-vm", 2, "2Gi"),
+		exceeded: true,
+	}, {
+		quota:    newQuota(2, 4, "3Gi"),
+		vm:       newVM("test-vm", 2, "2Gi"),
+		exceeded: true,
+	}}
+
+	for _, tc := range tests {
+		builder := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existingVM)
+		if tc.quota != nil {
+			builder = builder.WithObjects(tc.quota)
+		}
+		exceeded, err := checkVMQuotas(context.Background(), builder.Build(), tc.vm)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.exceeded, exceeded != "", exceeded)
+	}
+}
+
+func TestValidateRunningVMUpdate(t *testing.T) {
+	oldVM := &virtv1alpha1.VirtualMachine{
+		Spec: virtv1alpha1.VirtualMachineSpec{
+			Instance: virtv1alpha1.Instance{
+				Disks: []virtv1alpha1.Disk{{
+					Name: "vol-1",
+				}},
+			},
+			Volumes: []virtv1alpha1.Volume{{
+				Name: "vol-1",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					ContainerDisk: &virtv1alpha1.ContainerDiskVolumeSource{
+						Image: "container-disk",
+					},
+				},
+			}},
+		},
+		Status: virtv1alpha1.VirtualMachineStatus{
+			Phase: virtv1alpha1.VirtualMachineRunning,
+		},
+	}
+
+	tests := []struct {
+		vm            *virtv1alpha1.VirtualMachine
+		invalidFields []string
+	}{{
 		vm: func() *virtv1alpha1.VirtualMachine {
-			return oldVM.DeepCopy()
+			vm := oldVM.DeepCopy()
+			vm.Spec.Volumes[0].ContainerDisk.Image = "another-container-disk"
+			vm.Spec.Instance.Disks[0].Cache = virtv1alpha1.DiskCacheNone
+			return vm
 		}(),
-		assert: func(vm *virtv1alpha1.VirtualMachine) {
-			assert.NotNil(t, vm.Spec.Instance.Interfaces[0].Bridge)
-		},
 	}, {
 		vm: func() *virtv1alpha1.VirtualMachine {
 			vm := oldVM.DeepCopy()
-			vm.Spec.Instance.Interfaces[0].InterfaceBindingMethod = virtv1alpha1.InterfaceBindingMethod{
-				Masquerade: &virtv1alpha1.InterfaceMasquerade{},
-			}
+			vm.Spec.Instance.Disks = append(vm.Spec.Instance.Disks, virtv1alpha1.Disk{Name: "vol-2"})
+			vm.Spec.Volumes = append(vm.Spec.Volumes, virtv1alpha1.Volume{Name: "vol-2"})
 			return vm
 		}(),
-		assert: func(vm *virtv1alpha1.VirtualMachine) {
-			assert.Equal(t, vm.Spec.Instance.Interfaces[0].Masquerade.CIDR, "10.0.2.0/30")
-		},
+		invalidFields: []string{"spec.instance.disks", "spec.volumes"},
 	}}
+
 	for _, tc := range tests {
-		err := MutateVM(context.Background(), tc.vm, nil)
-		assert.Nil(t, err)
-		tc.assert(tc.vm)
+		errs := ValidateRunningVMUpdate(context.Background(), tc.vm, oldVM)
+		var invalidFields []string
+		for _, err := range errs {
+			invalidFields = append(invalidFields, err.Field)
+		}
+		assert.Equal(t, tc.invalidFields, invalidFields)
 	}
 }
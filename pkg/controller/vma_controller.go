@@ -0,0 +1,199 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// VMAReconciler carries out VMAs by setting the power action of the VM, which
+// is then performed by virt-controller or virt-daemon as usual.
+type VMAReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	MaxConcurrentReconciles int
+	RateLimiter             ratelimiter.RateLimiter
+	// BatchPeriod delays reconciliations triggered by changes of dependent
+	// objects, so that bursts of changes are handled together.
+	BatchPeriod time.Duration
+}
+
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachineactions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachineactions/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
+
+func (r *VMAReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var vma virtv1beta1.VirtualMachineAction
+	if err := r.Get(ctx, req.NamespacedName, &vma); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	status := vma.Status.DeepCopy()
+	if err := r.reconcile(ctx, &vma); err != nil {
+		r.Recorder.Eventf(&vma, corev1.EventTypeWarning, "FailedReconcile", "Failed to reconcile VMA: %s", err)
+		return ctrl.Result{}, err
+	}
+
+	if !reflect.DeepEqual(vma.Status, status) {
+		if err := r.Status().Update(ctx, &vma); err != nil {
+			if apierrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("update VMA status: %s", err)
+		}
+	}
+
+	if vma.Status.Phase == virtv1beta1.VirtualMachineActionPending {
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *VMAReconciler) reconcile(ctx context.Context, vma *virtv1beta1.VirtualMachineAction) error {
+	if vma.DeletionTimestamp != nil && !vma.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	switch vma.Status.Phase {
+	case virtv1beta1.VirtualMachineActionSucceeded, virtv1beta1.VirtualMachineActionFailed:
+		return nil
+	case "":
+		vma.Status.Phase = virtv1beta1.VirtualMachineActionPending
+		vma.Status.Requester = vma.Annotations[requesterAnnotation]
+		return nil
+	}
+
+	var vm virtv1alpha1.VirtualMachine
+	vmKey := types.NamespacedName{
+		Name:      vma.Spec.VirtualMachineName,
+		Namespace: vma.Namespace,
+	}
+	if err := r.Get(ctx, vmKey, &vm); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.failVMA(vma, "VM %q not found", vma.Spec.VirtualMachineName)
+			return nil
+		}
+		return fmt.Errorf("get VM: %s", err)
+	}
+
+	switch vma.Status.Phase {
+	case virtv1beta1.VirtualMachineActionPending:
+		if vm.Status.PowerAction != "" || vm.Status.Migration != nil {
+			// wait for the previous power action or the migration to complete
+			return nil
+		}
+
+		switch vma.Spec.Action {
+		case virtv1beta1.VirtualMachineActionStart:
+			if vm.Status.Phase != "" && vm.Status.Phase != virtv1alpha1.VirtualMachineSucceeded && vm.Status.Phase != virtv1alpha1.VirtualMachineFailed {
+				r.failVMA(vma, "VM is %s", vm.Status.Phase)
+				return nil
+			}
+		default:
+			if vm.Status.Phase != virtv1alpha1.VirtualMachineRunning {
+				r.failVMA(vma, "VM is not running")
+				return nil
+			}
+		}
+
+		vm.Status.PowerAction = getVMAPowerAction(vma.Spec.Action)
+		if err := r.Status().Update(ctx, &vm); err != nil {
+			if apierrors.IsConflict(err) {
+				return nil
+			}
+			return fmt.Errorf("update VM status: %s", err)
+		}
+		r.Recorder.Eventf(&vm, corev1.EventTypeNormal, "ActionRequested", "%s requested by %q with VMA %q", vma.Spec.Action, vma.Status.Requester, vma.Name)
+		vma.Status.Phase = virtv1beta1.VirtualMachineActionRunning
+	case virtv1beta1.VirtualMachineActionRunning:
+		if vm.Status.PowerAction == "" {
+			vma.Status.Phase = virtv1beta1.VirtualMachineActionSucceeded
+		}
+	}
+	return nil
+}
+
+func (r *VMAReconciler) failVMA(vma *virtv1beta1.VirtualMachineAction, messageFmt string, args ...interface{}) {
+	vma.Status.Phase = virtv1beta1.VirtualMachineActionFailed
+	vma.Status.Message = fmt.Sprintf(messageFmt, args...)
+	r.Recorder.Eventf(vma, corev1.EventTypeWarning, "FailedAction", messageFmt, args...)
+}
+
+func getVMAPowerAction(action virtv1beta1.VirtualMachineActionType) virtv1alpha1.VirtualMachinePowerAction {
+	switch action {
+	case virtv1beta1.VirtualMachineActionStart:
+		return virtv1alpha1.VirtualMachinePowerOn
+	case virtv1beta1.VirtualMachineActionStop:
+		return virtv1alpha1.VirtualMachinePowerOff
+	case virtv1beta1.VirtualMachineActionRestart:
+		return virtv1alpha1.VirtualMachineReboot
+	case virtv1beta1.VirtualMachineActionPause:
+		return virtv1alpha1.VirtualMachinePause
+	case virtv1beta1.VirtualMachineActionResume:
+		return virtv1alpha1.VirtualMachineResume
+	default:
+		return ""
+	}
+}
+
+func (r *VMAReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &virtv1beta1.VirtualMachineAction{}, ".spec.virtualMachineName", func(obj client.Object) []string {
+		vma := obj.(*virtv1beta1.VirtualMachineAction)
+		return []string{vma.Spec.VirtualMachineName}
+	}); err != nil {
+		return fmt.Errorf("index VMA by VM name: %s", err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&virtv1beta1.VirtualMachineAction{}).
+		Watches(&source.Kind{Type: &virtv1alpha1.VirtualMachine{}}, &batchingEventHandler{
+			EventHandler: handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+				vm := obj.(*virtv1alpha1.VirtualMachine)
+				var vmaList virtv1beta1.VirtualMachineActionList
+				if err := r.Client.List(context.Background(), &vmaList, client.InNamespace(vm.Namespace), client.MatchingFields{".spec.virtualMachineName": vm.Name}); err != nil {
+					return nil
+				}
+
+				var requests []reconcile.Request
+				for _, vma := range vmaList.Items {
+					if vma.Status.Phase == virtv1beta1.VirtualMachineActionSucceeded || vma.Status.Phase == virtv1beta1.VirtualMachineActionFailed {
+						continue
+					}
+					requests = append(requests, reconcile.Request{
+						NamespacedName: types.NamespacedName{
+							Namespace: vma.Namespace,
+							Name:      vma.Name,
+						},
+					})
+				}
+				return requests
+			}),
+			BatchPeriod: r.BatchPeriod,
+		}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+			RateLimiter:             r.RateLimiter,
+		}).
+		Complete(r)
+}
@@ -0,0 +1,186 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/smartxworks/virtink/pkg/apis/virt"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// requesterAnnotation records the user who created a VMA or a VMTI. It is set
+// by the webhooks and copied into the status by the controllers.
+const requesterAnnotation = "virtink.io/requester"
+
+// +kubebuilder:webhook:path=/mutate-v1beta1-virtualmachineaction,mutating=true,failurePolicy=fail,sideEffects=None,groups=virt.virtink.smartx.com,resources=virtualmachineactions,verbs=create,versions=v1beta1,name=mutate.virtualmachineaction.v1beta1.virt.virtink.smartx.com,admissionReviewVersions={v1,v1beta1}
+
+type VMAMutator struct {
+	decoder *admission.Decoder
+}
+
+var _ admission.DecoderInjector = &VMAMutator{}
+var _ admission.Handler = &VMAMutator{}
+
+func (h *VMAMutator) InjectDecoder(decoder *admission.Decoder) error {
+	h.decoder = decoder
+	return nil
+}
+
+func (h *VMAMutator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	var vma virtv1beta1.VirtualMachineAction
+	if err := h.decoder.Decode(req, &vma); err != nil {
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("unmarshal VMA: %s", err))
+	}
+
+	if req.Operation != admissionv1.Create {
+		return admission.Allowed("")
+	}
+
+	if vma.Annotations == nil {
+		vma.Annotations = map[string]string{}
+	}
+	vma.Annotations[requesterAnnotation] = req.UserInfo.Username
+
+	vmaJSON, err := json.Marshal(vma)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("marshal VMA: %s", err))
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, vmaJSON)
+}
+
+// +kubebuilder:webhook:path=/validate-v1beta1-virtualmachineaction,mutating=false,failurePolicy=fail,sideEffects=None,groups=virt.virtink.smartx.com,resources=virtualmachineactions,verbs=create;update,versions=v1beta1,name=validate.virtualmachineaction.v1beta1.virt.virtink.smartx.com,admissionReviewVersions={v1,v1beta1}
+
+type VMAValidator struct {
+	client.Client
+	decoder *admission.Decoder
+}
+
+var _ admission.DecoderInjector = &VMAValidator{}
+var _ admission.Handler = &VMAValidator{}
+
+func (h *VMAValidator) InjectDecoder(decoder *admission.Decoder) error {
+	h.decoder = decoder
+	return nil
+}
+
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+func (h *VMAValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	var vma virtv1beta1.VirtualMachineAction
+	if err := h.decoder.Decode(req, &vma); err != nil {
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("unmarshal VMA: %s", err))
+	}
+
+	var errs field.ErrorList
+	switch req.Operation {
+	case admissionv1.Create:
+		errs = ValidateVMA(ctx, &vma)
+		if len(errs) == 0 {
+			allowed, err := h.isActionAllowed(ctx, req, &vma)
+			if err != nil {
+				return admission.Errored(http.StatusInternalServerError, fmt.Errorf("review access: %s", err))
+			}
+			if !allowed {
+				return webhook.Denied(fmt.Sprintf("user %q may not %s VM %q", req.UserInfo.Username, getVMASubresource(vma.Spec.Action), vma.Spec.VirtualMachineName))
+			}
+		}
+	case admissionv1.Update:
+		var oldVMA virtv1beta1.VirtualMachineAction
+		if err := h.decoder.DecodeRaw(req.OldObject, &oldVMA); err != nil {
+			return admission.Errored(http.StatusBadRequest, fmt.Errorf("unmarshal old VMA: %s", err))
+		}
+
+		if vma.Spec != oldVMA.Spec {
+			errs = append(errs, field.Forbidden(field.NewPath("spec"), "VMA spec may not be updated"))
+		}
+		if vma.Annotations[requesterAnnotation] != oldVMA.Annotations[requesterAnnotation] {
+			errs = append(errs, field.Forbidden(field.NewPath("metadata").Child("annotations").Key(requesterAnnotation), "may not be updated"))
+		}
+	default:
+		return admission.Allowed("")
+	}
+
+	if len(errs) > 0 {
+		return webhook.Denied(errs.ToAggregate().Error())
+	}
+	return admission.Allowed("")
+}
+
+// isActionAllowed checks whether the requesting user may update the virtual
+// subresource of the VM named after the action, e.g. virtualmachines/restart,
+// so that RBAC can grant individual actions.
+func (h *VMAValidator) isActionAllowed(ctx context.Context, req admission.Request, vma *virtv1beta1.VirtualMachineAction) (bool, error) {
+	extra := map[string]authorizationv1.ExtraValue{}
+	for k, v := range req.UserInfo.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+	sar := authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   req.UserInfo.Username,
+			UID:    req.UserInfo.UID,
+			Groups: req.UserInfo.Groups,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   vma.Namespace,
+				Verb:        "update",
+				Group:       virt.GroupName,
+				Resource:    "virtualmachines",
+				Subresource: getVMASubresource(vma.Spec.Action),
+				Name:        vma.Spec.VirtualMachineName,
+			},
+		},
+	}
+	if err := h.Create(ctx, &sar); err != nil {
+		return false, err
+	}
+	return sar.Status.Allowed, nil
+}
+
+func getVMASubresource(action virtv1beta1.VirtualMachineActionType) string {
+	return strings.ToLower(string(action))
+}
+
+func ValidateVMA(ctx context.Context, vma *virtv1beta1.VirtualMachineAction) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, ValidateVMASpec(ctx, &vma.Spec, field.NewPath("spec"))...)
+	return errs
+}
+
+func ValidateVMASpec(ctx context.Context, spec *virtv1beta1.VirtualMachineActionSpec, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if spec == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if spec.VirtualMachineName == "" {
+		errs = append(errs, field.Required(fieldPath.Child("virtualMachineName"), ""))
+	}
+
+	supportedActions := []string{
+		string(virtv1beta1.VirtualMachineActionStart),
+		string(virtv1beta1.VirtualMachineActionStop),
+		string(virtv1beta1.VirtualMachineActionRestart),
+		string(virtv1beta1.VirtualMachineActionPause),
+		string(virtv1beta1.VirtualMachineActionResume),
+	}
+	switch spec.Action {
+	case "":
+		errs = append(errs, field.Required(fieldPath.Child("action"), ""))
+	case virtv1beta1.VirtualMachineActionStart, virtv1beta1.VirtualMachineActionStop, virtv1beta1.VirtualMachineActionRestart,
+		virtv1beta1.VirtualMachineActionPause, virtv1beta1.VirtualMachineActionResume:
+	default:
+		errs = append(errs, field.NotSupported(fieldPath.Child("action"), spec.Action, supportedActions))
+	}
+	return errs
+}
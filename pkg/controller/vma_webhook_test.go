@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+func TestValidateVMA(t *testing.T) {
+	validVMA := &virtv1beta1.VirtualMachineAction{
+		Spec: virtv1beta1.VirtualMachineActionSpec{
+			VirtualMachineName: "test-vm",
+			Action:             virtv1beta1.VirtualMachineActionRestart,
+		},
+	}
+
+	tests := []struct {
+		vma           *virtv1beta1.VirtualMachineAction
+		invalidFields []string
+	}{{
+		vma: validVMA,
+	}, {
+		vma: func() *virtv1beta1.VirtualMachineAction {
+			vma := validVMA.DeepCopy()
+			vma.Spec.VirtualMachineName = ""
+			return vma
+		}(),
+		invalidFields: []string{"spec.virtualMachineName"},
+	}, {
+		vma: func() *virtv1beta1.VirtualMachineAction {
+			vma := validVMA.DeepCopy()
+			vma.Spec.Action = ""
+			return vma
+		}(),
+		invalidFields: []string{"spec.action"},
+	}, {
+		vma: func() *virtv1beta1.VirtualMachineAction {
+			vma := validVMA.DeepCopy()
+			vma.Spec.Action = "Reset"
+			return vma
+		}(),
+		invalidFields: []string{"spec.action"},
+	}}
+
+	for _, tc := range tests {
+		errs := ValidateVMA(context.Background(), tc.vma)
+		var invalidFields []string
+		for _, err := range errs {
+			invalidFields = append(invalidFields, err.Field)
+		}
+		assert.Equal(t, tc.invalidFields, invalidFields)
+	}
+}
@@ -0,0 +1,587 @@
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/tlsutil"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+const (
+	exportCertDirPath = "/var/run/virtink/export"
+	exportWorkDirPath = "/var/lib/virtink/export"
+	exportDiskPath    = "/mnt/disk"
+	exportServerPort  = 8443
+
+	defaultVolumeSnapshotClassAnnotation = "snapshot.storage.kubernetes.io/is-default-class"
+)
+
+var (
+	volumeSnapshotGVK          = schema.GroupVersionKind{Group: "snapshot.storage.k8s.io", Version: "v1", Kind: "VolumeSnapshot"}
+	volumeSnapshotClassListGVK = schema.GroupVersionKind{Group: "snapshot.storage.k8s.io", Version: "v1", Kind: "VolumeSnapshotClassList"}
+)
+
+type VMEReconciler struct {
+	client.Client
+	Scheme            *runtime.Scheme
+	Recorder          record.EventRecorder
+	ExporterImageName string
+
+	MaxConcurrentReconciles int
+	RateLimiter             ratelimiter.RateLimiter
+	// BatchPeriod delays reconciliations triggered by changes of dependent
+	// objects, so that bursts of changes are handled together.
+	BatchPeriod time.Duration
+}
+
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachineexports,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachineexports/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtinkconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshotclasses,verbs=get;list
+// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;create;delete
+
+func (r *VMEReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var vme virtv1alpha1.VirtualMachineExport
+	if err := r.Get(ctx, req.NamespacedName, &vme); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	status := vme.Status.DeepCopy()
+	if err := r.reconcile(ctx, &vme); err != nil {
+		r.Recorder.Eventf(&vme, corev1.EventTypeWarning, "FailedReconcile", "Failed to reconcile VME: %s", err)
+		return ctrl.Result{}, err
+	}
+
+	if !reflect.DeepEqual(vme.Status, status) {
+		if err := r.Status().Update(ctx, &vme); err != nil {
+			if apierrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("update VME status: %s", err)
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *VMEReconciler) reconcile(ctx context.Context, vme *virtv1alpha1.VirtualMachineExport) error {
+	if vme.DeletionTimestamp != nil && !vme.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	switch vme.Status.Phase {
+	case virtv1alpha1.VirtualMachineExportSucceeded, virtv1alpha1.VirtualMachineExportFailed:
+		return nil
+	case "":
+		vme.Status.Phase = virtv1alpha1.VirtualMachineExportPending
+		return nil
+	}
+
+	var vm virtv1alpha1.VirtualMachine
+	vmKey := types.NamespacedName{
+		Name:      vme.Spec.VMName,
+		Namespace: vme.Namespace,
+	}
+	if err := r.Get(ctx, vmKey, &vm); err != nil {
+		if apierrors.IsNotFound(err) {
+			vme.Status.Phase = virtv1alpha1.VirtualMachineExportFailed
+			r.Recorder.Eventf(vme, corev1.EventTypeWarning, "FailedExport", "VM %q not found", vme.Spec.VMName)
+			return nil
+		}
+		return fmt.Errorf("get VM: %s", err)
+	}
+
+	sourceClaimName := getVolumeClaimName(&vm, vme.Spec.VolumeName)
+	if sourceClaimName == "" {
+		vme.Status.Phase = virtv1alpha1.VirtualMachineExportFailed
+		r.Recorder.Eventf(vme, corev1.EventTypeWarning, "FailedExport", "VM volume %q is not a PVC or data volume", vme.Spec.VolumeName)
+		return nil
+	}
+
+	var sourcePVC corev1.PersistentVolumeClaim
+	if err := r.Get(ctx, types.NamespacedName{Name: sourceClaimName, Namespace: vme.Namespace}, &sourcePVC); err != nil {
+		return fmt.Errorf("get source PVC: %s", err)
+	}
+
+	exportName := vme.Name + "-export"
+	var exportPVC corev1.PersistentVolumeClaim
+	if err := r.Get(ctx, types.NamespacedName{Name: exportName, Namespace: vme.Namespace}, &exportPVC); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("get export PVC: %s", err)
+		}
+
+		snapshotClassName, err := r.findVolumeSnapshotClass(ctx, &sourcePVC)
+		if err != nil {
+			return fmt.Errorf("find volume snapshot class: %s", err)
+		}
+		if snapshotClassName == "" && hasVMPod(&vm) {
+			// a clone of the PVC of a running VM is not consistent
+			r.Recorder.Eventf(vme, corev1.EventTypeWarning, "WaitingForVMStop", "No VolumeSnapshotClass matches the provisioner of PVC %q, waiting for VM %q to stop", sourcePVC.Name, vm.Name)
+			return nil
+		}
+		if snapshotClassName != "" {
+			if err := r.createExportVolumeSnapshot(ctx, vme, &sourcePVC, snapshotClassName, exportName); err != nil {
+				return fmt.Errorf("create export volume snapshot: %s", err)
+			}
+		}
+		if err := r.createExportPVC(ctx, vme, &sourcePVC, snapshotClassName != "", exportName); err != nil {
+			return fmt.Errorf("create export PVC: %s", err)
+		}
+	}
+	vme.Status.ClaimName = exportName
+
+	if vme.Spec.HTTP != nil {
+		if err := r.createExportSecret(ctx, vme, exportName); err != nil {
+			return fmt.Errorf("create export secret: %s", err)
+		}
+		vme.Status.SecretName = exportName
+
+		if err := r.createExportService(ctx, vme, exportName); err != nil {
+			return fmt.Errorf("create export service: %s", err)
+		}
+	}
+
+	var exportPod corev1.Pod
+	exportPodKey := types.NamespacedName{
+		Name:      exportName,
+		Namespace: vme.Namespace,
+	}
+	if err := r.Get(ctx, exportPodKey, &exportPod); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("get export pod: %s", err)
+		}
+
+		config, err := virtinkconfig.Get(ctx, r.Client)
+		if err != nil {
+			return fmt.Errorf("get Virtink config: %s", err)
+		}
+		exporterImageName := r.ExporterImageName
+		if config.Spec.Images.Exporter != "" {
+			exporterImageName = config.Spec.Images.Exporter
+		}
+
+		exportPod = *r.buildExportPod(vme, &sourcePVC, exportName, exporterImageName)
+		if err := controllerutil.SetControllerReference(vme, &exportPod, r.Scheme); err != nil {
+			return fmt.Errorf("set export pod controller reference: %s", err)
+		}
+		if err := r.Create(ctx, &exportPod); err != nil {
+			return fmt.Errorf("create export pod: %s", err)
+		}
+		r.Recorder.Eventf(vme, corev1.EventTypeNormal, "CreatedExportPod", "Created export pod %q", exportPod.Name)
+	}
+
+	switch exportPod.Status.Phase {
+	case corev1.PodSucceeded:
+		pvc := corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      exportName,
+				Namespace: vme.Namespace,
+			},
+		}
+		if err := r.Delete(ctx, &pvc); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("delete export PVC: %s", err)
+		}
+		var snapshot unstructured.Unstructured
+		snapshot.SetGroupVersionKind(volumeSnapshotGVK)
+		snapshot.SetName(exportName)
+		snapshot.SetNamespace(vme.Namespace)
+		if err := r.Delete(ctx, &snapshot); client.IgnoreNotFound(err) != nil && !meta.IsNoMatchError(err) {
+			return fmt.Errorf("delete export volume snapshot: %s", err)
+		}
+		vme.Status.Phase = virtv1alpha1.VirtualMachineExportSucceeded
+	case corev1.PodFailed:
+		vme.Status.Phase = virtv1alpha1.VirtualMachineExportFailed
+	case corev1.PodRunning:
+		if vme.Spec.HTTP != nil && isPodReady(&exportPod) {
+			vme.Status.Phase = virtv1alpha1.VirtualMachineExportReady
+			vme.Status.URL = fmt.Sprintf("https://%s.%s.svc/%s", exportName, vme.Namespace, getExportImageName(vme.Spec.Format))
+		} else {
+			vme.Status.Phase = virtv1alpha1.VirtualMachineExportExporting
+		}
+	default:
+		vme.Status.Phase = virtv1alpha1.VirtualMachineExportExporting
+	}
+	return nil
+}
+
+func getVolumeClaimName(vm *virtv1alpha1.VirtualMachine, volumeName string) string {
+	for _, volume := range vm.Spec.Volumes {
+		if volume.Name != volumeName {
+			continue
+		}
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			return volume.PersistentVolumeClaim.ClaimName
+		case volume.DataVolume != nil:
+			return volume.DataVolume.VolumeName
+		}
+	}
+	return ""
+}
+
+func getExportImageName(format virtv1alpha1.VirtualMachineExportFormat) string {
+	if format == virtv1alpha1.VirtualMachineExportQCOW2 {
+		return "disk.qcow2"
+	}
+	return "disk.img"
+}
+
+// findVolumeSnapshotClass returns the name of the VolumeSnapshotClass of the
+// CSI driver provisioning the PVC, preferring the default class of the
+// driver, or "" if there is none.
+func (r *VMEReconciler) findVolumeSnapshotClass(ctx context.Context, pvc *corev1.PersistentVolumeClaim) (string, error) {
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return "", nil
+	}
+	var storageClass storagev1.StorageClass
+	if err := r.Get(ctx, types.NamespacedName{Name: *pvc.Spec.StorageClassName}, &storageClass); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+
+	// unstructured objects are read from the API server directly
+	var snapshotClassList unstructured.UnstructuredList
+	snapshotClassList.SetGroupVersionKind(volumeSnapshotClassListGVK)
+	if err := r.List(ctx, &snapshotClassList); err != nil {
+		if meta.IsNoMatchError(err) {
+			// the snapshot CRDs are not installed
+			return "", nil
+		}
+		return "", err
+	}
+	var snapshotClassName string
+	for _, snapshotClass := range snapshotClassList.Items {
+		driver, _, _ := unstructured.NestedString(snapshotClass.Object, "driver")
+		if driver != storageClass.Provisioner {
+			continue
+		}
+		if snapshotClass.GetAnnotations()[defaultVolumeSnapshotClassAnnotation] == "true" {
+			return snapshotClass.GetName(), nil
+		}
+		if snapshotClassName == "" {
+			snapshotClassName = snapshotClass.GetName()
+		}
+	}
+	return snapshotClassName, nil
+}
+
+// createExportVolumeSnapshot snapshots the source PVC, so that the volume is
+// exported consistently while the VM keeps running.
+func (r *VMEReconciler) createExportVolumeSnapshot(ctx context.Context, vme *virtv1alpha1.VirtualMachineExport, sourcePVC *corev1.PersistentVolumeClaim, snapshotClassName string, name string) error {
+	var snapshot unstructured.Unstructured
+	snapshot.SetGroupVersionKind(volumeSnapshotGVK)
+	snapshot.SetName(name)
+	snapshot.SetNamespace(vme.Namespace)
+	if err := unstructured.SetNestedField(snapshot.Object, snapshotClassName, "spec", "volumeSnapshotClassName"); err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedField(snapshot.Object, sourcePVC.Name, "spec", "source", "persistentVolumeClaimName"); err != nil {
+		return err
+	}
+	if err := controllerutil.SetControllerReference(vme, &snapshot, r.Scheme); err != nil {
+		return fmt.Errorf("set controller reference: %s", err)
+	}
+	if err := r.Create(ctx, &snapshot); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil
+		}
+		return err
+	}
+	r.Recorder.Eventf(vme, corev1.EventTypeNormal, "CreatedExportVolumeSnapshot", "Created export volume snapshot %q", name)
+	return nil
+}
+
+// createExportPVC restores the volume snapshot of the same name, or clones
+// the source PVC of a stopped VM, so that the VM may run during the export.
+func (r *VMEReconciler) createExportPVC(ctx context.Context, vme *virtv1alpha1.VirtualMachineExport, sourcePVC *corev1.PersistentVolumeClaim, fromSnapshot bool, name string) error {
+	dataSource := &corev1.TypedLocalObjectReference{
+		Kind: "PersistentVolumeClaim",
+		Name: sourcePVC.Name,
+	}
+	if fromSnapshot {
+		dataSource = &corev1.TypedLocalObjectReference{
+			APIGroup: &volumeSnapshotGVK.Group,
+			Kind:     volumeSnapshotGVK.Kind,
+			Name:     name,
+		}
+	}
+
+	pvc := corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: vme.Namespace,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      sourcePVC.Spec.AccessModes,
+			Resources:        sourcePVC.Spec.Resources,
+			StorageClassName: sourcePVC.Spec.StorageClassName,
+			VolumeMode:       sourcePVC.Spec.VolumeMode,
+			DataSource:       dataSource,
+		},
+	}
+	if err := controllerutil.SetControllerReference(vme, &pvc, r.Scheme); err != nil {
+		return fmt.Errorf("set controller reference: %s", err)
+	}
+	if err := r.Create(ctx, &pvc); err != nil {
+		return err
+	}
+	r.Recorder.Eventf(vme, corev1.EventTypeNormal, "CreatedExportPVC", "Created export PVC %q", pvc.Name)
+	return nil
+}
+
+func (r *VMEReconciler) createExportSecret(ctx context.Context, vme *virtv1alpha1.VirtualMachineExport, name string) error {
+	var secret corev1.Secret
+	if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: vme.Namespace}, &secret); err == nil {
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return fmt.Errorf("generate token: %s", err)
+	}
+
+	dnsNames := []string{
+		fmt.Sprintf("%s.%s.svc", name, vme.Namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", name, vme.Namespace),
+		fmt.Sprintf("%s.%s", name, vme.Namespace),
+		name,
+	}
+	certPEM, keyPEM, err := tlsutil.GenerateSelfSignedCert(dnsNames, 365*24*time.Hour)
+	if err != nil {
+		return fmt.Errorf("generate cert: %s", err)
+	}
+
+	secret = corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: vme.Namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"token":   []byte(hex.EncodeToString(token)),
+			"ca.crt":  certPEM,
+			"tls.crt": certPEM,
+			"tls.key": keyPEM,
+		},
+	}
+	if err := controllerutil.SetControllerReference(vme, &secret, r.Scheme); err != nil {
+		return fmt.Errorf("set controller reference: %s", err)
+	}
+	return r.Create(ctx, &secret)
+}
+
+func (r *VMEReconciler) createExportService(ctx context.Context, vme *virtv1alpha1.VirtualMachineExport, name string) error {
+	var service corev1.Service
+	if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: vme.Namespace}, &service); err == nil {
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	service = corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: vme.Namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				"virtink.io/vme.name": vme.Name,
+			},
+			Ports: []corev1.ServicePort{{
+				Name:       "https",
+				Port:       443,
+				TargetPort: intstr.FromInt(exportServerPort),
+			}},
+		},
+	}
+	if err := controllerutil.SetControllerReference(vme, &service, r.Scheme); err != nil {
+		return fmt.Errorf("set controller reference: %s", err)
+	}
+	return r.Create(ctx, &service)
+}
+
+func (r *VMEReconciler) buildExportPod(vme *virtv1alpha1.VirtualMachineExport, sourcePVC *corev1.PersistentVolumeClaim, name string, imageName string) *corev1.Pod {
+	exportPod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: vme.Namespace,
+			Labels: map[string]string{
+				"virtink.io/vme.name": vme.Name,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers: []corev1.Container{{
+				Name:  "exporter",
+				Image: imageName,
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "work",
+					MountPath: exportWorkDirPath,
+				}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: "disk",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: name,
+						ReadOnly:  true,
+					},
+				},
+			}, {
+				Name: "work",
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			}},
+		},
+	}
+
+	diskPath := exportDiskPath
+	if sourcePVC.Spec.VolumeMode != nil && *sourcePVC.Spec.VolumeMode == corev1.PersistentVolumeBlock {
+		exportPod.Spec.Containers[0].VolumeDevices = append(exportPod.Spec.Containers[0].VolumeDevices, corev1.VolumeDevice{
+			Name:       "disk",
+			DevicePath: exportDiskPath,
+		})
+	} else {
+		exportPod.Spec.Containers[0].VolumeMounts = append(exportPod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      "disk",
+			MountPath: exportDiskPath,
+			ReadOnly:  true,
+		})
+		diskPath = exportDiskPath + "/disk.img"
+	}
+
+	format := string(vme.Spec.Format)
+	if format == "" {
+		format = string(virtv1alpha1.VirtualMachineExportRaw)
+	}
+
+	switch {
+	case vme.Spec.HTTP != nil:
+		exportPod.Spec.Containers[0].Args = []string{"serve", "--disk", diskPath, "--format", format,
+			"--cert-dir", exportCertDirPath, "--token-file", exportCertDirPath + "/token",
+			"--addr", fmt.Sprintf(":%d", exportServerPort)}
+		exportPod.Spec.Containers[0].Ports = []corev1.ContainerPort{{
+			Name:          "https",
+			ContainerPort: exportServerPort,
+		}}
+		exportPod.Spec.Containers[0].ReadinessProbe = &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   "/healthz",
+					Port:   intstr.FromInt(exportServerPort),
+					Scheme: corev1.URISchemeHTTPS,
+				},
+			},
+		}
+		exportPod.Spec.Containers[0].VolumeMounts = append(exportPod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      "export",
+			MountPath: exportCertDirPath,
+			ReadOnly:  true,
+		})
+		exportPod.Spec.Volumes = append(exportPod.Spec.Volumes, corev1.Volume{
+			Name: "export",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: name,
+				},
+			},
+		})
+	case vme.Spec.OCI != nil:
+		exportPod.Spec.Containers[0].Args = []string{"push", "--disk", diskPath, "--format", format,
+			"--image", vme.Spec.OCI.Image}
+		if vme.Spec.OCI.PushSecretName != "" {
+			exportPod.Spec.Containers[0].Args = append(exportPod.Spec.Containers[0].Args,
+				"--auth-file", exportCertDirPath+"/"+corev1.DockerConfigJsonKey)
+			exportPod.Spec.Containers[0].VolumeMounts = append(exportPod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+				Name:      "export",
+				MountPath: exportCertDirPath,
+				ReadOnly:  true,
+			})
+			exportPod.Spec.Volumes = append(exportPod.Spec.Volumes, corev1.Volume{
+				Name: "export",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: vme.Spec.OCI.PushSecretName,
+					},
+				},
+			})
+		}
+	}
+	return &exportPod
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func (r *VMEReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&virtv1alpha1.VirtualMachineExport{}).
+		Watches(&source.Kind{Type: &corev1.Pod{}}, &batchingEventHandler{
+			EventHandler: &handler.EnqueueRequestForOwner{OwnerType: &virtv1alpha1.VirtualMachineExport{}, IsController: true},
+			BatchPeriod:  r.BatchPeriod,
+		}).
+		// exports of volumes without snapshots wait for their VMs to stop
+		Watches(&source.Kind{Type: &virtv1alpha1.VirtualMachine{}}, &batchingEventHandler{
+			EventHandler: handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+				var vmeList virtv1alpha1.VirtualMachineExportList
+				if err := r.Client.List(context.Background(), &vmeList, client.InNamespace(obj.GetNamespace())); err != nil {
+					return nil
+				}
+
+				var requests []reconcile.Request
+				for _, vme := range vmeList.Items {
+					if vme.Spec.VMName == obj.GetName() && vme.Status.Phase == virtv1alpha1.VirtualMachineExportPending {
+						requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&vme)})
+					}
+				}
+				return requests
+			}),
+			BatchPeriod: r.BatchPeriod,
+		}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+			RateLimiter:             r.RateLimiter,
+		}).
+		Complete(r)
+}
@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+func TestReconcileVME(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(virtv1beta1.AddToScheme(scheme))
+
+	newObjects := func(provisioner string) []client.Object {
+		storageClassName := "standard"
+		snapshotClass := &unstructured.Unstructured{Object: map[string]interface{}{
+			"driver":         "csi.example.com",
+			"deletionPolicy": "Delete",
+		}}
+		snapshotClass.SetGroupVersionKind(volumeSnapshotClassListGVK.GroupVersion().WithKind("VolumeSnapshotClass"))
+		snapshotClass.SetName("csi-snapshots")
+		return []client.Object{
+			&virtv1alpha1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ubuntu"},
+				Spec: virtv1alpha1.VirtualMachineSpec{
+					Volumes: []virtv1alpha1.Volume{{
+						Name: "data",
+						VolumeSource: virtv1alpha1.VolumeSource{
+							PersistentVolumeClaim: &virtv1alpha1.PersistentVolumeClaimVolumeSource{ClaimName: "data"},
+						},
+					}},
+				},
+				Status: virtv1alpha1.VirtualMachineStatus{Phase: virtv1alpha1.VirtualMachineRunning},
+			},
+			&corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "data"},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					StorageClassName: &storageClassName,
+					AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+					},
+				},
+			},
+			&storagev1.StorageClass{
+				ObjectMeta:  metav1.ObjectMeta{Name: storageClassName},
+				Provisioner: provisioner,
+			},
+			snapshotClass,
+		}
+	}
+	newVME := func() *virtv1alpha1.VirtualMachineExport {
+		return &virtv1alpha1.VirtualMachineExport{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "data", UID: "vme-uid"},
+			Spec: virtv1alpha1.VirtualMachineExportSpec{
+				VMName:     "ubuntu",
+				VolumeName: "data",
+				VirtualMachineExportTarget: virtv1alpha1.VirtualMachineExportTarget{
+					OCI: &virtv1alpha1.VirtualMachineExportOCITarget{Image: "registry.example.com/data:latest"},
+				},
+			},
+			Status: virtv1alpha1.VirtualMachineExportStatus{Phase: virtv1alpha1.VirtualMachineExportPending},
+		}
+	}
+	exportKey := types.NamespacedName{Namespace: "default", Name: "data-export"}
+
+	t.Run("snapshot", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newObjects("csi.example.com")...).Build()
+		r := &VMEReconciler{Client: c, Scheme: scheme, Recorder: record.NewFakeRecorder(100)}
+		vme := newVME()
+		require.NoError(t, r.reconcile(context.Background(), vme))
+		assert.Equal(t, virtv1alpha1.VirtualMachineExportExporting, vme.Status.Phase)
+
+		var snapshot unstructured.Unstructured
+		snapshot.SetGroupVersionKind(volumeSnapshotGVK)
+		require.NoError(t, c.Get(context.Background(), exportKey, &snapshot))
+		snapshotClassName, _, _ := unstructured.NestedString(snapshot.Object, "spec", "volumeSnapshotClassName")
+		assert.Equal(t, "csi-snapshots", snapshotClassName)
+		sourceClaimName, _, _ := unstructured.NestedString(snapshot.Object, "spec", "source", "persistentVolumeClaimName")
+		assert.Equal(t, "data", sourceClaimName)
+
+		var pvc corev1.PersistentVolumeClaim
+		require.NoError(t, c.Get(context.Background(), exportKey, &pvc))
+		require.NotNil(t, pvc.Spec.DataSource)
+		assert.Equal(t, "VolumeSnapshot", pvc.Spec.DataSource.Kind)
+		assert.Equal(t, "data-export", pvc.Spec.DataSource.Name)
+
+		var exportPod corev1.Pod
+		require.NoError(t, c.Get(context.Background(), exportKey, &exportPod))
+		exportPod.Status.Phase = corev1.PodSucceeded
+		require.NoError(t, c.Status().Update(context.Background(), &exportPod))
+		require.NoError(t, r.reconcile(context.Background(), vme))
+		assert.Equal(t, virtv1alpha1.VirtualMachineExportSucceeded, vme.Status.Phase)
+		assert.True(t, apierrors.IsNotFound(c.Get(context.Background(), exportKey, &corev1.PersistentVolumeClaim{})))
+		assert.True(t, apierrors.IsNotFound(c.Get(context.Background(), exportKey, &snapshot)))
+	})
+
+	t.Run("clone", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newObjects("nfs.example.com")...).Build()
+		r := &VMEReconciler{Client: c, Scheme: scheme, Recorder: record.NewFakeRecorder(100)}
+		vme := newVME()
+
+		// the PVC of a running VM is not cloned
+		require.NoError(t, r.reconcile(context.Background(), vme))
+		assert.Equal(t, virtv1alpha1.VirtualMachineExportPending, vme.Status.Phase)
+		assert.True(t, apierrors.IsNotFound(c.Get(context.Background(), exportKey, &corev1.PersistentVolumeClaim{})))
+		assert.True(t, apierrors.IsNotFound(c.Get(context.Background(), exportKey, &corev1.Pod{})))
+
+		var vm virtv1alpha1.VirtualMachine
+		require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "ubuntu"}, &vm))
+		vm.Status.Phase = virtv1alpha1.VirtualMachineSucceeded
+		require.NoError(t, c.Status().Update(context.Background(), &vm))
+		require.NoError(t, r.reconcile(context.Background(), vme))
+		assert.Equal(t, virtv1alpha1.VirtualMachineExportExporting, vme.Status.Phase)
+
+		var pvc corev1.PersistentVolumeClaim
+		require.NoError(t, c.Get(context.Background(), exportKey, &pvc))
+		require.NotNil(t, pvc.Spec.DataSource)
+		assert.Equal(t, "PersistentVolumeClaim", pvc.Spec.DataSource.Kind)
+		assert.Equal(t, "data", pvc.Spec.DataSource.Name)
+		assert.NoError(t, c.Get(context.Background(), exportKey, &corev1.Pod{}))
+
+		// the VM may start again once its PVC is cloned
+		vm.Status.Phase = virtv1alpha1.VirtualMachineRunning
+		require.NoError(t, c.Status().Update(context.Background(), &vm))
+		require.NoError(t, r.reconcile(context.Background(), vme))
+		assert.Equal(t, virtv1alpha1.VirtualMachineExportExporting, vme.Status.Phase)
+	})
+}
@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/r3labs/diff/v2"
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+// +kubebuilder:webhook:path=/validate-v1alpha1-virtualmachineexport,mutating=false,failurePolicy=fail,sideEffects=None,groups=virt.virtink.smartx.com,resources=virtualmachineexports,verbs=create;update,versions=v1alpha1,name=validate.virtualmachineexport.v1alpha1.virt.virtink.smartx.com,admissionReviewVersions={v1,v1beta1}
+
+type VMEValidator struct {
+	client.Client
+	decoder *admission.Decoder
+}
+
+var _ admission.DecoderInjector = &VMEValidator{}
+var _ admission.Handler = &VMEValidator{}
+
+func (h *VMEValidator) InjectDecoder(decoder *admission.Decoder) error {
+	h.decoder = decoder
+	return nil
+}
+
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtinkconfigs,verbs=get;list;watch
+
+func (h *VMEValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	var vme virtv1alpha1.VirtualMachineExport
+	if err := h.decoder.Decode(req, &vme); err != nil {
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("unmarshal VME: %s", err))
+	}
+
+	var errs field.ErrorList
+	switch req.Operation {
+	case admissionv1.Create:
+		config, err := virtinkconfig.Get(ctx, h.Client)
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, fmt.Errorf("get Virtink config: %s", err))
+		}
+		if !virtinkconfig.FeatureGateEnabled(config, virtinkconfig.VMExport) {
+			return webhook.Denied(fmt.Sprintf("feature gate %s is disabled", virtinkconfig.VMExport))
+		}
+		errs = ValidateVME(ctx, h.Client, &vme)
+	case admissionv1.Update:
+		var oldVME virtv1alpha1.VirtualMachineExport
+		if err := h.decoder.DecodeRaw(req.OldObject, &oldVME); err != nil {
+			return admission.Errored(http.StatusBadRequest, fmt.Errorf("unmarshal old VME: %s", err))
+		}
+
+		changes, err := diff.Diff(oldVME.Spec, vme.Spec, diff.SliceOrdering(true))
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, fmt.Errorf("diff VME: %s", err))
+		}
+
+		if len(changes) != 0 {
+			errs = append(errs, field.Forbidden(field.NewPath("spec"), "VME spec may not be updated"))
+		}
+	default:
+		return admission.Allowed("")
+	}
+
+	if len(errs) > 0 {
+		return webhook.Denied(errs.ToAggregate().Error())
+	}
+	return admission.Allowed("")
+}
+
+func ValidateVME(ctx context.Context, c client.Client, vme *virtv1alpha1.VirtualMachineExport) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, ValidateVMESpec(ctx, c, vme.Namespace, &vme.Spec, field.NewPath("spec"))...)
+	return errs
+}
+
+func ValidateVMESpec(ctx context.Context, c client.Client, namespace string, spec *virtv1alpha1.VirtualMachineExportSpec, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if spec == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if spec.VMName == "" {
+		errs = append(errs, field.Required(fieldPath.Child("vmName"), ""))
+	}
+	if spec.VolumeName == "" {
+		errs = append(errs, field.Required(fieldPath.Child("volumeName"), ""))
+	}
+	if spec.VMName != "" && spec.VolumeName != "" {
+		errs = append(errs, ValidateVMEVolume(ctx, c, namespace, spec, fieldPath)...)
+	}
+
+	var cnt int
+	if spec.HTTP != nil {
+		cnt++
+	}
+	if spec.OCI != nil {
+		cnt++
+		if cnt > 1 {
+			errs = append(errs, field.Forbidden(fieldPath.Child("oci"), "may not specify more than 1 export target"))
+		} else {
+			errs = append(errs, ValidateVMEOCITarget(spec.OCI, fieldPath.Child("oci"))...)
+		}
+	}
+	if cnt == 0 {
+		errs = append(errs, field.Required(fieldPath, "at least 1 export target is required"))
+	}
+	return errs
+}
+
+func ValidateVMEVolume(ctx context.Context, c client.Client, namespace string, spec *virtv1alpha1.VirtualMachineExportSpec, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	vmKey := client.ObjectKey{Namespace: namespace, Name: spec.VMName}
+	var vm virtv1alpha1.VirtualMachine
+	if err := c.Get(ctx, vmKey, &vm); err != nil {
+		if apierrors.IsNotFound(err) {
+			errs = append(errs, field.NotFound(fieldPath.Child("vmName"), spec.VMName))
+		} else {
+			errs = append(errs, field.InternalError(fieldPath.Child("vmName"), err))
+		}
+		return errs
+	}
+
+	for _, volume := range vm.Spec.Volumes {
+		if volume.Name != spec.VolumeName {
+			continue
+		}
+		if volume.PersistentVolumeClaim == nil && volume.DataVolume == nil {
+			errs = append(errs, field.Forbidden(fieldPath.Child("volumeName"), "only PVC and data volume may be exported"))
+		}
+		return errs
+	}
+	errs = append(errs, field.NotFound(fieldPath.Child("volumeName"), spec.VolumeName))
+	return errs
+}
+
+func ValidateVMEOCITarget(oci *virtv1alpha1.VirtualMachineExportOCITarget, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if oci == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+	if oci.Image == "" {
+		errs = append(errs, field.Required(fieldPath.Child("image"), ""))
+	}
+	return errs
+}
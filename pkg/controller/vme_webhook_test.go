@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+func TestValidateVME(t *testing.T) {
+	var scheme = runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+
+	validVME := &virtv1alpha1.VirtualMachineExport{
+		Spec: virtv1alpha1.VirtualMachineExportSpec{
+			VMName:     "test-vm",
+			VolumeName: "data",
+			Format:     virtv1alpha1.VirtualMachineExportQCOW2,
+			VirtualMachineExportTarget: virtv1alpha1.VirtualMachineExportTarget{
+				HTTP: &virtv1alpha1.VirtualMachineExportHTTPTarget{},
+			},
+		},
+	}
+
+	validVM := &virtv1alpha1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-vm",
+		},
+		Spec: virtv1alpha1.VirtualMachineSpec{
+			Volumes: []virtv1alpha1.Volume{{
+				Name: "root",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					ContainerDisk: &virtv1alpha1.ContainerDiskVolumeSource{
+						Image: "test-image",
+					},
+				},
+			}, {
+				Name: "data",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					PersistentVolumeClaim: &virtv1alpha1.PersistentVolumeClaimVolumeSource{
+						ClaimName: "test-pvc",
+					},
+				},
+			}},
+		},
+	}
+
+	tests := []struct {
+		vme           *virtv1alpha1.VirtualMachineExport
+		invalidFields []string
+	}{{
+		vme: validVME,
+	}, {
+		vme: func() *virtv1alpha1.VirtualMachineExport {
+			vme := validVME.DeepCopy()
+			vme.Spec.VMName = "not-found"
+			return vme
+		}(),
+		invalidFields: []string{"spec.vmName"},
+	}, {
+		vme: func() *virtv1alpha1.VirtualMachineExport {
+			vme := validVME.DeepCopy()
+			vme.Spec.VolumeName = "root"
+			return vme
+		}(),
+		invalidFields: []string{"spec.volumeName"},
+	}, {
+		vme: func() *virtv1alpha1.VirtualMachineExport {
+			vme := validVME.DeepCopy()
+			vme.Spec.VolumeName = "not-found"
+			return vme
+		}(),
+		invalidFields: []string{"spec.volumeName"},
+	}, {
+		vme: func() *virtv1alpha1.VirtualMachineExport {
+			vme := validVME.DeepCopy()
+			vme.Spec.HTTP = nil
+			return vme
+		}(),
+		invalidFields: []string{"spec"},
+	}, {
+		vme: func() *virtv1alpha1.VirtualMachineExport {
+			vme := validVME.DeepCopy()
+			vme.Spec.OCI = &virtv1alpha1.VirtualMachineExportOCITarget{
+				Image: "registry.example.com/test-vm-data",
+			}
+			return vme
+		}(),
+		invalidFields: []string{"spec.oci"},
+	}, {
+		vme: func() *virtv1alpha1.VirtualMachineExport {
+			vme := validVME.DeepCopy()
+			vme.Spec.HTTP = nil
+			vme.Spec.OCI = &virtv1alpha1.VirtualMachineExportOCITarget{}
+			return vme
+		}(),
+		invalidFields: []string{"spec.oci.image"},
+	}}
+
+	for _, tc := range tests {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(validVM).Build()
+		errs := ValidateVME(context.Background(), c, tc.vme)
+		var invalidFields []string
+		for _, err := range errs {
+			invalidFields = append(invalidFields, err.Field)
+		}
+		assert.Equal(t, tc.invalidFields, invalidFields)
+	}
+}
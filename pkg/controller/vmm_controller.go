@@ -4,54 +4,108 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/conditions"
+	"github.com/smartxworks/virtink/pkg/migrationtoken"
+	"github.com/smartxworks/virtink/pkg/tracing"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
 )
 
+// maxMigrationTargetReasons is the number of nodes whose reasons not to be
+// the migration target are kept in the TargetFeasible condition.
+const maxMigrationTargetReasons = 5
+
 type VMMReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+	// Namespace is the namespace of virt-controller, where the Secrets of
+	// migration tokens are kept.
+	Namespace string
+
+	MaxConcurrentReconciles int
+	RateLimiter             ratelimiter.RateLimiter
+	// BatchPeriod delays reconciliations triggered by changes of dependent
+	// objects, so that bursts of changes are handled together.
+	BatchPeriod time.Duration
 }
 
-// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinemigrations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinemigrations,verbs=get;list;watch;delete
 // +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinemigrations/status,verbs=get;update;patch
-// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list;watch;update
 // +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtinkconfigs,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;update;delete
+// +kubebuilder:rbac:groups="",resources=persistentvolumes,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=k8s.cni.cncf.io,resources=network-attachment-definitions,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",namespace=virtink-system,resources=secrets,verbs=create;delete
 
 func (r *VMMReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	var vmm virtv1alpha1.VirtualMachineMigration
 	if err := r.Get(ctx, req.NamespacedName, &vmm); err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
+	ctx = ctrl.LoggerInto(ctx, ctrl.LoggerFrom(ctx).WithValues("virtualMachine", klog.KRef(vmm.Namespace, vmm.Spec.VMName)))
+	ctx, span := tracing.Start(ctx, "ReconcileVMM", attribute.String("vm.namespace", vmm.Namespace), attribute.String("vm.name", vmm.Spec.VMName), attribute.String("vmm.name", vmm.Name))
+	defer span.End()
 
 	status := vmm.Status.DeepCopy()
-	if err := r.reconcile(ctx, &vmm); err != nil {
-		r.Recorder.Eventf(&vmm, corev1.EventTypeWarning, "FailedReconcile", "Failed to reconcile VMM: %s", err)
-		return ctrl.Result{}, err
+	reconcileErr := r.reconcile(ctx, &vmm)
+	if reconcileErr != nil {
+		span.RecordError(reconcileErr)
+		r.Recorder.Eventf(&vmm, corev1.EventTypeWarning, "FailedReconcile", "Failed to reconcile VMM: %s", reconcileErr)
+		conditions.MarkFalse(&vmm.Status.Conditions, string(virtv1alpha1.VirtualMachineMigrationSynchronized), conditions.ReasonReconcileFailed, "%s", reconcileErr)
+	} else {
+		conditions.MarkTrue(&vmm.Status.Conditions, string(virtv1alpha1.VirtualMachineMigrationSynchronized), conditions.ReasonReconcileSucceeded)
 	}
 
 	if !reflect.DeepEqual(vmm.Status, status) {
 		if err := r.Status().Update(ctx, &vmm); err != nil {
+			if reconcileErr != nil {
+				return ctrl.Result{}, reconcileErr
+			}
 			if apierrors.IsConflict(err) {
 				return ctrl.Result{Requeue: true}, nil
 			}
 			return ctrl.Result{}, fmt.Errorf("update VMM status: %s", err)
 		}
 	}
+	if reconcileErr != nil {
+		return ctrl.Result{}, reconcileErr
+	}
 
+	if vmm.Status.Phase == virtv1alpha1.VirtualMachineMigrationPending {
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+	if vmm.Status.CompletionTime != nil && vmm.DeletionTimestamp.IsZero() {
+		requeueAfter, err := r.cleanUpFinishedVMMs(ctx, &vmm)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("clean up finished VMMs: %s", err)
+		}
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -75,10 +129,27 @@ func (r *VMMReconciler) reconcile(ctx context.Context, vmm *virtv1alpha1.Virtual
 
 	if vmm.Status.Phase == virtv1alpha1.VirtualMachineMigrationSucceeded ||
 		vmm.Status.Phase == virtv1alpha1.VirtualMachineMigrationFailed {
+		if vmm.Status.CompletionTime == nil {
+			if err := migrationtoken.Delete(ctx, r.Client, r.Namespace, vmm.UID); err != nil {
+				return fmt.Errorf("delete migration token: %s", err)
+			}
+			now := metav1.Now()
+			vmm.Status.CompletionTime = &now
+		}
 		if vmNotFound || !vm.DeletionTimestamp.IsZero() || vm.Status.Migration == nil || vm.Status.Migration.UID != vmm.UID {
 			return nil
 		}
 
+		if vmm.Status.Phase == virtv1alpha1.VirtualMachineMigrationSucceeded {
+			if err := r.switchMigratedVolumes(ctx, &vm); err != nil {
+				return fmt.Errorf("switch migrated volumes: %s", err)
+			}
+		} else {
+			if err := r.deleteMigrationTargetPVCs(ctx, &vm); err != nil {
+				return fmt.Errorf("delete migration target PVCs: %s", err)
+			}
+		}
+
 		vm.Status.Migration = nil
 		if err := r.Client.Status().Update(ctx, &vm); err != nil {
 			return fmt.Errorf("reset vm migration status: %s", err)
@@ -86,14 +157,51 @@ func (r *VMMReconciler) reconcile(ctx context.Context, vmm *virtv1alpha1.Virtual
 		return nil
 	}
 
+	if vmm.Spec.DryRun {
+		if vmNotFound || !vm.DeletionTimestamp.IsZero() {
+			vmm.Status.Phase = virtv1alpha1.VirtualMachineMigrationFailed
+			return nil
+		}
+		return r.checkMigration(ctx, &vm, vmm)
+	}
+
 	if vmNotFound || !vm.DeletionTimestamp.IsZero() || (vm.Status.Migration != nil && vm.Status.Migration.UID != vmm.UID) {
 		vmm.Status.Phase = virtv1alpha1.VirtualMachineMigrationFailed
 		return nil
 	}
 
 	if vm.Status.Migration == nil {
+		queued, err := r.isMigrationQueued(ctx, &vm)
+		if err != nil {
+			return fmt.Errorf("check parallel migrations: %s", err)
+		}
+		if queued {
+			vmm.Status.Phase = virtv1alpha1.VirtualMachineMigrationPending
+			return nil
+		}
+
+		feasible, err := r.reconcileTargetFeasibleCondition(ctx, &vm, vmm)
+		if err != nil {
+			return err
+		}
+		if !feasible {
+			vmm.Status.Phase = virtv1alpha1.VirtualMachineMigrationFailed
+			return nil
+		}
+
+		if err := migrationtoken.Create(ctx, r.Client, r.Namespace, vmm.UID); err != nil {
+			return fmt.Errorf("create migration token: %s", err)
+		}
+
+		migrationType := vmm.Spec.Type
+		if migrationType == "" {
+			migrationType = virtv1alpha1.VirtualMachineMigrationLive
+		}
 		vm.Status.Migration = &virtv1alpha1.VirtualMachineStatusMigration{
-			UID: vmm.UID,
+			UID:                vmm.UID,
+			Type:               migrationType,
+			TargetNodeSelector: vmm.Spec.NodeSelector,
+			TargetAffinity:     vmm.Spec.Affinity,
 		}
 		if err := r.Client.Status().Update(ctx, &vm); err != nil {
 			return fmt.Errorf("set VM migration status: %s", err)
@@ -110,6 +218,234 @@ func (r *VMMReconciler) reconcile(ctx context.Context, vmm *virtv1alpha1.Virtual
 	return nil
 }
 
+// reconcileTargetFeasibleCondition sets the TargetFeasible condition of the
+// VMM, and reports whether any node can be the migration target.
+func (r *VMMReconciler) reconcileTargetFeasibleCondition(ctx context.Context, vm *virtv1alpha1.VirtualMachine, vmm *virtv1alpha1.VirtualMachineMigration) (bool, error) {
+	targetNodes, reasons, err := findMigrationTargets(ctx, r.Client, vm, vmm)
+	if err != nil {
+		return false, fmt.Errorf("find migration targets: %s", err)
+	}
+	if len(targetNodes) == 0 {
+		message := strings.Join(reasons, "; ")
+		if len(reasons) > maxMigrationTargetReasons {
+			message = fmt.Sprintf("%s; and %d more", strings.Join(reasons[:maxMigrationTargetReasons], "; "), len(reasons)-maxMigrationTargetReasons)
+		}
+		conditions.MarkFalse(&vmm.Status.Conditions, string(virtv1alpha1.VirtualMachineMigrationTargetFeasible), conditions.ReasonNoFeasibleTarget, "No node can be the migration target: %s", message)
+		r.Recorder.Eventf(vmm, corev1.EventTypeWarning, "NoFeasibleTarget", "No node can be the migration target: %s", message)
+		return false, nil
+	}
+	conditions.MarkTrue(&vmm.Status.Conditions, string(virtv1alpha1.VirtualMachineMigrationTargetFeasible), conditions.ReasonFeasibleTargetFound)
+	return true, nil
+}
+
+// checkMigration finishes a dry-run VMM with the results of the checks a
+// migration of its type would go through, without migrating the VM.
+func (r *VMMReconciler) checkMigration(ctx context.Context, vm *virtv1alpha1.VirtualMachine, vmm *virtv1alpha1.VirtualMachineMigration) error {
+	vmm.Status.SourceNodeName = vm.Status.NodeName
+
+	migratableConditionType := virtv1alpha1.VirtualMachineLiveMigratable
+	if vmm.Spec.Type == virtv1alpha1.VirtualMachineMigrationOffline {
+		migratableConditionType = virtv1alpha1.VirtualMachineOfflineMigratable
+	}
+	migratableCondition := conditions.Get(vm.Status.Conditions, string(migratableConditionType))
+	if migratableCondition == nil {
+		return fmt.Errorf("VM %s condition is unknown", migratableConditionType)
+	}
+	if migratableCondition.Status != metav1.ConditionTrue {
+		conditions.MarkFalse(&vmm.Status.Conditions, string(virtv1alpha1.VirtualMachineMigrationMigratable), migratableCondition.Reason, "%s", migratableCondition.Message)
+		vmm.Status.Phase = virtv1alpha1.VirtualMachineMigrationFailed
+		return nil
+	}
+	if vm.Status.Phase != virtv1alpha1.VirtualMachineRunning {
+		conditions.MarkFalse(&vmm.Status.Conditions, string(virtv1alpha1.VirtualMachineMigrationMigratable), conditions.ReasonVMNotRunning, "VM is %s", vm.Status.Phase)
+		vmm.Status.Phase = virtv1alpha1.VirtualMachineMigrationFailed
+		return nil
+	}
+	if vm.Status.Migration != nil {
+		conditions.MarkFalse(&vmm.Status.Conditions, string(virtv1alpha1.VirtualMachineMigrationMigratable), conditions.ReasonMigrationInProgress, "VM is being migrated")
+		vmm.Status.Phase = virtv1alpha1.VirtualMachineMigrationFailed
+		return nil
+	}
+	conditions.MarkTrue(&vmm.Status.Conditions, string(virtv1alpha1.VirtualMachineMigrationMigratable), migratableCondition.Reason)
+
+	feasible, err := r.reconcileTargetFeasibleCondition(ctx, vm, vmm)
+	if err != nil {
+		return err
+	}
+	if !feasible {
+		vmm.Status.Phase = virtv1alpha1.VirtualMachineMigrationFailed
+		return nil
+	}
+	vmm.Status.Phase = virtv1alpha1.VirtualMachineMigrationSucceeded
+	return nil
+}
+
+// switchMigratedVolumes makes the VM use the PVCs copied to the target node.
+// The source PVCs are retained, and the target PVCs are released from the VM,
+// so that they are managed by users from now on.
+func (r *VMMReconciler) switchMigratedVolumes(ctx context.Context, vm *virtv1alpha1.VirtualMachine) error {
+	if len(vm.Status.Migration.Volumes) == 0 {
+		return nil
+	}
+
+	for _, migrationVolume := range vm.Status.Migration.Volumes {
+		var pvc corev1.PersistentVolumeClaim
+		pvcKey := types.NamespacedName{
+			Name:      migrationVolume.TargetClaimName,
+			Namespace: vm.Namespace,
+		}
+		if err := r.Client.Get(ctx, pvcKey, &pvc); err != nil {
+			return fmt.Errorf("get target PVC: %s", err)
+		}
+
+		if len(pvc.OwnerReferences) > 0 {
+			pvc.OwnerReferences = nil
+			if err := r.Client.Update(ctx, &pvc); err != nil {
+				return fmt.Errorf("release target PVC: %s", err)
+			}
+		}
+
+		for i := range vm.Spec.Volumes {
+			if vm.Spec.Volumes[i].Name == migrationVolume.Name && vm.Spec.Volumes[i].PersistentVolumeClaim != nil {
+				vm.Spec.Volumes[i].PersistentVolumeClaim.ClaimName = migrationVolume.TargetClaimName
+			}
+		}
+	}
+
+	status := vm.Status.DeepCopy()
+	if err := r.Client.Update(ctx, vm); err != nil {
+		return fmt.Errorf("update VM volumes: %s", err)
+	}
+	vm.Status = *status
+	return nil
+}
+
+func (r *VMMReconciler) deleteMigrationTargetPVCs(ctx context.Context, vm *virtv1alpha1.VirtualMachine) error {
+	for _, migrationVolume := range vm.Status.Migration.Volumes {
+		pvc := corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      migrationVolume.TargetClaimName,
+				Namespace: vm.Namespace,
+			},
+		}
+		if err := r.Client.Delete(ctx, &pvc); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("delete target PVC: %s", err)
+		}
+		r.Recorder.Eventf(vm, corev1.EventTypeNormal, "DeletedTargetPVC", "Deleted target PVC %q", pvc.Name)
+	}
+	return nil
+}
+
+// cleanUpFinishedVMMs deletes the finished VMM once its TTL expires, and the
+// oldest finished VMMs of its VM beyond the history limit of the Virtink
+// config. It returns when the TTL of the VMM expires.
+func (r *VMMReconciler) cleanUpFinishedVMMs(ctx context.Context, vmm *virtv1alpha1.VirtualMachineMigration) (time.Duration, error) {
+	// the VM must be reset from its migration before the VMM is gone
+	var vm virtv1alpha1.VirtualMachine
+	var migrationUID types.UID
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: vmm.Namespace, Name: vmm.Spec.VMName}, &vm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return 0, fmt.Errorf("get VM: %s", err)
+		}
+	} else if vm.Status.Migration != nil {
+		migrationUID = vm.Status.Migration.UID
+	}
+	if migrationUID == vmm.UID {
+		return 5 * time.Second, nil
+	}
+
+	config, err := virtinkconfig.Get(ctx, r.Client)
+	if err != nil {
+		return 0, fmt.Errorf("get Virtink config: %s", err)
+	}
+
+	if historyLimit := config.Spec.Migration.HistoryLimit; historyLimit > 0 {
+		var vmmList virtv1alpha1.VirtualMachineMigrationList
+		if err := r.Client.List(ctx, &vmmList, client.InNamespace(vmm.Namespace)); err != nil {
+			return 0, fmt.Errorf("list VMMs: %s", err)
+		}
+
+		var finishedVMMs []*virtv1alpha1.VirtualMachineMigration
+		for i := range vmmList.Items {
+			otherVMM := &vmmList.Items[i]
+			if otherVMM.Spec.VMName == vmm.Spec.VMName && otherVMM.Status.CompletionTime != nil && otherVMM.DeletionTimestamp.IsZero() && otherVMM.UID != migrationUID {
+				finishedVMMs = append(finishedVMMs, otherVMM)
+			}
+		}
+		sort.Slice(finishedVMMs, func(i, j int) bool {
+			if !finishedVMMs[i].Status.CompletionTime.Equal(finishedVMMs[j].Status.CompletionTime) {
+				return finishedVMMs[j].Status.CompletionTime.Before(finishedVMMs[i].Status.CompletionTime)
+			}
+			return finishedVMMs[i].Name < finishedVMMs[j].Name
+		})
+
+		deleted := false
+		for i := historyLimit; i < len(finishedVMMs); i++ {
+			if err := r.Client.Delete(ctx, finishedVMMs[i]); client.IgnoreNotFound(err) != nil {
+				return 0, fmt.Errorf("delete VMM %q: %s", finishedVMMs[i].Name, err)
+			}
+			deleted = deleted || finishedVMMs[i].UID == vmm.UID
+		}
+		if deleted {
+			return 0, nil
+		}
+	}
+
+	ttl := vmm.Spec.TTLSecondsAfterFinished
+	if ttl == nil {
+		ttl = config.Spec.Migration.TTLSecondsAfterFinished
+	}
+	if ttl == nil {
+		return 0, nil
+	}
+	if remaining := time.Until(vmm.Status.CompletionTime.Add(time.Duration(*ttl) * time.Second)); remaining > 0 {
+		return remaining, nil
+	}
+	if err := r.Client.Delete(ctx, vmm); client.IgnoreNotFound(err) != nil {
+		return 0, fmt.Errorf("delete VMM: %s", err)
+	}
+	return 0, nil
+}
+
+// isMigrationQueued returns whether starting to migrate the VM would exceed
+// the limits of parallel migrations in the Virtink config.
+func (r *VMMReconciler) isMigrationQueued(ctx context.Context, vm *virtv1alpha1.VirtualMachine) (bool, error) {
+	config, err := virtinkconfig.Get(ctx, r.Client)
+	if err != nil {
+		return false, fmt.Errorf("get Virtink config: %s", err)
+	}
+	migrationConfig := config.Spec.Migration
+	if migrationConfig.ParallelMigrationsPerCluster == 0 && migrationConfig.ParallelOutboundMigrationsPerNode == 0 {
+		return false, nil
+	}
+
+	var vmList virtv1alpha1.VirtualMachineList
+	if err := r.Client.List(ctx, &vmList); err != nil {
+		return false, fmt.Errorf("list VMs: %s", err)
+	}
+
+	var clusterMigrations, nodeMigrations int
+	for _, otherVM := range vmList.Items {
+		if otherVM.Status.Migration == nil ||
+			otherVM.Status.Migration.Phase == virtv1alpha1.VirtualMachineMigrationSucceeded ||
+			otherVM.Status.Migration.Phase == virtv1alpha1.VirtualMachineMigrationFailed {
+			continue
+		}
+		clusterMigrations++
+		if otherVM.Status.NodeName == vm.Status.NodeName {
+			nodeMigrations++
+		}
+	}
+
+	if migrationConfig.ParallelMigrationsPerCluster > 0 && clusterMigrations >= migrationConfig.ParallelMigrationsPerCluster {
+		return true, nil
+	}
+	if migrationConfig.ParallelOutboundMigrationsPerNode > 0 && nodeMigrations >= migrationConfig.ParallelOutboundMigrationsPerNode {
+		return true, nil
+	}
+	return false, nil
+}
+
 func (r *VMMReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &virtv1alpha1.VirtualMachineMigration{}, ".metadata.uid", func(obj client.Object) []string {
 		vmm := obj.(*virtv1alpha1.VirtualMachineMigration)
@@ -120,8 +456,8 @@ func (r *VMMReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&virtv1alpha1.VirtualMachineMigration{}).
-		Watches(&source.Kind{Type: &virtv1alpha1.VirtualMachine{}},
-			handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+		Watches(&source.Kind{Type: &virtv1alpha1.VirtualMachine{}}, &batchingEventHandler{
+			EventHandler: handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
 				vm := obj.(*virtv1alpha1.VirtualMachine)
 				if vm.Status.Migration == nil || vm.Status.Migration.UID == "" {
 					return nil
@@ -142,6 +478,12 @@ func (r *VMMReconciler) SetupWithManager(mgr ctrl.Manager) error {
 					})
 				}
 				return requests
-			})).
+			}),
+			BatchPeriod: r.BatchPeriod,
+		}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+			RateLimiter:             r.RateLimiter,
+		}).
 		Complete(r)
 }
@@ -0,0 +1,216 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/conditions"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+func TestCleanUpFinishedVMMs(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(virtv1beta1.AddToScheme(scheme))
+
+	now := time.Now()
+	newVMM := func(name string, age time.Duration) *virtv1alpha1.VirtualMachineMigration {
+		completionTime := metav1.NewTime(now.Add(-age))
+		return &virtv1alpha1.VirtualMachineMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				UID:       types.UID(name),
+			},
+			Spec: virtv1alpha1.VirtualMachineMigrationSpec{
+				VMName: "ubuntu",
+			},
+			Status: virtv1alpha1.VirtualMachineMigrationStatus{
+				Phase:          virtv1alpha1.VirtualMachineMigrationSucceeded,
+				CompletionTime: &completionTime,
+			},
+		}
+	}
+
+	ttl := int32(3600)
+	historyLimit := 2
+	config := &virtv1beta1.VirtinkConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: virtinkconfig.Name,
+		},
+		Spec: virtv1beta1.VirtinkConfigSpec{
+			Migration: virtv1beta1.VirtinkConfigMigration{
+				TTLSecondsAfterFinished: &ttl,
+				HistoryLimit:            historyLimit,
+			},
+		},
+	}
+	vm := &virtv1alpha1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ubuntu",
+			Namespace: "default",
+		},
+		Status: virtv1alpha1.VirtualMachineStatus{
+			Migration: &virtv1alpha1.VirtualMachineStatusMigration{
+				UID:   "current",
+				Phase: virtv1alpha1.VirtualMachineMigrationSucceeded,
+			},
+		},
+	}
+	current := newVMM("current", 0)
+	recent := newVMM("recent", time.Minute)
+	old := newVMM("old", 10*time.Minute)
+	oldest := newVMM("oldest", 20*time.Minute)
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(config, vm, current, recent, old, oldest).Build()
+	r := &VMMReconciler{
+		Client:   c,
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(100),
+	}
+
+	// the VM is not reset from the current VMM yet
+	requeueAfter, err := r.cleanUpFinishedVMMs(context.Background(), current)
+	require.NoError(t, err)
+	assert.NotZero(t, requeueAfter)
+	for _, vmm := range []*virtv1alpha1.VirtualMachineMigration{current, recent, old, oldest} {
+		assert.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(vmm), &virtv1alpha1.VirtualMachineMigration{}))
+	}
+
+	vm.Status.Migration = nil
+	require.NoError(t, c.Update(context.Background(), vm))
+	requeueAfter, err = r.cleanUpFinishedVMMs(context.Background(), current)
+	require.NoError(t, err)
+	assert.InDelta(t, time.Hour, requeueAfter, float64(time.Minute))
+	for _, vmm := range []*virtv1alpha1.VirtualMachineMigration{current, recent} {
+		assert.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(vmm), &virtv1alpha1.VirtualMachineMigration{}))
+	}
+	for _, vmm := range []*virtv1alpha1.VirtualMachineMigration{old, oldest} {
+		err := c.Get(context.Background(), client.ObjectKeyFromObject(vmm), &virtv1alpha1.VirtualMachineMigration{})
+		assert.True(t, apierrors.IsNotFound(err), vmm.Name)
+	}
+
+	expiredTTL := int32(30)
+	recent.Spec.TTLSecondsAfterFinished = &expiredTTL
+	requeueAfter, err = r.cleanUpFinishedVMMs(context.Background(), recent)
+	require.NoError(t, err)
+	assert.Zero(t, requeueAfter)
+	err = c.Get(context.Background(), client.ObjectKeyFromObject(recent), &virtv1alpha1.VirtualMachineMigration{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestCheckMigration(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(virtv1beta1.AddToScheme(scheme))
+
+	newNode := func(name string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{
+					Type:   corev1.NodeReady,
+					Status: corev1.ConditionTrue,
+				}},
+			},
+		}
+	}
+	vm := &virtv1alpha1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ubuntu",
+			Namespace: "default",
+		},
+		Status: virtv1alpha1.VirtualMachineStatus{
+			Phase:    virtv1alpha1.VirtualMachineRunning,
+			NodeName: "node-0",
+			Conditions: []metav1.Condition{{
+				Type:   string(virtv1alpha1.VirtualMachineLiveMigratable),
+				Status: metav1.ConditionTrue,
+				Reason: conditions.ReasonMigratable,
+			}, {
+				Type:    string(virtv1alpha1.VirtualMachineOfflineMigratable),
+				Status:  metav1.ConditionFalse,
+				Reason:  conditions.ReasonDeviceNotMigratable,
+				Message: "migration is disabled when VM has a virtio-fs file system",
+			}},
+		},
+	}
+
+	tests := []struct {
+		vmm       *virtv1alpha1.VirtualMachineMigration
+		nodes     []client.Object
+		phase     virtv1alpha1.VirtualMachineMigrationPhase
+		condition string
+		reason    string
+	}{{
+		vmm: &virtv1alpha1.VirtualMachineMigration{
+			Spec: virtv1alpha1.VirtualMachineMigrationSpec{
+				VMName: "ubuntu",
+				DryRun: true,
+			},
+		},
+		nodes:     []client.Object{newNode("node-0"), newNode("node-1")},
+		phase:     virtv1alpha1.VirtualMachineMigrationSucceeded,
+		condition: string(virtv1alpha1.VirtualMachineMigrationTargetFeasible),
+		reason:    conditions.ReasonFeasibleTargetFound,
+	}, {
+		vmm: &virtv1alpha1.VirtualMachineMigration{
+			Spec: virtv1alpha1.VirtualMachineMigrationSpec{
+				VMName: "ubuntu",
+				DryRun: true,
+			},
+		},
+		nodes:     []client.Object{newNode("node-0")},
+		phase:     virtv1alpha1.VirtualMachineMigrationFailed,
+		condition: string(virtv1alpha1.VirtualMachineMigrationTargetFeasible),
+		reason:    conditions.ReasonNoFeasibleTarget,
+	}, {
+		vmm: &virtv1alpha1.VirtualMachineMigration{
+			Spec: virtv1alpha1.VirtualMachineMigrationSpec{
+				VMName: "ubuntu",
+				Type:   virtv1alpha1.VirtualMachineMigrationOffline,
+				DryRun: true,
+			},
+		},
+		nodes:     []client.Object{newNode("node-0"), newNode("node-1")},
+		phase:     virtv1alpha1.VirtualMachineMigrationFailed,
+		condition: string(virtv1alpha1.VirtualMachineMigrationMigratable),
+		reason:    conditions.ReasonDeviceNotMigratable,
+	}}
+
+	for _, tc := range tests {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.nodes...).Build()
+		r := &VMMReconciler{
+			Client:   c,
+			Scheme:   scheme,
+			Recorder: record.NewFakeRecorder(100),
+		}
+		vmm := tc.vmm.DeepCopy()
+		require.NoError(t, r.checkMigration(context.Background(), vm.DeepCopy(), vmm))
+		assert.Equal(t, tc.phase, vmm.Status.Phase)
+		assert.Equal(t, "node-0", vmm.Status.SourceNodeName)
+		condition := conditions.Get(vmm.Status.Conditions, tc.condition)
+		require.NotNil(t, condition, tc.condition)
+		assert.Equal(t, tc.reason, condition.Reason)
+	}
+}
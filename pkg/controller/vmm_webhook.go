@@ -10,12 +10,14 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
 )
 
 // +kubebuilder:webhook:path=/validate-v1alpha1-virtualmachinemigration,mutating=false,failurePolicy=fail,sideEffects=None,groups=virt.virtink.smartx.com,resources=virtualmachinemigrations,verbs=create;update,versions=v1alpha1,name=validate.virtualmachinemigration.v1alpha1.virt.virtink.smartx.com,admissionReviewVersions={v1,v1beta1}
@@ -35,6 +37,7 @@ func (h *VMMValidator) InjectDecoder(decoder *admission.Decoder) error {
 
 // +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list
 // +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines/status,verbs=get
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtinkconfigs,verbs=get;list;watch
 
 func (h *VMMValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
 	var vmm virtv1alpha1.VirtualMachineMigration
@@ -45,6 +48,13 @@ func (h *VMMValidator) Handle(ctx context.Context, req admission.Request) admiss
 	var errs field.ErrorList
 	switch req.Operation {
 	case admissionv1.Create:
+		config, err := virtinkconfig.Get(ctx, h.Client)
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, fmt.Errorf("get Virtink config: %s", err))
+		}
+		if !virtinkconfig.FeatureGateEnabled(config, virtinkconfig.LiveMigration) {
+			return webhook.Denied(fmt.Sprintf("feature gate %s is disabled", virtinkconfig.LiveMigration))
+		}
 		errs = ValidateVMM(ctx, h.Client, &vmm, nil)
 	case admissionv1.Update:
 		var oldVMM virtv1alpha1.VirtualMachineMigration
@@ -83,11 +93,21 @@ func ValidateVMMSpec(ctx context.Context, c client.Client, namespace string, spe
 		errs = append(errs, field.Required(fieldPath, ""))
 		return errs
 	}
-	errs = append(errs, ValidateVMName(ctx, c, namespace, spec.VMName, fieldPath.Child("vmName"))...)
+
+	migratableConditionType := virtv1alpha1.VirtualMachineLiveMigratable
+	if spec.Type == virtv1alpha1.VirtualMachineMigrationOffline {
+		migratableConditionType = virtv1alpha1.VirtualMachineOfflineMigratable
+	}
+	// a dry run reports why the VM can't be migrated instead
+	if spec.DryRun {
+		migratableConditionType = ""
+	}
+	errs = append(errs, ValidateVMName(ctx, c, namespace, spec.VMName, migratableConditionType, fieldPath.Child("vmName"))...)
+	errs = append(errs, metav1validation.ValidateLabels(spec.NodeSelector, fieldPath.Child("nodeSelector"))...)
 	return errs
 }
 
-func ValidateVMName(ctx context.Context, c client.Client, namespace string, vmName string, fieldPath *field.Path) field.ErrorList {
+func ValidateVMName(ctx context.Context, c client.Client, namespace string, vmName string, migratableConditionType virtv1alpha1.VirtualMachineConditionType, fieldPath *field.Path) field.ErrorList {
 	var errs field.ErrorList
 	if vmName == "" {
 		errs = append(errs, field.Required(fieldPath, ""))
@@ -105,7 +125,11 @@ func ValidateVMName(ctx context.Context, c client.Client, namespace string, vmNa
 		return errs
 	}
 
-	migratableCondition := meta.FindStatusCondition(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineMigratable))
+	if migratableConditionType == "" {
+		return errs
+	}
+
+	migratableCondition := meta.FindStatusCondition(vm.Status.Conditions, string(migratableConditionType))
 	if migratableCondition == nil {
 		errs = append(errs, field.Forbidden(fieldPath, "VM migratable condition status is unknown"))
 		return errs
@@ -31,7 +31,7 @@ func TestValidateVMM(t *testing.T) {
 		},
 		Status: virtv1alpha1.VirtualMachineStatus{
 			Conditions: []metav1.Condition{{
-				Type:   string(virtv1alpha1.VirtualMachineMigratable),
+				Type:   string(virtv1alpha1.VirtualMachineLiveMigratable),
 				Status: metav1.ConditionTrue,
 			}},
 		},
@@ -68,6 +68,56 @@ func TestValidateVMM(t *testing.T) {
 			return vm
 		}(),
 		invalidDetail: "VM migratable condition status is unknown",
+	}, {
+		vmm: func() *virtv1alpha1.VirtualMachineMigration {
+			vmm := validVMM.DeepCopy()
+			vmm.Spec.Type = virtv1alpha1.VirtualMachineMigrationOffline
+			return vmm
+		}(),
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Status.Conditions[0].Status = metav1.ConditionFalse
+			vm.Status.Conditions = append(vm.Status.Conditions, metav1.Condition{
+				Type:   string(virtv1alpha1.VirtualMachineOfflineMigratable),
+				Status: metav1.ConditionTrue,
+			})
+			return vm
+		}(),
+	}, {
+		vmm: func() *virtv1alpha1.VirtualMachineMigration {
+			vmm := validVMM.DeepCopy()
+			vmm.Spec.Type = virtv1alpha1.VirtualMachineMigrationOffline
+			return vmm
+		}(),
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Status.Conditions = append(vm.Status.Conditions, metav1.Condition{
+				Type:    string(virtv1alpha1.VirtualMachineOfflineMigratable),
+				Status:  metav1.ConditionFalse,
+				Message: "migration is disabled when VM has a SR-IOV interface",
+			})
+			return vm
+		}(),
+		invalidDetail: "migration is disabled when VM has a SR-IOV interface",
+	}, {
+		vmm: func() *virtv1alpha1.VirtualMachineMigration {
+			vmm := validVMM.DeepCopy()
+			vmm.Spec.NodeSelector = map[string]string{"zone": "a b"}
+			return vmm
+		}(),
+		vm:            validVM,
+		invalidDetail: "a valid label must be",
+	}, {
+		vmm: func() *virtv1alpha1.VirtualMachineMigration {
+			vmm := validVMM.DeepCopy()
+			vmm.Spec.DryRun = true
+			return vmm
+		}(),
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := validVM.DeepCopy()
+			vm.Status.Conditions[0].Status = metav1.ConditionFalse
+			return vm
+		}(),
 	}}
 
 	for _, tc := range tests {
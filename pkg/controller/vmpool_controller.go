@@ -0,0 +1,343 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+const (
+	// vmPoolLabel is set on the VMs, VM pods and PVCs of a pool, and selects
+	// the VM pods for the headless Service of the pool.
+	vmPoolLabel = "virtink.io/pool"
+	// vmPoolOrdinalLabel records the ordinal of a VM in its pool.
+	vmPoolOrdinalLabel = "virtink.io/pool-ordinal"
+)
+
+// VMPoolReconciler keeps the VMs of VirtualMachinePools. Like a StatefulSet
+// with the Parallel pod management policy and the OnDelete update strategy,
+// missing VMs are created and VMs beyond the replicas are deleted all at
+// once, and existing VMs are never updated.
+type VMPoolReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	RateLimiter ratelimiter.RateLimiter
+}
+
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinepools,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinepools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtinknamespaceconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
+
+func (r *VMPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var vmPool virtv1beta1.VirtualMachinePool
+	if err := r.Get(ctx, req.NamespacedName, &vmPool); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	status := vmPool.Status.DeepCopy()
+	if err := r.reconcile(ctx, &vmPool); err != nil {
+		r.Recorder.Eventf(&vmPool, corev1.EventTypeWarning, "FailedReconcile", "Failed to reconcile VM pool: %s", err)
+		return ctrl.Result{}, err
+	}
+
+	if !reflect.DeepEqual(vmPool.Status, status) {
+		if err := r.Status().Update(ctx, &vmPool); err != nil {
+			if apierrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("update VM pool status: %s", err)
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *VMPoolReconciler) reconcile(ctx context.Context, vmPool *virtv1beta1.VirtualMachinePool) error {
+	if vmPool.DeletionTimestamp != nil && !vmPool.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	serviceName := getVMPoolServiceName(vmPool)
+	if err := r.reconcileService(ctx, vmPool, serviceName); err != nil {
+		return err
+	}
+
+	var vmList virtv1alpha1.VirtualMachineList
+	if err := r.List(ctx, &vmList, client.InNamespace(vmPool.Namespace), client.MatchingLabels{vmPoolLabel: vmPool.Name}); err != nil {
+		return fmt.Errorf("list VMs: %s", err)
+	}
+
+	replicas := 1
+	if vmPool.Spec.Replicas != nil {
+		replicas = int(*vmPool.Spec.Replicas)
+	}
+
+	vms := map[int]*virtv1alpha1.VirtualMachine{}
+	var excessVMs []*virtv1alpha1.VirtualMachine
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+		if !metav1.IsControlledBy(vm, vmPool) {
+			continue
+		}
+		ordinal, ok := getVMPoolOrdinal(vmPool, vm.Name)
+		if !ok {
+			continue
+		}
+		if ordinal >= replicas {
+			excessVMs = append(excessVMs, vm)
+			continue
+		}
+		vms[ordinal] = vm
+	}
+
+	sort.Slice(excessVMs, func(i, j int) bool {
+		ordinalI, _ := getVMPoolOrdinal(vmPool, excessVMs[i].Name)
+		ordinalJ, _ := getVMPoolOrdinal(vmPool, excessVMs[j].Name)
+		return ordinalI > ordinalJ
+	})
+	for _, vm := range excessVMs {
+		if vm.DeletionTimestamp != nil && !vm.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := r.Delete(ctx, vm); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("delete VM %q: %s", vm.Name, err)
+		}
+		r.Recorder.Eventf(vmPool, corev1.EventTypeNormal, "DeletedVM", "Deleted VM %q", vm.Name)
+	}
+
+	for ordinal := 0; ordinal < replicas; ordinal++ {
+		if _, ok := vms[ordinal]; ok {
+			continue
+		}
+		vm, err := r.createVM(ctx, vmPool, ordinal, serviceName)
+		if err != nil {
+			return err
+		}
+		if vm != nil {
+			vms[ordinal] = vm
+		}
+	}
+
+	vmPool.Status.ObservedGeneration = vmPool.Generation
+	vmPool.Status.ServiceName = serviceName
+	vmPool.Status.Selector = labels.SelectorFromSet(labels.Set{vmPoolLabel: vmPool.Name}).String()
+	vmPool.Status.Replicas = int32(len(vms))
+	vmPool.Status.ReadyReplicas = 0
+	for _, vm := range vms {
+		if meta.IsStatusConditionTrue(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineReady)) {
+			vmPool.Status.ReadyReplicas++
+		}
+	}
+	return nil
+}
+
+// reconcileService creates the headless Service of the pool. A Service of the
+// same name that already exists is left as it is, so that users may manage it.
+func (r *VMPoolReconciler) reconcileService(ctx context.Context, vmPool *virtv1beta1.VirtualMachinePool, serviceName string) error {
+	var service corev1.Service
+	serviceKey := types.NamespacedName{
+		Name:      serviceName,
+		Namespace: vmPool.Namespace,
+	}
+	if err := r.Get(ctx, serviceKey, &service); err == nil {
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("get service: %s", err)
+	}
+
+	service = corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceName,
+			Namespace: vmPool.Namespace,
+			Labels:    map[string]string{vmPoolLabel: vmPool.Name},
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector:  map[string]string{vmPoolLabel: vmPool.Name},
+			// VMs of clustered workloads usually look each other up
+			// before they are ready
+			PublishNotReadyAddresses: true,
+		},
+	}
+	if err := controllerutil.SetControllerReference(vmPool, &service, r.Scheme); err != nil {
+		return fmt.Errorf("set service controller reference: %s", err)
+	}
+	if err := r.Create(ctx, &service); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil
+		}
+		return fmt.Errorf("create service: %s", err)
+	}
+	r.Recorder.Eventf(vmPool, corev1.EventTypeNormal, "CreatedService", "Created service %q", serviceName)
+	return nil
+}
+
+// createVM creates the VM of the ordinal and its PVCs, and returns the VM, or
+// nil if a VM of the same name exists, e.g. a deleting one.
+func (r *VMPoolReconciler) createVM(ctx context.Context, vmPool *virtv1beta1.VirtualMachinePool, ordinal int, serviceName string) (*virtv1alpha1.VirtualMachine, error) {
+	vm, err := buildVMPoolVM(vmPool, ordinal, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("build VM: %s", err)
+	}
+
+	namespaceConfig, err := virtinkconfig.GetNamespaceConfig(ctx, r.Client, vmPool.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("get Virtink namespace config: %s", err)
+	}
+	for _, pvc := range buildVMPoolPVCs(vmPool, vm.Name) {
+		if pvc.Spec.StorageClassName == nil {
+			pvc.Spec.StorageClassName = namespaceConfig.Spec.Storage.DefaultStorageClassName
+		}
+		if err := r.Create(ctx, pvc); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				continue
+			}
+			return nil, fmt.Errorf("create PVC %q: %s", pvc.Name, err)
+		}
+		r.Recorder.Eventf(vmPool, corev1.EventTypeNormal, "CreatedPVC", "Created PVC %q for VM %q", pvc.Name, vm.Name)
+	}
+
+	if err := controllerutil.SetControllerReference(vmPool, vm, r.Scheme); err != nil {
+		return nil, fmt.Errorf("set VM controller reference: %s", err)
+	}
+	if err := r.Create(ctx, vm); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("create VM %q: %s", vm.Name, err)
+	}
+	r.Recorder.Eventf(vmPool, corev1.EventTypeNormal, "CreatedVM", "Created VM %q", vm.Name)
+	return vm, nil
+}
+
+func getVMPoolServiceName(vmPool *virtv1beta1.VirtualMachinePool) string {
+	if vmPool.Spec.ServiceName != "" {
+		return vmPool.Spec.ServiceName
+	}
+	return vmPool.Name
+}
+
+func getVMPoolVMName(vmPool *virtv1beta1.VirtualMachinePool, ordinal int) string {
+	return fmt.Sprintf("%s-%d", vmPool.Name, ordinal)
+}
+
+// getVMPoolOrdinal parses the ordinal from the name of a VM of the pool.
+func getVMPoolOrdinal(vmPool *virtv1beta1.VirtualMachinePool, vmName string) (int, bool) {
+	suffix := strings.TrimPrefix(vmName, vmPool.Name+"-")
+	if suffix == vmName {
+		return 0, false
+	}
+	ordinal, err := strconv.Atoi(suffix)
+	if err != nil || ordinal < 0 || strconv.Itoa(ordinal) != suffix {
+		return 0, false
+	}
+	return ordinal, true
+}
+
+// buildVMPoolVM builds the VM of the ordinal from the template of the pool.
+// The VM is given its name as hostname and the Service as subdomain, and the
+// PVCs of the volume claim templates for its persistentVolumeClaim volumes.
+func buildVMPoolVM(vmPool *virtv1beta1.VirtualMachinePool, ordinal int, serviceName string) (*virtv1alpha1.VirtualMachine, error) {
+	name := getVMPoolVMName(vmPool, ordinal)
+	template := vmPool.Spec.Template.DeepCopy()
+
+	vm := virtv1beta1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   vmPool.Namespace,
+			Labels:      template.Labels,
+			Annotations: template.Annotations,
+		},
+		Spec: template.Spec,
+	}
+	if vm.Labels == nil {
+		vm.Labels = map[string]string{}
+	}
+	vm.Labels[vmPoolLabel] = vmPool.Name
+	vm.Labels[vmPoolOrdinalLabel] = strconv.Itoa(ordinal)
+
+	vm.Spec.Hostname = name
+	vm.Spec.Subdomain = serviceName
+
+	claimTemplates := map[string]bool{}
+	for _, claimTemplate := range vmPool.Spec.VolumeClaimTemplates {
+		claimTemplates[claimTemplate.Name] = true
+	}
+	for i := range vm.Spec.Volumes {
+		source := vm.Spec.Volumes[i].PersistentVolumeClaim
+		if source != nil && claimTemplates[source.ClaimName] {
+			source.ClaimName = getVMPoolPVCName(source.ClaimName, name)
+		}
+	}
+
+	var alphaVM virtv1alpha1.VirtualMachine
+	if err := alphaVM.ConvertFrom(&vm); err != nil {
+		return nil, err
+	}
+	return &alphaVM, nil
+}
+
+func getVMPoolPVCName(claimTemplateName string, vmName string) string {
+	return fmt.Sprintf("%s-%s", claimTemplateName, vmName)
+}
+
+// buildVMPoolPVCs builds the PVCs of the volume claim templates for the VM.
+// They are not owned by the pool, so that data is kept when the pool is
+// scaled down or deleted.
+func buildVMPoolPVCs(vmPool *virtv1beta1.VirtualMachinePool, vmName string) []*corev1.PersistentVolumeClaim {
+	var pvcs []*corev1.PersistentVolumeClaim
+	for _, claimTemplate := range vmPool.Spec.VolumeClaimTemplates {
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        getVMPoolPVCName(claimTemplate.Name, vmName),
+				Namespace:   vmPool.Namespace,
+				Labels:      map[string]string{},
+				Annotations: claimTemplate.Annotations,
+			},
+			Spec: *claimTemplate.Spec.DeepCopy(),
+		}
+		for k, v := range claimTemplate.Labels {
+			pvc.Labels[k] = v
+		}
+		pvc.Labels[vmPoolLabel] = vmPool.Name
+		pvcs = append(pvcs, pvc)
+	}
+	return pvcs
+}
+
+func (r *VMPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&virtv1beta1.VirtualMachinePool{}).
+		Owns(&virtv1alpha1.VirtualMachine{}).
+		Owns(&corev1.Service{}).
+		WithOptions(controller.Options{
+			RateLimiter: r.RateLimiter,
+		}).
+		Complete(r)
+}
@@ -0,0 +1,170 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+func TestVMPoolReconciler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(virtv1beta1.AddToScheme(scheme))
+
+	replicas := int32(2)
+	isController := true
+	vmPool := &virtv1beta1.VirtualMachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "etcd",
+			Namespace: "default",
+			UID:       "pool-uid",
+		},
+		Spec: virtv1beta1.VirtualMachinePoolSpec{
+			Replicas: &replicas,
+			Template: virtv1beta1.VirtualMachinePoolTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"app": "etcd"},
+				},
+				Spec: virtv1beta1.VirtualMachineSpec{
+					Volumes: []virtv1beta1.Volume{{
+						Name: "data",
+						VolumeSource: virtv1beta1.VolumeSource{
+							PersistentVolumeClaim: &virtv1beta1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "data",
+							},
+						},
+					}, {
+						Name: "shared",
+						VolumeSource: virtv1beta1.VolumeSource{
+							PersistentVolumeClaim: &virtv1beta1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "shared",
+							},
+						},
+					}},
+				},
+			},
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "data",
+				},
+			}},
+		},
+	}
+	excessVM := &virtv1alpha1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "etcd-2",
+			Namespace: "default",
+			Labels:    map[string]string{vmPoolLabel: "etcd"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: virtv1beta1.SchemeGroupVersion.String(),
+				Kind:       "VirtualMachinePool",
+				Name:       "etcd",
+				UID:        "pool-uid",
+				Controller: &isController,
+			}},
+		},
+	}
+
+	storageClassName := "local-path"
+	namespaceConfig := &virtv1beta1.VirtinkNamespaceConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      virtinkconfig.Name,
+			Namespace: "default",
+		},
+		Spec: virtv1beta1.VirtinkNamespaceConfigSpec{
+			Storage: virtv1beta1.VirtinkNamespaceConfigStorage{
+				DefaultStorageClassName: &storageClassName,
+			},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(vmPool, excessVM, namespaceConfig).Build()
+	r := &VMPoolReconciler{
+		Client:   c,
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(100),
+	}
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(vmPool)})
+	require.NoError(t, err)
+
+	var service corev1.Service
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "etcd"}, &service))
+	assert.Equal(t, corev1.ClusterIPNone, service.Spec.ClusterIP)
+	assert.Equal(t, map[string]string{vmPoolLabel: "etcd"}, service.Spec.Selector)
+
+	for _, name := range []string{"etcd-0", "etcd-1"} {
+		var vm virtv1alpha1.VirtualMachine
+		require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: name}, &vm))
+		assert.Equal(t, "etcd", vm.Labels["app"])
+		assert.Equal(t, "etcd", vm.Labels[vmPoolLabel])
+		assert.Equal(t, name, vm.Spec.Hostname)
+		assert.Equal(t, "etcd", vm.Spec.Subdomain)
+		assert.Equal(t, "data-"+name, vm.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+		assert.Equal(t, "shared", vm.Spec.Volumes[1].PersistentVolumeClaim.ClaimName)
+
+		var pvc corev1.PersistentVolumeClaim
+		require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "data-" + name}, &pvc))
+		assert.Equal(t, &storageClassName, pvc.Spec.StorageClassName)
+	}
+
+	var vm virtv1alpha1.VirtualMachine
+	err = c.Get(context.Background(), client.ObjectKeyFromObject(excessVM), &vm)
+	assert.True(t, apierrors.IsNotFound(err))
+
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(vmPool), vmPool))
+	assert.Equal(t, int32(2), vmPool.Status.Replicas)
+	assert.Equal(t, "etcd", vmPool.Status.ServiceName)
+	assert.Equal(t, vmPoolLabel+"=etcd", vmPool.Status.Selector)
+}
+
+func TestGetVMPoolOrdinal(t *testing.T) {
+	vmPool := &virtv1beta1.VirtualMachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "db",
+		},
+	}
+
+	tests := []struct {
+		vmName  string
+		ordinal int
+		ok      bool
+	}{{
+		vmName:  "db-0",
+		ordinal: 0,
+		ok:      true,
+	}, {
+		vmName:  "db-12",
+		ordinal: 12,
+		ok:      true,
+	}, {
+		vmName: "db-01",
+	}, {
+		vmName: "db-backup-0",
+	}, {
+		vmName: "db",
+	}}
+
+	for _, tc := range tests {
+		ordinal, ok := getVMPoolOrdinal(vmPool, tc.vmName)
+		assert.Equal(t, tc.ok, ok, tc.vmName)
+		assert.Equal(t, tc.ordinal, ordinal, tc.vmName)
+	}
+}
@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// VMQuotaReconciler keeps the usage in the status of VM quotas up to date.
+// Quotas are enforced by the VM validating webhook.
+type VMQuotaReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	RateLimiter ratelimiter.RateLimiter
+	// BatchPeriod delays reconciliations triggered by changes of dependent
+	// objects, so that bursts of changes are handled together.
+	BatchPeriod time.Duration
+}
+
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinequotas,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinequotas/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list;watch
+
+func (r *VMQuotaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var quota virtv1beta1.VirtualMachineQuota
+	if err := r.Get(ctx, req.NamespacedName, &quota); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	used, err := getNamespaceVMQuotaUsage(ctx, r.Client, quota.Namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !equality.Semantic.DeepEqual(quota.Status.Used, *used) {
+		quota.Status.Used = *used
+		if err := r.Status().Update(ctx, &quota); err != nil {
+			if apierrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("update VM quota status: %s", err)
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *VMQuotaReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&virtv1beta1.VirtualMachineQuota{}).
+		Watches(&source.Kind{Type: &virtv1alpha1.VirtualMachine{}}, &batchingEventHandler{
+			EventHandler: handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+				var quotaList virtv1beta1.VirtualMachineQuotaList
+				if err := r.Client.List(context.Background(), &quotaList, client.InNamespace(obj.GetNamespace())); err != nil {
+					return nil
+				}
+
+				var requests []reconcile.Request
+				for _, quota := range quotaList.Items {
+					requests = append(requests, reconcile.Request{
+						NamespacedName: types.NamespacedName{
+							Namespace: quota.Namespace,
+							Name:      quota.Name,
+						},
+					})
+				}
+				return requests
+			}),
+			BatchPeriod: r.BatchPeriod,
+		}).
+		WithOptions(controller.Options{
+			RateLimiter: r.RateLimiter,
+		}).
+		Complete(r)
+}
+
+// getNamespaceVMQuotaUsage sums the quota usage of all VMs in the namespace.
+func getNamespaceVMQuotaUsage(ctx context.Context, c client.Reader, namespace string) (*virtv1beta1.VirtualMachineQuotaResources, error) {
+	var vmList virtv1alpha1.VirtualMachineList
+	if err := c.List(ctx, &vmList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("list VMs: %s", err)
+	}
+
+	var vms, vcpus int64
+	used := &virtv1beta1.VirtualMachineQuotaResources{
+		VirtualMachines: &vms,
+		VCPUs:           &vcpus,
+		Memory:          resource.NewQuantity(0, resource.BinarySI),
+	}
+	for i := range vmList.Items {
+		addVMQuotaUsage(used, &vmList.Items[i])
+	}
+	return used, nil
+}
+
+func addVMQuotaUsage(used *virtv1beta1.VirtualMachineQuotaResources, vm *virtv1alpha1.VirtualMachine) {
+	*used.VirtualMachines++
+	*used.VCPUs += int64(vm.Spec.Instance.CPU.Sockets * vm.Spec.Instance.CPU.CoresPerSocket)
+	used.Memory.Add(vm.Spec.Instance.Memory.Size)
+}
@@ -0,0 +1,301 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/conditions"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+// rollingRestartLabel is set on the VMMs created by a rolling restart to the
+// name of the rolling restart.
+const rollingRestartLabel = "virtink.io/rolling-restart"
+
+// VMRollingRestartReconciler carries out VirtualMachineRollingRestarts. Each
+// selected VM is either live migrated, or power cycled if it can't be, which
+// both move it into a new VM pod built with the current images and spec.
+type VMRollingRestartReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	RateLimiter ratelimiter.RateLimiter
+}
+
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinerollingrestarts,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinerollingrestarts/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinemigrations,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtinkconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
+
+func (r *VMRollingRestartReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var vmrr virtv1beta1.VirtualMachineRollingRestart
+	if err := r.Get(ctx, req.NamespacedName, &vmrr); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	status := vmrr.Status.DeepCopy()
+	if err := r.reconcile(ctx, &vmrr); err != nil {
+		r.Recorder.Eventf(&vmrr, corev1.EventTypeWarning, "FailedReconcile", "Failed to reconcile VM rolling restart: %s", err)
+		return ctrl.Result{}, err
+	}
+
+	if !reflect.DeepEqual(vmrr.Status, status) {
+		if err := r.Status().Update(ctx, &vmrr); err != nil {
+			if apierrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("update VM rolling restart status: %s", err)
+		}
+	}
+
+	if vmrr.Status.Phase == virtv1beta1.VirtualMachineRollingRestartRunning {
+		// VMM failures are only noticed by polling
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+type vmRestartState int
+
+const (
+	// vmNotRunning is the state of VMs that are stopped, which run the new
+	// VM pod once started anyway.
+	vmNotRunning vmRestartState = iota
+	vmRestartPending
+	vmRestarting
+	vmRestarted
+)
+
+func (r *VMRollingRestartReconciler) reconcile(ctx context.Context, vmrr *virtv1beta1.VirtualMachineRollingRestart) error {
+	if vmrr.DeletionTimestamp != nil && !vmrr.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	switch vmrr.Status.Phase {
+	case virtv1beta1.VirtualMachineRollingRestartSucceeded:
+		return nil
+	case "":
+		vmrr.Status.Phase = virtv1beta1.VirtualMachineRollingRestartRunning
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(vmrr.Spec.Selector)
+	if err != nil {
+		return fmt.Errorf("parse selector: %s", err)
+	}
+	var vmList virtv1alpha1.VirtualMachineList
+	if err := r.List(ctx, &vmList, client.InNamespace(vmrr.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return fmt.Errorf("list VMs: %s", err)
+	}
+
+	var vmmList virtv1alpha1.VirtualMachineMigrationList
+	if err := r.List(ctx, &vmmList, client.InNamespace(vmrr.Namespace), client.MatchingLabels{rollingRestartLabel: vmrr.Name}); err != nil {
+		return fmt.Errorf("list VMMs: %s", err)
+	}
+	migratingVMs := map[string]bool{}
+	failedMigrationVMs := map[string]bool{}
+	for _, vmm := range vmmList.Items {
+		switch vmm.Status.Phase {
+		case virtv1alpha1.VirtualMachineMigrationSucceeded:
+		case virtv1alpha1.VirtualMachineMigrationFailed:
+			failedMigrationVMs[vmm.Spec.VMName] = true
+		default:
+			migratingVMs[vmm.Spec.VMName] = true
+		}
+	}
+
+	var pendingVMs []*virtv1alpha1.VirtualMachine
+	var selected, restarting, restarted int
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+		state, err := r.getVMRestartState(ctx, vmrr, vm, migratingVMs[vm.Name])
+		if err != nil {
+			return fmt.Errorf("get restart state of VM %q: %s", vm.Name, err)
+		}
+		switch state {
+		case vmNotRunning:
+			continue
+		case vmRestartPending:
+			pendingVMs = append(pendingVMs, vm)
+		case vmRestarting:
+			restarting++
+		case vmRestarted:
+			restarted++
+		}
+		selected++
+	}
+	vmrr.Status.VirtualMachines = int32(selected)
+	vmrr.Status.RestartedVirtualMachines = int32(restarted)
+
+	if len(pendingVMs) == 0 && restarting == 0 {
+		vmrr.Status.Phase = virtv1beta1.VirtualMachineRollingRestartSucceeded
+		now := metav1.Now()
+		vmrr.Status.CompletionTime = &now
+		r.Recorder.Eventf(vmrr, corev1.EventTypeNormal, "Succeeded", "Restarted %d VMs", restarted)
+		return nil
+	}
+
+	maxUnavailable, err := getRollingRestartMaxUnavailable(vmrr, selected)
+	if err != nil {
+		return err
+	}
+
+	config, err := virtinkconfig.Get(ctx, r.Client)
+	if err != nil {
+		return fmt.Errorf("get Virtink config: %s", err)
+	}
+	liveMigrationEnabled := virtinkconfig.FeatureGateEnabled(config, virtinkconfig.LiveMigration)
+
+	for _, vm := range pendingVMs {
+		if restarting >= maxUnavailable {
+			break
+		}
+		liveMigrate := vmrr.Spec.Strategy != virtv1beta1.RollingRestartStrategyRestart && liveMigrationEnabled && !failedMigrationVMs[vm.Name] &&
+			conditions.IsTrue(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineLiveMigratable))
+		if err := r.restartVM(ctx, vmrr, vm, liveMigrate); err != nil {
+			if apierrors.IsConflict(err) {
+				continue
+			}
+			return fmt.Errorf("restart VM %q: %s", vm.Name, err)
+		}
+		restarting++
+	}
+	return nil
+}
+
+// getVMRestartState returns the state of the VM in the rolling restart. A
+// running VM is restarted once it runs in a VM pod created after the rolling
+// restart.
+func (r *VMRollingRestartReconciler) getVMRestartState(ctx context.Context, vmrr *virtv1beta1.VirtualMachineRollingRestart, vm *virtv1alpha1.VirtualMachine, migrating bool) (vmRestartState, error) {
+	if migrating || vm.Status.Migration != nil || vm.Status.PowerAction != "" {
+		return vmRestarting, nil
+	}
+
+	switch vm.Status.Phase {
+	case "", virtv1alpha1.VirtualMachineSucceeded, virtv1alpha1.VirtualMachineFailed:
+		return vmNotRunning, nil
+	case virtv1alpha1.VirtualMachineRunning:
+	default:
+		return vmRestarting, nil
+	}
+
+	var vmPod corev1.Pod
+	vmPodKey := types.NamespacedName{
+		Name:      vm.Status.VMPodName,
+		Namespace: vm.Namespace,
+	}
+	if err := r.Get(ctx, vmPodKey, &vmPod); err != nil {
+		if apierrors.IsNotFound(err) {
+			return vmRestarting, nil
+		}
+		return 0, fmt.Errorf("get VM pod: %s", err)
+	}
+	if vmPod.CreationTimestamp.Before(&vmrr.CreationTimestamp) {
+		return vmRestartPending, nil
+	}
+	return vmRestarted, nil
+}
+
+// restartVM moves the VM into a new VM pod, by creating a VMM for it if
+// liveMigrate is set, or power cycling it otherwise.
+func (r *VMRollingRestartReconciler) restartVM(ctx context.Context, vmrr *virtv1beta1.VirtualMachineRollingRestart, vm *virtv1alpha1.VirtualMachine, liveMigrate bool) error {
+	if liveMigrate {
+		vmm := virtv1alpha1.VirtualMachineMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    vm.Namespace,
+				GenerateName: vm.Name + "-",
+				Labels:       map[string]string{rollingRestartLabel: vmrr.Name},
+			},
+			Spec: virtv1alpha1.VirtualMachineMigrationSpec{
+				VMName: vm.Name,
+			},
+		}
+		if err := r.Create(ctx, &vmm); err != nil {
+			return fmt.Errorf("create VMM: %s", err)
+		}
+		r.Recorder.Eventf(vm, corev1.EventTypeNormal, "RollingRestart", "Created VMM %q for rolling restart %q", vmm.Name, vmrr.Name)
+		return nil
+	}
+
+	vm.Status.PowerAction = virtv1alpha1.VirtualMachinePowerCycle
+	if err := r.Status().Update(ctx, vm); err != nil {
+		return err
+	}
+	r.Recorder.Eventf(vm, corev1.EventTypeNormal, "RollingRestart", "Power cycling VM for rolling restart %q", vmrr.Name)
+	return nil
+}
+
+// getRollingRestartMaxUnavailable returns the number of the selected VMs that
+// may be restarting at the same time, which is at least 1.
+func getRollingRestartMaxUnavailable(vmrr *virtv1beta1.VirtualMachineRollingRestart, selected int) (int, error) {
+	maxUnavailable := intstr.FromInt(1)
+	if vmrr.Spec.MaxUnavailable != nil {
+		maxUnavailable = *vmrr.Spec.MaxUnavailable
+	}
+	value, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailable, selected, false)
+	if err != nil {
+		return 0, fmt.Errorf("parse max unavailable: %s", err)
+	}
+	if value < 1 {
+		value = 1
+	}
+	return value, nil
+}
+
+func (r *VMRollingRestartReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&virtv1beta1.VirtualMachineRollingRestart{}).
+		Watches(&source.Kind{Type: &virtv1alpha1.VirtualMachine{}}, handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+			vm := obj.(*virtv1alpha1.VirtualMachine)
+			var vmrrList virtv1beta1.VirtualMachineRollingRestartList
+			if err := r.Client.List(context.Background(), &vmrrList, client.InNamespace(vm.Namespace)); err != nil {
+				return nil
+			}
+
+			var requests []reconcile.Request
+			for _, vmrr := range vmrrList.Items {
+				if vmrr.Status.Phase == virtv1beta1.VirtualMachineRollingRestartSucceeded {
+					continue
+				}
+				selector, err := metav1.LabelSelectorAsSelector(vmrr.Spec.Selector)
+				if err != nil || !selector.Matches(labels.Set(vm.Labels)) {
+					continue
+				}
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Namespace: vmrr.Namespace,
+						Name:      vmrr.Name,
+					},
+				})
+			}
+			return requests
+		})).
+		WithOptions(controller.Options{
+			RateLimiter: r.RateLimiter,
+		}).
+		Complete(r)
+}
@@ -0,0 +1,158 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+func TestVMRollingRestartReconciler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(virtv1beta1.AddToScheme(scheme))
+
+	startTime := metav1.NewTime(time.Now().Truncate(time.Second))
+	maxUnavailable := intstr.FromInt(2)
+	vmrr := &virtv1beta1.VirtualMachineRollingRestart{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "upgrade",
+			Namespace:         "default",
+			CreationTimestamp: startTime,
+		},
+		Spec: virtv1beta1.VirtualMachineRollingRestartSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{vmPoolLabel: "etcd"},
+			},
+			MaxUnavailable: &maxUnavailable,
+			Strategy:       virtv1beta1.RollingRestartStrategyLiveMigrateIfPossible,
+		},
+	}
+
+	objs := []client.Object{vmrr}
+	newVM := func(name string, phase virtv1alpha1.VirtualMachinePhase, liveMigratable bool, podCreationTime time.Time) *virtv1alpha1.VirtualMachine {
+		vm := &virtv1alpha1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels:    map[string]string{vmPoolLabel: "etcd"},
+			},
+			Status: virtv1alpha1.VirtualMachineStatus{
+				Phase: phase,
+			},
+		}
+		if liveMigratable {
+			vm.Status.Conditions = []metav1.Condition{{
+				Type:   string(virtv1alpha1.VirtualMachineLiveMigratable),
+				Status: metav1.ConditionTrue,
+			}}
+		}
+		if phase == virtv1alpha1.VirtualMachineRunning {
+			vm.Status.VMPodName = "vm-" + name
+			objs = append(objs, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              vm.Status.VMPodName,
+					Namespace:         "default",
+					CreationTimestamp: metav1.NewTime(podCreationTime),
+				},
+			})
+		}
+		objs = append(objs, vm)
+		return vm
+	}
+	oldPodTime := startTime.Add(-time.Hour)
+	newPodTime := startTime.Add(time.Minute)
+	migratableVM := newVM("etcd-0", virtv1alpha1.VirtualMachineRunning, true, oldPodTime)
+	unmigratableVM := newVM("etcd-1", virtv1alpha1.VirtualMachineRunning, false, oldPodTime)
+	pendingVM := newVM("etcd-2", virtv1alpha1.VirtualMachineRunning, false, oldPodTime)
+	newVM("etcd-3", virtv1alpha1.VirtualMachineRunning, false, newPodTime)
+	newVM("etcd-4", virtv1alpha1.VirtualMachineSucceeded, false, oldPodTime)
+	otherVM := newVM("other", virtv1alpha1.VirtualMachineRunning, false, oldPodTime)
+	otherVM.Labels = nil
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	r := &VMRollingRestartReconciler{
+		Client:   c,
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(100),
+	}
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(vmrr)})
+	require.NoError(t, err)
+
+	var vmmList virtv1alpha1.VirtualMachineMigrationList
+	require.NoError(t, c.List(context.Background(), &vmmList))
+	require.Len(t, vmmList.Items, 1)
+	assert.Equal(t, migratableVM.Name, vmmList.Items[0].Spec.VMName)
+	assert.Equal(t, vmrr.Name, vmmList.Items[0].Labels[rollingRestartLabel])
+
+	var vm virtv1alpha1.VirtualMachine
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(unmigratableVM), &vm))
+	assert.Equal(t, virtv1alpha1.VirtualMachinePowerCycle, vm.Status.PowerAction)
+	for _, vmObj := range []*virtv1alpha1.VirtualMachine{pendingVM, otherVM} {
+		require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(vmObj), &vm))
+		assert.Empty(t, vm.Status.PowerAction)
+	}
+
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(vmrr), vmrr))
+	assert.Equal(t, virtv1beta1.VirtualMachineRollingRestartRunning, vmrr.Status.Phase)
+	assert.Equal(t, int32(4), vmrr.Status.VirtualMachines)
+	assert.Equal(t, int32(1), vmrr.Status.RestartedVirtualMachines)
+
+	// the migrating and power cycling VMs take up maxUnavailable
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(vmrr)})
+	require.NoError(t, err)
+	require.NoError(t, c.List(context.Background(), &vmmList))
+	assert.Len(t, vmmList.Items, 1)
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(pendingVM), &vm))
+	assert.Empty(t, vm.Status.PowerAction)
+}
+
+func TestGetRollingRestartMaxUnavailable(t *testing.T) {
+	tests := []struct {
+		maxUnavailable *intstr.IntOrString
+		selected       int
+		expected       int
+	}{{
+		selected: 10,
+		expected: 1,
+	}, {
+		maxUnavailable: &intstr.IntOrString{Type: intstr.Int, IntVal: 3},
+		selected:       10,
+		expected:       3,
+	}, {
+		maxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "25%"},
+		selected:       10,
+		expected:       2,
+	}, {
+		maxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "25%"},
+		selected:       3,
+		expected:       1,
+	}}
+
+	for _, tc := range tests {
+		vmrr := &virtv1beta1.VirtualMachineRollingRestart{
+			Spec: virtv1beta1.VirtualMachineRollingRestartSpec{
+				MaxUnavailable: tc.maxUnavailable,
+			},
+		}
+		maxUnavailable, err := getRollingRestartMaxUnavailable(vmrr, tc.selected)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, maxUnavailable)
+	}
+}
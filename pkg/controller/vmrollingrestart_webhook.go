@@ -0,0 +1,157 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/smartxworks/virtink/pkg/apis/virt"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// +kubebuilder:webhook:path=/validate-v1beta1-virtualmachinerollingrestart,mutating=false,failurePolicy=fail,sideEffects=None,groups=virt.virtink.smartx.com,resources=virtualmachinerollingrestarts,verbs=create;update,versions=v1beta1,name=validate.virtualmachinerollingrestart.v1beta1.virt.virtink.smartx.com,admissionReviewVersions={v1,v1beta1}
+
+type VMRollingRestartValidator struct {
+	client.Client
+	decoder *admission.Decoder
+}
+
+var _ admission.DecoderInjector = &VMRollingRestartValidator{}
+var _ admission.Handler = &VMRollingRestartValidator{}
+
+func (h *VMRollingRestartValidator) InjectDecoder(decoder *admission.Decoder) error {
+	h.decoder = decoder
+	return nil
+}
+
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+func (h *VMRollingRestartValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	var vmrr virtv1beta1.VirtualMachineRollingRestart
+	if err := h.decoder.Decode(req, &vmrr); err != nil {
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("unmarshal VM rolling restart: %s", err))
+	}
+
+	var errs field.ErrorList
+	switch req.Operation {
+	case admissionv1.Create:
+		errs = ValidateVMRollingRestart(ctx, &vmrr)
+		if len(errs) == 0 {
+			allowed, err := h.isRestartAllowed(ctx, req, &vmrr)
+			if err != nil {
+				return admission.Errored(http.StatusInternalServerError, fmt.Errorf("review access: %s", err))
+			}
+			if !allowed {
+				return webhook.Denied(fmt.Sprintf("user %q may not restart VMs in namespace %q", req.UserInfo.Username, vmrr.Namespace))
+			}
+		}
+	case admissionv1.Update:
+		var oldVMRR virtv1beta1.VirtualMachineRollingRestart
+		if err := h.decoder.DecodeRaw(req.OldObject, &oldVMRR); err != nil {
+			return admission.Errored(http.StatusBadRequest, fmt.Errorf("unmarshal old VM rolling restart: %s", err))
+		}
+
+		errs = ValidateVMRollingRestart(ctx, &vmrr)
+		if !reflect.DeepEqual(vmrr.Spec.Selector, oldVMRR.Spec.Selector) {
+			errs = append(errs, field.Forbidden(field.NewPath("spec").Child("selector"), "may not be updated"))
+		}
+		if vmrr.Spec.Strategy != oldVMRR.Spec.Strategy {
+			errs = append(errs, field.Forbidden(field.NewPath("spec").Child("strategy"), "may not be updated"))
+		}
+	default:
+		return admission.Allowed("")
+	}
+
+	if len(errs) > 0 {
+		return webhook.Denied(errs.ToAggregate().Error())
+	}
+	return admission.Allowed("")
+}
+
+// isRestartAllowed checks whether the requesting user may restart all VMs in
+// the namespace, which is what VMAs of the Restart action require for a VM.
+func (h *VMRollingRestartValidator) isRestartAllowed(ctx context.Context, req admission.Request, vmrr *virtv1beta1.VirtualMachineRollingRestart) (bool, error) {
+	extra := map[string]authorizationv1.ExtraValue{}
+	for k, v := range req.UserInfo.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+	sar := authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   req.UserInfo.Username,
+			UID:    req.UserInfo.UID,
+			Groups: req.UserInfo.Groups,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   vmrr.Namespace,
+				Verb:        "update",
+				Group:       virt.GroupName,
+				Resource:    "virtualmachines",
+				Subresource: getVMASubresource(virtv1beta1.VirtualMachineActionRestart),
+			},
+		},
+	}
+	if err := h.Create(ctx, &sar); err != nil {
+		return false, err
+	}
+	return sar.Status.Allowed, nil
+}
+
+func ValidateVMRollingRestart(ctx context.Context, vmrr *virtv1beta1.VirtualMachineRollingRestart) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, ValidateVMRollingRestartSpec(ctx, &vmrr.Spec, field.NewPath("spec"))...)
+	return errs
+}
+
+func ValidateVMRollingRestartSpec(ctx context.Context, spec *virtv1beta1.VirtualMachineRollingRestartSpec, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if spec == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if spec.Selector == nil {
+		errs = append(errs, field.Required(fieldPath.Child("selector"), ""))
+	} else {
+		errs = append(errs, metav1validation.ValidateLabelSelector(spec.Selector, fieldPath.Child("selector"))...)
+	}
+
+	if spec.MaxUnavailable != nil {
+		errs = append(errs, validateMaxUnavailable(spec.MaxUnavailable, fieldPath.Child("maxUnavailable"))...)
+	}
+
+	switch spec.Strategy {
+	case "", virtv1beta1.RollingRestartStrategyLiveMigrateIfPossible, virtv1beta1.RollingRestartStrategyRestart:
+	default:
+		errs = append(errs, field.NotSupported(fieldPath.Child("strategy"), spec.Strategy, []string{
+			string(virtv1beta1.RollingRestartStrategyLiveMigrateIfPossible),
+			string(virtv1beta1.RollingRestartStrategyRestart),
+		}))
+	}
+	return errs
+}
+
+func validateMaxUnavailable(maxUnavailable *intstr.IntOrString, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	switch maxUnavailable.Type {
+	case intstr.Int:
+		if maxUnavailable.IntVal < 1 {
+			errs = append(errs, field.Invalid(fieldPath, maxUnavailable.IntVal, "must be at least 1"))
+		}
+	case intstr.String:
+		for _, msg := range validation.IsValidPercent(maxUnavailable.StrVal) {
+			errs = append(errs, field.Invalid(fieldPath, maxUnavailable.StrVal, msg))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+func TestValidateVMRollingRestart(t *testing.T) {
+	maxUnavailable := intstr.FromString("25%")
+	validVMRR := &virtv1beta1.VirtualMachineRollingRestart{
+		Spec: virtv1beta1.VirtualMachineRollingRestartSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"virtink.io/pool": "etcd"},
+			},
+			MaxUnavailable: &maxUnavailable,
+			Strategy:       virtv1beta1.RollingRestartStrategyLiveMigrateIfPossible,
+		},
+	}
+
+	tests := []struct {
+		vmrr          *virtv1beta1.VirtualMachineRollingRestart
+		invalidFields []string
+	}{{
+		vmrr: validVMRR,
+	}, {
+		vmrr: func() *virtv1beta1.VirtualMachineRollingRestart {
+			vmrr := validVMRR.DeepCopy()
+			vmrr.Spec.Selector = nil
+			return vmrr
+		}(),
+		invalidFields: []string{"spec.selector"},
+	}, {
+		vmrr: func() *virtv1beta1.VirtualMachineRollingRestart {
+			vmrr := validVMRR.DeepCopy()
+			vmrr.Spec.Selector.MatchLabels = map[string]string{"virtink.io/pool": "-etcd"}
+			return vmrr
+		}(),
+		invalidFields: []string{"spec.selector.matchLabels"},
+	}, {
+		vmrr: func() *virtv1beta1.VirtualMachineRollingRestart {
+			vmrr := validVMRR.DeepCopy()
+			maxUnavailable := intstr.FromInt(0)
+			vmrr.Spec.MaxUnavailable = &maxUnavailable
+			return vmrr
+		}(),
+		invalidFields: []string{"spec.maxUnavailable"},
+	}, {
+		vmrr: func() *virtv1beta1.VirtualMachineRollingRestart {
+			vmrr := validVMRR.DeepCopy()
+			maxUnavailable := intstr.FromString("half")
+			vmrr.Spec.MaxUnavailable = &maxUnavailable
+			return vmrr
+		}(),
+		invalidFields: []string{"spec.maxUnavailable"},
+	}, {
+		vmrr: func() *virtv1beta1.VirtualMachineRollingRestart {
+			vmrr := validVMRR.DeepCopy()
+			vmrr.Spec.Strategy = "Recreate"
+			return vmrr
+		}(),
+		invalidFields: []string{"spec.strategy"},
+	}}
+
+	for _, tc := range tests {
+		errs := ValidateVMRollingRestart(context.Background(), tc.vmrr)
+		var invalidFields []string
+		for _, err := range errs {
+			invalidFields = append(invalidFields, err.Field)
+		}
+		assert.Equal(t, tc.invalidFields, invalidFields)
+	}
+}
@@ -0,0 +1,193 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+// maxScheduleCatchUp is how long ago a scheduled action may have been missed,
+// e.g. while virt-controller was down, and still be taken.
+const maxScheduleCatchUp = 24 * time.Hour
+
+// VMScheduleReconciler starts and stops VMs as given by their schedules, by
+// setting the power action of the VM like VMAs do.
+type VMScheduleReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	RateLimiter ratelimiter.RateLimiter
+}
+
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
+
+func (r *VMScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var vm virtv1alpha1.VirtualMachine
+	if err := r.Get(ctx, req.NamespacedName, &vm); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if vm.DeletionTimestamp != nil && !vm.DeletionTimestamp.IsZero() || vm.Spec.Schedule == nil {
+		return ctrl.Result{}, nil
+	}
+
+	start, err := parseVMSchedule(vm.Spec.Schedule.Start, vm.Spec.Schedule.TimeZone)
+	if err != nil {
+		r.Recorder.Eventf(&vm, corev1.EventTypeWarning, "InvalidSchedule", "Invalid start schedule: %s", err)
+		return ctrl.Result{}, nil
+	}
+	stop, err := parseVMSchedule(vm.Spec.Schedule.Stop, vm.Spec.Schedule.TimeZone)
+	if err != nil {
+		r.Recorder.Eventf(&vm, corev1.EventTypeWarning, "InvalidSchedule", "Invalid stop schedule: %s", err)
+		return ctrl.Result{}, nil
+	}
+
+	now := time.Now()
+	if vm.Status.Schedule == nil || vm.Status.Schedule.LastScheduleTime == nil {
+		vm.Status.Schedule = &virtv1alpha1.VirtualMachineStatusSchedule{
+			LastScheduleTime: &metav1.Time{Time: now},
+		}
+		if err := r.Status().Update(ctx, &vm); err != nil {
+			if apierrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("update VM status: %s", err)
+		}
+	}
+
+	since := vm.Status.Schedule.LastScheduleTime.Time
+	if since.Before(now.Add(-maxScheduleCatchUp)) {
+		since = now.Add(-maxScheduleCatchUp)
+	}
+	powerAction, scheduleTime, next := getScheduledPowerAction(start, stop, since, now)
+	if powerAction != "" {
+		r.takeScheduledPowerAction(&vm, powerAction)
+		vm.Status.Schedule.LastScheduleTime = &metav1.Time{Time: scheduleTime}
+		if err := r.Status().Update(ctx, &vm); err != nil {
+			if apierrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("update VM status: %s", err)
+		}
+	}
+
+	if next.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{RequeueAfter: next.Sub(now)}, nil
+}
+
+// takeScheduledPowerAction sets the power action on the VM unless the VM is
+// already in the desired state. Scheduled actions are skipped rather than
+// delayed while the VM is migrating or has another power action in progress.
+func (r *VMScheduleReconciler) takeScheduledPowerAction(vm *virtv1alpha1.VirtualMachine, powerAction virtv1alpha1.VirtualMachinePowerAction) {
+	if vm.Status.Migration != nil {
+		r.Recorder.Eventf(vm, corev1.EventTypeNormal, "SkippedScheduledAction", "Skipped scheduled %s: VM is migrating", powerAction)
+		return
+	}
+	if vm.Status.PowerAction != "" {
+		r.Recorder.Eventf(vm, corev1.EventTypeNormal, "SkippedScheduledAction", "Skipped scheduled %s: %s in progress", powerAction, vm.Status.PowerAction)
+		return
+	}
+
+	switch powerAction {
+	case virtv1alpha1.VirtualMachinePowerOn:
+		if vm.Status.Phase != "" && vm.Status.Phase != virtv1alpha1.VirtualMachineSucceeded && vm.Status.Phase != virtv1alpha1.VirtualMachineFailed {
+			return
+		}
+	case virtv1alpha1.VirtualMachinePowerOff:
+		if vm.Status.Phase != virtv1alpha1.VirtualMachineRunning {
+			return
+		}
+	}
+	vm.Status.PowerAction = powerAction
+	r.Recorder.Eventf(vm, corev1.EventTypeNormal, "ScheduledAction", "%s requested by schedule", powerAction)
+}
+
+// getScheduledPowerAction returns the last power action scheduled after since
+// and not after now, along with its time, and the time of the next one. The
+// stop wins if a start and a stop are scheduled at the same time.
+func getScheduledPowerAction(start, stop cron.Schedule, since, now time.Time) (virtv1alpha1.VirtualMachinePowerAction, time.Time, time.Time) {
+	var powerAction virtv1alpha1.VirtualMachinePowerAction
+	var scheduleTime time.Time
+	for t := since; ; {
+		nextStart := getNextScheduleTime(start, t)
+		nextStop := getNextScheduleTime(stop, t)
+		next, nextPowerAction := nextStop, virtv1alpha1.VirtualMachinePowerOff
+		if nextStop.IsZero() || !nextStart.IsZero() && nextStart.Before(nextStop) {
+			next, nextPowerAction = nextStart, virtv1alpha1.VirtualMachinePowerOn
+		}
+		if next.IsZero() || next.After(now) {
+			return powerAction, scheduleTime, next
+		}
+		powerAction, scheduleTime, t = nextPowerAction, next, next
+	}
+}
+
+func getNextScheduleTime(schedule cron.Schedule, t time.Time) time.Time {
+	if schedule == nil {
+		return time.Time{}
+	}
+	return schedule.Next(t)
+}
+
+// parseVMSchedule parses a cron expression of a VM schedule in the time zone.
+// An empty expression is parsed as nil.
+func parseVMSchedule(expr string, timeZone string) (cron.Schedule, error) {
+	if expr == "" {
+		return nil, nil
+	}
+
+	location := time.UTC
+	if timeZone != "" {
+		var err error
+		location, err = time.LoadLocation(timeZone)
+		if err != nil {
+			return nil, fmt.Errorf("load time zone: %s", err)
+		}
+	}
+
+	schedule, err := cron.ParseStandard(expr)
+	if err != nil {
+		return nil, err
+	}
+	specSchedule, ok := schedule.(*cron.SpecSchedule)
+	if !ok {
+		return nil, fmt.Errorf("unsupported schedule %q", expr)
+	}
+	if specSchedule.Location != time.Local {
+		return nil, fmt.Errorf("time zone must be set with timeZone")
+	}
+	specSchedule.Location = location
+	return specSchedule, nil
+}
+
+func (r *VMScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("vmschedule").
+		For(&virtv1alpha1.VirtualMachine{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			return obj.(*virtv1alpha1.VirtualMachine).Spec.Schedule != nil
+		}))).
+		WithOptions(controller.Options{
+			RateLimiter: r.RateLimiter,
+		}).
+		Complete(r)
+}
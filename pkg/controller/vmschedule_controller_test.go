@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+func TestGetScheduledPowerAction(t *testing.T) {
+	start, err := parseVMSchedule("0 8 * * *", "Asia/Shanghai")
+	require.NoError(t, err)
+	stop, err := parseVMSchedule("0 20 * * *", "Asia/Shanghai")
+	require.NoError(t, err)
+
+	parseTime := func(s string) time.Time {
+		parsed, err := time.Parse(time.RFC3339, s)
+		require.NoError(t, err)
+		return parsed
+	}
+
+	tests := []struct {
+		start, stop          bool
+		since, now           string
+		expectedPowerAction  virtv1alpha1.VirtualMachinePowerAction
+		expectedScheduleTime string
+		expectedNext         string
+	}{{
+		start:        true,
+		stop:         true,
+		since:        "2022-06-01T01:00:00Z",
+		now:          "2022-06-01T02:00:00Z",
+		expectedNext: "2022-06-01T12:00:00Z",
+	}, {
+		start:                true,
+		stop:                 true,
+		since:                "2022-06-01T01:00:00Z",
+		now:                  "2022-06-01T12:00:00Z",
+		expectedPowerAction:  virtv1alpha1.VirtualMachinePowerOff,
+		expectedScheduleTime: "2022-06-01T12:00:00Z",
+		expectedNext:         "2022-06-02T00:00:00Z",
+	}, {
+		start:                true,
+		stop:                 true,
+		since:                "2022-06-01T01:00:00Z",
+		now:                  "2022-06-02T01:00:00Z",
+		expectedPowerAction:  virtv1alpha1.VirtualMachinePowerOn,
+		expectedScheduleTime: "2022-06-02T00:00:00Z",
+		expectedNext:         "2022-06-02T12:00:00Z",
+	}, {
+		start:                true,
+		since:                "2022-06-01T01:00:00Z",
+		now:                  "2022-06-03T01:00:00Z",
+		expectedPowerAction:  virtv1alpha1.VirtualMachinePowerOn,
+		expectedScheduleTime: "2022-06-03T00:00:00Z",
+		expectedNext:         "2022-06-04T00:00:00Z",
+	}, {
+		since: "2022-06-01T01:00:00Z",
+		now:   "2022-06-03T01:00:00Z",
+	}}
+
+	for _, tc := range tests {
+		tcStart, tcStop := start, stop
+		if !tc.start {
+			tcStart = nil
+		}
+		if !tc.stop {
+			tcStop = nil
+		}
+		powerAction, scheduleTime, next := getScheduledPowerAction(tcStart, tcStop, parseTime(tc.since), parseTime(tc.now))
+		assert.Equal(t, tc.expectedPowerAction, powerAction)
+		if tc.expectedScheduleTime == "" {
+			assert.True(t, scheduleTime.IsZero())
+		} else {
+			assert.True(t, parseTime(tc.expectedScheduleTime).Equal(scheduleTime), scheduleTime)
+		}
+		if tc.expectedNext == "" {
+			assert.True(t, next.IsZero())
+		} else {
+			assert.True(t, parseTime(tc.expectedNext).Equal(next), next)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// +kubebuilder:webhook:path=/validate-v1beta1-virtualmachinesecuritygroup,mutating=false,failurePolicy=fail,sideEffects=None,groups=virt.virtink.smartx.com,resources=virtualmachinesecuritygroups,verbs=create;update,versions=v1beta1,name=validate.virtualmachinesecuritygroup.v1beta1.virt.virtink.smartx.com,admissionReviewVersions={v1,v1beta1}
+
+type VMSecurityGroupValidator struct {
+	decoder *admission.Decoder
+}
+
+var _ admission.DecoderInjector = &VMSecurityGroupValidator{}
+var _ admission.Handler = &VMSecurityGroupValidator{}
+
+func (h *VMSecurityGroupValidator) InjectDecoder(decoder *admission.Decoder) error {
+	h.decoder = decoder
+	return nil
+}
+
+func (h *VMSecurityGroupValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	var vmsg virtv1beta1.VirtualMachineSecurityGroup
+	if err := h.decoder.Decode(req, &vmsg); err != nil {
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("unmarshal VM security group: %s", err))
+	}
+
+	var errs field.ErrorList
+	switch req.Operation {
+	case admissionv1.Create, admissionv1.Update:
+		errs = ValidateVMSecurityGroup(ctx, &vmsg)
+	default:
+		return admission.Allowed("")
+	}
+
+	if len(errs) > 0 {
+		return webhook.Denied(errs.ToAggregate().Error())
+	}
+	return admission.Allowed("")
+}
+
+func ValidateVMSecurityGroup(ctx context.Context, vmsg *virtv1beta1.VirtualMachineSecurityGroup) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, ValidateVMSecurityGroupSpec(ctx, &vmsg.Spec, field.NewPath("spec"))...)
+	return errs
+}
+
+func ValidateVMSecurityGroupSpec(ctx context.Context, spec *virtv1beta1.VirtualMachineSecurityGroupSpec, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if spec == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	for i := range spec.Ingress {
+		errs = append(errs, ValidateSecurityGroupRule(ctx, &spec.Ingress[i], fieldPath.Child("ingress").Index(i))...)
+	}
+	for i := range spec.Egress {
+		errs = append(errs, ValidateSecurityGroupRule(ctx, &spec.Egress[i], fieldPath.Child("egress").Index(i))...)
+	}
+	return errs
+}
+
+func ValidateSecurityGroupRule(ctx context.Context, rule *virtv1beta1.SecurityGroupRule, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if rule == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	switch rule.Protocol {
+	case "", virtv1beta1.SecurityGroupProtocolAll, virtv1beta1.SecurityGroupProtocolTCP, virtv1beta1.SecurityGroupProtocolUDP,
+		virtv1beta1.SecurityGroupProtocolICMP, virtv1beta1.SecurityGroupProtocolICMPv6:
+	default:
+		errs = append(errs, field.NotSupported(fieldPath.Child("protocol"), rule.Protocol, []string{
+			string(virtv1beta1.SecurityGroupProtocolAll),
+			string(virtv1beta1.SecurityGroupProtocolTCP),
+			string(virtv1beta1.SecurityGroupProtocolUDP),
+			string(virtv1beta1.SecurityGroupProtocolICMP),
+			string(virtv1beta1.SecurityGroupProtocolICMPv6),
+		}))
+	}
+
+	if len(rule.Ports) > 0 && rule.Protocol != virtv1beta1.SecurityGroupProtocolTCP && rule.Protocol != virtv1beta1.SecurityGroupProtocolUDP {
+		errs = append(errs, field.Forbidden(fieldPath.Child("ports"), "may only be used with TCP or UDP"))
+	}
+	for i, port := range rule.Ports {
+		portPath := fieldPath.Child("ports").Index(i)
+		if port.Port < 1 || port.Port > 65535 {
+			errs = append(errs, field.Invalid(portPath.Child("port"), port.Port, "must be between 1 and 65535"))
+		}
+		if port.EndPort != 0 && (port.EndPort < port.Port || port.EndPort > 65535) {
+			errs = append(errs, field.Invalid(portPath.Child("endPort"), port.EndPort, "must be between port and 65535"))
+		}
+	}
+
+	for i, cidr := range rule.CIDRs {
+		ip, _, err := net.ParseCIDR(cidr)
+		if err != nil {
+			errs = append(errs, field.Invalid(fieldPath.Child("cidrs").Index(i), cidr, "must be a valid CIDR"))
+			continue
+		}
+		if rule.Protocol == virtv1beta1.SecurityGroupProtocolICMP && ip.To4() == nil {
+			errs = append(errs, field.Invalid(fieldPath.Child("cidrs").Index(i), cidr, "must be an IPv4 CIDR for ICMP"))
+		}
+		if rule.Protocol == virtv1beta1.SecurityGroupProtocolICMPv6 && ip.To4() != nil {
+			errs = append(errs, field.Invalid(fieldPath.Child("cidrs").Index(i), cidr, "must be an IPv6 CIDR for ICMPv6"))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+func TestValidateVMSecurityGroup(t *testing.T) {
+	validVMSG := &virtv1beta1.VirtualMachineSecurityGroup{
+		Spec: virtv1beta1.VirtualMachineSecurityGroupSpec{
+			Ingress: []virtv1beta1.SecurityGroupRule{{
+				Protocol: virtv1beta1.SecurityGroupProtocolTCP,
+				Ports:    []virtv1beta1.SecurityGroupPortRange{{Port: 22}, {Port: 8000, EndPort: 8080}},
+				CIDRs:    []string{"10.0.0.0/8", "2001:db8::/32"},
+			}, {
+				Protocol: virtv1beta1.SecurityGroupProtocolICMP,
+			}},
+			Egress: []virtv1beta1.SecurityGroupRule{{}},
+		},
+	}
+
+	tests := []struct {
+		vmsg          *virtv1beta1.VirtualMachineSecurityGroup
+		invalidFields []string
+	}{{
+		vmsg: validVMSG,
+	}, {
+		vmsg: func() *virtv1beta1.VirtualMachineSecurityGroup {
+			vmsg := validVMSG.DeepCopy()
+			vmsg.Spec.Ingress[0].Protocol = "SCTP"
+			return vmsg
+		}(),
+		invalidFields: []string{"spec.ingress[0].protocol", "spec.ingress[0].ports"},
+	}, {
+		vmsg: func() *virtv1beta1.VirtualMachineSecurityGroup {
+			vmsg := validVMSG.DeepCopy()
+			vmsg.Spec.Ingress[0].Ports[1].EndPort = 7000
+			return vmsg
+		}(),
+		invalidFields: []string{"spec.ingress[0].ports[1].endPort"},
+	}, {
+		vmsg: func() *virtv1beta1.VirtualMachineSecurityGroup {
+			vmsg := validVMSG.DeepCopy()
+			vmsg.Spec.Ingress[1].Ports = []virtv1beta1.SecurityGroupPortRange{{Port: 8}}
+			return vmsg
+		}(),
+		invalidFields: []string{"spec.ingress[1].ports"},
+	}, {
+		vmsg: func() *virtv1beta1.VirtualMachineSecurityGroup {
+			vmsg := validVMSG.DeepCopy()
+			vmsg.Spec.Ingress[1].CIDRs = []string{"2001:db8::/32"}
+			vmsg.Spec.Egress[0].CIDRs = []string{"10.0.0.1"}
+			return vmsg
+		}(),
+		invalidFields: []string{"spec.ingress[1].cidrs[0]", "spec.egress[0].cidrs[0]"},
+	}}
+
+	for _, tc := range tests {
+		errs := ValidateVMSecurityGroup(context.Background(), tc.vmsg)
+		var invalidFields []string
+		for _, err := range errs {
+			invalidFields = append(invalidFields, err.Field)
+		}
+		assert.Equal(t, tc.invalidFields, invalidFields)
+	}
+}
@@ -0,0 +1,236 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+
+	"github.com/smartxworks/virtink/pkg/apis/virt"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+const (
+	// vmTemplateLabel records the template a VM was instantiated from.
+	vmTemplateLabel = "virtink.io/template"
+	// vmTemplateInstanceUIDAnnotation records the VMTI a VM was created for,
+	// so that the VMTI is not failed if its status update is lost.
+	vmTemplateInstanceUIDAnnotation = "virtink.io/template-instance-uid"
+)
+
+// VMTIReconciler instantiates VMs from VirtualMachineTemplates. Each VMTI
+// creates at most one VM, and the VM is not deleted with the VMTI.
+type VMTIReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	RateLimiter ratelimiter.RateLimiter
+}
+
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinetemplateinstances,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinetemplateinstances/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinetemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
+
+func (r *VMTIReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var vmti virtv1beta1.VirtualMachineTemplateInstance
+	if err := r.Get(ctx, req.NamespacedName, &vmti); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	status := vmti.Status.DeepCopy()
+	if err := r.reconcile(ctx, &vmti); err != nil {
+		r.Recorder.Eventf(&vmti, corev1.EventTypeWarning, "FailedReconcile", "Failed to reconcile VMTI: %s", err)
+		return ctrl.Result{}, err
+	}
+
+	if !reflect.DeepEqual(vmti.Status, status) {
+		if err := r.Status().Update(ctx, &vmti); err != nil {
+			if apierrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("update VMTI status: %s", err)
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *VMTIReconciler) reconcile(ctx context.Context, vmti *virtv1beta1.VirtualMachineTemplateInstance) error {
+	if vmti.DeletionTimestamp != nil && !vmti.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	if vmti.Status.Phase != "" {
+		return nil
+	}
+	vmti.Status.Requester = vmti.Annotations[requesterAnnotation]
+
+	var vmTemplate virtv1beta1.VirtualMachineTemplate
+	vmTemplateKey := types.NamespacedName{
+		Name:      vmti.Spec.TemplateName,
+		Namespace: vmti.Namespace,
+	}
+	if err := r.Get(ctx, vmTemplateKey, &vmTemplate); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.failVMTI(vmti, "template %q not found", vmti.Spec.TemplateName)
+			return nil
+		}
+		return fmt.Errorf("get template: %s", err)
+	}
+
+	vmJSON, err := renderVMTemplate(&vmTemplate.Spec, vmti.Spec.Parameters)
+	if err != nil {
+		r.failVMTI(vmti, "render template: %s", err)
+		return nil
+	}
+	vm, err := r.decodeVM(vmJSON)
+	if err != nil {
+		r.failVMTI(vmti, "decode VM: %s", err)
+		return nil
+	}
+
+	vm.SetNamespace(vmti.Namespace)
+	if vm.GetName() == "" && vm.GetGenerateName() == "" {
+		vm.SetName(vmti.Name)
+	}
+	labels := vm.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[vmTemplateLabel] = vmTemplate.Name
+	vm.SetLabels(labels)
+	annotations := vm.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[vmTemplateInstanceUIDAnnotation] = string(vmti.UID)
+	vm.SetAnnotations(annotations)
+
+	if err := r.Create(ctx, vm); err != nil {
+		switch {
+		case apierrors.IsAlreadyExists(err):
+			existingVM := vm.DeepCopyObject().(client.Object)
+			if err := r.Get(ctx, client.ObjectKeyFromObject(vm), existingVM); err != nil {
+				return fmt.Errorf("get VM: %s", err)
+			}
+			if existingVM.GetAnnotations()[vmTemplateInstanceUIDAnnotation] != string(vmti.UID) {
+				r.failVMTI(vmti, "VM %q already exists", vm.GetName())
+				return nil
+			}
+			vm = existingVM
+		case apierrors.IsInvalid(err), apierrors.IsForbidden(err), apierrors.IsBadRequest(err):
+			r.failVMTI(vmti, "create VM: %s", err)
+			return nil
+		default:
+			return fmt.Errorf("create VM: %s", err)
+		}
+	} else {
+		r.Recorder.Eventf(vmti, corev1.EventTypeNormal, "CreatedVM", "Created VM %q from template %q", vm.GetName(), vmTemplate.Name)
+	}
+
+	vmti.Status.Phase = virtv1beta1.VirtualMachineTemplateInstanceSucceeded
+	vmti.Status.VirtualMachineName = vm.GetName()
+	return nil
+}
+
+// decodeVM decodes a VM of any version known to the scheme. The apiVersion and
+// kind default to the ones of the v1beta1 VM.
+func (r *VMTIReconciler) decodeVM(data []byte) (client.Object, error) {
+	defaultGVK := virtv1beta1.SchemeGroupVersion.WithKind("VirtualMachine")
+	obj, gvk, err := serializer.NewCodecFactory(r.Scheme).UniversalDeserializer().Decode(data, &defaultGVK, nil)
+	if err != nil {
+		return nil, err
+	}
+	if gvk.Group != virt.GroupName || gvk.Kind != "VirtualMachine" {
+		return nil, fmt.Errorf("unsupported kind %q", gvk)
+	}
+	return obj.(client.Object), nil
+}
+
+func (r *VMTIReconciler) failVMTI(vmti *virtv1beta1.VirtualMachineTemplateInstance, messageFmt string, args ...interface{}) {
+	vmti.Status.Phase = virtv1beta1.VirtualMachineTemplateInstanceFailed
+	vmti.Status.Message = fmt.Sprintf(messageFmt, args...)
+	r.Recorder.Eventf(vmti, corev1.EventTypeWarning, "FailedInstantiate", messageFmt, args...)
+}
+
+// vmTemplateReferenceRegexp matches "${{NAME}}", including the quotes, and
+// ${NAME} in the JSON of a template.
+var vmTemplateReferenceRegexp = regexp.MustCompile(`"\$\{\{([A-Z_][A-Z0-9_]*)\}\}"|\$\{([A-Z_][A-Z0-9_]*)\}`)
+
+// renderVMTemplate substitutes the parameters into the VM of the template and
+// returns the VM in JSON. References to names that are not parameters of the
+// template are kept, since they may be meant for the guest, e.g. in scripts.
+func renderVMTemplate(spec *virtv1beta1.VirtualMachineTemplateSpec, parameters map[string]string) ([]byte, error) {
+	if len(spec.VirtualMachine.Raw) == 0 {
+		return nil, fmt.Errorf("template has no VM")
+	}
+
+	values := map[string]string{}
+	for _, parameter := range spec.Parameters {
+		value, ok := parameters[parameter.Name]
+		if !ok {
+			value = parameter.Default
+		}
+		if parameter.Required && value == "" {
+			return nil, fmt.Errorf("parameter %q is required", parameter.Name)
+		}
+		values[parameter.Name] = value
+	}
+	for name := range parameters {
+		if _, ok := values[name]; !ok {
+			return nil, fmt.Errorf("unknown parameter %q", name)
+		}
+	}
+
+	var renderErr error
+	data := vmTemplateReferenceRegexp.ReplaceAllFunc(spec.VirtualMachine.Raw, func(ref []byte) []byte {
+		match := vmTemplateReferenceRegexp.FindSubmatch(ref)
+		if name := string(match[1]); name != "" {
+			value, ok := values[name]
+			if !ok {
+				return ref
+			}
+			if !json.Valid([]byte(value)) {
+				if renderErr == nil {
+					renderErr = fmt.Errorf("value of parameter %q is not valid JSON", name)
+				}
+				return ref
+			}
+			return []byte(value)
+		}
+
+		value, ok := values[string(match[2])]
+		if !ok {
+			return ref
+		}
+		valueJSON, _ := json.Marshal(value)
+		return valueJSON[1 : len(valueJSON)-1]
+	})
+	if renderErr != nil {
+		return nil, renderErr
+	}
+	return data, nil
+}
+
+func (r *VMTIReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&virtv1beta1.VirtualMachineTemplateInstance{}).
+		WithOptions(controller.Options{
+			RateLimiter: r.RateLimiter,
+		}).
+		Complete(r)
+}
@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+func TestRenderVMTemplate(t *testing.T) {
+	spec := &virtv1beta1.VirtualMachineTemplateSpec{
+		Parameters: []virtv1beta1.VirtualMachineTemplateParameter{{
+			Name:     "NAME",
+			Required: true,
+		}, {
+			Name:    "CPU_CORES",
+			Default: "1",
+		}, {
+			Name:    "MEMORY",
+			Default: "1Gi",
+		}},
+		VirtualMachine: runtime.RawExtension{
+			Raw: []byte(`{"metadata":{"name":"${NAME}-vm"},"spec":{"instance":{"cpu":{"coresPerSocket":"${{CPU_CORES}}"},"memory":{"size":"${MEMORY}"}},"script":"echo ${HOME}"}}`),
+		},
+	}
+
+	tests := []struct {
+		parameters map[string]string
+		vmJSON     string
+		err        string
+	}{{
+		parameters: map[string]string{"NAME": "test"},
+		vmJSON:     `{"metadata":{"name":"test-vm"},"spec":{"instance":{"cpu":{"coresPerSocket":1},"memory":{"size":"1Gi"}},"script":"echo ${HOME}"}}`,
+	}, {
+		parameters: map[string]string{"NAME": `"quoted"`, "CPU_CORES": "4", "MEMORY": "8Gi"},
+		vmJSON:     `{"metadata":{"name":"\"quoted\"-vm"},"spec":{"instance":{"cpu":{"coresPerSocket":4},"memory":{"size":"8Gi"}},"script":"echo ${HOME}"}}`,
+	}, {
+		parameters: map[string]string{},
+		err:        `parameter "NAME" is required`,
+	}, {
+		parameters: map[string]string{"NAME": "test", "DISK_SIZE": "10Gi"},
+		err:        `unknown parameter "DISK_SIZE"`,
+	}, {
+		parameters: map[string]string{"NAME": "test", "CPU_CORES": "four"},
+		err:        `value of parameter "CPU_CORES" is not valid JSON`,
+	}}
+
+	for _, tc := range tests {
+		vmJSON, err := renderVMTemplate(spec, tc.parameters)
+		if tc.err != "" {
+			assert.EqualError(t, err, tc.err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, tc.vmJSON, string(vmJSON))
+	}
+}
@@ -0,0 +1,169 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/smartxworks/virtink/pkg/apis/virt"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// +kubebuilder:webhook:path=/mutate-v1beta1-virtualmachinetemplateinstance,mutating=true,failurePolicy=fail,sideEffects=None,groups=virt.virtink.smartx.com,resources=virtualmachinetemplateinstances,verbs=create,versions=v1beta1,name=mutate.virtualmachinetemplateinstance.v1beta1.virt.virtink.smartx.com,admissionReviewVersions={v1,v1beta1}
+
+type VMTIMutator struct {
+	decoder *admission.Decoder
+}
+
+var _ admission.DecoderInjector = &VMTIMutator{}
+var _ admission.Handler = &VMTIMutator{}
+
+func (h *VMTIMutator) InjectDecoder(decoder *admission.Decoder) error {
+	h.decoder = decoder
+	return nil
+}
+
+func (h *VMTIMutator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	var vmti virtv1beta1.VirtualMachineTemplateInstance
+	if err := h.decoder.Decode(req, &vmti); err != nil {
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("unmarshal VMTI: %s", err))
+	}
+
+	if req.Operation != admissionv1.Create {
+		return admission.Allowed("")
+	}
+
+	if vmti.Annotations == nil {
+		vmti.Annotations = map[string]string{}
+	}
+	vmti.Annotations[requesterAnnotation] = req.UserInfo.Username
+
+	vmtiJSON, err := json.Marshal(vmti)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("marshal VMTI: %s", err))
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, vmtiJSON)
+}
+
+// +kubebuilder:webhook:path=/validate-v1beta1-virtualmachinetemplateinstance,mutating=false,failurePolicy=fail,sideEffects=None,groups=virt.virtink.smartx.com,resources=virtualmachinetemplateinstances,verbs=create;update,versions=v1beta1,name=validate.virtualmachinetemplateinstance.v1beta1.virt.virtink.smartx.com,admissionReviewVersions={v1,v1beta1}
+
+type VMTIValidator struct {
+	client.Client
+	decoder *admission.Decoder
+}
+
+var _ admission.DecoderInjector = &VMTIValidator{}
+var _ admission.Handler = &VMTIValidator{}
+
+func (h *VMTIValidator) InjectDecoder(decoder *admission.Decoder) error {
+	h.decoder = decoder
+	return nil
+}
+
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+func (h *VMTIValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	var vmti virtv1beta1.VirtualMachineTemplateInstance
+	if err := h.decoder.Decode(req, &vmti); err != nil {
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("unmarshal VMTI: %s", err))
+	}
+
+	var errs field.ErrorList
+	switch req.Operation {
+	case admissionv1.Create:
+		errs = ValidateVMTI(ctx, &vmti)
+		if len(errs) == 0 {
+			allowed, err := h.isInstantiationAllowed(ctx, req, &vmti)
+			if err != nil {
+				return admission.Errored(http.StatusInternalServerError, fmt.Errorf("review access: %s", err))
+			}
+			if !allowed {
+				return webhook.Denied(fmt.Sprintf("user %q may not create VMs in namespace %q", req.UserInfo.Username, vmti.Namespace))
+			}
+		}
+	case admissionv1.Update:
+		var oldVMTI virtv1beta1.VirtualMachineTemplateInstance
+		if err := h.decoder.DecodeRaw(req.OldObject, &oldVMTI); err != nil {
+			return admission.Errored(http.StatusBadRequest, fmt.Errorf("unmarshal old VMTI: %s", err))
+		}
+
+		if !reflect.DeepEqual(vmti.Spec, oldVMTI.Spec) {
+			errs = append(errs, field.Forbidden(field.NewPath("spec"), "VMTI spec may not be updated"))
+		}
+		if vmti.Annotations[requesterAnnotation] != oldVMTI.Annotations[requesterAnnotation] {
+			errs = append(errs, field.Forbidden(field.NewPath("metadata").Child("annotations").Key(requesterAnnotation), "may not be updated"))
+		}
+	default:
+		return admission.Allowed("")
+	}
+
+	if len(errs) > 0 {
+		return webhook.Denied(errs.ToAggregate().Error())
+	}
+	return admission.Allowed("")
+}
+
+// isInstantiationAllowed checks whether the requesting user may create VMs in
+// the namespace of the instance, since the VM is created by virt-controller on
+// behalf of the user.
+func (h *VMTIValidator) isInstantiationAllowed(ctx context.Context, req admission.Request, vmti *virtv1beta1.VirtualMachineTemplateInstance) (bool, error) {
+	extra := map[string]authorizationv1.ExtraValue{}
+	for k, v := range req.UserInfo.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+	sar := authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   req.UserInfo.Username,
+			UID:    req.UserInfo.UID,
+			Groups: req.UserInfo.Groups,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: vmti.Namespace,
+				Verb:      "create",
+				Group:     virt.GroupName,
+				Resource:  "virtualmachines",
+			},
+		},
+	}
+	if err := h.Create(ctx, &sar); err != nil {
+		return false, err
+	}
+	return sar.Status.Allowed, nil
+}
+
+var vmTemplateParameterNameRegexp = regexp.MustCompile(`^[A-Z_][A-Z0-9_]*$`)
+
+func ValidateVMTI(ctx context.Context, vmti *virtv1beta1.VirtualMachineTemplateInstance) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, ValidateVMTISpec(ctx, &vmti.Spec, field.NewPath("spec"))...)
+	return errs
+}
+
+func ValidateVMTISpec(ctx context.Context, spec *virtv1beta1.VirtualMachineTemplateInstanceSpec, fieldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if spec == nil {
+		errs = append(errs, field.Required(fieldPath, ""))
+		return errs
+	}
+
+	if spec.TemplateName == "" {
+		errs = append(errs, field.Required(fieldPath.Child("templateName"), ""))
+	}
+
+	for name := range spec.Parameters {
+		if !vmTemplateParameterNameRegexp.MatchString(name) {
+			errs = append(errs, field.Invalid(fieldPath.Child("parameters").Key(name), name, "must consist of upper case letters, digits and '_', and not start with a digit"))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+func TestValidateVMTI(t *testing.T) {
+	validVMTI := &virtv1beta1.VirtualMachineTemplateInstance{
+		Spec: virtv1beta1.VirtualMachineTemplateInstanceSpec{
+			TemplateName: "ubuntu",
+			Parameters: map[string]string{
+				"CPU_CORES": "2",
+			},
+		},
+	}
+
+	tests := []struct {
+		vmti          *virtv1beta1.VirtualMachineTemplateInstance
+		invalidFields []string
+	}{{
+		vmti: validVMTI,
+	}, {
+		vmti: func() *virtv1beta1.VirtualMachineTemplateInstance {
+			vmti := validVMTI.DeepCopy()
+			vmti.Spec.TemplateName = ""
+			return vmti
+		}(),
+		invalidFields: []string{"spec.templateName"},
+	}, {
+		vmti: func() *virtv1beta1.VirtualMachineTemplateInstance {
+			vmti := validVMTI.DeepCopy()
+			vmti.Spec.Parameters["cpu-cores"] = "2"
+			return vmti
+		}(),
+		invalidFields: []string{"spec.parameters[cpu-cores]"},
+	}}
+
+	for _, tc := range tests {
+		errs := ValidateVMTI(context.Background(), tc.vmti)
+		var invalidFields []string
+		for _, err := range errs {
+			invalidFields = append(invalidFields, err.Field)
+		}
+		assert.Equal(t, tc.invalidFields, invalidFields)
+	}
+}
@@ -0,0 +1,134 @@
+package daemon
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/types"
+
+	daemonv1 "github.com/smartxworks/virtink/pkg/daemonapi/v1"
+)
+
+// apiServer implements version v1 of the Daemon service of the gRPC API.
+type apiServer struct {
+	daemonv1.UnimplementedDaemonServer
+	*Server
+}
+
+var _ daemonv1.DaemonServer = &apiServer{}
+
+func (s *apiServer) GetConsoleLog(ctx context.Context, req *daemonv1.GetConsoleLogRequest) (*daemonv1.GetConsoleLogResponse, error) {
+	vm, err := s.getVM(ctx, req.Vm, false)
+	if err != nil {
+		return nil, err
+	}
+	return &daemonv1.GetConsoleLogResponse{Data: s.ConsoleLogs.Get(vm.UID)}, nil
+}
+
+// StreamConsoleLog streams the console output of the VM until it leaves the
+// node or the client goes away. Output is sent as soon as the daemon reads it
+// from the VM pod log, and the VM is looked up again whenever its console
+// output changes or the daemon notices it leaving the node.
+func (s *apiServer) StreamConsoleLog(req *daemonv1.StreamConsoleLogRequest, stream daemonv1.Daemon_StreamConsoleLogServer) error {
+	ctx := stream.Context()
+	vmRef := req.Vm
+	var vmUID types.UID
+	var offset int64
+	for {
+		vm, err := s.getVM(ctx, vmRef, false)
+		if err != nil {
+			if vmUID != "" && status.Code(err) != codes.Internal {
+				return nil
+			}
+			return err
+		}
+		if vmUID != "" && vm.UID != vmUID {
+			return nil
+		}
+		vmUID = vm.UID
+
+		// watch before reading, so that no output is missed in between
+		changed := s.ConsoleLogs.Watch(vm.UID)
+		var data []byte
+		data, offset = s.ConsoleLogs.GetSince(vm.UID, offset)
+		if len(data) > 0 {
+			if err := stream.Send(&daemonv1.ConsoleLogChunk{Data: data}); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+		}
+	}
+}
+
+// Forward connects to the port of the guest of the first request, and copies
+// the bytes of the other requests to the guest and those of the guest to the
+// responses.
+func (s *apiServer) Forward(stream daemonv1.Daemon_ForwardServer) error {
+	ctx := stream.Context()
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	target := req.GetTarget()
+	if target == nil {
+		return status.Error(codes.InvalidArgument, "target of the first request required")
+	}
+	vm, err := s.getVM(ctx, target.Vm, true)
+	if err != nil {
+		return err
+	}
+	conn, err := s.dialGuest(ctx, vm, target.Protocol, target.Port)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := stream.Send(&daemonv1.ForwardResponse{}); err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					closeWrite(conn)
+				} else {
+					conn.Close()
+				}
+				return
+			}
+			if _, err := conn.Write(req.GetData()); err != nil {
+				return
+			}
+		}
+	}()
+
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := conn.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&daemonv1.ForwardResponse{Data: buf[:n]}); err != nil {
+				conn.Close()
+				wg.Wait()
+				return err
+			}
+		}
+		if err != nil {
+			break
+		}
+	}
+	wg.Wait()
+	return nil
+}
@@ -0,0 +1,208 @@
+package daemon
+
+import (
+	"bytes"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// consoleLogSize is the number of bytes of console output kept per VM.
+const consoleLogSize = 256 * 1024
+
+// ConsoleLogs keeps the recent serial console output of VMs on the node. It
+// is keyed by VM, so the output outlives guest reboots, VM pods being
+// recreated by the run policy, and the rotation of VM pod logs.
+type ConsoleLogs struct {
+	mutex   sync.Mutex
+	buffers map[types.UID]*ringBuffer
+	// watchers are closed on the next change of the console output of a VM
+	watchers map[types.UID]chan struct{}
+}
+
+func NewConsoleLogs() *ConsoleLogs {
+	return &ConsoleLogs{
+		buffers:  map[types.UID]*ringBuffer{},
+		watchers: map[types.UID]chan struct{}{},
+	}
+}
+
+// Watch returns a channel that is closed once the console output kept for the
+// VM changes, or Notify is called for the VM.
+func (l *ConsoleLogs) Watch(vmUID types.UID) <-chan struct{} {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	watcher, ok := l.watchers[vmUID]
+	if !ok {
+		watcher = make(chan struct{})
+		l.watchers[vmUID] = watcher
+	}
+	return watcher
+}
+
+// Notify wakes up the watchers of the VM without a change of its console
+// output, e.g. for them to find out that the VM left the node.
+func (l *ConsoleLogs) Notify(vmUID types.UID) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.notify(vmUID)
+}
+
+func (l *ConsoleLogs) notify(vmUID types.UID) {
+	if watcher, ok := l.watchers[vmUID]; ok {
+		close(watcher)
+		delete(l.watchers, vmUID)
+	}
+}
+
+// AppendPodLog appends the console output found in lines of a VM pod log.
+func (l *ConsoleLogs) AppendPodLog(vmUID types.UID, podLog []byte) {
+	output := parseConsoleOutput(podLog)
+	if len(output) == 0 {
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	buffer, ok := l.buffers[vmUID]
+	if !ok {
+		buffer = newRingBuffer(consoleLogSize)
+		l.buffers[vmUID] = buffer
+	}
+	buffer.Write(output)
+	l.notify(vmUID)
+}
+
+// Get returns the console output kept for the VM, oldest first.
+func (l *ConsoleLogs) Get(vmUID types.UID) []byte {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if buffer, ok := l.buffers[vmUID]; ok {
+		return buffer.Bytes()
+	}
+	return nil
+}
+
+// GetSince returns the console output kept for the VM that was written after
+// offset bytes of output, and the offset of the end of it. Output that is no
+// longer kept is skipped.
+func (l *ConsoleLogs) GetSince(vmUID types.UID, offset int64) ([]byte, int64) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	buffer, ok := l.buffers[vmUID]
+	if !ok {
+		return nil, 0
+	}
+
+	data := buffer.Bytes()
+	start := buffer.written - int64(len(data))
+	if offset < start || offset > buffer.written {
+		offset = start
+	}
+	return data[offset-start:], buffer.written
+}
+
+// Snapshot returns the console output kept for the VM and the number of bytes
+// of output ever written, for it to be restored after a restart.
+func (l *ConsoleLogs) Snapshot(vmUID types.UID) ([]byte, int64) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if buffer, ok := l.buffers[vmUID]; ok {
+		return buffer.Bytes(), buffer.written
+	}
+	return nil, 0
+}
+
+// Restore replaces the console output kept for the VM with a snapshot.
+func (l *ConsoleLogs) Restore(vmUID types.UID, data []byte, written int64) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	buffer := newRingBuffer(consoleLogSize)
+	buffer.Write(data)
+	if written > buffer.written {
+		buffer.written = written
+	}
+	l.buffers[vmUID] = buffer
+	l.notify(vmUID)
+}
+
+func (l *ConsoleLogs) Delete(vmUID types.UID) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	delete(l.buffers, vmUID)
+	l.notify(vmUID)
+}
+
+// Prune drops the console output of VMs that no longer exist.
+func (l *ConsoleLogs) Prune(vmUIDs map[types.UID]bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	for vmUID := range l.buffers {
+		if !vmUIDs[vmUID] {
+			delete(l.buffers, vmUID)
+		}
+	}
+	for vmUID := range l.watchers {
+		if !vmUIDs[vmUID] {
+			l.notify(vmUID)
+		}
+	}
+}
+
+// parseConsoleOutput extracts the stdout stream, where Cloud Hypervisor writes
+// the serial console, from lines in the CRI log format:
+// "<timestamp> <stream> <P|F> <content>". Partial lines are joined with the
+// lines that complete them.
+func parseConsoleOutput(podLog []byte) []byte {
+	var output []byte
+	for _, line := range bytes.Split(podLog, []byte("\n")) {
+		fields := bytes.SplitN(line, []byte(" "), 4)
+		if len(fields) != 4 || string(fields[1]) != "stdout" {
+			continue
+		}
+		output = append(output, fields[3]...)
+		if string(fields[2]) != "P" {
+			output = append(output, '\n')
+		}
+	}
+	return output
+}
+
+// ringBuffer keeps the last bytes written to it, up to its size.
+type ringBuffer struct {
+	data []byte
+	next int
+	full bool
+	// written is the number of bytes ever written
+	written int64
+}
+
+func newRingBuffer(size int) *ringBuffer {
+	return &ringBuffer{
+		data: make([]byte, size),
+	}
+}
+
+func (b *ringBuffer) Write(p []byte) {
+	b.written += int64(len(p))
+	if len(p) >= len(b.data) {
+		copy(b.data, p[len(p)-len(b.data):])
+		b.next = 0
+		b.full = true
+		return
+	}
+
+	n := copy(b.data[b.next:], p)
+	copy(b.data, p[n:])
+	if b.next+len(p) >= len(b.data) {
+		b.full = true
+	}
+	b.next = (b.next + len(p)) % len(b.data)
+}
+
+func (b *ringBuffer) Bytes() []byte {
+	if !b.full {
+		return append([]byte(nil), b.data[:b.next]...)
+	}
+	return append(append([]byte(nil), b.data[b.next:]...), b.data[:b.next]...)
+}
@@ -0,0 +1,160 @@
+package daemon
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"path/filepath"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/tlsutil"
+)
+
+// DebugServer serves pprof profiles, expvar variables and a dump of the
+// per-VM state kept by the daemon, for debugging in the field. It serves
+// plain HTTP if bound to a loopback address. Otherwise clients must present
+// certificates signed by the virt-daemon CA, as for Server.
+type DebugServer struct {
+	Addr        string
+	CertDirPath string
+	Reconciler  *VMReconciler
+}
+
+func (s *DebugServer) Start(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.Addr)
+	if err != nil {
+		return fmt.Errorf("listen: %s", err)
+	}
+	if !isLoopbackAddr(s.Addr) {
+		listener = tls.NewListener(listener, tlsutil.NewServerConfig(s.CertDirPath))
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.HandleFunc("/debug/vms", s.handleVMs)
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+
+	if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func isLoopbackAddr(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// vmDebugState is the state kept by the daemon for a VM on the node.
+type vmDebugState struct {
+	Namespace          string                                    `json:"namespace"`
+	Name               string                                    `json:"name"`
+	UID                types.UID                                 `json:"uid"`
+	Phase              virtv1alpha1.VirtualMachinePhase          `json:"phase"`
+	PowerAction        virtv1alpha1.VirtualMachinePowerAction    `json:"powerAction,omitempty"`
+	MigrationPhase     virtv1alpha1.VirtualMachineMigrationPhase `json:"migrationPhase,omitempty"`
+	SocketPath         string                                    `json:"socketPath,omitempty"`
+	TargetSocketPath   string                                    `json:"targetSocketPath,omitempty"`
+	SendingMigration   bool                                      `json:"sendingMigration,omitempty"`
+	ReceivingMigration bool                                      `json:"receivingMigration,omitempty"`
+	PodLogOffset       int64                                     `json:"podLogOffset,omitempty"`
+	LastError          string                                    `json:"lastError,omitempty"`
+	LastErrorTime      *metav1.Time                              `json:"lastErrorTime,omitempty"`
+}
+
+// vmError is the last error reconciling a VM.
+type vmError struct {
+	Message string
+	Time    metav1.Time
+}
+
+func (r *VMReconciler) recordLastError(vmUID types.UID, err error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.lastErrors[vmUID] = vmError{
+		Message: err.Error(),
+		Time:    metav1.Now(),
+	}
+}
+
+// handleVMs dumps the state of the VMs on the node, or being migrated to it,
+// as JSON.
+func (s *DebugServer) handleVMs(w http.ResponseWriter, r *http.Request) {
+	var vmList virtv1alpha1.VirtualMachineList
+	if err := s.Reconciler.List(r.Context(), &vmList); err != nil {
+		http.Error(w, fmt.Sprintf("list VMs: %s", err), http.StatusInternalServerError)
+		return
+	}
+
+	s.Reconciler.mutex.Lock()
+	var states []vmDebugState
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+		isSource := vm.Status.NodeName == s.Reconciler.NodeName
+		isTarget := vm.Status.Migration != nil && vm.Status.Migration.TargetNodeName == s.Reconciler.NodeName
+		if !isSource && !isTarget {
+			continue
+		}
+
+		state := vmDebugState{
+			Namespace:   vm.Namespace,
+			Name:        vm.Name,
+			UID:         vm.UID,
+			Phase:       vm.Status.Phase,
+			PowerAction: vm.Status.PowerAction,
+		}
+		if isSource && vm.Status.VMPodUID != "" {
+			state.SocketPath = filepath.Join(getVMSocketDirPath(vm), "ch.sock")
+			state.PodLogOffset = s.Reconciler.vmPodLogOffsets[vm.Status.VMPodUID]
+		}
+		if vm.Status.Migration != nil {
+			state.MigrationPhase = vm.Status.Migration.Phase
+			if isTarget && vm.Status.Migration.TargetVMPodUID != "" {
+				state.TargetSocketPath = filepath.Join(getMigrationTargetVMSocketDirPath(vm), "ch.sock")
+			}
+		}
+		if migrationControlBlock, ok := s.Reconciler.migrationControlBlocks[vm.UID]; ok {
+			state.SendingMigration = migrationControlBlock.SendMigrationCancelFunc != nil
+			state.ReceivingMigration = migrationControlBlock.ReceiveMigrationCancelFunc != nil
+		}
+		if lastError, ok := s.Reconciler.lastErrors[vm.UID]; ok {
+			state.LastError = lastError.Message
+			state.LastErrorTime = &lastError.Time
+		}
+		states = append(states, state)
+	}
+	s.Reconciler.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.Encode(states)
+}
@@ -7,12 +7,15 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc"
 	"gopkg.in/fsnotify.v1"
 	devicepluginv1beta1 "k8s.io/kubelet/pkg/apis/deviceplugin/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
 )
 
 const (
@@ -23,18 +26,38 @@ const (
 )
 
 type devicePluginManager struct {
-	devicePlugins []*devicePlugin
+	devicePlugins    []*devicePlugin
+	vcpuDevicePlugin *devicePlugin
 }
 
-func NewDevicePluginManager() *devicePluginManager {
+// NewDevicePluginManager returns the manager of the device plugins of the
+// node, which publish a resource of each host USB device model in usbDevices,
+// keyed by name, besides the devices every VM needs.
+func NewDevicePluginManager(usbDevices map[string]USBDeviceModel) *devicePluginManager {
+	// vCPUs are not devices, but are published the same way so that the
+	// kubelet accounts them
+	vcpuDevicePlugin := newDevicePlugin("vcpu", "", 0)
+	vcpuDevicePlugin.resourceName = virtv1beta1.VCPUResourceName
+	devicePlugins := []*devicePlugin{
+		newDevicePlugin("kvm", "/dev/kvm", 1000),
+		newDevicePlugin("tun", "/dev/net/tun", 1000),
+		newDevicePlugin("vhost-net", "/dev/vhost-net", 1000),
+		vcpuDevicePlugin,
+	}
+	for name, model := range usbDevices {
+		devicePlugins = append(devicePlugins, newUSBDevicePlugin(name, model))
+	}
 	return &devicePluginManager{
-		devicePlugins: []*devicePlugin{
-			newDevicePlugin("kvm", "/dev/kvm", 1000),
-			newDevicePlugin("tun", "/dev/net/tun", 1000),
-		},
+		devicePlugins:    devicePlugins,
+		vcpuDevicePlugin: vcpuDevicePlugin,
 	}
 }
 
+// SetVCPUCount sets the capacity of virtink.io/vcpu published for the node.
+func (dpm *devicePluginManager) SetVCPUCount(count int) {
+	dpm.vcpuDevicePlugin.setDeviceCount(count)
+}
+
 func (dpm *devicePluginManager) Start(ctx context.Context) error {
 	for _, dp := range dpm.devicePlugins {
 		dp.Start()
@@ -49,26 +72,78 @@ func (dpm *devicePluginManager) Start(ctx context.Context) error {
 }
 
 type devicePlugin struct {
-	deviceName string
+	deviceName   string
+	resourceName string
+	// devicePath is the device file mounted into containers. Nothing is
+	// mounted if empty.
 	devicePath string
-	devices    []*devicepluginv1beta1.Device
-	socketPath string
-	server     *grpc.Server
-	health     chan string
+	// usbDeviceModel is the model of the host USB devices published, which
+	// are discovered in sysfs instead of counted.
+	usbDeviceModel *USBDeviceModel
+	devices        []*devicepluginv1beta1.Device
+	mutex          sync.Mutex
+	socketPath     string
+	server         *grpc.Server
+	health         chan string
+	update         chan struct{}
 }
 
 func newDevicePlugin(deviceName string, devicePath string, deviceCount int) *devicePlugin {
 	dp := &devicePlugin{
-		deviceName: deviceName,
-		devicePath: devicePath,
+		deviceName:   deviceName,
+		resourceName: resourceNamePrefix + deviceName,
+		devicePath:   devicePath,
+		update:       make(chan struct{}, 1),
+	}
+	dp.setDeviceCount(deviceCount)
+	return dp
+}
+
+// setDeviceCount changes the number of devices, which is sent to the kubelet
+// by ListAndWatch.
+func (dp *devicePlugin) setDeviceCount(count int) {
+	var ids []string
+	for i := 1; i <= count; i++ {
+		ids = append(ids, dp.deviceName+strconv.Itoa(i))
+	}
+	dp.setDeviceIDs(ids)
+}
+
+func (dp *devicePlugin) setDeviceIDs(ids []string) {
+	dp.mutex.Lock()
+	defer dp.mutex.Unlock()
+	if len(ids) == len(dp.devices) {
+		changed := false
+		for i, id := range ids {
+			if dp.devices[i].ID != id {
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			return
+		}
 	}
-	for i := 1; i <= deviceCount; i++ {
-		dp.devices = append(dp.devices, &devicepluginv1beta1.Device{
-			ID:     deviceName + strconv.Itoa(i),
+
+	var devices []*devicepluginv1beta1.Device
+	for _, id := range ids {
+		devices = append(devices, &devicepluginv1beta1.Device{
+			ID:     id,
 			Health: devicepluginv1beta1.Healthy,
 		})
 	}
-	return dp
+	dp.devices = devices
+
+	select {
+	case dp.update <- struct{}{}:
+	default:
+	}
+}
+
+func (dp *devicePlugin) listDevices() []*devicepluginv1beta1.Device {
+	dp.mutex.Lock()
+	defer dp.mutex.Unlock()
+	return append([]*devicepluginv1beta1.Device(nil), dp.devices...)
 }
 
 func (dp *devicePlugin) Start() {
@@ -126,7 +201,7 @@ func (dp *devicePlugin) register() error {
 	req := &devicepluginv1beta1.RegisterRequest{
 		Version:      "v1beta1",
 		Endpoint:     filepath.Base(dp.socketPath),
-		ResourceName: fmt.Sprintf("%s%s", resourceNamePrefix, dp.deviceName),
+		ResourceName: dp.resourceName,
 	}
 	if _, err := client.Register(context.Background(), req); err != nil {
 		return fmt.Errorf("register to kubelet: %s", err)
@@ -144,22 +219,33 @@ func (dp *devicePlugin) GetDevicePluginOptions(ctx context.Context, req *devicep
 }
 
 func (dp *devicePlugin) ListAndWatch(req *devicepluginv1beta1.Empty, stream devicepluginv1beta1.DevicePlugin_ListAndWatchServer) error {
-	resp := &devicepluginv1beta1.ListAndWatchResponse{Devices: dp.devices}
+	resp := &devicepluginv1beta1.ListAndWatchResponse{Devices: dp.listDevices()}
 	stream.Send(resp)
 
 	tick := time.NewTicker(30 * time.Second)
 	for {
 		select {
 		case health := <-dp.health:
+			dp.mutex.Lock()
 			for _, dev := range dp.devices {
 				dev.Health = health
 			}
-			resp := &devicepluginv1beta1.ListAndWatchResponse{Devices: dp.devices}
+			dp.mutex.Unlock()
+			resp := &devicepluginv1beta1.ListAndWatchResponse{Devices: dp.listDevices()}
+			if err := stream.Send(resp); err != nil {
+				return fmt.Errorf("send response: %s", err)
+			}
+		case <-dp.update:
+			resp := &devicepluginv1beta1.ListAndWatchResponse{Devices: dp.listDevices()}
 			if err := stream.Send(resp); err != nil {
 				return fmt.Errorf("send response: %s", err)
 			}
 		case <-tick.C:
-			resp := &devicepluginv1beta1.ListAndWatchResponse{Devices: dp.devices}
+			// USB devices may be plugged or unplugged at any time
+			if dp.usbDeviceModel != nil {
+				dp.discoverUSBDevices()
+			}
+			resp := &devicepluginv1beta1.ListAndWatchResponse{Devices: dp.listDevices()}
 			if err := stream.Send(resp); err != nil {
 				return fmt.Errorf("send response: %s", err)
 			}
@@ -171,7 +257,7 @@ func (dp *devicePlugin) Allocate(ctx context.Context, req *devicepluginv1beta1.A
 	var containerResps []*devicepluginv1beta1.ContainerAllocateResponse
 	for _, containerReq := range req.ContainerRequests {
 		var devices []*devicepluginv1beta1.DeviceSpec
-		for i := 0; i < len(containerReq.DevicesIDs); i++ {
+		for i := 0; i < len(containerReq.DevicesIDs) && dp.devicePath != ""; i++ {
 			devices = append(devices, &devicepluginv1beta1.DeviceSpec{
 				HostPath:      dp.devicePath,
 				ContainerPath: dp.devicePath,
@@ -182,6 +268,14 @@ func (dp *devicePlugin) Allocate(ctx context.Context, req *devicepluginv1beta1.A
 		containerResp := &devicepluginv1beta1.ContainerAllocateResponse{
 			Devices: devices,
 		}
+		if dp.usbDeviceModel != nil {
+			usbDevices, usbDeviceSpecs, err := allocateUSBDevices(containerReq.DevicesIDs)
+			if err != nil {
+				return nil, err
+			}
+			containerResp.Devices = usbDeviceSpecs
+			containerResp.Envs = map[string]string{USBDeviceEnvName(dp.resourceName): usbDevices}
+		}
 		containerResps = append(containerResps, containerResp)
 	}
 	return &devicepluginv1beta1.AllocateResponse{
@@ -220,8 +314,10 @@ func (dp *devicePlugin) healthCheck() error {
 	}
 	defer watcher.Close()
 
-	if err := watcher.Add(filepath.Dir(dp.devicePath)); err != nil {
-		return fmt.Errorf("start watching %s: %s", dp.devicePath, err)
+	if dp.devicePath != "" {
+		if err := watcher.Add(filepath.Dir(dp.devicePath)); err != nil {
+			return fmt.Errorf("start watching %s: %s", dp.devicePath, err)
+		}
 	}
 
 	if err := watcher.Add(filepath.Dir(dp.socketPath)); err != nil {
@@ -234,7 +330,7 @@ func (dp *devicePlugin) healthCheck() error {
 			if !ok {
 				continue
 			}
-			if dp.devicePath == event.Name {
+			if dp.devicePath != "" && dp.devicePath == event.Name {
 				if event.Op == fsnotify.Remove || event.Op == fsnotify.Rename {
 					ctrl.Log.Info("device file was removed", "device", dp.devicePath)
 					dp.health <- devicepluginv1beta1.Unhealthy
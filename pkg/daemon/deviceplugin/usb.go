@@ -0,0 +1,170 @@
+package deviceplugin
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	devicepluginv1beta1 "k8s.io/kubelet/pkg/apis/deviceplugin/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const usbDevicesSysfsDir = "/sys/bus/usb/devices"
+
+// USBDeviceModel selects host USB devices by their vendor and product IDs.
+type USBDeviceModel struct {
+	VendorID  string
+	ProductID string
+}
+
+var (
+	usbDeviceModelRegexp = regexp.MustCompile(`^[0-9a-f]{4}:[0-9a-f]{4}$`)
+	envNameInvalidChars  = regexp.MustCompile(`[^A-Za-z0-9]`)
+)
+
+// ParseUSBDeviceModels parses the host USB device models to publish from a
+// comma separated list of <name>=<vendor ID>:<product ID>, e.g.
+// "dongle=0529:0001". Each model is published as the resource
+// devices.virtink.io/usb-<name>.
+func ParseUSBDeviceModels(s string) (map[string]USBDeviceModel, error) {
+	models := map[string]USBDeviceModel{}
+	if s == "" {
+		return models, nil
+	}
+	for _, entry := range strings.Split(s, ",") {
+		name, id, ok := strings.Cut(entry, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid USB device %q: must be <name>=<vendor ID>:<product ID>", entry)
+		}
+		id = strings.ToLower(id)
+		if !usbDeviceModelRegexp.MatchString(id) {
+			return nil, fmt.Errorf("invalid USB device ID %q: must be 4 hex digits of vendor and product separated by a colon", id)
+		}
+		if _, ok := models[name]; ok {
+			return nil, fmt.Errorf("duplicate USB device %q", name)
+		}
+		models[name] = USBDeviceModel{
+			VendorID:  id[:4],
+			ProductID: id[5:],
+		}
+	}
+	return models, nil
+}
+
+// USBDeviceEnvName returns the name of the environment variable of a container
+// listing the host USB devices of the resource allocated to the container, as
+// comma separated <bus>:<address> pairs.
+func USBDeviceEnvName(resourceName string) string {
+	return "USB_DEVICE_" + strings.ToUpper(envNameInvalidChars.ReplaceAllString(resourceName, "_"))
+}
+
+func newUSBDevicePlugin(name string, model USBDeviceModel) *devicePlugin {
+	dp := &devicePlugin{
+		deviceName:     "usb-" + name,
+		resourceName:   resourceNamePrefix + "usb-" + name,
+		usbDeviceModel: &model,
+		update:         make(chan struct{}, 1),
+	}
+	dp.discoverUSBDevices()
+	return dp
+}
+
+// discoverUSBDevices publishes the host USB devices of the model, identified
+// by <bus>-<address>.
+func (dp *devicePlugin) discoverUSBDevices() {
+	usbDevices, err := findUSBDevices(usbDevicesSysfsDir, *dp.usbDeviceModel)
+	if err != nil {
+		ctrl.Log.Error(err, "discover USB devices", "device", dp.deviceName)
+		return
+	}
+	var ids []string
+	for _, usbDevice := range usbDevices {
+		ids = append(ids, fmt.Sprintf("%03d-%03d", usbDevice.bus, usbDevice.addr))
+	}
+	dp.setDeviceIDs(ids)
+}
+
+type usbDevice struct {
+	bus  int
+	addr int
+}
+
+// findUSBDevices returns the USB devices of the model in the sysfs dir, in the
+// order of the directory entries.
+func findUSBDevices(sysfsDir string, model USBDeviceModel) ([]usbDevice, error) {
+	entries, err := os.ReadDir(sysfsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var usbDevices []usbDevice
+	for _, entry := range entries {
+		// interfaces such as 1-1:1.0 have no IDs of their own
+		if strings.Contains(entry.Name(), ":") {
+			continue
+		}
+		deviceDir := filepath.Join(sysfsDir, entry.Name())
+		vendorID, err := readSysfsAttr(deviceDir, "idVendor")
+		if err != nil {
+			continue
+		}
+		productID, err := readSysfsAttr(deviceDir, "idProduct")
+		if err != nil {
+			continue
+		}
+		if vendorID != model.VendorID || productID != model.ProductID {
+			continue
+		}
+
+		busNum, err := readSysfsAttr(deviceDir, "busnum")
+		if err != nil {
+			return nil, err
+		}
+		devNum, err := readSysfsAttr(deviceDir, "devnum")
+		if err != nil {
+			return nil, err
+		}
+		bus, err := strconv.Atoi(busNum)
+		if err != nil {
+			return nil, fmt.Errorf("parse bus of USB device %s: %s", entry.Name(), err)
+		}
+		addr, err := strconv.Atoi(devNum)
+		if err != nil {
+			return nil, fmt.Errorf("parse address of USB device %s: %s", entry.Name(), err)
+		}
+		usbDevices = append(usbDevices, usbDevice{bus: bus, addr: addr})
+	}
+	return usbDevices, nil
+}
+
+func readSysfsAttr(dir string, name string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// allocateUSBDevices returns the value of the environment variable listing the
+// USB devices of the IDs and the device files of the devices to mount.
+func allocateUSBDevices(ids []string) (string, []*devicepluginv1beta1.DeviceSpec, error) {
+	var usbDevices []string
+	var deviceSpecs []*devicepluginv1beta1.DeviceSpec
+	for _, id := range ids {
+		var bus, addr int
+		if _, err := fmt.Sscanf(id, "%d-%d", &bus, &addr); err != nil {
+			return "", nil, fmt.Errorf("invalid USB device ID %q: %s", id, err)
+		}
+		usbDevices = append(usbDevices, fmt.Sprintf("%d:%d", bus, addr))
+		devicePath := fmt.Sprintf("/dev/bus/usb/%03d/%03d", bus, addr)
+		deviceSpecs = append(deviceSpecs, &devicepluginv1beta1.DeviceSpec{
+			HostPath:      devicePath,
+			ContainerPath: devicePath,
+			Permissions:   "rw",
+		})
+	}
+	return strings.Join(usbDevices, ","), deviceSpecs, nil
+}
@@ -0,0 +1,58 @@
+package deviceplugin
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUSBDeviceModels(t *testing.T) {
+	models, err := ParseUSBDeviceModels("dongle=0529:0001,reader=076B:3031")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]USBDeviceModel{
+		"dongle": {VendorID: "0529", ProductID: "0001"},
+		"reader": {VendorID: "076b", ProductID: "3031"},
+	}, models)
+
+	models, err = ParseUSBDeviceModels("")
+	assert.NoError(t, err)
+	assert.Empty(t, models)
+
+	for _, s := range []string{"dongle", "=0529:0001", "dongle=0529", "dongle=0529:0001,dongle=0529:0002"} {
+		_, err := ParseUSBDeviceModels(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestFindUSBDevices(t *testing.T) {
+	sysfsDir := t.TempDir()
+	for name, attrs := range map[string]map[string]string{
+		"1-1":     {"idVendor": "0529", "idProduct": "0001", "busnum": "1", "devnum": "4"},
+		"1-1:1.0": {},
+		"1-2":     {"idVendor": "0529", "idProduct": "0002", "busnum": "1", "devnum": "5"},
+		"2-1":     {"idVendor": "0529", "idProduct": "0001", "busnum": "2", "devnum": "12"},
+		"usb1":    {"idVendor": "1d6b", "idProduct": "0002", "busnum": "1", "devnum": "1"},
+	} {
+		assert.NoError(t, os.Mkdir(filepath.Join(sysfsDir, name), 0755))
+		for attr, value := range attrs {
+			assert.NoError(t, os.WriteFile(filepath.Join(sysfsDir, name, attr), []byte(value+"\n"), 0644))
+		}
+	}
+
+	usbDevices, err := findUSBDevices(sysfsDir, USBDeviceModel{VendorID: "0529", ProductID: "0001"})
+	assert.NoError(t, err)
+	assert.Equal(t, []usbDevice{{bus: 1, addr: 4}, {bus: 2, addr: 12}}, usbDevices)
+}
+
+func TestAllocateUSBDevices(t *testing.T) {
+	env, deviceSpecs, err := allocateUSBDevices([]string{"001-004", "002-012"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1:4,2:12", env)
+	assert.Len(t, deviceSpecs, 2)
+	assert.Equal(t, "/dev/bus/usb/001/004", deviceSpecs[0].HostPath)
+	assert.Equal(t, "/dev/bus/usb/002/012", deviceSpecs[1].ContainerPath)
+
+	assert.Equal(t, "USB_DEVICE_DEVICES_VIRTINK_IO_USB_DONGLE", USBDeviceEnvName("devices.virtink.io/usb-dongle"))
+}
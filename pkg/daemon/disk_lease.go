@@ -0,0 +1,226 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/disklease"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+// heldDiskLeases are the disk leases held by a VM pod on the node. They are
+// renewed for as long as its VMM runs, and released once it stops.
+type heldDiskLeases struct {
+	// VM is the VM as of when the leases were acquired, with the VM pod as
+	// its current VM pod.
+	VM         *virtv1alpha1.VirtualMachine
+	ClaimNames []string
+	// RenewTime is when the last successful renewal started.
+	RenewTime time.Time
+	// Booted is whether the VMM was seen running the VM.
+	Booted bool
+}
+
+// acquireDiskLeases acquires the disk leases of the VM pod of a scheduled VM,
+// and then lets virt-prerunner start the VMM.
+func (r *VMReconciler) acquireDiskLeases(ctx context.Context, vm *virtv1alpha1.VirtualMachine) error {
+	socketDirPath := getVMSocketDirPath(vm)
+	acquiredFilePath := filepath.Join(socketDirPath, disklease.AcquiredFileName)
+	if _, err := os.Stat(acquiredFilePath); err == nil {
+		return nil
+	}
+	if _, err := os.Stat(socketDirPath); err != nil {
+		// the VM pod has not started yet
+		return nil
+	}
+
+	config, err := virtinkconfig.Get(ctx, r.Client)
+	if err != nil {
+		return fmt.Errorf("get Virtink config: %s", err)
+	}
+	if claimNames := disklease.GetClaimNames(vm); config.Spec.Storage.DiskLeases.Enabled && len(claimNames) > 0 {
+		if err := r.renewDiskLeases(ctx, vm, claimNames, getDiskLeaseDuration(config), false); err != nil {
+			for _, claimName := range claimNames {
+				disklease.Release(ctx, r.LeaseClient, vm.Namespace, claimName, vm.Status.VMPodUID)
+			}
+			return err
+		}
+	}
+
+	if err := os.WriteFile(acquiredFilePath, nil, 0644); err != nil {
+		return fmt.Errorf("create %s: %s", disklease.AcquiredFileName, err)
+	}
+	return nil
+}
+
+// renewDiskLeases acquires or renews the disk leases of the current VM pod
+// of the VM, and records them as held by it.
+func (r *VMReconciler) renewDiskLeases(ctx context.Context, vm *virtv1alpha1.VirtualMachine, claimNames []string, duration time.Duration, takeOver bool) error {
+	renewTime := time.Now()
+	for _, claimName := range claimNames {
+		if err := disklease.Acquire(ctx, r.LeaseClient, vm.Namespace, claimName, vm.Status.VMPodUID, duration, takeOver); err != nil {
+			return err
+		}
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if held, ok := r.diskLeases[vm.Status.VMPodUID]; ok {
+		held.RenewTime = renewTime
+		return nil
+	}
+	r.diskLeases[vm.Status.VMPodUID] = &heldDiskLeases{
+		VM:         vm.DeepCopy(),
+		ClaimNames: claimNames,
+		RenewTime:  renewTime,
+	}
+	return nil
+}
+
+func (r *VMReconciler) releaseDiskLeases(ctx context.Context, podUID types.UID, held *heldDiskLeases) error {
+	for _, claimName := range held.ClaimNames {
+		if err := disklease.Release(ctx, r.LeaseClient, held.VM.Namespace, claimName, podUID); err != nil {
+			return fmt.Errorf("release lease of PVC %q: %s", claimName, err)
+		}
+	}
+
+	r.mutex.Lock()
+	delete(r.diskLeases, podUID)
+	r.mutex.Unlock()
+	return nil
+}
+
+func getDiskLeaseDuration(config *virtv1beta1.VirtinkConfig) time.Duration {
+	if config.Spec.Storage.DiskLeases.DurationSeconds > 0 {
+		return time.Duration(config.Spec.Storage.DiskLeases.DurationSeconds) * time.Second
+	}
+	return disklease.DefaultDuration
+}
+
+// diskLeaseKeeper renews the disk leases held by VM pods on the node six
+// times per lease duration, and releases them once their VMMs stop. A VMM
+// whose leases are taken over, or can't be renewed for half the lease
+// duration, e.g. as the node is cut off from the API server, is powered off
+// before the leases expire and its VM is restarted elsewhere.
+type diskLeaseKeeper struct {
+	reconciler *VMReconciler
+}
+
+func newDiskLeaseKeeper(r *VMReconciler) *diskLeaseKeeper {
+	return &diskLeaseKeeper{
+		reconciler: r,
+	}
+}
+
+func (k *diskLeaseKeeper) Start(ctx context.Context) error {
+	for {
+		interval := k.renew(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (k *diskLeaseKeeper) renew(ctx context.Context) time.Duration {
+	log := ctrl.LoggerFrom(ctx).WithName("disk-lease-keeper")
+	r := k.reconciler
+
+	config, err := virtinkconfig.Get(ctx, r.Client)
+	if err != nil {
+		log.Error(err, "get Virtink config")
+		return disklease.DefaultDuration / 6
+	}
+	enabled := config.Spec.Storage.DiskLeases.Enabled
+	duration := getDiskLeaseDuration(config)
+
+	var vmList virtv1alpha1.VirtualMachineList
+	if err := r.List(ctx, &vmList); err != nil {
+		log.Error(err, "list VMs")
+		return duration / 6
+	}
+	vmsByPodUID := map[types.UID]*virtv1alpha1.VirtualMachine{}
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+		if vm.Status.NodeName == r.NodeName && vm.Status.VMPodUID != "" {
+			vmsByPodUID[vm.Status.VMPodUID] = vm
+		}
+	}
+
+	r.mutex.Lock()
+	heldByPodUID := make(map[types.UID]*heldDiskLeases, len(r.diskLeases))
+	for podUID, held := range r.diskLeases {
+		heldByPodUID[podUID] = held
+	}
+	r.mutex.Unlock()
+
+	if enabled {
+		// VMs running without held leases, e.g. after virt-daemon restarts or
+		// once a migration to the node completes
+		for podUID, vm := range vmsByPodUID {
+			if _, ok := heldByPodUID[podUID]; ok || vm.Status.Phase != virtv1alpha1.VirtualMachineRunning {
+				continue
+			}
+			claimNames := disklease.GetClaimNames(vm)
+			if len(claimNames) == 0 {
+				continue
+			}
+			takeOver := vm.Status.Migration != nil && vm.Status.Migration.Phase == virtv1alpha1.VirtualMachineMigrationSucceeded &&
+				vm.Status.Migration.TargetVMPodUID == podUID
+			if err := r.renewDiskLeases(ctx, vm, claimNames, duration, takeOver); err != nil {
+				r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedAcquireDiskLease", "Failed to acquire disk leases: %s", err)
+			}
+		}
+	}
+
+	for podUID, held := range heldByPodUID {
+		vm, isCurrent := vmsByPodUID[podUID]
+		if !isCurrent {
+			vm = held.VM
+		}
+
+		vmInfo, err := r.getVMM(held.VM).VmInfo(ctx)
+		running := err == nil && (vmInfo.State == "Running" || vmInfo.State == "Paused")
+		r.mutex.Lock()
+		if running {
+			held.Booted = true
+		}
+		booted, renewTime := held.Booted, held.RenewTime
+		r.mutex.Unlock()
+		if !enabled || (!running && (booted || !isCurrent)) {
+			if err := r.releaseDiskLeases(ctx, podUID, held); err != nil {
+				log.Error(err, "release disk leases", "podUID", podUID)
+			}
+			continue
+		}
+
+		err = r.renewDiskLeases(ctx, vm, held.ClaimNames, duration, false)
+		if err == nil {
+			continue
+		}
+		var heldErr *disklease.HeldError
+		if !errors.As(err, &heldErr) && time.Since(renewTime) < duration/2 {
+			log.Error(err, "renew disk leases", "podUID", podUID)
+			continue
+		}
+
+		if err := r.getVMM(held.VM).VmShutdown(ctx); err != nil {
+			log.Error(err, "power off VM with lost disk leases", "podUID", podUID)
+			continue
+		}
+		log.Info("powered off VM with lost disk leases", "podUID", podUID, "error", err.Error())
+		r.Recorder.Eventf(vm, corev1.EventTypeWarning, "LostDiskLease", "Powered off VM as its disk leases are lost: %s", err)
+	}
+	return duration / 6
+}
@@ -0,0 +1,223 @@
+package daemon
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+// DownwardMetrics serves metrics of the node and VMs to agents in the guests.
+// Guests connect to the metrics port on the host (CID 2), which the hybrid
+// vsock of the VMM forwards to a UNIX socket next to its vsock socket, and
+// read a JSON document of the metrics at the time.
+type DownwardMetrics struct {
+	NodeName string
+
+	mutex     sync.Mutex
+	listeners map[types.UID]*downwardMetricsListener
+}
+
+type downwardMetricsListener struct {
+	vmPodUID types.UID
+	listener net.Listener
+}
+
+type downwardMetrics struct {
+	Timestamp time.Time           `json:"timestamp"`
+	VM        downwardMetricsVM   `json:"vm"`
+	Node      downwardMetricsNode `json:"node"`
+}
+
+type downwardMetricsVM struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	UID       string `json:"uid"`
+}
+
+type downwardMetricsNode struct {
+	Name                 string    `json:"name"`
+	MemoryTotalBytes     int64     `json:"memoryTotalBytes"`
+	MemoryAvailableBytes int64     `json:"memoryAvailableBytes"`
+	LoadAverage          []float64 `json:"loadAverage"`
+	// pressures are only available on kernels with PSI enabled
+	CPUPressure    *downwardMetricsPressure `json:"cpuPressure,omitempty"`
+	MemoryPressure *downwardMetricsPressure `json:"memoryPressure,omitempty"`
+	IOPressure     *downwardMetricsPressure `json:"ioPressure,omitempty"`
+}
+
+// downwardMetricsPressure is the share of time in percent some or all tasks
+// were stalled on the resource in the last 10 seconds.
+type downwardMetricsPressure struct {
+	Some float64 `json:"some"`
+	Full float64 `json:"full"`
+}
+
+func NewDownwardMetrics(nodeName string) *DownwardMetrics {
+	return &DownwardMetrics{
+		NodeName:  nodeName,
+		listeners: map[types.UID]*downwardMetricsListener{},
+	}
+}
+
+// Serve starts serving metrics to the VM in the VM socket dir, unless they
+// are already served to its current VM pod.
+func (m *DownwardMetrics) Serve(vm *virtv1alpha1.VirtualMachine, socketDirPath string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if l, ok := m.listeners[vm.UID]; ok {
+		if l.vmPodUID == vm.Status.VMPodUID {
+			return nil
+		}
+		l.listener.Close()
+		delete(m.listeners, vm.UID)
+	}
+
+	socketPath := filepath.Join(socketDirPath, fmt.Sprintf("vsock.sock_%d", vm.Spec.Instance.Downward.MetricsPort))
+	// the socket may be left by a previous daemon
+	if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove socket: %s", err)
+	}
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return fmt.Errorf("listen: %s", err)
+	}
+	m.listeners[vm.UID] = &downwardMetricsListener{
+		vmPodUID: vm.Status.VMPodUID,
+		listener: listener,
+	}
+
+	metricsVM := downwardMetricsVM{
+		Namespace: vm.Namespace,
+		Name:      vm.Name,
+		UID:       string(vm.UID),
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				conn.SetDeadline(time.Now().Add(10 * time.Second))
+				json.NewEncoder(conn).Encode(downwardMetrics{
+					Timestamp: time.Now().UTC(),
+					VM:        metricsVM,
+					Node:      m.collectNodeMetrics(),
+				})
+			}()
+		}
+	}()
+	return nil
+}
+
+// Delete stops serving metrics to the VM.
+func (m *DownwardMetrics) Delete(vmUID types.UID) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if l, ok := m.listeners[vmUID]; ok {
+		l.listener.Close()
+		delete(m.listeners, vmUID)
+	}
+}
+
+// Prune stops serving metrics to VMs that no longer exist.
+func (m *DownwardMetrics) Prune(vmUIDs map[types.UID]bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for vmUID, l := range m.listeners {
+		if !vmUIDs[vmUID] {
+			l.listener.Close()
+			delete(m.listeners, vmUID)
+		}
+	}
+}
+
+// collectNodeMetrics reads the metrics of the node from procfs, leaving out
+// the ones that can't be read.
+func (m *DownwardMetrics) collectNodeMetrics() downwardMetricsNode {
+	metrics := downwardMetricsNode{
+		Name: m.NodeName,
+	}
+
+	if meminfo, err := readProcFields("/proc/meminfo"); err == nil {
+		// values in /proc/meminfo are in KiB
+		if fields := meminfo["MemTotal:"]; len(fields) > 0 {
+			value, _ := strconv.ParseInt(fields[0], 10, 64)
+			metrics.MemoryTotalBytes = value << 10
+		}
+		if fields := meminfo["MemAvailable:"]; len(fields) > 0 {
+			value, _ := strconv.ParseInt(fields[0], 10, 64)
+			metrics.MemoryAvailableBytes = value << 10
+		}
+	}
+
+	if loadavg, err := os.ReadFile("/proc/loadavg"); err == nil {
+		fields := strings.Fields(string(loadavg))
+		for i := 0; i < 3 && i < len(fields); i++ {
+			value, _ := strconv.ParseFloat(fields[i], 64)
+			metrics.LoadAverage = append(metrics.LoadAverage, value)
+		}
+	}
+
+	metrics.CPUPressure = readPressure("/proc/pressure/cpu")
+	metrics.MemoryPressure = readPressure("/proc/pressure/memory")
+	metrics.IOPressure = readPressure("/proc/pressure/io")
+	return metrics
+}
+
+// readPressure reads the 10-second averages of a PSI file, of which each
+// line is like "some avg10=0.00 avg60=0.00 avg300=0.00 total=0".
+func readPressure(path string) *downwardMetricsPressure {
+	lines, err := readProcFields(path)
+	if err != nil {
+		return nil
+	}
+
+	var pressure downwardMetricsPressure
+	for kind, fields := range lines {
+		if len(fields) == 0 || !strings.HasPrefix(fields[0], "avg10=") {
+			continue
+		}
+		value, _ := strconv.ParseFloat(strings.TrimPrefix(fields[0], "avg10="), 64)
+		switch kind {
+		case "some":
+			pressure.Some = value
+		case "full":
+			pressure.Full = value
+		}
+	}
+	return &pressure
+}
+
+// readProcFields reads a procfs file into the fields of each line, keyed by
+// the first field.
+func readProcFields(path string) (map[string][]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	lines := map[string][]string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) > 0 {
+			lines[fields[0]] = fields[1:]
+		}
+	}
+	return lines, scanner.Err()
+}
@@ -0,0 +1,173 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/logging"
+	"github.com/smartxworks/virtink/pkg/vsock"
+)
+
+const (
+	// guestMetricsInterval matches the default sync period of
+	// HorizontalPodAutoscalers.
+	guestMetricsInterval = 15 * time.Second
+	guestMetricsTimeout  = 5 * time.Second
+)
+
+var (
+	vmGuestCPUUsageGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "virtink_vm_guest_cpu_usage_cores",
+		Help: "CPU usage in the guest of the VM, in vCPUs, as reported by the agent in the guest.",
+	}, []string{"namespace", "pod", "name"})
+	vmGuestMemoryUsageGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "virtink_vm_guest_memory_usage_bytes",
+		Help: "Memory in use in the guest of the VM, excluding reclaimable caches, as reported by the agent in the guest.",
+	}, []string{"namespace", "pod", "name"})
+	vmGuestMemoryTotalGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "virtink_vm_guest_memory_total_bytes",
+		Help: "Memory of the guest of the VM, as reported by the agent in the guest.",
+	}, []string{"namespace", "pod", "name"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(vmGuestCPUUsageGauge, vmGuestMemoryUsageGauge, vmGuestMemoryTotalGauge)
+}
+
+// guestMetrics is the JSON document served by the agent in the guest.
+type guestMetrics struct {
+	// CPUSeconds is the CPU time spent by all vCPUs out of idle since boot.
+	CPUSeconds           float64 `json:"cpuSeconds"`
+	MemoryTotalBytes     int64   `json:"memoryTotalBytes"`
+	MemoryAvailableBytes int64   `json:"memoryAvailableBytes"`
+}
+
+// guestMetricsCollector reads the metrics of the agents in the guests of the
+// VMs on the node through their vsock, and exports them labelled with the VM
+// pods, so that a Prometheus adapter can serve them as pod metrics to the
+// HorizontalPodAutoscalers of VM pools.
+type guestMetricsCollector struct {
+	client.Client
+	NodeName string
+
+	samples map[types.UID]guestCPUSample
+}
+
+type guestCPUSample struct {
+	PodUID     types.UID
+	CPUSeconds float64
+	Time       time.Time
+}
+
+func newGuestMetricsCollector(r *VMReconciler) *guestMetricsCollector {
+	return &guestMetricsCollector{
+		Client:   r.Client,
+		NodeName: r.NodeName,
+		samples:  map[types.UID]guestCPUSample{},
+	}
+}
+
+func (c *guestMetricsCollector) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, c.collect, guestMetricsInterval)
+	return nil
+}
+
+func (c *guestMetricsCollector) collect(ctx context.Context) {
+	log := ctrl.LoggerFrom(ctx).WithName("guest-metrics")
+
+	var vmList virtv1alpha1.VirtualMachineList
+	if err := c.List(ctx, &vmList); err != nil {
+		log.Error(err, "list VMs")
+		return
+	}
+
+	type gaugeValue struct {
+		Gauge  *prometheus.GaugeVec
+		Labels prometheus.Labels
+		Value  float64
+	}
+	var values []gaugeValue
+
+	vmUIDs := map[types.UID]bool{}
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+		if vm.Spec.Instance.GuestMetrics == nil || vm.Status.NodeName != c.NodeName || vm.Status.Phase != virtv1alpha1.VirtualMachineRunning || vm.Status.VMPodUID == "" {
+			continue
+		}
+		vmUIDs[vm.UID] = true
+
+		m, err := c.readGuestMetrics(ctx, vm)
+		if err != nil {
+			log.WithValues(logging.VMKeysAndValues(vm)...).Error(err, "read guest metrics")
+			delete(c.samples, vm.UID)
+			continue
+		}
+
+		labels := prometheus.Labels{"namespace": vm.Namespace, "pod": vm.Status.VMPodName, "name": vm.Name}
+		values = append(values,
+			gaugeValue{vmGuestMemoryTotalGauge, labels, float64(m.MemoryTotalBytes)},
+			gaugeValue{vmGuestMemoryUsageGauge, labels, float64(m.MemoryTotalBytes - m.MemoryAvailableBytes)})
+
+		sample := guestCPUSample{
+			PodUID:     vm.Status.VMPodUID,
+			CPUSeconds: m.CPUSeconds,
+			Time:       time.Now(),
+		}
+		if cores, ok := getGuestCPUUsage(c.samples[vm.UID], sample); ok {
+			values = append(values, gaugeValue{vmGuestCPUUsageGauge, labels, cores})
+		}
+		c.samples[vm.UID] = sample
+	}
+
+	for vmUID := range c.samples {
+		if !vmUIDs[vmUID] {
+			delete(c.samples, vmUID)
+		}
+	}
+
+	// series of VMs that are gone or whose agents stopped responding are
+	// dropped rather than left at their last values
+	vmGuestCPUUsageGauge.Reset()
+	vmGuestMemoryUsageGauge.Reset()
+	vmGuestMemoryTotalGauge.Reset()
+	for _, v := range values {
+		v.Gauge.With(v.Labels).Set(v.Value)
+	}
+}
+
+func (c *guestMetricsCollector) readGuestMetrics(ctx context.Context, vm *virtv1alpha1.VirtualMachine) (*guestMetrics, error) {
+	ctx, cancel := context.WithTimeout(ctx, guestMetricsTimeout)
+	defer cancel()
+
+	conn, err := vsock.Dial(ctx, filepath.Join(getVMSocketDirPath(vm), "vsock.sock"), vm.Spec.Instance.GuestMetrics.Port)
+	if err != nil {
+		return nil, fmt.Errorf("connect vsock: %s", err)
+	}
+	defer conn.Close()
+
+	var m guestMetrics
+	if err := json.NewDecoder(conn).Decode(&m); err != nil {
+		return nil, fmt.Errorf("decode metrics: %s", err)
+	}
+	return &m, nil
+}
+
+// getGuestCPUUsage returns the average number of vCPUs in use between two
+// samples, unless they are not of the same guest, which restarts counting.
+func getGuestCPUUsage(lastSample guestCPUSample, sample guestCPUSample) (float64, bool) {
+	if lastSample.PodUID != sample.PodUID || !sample.Time.After(lastSample.Time) || sample.CPUSeconds < lastSample.CPUSeconds {
+		return 0, false
+	}
+	return (sample.CPUSeconds - lastSample.CPUSeconds) / sample.Time.Sub(lastSample.Time).Seconds(), true
+}
@@ -0,0 +1,48 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetGuestCPUUsage(t *testing.T) {
+	now := time.Now()
+	lastSample := guestCPUSample{
+		PodUID:     "pod-uid",
+		CPUSeconds: 100,
+		Time:       now,
+	}
+
+	tests := []struct {
+		sample guestCPUSample
+		cores  float64
+		ok     bool
+	}{{
+		sample: guestCPUSample{PodUID: "pod-uid", CPUSeconds: 130, Time: now.Add(15 * time.Second)},
+		cores:  2,
+		ok:     true,
+	}, {
+		sample: guestCPUSample{PodUID: "pod-uid", CPUSeconds: 100, Time: now.Add(15 * time.Second)},
+		cores:  0,
+		ok:     true,
+	}, {
+		// the VM was migrated to another pod
+		sample: guestCPUSample{PodUID: "other-pod-uid", CPUSeconds: 130, Time: now.Add(15 * time.Second)},
+	}, {
+		// the guest was rebooted
+		sample: guestCPUSample{PodUID: "pod-uid", CPUSeconds: 10, Time: now.Add(15 * time.Second)},
+	}, {
+		sample: guestCPUSample{PodUID: "pod-uid", CPUSeconds: 130, Time: now},
+	}}
+
+	for _, tc := range tests {
+		cores, ok := getGuestCPUUsage(lastSample, tc.sample)
+		assert.Equal(t, tc.ok, ok)
+		assert.InDelta(t, tc.cores, cores, 1e-9)
+	}
+
+	_, ok := getGuestCPUUsage(guestCPUSample{}, lastSample)
+	assert.False(t, ok)
+}
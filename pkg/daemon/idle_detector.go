@@ -0,0 +1,228 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/conditions"
+	"github.com/smartxworks/virtink/pkg/logging"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+const (
+	idleDetectorInterval = time.Minute
+	// idleSuspendOptOutAnnotation keeps a VM from being paused or powered
+	// off when idle when set to "true".
+	idleSuspendOptOutAnnotation = "virtink.io/idle-suspend-opt-out"
+	// hostCgroupRoot is where the cgroup filesystem of the node is mounted.
+	hostCgroupRoot = "/host/sys/fs/cgroup"
+
+	defaultIdleCPUThresholdPercent = 5
+	defaultIdleMinutes             = 30
+)
+
+// idleDetector samples the CPU usage of the VM pods on the node from their
+// cgroups, and pauses or powers off VMs whose usage stays below the threshold
+// of the idle suspend policy for long enough.
+type idleDetector struct {
+	client.Client
+	Recorder record.EventRecorder
+	NodeName string
+
+	samples map[types.UID]cpuSample
+}
+
+type cpuSample struct {
+	PodUID types.UID
+	Usage  time.Duration
+	Time   time.Time
+	// IdleSince is the time of the first sample of the current idle period,
+	// or zero if the VM was busy in the last interval.
+	IdleSince time.Time
+}
+
+func newIdleDetector(r *VMReconciler) *idleDetector {
+	return &idleDetector{
+		Client:   r.Client,
+		Recorder: r.Recorder,
+		NodeName: r.NodeName,
+		samples:  map[types.UID]cpuSample{},
+	}
+}
+
+func (d *idleDetector) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, d.detect, idleDetectorInterval)
+	return nil
+}
+
+func (d *idleDetector) detect(ctx context.Context) {
+	log := ctrl.LoggerFrom(ctx).WithName("idle-detector")
+
+	config, err := virtinkconfig.Get(ctx, d.Client)
+	if err != nil {
+		log.Error(err, "get Virtink config")
+		return
+	}
+	policy := config.Spec.IdleSuspend
+	if policy.Action == "" || policy.Action == virtv1beta1.IdleSuspendNone {
+		d.samples = map[types.UID]cpuSample{}
+		return
+	}
+	threshold := policy.CPUThresholdPercent
+	if threshold == 0 {
+		threshold = defaultIdleCPUThresholdPercent
+	}
+	idleTime := time.Duration(policy.IdleMinutes) * time.Minute
+	if idleTime == 0 {
+		idleTime = defaultIdleMinutes * time.Minute
+	}
+
+	var vmList virtv1alpha1.VirtualMachineList
+	if err := d.List(ctx, &vmList); err != nil {
+		log.Error(err, "list VMs")
+		return
+	}
+
+	vmUIDs := map[types.UID]bool{}
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+		if vm.Status.NodeName != d.NodeName || vm.Status.Phase != virtv1alpha1.VirtualMachineRunning || vm.Status.VMPodUID == "" {
+			continue
+		}
+		vmUIDs[vm.UID] = true
+
+		if vm.Annotations[idleSuspendOptOutAnnotation] == "true" || vm.Status.Migration != nil || vm.Status.PowerAction != "" ||
+			conditions.IsTrue(vm.Status.Conditions, string(virtv1alpha1.VirtualMachinePaused)) {
+			delete(d.samples, vm.UID)
+			continue
+		}
+
+		usage, err := getPodCPUUsage(vm.Status.VMPodUID)
+		if err != nil {
+			log.WithValues(logging.VMKeysAndValues(vm)...).Error(err, "get VM pod CPU usage")
+			delete(d.samples, vm.UID)
+			continue
+		}
+
+		sample := cpuSample{
+			PodUID: vm.Status.VMPodUID,
+			Usage:  usage,
+			Time:   time.Now(),
+		}
+		if lastSample, ok := d.samples[vm.UID]; ok && lastSample.PodUID == sample.PodUID {
+			vCPUs := int(vm.Spec.Instance.CPU.Sockets * vm.Spec.Instance.CPU.CoresPerSocket)
+			percent := 100 * float64(sample.Usage-lastSample.Usage) / float64(sample.Time.Sub(lastSample.Time)) / float64(vCPUs)
+			if percent < float64(threshold) {
+				sample.IdleSince = lastSample.IdleSince
+				if sample.IdleSince.IsZero() {
+					sample.IdleSince = lastSample.Time
+				}
+			}
+		}
+		d.samples[vm.UID] = sample
+
+		if sample.IdleSince.IsZero() || sample.Time.Sub(sample.IdleSince) < idleTime {
+			continue
+		}
+		if err := d.suspendVM(ctx, vm, policy.Action, threshold, idleTime); err != nil {
+			log.WithValues(logging.VMKeysAndValues(vm)...).Error(err, "suspend idle VM")
+			continue
+		}
+		delete(d.samples, vm.UID)
+	}
+
+	for vmUID := range d.samples {
+		if !vmUIDs[vmUID] {
+			delete(d.samples, vmUID)
+		}
+	}
+}
+
+func (d *idleDetector) suspendVM(ctx context.Context, vm *virtv1alpha1.VirtualMachine, action virtv1beta1.IdleSuspendAction, threshold int, idleTime time.Duration) error {
+	switch action {
+	case virtv1beta1.IdleSuspendPause:
+		vm.Status.PowerAction = virtv1alpha1.VirtualMachinePause
+	case virtv1beta1.IdleSuspendPowerOff:
+		vm.Status.PowerAction = virtv1alpha1.VirtualMachinePowerOff
+	default:
+		return fmt.Errorf("unsupported idle suspend action %q", action)
+	}
+	if err := d.Status().Update(ctx, vm); err != nil {
+		return fmt.Errorf("update VM status: %s", err)
+	}
+	d.Recorder.Eventf(vm, corev1.EventTypeNormal, "IdleSuspended", "%s requested since CPU usage stayed below %d%% of the vCPUs for %s", vm.Status.PowerAction, threshold, idleTime)
+	return nil
+}
+
+// getPodCPUUsage returns the CPU time used by the pod so far, read from the
+// pod cgroup. Both cgroup v1 and v2 are supported.
+func getPodCPUUsage(podUID types.UID) (time.Duration, error) {
+	for _, hierarchy := range []string{"", "cpuacct"} {
+		if cgroupPath := findPodCgroupPath(podUID, hierarchy); cgroupPath != "" {
+			return readCgroupCPUUsage(cgroupPath)
+		}
+	}
+	return 0, fmt.Errorf("cgroup of pod %s not found", podUID)
+}
+
+// findPodCgroupPath returns the cgroup dir of the pod in the hierarchy, which
+// is empty for cgroup v2, or an empty string if it's not found. Both the
+// cgroupfs and the systemd cgroup drivers of the kubelet are supported.
+func findPodCgroupPath(podUID types.UID, hierarchy string) string {
+	systemdPodUID := strings.ReplaceAll(string(podUID), "-", "_")
+	patterns := []string{
+		"kubepods/pod" + string(podUID),
+		"kubepods/*/pod" + string(podUID),
+		"kubepods.slice/kubepods-pod" + systemdPodUID + ".slice",
+		"kubepods.slice/*/kubepods-*-pod" + systemdPodUID + ".slice",
+	}
+	for _, pattern := range patterns {
+		// the patterns are well-formed, so Glob never fails
+		cgroupPaths, _ := filepath.Glob(filepath.Join(hostCgroupRoot, hierarchy, pattern))
+		if len(cgroupPaths) > 0 {
+			return cgroupPaths[0]
+		}
+	}
+	return ""
+}
+
+func readCgroupCPUUsage(cgroupPath string) (time.Duration, error) {
+	// cgroup v2
+	if data, err := os.ReadFile(filepath.Join(cgroupPath, "cpu.stat")); err == nil {
+		for _, line := range strings.Split(string(data), "\n") {
+			fields := strings.Fields(line)
+			if len(fields) == 2 && fields[0] == "usage_usec" {
+				usec, err := strconv.ParseInt(fields[1], 10, 64)
+				if err != nil {
+					return 0, fmt.Errorf("parse cpu.stat: %s", err)
+				}
+				return time.Duration(usec) * time.Microsecond, nil
+			}
+		}
+	}
+
+	// cgroup v1
+	data, err := os.ReadFile(filepath.Join(cgroupPath, "cpuacct.usage"))
+	if err != nil {
+		return 0, err
+	}
+	nsec, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse cpuacct.usage: %s", err)
+	}
+	return time.Duration(nsec), nil
+}
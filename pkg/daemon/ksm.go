@@ -0,0 +1,209 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+const (
+	ksmInterval = 10 * time.Second
+	// hostKSMPath is where the KSM sysfs dir of the node is mounted, as /sys
+	// is read-only in the container.
+	hostKSMPath = "/host/sys/kernel/mm/ksm"
+
+	defaultKSMPagesToScan              = 100
+	defaultKSMSleepMilliseconds        = 20
+	defaultKSMPressureThresholdPercent = 5
+)
+
+var (
+	nodeKSMRunningGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "virtink_node_ksm_running",
+		Help: "Whether KSM is running on the node.",
+	}, []string{"node"})
+	nodeKSMPagesGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "virtink_node_ksm_pages",
+		Help: "Pages of KSM on the node by state: shared pages in use, more sites sharing them, and unshared pages scanned.",
+	}, []string{"node", "state"})
+	nodeKSMFullScansGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "virtink_node_ksm_full_scans",
+		Help: "Number of times KSM has scanned all mergeable memory on the node.",
+	}, []string{"node"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(nodeKSMRunningGauge, nodeKSMPagesGauge, nodeKSMFullScansGauge)
+}
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+
+// ksmController runs and tunes KSM on the node as the KSM policy of the
+// Virtink config asks, and exports the KSM counters of the node. Once the
+// policy no longer applies to the node, KSM is stopped if virt-daemon has been
+// running it, and left as it is otherwise.
+type ksmController struct {
+	client.Client
+	NodeName string
+
+	managed bool
+	running bool
+}
+
+func newKSMController(r *VMReconciler) *ksmController {
+	return &ksmController{
+		Client:   r.Client,
+		NodeName: r.NodeName,
+	}
+}
+
+func (c *ksmController) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, c.reconcile, ksmInterval)
+	return nil
+}
+
+func (c *ksmController) reconcile(ctx context.Context) {
+	log := ctrl.LoggerFrom(ctx).WithName("ksm")
+
+	if _, err := os.Stat(hostKSMPath); err != nil {
+		// the kernel is built without KSM, or the dir is not mounted
+		return
+	}
+	c.exportMetrics()
+
+	config, err := virtinkconfig.Get(ctx, c.Client)
+	if err != nil {
+		log.Error(err, "get Virtink config")
+		return
+	}
+	policy := config.Spec.KSM
+
+	applies, err := c.policyAppliesToNode(ctx, &policy)
+	if err != nil {
+		log.Error(err, "match KSM node selector")
+		return
+	}
+	if !applies || policy.Policy == "" || policy.Policy == virtv1beta1.KSMNone {
+		if c.managed {
+			if err := writeKSMParam("run", 0); err != nil {
+				log.Error(err, "stop KSM")
+				return
+			}
+			log.Info("stopped KSM as the KSM policy no longer applies")
+			c.managed = false
+			c.running = false
+		}
+		return
+	}
+
+	pagesToScan := policy.PagesToScan
+	if pagesToScan == 0 {
+		pagesToScan = defaultKSMPagesToScan
+	}
+	sleepMilliseconds := policy.SleepMilliseconds
+	if sleepMilliseconds == 0 {
+		sleepMilliseconds = defaultKSMSleepMilliseconds
+	}
+	if err := writeKSMParam("pages_to_scan", pagesToScan); err != nil {
+		log.Error(err, "set KSM pages to scan")
+		return
+	}
+	if err := writeKSMParam("sleep_millisecs", sleepMilliseconds); err != nil {
+		log.Error(err, "set KSM sleep milliseconds")
+		return
+	}
+
+	run := true
+	if policy.Policy == virtv1beta1.KSMOnPressure {
+		threshold := float64(policy.PressureThresholdPercent)
+		if threshold == 0 {
+			threshold = defaultKSMPressureThresholdPercent
+		}
+		pressure := readPressure("/proc/pressure/memory")
+		switch {
+		case pressure == nil:
+			// the pressure is unknown on kernels without PSI
+			run = false
+		case pressure.Some > threshold:
+			run = true
+		case pressure.Some < threshold/2:
+			run = false
+		default:
+			run = c.managed && c.running
+		}
+	}
+
+	if c.managed && run == c.running {
+		return
+	}
+	value := 0
+	if run {
+		value = 1
+	}
+	if err := writeKSMParam("run", value); err != nil {
+		log.Error(err, "set KSM run")
+		return
+	}
+	log.Info("set KSM run", "run", run, "policy", policy.Policy)
+	c.managed = true
+	c.running = run
+}
+
+func (c *ksmController) policyAppliesToNode(ctx context.Context, policy *virtv1beta1.VirtinkConfigKSM) (bool, error) {
+	if policy.NodeSelector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(policy.NodeSelector)
+	if err != nil {
+		return false, fmt.Errorf("parse node selector: %s", err)
+	}
+
+	var node corev1.Node
+	if err := c.Get(ctx, types.NamespacedName{Name: c.NodeName}, &node); err != nil {
+		return false, fmt.Errorf("get node: %s", err)
+	}
+	return selector.Matches(labels.Set(node.Labels)), nil
+}
+
+func (c *ksmController) exportMetrics() {
+	if run, err := readKSMParam("run"); err == nil {
+		nodeKSMRunningGauge.WithLabelValues(c.NodeName).Set(float64(run))
+	}
+	for _, state := range []string{"shared", "sharing", "unshared"} {
+		if pages, err := readKSMParam("pages_" + state); err == nil {
+			nodeKSMPagesGauge.WithLabelValues(c.NodeName, state).Set(float64(pages))
+		}
+	}
+	if fullScans, err := readKSMParam("full_scans"); err == nil {
+		nodeKSMFullScansGauge.WithLabelValues(c.NodeName).Set(float64(fullScans))
+	}
+}
+
+func readKSMParam(name string) (int64, error) {
+	data, err := os.ReadFile(filepath.Join(hostKSMPath, name))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+}
+
+func writeKSMParam(name string, value int) error {
+	return os.WriteFile(filepath.Join(hostKSMPath, name), []byte(strconv.Itoa(value)), 0644)
+}
@@ -0,0 +1,271 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/defaults"
+	"github.com/smartxworks/virtink/pkg/logging"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+	"github.com/smartxworks/virtink/pkg/vmm"
+)
+
+const (
+	memoryOvercommitInterval = 10 * time.Second
+
+	defaultMemoryPressureThresholdPercent = 10
+	defaultBalloonMaxReclaimPercent       = 50
+	// balloonStepPercent is how much guest memory, in percent of its size, a
+	// balloon is inflated or deflated by in each interval.
+	balloonStepPercent = 5
+)
+
+var (
+	nodeMemoryPressureGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "virtink_node_memory_pressure_percent",
+		Help: "Share of time in the last 10 seconds some tasks on the node were stalled on memory.",
+	}, []string{"node"})
+	nodeMemoryOvercommitGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "virtink_node_memory_overcommit_ratio",
+		Help: "Guest memory of the running VMs on the node relative to the memory of the node.",
+	}, []string{"node"})
+	vmBalloonGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "virtink_vm_balloon_bytes",
+		Help: "Guest memory reclaimed by the balloon of the VM.",
+	}, []string{"namespace", "name"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(nodeMemoryPressureGauge, nodeMemoryOvercommitGauge, vmBalloonGauge)
+}
+
+// memoryOvercommitController keeps overcommitted nodes from running out of
+// memory. It applies the swap settings of the VM pods on the node to their
+// cgroups, and inflates the balloons of VMs whose guest memory is not backed
+// by their memory requests while the node is under memory pressure, deflating
+// them again once the pressure is gone.
+type memoryOvercommitController struct {
+	client.Client
+	Recorder record.EventRecorder
+	NodeName string
+
+	balloons map[types.UID]balloonState
+}
+
+type balloonState struct {
+	PodUID types.UID
+	Size   int64
+}
+
+func newMemoryOvercommitController(r *VMReconciler) *memoryOvercommitController {
+	return &memoryOvercommitController{
+		Client:   r.Client,
+		Recorder: r.Recorder,
+		NodeName: r.NodeName,
+		balloons: map[types.UID]balloonState{},
+	}
+}
+
+func (c *memoryOvercommitController) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, c.reconcile, memoryOvercommitInterval)
+	return nil
+}
+
+func (c *memoryOvercommitController) reconcile(ctx context.Context) {
+	log := ctrl.LoggerFrom(ctx).WithName("memory-overcommit")
+
+	config, err := virtinkconfig.Get(ctx, c.Client)
+	if err != nil {
+		log.Error(err, "get Virtink config")
+		return
+	}
+	threshold := float64(config.Spec.MemoryOvercommit.PressureThresholdPercent)
+	if threshold == 0 {
+		threshold = defaultMemoryPressureThresholdPercent
+	}
+
+	// balloons are left as they are on kernels without PSI
+	pressure := readPressure("/proc/pressure/memory")
+	if pressure != nil {
+		nodeMemoryPressureGauge.WithLabelValues(c.NodeName).Set(pressure.Some)
+	}
+
+	var vmList virtv1alpha1.VirtualMachineList
+	if err := c.List(ctx, &vmList); err != nil {
+		log.Error(err, "list VMs")
+		return
+	}
+
+	vmUIDs := map[types.UID]bool{}
+	var guestMemory int64
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+		if vm.Status.NodeName != c.NodeName || vm.Status.Phase != virtv1alpha1.VirtualMachineRunning || vm.Status.VMPodUID == "" {
+			continue
+		}
+		vmUIDs[vm.UID] = true
+		guestMemory += vm.Spec.Instance.Memory.Size.Value()
+
+		if vm.Spec.Instance.Memory.Swap != nil {
+			if err := setPodSwap(vm.Status.VMPodUID, vm.Spec.Instance.Memory.Swap); err != nil {
+				log.WithValues(logging.VMKeysAndValues(vm)...).Error(err, "set VM pod swap")
+			}
+		}
+
+		if vm.Spec.Instance.Memory.Balloon == nil || pressure == nil {
+			continue
+		}
+		if err := c.resizeBalloon(ctx, vm, pressure.Some, threshold); err != nil {
+			log.WithValues(logging.VMKeysAndValues(vm)...).Error(err, "resize VM balloon")
+		}
+	}
+
+	for vmUID := range c.balloons {
+		if !vmUIDs[vmUID] {
+			delete(c.balloons, vmUID)
+		}
+	}
+	vmBalloonGauge.Reset()
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+		if state, ok := c.balloons[vm.UID]; ok {
+			vmBalloonGauge.WithLabelValues(vm.Namespace, vm.Name).Set(float64(state.Size))
+		}
+	}
+
+	if meminfo, err := readProcFields("/proc/meminfo"); err == nil {
+		if fields := meminfo["MemTotal:"]; len(fields) > 0 {
+			// values in /proc/meminfo are in KiB
+			memTotal, _ := strconv.ParseInt(fields[0], 10, 64)
+			if memTotal > 0 {
+				nodeMemoryOvercommitGauge.WithLabelValues(c.NodeName).Set(float64(guestMemory) / float64(memTotal<<10))
+			}
+		}
+	}
+}
+
+// resizeBalloon inflates the balloon of the VM by a step while the memory
+// pressure is above the threshold, and deflates it by a step while it's below
+// half of the threshold.
+func (c *memoryOvercommitController) resizeBalloon(ctx context.Context, vm *virtv1alpha1.VirtualMachine, pressure float64, threshold float64) error {
+	state, ok := c.balloons[vm.UID]
+	if !ok || state.PodUID != vm.Status.VMPodUID {
+		// the balloon of a new VMM starts deflated
+		state = balloonState{PodUID: vm.Status.VMPodUID}
+	}
+
+	memorySize := vm.Spec.Instance.Memory.Size.Value()
+	maxSize := memorySize * int64(getBalloonMaxReclaimPercent(vm)) / 100
+	if unbacked := getUnbackedMemory(vm); unbacked < maxSize {
+		maxSize = unbacked
+	}
+	// the balloon is resized in whole MiB, which all VMMs support
+	maxSize = maxSize >> 20 << 20
+	step := memorySize * balloonStepPercent / 100 >> 20 << 20
+
+	size := state.Size
+	switch {
+	case pressure > threshold:
+		size += step
+	case pressure < threshold/2:
+		size -= step
+	}
+	if size > maxSize {
+		size = maxSize
+	}
+	if size < 0 {
+		size = 0
+	}
+
+	// VMs being migrated or powered off are resized later
+	if size != state.Size && vm.Status.Migration == nil && vm.Status.PowerAction == "" {
+		if err := vmm.GetDriver(vm).Connect(getVMSocketDirPath(vm)).VmResizeBalloon(ctx, size); err != nil {
+			return err
+		}
+		switch {
+		case state.Size == 0:
+			c.Recorder.Eventf(vm, corev1.EventTypeNormal, "BalloonInflated", "Started reclaiming guest memory as node memory pressure is %.2f%%", pressure)
+		case size == 0:
+			c.Recorder.Eventf(vm, corev1.EventTypeNormal, "BalloonDeflated", "Returned all reclaimed guest memory as node memory pressure is %.2f%%", pressure)
+		}
+		state.Size = size
+	}
+	c.balloons[vm.UID] = state
+	return nil
+}
+
+func getBalloonMaxReclaimPercent(vm *virtv1alpha1.VirtualMachine) int {
+	if percent := vm.Spec.Instance.Memory.Balloon.MaxReclaimPercent; percent > 0 {
+		return percent
+	}
+	return defaultBalloonMaxReclaimPercent
+}
+
+// getUnbackedMemory returns the guest memory of the VM that its VM pod doesn't
+// request, which is all of it if the VM pod requests no memory.
+func getUnbackedMemory(vm *virtv1alpha1.VirtualMachine) int64 {
+	memorySize := vm.Spec.Instance.Memory.Size.Value()
+	request := vm.Spec.Resources.Requests.Memory()
+	if request.IsZero() {
+		return memorySize
+	}
+	overhead := resource.MustParse(defaults.VMMemoryOverhead)
+	unbacked := memorySize + overhead.Value() - request.Value()
+	if unbacked < 0 {
+		return 0
+	}
+	return unbacked
+}
+
+// setPodSwap writes the swap limits to the cgroup v2 dir of the pod and of its
+// containers, to which the kubelet may have set other limits.
+func setPodSwap(podUID types.UID, swap *virtv1alpha1.MemorySwap) error {
+	podCgroupPath := findPodCgroupPath(podUID, "")
+	if podCgroupPath == "" {
+		return fmt.Errorf("cgroup v2 of pod %s not found", podUID)
+	}
+
+	swapMax := "max"
+	if swap.Max != nil {
+		swapMax = strconv.FormatInt(swap.Max.Value(), 10)
+	}
+	zswapMax := "max"
+	if swap.DisableZswap {
+		zswapMax = "0"
+	}
+
+	containerCgroupPaths, _ := filepath.Glob(filepath.Join(podCgroupPath, "*", "memory.swap.max"))
+	cgroupPaths := []string{podCgroupPath}
+	for _, path := range containerCgroupPaths {
+		cgroupPaths = append(cgroupPaths, filepath.Dir(path))
+	}
+	for _, cgroupPath := range cgroupPaths {
+		if err := os.WriteFile(filepath.Join(cgroupPath, "memory.swap.max"), []byte(swapMax), 0644); err != nil {
+			return fmt.Errorf("write memory.swap.max: %s", err)
+		}
+		// memory.zswap.max is missing on kernels without zswap
+		zswapMaxPath := filepath.Join(cgroupPath, "memory.zswap.max")
+		if _, err := os.Stat(zswapMaxPath); err != nil {
+			continue
+		}
+		if err := os.WriteFile(zswapMaxPath, []byte(zswapMax), 0644); err != nil {
+			return fmt.Errorf("write memory.zswap.max: %s", err)
+		}
+	}
+	return nil
+}
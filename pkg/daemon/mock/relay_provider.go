@@ -36,30 +36,30 @@ func (m *MockRelayProvider) EXPECT() *MockRelayProviderMockRecorder {
 }
 
 // RelaySocketToTCP mocks base method.
-func (m *MockRelayProvider) RelaySocketToTCP(arg0 context.Context, arg1, arg2 string, arg3 *tls.Config) error {
+func (m *MockRelayProvider) RelaySocketToTCP(arg0 context.Context, arg1, arg2 string, arg3 *tls.Config, arg4 []byte) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RelaySocketToTCP", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "RelaySocketToTCP", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RelaySocketToTCP indicates an expected call of RelaySocketToTCP.
-func (mr *MockRelayProviderMockRecorder) RelaySocketToTCP(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockRelayProviderMockRecorder) RelaySocketToTCP(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelaySocketToTCP", reflect.TypeOf((*MockRelayProvider)(nil).RelaySocketToTCP), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelaySocketToTCP", reflect.TypeOf((*MockRelayProvider)(nil).RelaySocketToTCP), arg0, arg1, arg2, arg3, arg4)
 }
 
 // RelayTCPToSocket mocks base method.
-func (m *MockRelayProvider) RelayTCPToSocket(arg0 context.Context, arg1 string, arg2 *tls.Config, arg3 string) (int, error) {
+func (m *MockRelayProvider) RelayTCPToSocket(arg0 context.Context, arg1 string, arg2 *tls.Config, arg3 []byte, arg4 string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RelayTCPToSocket", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "RelayTCPToSocket", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RelayTCPToSocket indicates an expected call of RelayTCPToSocket.
-func (mr *MockRelayProviderMockRecorder) RelayTCPToSocket(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockRelayProviderMockRecorder) RelayTCPToSocket(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayTCPToSocket", reflect.TypeOf((*MockRelayProvider)(nil).RelayTCPToSocket), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayTCPToSocket", reflect.TypeOf((*MockRelayProvider)(nil).RelayTCPToSocket), arg0, arg1, arg2, arg3, arg4)
 }
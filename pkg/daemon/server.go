@@ -0,0 +1,495 @@
+package daemon
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/daemonapi"
+	daemonv1 "github.com/smartxworks/virtink/pkg/daemonapi/v1"
+	"github.com/smartxworks/virtink/pkg/logging"
+	"github.com/smartxworks/virtink/pkg/tlsutil"
+	"github.com/smartxworks/virtink/pkg/vsock"
+)
+
+// streamUpgradeProtocol is the protocol connections are upgraded to by
+// streaming endpoints, after which raw bytes are exchanged.
+const streamUpgradeProtocol = "tcp"
+
+// callerNames are the common names of the certificates of the components
+// allowed to call the API of virt-daemon. Other certificates signed by the
+// virt-daemon CA, such as those of virt-daemons, are only accepted for
+// migrations, on ports that also require the token of the migration.
+var callerNames = []string{"virt-api"}
+
+// Server serves the gRPC API of virt-daemon for VMs running on the node. It
+// is only used by Virtink components, which authenticate with certificates
+// signed by the virt-daemon CA, and are authorized by the common names of
+// their certificates. The HTTP endpoints served before the gRPC API are kept
+// on the same address for components of older releases during rolling
+// upgrades.
+type Server struct {
+	client.Client
+	NodeName    string
+	Addr        string
+	CertDirPath string
+	ConsoleLogs *ConsoleLogs
+}
+
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list;watch
+
+func (s *Server) Start(ctx context.Context) error {
+	// gRPC clients negotiate HTTP/2 through ALPN, while clients of the HTTP
+	// endpoints stay on HTTP/1.1
+	tlsConfig := tlsutil.NewServerConfig(s.CertDirPath)
+	getConfigForClient := tlsConfig.GetConfigForClient
+	tlsConfig.GetConfigForClient = func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+		config, err := getConfigForClient(hello)
+		if err != nil {
+			return nil, err
+		}
+		config.NextProtos = []string{"h2", "http/1.1"}
+		return config, nil
+	}
+	listener, err := tls.Listen("tcp", s.Addr, tlsConfig)
+	if err != nil {
+		return fmt.Errorf("listen: %s", err)
+	}
+
+	server := &http.Server{
+		Handler:           otelhttp.NewHandler(s.handler(), "virt-daemon"),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+
+	if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// handler serves the gRPC API to clients that negotiated HTTP/2, and the HTTP
+// endpoints to the others.
+func (s *Server) handler() http.Handler {
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if err := authorizeGRPCCaller(ctx); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := authorizeGRPCCaller(ss.Context()); err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		}),
+	)
+	daemonapi.RegisterDaemonServer(grpcServer, &apiServer{Server: s})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/virtualmachines/", s.handleVM)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			grpcServer.ServeHTTP(w, r)
+			return
+		}
+		if err := authorizeCaller(r.TLS); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// authorizeCaller returns an error unless the client certificate of the
+// connection is of a component allowed to call the API.
+func authorizeCaller(state *tls.ConnectionState) error {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return fmt.Errorf("client certificate required")
+	}
+	commonName := state.PeerCertificates[0].Subject.CommonName
+	for _, callerName := range callerNames {
+		if commonName == callerName {
+			return nil
+		}
+	}
+	return fmt.Errorf("%q is not allowed to call virt-daemon", commonName)
+}
+
+// authorizeGRPCCaller is authorizeCaller for gRPC calls, returning a gRPC
+// status error.
+func authorizeGRPCCaller(ctx context.Context) error {
+	var state *tls.ConnectionState
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			state = &tlsInfo.State
+		}
+	}
+	if err := authorizeCaller(state); err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return nil
+}
+
+// getVM returns the VM if it's on this node, or a gRPC status error.
+func (s *Server) getVM(ctx context.Context, vmRef *daemonv1.VMRef, requireRunning bool) (*virtv1alpha1.VirtualMachine, error) {
+	if vmRef == nil {
+		return nil, status.Error(codes.InvalidArgument, "VM required")
+	}
+	var vm virtv1alpha1.VirtualMachine
+	if err := s.Get(ctx, client.ObjectKey{Namespace: vmRef.Namespace, Name: vmRef.Name}, &vm); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, status.Error(codes.NotFound, "VM not found")
+		}
+		return nil, status.Errorf(codes.Internal, "get VM: %s", err)
+	}
+	if vm.Status.NodeName != s.NodeName {
+		return nil, status.Error(codes.FailedPrecondition, "VM is not on this node")
+	}
+	if requireRunning && vm.Status.Phase != virtv1alpha1.VirtualMachineRunning {
+		return nil, status.Error(codes.FailedPrecondition, "VM is not running on this node")
+	}
+	return &vm, nil
+}
+
+// dialGuest connects to a port of the guest, or returns a gRPC status error.
+// TCP ports are reached through the IP of the VM pod, which is either owned
+// by the guest or forwarded to it, depending on the interface binding
+// method. Vsock ports are reached through the vsock socket of the VM, so
+// that agents in the guest can be reached without networking. The serial
+// console and the VNC server of QEMU VMs are sockets of the VM, which have no
+// port.
+func (s *Server) dialGuest(ctx context.Context, vm *virtv1alpha1.VirtualMachine, protocol daemonv1.ForwardProtocol, port uint32) (net.Conn, error) {
+	dialCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	switch protocol {
+	case daemonv1.ForwardProtocol_FORWARD_PROTOCOL_TCP:
+		if port == 0 || port > 65535 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid port %d", port)
+		}
+		if vm.Status.VMPodIP == "" {
+			return nil, status.Error(codes.FailedPrecondition, "VM pod IP not found")
+		}
+		conn, err := (&net.Dialer{}).DialContext(dialCtx, "tcp", net.JoinHostPort(vm.Status.VMPodIP, strconv.FormatUint(uint64(port), 10)))
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "dial guest: %s", err)
+		}
+		return conn, nil
+	case daemonv1.ForwardProtocol_FORWARD_PROTOCOL_VSOCK:
+		if vm.Spec.Instance.Vsock == nil {
+			return nil, status.Error(codes.FailedPrecondition, "VM has no vsock device")
+		}
+		conn, err := vsock.Dial(dialCtx, filepath.Join(getVMSocketDirPath(vm), "vsock.sock"), port)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "dial guest: %s", err)
+		}
+		return conn, nil
+	case daemonv1.ForwardProtocol_FORWARD_PROTOCOL_SERIAL, daemonv1.ForwardProtocol_FORWARD_PROTOCOL_VNC:
+		if port != 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid port %d", port)
+		}
+		if vm.Spec.Instance.Hypervisor != virtv1alpha1.HypervisorQEMU {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s is only supported by QEMU", protocol)
+		}
+		socketName := "serial.sock"
+		if protocol == daemonv1.ForwardProtocol_FORWARD_PROTOCOL_VNC {
+			if vm.Spec.Instance.Devices == nil || vm.Spec.Instance.Devices.Display == nil {
+				return nil, status.Error(codes.FailedPrecondition, "VM has no display")
+			}
+			socketName = "vnc.sock"
+		}
+		conn, err := (&net.Dialer{}).DialContext(dialCtx, "unix", filepath.Join(getVMSocketDirPath(vm), socketName))
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "dial guest: %s", err)
+		}
+		return conn, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown protocol %s", protocol)
+	}
+}
+
+// handleVM serves /virtualmachines/<namespace>/<name>/<endpoint>[/<arg>...].
+func (s *Server) handleVM(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/virtualmachines/"), "/")
+	if len(parts) < 3 {
+		http.NotFound(w, r)
+		return
+	}
+	vmRef := &daemonv1.VMRef{Namespace: parts[0], Name: parts[1]}
+
+	if parts[2] == "consolelog" && len(parts) == 3 {
+		vm, err := s.getVM(r.Context(), vmRef, false)
+		if err != nil {
+			writeStatusError(w, err)
+			return
+		}
+		s.handleConsoleLog(w, r, vm)
+		return
+	}
+
+	var protocol daemonv1.ForwardProtocol
+	var bitSize int
+	switch parts[2] {
+	case "portforward":
+		protocol, bitSize = daemonv1.ForwardProtocol_FORWARD_PROTOCOL_TCP, 16
+	case "vsock":
+		protocol, bitSize = daemonv1.ForwardProtocol_FORWARD_PROTOCOL_VSOCK, 32
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	if len(parts) != 4 {
+		http.NotFound(w, r)
+		return
+	}
+	vm, err := s.getVM(r.Context(), vmRef, true)
+	if err != nil {
+		writeStatusError(w, err)
+		return
+	}
+	port, err := strconv.ParseUint(parts[3], 10, bitSize)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid port %q", parts[3]), http.StatusBadRequest)
+		return
+	}
+	s.handleForward(w, r, vm, protocol, uint32(port))
+}
+
+// writeStatusError writes a gRPC status error as the HTTP error closest to it.
+func writeStatusError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	switch status.Code(err) {
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.FailedPrecondition:
+		code = http.StatusConflict
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.Unavailable:
+		code = http.StatusBadGateway
+	}
+	http.Error(w, status.Convert(err).Message(), code)
+}
+
+// handleConsoleLog returns the recent serial console output of the VM kept by
+// the daemon. It is available as long as the VM stays on this node, whether
+// the VM is running or not.
+func (s *Server) handleConsoleLog(w http.ResponseWriter, r *http.Request, vm *virtv1alpha1.VirtualMachine) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Write(s.ConsoleLogs.Get(vm.UID))
+}
+
+// handleForward connects the client to a port of the guest.
+func (s *Server) handleForward(w http.ResponseWriter, r *http.Request, vm *virtv1alpha1.VirtualMachine, protocol daemonv1.ForwardProtocol, port uint32) {
+	conn, err := s.dialGuest(r.Context(), vm, protocol, port)
+	if err != nil {
+		writeStatusError(w, err)
+		return
+	}
+	defer conn.Close()
+
+	if err := ServeUpgradedStream(w, r, conn); err != nil {
+		ctrl.LoggerFrom(r.Context()).WithValues(logging.VMKeysAndValues(vm)...).Error(err, "forward to guest", "protocol", protocol.String(), "port", port)
+	}
+}
+
+// ServeUpgradedStream upgrades the client connection to a raw stream, as
+// DialVMStream expects of the HTTP endpoints, and copies bytes between it and
+// conn until either side closes.
+func ServeUpgradedStream(w http.ResponseWriter, r *http.Request, conn net.Conn) error {
+	if !strings.EqualFold(r.Header.Get("Upgrade"), streamUpgradeProtocol) {
+		http.Error(w, fmt.Sprintf("connection must be upgraded to %q", streamUpgradeProtocol), http.StatusUpgradeRequired)
+		return nil
+	}
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "connection can not be upgraded", http.StatusInternalServerError)
+		return nil
+	}
+	clientConn, clientRW, err := hijacker.Hijack()
+	if err != nil {
+		return fmt.Errorf("hijack connection: %s", err)
+	}
+	defer clientConn.Close()
+
+	if _, err := fmt.Fprintf(clientConn, "HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: %s\r\n\r\n", streamUpgradeProtocol); err != nil {
+		return fmt.Errorf("write upgrade response: %s", err)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		io.Copy(conn, clientRW.Reader)
+		closeWrite(conn)
+	}()
+	go func() {
+		defer wg.Done()
+		io.Copy(clientConn, conn)
+		closeWrite(clientConn)
+	}()
+	wg.Wait()
+	return nil
+}
+
+func closeWrite(conn net.Conn) {
+	if c, ok := conn.(interface{ CloseWrite() error }); ok {
+		c.CloseWrite()
+		return
+	}
+	conn.Close()
+}
+
+// DialVMStream opens a stream to an endpoint of the VM on the virt-daemon at
+// addr, such as "portforward/22", "vsock/1024" or "serialconsole". It calls the Forward method
+// of the gRPC API, or the HTTP endpoint of daemons from before the gRPC API.
+func DialVMStream(ctx context.Context, addr string, tlsConfig *tls.Config, vmKey client.ObjectKey, endpoint string) (net.Conn, error) {
+	protocol, port, err := ParseVMStreamEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient, err := daemonapi.Dial(ctx, addr, tlsConfig)
+	if err != nil {
+		if err == daemonapi.ErrLegacyDaemon {
+			return DialLegacyVMStream(ctx, addr, tlsConfig, vmKey, endpoint)
+		}
+		return nil, err
+	}
+	conn, err := apiClient.Forward(ctx, vmKey, protocol, port)
+	if err != nil {
+		apiClient.Close()
+		return nil, err
+	}
+	return &clientConn{Conn: conn, client: apiClient}, nil
+}
+
+// ParseVMStreamEndpoint returns the protocol and port of the guest a stream
+// endpoint such as "portforward/22", "vsock/1024", "serialconsole" or "vnc"
+// forwards to.
+func ParseVMStreamEndpoint(endpoint string) (daemonv1.ForwardProtocol, uint32, error) {
+	switch endpoint {
+	case "serialconsole":
+		return daemonv1.ForwardProtocol_FORWARD_PROTOCOL_SERIAL, 0, nil
+	case "vnc":
+		return daemonv1.ForwardProtocol_FORWARD_PROTOCOL_VNC, 0, nil
+	}
+
+	var protocol daemonv1.ForwardProtocol
+	var portStr string
+	if strings.HasPrefix(endpoint, "portforward/") {
+		protocol, portStr = daemonv1.ForwardProtocol_FORWARD_PROTOCOL_TCP, strings.TrimPrefix(endpoint, "portforward/")
+	} else if strings.HasPrefix(endpoint, "vsock/") {
+		protocol, portStr = daemonv1.ForwardProtocol_FORWARD_PROTOCOL_VSOCK, strings.TrimPrefix(endpoint, "vsock/")
+	} else {
+		return 0, 0, fmt.Errorf("unknown endpoint %q", endpoint)
+	}
+	port, err := strconv.ParseUint(portStr, 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port %q", portStr)
+	}
+	return protocol, uint32(port), nil
+}
+
+// clientConn closes the client of the gRPC API along with the connection.
+type clientConn struct {
+	net.Conn
+	client *daemonapi.Client
+}
+
+func (c *clientConn) CloseWrite() error {
+	return c.Conn.(interface{ CloseWrite() error }).CloseWrite()
+}
+
+func (c *clientConn) Close() error {
+	c.Conn.Close()
+	return c.client.Close()
+}
+
+// DialLegacyVMStream opens a stream to an endpoint of the VM through the HTTP
+// endpoints, for virt-daemons from before the gRPC API.
+func DialLegacyVMStream(ctx context.Context, addr string, tlsConfig *tls.Config, vmKey client.ObjectKey, endpoint string) (net.Conn, error) {
+	conn, err := (&tls.Dialer{Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("dial virt-daemon: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://%s/virtualmachines/%s/%s/%s", addr, vmKey.Namespace, vmKey.Name, endpoint), nil)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	stream, err := UpgradeStream(conn, req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return stream, nil
+}
+
+// UpgradeStream sends the request over conn, asking for the connection to be
+// upgraded to a raw stream as ServeUpgradedStream does, and returns the
+// stream once the server has switched protocols.
+func UpgradeStream(conn net.Conn, req *http.Request) (net.Conn, error) {
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", streamUpgradeProtocol)
+	if err := req.Write(conn); err != nil {
+		return nil, fmt.Errorf("write request: %s", err)
+	}
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %s", err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("unexpected status %q: %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+	return &bufferedConn{Conn: conn, reader: reader}, nil
+}
+
+// bufferedConn reads the bytes already buffered while reading the upgrade
+// response before reading from the connection.
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
+
+func (c *bufferedConn) CloseWrite() error {
+	if conn, ok := c.Conn.(interface{ CloseWrite() error }); ok {
+		return conn.CloseWrite()
+	}
+	return c.Conn.Close()
+}
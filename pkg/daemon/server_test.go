@@ -0,0 +1,286 @@
+package daemon
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	daemonv1 "github.com/smartxworks/virtink/pkg/daemonapi/v1"
+	"github.com/smartxworks/virtink/pkg/tlsutil"
+)
+
+// serveEcho serves a TCP port on the loopback address that echoes the bytes
+// received back in upper case, and returns the port.
+func serveEcho(t *testing.T) int {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				data, _ := io.ReadAll(conn)
+				conn.Write(bytes.ToUpper(data))
+			}()
+		}
+	}()
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+func newTestServer(t *testing.T) *Server {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+
+	newVM := func(name string, nodeName string) *virtv1alpha1.VirtualMachine {
+		return &virtv1alpha1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+				UID:       types.UID(name + "-uid"),
+			},
+			Status: virtv1alpha1.VirtualMachineStatus{
+				Phase:    virtv1alpha1.VirtualMachineRunning,
+				NodeName: nodeName,
+				VMPodIP:  "127.0.0.1",
+			},
+		}
+	}
+	return &Server{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			newVM("ubuntu", "node-0"),
+			newVM("centos", "node-1"),
+		).Build(),
+		NodeName:    "node-0",
+		ConsoleLogs: NewConsoleLogs(),
+	}
+}
+
+func newCallerTLSState(commonName string) *tls.ConnectionState {
+	return &tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{{
+			Subject: pkix.Name{CommonName: commonName},
+		}},
+	}
+}
+
+// newCallerCert returns a client certificate with the common name.
+func newCallerCert(t *testing.T, commonName string) tls.Certificate {
+	certPEM, keyPEM, err := tlsutil.GenerateSelfSignedCert([]string{commonName}, time.Hour)
+	require.NoError(t, err)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestHandleVM(t *testing.T) {
+	handler := newTestServer(t).handler()
+
+	tests := []struct {
+		path       string
+		callerName string
+		code       int
+	}{{
+		path:       "/virtualmachines/default/ubuntu/consolelog",
+		callerName: "-",
+		code:       http.StatusForbidden,
+	}, {
+		path:       "/virtualmachines/default/ubuntu/consolelog",
+		callerName: "virt-daemon",
+		code:       http.StatusForbidden,
+	}, {
+		path:       "/virtualmachines/default/ubuntu/consolelog",
+		callerName: "virt-controller",
+		code:       http.StatusForbidden,
+	}, {
+		path:       "/virtualmachines/default/ubuntu/consolelog",
+		callerName: "virt-api",
+		code:       http.StatusOK,
+	}, {
+		path: "/virtualmachines/default/ubuntu",
+		code: http.StatusNotFound,
+	}, {
+		path: "/virtualmachines/default/ubuntu/unknown/22",
+		code: http.StatusNotFound,
+	}, {
+		path: "/virtualmachines/default/ubuntu/portforward",
+		code: http.StatusNotFound,
+	}, {
+		path: "/virtualmachines/default/ubuntu/portforward/22/ssh",
+		code: http.StatusNotFound,
+	}, {
+		path: "/virtualmachines/default/ubuntu/portforward/ssh",
+		code: http.StatusBadRequest,
+	}, {
+		path: "/virtualmachines/default/ubuntu/portforward/65536",
+		code: http.StatusBadRequest,
+	}, {
+		path: "/virtualmachines/default/ubuntu/portforward/0",
+		code: http.StatusBadRequest,
+	}, {
+		path: "/virtualmachines/default/debian/portforward/22",
+		code: http.StatusNotFound,
+	}, {
+		// the VM is on another node
+		path: "/virtualmachines/default/centos/portforward/22",
+		code: http.StatusConflict,
+	}, {
+		// the VM has no vsock device
+		path: "/virtualmachines/default/ubuntu/vsock/1024",
+		code: http.StatusConflict,
+	}, {
+		// the connection is not upgraded
+		path: "/virtualmachines/default/ubuntu/portforward/" + strconv.Itoa(serveEcho(t)),
+		code: http.StatusUpgradeRequired,
+	}, {
+		path: "/virtualmachines/default/ubuntu/consolelog",
+		code: http.StatusOK,
+	}}
+
+	for _, tc := range tests {
+		req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		switch tc.callerName {
+		case "-":
+			req.TLS = nil
+		case "":
+			req.TLS = newCallerTLSState("virt-api")
+		default:
+			req.TLS = newCallerTLSState(tc.callerName)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		assert.Equal(t, tc.code, w.Code, tc.path)
+	}
+}
+
+type consoleLogStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks chan []byte
+}
+
+func (s *consoleLogStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *consoleLogStream) Send(chunk *daemonv1.ConsoleLogChunk) error {
+	s.chunks <- chunk.Data
+	return nil
+}
+
+func TestStreamConsoleLog(t *testing.T) {
+	server := newTestServer(t)
+	vmUID := types.UID("ubuntu-uid")
+	server.ConsoleLogs.AppendPodLog(vmUID, []byte("2023-01-01T00:00:00Z stdout F login: \n"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream := &consoleLogStream{ctx: ctx, chunks: make(chan []byte, 10)}
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- (&apiServer{Server: server}).StreamConsoleLog(&daemonv1.StreamConsoleLogRequest{
+			Vm: &daemonv1.VMRef{Namespace: "default", Name: "ubuntu"},
+		}, stream)
+	}()
+	assert.Equal(t, "login: \n", string(<-stream.chunks))
+
+	// output is pushed as soon as it's appended
+	server.ConsoleLogs.AppendPodLog(vmUID, []byte("2023-01-01T00:00:01Z stdout F root\n"))
+	select {
+	case data := <-stream.chunks:
+		assert.Equal(t, "root\n", string(data))
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("console output was not pushed")
+	}
+
+	// the stream ends once the VM leaves the node
+	var vm virtv1alpha1.VirtualMachine
+	require.NoError(t, server.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ubuntu"}, &vm))
+	vm.Status.NodeName = "node-1"
+	require.NoError(t, server.Update(ctx, &vm))
+	server.ConsoleLogs.Notify(vmUID)
+	select {
+	case err := <-errChan:
+		assert.NoError(t, err)
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("stream did not end")
+	}
+}
+
+func TestDialVMStream(t *testing.T) {
+	port := serveEcho(t)
+	vmKey := client.ObjectKey{Namespace: "default", Name: "ubuntu"}
+
+	for _, http2 := range []bool{true, false} {
+		// daemons that don't negotiate HTTP/2 are reached through the HTTP
+		// endpoints
+		server := httptest.NewUnstartedServer(newTestServer(t).handler())
+		server.EnableHTTP2 = http2
+		server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+		server.StartTLS()
+		defer server.Close()
+		addr := server.Listener.Addr().String()
+		tlsConfig := server.Client().Transport.(*http.Transport).TLSClientConfig.Clone()
+		tlsConfig.NextProtos = nil
+		tlsConfig.Certificates = []tls.Certificate{newCallerCert(t, "virt-api")}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		// only Virtink components other than virt-daemon may call the API
+		daemonTLSConfig := tlsConfig.Clone()
+		daemonTLSConfig.Certificates = []tls.Certificate{newCallerCert(t, "virt-daemon")}
+		_, err := DialVMStream(ctx, addr, daemonTLSConfig, vmKey, "portforward/"+strconv.Itoa(port))
+		assert.Error(t, err)
+		if http2 {
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		}
+
+		_, err = DialVMStream(ctx, addr, tlsConfig, vmKey, "ssh/22")
+		assert.Error(t, err)
+		_, err = DialVMStream(ctx, addr, tlsConfig, vmKey, "portforward/ssh")
+		assert.Error(t, err)
+		_, err = DialVMStream(ctx, addr, tlsConfig, client.ObjectKey{Namespace: "default", Name: "centos"}, "portforward/22")
+		assert.Error(t, err)
+		if http2 {
+			// the VM doesn't run with QEMU
+			_, err = DialVMStream(ctx, addr, tlsConfig, vmKey, "serialconsole")
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		}
+
+		conn, err := DialVMStream(ctx, addr, tlsConfig.Clone(), vmKey, "portforward/"+strconv.Itoa(port))
+		require.NoError(t, err)
+		_, err = conn.Write([]byte("hello"))
+		require.NoError(t, err)
+		require.NoError(t, conn.(interface{ CloseWrite() error }).CloseWrite())
+		data, err := io.ReadAll(conn)
+		assert.NoError(t, err)
+		assert.Equal(t, "HELLO", string(data))
+		conn.Close()
+	}
+}
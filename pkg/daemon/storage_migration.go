@@ -0,0 +1,443 @@
+package daemon
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/cloudhypervisor"
+	"github.com/smartxworks/virtink/pkg/migrationtoken"
+	"github.com/smartxworks/virtink/pkg/tlsutil"
+)
+
+// Files are copied in chunks. The receiver replies with the checksums of the
+// chunks it already has, so that only the changed chunks are sent. This makes
+// a second copy of a volume after pausing the VM cheap, and skips the holes of
+// sparse files on the first copy.
+const storageMigrationChunkSize = 4 << 20
+
+const storageMigrationEndOfChunks = ^uint64(0)
+
+// migratesByCheckpoint returns whether the VM is migrated by copying its
+// snapshot and disks to the target node and restoring it there, instead of by
+// a Cloud Hypervisor live migration. Node-local volumes can only be copied
+// this way.
+func migratesByCheckpoint(vm *virtv1alpha1.VirtualMachine) bool {
+	return vm.Status.Migration.Type == virtv1alpha1.VirtualMachineMigrationOffline || len(vm.Status.Migration.Volumes) > 0
+}
+
+// getMigrationVolumePaths returns the paths of the disks to copy during the
+// migration, on the source node or on the target node. Offline migrations
+// copy the disks of container volumes too, since they are not persisted
+// anywhere else.
+func (r *VMReconciler) getMigrationVolumePaths(ctx context.Context, vm *virtv1alpha1.VirtualMachine, target bool) (map[string]string, error) {
+	podUID := vm.Status.VMPodUID
+	if target {
+		podUID = vm.Status.Migration.TargetVMPodUID
+	}
+
+	volumePaths := map[string]string{}
+	for _, migrationVolume := range vm.Status.Migration.Volumes {
+		claimName := migrationVolume.SourceClaimName
+		if target {
+			claimName = migrationVolume.TargetClaimName
+		}
+		path, err := r.getVolumeDiskPath(ctx, vm.Namespace, podUID, claimName)
+		if err != nil {
+			return nil, err
+		}
+		volumePaths[migrationVolume.Name] = path
+	}
+
+	if vm.Status.Migration.Type == virtv1alpha1.VirtualMachineMigrationOffline {
+		for _, volume := range vm.Spec.Volumes {
+			var fileName string
+			switch {
+			case volume.ContainerDisk != nil, volume.HTTPDisk != nil:
+				fileName = "disk.raw"
+			case volume.ContainerRootfs != nil:
+				fileName = "rootfs.raw"
+			case volume.EmptyDisk != nil && volume.EmptyDisk.VolumeClaim == nil:
+				fileName = "disk.img"
+			case isFATConfigDisk(vm, &volume):
+				// the guest may have written to FAT images
+				fileName = "config.img"
+			default:
+				continue
+			}
+			volumePaths[volume.Name] = filepath.Join("/var/lib/kubelet/pods", string(podUID), "volumes", "kubernetes.io~empty-dir", volume.Name, fileName)
+		}
+	}
+	return volumePaths, nil
+}
+
+// isFATConfigDisk tells whether the volume is a config map or secret volume
+// with a FAT disk image, rather than a file system.
+func isFATConfigDisk(vm *virtv1alpha1.VirtualMachine, volume *virtv1alpha1.Volume) bool {
+	for _, fs := range vm.Spec.Instance.FileSystems {
+		if fs.Name == volume.Name {
+			return false
+		}
+	}
+	return (volume.ConfigMap != nil && volume.ConfigMap.Format == virtv1alpha1.ConfigDiskFormatFAT) ||
+		(volume.Secret != nil && volume.Secret.Format == virtv1alpha1.ConfigDiskFormatFAT)
+}
+
+func (r *VMReconciler) startStorageMigrationReceiver(ctx context.Context, vm *virtv1alpha1.VirtualMachine, certDirPath string, token []byte) (int, error) {
+	volumePaths, err := r.getMigrationVolumePaths(ctx, vm, true)
+	if err != nil {
+		return 0, fmt.Errorf("get target volume paths: %s", err)
+	}
+
+	snapshotDirPath := filepath.Join(getMigrationTargetVMSocketDirPath(vm), "snapshot")
+	if err := os.MkdirAll(snapshotDirPath, 0755); err != nil {
+		return 0, fmt.Errorf("create snapshot dir: %s", err)
+	}
+
+	listener, err := tls.Listen("tcp", "0.0.0.0:0", tlsutil.NewServerConfig(certDirPath))
+	if err != nil {
+		return 0, fmt.Errorf("listen: %s", err)
+	}
+	listener = migrationtoken.NewListener(listener, token)
+
+	resolvePath := func(name string) (string, error) {
+		kind, key, _ := strings.Cut(name, "/")
+		switch kind {
+		case "volume":
+			if path, ok := volumePaths[key]; ok {
+				return path, nil
+			}
+		case "snapshot":
+			if key != "" && key != "." && key != ".." && key == filepath.Base(key) {
+				return filepath.Join(snapshotDirPath, key), nil
+			}
+		}
+		return "", fmt.Errorf("unexpected file %q", name)
+	}
+	go serveStorageMigration(ctx, listener, resolvePath)
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
+
+// sendStorageMigration copies the node-local volumes and the VM snapshot to
+// the target node. Volumes are first copied while the VM is running, then the
+// VM is paused and snapshotted, and only the chunks changed since are copied
+// again. The VM is resumed if the copy fails.
+func (r *VMReconciler) sendStorageMigration(ctx context.Context, vm *virtv1alpha1.VirtualMachine, volumePaths map[string]string, dial func() (net.Conn, error)) (err error) {
+	for name, path := range volumePaths {
+		if err := sendStorageMigrationFile(ctx, dial, "volume/"+name, path); err != nil {
+			return fmt.Errorf("pre-copy volume %q: %s", name, err)
+		}
+	}
+
+	chClient := r.getCloudHypervisorClient(vm)
+	if err := chClient.VmPause(ctx); err != nil {
+		return fmt.Errorf("pause VM: %s", err)
+	}
+	defer func() {
+		if err != nil {
+			if resumeErr := chClient.VmResume(context.Background()); resumeErr != nil {
+				err = fmt.Errorf("%s, resume VM: %s", err, resumeErr)
+			}
+		}
+	}()
+
+	snapshotDirPath := filepath.Join(getVMSocketDirPath(vm), "snapshot")
+	if err := os.RemoveAll(snapshotDirPath); err != nil {
+		return fmt.Errorf("remove snapshot dir: %s", err)
+	}
+	if err := os.MkdirAll(snapshotDirPath, 0755); err != nil {
+		return fmt.Errorf("create snapshot dir: %s", err)
+	}
+	if err := chClient.VmSnapshot(ctx, &cloudhypervisor.VmSnapshotConfig{
+		DestinationUrl: "file:///var/run/virtink/snapshot",
+	}); err != nil {
+		return fmt.Errorf("snapshot VM: %s", err)
+	}
+
+	for name, path := range volumePaths {
+		if err := sendStorageMigrationFile(ctx, dial, "volume/"+name, path); err != nil {
+			return fmt.Errorf("copy volume %q: %s", name, err)
+		}
+	}
+
+	entries, err := os.ReadDir(snapshotDirPath)
+	if err != nil {
+		return fmt.Errorf("read snapshot dir: %s", err)
+	}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		if err := sendStorageMigrationFile(ctx, dial, "snapshot/"+entry.Name(), filepath.Join(snapshotDirPath, entry.Name())); err != nil {
+			return fmt.Errorf("copy snapshot file %q: %s", entry.Name(), err)
+		}
+	}
+	return nil
+}
+
+// restoreMigratedVM restores the VM on the target node from the snapshot
+// copied by sendStorageMigration, and resumes it.
+func (r *VMReconciler) restoreMigratedVM(ctx context.Context, vm *virtv1alpha1.VirtualMachine) error {
+	chClient := r.getMigrationTargetCloudHypervisorClient(vm)
+	vmInfo, err := chClient.VmInfo(ctx)
+	if err != nil {
+		// the VM is not created until it's restored
+		if err := chClient.VmRestore(ctx, &cloudhypervisor.RestoreConfig{
+			SourceUrl: "file:///var/run/virtink/snapshot",
+		}); err != nil {
+			return fmt.Errorf("restore VM: %s", err)
+		}
+	} else if vmInfo.State != "Paused" {
+		return nil
+	}
+
+	if err := chClient.VmResume(ctx); err != nil {
+		return fmt.Errorf("resume VM: %s", err)
+	}
+	return nil
+}
+
+// getVolumeDiskPath returns the path of the disk image in a filesystem PVC
+// mounted to a Pod on this node.
+func (r *VMReconciler) getVolumeDiskPath(ctx context.Context, namespace string, podUID types.UID, claimName string) (string, error) {
+	var pvc corev1.PersistentVolumeClaim
+	pvcKey := types.NamespacedName{
+		Name:      claimName,
+		Namespace: namespace,
+	}
+	if err := r.Get(ctx, pvcKey, &pvc); err != nil {
+		return "", fmt.Errorf("get PVC: %s", err)
+	}
+	if pvc.Spec.VolumeName == "" {
+		return "", fmt.Errorf("PVC %q is not bound", claimName)
+	}
+
+	matches, err := filepath.Glob(filepath.Join("/var/lib/kubelet/pods", string(podUID), "volumes", "*", pvc.Spec.VolumeName))
+	if err != nil {
+		return "", err
+	}
+	if len(matches) == 0 {
+		return "", fmt.Errorf("volume of PVC %q is not mounted", claimName)
+	}
+
+	dirPath := matches[0]
+	if filepath.Base(filepath.Dir(dirPath)) == "kubernetes.io~csi" {
+		dirPath = filepath.Join(dirPath, "mount")
+	}
+	return filepath.Join(dirPath, "disk.img"), nil
+}
+
+func serveStorageMigration(ctx context.Context, listener net.Listener, resolvePath func(name string) (string, error)) {
+	log := ctrl.LoggerFrom(ctx)
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer conn.Close()
+			if err := receiveStorageMigrationFile(conn, resolvePath); err != nil {
+				log.Error(err, "receive storage migration file")
+			}
+		}()
+	}
+}
+
+func receiveStorageMigrationFile(conn net.Conn, resolvePath func(name string) (string, error)) error {
+	reader := bufio.NewReader(conn)
+	writer := bufio.NewWriter(conn)
+
+	var nameLen uint16
+	if err := binary.Read(reader, binary.BigEndian, &nameLen); err != nil {
+		return fmt.Errorf("read name length: %s", err)
+	}
+	name := make([]byte, nameLen)
+	if _, err := io.ReadFull(reader, name); err != nil {
+		return fmt.Errorf("read name: %s", err)
+	}
+	var size int64
+	if err := binary.Read(reader, binary.BigEndian, &size); err != nil {
+		return fmt.Errorf("read size: %s", err)
+	}
+	if size < 0 {
+		return fmt.Errorf("invalid size %d", size)
+	}
+
+	path, err := resolvePath(string(name))
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("open file: %s", err)
+	}
+	defer file.Close()
+	if err := file.Truncate(size); err != nil {
+		return fmt.Errorf("truncate file: %s", err)
+	}
+
+	numChunks := storageMigrationNumChunks(size)
+	if err := binary.Write(writer, binary.BigEndian, numChunks); err != nil {
+		return fmt.Errorf("write number of chunks: %s", err)
+	}
+	buf := make([]byte, storageMigrationChunkSize)
+	for index := uint64(0); index < numChunks; index++ {
+		chunk := buf[:storageMigrationChunkLen(index, size)]
+		if _, err := file.ReadAt(chunk, int64(index)*storageMigrationChunkSize); err != nil {
+			return fmt.Errorf("read chunk: %s", err)
+		}
+		checksum := sha256.Sum256(chunk)
+		if _, err := writer.Write(checksum[:]); err != nil {
+			return fmt.Errorf("write checksum: %s", err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("write checksums: %s", err)
+	}
+
+	for {
+		var index uint64
+		if err := binary.Read(reader, binary.BigEndian, &index); err != nil {
+			return fmt.Errorf("read chunk index: %s", err)
+		}
+		if index == storageMigrationEndOfChunks {
+			break
+		}
+		if index >= numChunks {
+			return fmt.Errorf("invalid chunk index %d", index)
+		}
+
+		chunk := buf[:storageMigrationChunkLen(index, size)]
+		if _, err := io.ReadFull(reader, chunk); err != nil {
+			return fmt.Errorf("read chunk: %s", err)
+		}
+		if _, err := file.WriteAt(chunk, int64(index)*storageMigrationChunkSize); err != nil {
+			return fmt.Errorf("write chunk: %s", err)
+		}
+	}
+
+	if err := file.Sync(); err != nil {
+		return fmt.Errorf("sync file: %s", err)
+	}
+	if _, err := conn.Write([]byte{0}); err != nil {
+		return fmt.Errorf("write ack: %s", err)
+	}
+	return nil
+}
+
+func sendStorageMigrationFile(ctx context.Context, dial func() (net.Conn, error), name string, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open file: %s", err)
+	}
+	defer file.Close()
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("stat file: %s", err)
+	}
+	size := fileInfo.Size()
+
+	conn, err := dial()
+	if err != nil {
+		return fmt.Errorf("dial: %s", err)
+	}
+	defer conn.Close()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	reader := bufio.NewReader(conn)
+	writer := bufio.NewWriter(conn)
+
+	if err := binary.Write(writer, binary.BigEndian, uint16(len(name))); err != nil {
+		return fmt.Errorf("write name length: %s", err)
+	}
+	if _, err := writer.WriteString(name); err != nil {
+		return fmt.Errorf("write name: %s", err)
+	}
+	if err := binary.Write(writer, binary.BigEndian, size); err != nil {
+		return fmt.Errorf("write size: %s", err)
+	}
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("write header: %s", err)
+	}
+
+	var numChunks uint64
+	if err := binary.Read(reader, binary.BigEndian, &numChunks); err != nil {
+		return fmt.Errorf("read number of chunks: %s", err)
+	}
+	if numChunks != storageMigrationNumChunks(size) {
+		return fmt.Errorf("unexpected number of chunks %d", numChunks)
+	}
+	checksums := make([][sha256.Size]byte, numChunks)
+	for i := range checksums {
+		if _, err := io.ReadFull(reader, checksums[i][:]); err != nil {
+			return fmt.Errorf("read checksum: %s", err)
+		}
+	}
+
+	buf := make([]byte, storageMigrationChunkSize)
+	for index := uint64(0); index < numChunks; index++ {
+		chunk := buf[:storageMigrationChunkLen(index, size)]
+		if _, err := file.ReadAt(chunk, int64(index)*storageMigrationChunkSize); err != nil {
+			return fmt.Errorf("read chunk: %s", err)
+		}
+		if sha256.Sum256(chunk) == checksums[index] {
+			continue
+		}
+		if err := binary.Write(writer, binary.BigEndian, index); err != nil {
+			return fmt.Errorf("write chunk index: %s", err)
+		}
+		if _, err := writer.Write(chunk); err != nil {
+			return fmt.Errorf("write chunk: %s", err)
+		}
+	}
+	if err := binary.Write(writer, binary.BigEndian, storageMigrationEndOfChunks); err != nil {
+		return fmt.Errorf("write end of chunks: %s", err)
+	}
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("write chunks: %s", err)
+	}
+
+	ack := make([]byte, 1)
+	if _, err := io.ReadFull(reader, ack); err != nil {
+		return fmt.Errorf("read ack: %s", err)
+	}
+	return nil
+}
+
+func storageMigrationNumChunks(size int64) uint64 {
+	return uint64((size + storageMigrationChunkSize - 1) / storageMigrationChunkSize)
+}
+
+func storageMigrationChunkLen(index uint64, size int64) int64 {
+	offset := int64(index) * storageMigrationChunkSize
+	if size-offset < storageMigrationChunkSize {
+		return size - offset
+	}
+	return storageMigrationChunkSize
+}
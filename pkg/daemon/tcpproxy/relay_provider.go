@@ -11,6 +11,7 @@ import (
 	"inet.af/tcpproxy"
 
 	"github.com/smartxworks/virtink/pkg/daemon"
+	"github.com/smartxworks/virtink/pkg/migrationtoken"
 )
 
 func NewRelayProvider() daemon.RelayProvider {
@@ -19,7 +20,7 @@ func NewRelayProvider() daemon.RelayProvider {
 
 type relayProvider struct{}
 
-func (p *relayProvider) RelaySocketToTCP(ctx context.Context, socketPath string, tcpAddr string, tlsConfig *tls.Config) error {
+func (p *relayProvider) RelaySocketToTCP(ctx context.Context, socketPath string, tcpAddr string, tlsConfig *tls.Config, token []byte) error {
 	proxy := &tcpproxy.Proxy{
 		ListenFunc: func(_ string, _ string) (net.Listener, error) {
 			if err := os.MkdirAll(filepath.Dir(socketPath), 0755); err != nil {
@@ -33,7 +34,15 @@ func (p *relayProvider) RelaySocketToTCP(ctx context.Context, socketPath string,
 	}
 	proxy.AddRoute("", &tcpproxy.DialProxy{
 		DialContext: func(ctx context.Context, _ string, _ string) (net.Conn, error) {
-			return (&tls.Dialer{Config: tlsConfig}).DialContext(ctx, "tcp", tcpAddr)
+			conn, err := (&tls.Dialer{Config: tlsConfig}).DialContext(ctx, "tcp", tcpAddr)
+			if err != nil {
+				return nil, err
+			}
+			if err := migrationtoken.Send(conn, token); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			return conn, nil
 		},
 	})
 
@@ -45,7 +54,7 @@ func (p *relayProvider) RelaySocketToTCP(ctx context.Context, socketPath string,
 	return proxy.Start()
 }
 
-func (p *relayProvider) RelayTCPToSocket(ctx context.Context, tcpAddr string, tlsConfig *tls.Config, socketPath string) (int, error) {
+func (p *relayProvider) RelayTCPToSocket(ctx context.Context, tcpAddr string, tlsConfig *tls.Config, token []byte, socketPath string) (int, error) {
 	var port int
 	proxy := &tcpproxy.Proxy{
 		ListenFunc: func(_ string, _ string) (net.Listener, error) {
@@ -54,7 +63,7 @@ func (p *relayProvider) RelayTCPToSocket(ctx context.Context, tcpAddr string, tl
 				return nil, err
 			}
 			port = l.Addr().(*net.TCPAddr).Port
-			return l, nil
+			return migrationtoken.NewListener(l, token), nil
 		},
 	}
 	proxy.AddRoute("", &tcpproxy.DialProxy{
@@ -0,0 +1,72 @@
+package daemon
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+const (
+	vcpuResourceInterval = 10 * time.Second
+
+	defaultVCPUOvercommitPercent = 100
+)
+
+// VCPUCountSetter publishes the virtink.io/vcpu capacity of the node, e.g. the
+// device plugin manager.
+type VCPUCountSetter interface {
+	SetVCPUCount(count int)
+}
+
+// VCPUResourcePublisher keeps the virtink.io/vcpu capacity of the node at its
+// allocatable CPUs times the overcommit percent of the Virtink config, and at
+// zero while the vCPU resource is disabled.
+type VCPUResourcePublisher struct {
+	client.Client
+	NodeName      string
+	DevicePlugins VCPUCountSetter
+}
+
+func (p *VCPUResourcePublisher) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, p.reconcile, vcpuResourceInterval)
+	return nil
+}
+
+func (p *VCPUResourcePublisher) reconcile(ctx context.Context) {
+	log := ctrl.LoggerFrom(ctx).WithName("vcpu-resource")
+
+	config, err := virtinkconfig.Get(ctx, p.Client)
+	if err != nil {
+		log.Error(err, "get Virtink config")
+		return
+	}
+	if !config.Spec.VCPUResource.Enabled {
+		p.DevicePlugins.SetVCPUCount(0)
+		return
+	}
+
+	var node corev1.Node
+	if err := p.Get(ctx, types.NamespacedName{Name: p.NodeName}, &node); err != nil {
+		log.Error(err, "get node")
+		return
+	}
+	overcommitPercent := config.Spec.VCPUResource.OvercommitPercent
+	if overcommitPercent == 0 {
+		overcommitPercent = defaultVCPUOvercommitPercent
+	}
+	p.DevicePlugins.SetVCPUCount(calculateVCPUCount(&node, overcommitPercent))
+}
+
+// calculateVCPUCount returns the vCPUs the node can run. Allocatable CPUs
+// already exclude the CPUs reserved for the system and the kubelet.
+func calculateVCPUCount(node *corev1.Node, overcommitPercent int) int {
+	allocatable := node.Status.Allocatable[corev1.ResourceCPU]
+	return int(allocatable.MilliValue() * int64(overcommitPercent) / 100 / 1000)
+}
@@ -1,29 +1,49 @@
 package daemon
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
 	"github.com/smartxworks/virtink/pkg/cloudhypervisor"
+	"github.com/smartxworks/virtink/pkg/conditions"
+	"github.com/smartxworks/virtink/pkg/linkstate"
+	"github.com/smartxworks/virtink/pkg/migrationtoken"
+	"github.com/smartxworks/virtink/pkg/securitygroup"
 	"github.com/smartxworks/virtink/pkg/tlsutil"
+	"github.com/smartxworks/virtink/pkg/tracing"
+	"github.com/smartxworks/virtink/pkg/vmm"
 )
 
+// daemonServerName is the DNS name in the certificate of virt-daemon, which
+// is verified by migration sources.
+const daemonServerName = "virt-daemon.virtink-system.svc"
+
 type VMReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
@@ -32,25 +52,51 @@ type VMReconciler struct {
 	NodeName string
 	NodeIP   string
 	RelayProvider
+	ConsoleLogs     *ConsoleLogs
+	DownwardMetrics *DownwardMetrics
+	// StateDirPath is where per-VM state is persisted across restarts of
+	// the daemon. It's not persisted if empty.
+	StateDirPath string
+	// LeaseClient reads and writes disk leases directly from and to the API
+	// server.
+	LeaseClient client.Client
+	// APIReader reads the Secrets of migration tokens in Namespace, the
+	// namespace of the daemon, directly from the API server.
+	APIReader client.Reader
+	Namespace string
 
 	migrationControlBlocks map[types.UID]migrationControlBlock
+	downedInterfaces       map[string]*downedInterface
+	vmPodLogOffsets        map[types.UID]int64
+	lastErrors             map[types.UID]vmError
+	diskLeases             map[types.UID]*heldDiskLeases
 	mutex                  sync.Mutex
 }
 
 // +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines/finalizers,verbs=update
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtinkconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinesecuritygroups,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch
+// +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;create;update
+// +kubebuilder:rbac:groups="",namespace=virtink-system,resources=secrets,verbs=get
 
 func (r *VMReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	var vm virtv1alpha1.VirtualMachine
 	if err := r.Get(ctx, req.NamespacedName, &vm); err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
+	ctx = ctrl.LoggerInto(ctx, ctrl.LoggerFrom(ctx).WithValues("uid", vm.UID))
+	ctx, span := tracing.Start(r.traceContext(ctx, &vm), "ReconcileVM", tracing.VMAttributes(&vm)...)
+	defer span.End()
 
 	status := vm.Status.DeepCopy()
 	if err := r.reconcile(ctx, &vm); err != nil {
+		span.RecordError(err)
+		r.recordLastError(vm.UID, err)
 		r.Recorder.Eventf(&vm, corev1.EventTypeWarning, "FailedReconcile", "Failed to reconcile VM: %s", err)
 		return ctrl.Result{}, err
 	}
@@ -66,26 +112,76 @@ func (r *VMReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 	return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
 }
 
+// traceContext returns the context to trace a reconcile of the VM in. While
+// the VM boots or migrates, reconciles continue the trace of the
+// virt-controller reconcile that created the VM pod doing it.
+func (r *VMReconciler) traceContext(ctx context.Context, vm *virtv1alpha1.VirtualMachine) context.Context {
+	var vmPodName string
+	switch {
+	case vm.Status.Migration != nil && vm.Status.Migration.Phase != virtv1alpha1.VirtualMachineMigrationSucceeded && vm.Status.Migration.Phase != virtv1alpha1.VirtualMachineMigrationFailed:
+		vmPodName = vm.Status.Migration.TargetVMPodName
+	case vm.Status.Phase == virtv1alpha1.VirtualMachineScheduled:
+		vmPodName = vm.Status.VMPodName
+	}
+	if vmPodName == "" {
+		return ctx
+	}
+
+	var vmPod corev1.Pod
+	if err := r.Get(ctx, types.NamespacedName{Namespace: vm.Namespace, Name: vmPodName}, &vmPod); err != nil {
+		return ctx
+	}
+	return tracing.Extract(ctx, vmPod.Annotations[tracing.TraceContextAnnotation])
+}
+
 func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMachine) error {
 	log := ctrl.LoggerFrom(ctx)
 	shouldReconcile := (vm.Status.NodeName != "" && vm.Status.NodeName == r.NodeName) ||
 		(vm.Status.Migration != nil && vm.Status.Migration.TargetNodeName != "" && vm.Status.Migration.TargetNodeName == r.NodeName)
 	if !shouldReconcile {
+		// the VM may have been migrated away
+		r.DownwardMetrics.Delete(vm.UID)
+		r.ConsoleLogs.Notify(vm.UID)
 		return nil
 	}
 
 	if vm.DeletionTimestamp != nil && !vm.DeletionTimestamp.IsZero() {
+		r.cleanupVMState(vm.UID, vm.Status.VMPodUID)
 		return nil
 	}
 
+	if vm.Status.Phase != virtv1alpha1.VirtualMachineRunning {
+		r.DownwardMetrics.Delete(vm.UID)
+	}
+
 	switch vm.Status.Phase {
 	case virtv1alpha1.VirtualMachineScheduled:
-		vmInfo, err := r.getCloudHypervisorClient(vm).VmInfo(ctx)
+		if err := r.acquireDiskLeases(ctx, vm); err != nil {
+			return fmt.Errorf("acquire disk leases: %s", err)
+		}
+		if err := r.bootHookedVM(ctx, vm); err != nil {
+			return fmt.Errorf("boot hooked VM: %s", err)
+		}
+
+		vmInfo, err := r.getVMM(vm).VmInfo(ctx)
 		if err != nil {
 			// TODO: ignore VM not found error
 			return fmt.Errorf("get VM info: %s", err)
 		}
 
+		if vm.Status.Hibernation != nil && (vmInfo.State == "Running" || vmInfo.State == "Paused") {
+			// the VM is restored paused, or booted afresh if hibernation
+			// was removed from its spec
+			if vmInfo.State == "Paused" {
+				if err := r.getVMM(vm).VmResume(ctx); err != nil {
+					return fmt.Errorf("resume restored VM: %s", err)
+				}
+				r.Recorder.Eventf(vm, corev1.EventTypeNormal, "Restored", "Restored VM from hibernation")
+				r.syncGuestClock(ctx, vm)
+			}
+			vm.Status.Hibernation = nil
+		}
+
 		if vmInfo.State == "Running" || vmInfo.State == "Paused" {
 			vm.Status.Phase = virtv1alpha1.VirtualMachineRunning
 		}
@@ -94,65 +190,119 @@ func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMa
 			if vm.Status.NodeName != r.NodeName {
 				return nil
 			}
-			vmInfo, err := r.getCloudHypervisorClient(vm).VmInfo(ctx)
+			vmInfo, err := r.getVMM(vm).VmInfo(ctx)
 			if err != nil {
 				// TODO: ignore VM not found error
 				return fmt.Errorf("get VM info: %s", err)
 			}
 
+			if vmInfo.State == "Paused" {
+				conditions.MarkTrue(&vm.Status.Conditions, string(virtv1alpha1.VirtualMachinePaused), conditions.ReasonPaused)
+			} else {
+				conditions.Remove(&vm.Status.Conditions, string(virtv1alpha1.VirtualMachinePaused))
+			}
+
 			if vmInfo.State == "Running" || vmInfo.State == "Paused" {
 				if vm.Spec.RunPolicy == virtv1alpha1.RunPolicyHalted {
 					// TODO: shutdown with graceful timeout
-					if err := r.getCloudHypervisorClient(vm).VmShutdown(ctx); err != nil {
+					if err := r.getVMM(vm).VmShutdown(ctx); err != nil {
 						r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedPowerOff", "Failed to powered off VM")
 						return fmt.Errorf("power off VM: %s", err)
 					}
 				} else {
+					if vm.Status.PowerAction == "" && vmInfo.State == "Running" {
+						if err := r.reconcileCDROMMedia(ctx, vm); err != nil {
+							return fmt.Errorf("reconcile CD-ROM media: %s", err)
+						}
+						if err := r.reconcileDiskRateLimits(ctx, vm); err != nil {
+							return fmt.Errorf("reconcile disk rate limits: %s", err)
+						}
+						if err := r.reconcileInterfaceLinkStates(ctx, vm); err != nil {
+							return fmt.Errorf("reconcile interface link states: %s", err)
+						}
+						if err := r.reconcileSecurityGroups(ctx, vm); err != nil {
+							return fmt.Errorf("reconcile security groups: %s", err)
+						}
+					}
+
+					if err := r.reconcileVMLog(ctx, vm, vmInfo); err != nil {
+						return fmt.Errorf("reconcile VM log: %s", err)
+					}
+
+					if downward := vm.Spec.Instance.Downward; downward != nil && downward.MetricsPort > 0 && vm.Spec.Instance.Vsock != nil {
+						if err := r.DownwardMetrics.Serve(vm, getVMSocketDirPath(vm)); err != nil {
+							return fmt.Errorf("serve downward metrics: %s", err)
+						}
+					}
+
+					if vm.Status.MemoryDump != nil && vm.Status.MemoryDump.Phase == virtv1alpha1.VirtualMachineMemoryDumpRequested {
+						r.dumpMemory(ctx, vm, vmInfo)
+					}
+
+					var powerAction virtv1alpha1.VirtualMachinePowerAction
 					switch vm.Status.PowerAction {
+					case virtv1alpha1.VirtualMachinePowerCycle:
+						if err := r.getVMM(vm).VmShutdown(ctx); err != nil {
+							r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedPowerCycle", "Failed to power off VM to power cycle it")
+						} else {
+							r.Recorder.Eventf(vm, corev1.EventTypeNormal, "PoweredOff", "Powered off VM to power cycle it")
+							// kept for virt-controller to power on the VM again
+							powerAction = virtv1alpha1.VirtualMachinePowerCycle
+						}
 					case virtv1alpha1.VirtualMachinePowerOff:
-						if err := r.getCloudHypervisorClient(vm).VmShutdown(ctx); err != nil {
+						if err := r.getVMM(vm).VmShutdown(ctx); err != nil {
 							r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedPowerOff", "Failed to powered off VM")
 						} else {
 							r.Recorder.Eventf(vm, corev1.EventTypeNormal, "PoweredOff", "Powered off VM")
 						}
 					case virtv1alpha1.VirtualMachineShutdown:
-						if err := r.getCloudHypervisorClient(vm).VmPowerButton(ctx); err != nil {
+						if err := r.getVMM(vm).VmPowerButton(ctx); err != nil {
 							r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedShutdown", "Failed to shutdown VM")
 						} else {
 							r.Recorder.Eventf(vm, corev1.EventTypeNormal, "Shutdown", "Shutdown VM")
 						}
 					case virtv1alpha1.VirtualMachineReset:
-						if err := r.getCloudHypervisorClient(vm).VmReboot(ctx); err != nil {
+						if err := r.getVMM(vm).VmReboot(ctx); err != nil {
 							r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedReset", "Failed to reset VM")
 						} else {
 							r.Recorder.Eventf(vm, corev1.EventTypeNormal, "Reset", "Reset VM")
 						}
 					case virtv1alpha1.VirtualMachineReboot:
 						// TODO: reboot
-						if err := r.getCloudHypervisorClient(vm).VmReboot(ctx); err != nil {
+						if err := r.getVMM(vm).VmReboot(ctx); err != nil {
 							r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedReboot", "Failed to reboot VM")
 						} else {
 							r.Recorder.Eventf(vm, corev1.EventTypeNormal, "Rebooted", "Rebooted VM")
 						}
 					case virtv1alpha1.VirtualMachinePause:
-						if err := r.getCloudHypervisorClient(vm).VmPause(ctx); err != nil {
+						if err := r.getVMM(vm).VmPause(ctx); err != nil {
 							r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedPause", "Failed to pause VM")
 						} else {
 							r.Recorder.Eventf(vm, corev1.EventTypeNormal, "Paused", "Paused VM")
 						}
 					case virtv1alpha1.VirtualMachineResume:
-						if err := r.getCloudHypervisorClient(vm).VmResume(ctx); err != nil {
+						if err := r.getVMM(vm).VmResume(ctx); err != nil {
 							r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedResume", "Failed to resume VM")
 						} else {
 							r.Recorder.Eventf(vm, corev1.EventTypeNormal, "Resumed", "Resumed VM")
+							r.syncGuestClock(ctx, vm)
+						}
+					case virtv1alpha1.VirtualMachineHibernate:
+						if err := r.hibernate(ctx, vm, vmInfo); err != nil {
+							r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedHibernate", "Failed to hibernate VM: %s", err)
+						} else {
+							r.Recorder.Eventf(vm, corev1.EventTypeNormal, "Hibernated", "Hibernated VM")
 						}
 					default:
 						// ignored
 					}
 
-					vm.Status.PowerAction = ""
+					vm.Status.PowerAction = powerAction
 				}
 			} else {
+				r.mutex.Lock()
+				delete(r.vmPodLogOffsets, vm.Status.VMPodUID)
+				r.mutex.Unlock()
 				vm.Status.Phase = virtv1alpha1.VirtualMachineSucceeded
 			}
 		} else {
@@ -164,9 +314,28 @@ func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMa
 			switch vm.Status.Migration.Phase {
 			case virtv1alpha1.VirtualMachineMigrationScheduled:
 				if vm.Status.Migration.TargetNodeName == r.NodeName {
-					ctx, cancel := context.WithCancel(context.Background())
+					ctx, cancel := context.WithCancel(tracing.Detach(ctx))
 					migrationControlBlock.ReceiveMigrationCancelFunc = cancel
 
+					token, err := migrationtoken.Get(ctx, r.APIReader, r.Namespace, vm.Status.Migration.UID)
+					if err != nil {
+						cancel()
+						return fmt.Errorf("get migration token: %s", err)
+					}
+
+					if migratesByCheckpoint(vm) {
+						port, err := r.startStorageMigrationReceiver(ctx, vm, daemonCertDirPath, token)
+						if err != nil {
+							cancel()
+							return fmt.Errorf("start storage migration receiver: %s", err)
+						}
+
+						vm.Status.Migration.TargetStoragePort = port
+						vm.Status.Migration.TargetNodeIP = r.NodeIP
+						vm.Status.Migration.Phase = virtv1alpha1.VirtualMachineMigrationTargetReady
+						break
+					}
+
 					receiveMigrationSocketPath := filepath.Join(getMigrationTargetVMSocketDirPath(vm), "rx.sock")
 					if _, err := os.Stat(receiveMigrationSocketPath); err != nil {
 						if !os.IsNotExist(err) {
@@ -203,19 +372,7 @@ func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMa
 						}
 					}
 
-					clientCACertPool, err := tlsutil.LoadCACert(daemonCertDirPath)
-					if err != nil {
-						return fmt.Errorf("load CA cert: %s", err)
-					}
-					tlsConfig := &tls.Config{
-						GetCertificate: func(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
-							return tlsutil.LoadCert(daemonCertDirPath)
-						},
-						ClientAuth: tls.RequireAndVerifyClientCert,
-						ClientCAs:  clientCACertPool,
-					}
-
-					port, err := r.RelayTCPToSocket(ctx, "0.0.0.0:0", tlsConfig, receiveMigrationSocketPath)
+					port, err := r.RelayTCPToSocket(ctx, "0.0.0.0:0", tlsutil.NewServerConfig(daemonCertDirPath), token, receiveMigrationSocketPath)
 					if err != nil {
 						return fmt.Errorf("start target relay: %s", err)
 					}
@@ -226,16 +383,50 @@ func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMa
 				}
 			case virtv1alpha1.VirtualMachineMigrationTargetReady:
 				if vm.Status.NodeName == r.NodeName {
-					ctx, cancel := context.WithCancel(context.Background())
+					ctx, cancel := context.WithCancel(tracing.Detach(ctx))
 					migrationControlBlock.SendMigrationCancelFunc = cancel
 
-					tlsConfig := &tls.Config{
-						InsecureSkipVerify: true,
-						GetClientCertificate: func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
-							return tlsutil.LoadCert(daemonCertDirPath)
-						},
+					tlsConfig, err := tlsutil.NewClientConfig(daemonCertDirPath, daemonServerName)
+					if err != nil {
+						cancel()
+						return fmt.Errorf("create TLS config: %s", err)
 					}
-					if err := r.RelaySocketToTCP(ctx, filepath.Join(getVMSocketDirPath(vm), "tx.sock"), fmt.Sprintf("%s:%d", vm.Status.Migration.TargetNodeIP, vm.Status.Migration.TargetNodePort), tlsConfig); err != nil {
+					token, err := migrationtoken.Get(ctx, r.APIReader, r.Namespace, vm.Status.Migration.UID)
+					if err != nil {
+						cancel()
+						return fmt.Errorf("get migration token: %s", err)
+					}
+
+					if migratesByCheckpoint(vm) {
+						volumePaths, err := r.getMigrationVolumePaths(ctx, vm, false)
+						if err != nil {
+							cancel()
+							return fmt.Errorf("get source volume paths: %s", err)
+						}
+
+						targetAddr := fmt.Sprintf("%s:%d", vm.Status.Migration.TargetNodeIP, vm.Status.Migration.TargetStoragePort)
+						dial := func() (net.Conn, error) {
+							conn, err := tls.Dial("tcp", targetAddr, tlsConfig)
+							if err != nil {
+								return nil, err
+							}
+							if err := migrationtoken.Send(conn, token); err != nil {
+								conn.Close()
+								return nil, err
+							}
+							return conn, nil
+						}
+						sendMigrationErrChan := make(chan error, 1)
+						migrationVM := vm.DeepCopy()
+						go func() {
+							sendMigrationErrChan <- r.sendStorageMigration(ctx, migrationVM, volumePaths, dial)
+						}()
+						migrationControlBlock.SendMigrationErrCh = sendMigrationErrChan
+						vm.Status.Migration.Phase = virtv1alpha1.VirtualMachineMigrationCopyingStorage
+						break
+					}
+
+					if err := r.RelaySocketToTCP(ctx, filepath.Join(getVMSocketDirPath(vm), "tx.sock"), fmt.Sprintf("%s:%d", vm.Status.Migration.TargetNodeIP, vm.Status.Migration.TargetNodePort), tlsConfig, token); err != nil {
 						return fmt.Errorf("start source relay: %s", err)
 					}
 
@@ -250,6 +441,29 @@ func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMa
 					migrationControlBlock.SendMigrationErrCh = sendMigrationErrChan
 					vm.Status.Migration.Phase = virtv1alpha1.VirtualMachineMigrationRunning
 				}
+			case virtv1alpha1.VirtualMachineMigrationCopyingStorage:
+				if vm.Status.NodeName == r.NodeName {
+					if migrationControlBlock.SendMigrationErrCh == nil {
+						r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedMigrate", "Storage copy to %s was interrupted by a restart of virt-daemon", vm.Status.Migration.TargetNodeName)
+						vm.Status.Migration.Phase = virtv1alpha1.VirtualMachineMigrationFailed
+					} else {
+						select {
+						case err := <-migrationControlBlock.SendMigrationErrCh:
+							if err != nil {
+								r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedMigrate", "Failed to copy storage to %s: %s", vm.Status.Migration.TargetNodeName, err)
+								vm.Status.Migration.Phase = virtv1alpha1.VirtualMachineMigrationFailed
+							} else {
+								vm.Status.Migration.Phase = virtv1alpha1.VirtualMachineMigrationSent
+							}
+						default:
+							log.Info("VM is copying storage")
+							return nil
+						}
+					}
+					if sendDomainCancelFunc := migrationControlBlock.SendMigrationCancelFunc; sendDomainCancelFunc != nil {
+						sendDomainCancelFunc()
+					}
+				}
 			case virtv1alpha1.VirtualMachineMigrationRunning:
 				if vm.Status.NodeName == r.NodeName {
 					var vmPod corev1.Pod
@@ -264,6 +478,7 @@ func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMa
 					if vmPod.Status.Phase == corev1.PodSucceeded {
 						vm.Status.Migration.Phase = virtv1alpha1.VirtualMachineMigrationSent
 					} else if migrationControlBlock.SendMigrationErrCh == nil {
+						r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedMigrate", "Migration to %s was interrupted by a restart of virt-daemon", vm.Status.Migration.TargetNodeName)
 						vm.Status.Migration.Phase = virtv1alpha1.VirtualMachineMigrationFailed
 					} else {
 						select {
@@ -283,6 +498,17 @@ func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMa
 				}
 			case virtv1alpha1.VirtualMachineMigrationSent:
 				if vm.Status.Migration.TargetNodeName == r.NodeName {
+					if migratesByCheckpoint(vm) {
+						if err := r.restoreMigratedVM(ctx, vm); err != nil {
+							r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedMigrate", "Failed to restore VM on %s: %s", vm.Status.Migration.TargetNodeName, err)
+							vm.Status.Migration.Phase = virtv1alpha1.VirtualMachineMigrationFailed
+							if receiveDomainCancelFunc := migrationControlBlock.ReceiveMigrationCancelFunc; receiveDomainCancelFunc != nil {
+								receiveDomainCancelFunc()
+							}
+							break
+						}
+					}
+
 					vmInfo, err := r.getMigrationTargetCloudHypervisorClient(vm).VmInfo(ctx)
 					if err != nil {
 						return err
@@ -293,6 +519,7 @@ func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMa
 						vm.Status.NodeName = vm.Status.Migration.TargetNodeName
 						vm.Status.VMPodName = vm.Status.Migration.TargetVMPodName
 						vm.Status.VMPodUID = vm.Status.Migration.TargetVMPodUID
+						r.syncGuestClock(ctx, vm)
 					default:
 						log.Info("waiting target VM being Running")
 						return nil
@@ -303,6 +530,17 @@ func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMa
 					}
 				}
 			case virtv1alpha1.VirtualMachineMigrationSucceeded, virtv1alpha1.VirtualMachineMigrationFailed:
+				if vm.Status.Migration.Phase == virtv1alpha1.VirtualMachineMigrationFailed && migratesByCheckpoint(vm) && vm.Status.NodeName == r.NodeName {
+					// the VM may have been paused for copying storage
+					vmInfo, err := r.getCloudHypervisorClient(vm).VmInfo(ctx)
+					if err == nil && vmInfo.State == "Paused" {
+						if err := r.getCloudHypervisorClient(vm).VmResume(ctx); err != nil {
+							r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedResume", "Failed to resume VM")
+						} else {
+							r.syncGuestClock(ctx, vm)
+						}
+					}
+				}
 				delete(r.migrationControlBlocks, vm.UID)
 			}
 
@@ -317,10 +555,555 @@ func (r *VMReconciler) reconcile(ctx context.Context, vm *virtv1alpha1.VirtualMa
 	return nil
 }
 
+// cleanupVMState drops the per-VM state kept by the daemon, cancelling any
+// migration still in progress on this node.
+func (r *VMReconciler) cleanupVMState(vmUID types.UID, vmPodUIDs ...types.UID) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if migrationControlBlock, ok := r.migrationControlBlocks[vmUID]; ok {
+		if migrationControlBlock.SendMigrationCancelFunc != nil {
+			migrationControlBlock.SendMigrationCancelFunc()
+		}
+		if migrationControlBlock.ReceiveMigrationCancelFunc != nil {
+			migrationControlBlock.ReceiveMigrationCancelFunc()
+		}
+		delete(r.migrationControlBlocks, vmUID)
+	}
+
+	for downKey := range r.downedInterfaces {
+		if strings.HasPrefix(downKey, string(vmUID)+"/") {
+			delete(r.downedInterfaces, downKey)
+		}
+	}
+
+	for _, vmPodUID := range vmPodUIDs {
+		delete(r.vmPodLogOffsets, vmPodUID)
+	}
+	delete(r.lastErrors, vmUID)
+	r.ConsoleLogs.Delete(vmUID)
+	r.DownwardMetrics.Delete(vmUID)
+	if err := r.removeVMState(vmUID); err != nil {
+		ctrl.Log.Error(err, "remove VM state", "uid", vmUID)
+	}
+}
+
+// pruneVMState drops the per-VM state of VMs and VM pods that no longer exist.
+// It catches VMs removed while the daemon was not watching.
+func (r *VMReconciler) pruneVMState(vmUIDs map[types.UID]bool, vmPodUIDs map[types.UID]bool) {
+	var staleVMUIDs []types.UID
+	r.mutex.Lock()
+	for vmUID := range r.migrationControlBlocks {
+		if !vmUIDs[vmUID] {
+			staleVMUIDs = append(staleVMUIDs, vmUID)
+		}
+	}
+	for downKey := range r.downedInterfaces {
+		vmUID := types.UID(strings.SplitN(downKey, "/", 2)[0])
+		if !vmUIDs[vmUID] {
+			staleVMUIDs = append(staleVMUIDs, vmUID)
+		}
+	}
+	for vmPodUID := range r.vmPodLogOffsets {
+		if !vmPodUIDs[vmPodUID] {
+			delete(r.vmPodLogOffsets, vmPodUID)
+		}
+	}
+	for vmUID := range r.lastErrors {
+		if !vmUIDs[vmUID] {
+			delete(r.lastErrors, vmUID)
+		}
+	}
+	r.mutex.Unlock()
+	r.ConsoleLogs.Prune(vmUIDs)
+	r.DownwardMetrics.Prune(vmUIDs)
+	if err := r.pruneVMStateFiles(vmUIDs); err != nil {
+		ctrl.Log.Error(err, "prune VM states")
+	}
+
+	for _, vmUID := range staleVMUIDs {
+		r.cleanupVMState(vmUID)
+	}
+}
+
+// downedInterface is an interface of which QEMU has set the link of the guest
+// NIC down.
+type downedInterface struct {
+	// VMPodUID is the VM pod of which QEMU has set the link down, as QEMU
+	// doesn't report states of links, and starts with all links up.
+	VMPodUID types.UID `json:"vmPodUID,omitempty"`
+}
+
+// reconcileInterfaceLinkStates sets the links of interfaces of a running VM
+// down and up as their states change. Interfaces are cut off from their
+// networks by the link state server of the VM pod, which virt-prerunner starts
+// with the links of interfaces that are down already down. QEMU also sets the
+// links of guest NICs, so that the guest sees them go down.
+func (r *VMReconciler) reconcileInterfaceLinkStates(ctx context.Context, vm *virtv1alpha1.VirtualMachine) error {
+	if vm.Spec.Instance.Hypervisor == virtv1alpha1.HypervisorFirecracker {
+		return nil
+	}
+
+	socketPath := filepath.Join(getVMSocketDirPath(vm), linkstate.SocketName)
+	if _, err := os.Stat(socketPath); os.IsNotExist(err) {
+		// VM pods without bridge, masquerade or OVS interfaces have no
+		// link state server
+		return nil
+	}
+	linkStateClient := linkstate.NewClient(socketPath)
+	linkStates, err := linkStateClient.GetStates(ctx)
+	if err != nil {
+		return fmt.Errorf("get link states: %s", err)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, iface := range vm.Spec.Instance.Interfaces {
+		linkUp, ok := linkStates[iface.Name]
+		if !ok {
+			continue
+		}
+
+		down := iface.State == virtv1alpha1.InterfaceStateDown
+		changed := false
+		if linkUp == down {
+			if err := linkStateClient.SetState(ctx, iface.Name, !down); err != nil {
+				r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedUpdateInterfaceLinkState", "Failed to set link of interface %q %s: %s", iface.Name, getInterfaceState(down), err)
+				return fmt.Errorf("set link state: %s", err)
+			}
+			changed = true
+		}
+
+		if vm.Spec.Instance.Hypervisor == virtv1alpha1.HypervisorQEMU {
+			downKey := fmt.Sprintf("%s/%s", vm.UID, iface.Name)
+			downed := r.downedInterfaces[downKey]
+			guestLinkDown := downed != nil && downed.VMPodUID == vm.Status.VMPodUID
+			if guestLinkDown != down {
+				if err := r.getQMPClient(vm).SetLink(ctx, iface.Name, !down); err != nil {
+					r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedUpdateInterfaceLinkState", "Failed to set link of interface %q %s: %s", iface.Name, getInterfaceState(down), err)
+					return fmt.Errorf("set link: %s", err)
+				}
+				if down {
+					r.downedInterfaces[downKey] = &downedInterface{VMPodUID: vm.Status.VMPodUID}
+				} else {
+					delete(r.downedInterfaces, downKey)
+				}
+				if err := r.saveVMState(vm); err != nil {
+					return fmt.Errorf("save VM state: %s", err)
+				}
+				changed = true
+			} else if !down && downed != nil {
+				delete(r.downedInterfaces, downKey)
+			}
+		}
+
+		if changed {
+			r.Recorder.Eventf(vm, corev1.EventTypeNormal, "UpdatedInterfaceLinkState", "Set link of interface %q %s", iface.Name, getInterfaceState(down))
+		}
+	}
+	return nil
+}
+
+// reconcileSecurityGroups replaces the rules of the security groups of the
+// interfaces of a running VM as the VirtualMachineSecurityGroups change,
+// through the security group server of the VM pod, which virt-prerunner starts
+// with the rules it applied. Security groups added to or removed from
+// interfaces still only apply to VM pods created afterwards.
+func (r *VMReconciler) reconcileSecurityGroups(ctx context.Context, vm *virtv1alpha1.VirtualMachine) error {
+	socketPath := filepath.Join(getVMSocketDirPath(vm), securitygroup.SocketName)
+	if _, err := os.Stat(socketPath); os.IsNotExist(err) {
+		// VM pods without security groups have no security group server
+		return nil
+	}
+
+	securityGroups, err := securitygroup.Get(ctx, r.Client, vm)
+	if err != nil {
+		// the rules last applied are kept until the security groups exist
+		// again, which requeues the VM
+		r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedUpdateSecurityGroups", "Failed to update security groups: %s", err)
+		return nil
+	}
+
+	securityGroupClient := securitygroup.NewClient(socketPath)
+	appliedSecurityGroups, err := securityGroupClient.GetSecurityGroups(ctx)
+	if err != nil {
+		return fmt.Errorf("get security groups: %s", err)
+	}
+	for name, appliedSecurityGroup := range appliedSecurityGroups {
+		securityGroup, ok := securityGroups[name]
+		if !ok || equality.Semantic.DeepEqual(securityGroup, appliedSecurityGroup) {
+			continue
+		}
+		if err := securityGroupClient.SetSecurityGroup(ctx, name, &securityGroup); err != nil {
+			r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedUpdateSecurityGroups", "Failed to update security groups of interface %q: %s", name, err)
+			return fmt.Errorf("set security group: %s", err)
+		}
+		r.Recorder.Eventf(vm, corev1.EventTypeNormal, "UpdatedSecurityGroups", "Updated security groups of interface %q", name)
+	}
+	return nil
+}
+
+func referencesSecurityGroup(vm *virtv1alpha1.VirtualMachine, name string) bool {
+	for _, iface := range vm.Spec.Instance.Interfaces {
+		for _, securityGroup := range iface.SecurityGroups {
+			if securityGroup == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func getInterfaceState(down bool) virtv1alpha1.InterfaceState {
+	if down {
+		return virtv1alpha1.InterfaceStateDown
+	}
+	return virtv1alpha1.InterfaceStateUp
+}
+
+// reconcileDiskRateLimits sets the I/O throttling of drives of a running
+// QEMU VM as the rate limits of their disks change. Cloud Hypervisor can't
+// change the rate limit of an attached disk, so changes to Cloud Hypervisor
+// VMs take effect on restart.
+func (r *VMReconciler) reconcileDiskRateLimits(ctx context.Context, vm *virtv1alpha1.VirtualMachine) error {
+	if vm.Spec.Instance.Hypervisor != virtv1alpha1.HypervisorQEMU {
+		return nil
+	}
+
+	qmpClient := r.getQMPClient(vm)
+	for _, disk := range vm.Spec.Instance.Disks {
+		if disk.Type == virtv1alpha1.DiskTypeCDROM || disk.Type == virtv1alpha1.DiskTypePmem {
+			continue
+		}
+
+		changed, err := qmpClient.SetIOThrottle(ctx, disk.Name, vmm.NewIOThrottle(disk.RateLimit))
+		if err != nil {
+			r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedUpdateDiskRateLimit", "Failed to update rate limit of disk %q: %s", disk.Name, err)
+			return fmt.Errorf("set I/O throttle of disk %q: %s", disk.Name, err)
+		}
+		if changed {
+			r.Recorder.Eventf(vm, corev1.EventTypeNormal, "UpdatedDiskRateLimit", "Updated rate limit of disk %q", disk.Name)
+		}
+	}
+	return nil
+}
+
+// reconcileCDROMMedia inserts the media of cdrom disks of a running QEMU VM,
+// or ejects them, as their spec changes. Media are looked up in the paths
+// saved by virt-prerunner, as volumes are mounted only in the VM pod.
+func (r *VMReconciler) reconcileCDROMMedia(ctx context.Context, vm *virtv1alpha1.VirtualMachine) error {
+	if vm.Spec.Instance.Hypervisor != virtv1alpha1.HypervisorQEMU {
+		return nil
+	}
+
+	var mediumPaths map[string]string
+	qmpClient := r.getQMPClient(vm)
+	for _, disk := range vm.Spec.Instance.Disks {
+		if disk.Type != virtv1alpha1.DiskTypeCDROM {
+			continue
+		}
+
+		if mediumPaths == nil {
+			mediumPathsJSON, err := os.ReadFile(filepath.Join(getVMSocketDirPath(vm), "medium-paths.json"))
+			if err != nil {
+				return fmt.Errorf("read medium paths: %s", err)
+			}
+			if err := json.Unmarshal(mediumPathsJSON, &mediumPaths); err != nil {
+				return fmt.Errorf("unmarshal medium paths: %s", err)
+			}
+		}
+
+		medium := disk.Medium
+		if medium == "" {
+			medium = disk.Name
+		}
+		var mediumPath string
+		if !disk.Ejected {
+			var ok bool
+			mediumPath, ok = mediumPaths[medium]
+			if !ok {
+				return fmt.Errorf("path of medium %q not found", medium)
+			}
+		}
+
+		currentMediumPath, err := qmpClient.MediumPath(ctx, disk.Name)
+		if err != nil {
+			return fmt.Errorf("get medium of disk %q: %s", disk.Name, err)
+		}
+		if currentMediumPath == mediumPath {
+			continue
+		}
+
+		if mediumPath == "" {
+			if err := qmpClient.EjectMedium(ctx, disk.Name); err != nil {
+				r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedEjectMedium", "Failed to eject medium of disk %q: %s", disk.Name, err)
+				return fmt.Errorf("eject medium: %s", err)
+			}
+			r.Recorder.Eventf(vm, corev1.EventTypeNormal, "EjectedMedium", "Ejected medium of disk %q", disk.Name)
+		} else {
+			if err := qmpClient.ChangeMedium(ctx, disk.Name, mediumPath); err != nil {
+				r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedChangeMedium", "Failed to insert medium %q into disk %q: %s", medium, disk.Name, err)
+				return fmt.Errorf("change medium: %s", err)
+			}
+			r.Recorder.Eventf(vm, corev1.EventTypeNormal, "ChangedMedium", "Inserted medium %q into disk %q", medium, disk.Name)
+		}
+	}
+	return nil
+}
+
+// reconcileVMLog scans the new log of the VM pod for guest panics and
+// watchdog expiry. Cloud Hypervisor has already reset the guest by the time
+// the watchdog expiry is seen, so the configured action is performed after.
+func (r *VMReconciler) reconcileVMLog(ctx context.Context, vm *virtv1alpha1.VirtualMachine, vmInfo *cloudhypervisor.VmInfo) error {
+	vmLog, err := r.readVMPodLog(vm)
+	if err != nil {
+		return fmt.Errorf("read VM pod log: %s", err)
+	}
+
+	if vm.Spec.MemoryDump != nil && vm.Spec.MemoryDump.OnCrash && bytes.Contains(vmLog, []byte("Kernel panic - not syncing")) {
+		r.Recorder.Eventf(vm, corev1.EventTypeWarning, "GuestPanicked", "Guest kernel panicked")
+		vm.Status.MemoryDump = &virtv1alpha1.VirtualMachineStatusMemoryDump{
+			Phase: virtv1alpha1.VirtualMachineMemoryDumpRequested,
+		}
+		r.dumpMemory(ctx, vm, vmInfo)
+	}
+
+	if vm.Spec.Instance.Watchdog == nil || !bytes.Contains(vmLog, []byte("Watchdog triggered")) {
+		return nil
+	}
+
+	switch vm.Spec.Instance.Watchdog.Action {
+	case virtv1alpha1.WatchdogPowerOff:
+		r.Recorder.Eventf(vm, corev1.EventTypeWarning, "WatchdogExpired", "Watchdog expired, powering off VM")
+		if err := r.getVMM(vm).VmShutdown(ctx); err != nil {
+			r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedPowerOff", "Failed to powered off VM")
+			return fmt.Errorf("power off VM: %s", err)
+		}
+	case virtv1alpha1.WatchdogNone:
+		r.Recorder.Eventf(vm, corev1.EventTypeWarning, "WatchdogExpired", "Watchdog expired")
+	default:
+		r.Recorder.Eventf(vm, corev1.EventTypeWarning, "WatchdogExpired", "Watchdog expired, VM was reset")
+	}
+	return nil
+}
+
+// readVMPodLog returns the complete lines appended to the log of the VM pod
+// since the last read, and appends the console output in them to the console
+// log of the VM. Logs written before virt-daemon started only go to the
+// console log.
+func (r *VMReconciler) readVMPodLog(vm *virtv1alpha1.VirtualMachine) ([]byte, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	logDirName := fmt.Sprintf("%s_%s_%s", vm.Namespace, vm.Status.VMPodName, vm.Status.VMPodUID)
+	logFile, err := os.Open(filepath.Join("/var/log/pods", logDirName, "cloud-hypervisor", "0.log"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer logFile.Close()
+
+	logFileInfo, err := logFile.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	offset, ok := r.vmPodLogOffsets[vm.Status.VMPodUID]
+	if !ok {
+		offset = logFileInfo.Size() - consoleLogSize
+		if offset < 0 {
+			offset = 0
+		}
+	}
+	if logFileInfo.Size() < offset {
+		// the log has been rotated
+		offset = 0
+	}
+
+	data := make([]byte, logFileInfo.Size()-offset)
+	if _, err := logFile.ReadAt(data, offset); err != nil {
+		return nil, err
+	}
+	// leave the incomplete last line for the next time
+	n := bytes.LastIndexByte(data, '\n') + 1
+	r.vmPodLogOffsets[vm.Status.VMPodUID] = offset + int64(n)
+	data = data[:n]
+	defer func() {
+		if n > 0 || !ok {
+			if err := r.saveVMState(vm); err != nil {
+				ctrl.Log.Error(err, "save VM state", "uid", vm.UID)
+			}
+		}
+	}()
+
+	if !ok {
+		if offset > 0 {
+			// skip the line cut by the offset
+			data = data[bytes.IndexByte(data, '\n')+1:]
+		}
+		r.ConsoleLogs.AppendPodLog(vm.UID, data)
+		return nil, nil
+	}
+	r.ConsoleLogs.AppendPodLog(vm.UID, data)
+	return data, nil
+}
+
+// dumpMemory writes a dump of the guest memory to the memory dump PVC. Cloud
+// Hypervisor requires the VM to be paused during the dump.
+func (r *VMReconciler) dumpMemory(ctx context.Context, vm *virtv1alpha1.VirtualMachine, vmInfo *cloudhypervisor.VmInfo) {
+	if vm.Spec.MemoryDump == nil {
+		r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedDumpMemory", "Failed to dump memory: memory dump is not configured")
+		vm.Status.MemoryDump.Phase = virtv1alpha1.VirtualMachineMemoryDumpFailed
+		return
+	}
+
+	chClient := r.getCloudHypervisorClient(vm)
+	if vmInfo.State == "Running" {
+		if err := chClient.VmPause(ctx); err != nil {
+			r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedDumpMemory", "Failed to pause VM: %s", err)
+			vm.Status.MemoryDump.Phase = virtv1alpha1.VirtualMachineMemoryDumpFailed
+			return
+		}
+		defer func() {
+			if err := chClient.VmResume(ctx); err != nil {
+				r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedResume", "Failed to resume VM")
+			}
+		}()
+	}
+
+	fileName := fmt.Sprintf("%s-%s.elf", vm.Name, time.Now().UTC().Format("20060102-150405"))
+	if err := chClient.VmCoredump(ctx, &cloudhypervisor.VmCoredumpData{
+		DestinationUrl: "file:///mnt/virtink-memory-dump/" + fileName,
+	}); err != nil {
+		r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedDumpMemory", "Failed to dump memory: %s", err)
+		vm.Status.MemoryDump.Phase = virtv1alpha1.VirtualMachineMemoryDumpFailed
+		return
+	}
+
+	r.Recorder.Eventf(vm, corev1.EventTypeNormal, "DumpedMemory", "Dumped memory to %q", fileName)
+	now := metav1.Now()
+	vm.Status.MemoryDump = &virtv1alpha1.VirtualMachineStatusMemoryDump{
+		Phase:          virtv1alpha1.VirtualMachineMemoryDumpCompleted,
+		FileName:       fileName,
+		CompletionTime: &now,
+	}
+}
+
+// hibernate saves the state of the VM to the snapshot directory prepared by
+// virt-prerunner for the VM pod on the hibernation PVC, and powers off the VM. Cloud
+// Hypervisor requires the VM to be paused during the snapshot, and the VM is
+// resumed if the snapshot fails.
+func (r *VMReconciler) hibernate(ctx context.Context, vm *virtv1alpha1.VirtualMachine, vmInfo *cloudhypervisor.VmInfo) error {
+	if vm.Spec.Hibernation == nil {
+		return fmt.Errorf("hibernation is not configured")
+	}
+
+	chClient := r.getCloudHypervisorClient(vm)
+	if vmInfo.State == "Running" {
+		if err := chClient.VmPause(ctx); err != nil {
+			return fmt.Errorf("pause VM: %s", err)
+		}
+	}
+
+	if err := chClient.VmSnapshot(ctx, &cloudhypervisor.VmSnapshotConfig{
+		DestinationUrl: "file:///mnt/virtink-hibernation/" + string(vm.Status.VMPodUID),
+	}); err != nil {
+		if vmInfo.State == "Running" {
+			if err := chClient.VmResume(ctx); err != nil {
+				r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedResume", "Failed to resume VM")
+			}
+		}
+		return fmt.Errorf("snapshot VM: %s", err)
+	}
+
+	if err := chClient.VmShutdown(ctx); err != nil {
+		return fmt.Errorf("power off VM: %s", err)
+	}
+	now := metav1.Now()
+	vm.Status.Hibernation = &virtv1alpha1.VirtualMachineStatusHibernation{
+		Phase:           virtv1alpha1.VirtualMachineHibernated,
+		SnapshotName:    string(vm.Status.VMPodUID),
+		HibernationTime: &now,
+	}
+	return nil
+}
+
+// syncGuestClock sets the guest clock to the time of the node through the
+// guest agent, if the VM has clock sync configured, as the guest clock stands
+// still while the VM is paused. The guest agent may not be running yet, so
+// failures are only reported.
+func (r *VMReconciler) syncGuestClock(ctx context.Context, vm *virtv1alpha1.VirtualMachine) {
+	guestAgent := vmm.ConnectGuestAgent(getVMSocketDirPath(vm), vm)
+	if guestAgent == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	if err := guestAgent.SetTime(ctx, time.Now()); err != nil {
+		r.Recorder.Eventf(vm, corev1.EventTypeWarning, "FailedSyncClock", "Failed to sync guest clock: %s", err)
+		return
+	}
+	r.Recorder.Eventf(vm, corev1.EventTypeNormal, "SyncedClock", "Synced guest clock")
+}
+
+// getVMM returns the API of the VMM of the VM. Features other than the
+// lifecycle of the VM, such as migration and hibernation, are only supported
+// by Cloud Hypervisor, and use getCloudHypervisorClient instead.
+func (r *VMReconciler) getVMM(vm *virtv1alpha1.VirtualMachine) vmm.VMM {
+	return vmm.GetDriver(vm).Connect(getVMSocketDirPath(vm))
+}
+
 func (r *VMReconciler) getCloudHypervisorClient(vm *virtv1alpha1.VirtualMachine) *cloudhypervisor.Client {
 	return cloudhypervisor.NewClient(filepath.Join(getVMSocketDirPath(vm), "ch.sock"))
 }
 
+func (r *VMReconciler) getQMPClient(vm *virtv1alpha1.VirtualMachine) *vmm.QMPClient {
+	return vmm.NewQMPClient(filepath.Join(getVMSocketDirPath(vm), "qmp.sock"))
+}
+
+// bootHookedVM creates and boots the VM with the config mutated by hook
+// sidecars, which virt-prerunner leaves in the VM socket dir instead of
+// booting the VM. The config is removed once the VM boots.
+func (r *VMReconciler) bootHookedVM(ctx context.Context, vm *virtv1alpha1.VirtualMachine) error {
+	vmConfigPath := filepath.Join(getVMSocketDirPath(vm), "vm-config.json")
+	vmConfigJSON, err := os.ReadFile(vmConfigPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("read VM config: %s", err)
+	}
+
+	chClient := r.getCloudHypervisorClient(vm)
+	vmInfo, err := chClient.VmInfo(ctx)
+	if err != nil {
+		// the VM is not created until it's booted with the config
+		var vmConfig cloudhypervisor.VmConfig
+		if err := json.Unmarshal(vmConfigJSON, &vmConfig); err != nil {
+			return fmt.Errorf("unmarshal VM config: %s", err)
+		}
+		if err := chClient.VmCreate(ctx, &vmConfig); err != nil {
+			return fmt.Errorf("create VM: %s", err)
+		}
+		vmInfo = &cloudhypervisor.VmInfo{State: "Created"}
+	}
+	if vmInfo.State == "Created" {
+		if err := chClient.VmBoot(ctx); err != nil {
+			return fmt.Errorf("boot VM: %s", err)
+		}
+		r.Recorder.Eventf(vm, corev1.EventTypeNormal, "BootedHookedVM", "Booted VM with the config mutated by hook sidecars")
+	}
+
+	if err := os.Remove(vmConfigPath); err != nil {
+		return fmt.Errorf("remove VM config: %s", err)
+	}
+	return nil
+}
+
 func getVMSocketDirPath(vm *virtv1alpha1.VirtualMachine) string {
 	return filepath.Join("var/lib/kubelet/pods", string(vm.Status.VMPodUID), "volumes/kubernetes.io~empty-dir/virtink/")
 }
@@ -334,17 +1117,71 @@ func getMigrationTargetVMSocketDirPath(vm *virtv1alpha1.VirtualMachine) string {
 
 func (r *VMReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.migrationControlBlocks = map[types.UID]migrationControlBlock{}
+	r.downedInterfaces = map[string]*downedInterface{}
+	r.vmPodLogOffsets = map[types.UID]int64{}
+	r.lastErrors = map[types.UID]vmError{}
+	r.diskLeases = map[types.UID]*heldDiskLeases{}
+	r.loadVMStates()
+
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &corev1.Pod{}, ".metadata.uid", func(obj client.Object) []string {
+		return []string{string(obj.GetUID())}
+	}); err != nil {
+		return fmt.Errorf("index Pods by UID: %s", err)
+	}
+
+	watchdog := newVMWatchdog(r)
+	if err := mgr.Add(watchdog); err != nil {
+		return fmt.Errorf("add VM watchdog: %s", err)
+	}
+	if err := mgr.Add(newIdleDetector(r)); err != nil {
+		return fmt.Errorf("add idle detector: %s", err)
+	}
+	if err := mgr.Add(newMemoryOvercommitController(r)); err != nil {
+		return fmt.Errorf("add memory overcommit controller: %s", err)
+	}
+	if err := mgr.Add(newKSMController(r)); err != nil {
+		return fmt.Errorf("add KSM controller: %s", err)
+	}
+	if err := mgr.Add(newGuestMetricsCollector(r)); err != nil {
+		return fmt.Errorf("add guest metrics collector: %s", err)
+	}
+	if err := mgr.Add(newDiskLeaseKeeper(r)); err != nil {
+		return fmt.Errorf("add disk lease keeper: %s", err)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&virtv1alpha1.VirtualMachine{}).
 		Owns(&corev1.Pod{}).
+		Watches(&source.Channel{Source: watchdog.events}, &handler.EnqueueRequestForObject{}).
+		Watches(&source.Kind{Type: &virtv1beta1.VirtualMachineSecurityGroup{}}, handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+			var vmList virtv1alpha1.VirtualMachineList
+			if err := r.Client.List(context.Background(), &vmList, client.InNamespace(obj.GetNamespace())); err != nil {
+				return nil
+			}
+
+			var requests []reconcile.Request
+			for _, vm := range vmList.Items {
+				if vm.Status.NodeName != r.NodeName {
+					continue
+				}
+				if referencesSecurityGroup(&vm, obj.GetName()) {
+					requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&vm)})
+				}
+			}
+			return requests
+		})).
 		Complete(r)
 }
 
 //go:generate mockgen -destination=mock/relay_provider.go -package=mock . RelayProvider
 
+// RelayProvider relays migration connections between the sockets of the VMMs
+// over TLS. The source sends the token of the migration over every connection
+// right after the TLS handshake, and the target only relays the connections
+// presenting it.
 type RelayProvider interface {
-	RelaySocketToTCP(ctx context.Context, socketPath string, tcpAddr string, tlsConfig *tls.Config) error
-	RelayTCPToSocket(ctx context.Context, tcpAddr string, tlsConfig *tls.Config, socketPath string) (int, error)
+	RelaySocketToTCP(ctx context.Context, socketPath string, tcpAddr string, tlsConfig *tls.Config, token []byte) error
+	RelayTCPToSocket(ctx context.Context, tcpAddr string, tlsConfig *tls.Config, token []byte, socketPath string) (int, error)
 }
 
 type migrationControlBlock struct {
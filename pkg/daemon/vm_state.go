@@ -0,0 +1,140 @@
+package daemon
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+// persistedVMState is the per-VM state of the daemon that can't be recovered
+// from the VM, its VM pod or Cloud Hypervisor, persisted so that restarts of
+// the daemon, e.g. rolling upgrades, don't disturb running VMs.
+type persistedVMState struct {
+	VMPodUID          types.UID `json:"vmPodUID,omitempty"`
+	VMPodLogOffset    *int64    `json:"vmPodLogOffset,omitempty"`
+	ConsoleLog        []byte    `json:"consoleLog,omitempty"`
+	ConsoleLogWritten int64     `json:"consoleLogWritten,omitempty"`
+	// DownedInterfaces are the interfaces of which QEMU has set the links of
+	// guest NICs down, keyed by interface name, as QEMU doesn't report them.
+	DownedInterfaces map[string]*downedInterface `json:"downedInterfaces,omitempty"`
+}
+
+func (r *VMReconciler) getVMStatePath(vmUID types.UID) string {
+	return filepath.Join(r.StateDirPath, string(vmUID)+".json")
+}
+
+// saveVMState persists the state of the VM. It must be called with the mutex
+// held.
+func (r *VMReconciler) saveVMState(vm *virtv1alpha1.VirtualMachine) error {
+	if r.StateDirPath == "" {
+		return nil
+	}
+
+	state := persistedVMState{
+		VMPodUID: vm.Status.VMPodUID,
+	}
+	if offset, ok := r.vmPodLogOffsets[vm.Status.VMPodUID]; ok {
+		state.VMPodLogOffset = &offset
+	}
+	state.ConsoleLog, state.ConsoleLogWritten = r.ConsoleLogs.Snapshot(vm.UID)
+	for downKey, downed := range r.downedInterfaces {
+		if ifaceName := strings.TrimPrefix(downKey, string(vm.UID)+"/"); ifaceName != downKey {
+			if state.DownedInterfaces == nil {
+				state.DownedInterfaces = map[string]*downedInterface{}
+			}
+			state.DownedInterfaces[ifaceName] = downed
+		}
+	}
+
+	data, err := json.Marshal(&state)
+	if err != nil {
+		return fmt.Errorf("marshal VM state: %s", err)
+	}
+	if err := os.MkdirAll(r.StateDirPath, 0700); err != nil {
+		return fmt.Errorf("create state dir: %s", err)
+	}
+	// replace the file atomically, as the daemon may be killed at any time
+	tmpPath := r.getVMStatePath(vm.UID) + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return fmt.Errorf("write VM state: %s", err)
+	}
+	if err := os.Rename(tmpPath, r.getVMStatePath(vm.UID)); err != nil {
+		return fmt.Errorf("rename VM state: %s", err)
+	}
+	return nil
+}
+
+func (r *VMReconciler) removeVMState(vmUID types.UID) error {
+	if r.StateDirPath == "" {
+		return nil
+	}
+	if err := os.Remove(r.getVMStatePath(vmUID)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// loadVMStates restores the state persisted by the previous daemon on the
+// node. Unreadable state is dropped, as the daemon works without it, only
+// losing the console output and restarting the reads of VM pod logs.
+func (r *VMReconciler) loadVMStates() {
+	if r.StateDirPath == "" {
+		return
+	}
+	log := ctrl.Log.WithName("vm-state")
+
+	statePaths, err := filepath.Glob(filepath.Join(r.StateDirPath, "*.json"))
+	if err != nil {
+		log.Error(err, "list VM states")
+		return
+	}
+	for _, statePath := range statePaths {
+		vmUID := types.UID(strings.TrimSuffix(filepath.Base(statePath), ".json"))
+		var state persistedVMState
+		data, err := os.ReadFile(statePath)
+		if err == nil {
+			err = json.Unmarshal(data, &state)
+		}
+		if err != nil {
+			log.Error(err, "load VM state", "uid", vmUID)
+			os.Remove(statePath)
+			continue
+		}
+
+		if state.VMPodLogOffset != nil {
+			r.vmPodLogOffsets[state.VMPodUID] = *state.VMPodLogOffset
+		}
+		if len(state.ConsoleLog) > 0 {
+			r.ConsoleLogs.Restore(vmUID, state.ConsoleLog, state.ConsoleLogWritten)
+		}
+		for ifaceName, downed := range state.DownedInterfaces {
+			r.downedInterfaces[fmt.Sprintf("%s/%s", vmUID, ifaceName)] = downed
+		}
+	}
+}
+
+// pruneVMStateFiles removes the persisted state of VMs that no longer exist.
+func (r *VMReconciler) pruneVMStateFiles(vmUIDs map[types.UID]bool) error {
+	if r.StateDirPath == "" {
+		return nil
+	}
+	statePaths, err := filepath.Glob(filepath.Join(r.StateDirPath, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, statePath := range statePaths {
+		if vmUID := types.UID(strings.TrimSuffix(filepath.Base(statePath), ".json")); !vmUIDs[vmUID] {
+			if err := os.Remove(statePath); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package daemon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+func newTestVMReconciler(stateDirPath string) *VMReconciler {
+	return &VMReconciler{
+		ConsoleLogs:            NewConsoleLogs(),
+		StateDirPath:           stateDirPath,
+		migrationControlBlocks: map[types.UID]migrationControlBlock{},
+		downedInterfaces:       map[string]*downedInterface{},
+		vmPodLogOffsets:        map[types.UID]int64{},
+	}
+}
+
+func TestVMStateSurvivesRestart(t *testing.T) {
+	stateDirPath := t.TempDir()
+	vm := &virtv1alpha1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			UID: "vm-uid",
+		},
+		Status: virtv1alpha1.VirtualMachineStatus{
+			VMPodUID: "vm-pod-uid",
+		},
+	}
+
+	r := newTestVMReconciler(stateDirPath)
+	r.vmPodLogOffsets[vm.Status.VMPodUID] = 1024
+	r.downedInterfaces["vm-uid/pod"] = &downedInterface{VMPodUID: vm.Status.VMPodUID}
+	r.ConsoleLogs.AppendPodLog(vm.UID, []byte("2022-06-01T00:00:00Z stdout F login: \n"))
+	require.NoError(t, r.saveVMState(vm))
+
+	restarted := newTestVMReconciler(stateDirPath)
+	restarted.loadVMStates()
+	assert.Equal(t, int64(1024), restarted.vmPodLogOffsets[vm.Status.VMPodUID])
+	assert.Equal(t, r.downedInterfaces, restarted.downedInterfaces)
+	consoleLog, written := restarted.ConsoleLogs.Snapshot(vm.UID)
+	assert.Equal(t, "login: \n", string(consoleLog))
+	assert.Equal(t, int64(8), written)
+
+	require.NoError(t, restarted.pruneVMStateFiles(map[types.UID]bool{}))
+	pruned := newTestVMReconciler(stateDirPath)
+	pruned.loadVMStates()
+	assert.Empty(t, pruned.vmPodLogOffsets)
+}
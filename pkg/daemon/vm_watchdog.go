@@ -0,0 +1,192 @@
+package daemon
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/cloudhypervisor"
+	"github.com/smartxworks/virtink/pkg/logging"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+const (
+	vmWatchdogInterval = time.Minute
+	// orphanVMGracePeriod is how long a Cloud Hypervisor process may stay
+	// unclaimed by any VM before it is shut down. This covers the window
+	// between a VM pod being created and the VM status referencing it.
+	orphanVMGracePeriod = 2 * time.Minute
+)
+
+// vmWatchdog rediscovers Cloud Hypervisor processes running on the node, so
+// that VMs keep being managed across virt-daemon restarts. Processes that are
+// not claimed by any VM are shut down after a grace period, and the sockets
+// they leave behind are removed. It also acts as the janitor of the per-VM
+// state kept by the daemon.
+type vmWatchdog struct {
+	client.Client
+	Recorder   record.EventRecorder
+	NodeName   string
+	reconciler *VMReconciler
+
+	events           chan event.GenericEvent
+	adoptedPodUIDs   map[types.UID]bool
+	orphanCandidates map[types.UID]time.Time
+}
+
+func newVMWatchdog(r *VMReconciler) *vmWatchdog {
+	return &vmWatchdog{
+		Client:           r.Client,
+		Recorder:         r.Recorder,
+		NodeName:         r.NodeName,
+		reconciler:       r,
+		events:           make(chan event.GenericEvent),
+		adoptedPodUIDs:   map[types.UID]bool{},
+		orphanCandidates: map[types.UID]time.Time{},
+	}
+}
+
+func (w *vmWatchdog) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, w.scan, vmWatchdogInterval)
+	return nil
+}
+
+func (w *vmWatchdog) scan(ctx context.Context) {
+	log := ctrl.LoggerFrom(ctx).WithName("vm-watchdog")
+
+	socketPaths, err := filepath.Glob("/var/lib/kubelet/pods/*/volumes/kubernetes.io~empty-dir/virtink/ch.sock")
+	if err != nil {
+		log.Error(err, "find VM sockets")
+		return
+	}
+
+	var vmList virtv1alpha1.VirtualMachineList
+	if err := w.List(ctx, &vmList); err != nil {
+		log.Error(err, "list VMs")
+		return
+	}
+
+	vmUIDs := map[types.UID]bool{}
+	vmPodUIDs := map[types.UID]bool{}
+	vmsByPodUID := map[types.UID]*virtv1alpha1.VirtualMachine{}
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+		vmUIDs[vm.UID] = true
+		vmPodUIDs[vm.Status.VMPodUID] = true
+		if vm.Status.Migration != nil {
+			vmPodUIDs[vm.Status.Migration.TargetVMPodUID] = true
+		}
+		if vm.Status.NodeName == w.NodeName && vm.Status.VMPodUID != "" {
+			vmsByPodUID[vm.Status.VMPodUID] = vm
+		}
+		if vm.Status.Migration != nil && vm.Status.Migration.TargetNodeName == w.NodeName && vm.Status.Migration.TargetVMPodUID != "" {
+			vmsByPodUID[vm.Status.Migration.TargetVMPodUID] = vm
+		}
+	}
+
+	foundPodUIDs := map[types.UID]bool{}
+	for _, socketPath := range socketPaths {
+		relPath, err := filepath.Rel("/var/lib/kubelet/pods", socketPath)
+		if err != nil {
+			continue
+		}
+		podUID := types.UID(strings.SplitN(relPath, string(filepath.Separator), 2)[0])
+		foundPodUIDs[podUID] = true
+
+		if vm, ok := vmsByPodUID[podUID]; ok {
+			delete(w.orphanCandidates, podUID)
+			if !w.adoptedPodUIDs[podUID] {
+				log.WithValues(logging.VMKeysAndValues(vm)...).Info("adopted VM", "podUID", podUID)
+				w.adoptedPodUIDs[podUID] = true
+				select {
+				case w.events <- event.GenericEvent{Object: vm}:
+				case <-ctx.Done():
+					return
+				}
+			}
+			continue
+		}
+
+		pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		_, pingErr := cloudhypervisor.NewClient(socketPath).VmmPing(pingCtx)
+		cancel()
+
+		firstSeen, ok := w.orphanCandidates[podUID]
+		if !ok {
+			log.Info("found orphan VM", "podUID", podUID)
+			w.orphanCandidates[podUID] = time.Now()
+			continue
+		}
+		if time.Since(firstSeen) < orphanVMGracePeriod {
+			continue
+		}
+
+		if pingErr != nil {
+			// the socket is stale, the Cloud Hypervisor process has exited
+			if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+				log.Error(err, "remove stale VM socket", "podUID", podUID)
+				continue
+			}
+			log.Info("removed stale VM socket", "podUID", podUID)
+			delete(w.orphanCandidates, podUID)
+			continue
+		}
+
+		config, err := virtinkconfig.Get(ctx, w.Client)
+		if err != nil {
+			log.Error(err, "get Virtink config")
+			continue
+		}
+		if !virtinkconfig.FeatureGateEnabled(config, virtinkconfig.OrphanVMCleanup) {
+			continue
+		}
+
+		if err := w.shutdownOrphanVM(ctx, podUID, socketPath); err != nil {
+			log.Error(err, "shutdown orphan VM", "podUID", podUID)
+			continue
+		}
+		log.Info("shutdown orphan VM", "podUID", podUID)
+		delete(w.orphanCandidates, podUID)
+	}
+
+	for podUID := range w.adoptedPodUIDs {
+		if !foundPodUIDs[podUID] {
+			delete(w.adoptedPodUIDs, podUID)
+		}
+	}
+	for podUID := range w.orphanCandidates {
+		if !foundPodUIDs[podUID] {
+			delete(w.orphanCandidates, podUID)
+		}
+	}
+
+	w.reconciler.pruneVMState(vmUIDs, vmPodUIDs)
+}
+
+func (w *vmWatchdog) shutdownOrphanVM(ctx context.Context, podUID types.UID, socketPath string) error {
+	chClient := cloudhypervisor.NewClient(socketPath)
+	// the VM may not have been booted, so only the VMM shutdown error matters
+	chClient.VmShutdown(ctx)
+	if err := chClient.VmmShutdown(ctx); err != nil {
+		return err
+	}
+
+	var podList corev1.PodList
+	if err := w.List(ctx, &podList, client.MatchingFields{".metadata.uid": string(podUID)}); err == nil {
+		for i := range podList.Items {
+			w.Recorder.Eventf(&podList.Items[i], corev1.EventTypeWarning, "ShutdownOrphanVM", "Shutdown VM not managed by any VirtualMachine")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+package daemonapi
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/types"
+
+	daemonv1 "github.com/smartxworks/virtink/pkg/daemonapi/v1"
+)
+
+// ErrLegacyDaemon is returned by Dial for daemons of releases before the
+// gRPC API, which only serve HTTP endpoints.
+var ErrLegacyDaemon = errors.New("virt-daemon doesn't serve the gRPC API")
+
+// maxChunkSize is the most bytes sent in a message by Forward connections.
+const maxChunkSize = 32 * 1024
+
+// Client calls the Daemon service of a virt-daemon, in the newest version
+// both sides support.
+type Client struct {
+	conn       *grpc.ClientConn
+	apiVersion string
+	daemon     daemonv1.DaemonClient
+}
+
+// Dial connects to the virt-daemon at addr and negotiates the API version.
+// The TLS config must present a certificate signed by the virt-daemon CA.
+func Dial(ctx context.Context, addr string, tlsConfig *tls.Config) (*Client, error) {
+	// daemons serving the gRPC API negotiate HTTP/2 through ALPN, while
+	// legacy daemons stay on HTTP/1.1
+	tlsConfig = tlsConfig.Clone()
+	tlsConfig.NextProtos = []string{"h2", "http/1.1"}
+	tlsConn, err := (&tls.Dialer{Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("dial virt-daemon: %s", err)
+	}
+	negotiatedProtocol := tlsConn.(*tls.Conn).ConnectionState().NegotiatedProtocol
+	tlsConn.Close()
+	if negotiatedProtocol != "h2" {
+		return nil, ErrLegacyDaemon
+	}
+
+	tlsConfig.NextProtos = nil
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), grpc.WithBlock())
+	if err != nil {
+		return nil, fmt.Errorf("dial virt-daemon: %s", err)
+	}
+
+	apiVersion, err := negotiateAPIVersion(ctx, conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &Client{
+		conn:       conn,
+		apiVersion: apiVersion,
+		daemon:     daemonv1.NewDaemonClient(conn),
+	}, nil
+}
+
+func negotiateAPIVersion(ctx context.Context, conn *grpc.ClientConn) (string, error) {
+	apiVersionList, err := NewVersionClient(conn).GetAPIVersions(ctx, &GetAPIVersionsRequest{})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return "", ErrLegacyDaemon
+		}
+		// the status is kept, e.g. for callers the daemon doesn't allow
+		return "", status.Errorf(status.Code(err), "get API versions: %s", status.Convert(err).Message())
+	}
+
+	for _, apiVersion := range APIVersions {
+		for _, serverAPIVersion := range apiVersionList.Versions {
+			if apiVersion == serverAPIVersion {
+				return apiVersion, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no API version in common with virt-daemon, which serves %v", apiVersionList.Versions)
+}
+
+// APIVersion returns the version of the Daemon service negotiated with the
+// daemon.
+func (c *Client) APIVersion() string {
+	return c.apiVersion
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// GetConsoleLog returns the console output kept for the VM.
+func (c *Client) GetConsoleLog(ctx context.Context, vmKey types.NamespacedName) ([]byte, error) {
+	resp, err := c.daemon.GetConsoleLog(ctx, &daemonv1.GetConsoleLogRequest{Vm: NewVMRef(vmKey)})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// StreamConsoleLog writes the console output kept for the VM to w, followed by
+// the console output of the VM as it's written, until ctx is done.
+func (c *Client) StreamConsoleLog(ctx context.Context, vmKey types.NamespacedName, w io.Writer) error {
+	stream, err := c.daemon.StreamConsoleLog(ctx, &daemonv1.StreamConsoleLogRequest{Vm: NewVMRef(vmKey)})
+	if err != nil {
+		return err
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if _, err := w.Write(chunk.Data); err != nil {
+			return err
+		}
+	}
+}
+
+// Forward connects to the port of the guest. The connection stays open after
+// ctx is done, until it's closed.
+func (c *Client) Forward(ctx context.Context, vmKey types.NamespacedName, protocol daemonv1.ForwardProtocol, port uint32) (net.Conn, error) {
+	streamCtx, cancel := context.WithCancel(context.Background())
+	stream, err := c.daemon.Forward(streamCtx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	connected := make(chan error, 1)
+	go func() {
+		if err := stream.Send(&daemonv1.ForwardRequest{
+			Request: &daemonv1.ForwardRequest_Target{
+				Target: &daemonv1.ForwardTarget{
+					Vm:       NewVMRef(vmKey),
+					Protocol: protocol,
+					Port:     port,
+				},
+			},
+		}); err != nil {
+			connected <- err
+			return
+		}
+		_, err := stream.Recv()
+		connected <- err
+	}()
+	select {
+	case err := <-connected:
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+	case <-ctx.Done():
+		cancel()
+		return nil, ctx.Err()
+	}
+
+	return &streamConn{
+		stream: stream,
+		cancel: cancel,
+	}, nil
+}
+
+// streamConn is a connection over the stream of a Forward call.
+type streamConn struct {
+	stream    daemonv1.Daemon_ForwardClient
+	cancel    context.CancelFunc
+	readBuf   []byte
+	writeLock sync.Mutex
+}
+
+var _ net.Conn = &streamConn{}
+
+func (c *streamConn) Read(b []byte) (int, error) {
+	for len(c.readBuf) == 0 {
+		resp, err := c.stream.Recv()
+		if err != nil {
+			if status.Code(err) == codes.Canceled {
+				return 0, net.ErrClosed
+			}
+			return 0, err
+		}
+		c.readBuf = resp.Data
+	}
+	n := copy(b, c.readBuf)
+	c.readBuf = c.readBuf[n:]
+	return n, nil
+}
+
+func (c *streamConn) Write(b []byte) (int, error) {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	var n int
+	for n < len(b) {
+		size := len(b) - n
+		if size > maxChunkSize {
+			size = maxChunkSize
+		}
+		if err := c.stream.Send(&daemonv1.ForwardRequest{
+			Request: &daemonv1.ForwardRequest_Data{Data: b[n : n+size]},
+		}); err != nil {
+			return n, err
+		}
+		n += size
+	}
+	return n, nil
+}
+
+// CloseWrite tells the daemon nothing more is sent, which shuts down the
+// writing side of the connection to the guest.
+func (c *streamConn) CloseWrite() error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	return c.stream.CloseSend()
+}
+
+func (c *streamConn) Close() error {
+	c.cancel()
+	return nil
+}
+
+func (c *streamConn) LocalAddr() net.Addr {
+	return streamAddr{}
+}
+
+func (c *streamConn) RemoteAddr() net.Addr {
+	return streamAddr{}
+}
+
+func (c *streamConn) SetDeadline(t time.Time) error {
+	return errors.New("deadlines are not supported")
+}
+
+func (c *streamConn) SetReadDeadline(t time.Time) error {
+	return errors.New("deadlines are not supported")
+}
+
+func (c *streamConn) SetWriteDeadline(t time.Time) error {
+	return errors.New("deadlines are not supported")
+}
+
+type streamAddr struct{}
+
+func (streamAddr) Network() string {
+	return "grpc"
+}
+
+func (streamAddr) String() string {
+	return "virt-daemon"
+}
@@ -0,0 +1,48 @@
+// Package daemonapi is the versioned gRPC API virt-daemon serves for other
+// Virtink components, defined in version.proto and <version>/daemon.proto,
+// from which the *.pb.go files are generated. The Daemon service is versioned
+// by its package, e.g. virtink.daemon.v1.Daemon in the daemonv1 package, so
+// that a daemon can serve several versions side by side. Clients find the
+// version they have in common with the daemon from the unversioned Version
+// service before calling it, which keeps components of different releases
+// working together during rolling upgrades.
+package daemonapi
+
+//go:generate protoc --go_out=paths=source_relative:. --go-grpc_out=paths=source_relative:. version.proto v1/daemon.proto
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/types"
+
+	daemonv1 "github.com/smartxworks/virtink/pkg/daemonapi/v1"
+)
+
+// APIVersions are the versions of the Daemon service implemented by this
+// package, newest first.
+var APIVersions = []string{"v1"}
+
+func NewVMRef(vmKey types.NamespacedName) *daemonv1.VMRef {
+	return &daemonv1.VMRef{
+		Namespace: vmKey.Namespace,
+		Name:      vmKey.Name,
+	}
+}
+
+// RegisterDaemonServer registers the Version service and version v1 of the
+// Daemon service to a gRPC server.
+func RegisterDaemonServer(s *grpc.Server, srv daemonv1.DaemonServer) {
+	RegisterVersionServer(s, versionServer{})
+	daemonv1.RegisterDaemonServer(s, srv)
+}
+
+// versionServer serves the versions of the Daemon service implemented by this
+// package.
+type versionServer struct {
+	UnimplementedVersionServer
+}
+
+func (versionServer) GetAPIVersions(ctx context.Context, req *GetAPIVersionsRequest) (*APIVersionList, error) {
+	return &APIVersionList{Versions: APIVersions}, nil
+}
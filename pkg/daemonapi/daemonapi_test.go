@@ -0,0 +1,152 @@
+package daemonapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/types"
+
+	daemonv1 "github.com/smartxworks/virtink/pkg/daemonapi/v1"
+	"github.com/smartxworks/virtink/pkg/tlsutil"
+)
+
+type fakeDaemonServer struct {
+	daemonv1.UnimplementedDaemonServer
+}
+
+func (s *fakeDaemonServer) GetConsoleLog(ctx context.Context, req *daemonv1.GetConsoleLogRequest) (*daemonv1.GetConsoleLogResponse, error) {
+	if req.Vm.Name != "ubuntu" {
+		return nil, status.Error(codes.NotFound, "VM not found")
+	}
+	return &daemonv1.GetConsoleLogResponse{Data: []byte("login: ")}, nil
+}
+
+func (s *fakeDaemonServer) StreamConsoleLog(req *daemonv1.StreamConsoleLogRequest, stream daemonv1.Daemon_StreamConsoleLogServer) error {
+	for _, data := range []string{"login: ", "root\n"} {
+		if err := stream.Send(&daemonv1.ConsoleLogChunk{Data: []byte(data)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Forward echoes the bytes received back in upper case.
+func (s *fakeDaemonServer) Forward(stream daemonv1.Daemon_ForwardServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	target := req.GetTarget()
+	if target == nil || target.Protocol != daemonv1.ForwardProtocol_FORWARD_PROTOCOL_VSOCK || target.Port != 1024 {
+		return status.Error(codes.InvalidArgument, "unexpected port")
+	}
+	if err := stream.Send(&daemonv1.ForwardResponse{}); err != nil {
+		return err
+	}
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(&daemonv1.ForwardResponse{Data: bytes.ToUpper(req.GetData())}); err != nil {
+			return err
+		}
+	}
+}
+
+// serveTLS serves handler over TLS on a local port, negotiating the protocols
+// through ALPN, and returns the address and the client TLS config.
+func serveTLS(t *testing.T, handler http.Handler, nextProtos []string) (string, *tls.Config) {
+	certPEM, keyPEM, err := tlsutil.GenerateSelfSignedCert([]string{"virt-daemon"}, time.Hour)
+	require.NoError(t, err)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	certPool := x509.NewCertPool()
+	certPool.AppendCertsFromPEM(certPEM)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   nextProtos,
+	})
+	require.NoError(t, err)
+	server := &http.Server{Handler: handler}
+	go server.Serve(listener)
+	t.Cleanup(func() { server.Close() })
+
+	return listener.Addr().String(), &tls.Config{
+		RootCAs:    certPool,
+		ServerName: "virt-daemon",
+	}
+}
+
+func TestClient(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	RegisterDaemonServer(grpcServer, &fakeDaemonServer{})
+	addr, tlsConfig := serveTLS(t, grpcServer, []string{"h2", "http/1.1"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	c, err := Dial(ctx, addr, tlsConfig)
+	require.NoError(t, err)
+	defer c.Close()
+	assert.Equal(t, "v1", c.APIVersion())
+
+	vmKey := types.NamespacedName{Namespace: "default", Name: "ubuntu"}
+	consoleLog, err := c.GetConsoleLog(ctx, vmKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "login: ", string(consoleLog))
+
+	_, err = c.GetConsoleLog(ctx, types.NamespacedName{Namespace: "default", Name: "centos"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	var consoleOutput []byte
+	assert.NoError(t, c.StreamConsoleLog(ctx, vmKey, writerFunc(func(p []byte) (int, error) {
+		consoleOutput = append(consoleOutput, p...)
+		return len(p), nil
+	})))
+	assert.Equal(t, "login: root\n", string(consoleOutput))
+
+	_, err = c.Forward(ctx, vmKey, daemonv1.ForwardProtocol_FORWARD_PROTOCOL_TCP, 22)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	conn, err := c.Forward(ctx, vmKey, daemonv1.ForwardProtocol_FORWARD_PROTOCOL_VSOCK, 1024)
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("hello"))
+	require.NoError(t, err)
+	require.NoError(t, conn.(interface{ CloseWrite() error }).CloseWrite())
+	data, err := io.ReadAll(conn)
+	assert.NoError(t, err)
+	assert.Equal(t, "HELLO", string(data))
+}
+
+func TestDialLegacyDaemon(t *testing.T) {
+	addr, tlsConfig := serveTLS(t, http.NotFoundHandler(), nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := Dial(ctx, addr, tlsConfig)
+	assert.Equal(t, ErrLegacyDaemon, err)
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
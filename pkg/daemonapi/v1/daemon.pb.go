@@ -0,0 +1,769 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.21.12
+// source: v1/daemon.proto
+
+package daemonv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ForwardProtocol int32
+
+const (
+	ForwardProtocol_FORWARD_PROTOCOL_UNSPECIFIED ForwardProtocol = 0
+	// FORWARD_PROTOCOL_TCP connects to a TCP port of the guest through the IP
+	// of the VM pod.
+	ForwardProtocol_FORWARD_PROTOCOL_TCP ForwardProtocol = 1
+	// FORWARD_PROTOCOL_VSOCK connects to a vsock port of the guest through the
+	// vsock socket of the VM.
+	ForwardProtocol_FORWARD_PROTOCOL_VSOCK ForwardProtocol = 2
+	// FORWARD_PROTOCOL_SERIAL connects to the serial console of a QEMU VM. The
+	// port must be 0.
+	ForwardProtocol_FORWARD_PROTOCOL_SERIAL ForwardProtocol = 3
+	// FORWARD_PROTOCOL_VNC connects to the VNC server of the display of a QEMU
+	// VM. The port must be 0.
+	ForwardProtocol_FORWARD_PROTOCOL_VNC ForwardProtocol = 4
+)
+
+// Enum value maps for ForwardProtocol.
+var (
+	ForwardProtocol_name = map[int32]string{
+		0: "FORWARD_PROTOCOL_UNSPECIFIED",
+		1: "FORWARD_PROTOCOL_TCP",
+		2: "FORWARD_PROTOCOL_VSOCK",
+		3: "FORWARD_PROTOCOL_SERIAL",
+		4: "FORWARD_PROTOCOL_VNC",
+	}
+	ForwardProtocol_value = map[string]int32{
+		"FORWARD_PROTOCOL_UNSPECIFIED": 0,
+		"FORWARD_PROTOCOL_TCP":         1,
+		"FORWARD_PROTOCOL_VSOCK":       2,
+		"FORWARD_PROTOCOL_SERIAL":      3,
+		"FORWARD_PROTOCOL_VNC":         4,
+	}
+)
+
+func (x ForwardProtocol) Enum() *ForwardProtocol {
+	p := new(ForwardProtocol)
+	*p = x
+	return p
+}
+
+func (x ForwardProtocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ForwardProtocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_daemon_proto_enumTypes[0].Descriptor()
+}
+
+func (ForwardProtocol) Type() protoreflect.EnumType {
+	return &file_v1_daemon_proto_enumTypes[0]
+}
+
+func (x ForwardProtocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ForwardProtocol.Descriptor instead.
+func (ForwardProtocol) EnumDescriptor() ([]byte, []int) {
+	return file_v1_daemon_proto_rawDescGZIP(), []int{0}
+}
+
+// VMRef refers to a VM on the node of the daemon.
+type VMRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *VMRef) Reset() {
+	*x = VMRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_daemon_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VMRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMRef) ProtoMessage() {}
+
+func (x *VMRef) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_daemon_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMRef.ProtoReflect.Descriptor instead.
+func (*VMRef) Descriptor() ([]byte, []int) {
+	return file_v1_daemon_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VMRef) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *VMRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetConsoleLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vm *VMRef `protobuf:"bytes,1,opt,name=vm,proto3" json:"vm,omitempty"`
+}
+
+func (x *GetConsoleLogRequest) Reset() {
+	*x = GetConsoleLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_daemon_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConsoleLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsoleLogRequest) ProtoMessage() {}
+
+func (x *GetConsoleLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_daemon_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsoleLogRequest.ProtoReflect.Descriptor instead.
+func (*GetConsoleLogRequest) Descriptor() ([]byte, []int) {
+	return file_v1_daemon_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetConsoleLogRequest) GetVm() *VMRef {
+	if x != nil {
+		return x.Vm
+	}
+	return nil
+}
+
+type GetConsoleLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetConsoleLogResponse) Reset() {
+	*x = GetConsoleLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_daemon_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConsoleLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsoleLogResponse) ProtoMessage() {}
+
+func (x *GetConsoleLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_daemon_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsoleLogResponse.ProtoReflect.Descriptor instead.
+func (*GetConsoleLogResponse) Descriptor() ([]byte, []int) {
+	return file_v1_daemon_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetConsoleLogResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type StreamConsoleLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vm *VMRef `protobuf:"bytes,1,opt,name=vm,proto3" json:"vm,omitempty"`
+}
+
+func (x *StreamConsoleLogRequest) Reset() {
+	*x = StreamConsoleLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_daemon_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamConsoleLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamConsoleLogRequest) ProtoMessage() {}
+
+func (x *StreamConsoleLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_daemon_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamConsoleLogRequest.ProtoReflect.Descriptor instead.
+func (*StreamConsoleLogRequest) Descriptor() ([]byte, []int) {
+	return file_v1_daemon_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StreamConsoleLogRequest) GetVm() *VMRef {
+	if x != nil {
+		return x.Vm
+	}
+	return nil
+}
+
+type ConsoleLogChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ConsoleLogChunk) Reset() {
+	*x = ConsoleLogChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_daemon_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsoleLogChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsoleLogChunk) ProtoMessage() {}
+
+func (x *ConsoleLogChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_daemon_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsoleLogChunk.ProtoReflect.Descriptor instead.
+func (*ConsoleLogChunk) Descriptor() ([]byte, []int) {
+	return file_v1_daemon_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ConsoleLogChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ForwardTarget is the port of the guest a Forward call connects to.
+type ForwardTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vm       *VMRef          `protobuf:"bytes,1,opt,name=vm,proto3" json:"vm,omitempty"`
+	Protocol ForwardProtocol `protobuf:"varint,2,opt,name=protocol,proto3,enum=virtink.daemon.v1.ForwardProtocol" json:"protocol,omitempty"`
+	Port     uint32          `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *ForwardTarget) Reset() {
+	*x = ForwardTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_daemon_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardTarget) ProtoMessage() {}
+
+func (x *ForwardTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_daemon_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardTarget.ProtoReflect.Descriptor instead.
+func (*ForwardTarget) Descriptor() ([]byte, []int) {
+	return file_v1_daemon_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ForwardTarget) GetVm() *VMRef {
+	if x != nil {
+		return x.Vm
+	}
+	return nil
+}
+
+func (x *ForwardTarget) GetProtocol() ForwardProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return ForwardProtocol_FORWARD_PROTOCOL_UNSPECIFIED
+}
+
+func (x *ForwardTarget) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type ForwardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Request:
+	//	*ForwardRequest_Target
+	//	*ForwardRequest_Data
+	Request isForwardRequest_Request `protobuf_oneof:"request"`
+}
+
+func (x *ForwardRequest) Reset() {
+	*x = ForwardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_daemon_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardRequest) ProtoMessage() {}
+
+func (x *ForwardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_daemon_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardRequest.ProtoReflect.Descriptor instead.
+func (*ForwardRequest) Descriptor() ([]byte, []int) {
+	return file_v1_daemon_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *ForwardRequest) GetRequest() isForwardRequest_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (x *ForwardRequest) GetTarget() *ForwardTarget {
+	if x, ok := x.GetRequest().(*ForwardRequest_Target); ok {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ForwardRequest) GetData() []byte {
+	if x, ok := x.GetRequest().(*ForwardRequest_Data); ok {
+		return x.Data
+	}
+	return nil
+}
+
+type isForwardRequest_Request interface {
+	isForwardRequest_Request()
+}
+
+type ForwardRequest_Target struct {
+	// target is only sent in the first request.
+	Target *ForwardTarget `protobuf:"bytes,1,opt,name=target,proto3,oneof"`
+}
+
+type ForwardRequest_Data struct {
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*ForwardRequest_Target) isForwardRequest_Request() {}
+
+func (*ForwardRequest_Data) isForwardRequest_Request() {}
+
+type ForwardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ForwardResponse) Reset() {
+	*x = ForwardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_daemon_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardResponse) ProtoMessage() {}
+
+func (x *ForwardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_daemon_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardResponse.ProtoReflect.Descriptor instead.
+func (*ForwardResponse) Descriptor() ([]byte, []int) {
+	return file_v1_daemon_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ForwardResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_v1_daemon_proto protoreflect.FileDescriptor
+
+var file_v1_daemon_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x11, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6e, 0x6b, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x22, 0x39, 0x0a, 0x05, 0x56, 0x4d, 0x52, 0x65, 0x66, 0x12, 0x1c, 0x0a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x40, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4c, 0x6f, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x02, 0x76, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6e, 0x6b, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x4d, 0x52, 0x65, 0x66, 0x52, 0x02, 0x76,
+	0x6d, 0x22, 0x2b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4c,
+	0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x43,
+	0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4c,
+	0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x02, 0x76, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6e, 0x6b, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x4d, 0x52, 0x65, 0x66, 0x52,
+	0x02, 0x76, 0x6d, 0x22, 0x25, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4c, 0x6f,
+	0x67, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x8d, 0x01, 0x0a, 0x0d, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x02,
+	0x76, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x72, 0x74, 0x69,
+	0x6e, 0x6b, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x4d, 0x52,
+	0x65, 0x66, 0x52, 0x02, 0x76, 0x6d, 0x12, 0x3e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x76, 0x69, 0x72, 0x74, 0x69,
+	0x6e, 0x6b, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x6d, 0x0a, 0x0e, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76,
+	0x69, 0x72, 0x74, 0x69, 0x6e, 0x6b, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x00,
+	0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x09,
+	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x25, 0x0a, 0x0f, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x2a, 0xa0, 0x01, 0x0a, 0x0f, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x20, 0x0a, 0x1c, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52,
+	0x44, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x54, 0x43, 0x50, 0x10, 0x01,
+	0x12, 0x1a, 0x0a, 0x16, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x54,
+	0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x56, 0x53, 0x4f, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17,
+	0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c,
+	0x5f, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x4f, 0x52,
+	0x57, 0x41, 0x52, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x56, 0x4e,
+	0x43, 0x10, 0x04, 0x32, 0xa8, 0x02, 0x0a, 0x06, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x62,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x12,
+	0x27, 0x2e, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6e, 0x6b, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4c, 0x6f,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x76, 0x69, 0x72, 0x74, 0x69,
+	0x6e, 0x6b, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x64, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x73,
+	0x6f, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x12, 0x2a, 0x2e, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6e, 0x6b,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6e, 0x6b, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4c, 0x6f,
+	0x67, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x54, 0x0a, 0x07, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x12, 0x21, 0x2e, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6e, 0x6b, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6e, 0x6b,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x42, 0x3a,
+	0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6d, 0x61,
+	0x72, 0x74, 0x78, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6e, 0x6b,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x3b, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_v1_daemon_proto_rawDescOnce sync.Once
+	file_v1_daemon_proto_rawDescData = file_v1_daemon_proto_rawDesc
+)
+
+func file_v1_daemon_proto_rawDescGZIP() []byte {
+	file_v1_daemon_proto_rawDescOnce.Do(func() {
+		file_v1_daemon_proto_rawDescData = protoimpl.X.CompressGZIP(file_v1_daemon_proto_rawDescData)
+	})
+	return file_v1_daemon_proto_rawDescData
+}
+
+var file_v1_daemon_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_daemon_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_v1_daemon_proto_goTypes = []interface{}{
+	(ForwardProtocol)(0),            // 0: virtink.daemon.v1.ForwardProtocol
+	(*VMRef)(nil),                   // 1: virtink.daemon.v1.VMRef
+	(*GetConsoleLogRequest)(nil),    // 2: virtink.daemon.v1.GetConsoleLogRequest
+	(*GetConsoleLogResponse)(nil),   // 3: virtink.daemon.v1.GetConsoleLogResponse
+	(*StreamConsoleLogRequest)(nil), // 4: virtink.daemon.v1.StreamConsoleLogRequest
+	(*ConsoleLogChunk)(nil),         // 5: virtink.daemon.v1.ConsoleLogChunk
+	(*ForwardTarget)(nil),           // 6: virtink.daemon.v1.ForwardTarget
+	(*ForwardRequest)(nil),          // 7: virtink.daemon.v1.ForwardRequest
+	(*ForwardResponse)(nil),         // 8: virtink.daemon.v1.ForwardResponse
+}
+var file_v1_daemon_proto_depIdxs = []int32{
+	1, // 0: virtink.daemon.v1.GetConsoleLogRequest.vm:type_name -> virtink.daemon.v1.VMRef
+	1, // 1: virtink.daemon.v1.StreamConsoleLogRequest.vm:type_name -> virtink.daemon.v1.VMRef
+	1, // 2: virtink.daemon.v1.ForwardTarget.vm:type_name -> virtink.daemon.v1.VMRef
+	0, // 3: virtink.daemon.v1.ForwardTarget.protocol:type_name -> virtink.daemon.v1.ForwardProtocol
+	6, // 4: virtink.daemon.v1.ForwardRequest.target:type_name -> virtink.daemon.v1.ForwardTarget
+	2, // 5: virtink.daemon.v1.Daemon.GetConsoleLog:input_type -> virtink.daemon.v1.GetConsoleLogRequest
+	4, // 6: virtink.daemon.v1.Daemon.StreamConsoleLog:input_type -> virtink.daemon.v1.StreamConsoleLogRequest
+	7, // 7: virtink.daemon.v1.Daemon.Forward:input_type -> virtink.daemon.v1.ForwardRequest
+	3, // 8: virtink.daemon.v1.Daemon.GetConsoleLog:output_type -> virtink.daemon.v1.GetConsoleLogResponse
+	5, // 9: virtink.daemon.v1.Daemon.StreamConsoleLog:output_type -> virtink.daemon.v1.ConsoleLogChunk
+	8, // 10: virtink.daemon.v1.Daemon.Forward:output_type -> virtink.daemon.v1.ForwardResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_v1_daemon_proto_init() }
+func file_v1_daemon_proto_init() {
+	if File_v1_daemon_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_v1_daemon_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VMRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_daemon_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConsoleLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_daemon_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConsoleLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_daemon_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamConsoleLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_daemon_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsoleLogChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_daemon_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_daemon_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_daemon_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_v1_daemon_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*ForwardRequest_Target)(nil),
+		(*ForwardRequest_Data)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_v1_daemon_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_daemon_proto_goTypes,
+		DependencyIndexes: file_v1_daemon_proto_depIdxs,
+		EnumInfos:         file_v1_daemon_proto_enumTypes,
+		MessageInfos:      file_v1_daemon_proto_msgTypes,
+	}.Build()
+	File_v1_daemon_proto = out.File
+	file_v1_daemon_proto_rawDesc = nil
+	file_v1_daemon_proto_goTypes = nil
+	file_v1_daemon_proto_depIdxs = nil
+}
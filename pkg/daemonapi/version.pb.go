@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.21.12
+// source: version.proto
+
+package daemonapi
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetAPIVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetAPIVersionsRequest) Reset() {
+	*x = GetAPIVersionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_version_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAPIVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPIVersionsRequest) ProtoMessage() {}
+
+func (x *GetAPIVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_version_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAPIVersionsRequest.ProtoReflect.Descriptor instead.
+func (*GetAPIVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_version_proto_rawDescGZIP(), []int{0}
+}
+
+type APIVersionList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Versions []string `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (x *APIVersionList) Reset() {
+	*x = APIVersionList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_version_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIVersionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIVersionList) ProtoMessage() {}
+
+func (x *APIVersionList) ProtoReflect() protoreflect.Message {
+	mi := &file_version_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIVersionList.ProtoReflect.Descriptor instead.
+func (*APIVersionList) Descriptor() ([]byte, []int) {
+	return file_version_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *APIVersionList) GetVersions() []string {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+var File_version_proto protoreflect.FileDescriptor
+
+var file_version_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0e, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6e, 0x6b, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x22,
+	0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x50, 0x49, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2c, 0x0a, 0x0e, 0x41, 0x50, 0x49, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0x62, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x57, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x50, 0x49, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6e, 0x6b, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x50, 0x49, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x69, 0x72,
+	0x74, 0x69, 0x6e, 0x6b, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x50, 0x49, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x78, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6e, 0x6b, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_version_proto_rawDescOnce sync.Once
+	file_version_proto_rawDescData = file_version_proto_rawDesc
+)
+
+func file_version_proto_rawDescGZIP() []byte {
+	file_version_proto_rawDescOnce.Do(func() {
+		file_version_proto_rawDescData = protoimpl.X.CompressGZIP(file_version_proto_rawDescData)
+	})
+	return file_version_proto_rawDescData
+}
+
+var file_version_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_version_proto_goTypes = []interface{}{
+	(*GetAPIVersionsRequest)(nil), // 0: virtink.daemon.GetAPIVersionsRequest
+	(*APIVersionList)(nil),        // 1: virtink.daemon.APIVersionList
+}
+var file_version_proto_depIdxs = []int32{
+	0, // 0: virtink.daemon.Version.GetAPIVersions:input_type -> virtink.daemon.GetAPIVersionsRequest
+	1, // 1: virtink.daemon.Version.GetAPIVersions:output_type -> virtink.daemon.APIVersionList
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_version_proto_init() }
+func file_version_proto_init() {
+	if File_version_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_version_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAPIVersionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_version_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIVersionList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_version_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_version_proto_goTypes,
+		DependencyIndexes: file_version_proto_depIdxs,
+		MessageInfos:      file_version_proto_msgTypes,
+	}.Build()
+	File_version_proto = out.File
+	file_version_proto_rawDesc = nil
+	file_version_proto_goTypes = nil
+	file_version_proto_depIdxs = nil
+}
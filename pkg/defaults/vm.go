@@ -0,0 +1,360 @@
+// Package defaults sets the defaults of Virtink objects, as the mutating
+// webhooks of virt-controller do. Tools such as GitOps controllers can use it
+// to render the objects as they would be stored, to diff against them.
+package defaults
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+// VMMemoryOverhead is the memory used by the VM pod besides the guest memory.
+const VMMemoryOverhead = "256Mi"
+
+// RenderVM returns a copy of the VM to be created with all defaults set, as it
+// would be stored by the API server, using the Virtink config and the Virtink
+// namespace config of the VM from c.
+func RenderVM(ctx context.Context, c client.Reader, vm *virtv1alpha1.VirtualMachine) (*virtv1alpha1.VirtualMachine, error) {
+	config, err := virtinkconfig.Get(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("get Virtink config: %s", err)
+	}
+	namespaceConfig, err := virtinkconfig.GetNamespaceConfig(ctx, c, vm.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("get Virtink namespace config: %s", err)
+	}
+
+	vm = vm.DeepCopy()
+	ApplyNamespaceDefaults(&namespaceConfig.Spec, vm)
+	ApplyNetworkDefaults(&config.Spec.Network, vm)
+	ApplyMemoryOvercommitDefaults(&config.Spec.MemoryOvercommit, vm)
+	if err := SetVMDefaults(vm, nil); err != nil {
+		return nil, err
+	}
+	return vm, nil
+}
+
+// ApplyNetworkDefaults sets the interface binding method and masquerade CIDR
+// configured as network defaults. SetVMDefaults sets built-in defaults for the rest.
+func ApplyNetworkDefaults(networkConfig *virtv1beta1.VirtinkConfigNetwork, vm *virtv1alpha1.VirtualMachine) {
+	for i := range vm.Spec.Instance.Interfaces {
+		iface := &vm.Spec.Instance.Interfaces[i]
+		if iface.Bridge == nil && iface.Masquerade == nil && iface.SRIOV == nil && iface.VhostUser == nil && iface.OVS == nil {
+			if networkConfig.DefaultInterfaceBinding == "masquerade" {
+				iface.Masquerade = &virtv1alpha1.InterfaceMasquerade{}
+			}
+		}
+		if iface.Masquerade != nil && iface.Masquerade.CIDR == "" {
+			iface.Masquerade.CIDR = networkConfig.DefaultMasqueradeCIDR
+		}
+	}
+}
+
+// ApplyNamespaceDefaults sets the defaults configured by the Virtink namespace
+// config. They take precedence over the Virtink config, so it is called before
+// ApplyNetworkDefaults.
+func ApplyNamespaceDefaults(namespaceConfig *virtv1beta1.VirtinkNamespaceConfigSpec, vm *virtv1alpha1.VirtualMachine) {
+	// bridge is not set by ApplyNetworkDefaults, as it is the built-in
+	// default, but it has to be here to override the Virtink config
+	if namespaceConfig.Network.DefaultInterfaceBinding == "bridge" {
+		for i := range vm.Spec.Instance.Interfaces {
+			iface := &vm.Spec.Instance.Interfaces[i]
+			if iface.Bridge == nil && iface.Masquerade == nil && iface.SRIOV == nil && iface.VhostUser == nil && iface.OVS == nil {
+				iface.Bridge = &virtv1alpha1.InterfaceBridge{}
+			}
+		}
+	}
+	ApplyNetworkDefaults(&namespaceConfig.Network, vm)
+
+	if userData := namespaceConfig.CloudInit.DefaultUserData; userData != "" {
+		for i := range vm.Spec.Volumes {
+			cloudInit := vm.Spec.Volumes[i].CloudInit
+			if cloudInit != nil && cloudInit.UserData == "" && cloudInit.UserDataBase64 == "" && cloudInit.UserDataSecretName == "" {
+				cloudInit.UserData = userData
+			}
+		}
+	}
+
+	memory := &vm.Spec.Instance.Memory
+	overhead := namespaceConfig.Resources.MemoryOverhead
+	if overhead == nil || memory.Size.IsZero() || !vm.Spec.Resources.Requests.Memory().IsZero() {
+		return
+	}
+	// left to SetVMDefaults and ApplyMemoryOvercommitDefaults
+	if vm.Spec.Instance.CPU.DedicatedCPUPlacement || vm.Spec.Instance.Realtime != nil || memory.Hugepages != nil || memory.Balloon != nil || memory.Swap != nil {
+		return
+	}
+	request := memory.Size.DeepCopy()
+	request.Add(*overhead)
+	if vm.Spec.Resources.Requests == nil {
+		vm.Spec.Resources.Requests = corev1.ResourceList{}
+	}
+	vm.Spec.Resources.Requests[corev1.ResourceMemory] = request
+}
+
+// ApplyMemoryOvercommitDefaults sets the memory request of a VM with a balloon
+// or swap but without a memory request to its guest memory scaled down by the
+// overcommit percent, plus VMMemoryOverhead.
+func ApplyMemoryOvercommitDefaults(overcommitConfig *virtv1beta1.VirtinkConfigMemoryOvercommit, vm *virtv1alpha1.VirtualMachine) {
+	memory := &vm.Spec.Instance.Memory
+	if overcommitConfig.Percent <= 100 || (memory.Balloon == nil && memory.Swap == nil) || memory.Hugepages != nil || memory.Size.IsZero() {
+		return
+	}
+	// dedicated resources are never overcommitted
+	if vm.Spec.Instance.CPU.DedicatedCPUPlacement || vm.Spec.Instance.Realtime != nil || !vm.Spec.Resources.Requests.Memory().IsZero() {
+		return
+	}
+
+	request := resource.NewQuantity(memory.Size.Value()*100/int64(overcommitConfig.Percent), resource.BinarySI)
+	request.Add(resource.MustParse(VMMemoryOverhead))
+	if vm.Spec.Resources.Requests == nil {
+		vm.Spec.Resources.Requests = corev1.ResourceList{}
+	}
+	vm.Spec.Resources.Requests[corev1.ResourceMemory] = *request
+}
+
+// SetVMDefaults sets the built-in defaults of the VM. oldVM is nil when the VM
+// is being created. Defaults are only set for unset fields, so setting them
+// again doesn't change the VM.
+func SetVMDefaults(vm *virtv1alpha1.VirtualMachine, oldVM *virtv1alpha1.VirtualMachine) error {
+	if vm.Spec.RunPolicy == "" {
+		vm.Spec.RunPolicy = virtv1alpha1.RunPolicyOnce
+	}
+	if vm.Spec.UpdateStrategy == "" {
+		vm.Spec.UpdateStrategy = virtv1alpha1.UpdateStrategyLiveUpdateIfPossible
+	}
+
+	if vm.Spec.Instance.CPU.Sockets == 0 {
+		vm.Spec.Instance.CPU.Sockets = 1
+	}
+	if vm.Spec.Instance.CPU.CoresPerSocket == 0 {
+		vm.Spec.Instance.CPU.CoresPerSocket = 1
+	}
+
+	if vm.Spec.Instance.Memory.Size.IsZero() {
+		if !vm.Spec.Resources.Requests.Memory().IsZero() {
+			vm.Spec.Instance.Memory.Size = vm.Spec.Resources.Requests.Memory().DeepCopy()
+		} else {
+			vm.Spec.Instance.Memory.Size = resource.MustParse("1Gi")
+		}
+	}
+
+	if vm.Spec.Instance.Realtime != nil {
+		vm.Spec.Instance.CPU.DedicatedCPUPlacement = true
+		if vm.Spec.Instance.Realtime.Priority == 0 {
+			vm.Spec.Instance.Realtime.Priority = 1
+		}
+	}
+
+	if vm.Spec.Instance.CPU.DedicatedCPUPlacement {
+		memSize := resource.MustParse(VMMemoryOverhead)
+		if !vm.Spec.Instance.Memory.Size.IsZero() {
+			if vm.Spec.Instance.Memory.Hugepages == nil {
+				memSize.Add(vm.Spec.Instance.Memory.Size)
+			}
+		}
+		rsList := map[corev1.ResourceName]resource.Quantity{
+			corev1.ResourceCPU:    *resource.NewQuantity(int64(vm.Spec.Instance.CPU.CoresPerSocket*vm.Spec.Instance.CPU.Sockets), resource.DecimalSI),
+			corev1.ResourceMemory: memSize,
+		}
+
+		if vm.Spec.Resources.Requests == nil {
+			vm.Spec.Resources.Requests = rsList
+		} else {
+			if vm.Spec.Resources.Requests.Cpu().IsZero() {
+				vm.Spec.Resources.Requests[corev1.ResourceCPU] = rsList[corev1.ResourceCPU]
+			}
+			if vm.Spec.Resources.Requests.Memory().IsZero() {
+				vm.Spec.Resources.Requests[corev1.ResourceMemory] = rsList[corev1.ResourceMemory]
+			}
+		}
+
+		if vm.Spec.Resources.Limits == nil {
+			vm.Spec.Resources.Limits = rsList
+		} else {
+			if vm.Spec.Resources.Limits.Cpu().IsZero() {
+				vm.Spec.Resources.Limits[corev1.ResourceCPU] = rsList[corev1.ResourceCPU]
+			}
+			if vm.Spec.Resources.Limits.Memory().IsZero() {
+				vm.Spec.Resources.Limits[corev1.ResourceMemory] = rsList[corev1.ResourceMemory]
+			}
+		}
+	}
+
+	if vm.Spec.Instance.Memory.Hugepages != nil {
+		hugepagesSize := fmt.Sprintf("hugepages-%s", vm.Spec.Instance.Memory.Hugepages.PageSize)
+
+		if vm.Spec.Resources.Limits == nil {
+			vm.Spec.Resources.Limits = corev1.ResourceList{}
+		}
+		hugepagesLimit, exist := vm.Spec.Resources.Limits[corev1.ResourceName(hugepagesSize)]
+		if !exist {
+			hugepagesLimit = vm.Spec.Instance.Memory.Size.DeepCopy()
+			vm.Spec.Resources.Limits[corev1.ResourceName(hugepagesSize)] = hugepagesLimit
+		}
+		if vm.Spec.Resources.Requests == nil {
+			vm.Spec.Resources.Requests = corev1.ResourceList{}
+		}
+		if _, exist := vm.Spec.Resources.Requests[corev1.ResourceName(hugepagesSize)]; !exist {
+			vm.Spec.Resources.Requests[corev1.ResourceName(hugepagesSize)] = hugepagesLimit.DeepCopy()
+		}
+
+		if vm.Spec.Resources.Limits.Cpu().IsZero() && vm.Spec.Resources.Limits.Memory().IsZero() && vm.Spec.Resources.Requests.Cpu().IsZero() && vm.Spec.Resources.Requests.Memory().IsZero() {
+			vm.Spec.Resources.Requests[corev1.ResourceMemory] = resource.MustParse(VMMemoryOverhead)
+		}
+	}
+
+	if vm.Spec.Instance.Vsock != nil && vm.Spec.Instance.Vsock.CID == 0 {
+		vm.Spec.Instance.Vsock.CID = 3
+	}
+
+	if vm.Spec.Instance.RNG != nil && !vm.Spec.Instance.RNG.Disabled && vm.Spec.Instance.RNG.Source == "" {
+		vm.Spec.Instance.RNG.Source = "/dev/urandom"
+	}
+
+	for i := range vm.Spec.Volumes {
+		volume := &vm.Spec.Volumes[i]
+		if volume.ConfigMap != nil {
+			setConfigDiskDefaults(&volume.ConfigMap.Format, &volume.ConfigMap.VolumeLabel, volume.Name)
+		}
+		if volume.Secret != nil {
+			setConfigDiskDefaults(&volume.Secret.Format, &volume.Secret.VolumeLabel, volume.Name)
+		}
+	}
+
+	for i := range vm.Spec.Instance.Interfaces {
+		if vm.Spec.Instance.Interfaces[i].MAC == "" {
+			mac, err := generateMAC(vm, vm.Spec.Instance.Interfaces[i].Name)
+			if err != nil {
+				return fmt.Errorf("generate MAC: %s", err)
+			}
+			vm.Spec.Instance.Interfaces[i].MAC = mac.String()
+		}
+
+		if vm.Spec.Instance.Interfaces[i].Bridge == nil && vm.Spec.Instance.Interfaces[i].Masquerade == nil && vm.Spec.Instance.Interfaces[i].SRIOV == nil && vm.Spec.Instance.Interfaces[i].VhostUser == nil && vm.Spec.Instance.Interfaces[i].OVS == nil {
+			vm.Spec.Instance.Interfaces[i].InterfaceBindingMethod = virtv1alpha1.InterfaceBindingMethod{
+				Bridge: &virtv1alpha1.InterfaceBridge{},
+			}
+		}
+
+		if vm.Spec.Instance.Interfaces[i].Masquerade != nil {
+			if vm.Spec.Instance.Interfaces[i].Masquerade.CIDR == "" {
+				vm.Spec.Instance.Interfaces[i].Masquerade.CIDR = "10.0.2.0/30"
+			}
+		}
+	}
+
+	for i := range vm.Spec.Instance.Bonds {
+		bond := &vm.Spec.Instance.Bonds[i]
+		if bond.Mode == "" {
+			bond.Mode = virtv1alpha1.BondModeActiveBackup
+		}
+		if bond.MIIMonitorInterval == 0 {
+			bond.MIIMonitorInterval = 100
+		}
+	}
+
+	// Queues are only defaulted on creation, and for disks added later, so
+	// that updates don't change interfaces and disks of VMs created before
+	// the defaults.
+	numVCPUs := vm.Spec.Instance.CPU.Sockets * vm.Spec.Instance.CPU.CoresPerSocket
+	if oldVM == nil {
+		for i := range vm.Spec.Instance.Interfaces {
+			if vm.Spec.Instance.Interfaces[i].Queues == 0 && (vm.Spec.Instance.Interfaces[i].Bridge != nil || vm.Spec.Instance.Interfaces[i].Masquerade != nil || vm.Spec.Instance.Interfaces[i].OVS != nil) {
+				vm.Spec.Instance.Interfaces[i].Queues = numVCPUs
+			}
+		}
+	}
+	oldDiskNames := map[string]bool{}
+	if oldVM != nil {
+		for _, disk := range oldVM.Spec.Instance.Disks {
+			oldDiskNames[disk.Name] = true
+		}
+	}
+	for i := range vm.Spec.Instance.Disks {
+		if !oldDiskNames[vm.Spec.Instance.Disks[i].Name] {
+			if vm.Spec.Instance.Disks[i].Queues == 0 {
+				vm.Spec.Instance.Disks[i].Queues = numVCPUs
+			}
+			if vm.Spec.Instance.Disks[i].Cache == "" {
+				vm.Spec.Instance.Disks[i].Cache = defaultDiskCache(vm, vm.Spec.Instance.Disks[i].Name)
+			}
+			if vm.Spec.Instance.Disks[i].Type == virtv1alpha1.DiskTypeCDROM && vm.Spec.Instance.Disks[i].Bus == "" {
+				vm.Spec.Instance.Disks[i].Bus = virtv1alpha1.DiskBusSATA
+			}
+		}
+	}
+	return nil
+}
+
+// setConfigDiskDefaults sets the format of the disk image of a config map or
+// secret volume, and its label to the name of the volume, truncated to the
+// longest label of the format.
+func setConfigDiskDefaults(format *virtv1alpha1.ConfigDiskFormat, volumeLabel *string, volumeName string) {
+	if *format == "" {
+		*format = virtv1alpha1.ConfigDiskFormatISO
+	}
+	if *volumeLabel == "" {
+		*volumeLabel = volumeName
+		if maxLen := ConfigDiskVolumeLabelMaxLen(*format); len(*volumeLabel) > maxLen {
+			*volumeLabel = (*volumeLabel)[:maxLen]
+		}
+	}
+}
+
+// ConfigDiskVolumeLabelMaxLen returns the longest label of file systems of
+// the format.
+func ConfigDiskVolumeLabelMaxLen(format virtv1alpha1.ConfigDiskFormat) int {
+	if format == virtv1alpha1.ConfigDiskFormatFAT {
+		return 11
+	}
+	return 32
+}
+
+// defaultDiskCache bypasses the page cache of the host unless the disk is a
+// cloud-init, sysprep, config map, secret or service account token volume,
+// which is small and read by the guest once, or the VMM doesn't support
+// O_DIRECT. Shareable disks always bypass it, as other VMs write to them.
+func defaultDiskCache(vm *virtv1alpha1.VirtualMachine, diskName string) virtv1alpha1.DiskCache {
+	for _, disk := range vm.Spec.Instance.Disks {
+		if disk.Name == diskName && disk.Shareable {
+			return virtv1alpha1.DiskCacheNone
+		}
+	}
+	if vm.Spec.Instance.Hypervisor == virtv1alpha1.HypervisorFirecracker {
+		return virtv1alpha1.DiskCacheWriteback
+	}
+	for _, volume := range vm.Spec.Volumes {
+		if volume.Name == diskName && (volume.CloudInit != nil || volume.ClusterAPIBootstrap != nil || volume.Sysprep != nil || volume.ConfigMap != nil || volume.Secret != nil || volume.ServiceAccountToken != nil) {
+			return virtv1alpha1.DiskCacheWriteback
+		}
+	}
+	return virtv1alpha1.DiskCacheNone
+}
+
+// generateMAC derives the MAC of the interface from the names of the VM and
+// the interface, so that setting defaults of the same VM, such as in dry-run
+// requests, gives the same MAC. VMs without names, which are generated by the
+// API server after the defaults are set, get random MACs.
+func generateMAC(vm *virtv1alpha1.VirtualMachine, ifaceName string) (net.HardwareAddr, error) {
+	prefix := []byte{0x52, 0x54, 0x00}
+	suffix := make([]byte, 3)
+	if vm.Name != "" {
+		sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s", vm.Namespace, vm.Name, ifaceName)))
+		copy(suffix, sum[:])
+	} else if _, err := rand.Read(suffix); err != nil {
+		return nil, fmt.Errorf("rand: %s", err)
+	}
+	return net.HardwareAddr(append(prefix, suffix...)), nil
+}
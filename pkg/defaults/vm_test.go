@@ -0,0 +1,465 @@
+package defaults
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+func TestSetVMDefaults(t *testing.T) {
+	oldVM := &virtv1alpha1.VirtualMachine{
+		Spec: virtv1alpha1.VirtualMachineSpec{
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+			},
+			Instance: virtv1alpha1.Instance{
+				Interfaces: []virtv1alpha1.Interface{{
+					Name: "pod",
+				}},
+			},
+		},
+	}
+
+	tests := []struct {
+		vm     *virtv1alpha1.VirtualMachine
+		assert func(vm *virtv1alpha1.VirtualMachine)
+	}{{
+		vm: func() *virtv1alpha1.VirtualMachine {
+			return oldVM.DeepCopy()
+		}(),
+		assert: func(vm *virtv1alpha1.VirtualMachine) {
+			assert.Equal(t, uint32(1), vm.Spec.Instance.CPU.Sockets)
+		},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			return oldVM.DeepCopy()
+		}(),
+		assert: func(vm *virtv1alpha1.VirtualMachine) {
+			assert.Equal(t, uint32(1), vm.Spec.Instance.CPU.CoresPerSocket)
+		},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			return oldVM.DeepCopy()
+		}(),
+		assert: func(vm *virtv1alpha1.VirtualMachine) {
+			assert.Equal(t, "1Gi", vm.Spec.Instance.Memory.Size.String())
+		},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := oldVM.DeepCopy()
+			vm.Spec.Resources.Requests = nil
+			return vm
+		}(),
+		assert: func(vm *virtv1alpha1.VirtualMachine) {
+			assert.Equal(t, "1Gi", vm.Spec.Instance.Memory.Size.String())
+		},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := oldVM.DeepCopy()
+			vm.Spec.Instance.CPU.DedicatedCPUPlacement = true
+			vm.Spec.Resources.Requests = nil
+			vm.Spec.Instance.Memory.Size = resource.MustParse("1Gi")
+			return vm
+		}(),
+		assert: func(vm *virtv1alpha1.VirtualMachine) {
+			assert.Equal(t, "1", vm.Spec.Resources.Requests.Cpu().String())
+			assert.Equal(t, "1", vm.Spec.Resources.Limits.Cpu().String())
+			assert.Equal(t, "1280Mi", vm.Spec.Resources.Requests.Memory().String())
+			assert.Equal(t, "1280Mi", vm.Spec.Resources.Limits.Memory().String())
+		},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := oldVM.DeepCopy()
+			vm.Spec.Resources.Requests = nil
+			vm.Spec.Instance.Memory.Hugepages = &virtv1alpha1.Hugepages{
+				PageSize: "1Gi",
+			}
+			return vm
+		}(),
+		assert: func(vm *virtv1alpha1.VirtualMachine) {
+			assert.True(t, vm.Spec.Resources.Limits["hugepages-1Gi"].Equal(resource.MustParse("1Gi")))
+			assert.True(t, vm.Spec.Resources.Requests["hugepages-1Gi"].Equal(resource.MustParse("1Gi")))
+			assert.True(t, vm.Spec.Resources.Requests[corev1.ResourceMemory].Equal(resource.MustParse(VMMemoryOverhead)))
+		},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			return oldVM.DeepCopy()
+		}(),
+		assert: func(vm *virtv1alpha1.VirtualMachine) {
+			assert.NotEmpty(t, vm.Spec.Instance.Interfaces[0].MAC)
+		},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			return oldVM.DeepCopy()
+		}(),
+		assert: func(vm *virtv1alpha1.VirtualMachine) {
+			assert.NotNil(t, vm.Spec.Instance.Interfaces[0].Bridge)
+		},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := oldVM.DeepCopy()
+			vm.Spec.Instance.Interfaces[0].InterfaceBindingMethod = virtv1alpha1.InterfaceBindingMethod{
+				Masquerade: &virtv1alpha1.InterfaceMasquerade{},
+			}
+			return vm
+		}(),
+		assert: func(vm *virtv1alpha1.VirtualMachine) {
+			assert.Equal(t, vm.Spec.Instance.Interfaces[0].Masquerade.CIDR, "10.0.2.0/30")
+		},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := oldVM.DeepCopy()
+			vm.Spec.Resources.Requests = nil
+			vm.Spec.Instance.Realtime = &virtv1alpha1.Realtime{}
+			return vm
+		}(),
+		assert: func(vm *virtv1alpha1.VirtualMachine) {
+			assert.True(t, vm.Spec.Instance.CPU.DedicatedCPUPlacement)
+			assert.Equal(t, int32(1), vm.Spec.Instance.Realtime.Priority)
+			assert.Equal(t, "1", vm.Spec.Resources.Requests.Cpu().String())
+		},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := oldVM.DeepCopy()
+			vm.Spec.Instance.CPU.Sockets = 2
+			vm.Spec.Instance.CPU.CoresPerSocket = 2
+			vm.Spec.Instance.Disks = []virtv1alpha1.Disk{{
+				Name: "ubuntu",
+			}}
+			return vm
+		}(),
+		assert: func(vm *virtv1alpha1.VirtualMachine) {
+			assert.Equal(t, uint32(4), vm.Spec.Instance.Interfaces[0].Queues)
+			assert.Equal(t, uint32(4), vm.Spec.Instance.Disks[0].Queues)
+		},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := oldVM.DeepCopy()
+			vm.Spec.Instance.Vsock = &virtv1alpha1.Vsock{}
+			return vm
+		}(),
+		assert: func(vm *virtv1alpha1.VirtualMachine) {
+			assert.Equal(t, uint32(3), vm.Spec.Instance.Vsock.CID)
+		},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := oldVM.DeepCopy()
+			vm.Spec.Instance.RNG = &virtv1alpha1.RNG{}
+			return vm
+		}(),
+		assert: func(vm *virtv1alpha1.VirtualMachine) {
+			assert.Equal(t, "/dev/urandom", vm.Spec.Instance.RNG.Source)
+		},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := oldVM.DeepCopy()
+			vm.Spec.Instance.Disks = []virtv1alpha1.Disk{{
+				Name: "ubuntu",
+			}, {
+				Name: "cloud-init",
+			}, {
+				Name:  "data",
+				Cache: virtv1alpha1.DiskCacheWritethrough,
+			}}
+			vm.Spec.Volumes = []virtv1alpha1.Volume{{
+				Name: "ubuntu",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					ContainerDisk: &virtv1alpha1.ContainerDiskVolumeSource{},
+				},
+			}, {
+				Name: "cloud-init",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					CloudInit: &virtv1alpha1.CloudInitVolumeSource{},
+				},
+			}}
+			return vm
+		}(),
+		assert: func(vm *virtv1alpha1.VirtualMachine) {
+			assert.Equal(t, virtv1alpha1.DiskCacheNone, vm.Spec.Instance.Disks[0].Cache)
+			assert.Equal(t, virtv1alpha1.DiskCacheWriteback, vm.Spec.Instance.Disks[1].Cache)
+			assert.Equal(t, virtv1alpha1.DiskCacheWritethrough, vm.Spec.Instance.Disks[2].Cache)
+		},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := oldVM.DeepCopy()
+			vm.Spec.Instance.Disks = []virtv1alpha1.Disk{{
+				Name: "app-config",
+			}, {
+				Name: "app-certificates",
+			}}
+			vm.Spec.Volumes = []virtv1alpha1.Volume{{
+				Name: "app-config",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					ConfigMap: &virtv1alpha1.ConfigMapVolumeSource{Name: "app"},
+				},
+			}, {
+				Name: "app-certificates",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					Secret: &virtv1alpha1.SecretVolumeSource{SecretName: "app-tls", Format: virtv1alpha1.ConfigDiskFormatFAT},
+				},
+			}}
+			return vm
+		}(),
+		assert: func(vm *virtv1alpha1.VirtualMachine) {
+			assert.Equal(t, virtv1alpha1.ConfigDiskFormatISO, vm.Spec.Volumes[0].ConfigMap.Format)
+			assert.Equal(t, "app-config", vm.Spec.Volumes[0].ConfigMap.VolumeLabel)
+			assert.Equal(t, "app-certifi", vm.Spec.Volumes[1].Secret.VolumeLabel)
+			assert.Equal(t, virtv1alpha1.DiskCacheWriteback, vm.Spec.Instance.Disks[0].Cache)
+		},
+	}, {
+		vm: func() *virtv1alpha1.VirtualMachine {
+			vm := oldVM.DeepCopy()
+			vm.Spec.Instance.Bonds = []virtv1alpha1.Bond{{
+				Name:       "bond0",
+				Interfaces: []string{"data-1", "data-2"},
+			}}
+			return vm
+		}(),
+		assert: func(vm *virtv1alpha1.VirtualMachine) {
+			assert.Equal(t, virtv1alpha1.BondModeActiveBackup, vm.Spec.Instance.Bonds[0].Mode)
+			assert.Equal(t, uint32(100), vm.Spec.Instance.Bonds[0].MIIMonitorInterval)
+		},
+	}}
+	for _, tc := range tests {
+		err := SetVMDefaults(tc.vm, nil)
+		assert.Nil(t, err)
+		tc.assert(tc.vm)
+	}
+}
+
+func TestApplyNetworkDefaults(t *testing.T) {
+	vm := &virtv1alpha1.VirtualMachine{
+		Spec: virtv1alpha1.VirtualMachineSpec{
+			Instance: virtv1alpha1.Instance{
+				Interfaces: []virtv1alpha1.Interface{{
+					Name: "pod",
+				}, {
+					Name: "bridge",
+					InterfaceBindingMethod: virtv1alpha1.InterfaceBindingMethod{
+						Bridge: &virtv1alpha1.InterfaceBridge{},
+					},
+				}},
+			},
+		},
+	}
+
+	ApplyNetworkDefaults(&virtv1beta1.VirtinkConfigNetwork{
+		DefaultInterfaceBinding: "masquerade",
+		DefaultMasqueradeCIDR:   "10.0.3.0/30",
+	}, vm)
+	assert.NotNil(t, vm.Spec.Instance.Interfaces[0].Masquerade)
+	assert.Equal(t, "10.0.3.0/30", vm.Spec.Instance.Interfaces[0].Masquerade.CIDR)
+	assert.Nil(t, vm.Spec.Instance.Interfaces[1].Masquerade)
+}
+
+func TestApplyMemoryOvercommitDefaults(t *testing.T) {
+	vm := &virtv1alpha1.VirtualMachine{
+		Spec: virtv1alpha1.VirtualMachineSpec{
+			Instance: virtv1alpha1.Instance{
+				Memory: virtv1alpha1.Memory{
+					Size:    resource.MustParse("3Gi"),
+					Balloon: &virtv1alpha1.MemoryBalloon{},
+				},
+			},
+		},
+	}
+
+	config := &virtv1beta1.VirtinkConfigMemoryOvercommit{}
+	ApplyMemoryOvercommitDefaults(config, vm)
+	assert.True(t, vm.Spec.Resources.Requests.Memory().IsZero())
+
+	config.Percent = 150
+	ApplyMemoryOvercommitDefaults(config, vm)
+	assert.Equal(t, "2304Mi", vm.Spec.Resources.Requests.Memory().String())
+
+	vm.Spec.Resources.Requests = nil
+	vm.Spec.Instance.Memory.Balloon = nil
+	ApplyMemoryOvercommitDefaults(config, vm)
+	assert.True(t, vm.Spec.Resources.Requests.Memory().IsZero())
+}
+
+func TestApplyNamespaceDefaults(t *testing.T) {
+	vm := &virtv1alpha1.VirtualMachine{
+		Spec: virtv1alpha1.VirtualMachineSpec{
+			Instance: virtv1alpha1.Instance{
+				Memory: virtv1alpha1.Memory{
+					Size: resource.MustParse("1Gi"),
+				},
+				Interfaces: []virtv1alpha1.Interface{{
+					Name: "pod",
+				}, {
+					Name: "masquerade",
+					InterfaceBindingMethod: virtv1alpha1.InterfaceBindingMethod{
+						Masquerade: &virtv1alpha1.InterfaceMasquerade{},
+					},
+				}},
+			},
+			Volumes: []virtv1alpha1.Volume{{
+				Name: "cloud-init",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					CloudInit: &virtv1alpha1.CloudInitVolumeSource{},
+				},
+			}, {
+				Name: "custom-cloud-init",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					CloudInit: &virtv1alpha1.CloudInitVolumeSource{
+						UserDataSecretName: "ubuntu-user-data",
+					},
+				},
+			}},
+		},
+	}
+
+	memoryOverhead := resource.MustParse("128Mi")
+	ApplyNamespaceDefaults(&virtv1beta1.VirtinkNamespaceConfigSpec{
+		CloudInit: virtv1beta1.VirtinkNamespaceConfigCloudInit{
+			DefaultUserData: "#cloud-config",
+		},
+		Network: virtv1beta1.VirtinkConfigNetwork{
+			DefaultInterfaceBinding: "bridge",
+			DefaultMasqueradeCIDR:   "10.0.3.0/30",
+		},
+		Resources: virtv1beta1.VirtinkNamespaceConfigResources{
+			MemoryOverhead: &memoryOverhead,
+		},
+	}, vm)
+	assert.NotNil(t, vm.Spec.Instance.Interfaces[0].Bridge)
+	assert.Equal(t, "10.0.3.0/30", vm.Spec.Instance.Interfaces[1].Masquerade.CIDR)
+	assert.Equal(t, "#cloud-config", vm.Spec.Volumes[0].CloudInit.UserData)
+	assert.Empty(t, vm.Spec.Volumes[1].CloudInit.UserData)
+	assert.Equal(t, "1152Mi", vm.Spec.Resources.Requests.Memory().String())
+
+	// the VirtinkConfig only applies to what is left unset
+	ApplyNetworkDefaults(&virtv1beta1.VirtinkConfigNetwork{
+		DefaultInterfaceBinding: "masquerade",
+	}, vm)
+	assert.Nil(t, vm.Spec.Instance.Interfaces[0].Masquerade)
+
+	vm.Spec.Resources.Requests = nil
+	vm.Spec.Instance.Memory.Balloon = &virtv1alpha1.MemoryBalloon{}
+	ApplyNamespaceDefaults(&virtv1beta1.VirtinkNamespaceConfigSpec{
+		Resources: virtv1beta1.VirtinkNamespaceConfigResources{
+			MemoryOverhead: &memoryOverhead,
+		},
+	}, vm)
+	assert.True(t, vm.Spec.Resources.Requests.Memory().IsZero())
+}
+
+func TestSetVMDefaultsIdempotent(t *testing.T) {
+	vm := &virtv1alpha1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "ubuntu",
+		},
+		Spec: virtv1alpha1.VirtualMachineSpec{
+			Instance: virtv1alpha1.Instance{
+				CPU: virtv1alpha1.CPU{
+					DedicatedCPUPlacement: true,
+				},
+				Memory: virtv1alpha1.Memory{
+					Hugepages: &virtv1alpha1.Hugepages{
+						PageSize: "2Mi",
+					},
+				},
+				Disks: []virtv1alpha1.Disk{{
+					Name: "ubuntu",
+				}},
+				Interfaces: []virtv1alpha1.Interface{{
+					Name: "pod",
+				}},
+			},
+		},
+	}
+
+	defaultedVM := vm.DeepCopy()
+	assert.Nil(t, SetVMDefaults(defaultedVM, nil))
+	redefaultedVM := defaultedVM.DeepCopy()
+	assert.Nil(t, SetVMDefaults(redefaultedVM, nil))
+	assert.Equal(t, defaultedVM, redefaultedVM)
+	assert.Nil(t, SetVMDefaults(redefaultedVM, defaultedVM))
+	assert.Equal(t, defaultedVM, redefaultedVM)
+
+	// MACs are derived from the names, so that dry-run requests give the same MACs
+	anotherDefaultedVM := vm.DeepCopy()
+	assert.Nil(t, SetVMDefaults(anotherDefaultedVM, nil))
+	assert.Equal(t, defaultedVM, anotherDefaultedVM)
+
+	renamedVM := vm.DeepCopy()
+	renamedVM.Name = "centos"
+	assert.Nil(t, SetVMDefaults(renamedVM, nil))
+	assert.NotEqual(t, defaultedVM.Spec.Instance.Interfaces[0].MAC, renamedVM.Spec.Instance.Interfaces[0].MAC)
+}
+
+func TestRenderVM(t *testing.T) {
+	var scheme = runtime.NewScheme()
+	utilruntime.Must(virtv1beta1.AddToScheme(scheme))
+
+	vm := &virtv1alpha1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "ubuntu",
+		},
+		Spec: virtv1alpha1.VirtualMachineSpec{
+			Instance: virtv1alpha1.Instance{
+				Interfaces: []virtv1alpha1.Interface{{
+					Name: "pod",
+				}},
+			},
+		},
+	}
+
+	renderedVM, err := RenderVM(context.Background(), fake.NewClientBuilder().WithScheme(scheme).Build(), vm)
+	assert.Nil(t, err)
+	assert.Empty(t, vm.Spec.Instance.Interfaces[0].MAC)
+	assert.NotEmpty(t, renderedVM.Spec.Instance.Interfaces[0].MAC)
+	assert.NotNil(t, renderedVM.Spec.Instance.Interfaces[0].Bridge)
+
+	renderedVM, err = RenderVM(context.Background(), fake.NewClientBuilder().WithScheme(scheme).WithObjects(&virtv1beta1.VirtinkConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "virtink",
+		},
+		Spec: virtv1beta1.VirtinkConfigSpec{
+			Network: virtv1beta1.VirtinkConfigNetwork{
+				DefaultInterfaceBinding: "masquerade",
+			},
+		},
+	}).Build(), vm)
+	assert.Nil(t, err)
+	assert.NotNil(t, renderedVM.Spec.Instance.Interfaces[0].Masquerade)
+
+	renderedVM, err = RenderVM(context.Background(), fake.NewClientBuilder().WithScheme(scheme).WithObjects(&virtv1beta1.VirtinkConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "virtink",
+		},
+		Spec: virtv1beta1.VirtinkConfigSpec{
+			Network: virtv1beta1.VirtinkConfigNetwork{
+				DefaultInterfaceBinding: "masquerade",
+			},
+		},
+	}, &virtv1beta1.VirtinkNamespaceConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "virtink",
+		},
+		Spec: virtv1beta1.VirtinkNamespaceConfigSpec{
+			Network: virtv1beta1.VirtinkConfigNetwork{
+				DefaultInterfaceBinding: "bridge",
+			},
+		},
+	}).Build(), vm)
+	assert.Nil(t, err)
+	assert.NotNil(t, renderedVM.Spec.Instance.Interfaces[0].Bridge)
+	assert.Nil(t, renderedVM.Spec.Instance.Interfaces[0].Masquerade)
+}
@@ -0,0 +1,159 @@
+// Package disklease keeps two VM pods from writing to the same PVC at the
+// same time. Every PVC a VM writes to has a coordination.k8s.io Lease in the
+// namespace of the VM, held by the VM pod running the VM. A VM pod only
+// starts its VMM once it holds the leases of all its PVCs, and a lease is
+// only taken over from another VM pod once that VM pod hasn't renewed it for
+// the lease duration, by which time its VMM is powered off.
+package disklease
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+const (
+	// ClaimNameLabel is set on leases to the name of their PVC.
+	ClaimNameLabel = "virtink.io/disk-lease-claim"
+	// AcquiredFileName is created by virt-daemon in the socket dir of a VM
+	// pod once the VM pod holds the leases of its PVCs. virt-prerunner waits
+	// for it before starting the VMM.
+	AcquiredFileName = "disk-leases-acquired"
+
+	DefaultDuration = 60 * time.Second
+)
+
+// HeldError is returned when a lease is held by another VM pod.
+type HeldError struct {
+	ClaimName string
+	Holder    string
+}
+
+func (e *HeldError) Error() string {
+	return fmt.Sprintf("lease of PVC %q is held by VM pod %s", e.ClaimName, e.Holder)
+}
+
+// LeaseName returns the name of the lease of the PVC.
+func LeaseName(claimName string) string {
+	return "virtink-disk-" + claimName
+}
+
+// GetClaimNames returns the names of the PVCs the VM writes to. PVCs of
+// read-only, CD-ROM and shareable disks are not leased.
+func GetClaimNames(vm *virtv1alpha1.VirtualMachine) []string {
+	var claimNames []string
+	for _, disk := range vm.Spec.Instance.Disks {
+		if (disk.ReadOnly != nil && *disk.ReadOnly) || disk.Type == virtv1alpha1.DiskTypeCDROM || disk.Shareable {
+			continue
+		}
+		for _, volume := range vm.Spec.Volumes {
+			if volume.Name != disk.Name {
+				continue
+			}
+			switch {
+			case volume.PersistentVolumeClaim != nil:
+				claimNames = append(claimNames, volume.PersistentVolumeClaim.ClaimName)
+			case volume.DataVolume != nil:
+				claimNames = append(claimNames, volume.DataVolume.VolumeName)
+			}
+		}
+	}
+	return claimNames
+}
+
+// Acquire acquires or renews the lease of the PVC for the VM pod. A lease
+// held by another VM pod is only taken over once it has expired, or if
+// takeOver is set, e.g. by the target VM pod of a completed migration. The
+// client should read from the API server rather than a cache, so that a
+// lease is never taken over based on a stale renew time.
+func Acquire(ctx context.Context, c client.Client, namespace string, claimName string, holder types.UID, duration time.Duration, takeOver bool) error {
+	now := metav1.NewMicroTime(time.Now())
+	durationSeconds := int32(duration / time.Second)
+	holderIdentity := string(holder)
+
+	var lease coordinationv1.Lease
+	leaseKey := types.NamespacedName{Namespace: namespace, Name: LeaseName(claimName)}
+	if err := c.Get(ctx, leaseKey, &lease); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("get lease: %s", err)
+		}
+
+		lease = coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: leaseKey.Namespace,
+				Name:      leaseKey.Name,
+				Labels: map[string]string{
+					ClaimNameLabel: claimName,
+				},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &holderIdentity,
+				LeaseDurationSeconds: &durationSeconds,
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}
+		if err := c.Create(ctx, &lease); err != nil {
+			return fmt.Errorf("create lease: %s", err)
+		}
+		return nil
+	}
+
+	if !isHeldBy(&lease, holderIdentity) {
+		if isHeld(&lease) && !isExpired(&lease, now.Time) && !takeOver {
+			return &HeldError{ClaimName: claimName, Holder: *lease.Spec.HolderIdentity}
+		}
+		lease.Spec.HolderIdentity = &holderIdentity
+		lease.Spec.AcquireTime = &now
+	}
+	lease.Spec.LeaseDurationSeconds = &durationSeconds
+	lease.Spec.RenewTime = &now
+	if err := c.Update(ctx, &lease); err != nil {
+		return fmt.Errorf("update lease: %s", err)
+	}
+	return nil
+}
+
+// Release releases the lease of the PVC if it's held by the VM pod, so that
+// other VM pods don't wait for it to expire.
+func Release(ctx context.Context, c client.Client, namespace string, claimName string, holder types.UID) error {
+	var lease coordinationv1.Lease
+	leaseKey := types.NamespacedName{Namespace: namespace, Name: LeaseName(claimName)}
+	if err := c.Get(ctx, leaseKey, &lease); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !isHeldBy(&lease, string(holder)) {
+		return nil
+	}
+
+	lease.Spec.HolderIdentity = nil
+	lease.Spec.AcquireTime = nil
+	lease.Spec.RenewTime = nil
+	if err := c.Update(ctx, &lease); err != nil {
+		return fmt.Errorf("update lease: %s", err)
+	}
+	return nil
+}
+
+func isHeld(lease *coordinationv1.Lease) bool {
+	return lease.Spec.HolderIdentity != nil && *lease.Spec.HolderIdentity != ""
+}
+
+func isHeldBy(lease *coordinationv1.Lease, holderIdentity string) bool {
+	return lease.Spec.HolderIdentity != nil && *lease.Spec.HolderIdentity == holderIdentity
+}
+
+func isExpired(lease *coordinationv1.Lease, now time.Time) bool {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	return now.After(lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second))
+}
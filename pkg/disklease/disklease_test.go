@@ -0,0 +1,107 @@
+package disklease
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+func TestAcquire(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).Build()
+	getHolder := func() string {
+		var lease coordinationv1.Lease
+		require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "default", Name: LeaseName("disk")}, &lease))
+		if lease.Spec.HolderIdentity == nil {
+			return ""
+		}
+		return *lease.Spec.HolderIdentity
+	}
+
+	require.NoError(t, Acquire(ctx, c, "default", "disk", "pod-1", time.Minute, false))
+	assert.Equal(t, "pod-1", getHolder())
+	require.NoError(t, Acquire(ctx, c, "default", "disk", "pod-1", time.Minute, false))
+
+	err := Acquire(ctx, c, "default", "disk", "pod-2", time.Minute, false)
+	var heldErr *HeldError
+	require.True(t, errors.As(err, &heldErr))
+	assert.Equal(t, "pod-1", heldErr.Holder)
+
+	// the target VM pod of a completed migration takes over
+	require.NoError(t, Acquire(ctx, c, "default", "disk", "pod-2", time.Minute, true))
+	assert.Equal(t, "pod-2", getHolder())
+
+	// a lease not renewed for its duration expires
+	var lease coordinationv1.Lease
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "default", Name: LeaseName("disk")}, &lease))
+	lease.Spec.RenewTime = &metav1.MicroTime{Time: time.Now().Add(-2 * time.Minute)}
+	require.NoError(t, c.Update(ctx, &lease))
+	require.NoError(t, Acquire(ctx, c, "default", "disk", "pod-3", time.Minute, false))
+	assert.Equal(t, "pod-3", getHolder())
+
+	// only the holder releases a lease
+	require.NoError(t, Release(ctx, c, "default", "disk", "pod-2"))
+	assert.Equal(t, "pod-3", getHolder())
+	require.NoError(t, Release(ctx, c, "default", "disk", "pod-3"))
+	assert.Equal(t, "", getHolder())
+	require.NoError(t, Acquire(ctx, c, "default", "disk", types.UID("pod-4"), time.Minute, false))
+	assert.Equal(t, "pod-4", getHolder())
+
+	require.NoError(t, Release(ctx, c, "default", "missing", "pod-4"))
+}
+
+func TestGetClaimNames(t *testing.T) {
+	readOnly := true
+	vm := &virtv1alpha1.VirtualMachine{
+		Spec: virtv1alpha1.VirtualMachineSpec{
+			Instance: virtv1alpha1.Instance{
+				Disks: []virtv1alpha1.Disk{
+					{Name: "root"},
+					{Name: "data"},
+					{Name: "read-only", ReadOnly: &readOnly},
+					{Name: "shared", Shareable: true},
+					{Name: "cloud-init"},
+				},
+			},
+			Volumes: []virtv1alpha1.Volume{{
+				Name: "root",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					DataVolume: &virtv1alpha1.DataVolumeVolumeSource{VolumeName: "root-dv"},
+				},
+			}, {
+				Name: "data",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					PersistentVolumeClaim: &virtv1alpha1.PersistentVolumeClaimVolumeSource{ClaimName: "data-pvc"},
+				},
+			}, {
+				Name: "read-only",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					PersistentVolumeClaim: &virtv1alpha1.PersistentVolumeClaimVolumeSource{ClaimName: "read-only-pvc"},
+				},
+			}, {
+				Name: "shared",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					PersistentVolumeClaim: &virtv1alpha1.PersistentVolumeClaimVolumeSource{ClaimName: "shared-pvc"},
+				},
+			}, {
+				Name: "cloud-init",
+				VolumeSource: virtv1alpha1.VolumeSource{
+					CloudInit: &virtv1alpha1.CloudInitVolumeSource{},
+				},
+			}},
+		},
+	}
+	assert.Equal(t, []string{"root-dv", "data-pvc"}, GetClaimNames(vm))
+}
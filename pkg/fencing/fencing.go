@@ -0,0 +1,110 @@
+// Package fencing powers off failed nodes before their VMs are restarted on
+// other nodes, so that a node which is only cut off from the API server can't
+// keep running the VMs and writing to their shared disks. Fencers are
+// pluggable: the webhook fencer delegates to an endpoint that knows how to
+// power off the nodes, e.g. through IPMI or the API of the cloud provider.
+package fencing
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// FencedAnnotation is set to "true" on a node once it is fenced, by the
+// webhook fencer, or by an administrator or an external agent in the Manual
+// mode. It is removed once the node is ready again.
+const FencedAnnotation = "virtink.io/fenced"
+
+// DefaultWebhookTimeout is how long to wait for a fencing webhook to respond
+// if not configured.
+const DefaultWebhookTimeout = 60 * time.Second
+
+// A Fencer powers off a node. Fence must only return nil once the node is
+// known to be powered off.
+type Fencer interface {
+	Fence(ctx context.Context, node *corev1.Node) error
+}
+
+// Request is posted to fencing webhooks as JSON, with what is known about the
+// node to find its BMC or cloud instance.
+type Request struct {
+	NodeName   string               `json:"nodeName"`
+	ProviderID string               `json:"providerID,omitempty"`
+	Addresses  []corev1.NodeAddress `json:"addresses,omitempty"`
+	Labels     map[string]string    `json:"labels,omitempty"`
+}
+
+// WebhookFencer fences nodes by posting a Request to a webhook, which
+// responds with a 2xx status once the node is powered off.
+type WebhookFencer struct {
+	URL        string
+	HTTPClient *http.Client
+}
+
+var _ Fencer = &WebhookFencer{}
+
+// NewWebhookFencer returns a WebhookFencer calling the webhook of the config.
+func NewWebhookFencer(config *virtv1beta1.VirtinkConfigFencingWebhook) (*WebhookFencer, error) {
+	timeout := DefaultWebhookTimeout
+	if config.TimeoutSeconds > 0 {
+		timeout = time.Duration(config.TimeoutSeconds) * time.Second
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(config.CABundle) > 0 {
+		caCertPool := x509.NewCertPool()
+		if !caCertPool.AppendCertsFromPEM(config.CABundle) {
+			return nil, fmt.Errorf("no certificate found in CA bundle")
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs: caCertPool,
+		}
+	}
+
+	return &WebhookFencer{
+		URL: config.URL,
+		HTTPClient: &http.Client{
+			Transport: transport,
+			Timeout:   timeout,
+		},
+	}, nil
+}
+
+func (f *WebhookFencer) Fence(ctx context.Context, node *corev1.Node) error {
+	data, err := json.Marshal(&Request{
+		NodeName:   node.Name,
+		ProviderID: node.Spec.ProviderID,
+		Addresses:  node.Status.Addresses,
+		Labels:     node.Labels,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal request: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.URL, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("create request: %s", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := f.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %q: %s", resp.Status, bytes.TrimSpace(message))
+	}
+	return nil
+}
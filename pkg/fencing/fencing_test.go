@@ -0,0 +1,74 @@
+package fencing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+func TestWebhookFencer(t *testing.T) {
+	var requests []Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req Request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		requests = append(requests, req)
+		if req.NodeName != "node-1" {
+			http.Error(w, "BMC unreachable", http.StatusBadGateway)
+		}
+	}))
+	defer server.Close()
+
+	fencer, err := NewWebhookFencer(&virtv1beta1.VirtinkConfigFencingWebhook{
+		URL: server.URL,
+	})
+	require.NoError(t, err)
+
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node-1",
+			Labels: map[string]string{"rack": "a"},
+		},
+		Spec: corev1.NodeSpec{
+			ProviderID: "openstack:///4f7a",
+		},
+		Status: corev1.NodeStatus{
+			Addresses: []corev1.NodeAddress{{
+				Type:    corev1.NodeInternalIP,
+				Address: "192.168.0.11",
+			}},
+		},
+	}
+	require.NoError(t, fencer.Fence(context.Background(), node))
+	require.Len(t, requests, 1)
+	assert.Equal(t, Request{
+		NodeName:   "node-1",
+		ProviderID: "openstack:///4f7a",
+		Addresses:  node.Status.Addresses,
+		Labels:     node.Labels,
+	}, requests[0])
+
+	node.Name = "node-2"
+	err = fencer.Fence(context.Background(), node)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "BMC unreachable")
+}
+
+func TestNewWebhookFencerInvalidCABundle(t *testing.T) {
+	_, err := NewWebhookFencer(&virtv1beta1.VirtinkConfigFencingWebhook{
+		URL:      "https://fencing.example.com",
+		CABundle: []byte("not a certificate"),
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,427 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package applyconfiguration
+
+import (
+	v1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/generated/applyconfiguration/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/generated/applyconfiguration/virt/v1beta1"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ForKind returns an apply configuration type for the given GroupVersionKind, or nil if no
+// apply configuration type exists for the given GroupVersionKind.
+func ForKind(kind schema.GroupVersionKind) interface{} {
+	switch kind {
+	// Group=virt.virtink.smartx.com, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("BandwidthLimit"):
+		return &virtv1alpha1.BandwidthLimitApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Bond"):
+		return &virtv1alpha1.BondApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Clock"):
+		return &virtv1alpha1.ClockApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ClockSync"):
+		return &virtv1alpha1.ClockSyncApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CloudInitVolumeSource"):
+		return &virtv1alpha1.CloudInitVolumeSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ClusterAPIBootstrapVolumeSource"):
+		return &virtv1alpha1.ClusterAPIBootstrapVolumeSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ConfigMapVolumeSource"):
+		return &virtv1alpha1.ConfigMapVolumeSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ContainerDiskVolumeSource"):
+		return &virtv1alpha1.ContainerDiskVolumeSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ContainerRootfsVolumeSource"):
+		return &virtv1alpha1.ContainerRootfsVolumeSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CPU"):
+		return &virtv1alpha1.CPUApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DataVolumeVolumeSource"):
+		return &virtv1alpha1.DataVolumeVolumeSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Devices"):
+		return &virtv1alpha1.DevicesApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Disk"):
+		return &virtv1alpha1.DiskApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DiskDiscard"):
+		return &virtv1alpha1.DiskDiscardApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DiskEncryption"):
+		return &virtv1alpha1.DiskEncryptionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DiskRateLimit"):
+		return &virtv1alpha1.DiskRateLimitApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Downward"):
+		return &virtv1alpha1.DownwardApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("EmptyDiskVolumeClaim"):
+		return &virtv1alpha1.EmptyDiskVolumeClaimApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("EmptyDiskVolumeSource"):
+		return &virtv1alpha1.EmptyDiskVolumeSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FileSystem"):
+		return &virtv1alpha1.FileSystemApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Firmware"):
+		return &virtv1alpha1.FirmwareApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("GuestMetrics"):
+		return &virtv1alpha1.GuestMetricsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HTTPDiskVolumeSource"):
+		return &virtv1alpha1.HTTPDiskVolumeSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Hibernation"):
+		return &virtv1alpha1.HibernationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HostUSBDevice"):
+		return &virtv1alpha1.HostUSBDeviceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Hugepages"):
+		return &virtv1alpha1.HugepagesApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Instance"):
+		return &virtv1alpha1.InstanceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Interface"):
+		return &virtv1alpha1.InterfaceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("InterfaceBindingMethod"):
+		return &virtv1alpha1.InterfaceBindingMethodApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("InterfaceDHCPOptions"):
+		return &virtv1alpha1.InterfaceDHCPOptionsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("InterfaceMasquerade"):
+		return &virtv1alpha1.InterfaceMasqueradeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("InterfaceOVS"):
+		return &virtv1alpha1.InterfaceOVSApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("InterfaceOffloads"):
+		return &virtv1alpha1.InterfaceOffloadsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("InterfaceRateLimit"):
+		return &virtv1alpha1.InterfaceRateLimitApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("InterfaceRoute"):
+		return &virtv1alpha1.InterfaceRouteApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("InterfaceVLAN"):
+		return &virtv1alpha1.InterfaceVLANApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Kernel"):
+		return &virtv1alpha1.KernelApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Memory"):
+		return &virtv1alpha1.MemoryApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MemoryBalloon"):
+		return &virtv1alpha1.MemoryBalloonApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MemoryDump"):
+		return &virtv1alpha1.MemoryDumpApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MemorySwap"):
+		return &virtv1alpha1.MemorySwapApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MetadataService"):
+		return &virtv1alpha1.MetadataServiceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MultusNetworkSource"):
+		return &virtv1alpha1.MultusNetworkSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Network"):
+		return &virtv1alpha1.NetworkApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkSource"):
+		return &virtv1alpha1.NetworkSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PersistentVolumeClaimPopulateSource"):
+		return &virtv1alpha1.PersistentVolumeClaimPopulateSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PersistentVolumeClaimVolumeSource"):
+		return &virtv1alpha1.PersistentVolumeClaimVolumeSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Realtime"):
+		return &virtv1alpha1.RealtimeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RNG"):
+		return &virtv1alpha1.RNGApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Schedule"):
+		return &virtv1alpha1.ScheduleApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SSHPublicKey"):
+		return &virtv1alpha1.SSHPublicKeyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SecretVolumeSource"):
+		return &virtv1alpha1.SecretVolumeSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ServiceAccountTokenVolumeSource"):
+		return &virtv1alpha1.ServiceAccountTokenVolumeSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SysprepVolumeSource"):
+		return &virtv1alpha1.SysprepVolumeSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VLANRange"):
+		return &virtv1alpha1.VLANRangeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VirtualMachine"):
+		return &virtv1alpha1.VirtualMachineApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VirtualMachineAddress"):
+		return &virtv1alpha1.VirtualMachineAddressApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VirtualMachineExport"):
+		return &virtv1alpha1.VirtualMachineExportApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VirtualMachineExportOCITarget"):
+		return &virtv1alpha1.VirtualMachineExportOCITargetApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VirtualMachineExportSpec"):
+		return &virtv1alpha1.VirtualMachineExportSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VirtualMachineExportStatus"):
+		return &virtv1alpha1.VirtualMachineExportStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VirtualMachineExportTarget"):
+		return &virtv1alpha1.VirtualMachineExportTargetApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VirtualMachineMigration"):
+		return &virtv1alpha1.VirtualMachineMigrationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VirtualMachineMigrationSpec"):
+		return &virtv1alpha1.VirtualMachineMigrationSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VirtualMachineMigrationStatus"):
+		return &virtv1alpha1.VirtualMachineMigrationStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VirtualMachineSpec"):
+		return &virtv1alpha1.VirtualMachineSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VirtualMachineStatus"):
+		return &virtv1alpha1.VirtualMachineStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VirtualMachineStatusHibernation"):
+		return &virtv1alpha1.VirtualMachineStatusHibernationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VirtualMachineStatusMemoryDump"):
+		return &virtv1alpha1.VirtualMachineStatusMemoryDumpApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VirtualMachineStatusMigration"):
+		return &virtv1alpha1.VirtualMachineStatusMigrationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VirtualMachineStatusMigrationVolume"):
+		return &virtv1alpha1.VirtualMachineStatusMigrationVolumeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VirtualMachineStatusSchedule"):
+		return &virtv1alpha1.VirtualMachineStatusScheduleApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Volume"):
+		return &virtv1alpha1.VolumeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeSource"):
+		return &virtv1alpha1.VolumeSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Vsock"):
+		return &virtv1alpha1.VsockApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Watchdog"):
+		return &virtv1alpha1.WatchdogApplyConfiguration{}
+
+		// Group=virt.virtink.smartx.com, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithKind("BandwidthLimit"):
+		return &virtv1beta1.BandwidthLimitApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Bond"):
+		return &virtv1beta1.BondApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Clock"):
+		return &virtv1beta1.ClockApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ClockSync"):
+		return &virtv1beta1.ClockSyncApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CloudInitVolumeSource"):
+		return &virtv1beta1.CloudInitVolumeSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ClusterAPIBootstrapVolumeSource"):
+		return &virtv1beta1.ClusterAPIBootstrapVolumeSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ConfigMapVolumeSource"):
+		return &virtv1beta1.ConfigMapVolumeSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ContainerDiskVolumeSource"):
+		return &virtv1beta1.ContainerDiskVolumeSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ContainerRootfsVolumeSource"):
+		return &virtv1beta1.ContainerRootfsVolumeSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CPU"):
+		return &virtv1beta1.CPUApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DataVolumeVolumeSource"):
+		return &virtv1beta1.DataVolumeVolumeSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Devices"):
+		return &virtv1beta1.DevicesApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Disk"):
+		return &virtv1beta1.DiskApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DiskDiscard"):
+		return &virtv1beta1.DiskDiscardApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DiskEncryption"):
+		return &virtv1beta1.DiskEncryptionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DiskRateLimit"):
+		return &virtv1beta1.DiskRateLimitApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Downward"):
+		return &virtv1beta1.DownwardApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("EmptyDiskVolumeClaim"):
+		return &virtv1beta1.EmptyDiskVolumeClaimApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("EmptyDiskVolumeSource"):
+		return &virtv1beta1.EmptyDiskVolumeSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("FileSystem"):
+		return &virtv1beta1.FileSystemApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Firmware"):
+		return &virtv1beta1.FirmwareApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GuestMetrics"):
+		return &virtv1beta1.GuestMetricsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPDiskVolumeSource"):
+		return &virtv1beta1.HTTPDiskVolumeSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Hibernation"):
+		return &virtv1beta1.HibernationApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HostUSBDevice"):
+		return &virtv1beta1.HostUSBDeviceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Hugepages"):
+		return &virtv1beta1.HugepagesApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Instance"):
+		return &virtv1beta1.InstanceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Interface"):
+		return &virtv1beta1.InterfaceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("InterfaceBindingMethod"):
+		return &virtv1beta1.InterfaceBindingMethodApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("InterfaceDHCPOptions"):
+		return &virtv1beta1.InterfaceDHCPOptionsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("InterfaceMasquerade"):
+		return &virtv1beta1.InterfaceMasqueradeApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("InterfaceOVS"):
+		return &virtv1beta1.InterfaceOVSApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("InterfaceOffloads"):
+		return &virtv1beta1.InterfaceOffloadsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("InterfaceRateLimit"):
+		return &virtv1beta1.InterfaceRateLimitApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("InterfaceRoute"):
+		return &virtv1beta1.InterfaceRouteApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("InterfaceVLAN"):
+		return &virtv1beta1.InterfaceVLANApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Kernel"):
+		return &virtv1beta1.KernelApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Memory"):
+		return &virtv1beta1.MemoryApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MemoryBalloon"):
+		return &virtv1beta1.MemoryBalloonApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MemoryDump"):
+		return &virtv1beta1.MemoryDumpApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MemorySwap"):
+		return &virtv1beta1.MemorySwapApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MetadataService"):
+		return &virtv1beta1.MetadataServiceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MultusNetworkSource"):
+		return &virtv1beta1.MultusNetworkSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Network"):
+		return &virtv1beta1.NetworkApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("NetworkSource"):
+		return &virtv1beta1.NetworkSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PersistentVolumeClaimPopulateSource"):
+		return &virtv1beta1.PersistentVolumeClaimPopulateSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PersistentVolumeClaimVolumeSource"):
+		return &virtv1beta1.PersistentVolumeClaimVolumeSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Realtime"):
+		return &virtv1beta1.RealtimeApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RNG"):
+		return &virtv1beta1.RNGApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Schedule"):
+		return &virtv1beta1.ScheduleApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("SSHPublicKey"):
+		return &virtv1beta1.SSHPublicKeyApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("SecretVolumeSource"):
+		return &virtv1beta1.SecretVolumeSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("SecurityGroupPortRange"):
+		return &virtv1beta1.SecurityGroupPortRangeApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("SecurityGroupRule"):
+		return &virtv1beta1.SecurityGroupRuleApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ServiceAccountTokenVolumeSource"):
+		return &virtv1beta1.ServiceAccountTokenVolumeSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("SysprepVolumeSource"):
+		return &virtv1beta1.SysprepVolumeSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VLANRange"):
+		return &virtv1beta1.VLANRangeApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkConfig"):
+		return &virtv1beta1.VirtinkConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkConfigDiskLeases"):
+		return &virtv1beta1.VirtinkConfigDiskLeasesApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkConfigFencing"):
+		return &virtv1beta1.VirtinkConfigFencingApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkConfigFencingWebhook"):
+		return &virtv1beta1.VirtinkConfigFencingWebhookApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkConfigFilesystemOverhead"):
+		return &virtv1beta1.VirtinkConfigFilesystemOverheadApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkConfigIdleSuspend"):
+		return &virtv1beta1.VirtinkConfigIdleSuspendApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkConfigImages"):
+		return &virtv1beta1.VirtinkConfigImagesApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkConfigKSM"):
+		return &virtv1beta1.VirtinkConfigKSMApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkConfigLogging"):
+		return &virtv1beta1.VirtinkConfigLoggingApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkConfigMemoryOvercommit"):
+		return &virtv1beta1.VirtinkConfigMemoryOvercommitApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkConfigMigration"):
+		return &virtv1beta1.VirtinkConfigMigrationApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkConfigNetwork"):
+		return &virtv1beta1.VirtinkConfigNetworkApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkConfigNodePressure"):
+		return &virtv1beta1.VirtinkConfigNodePressureApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkConfigRebalance"):
+		return &virtv1beta1.VirtinkConfigRebalanceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkConfigSpec"):
+		return &virtv1beta1.VirtinkConfigSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkConfigStorage"):
+		return &virtv1beta1.VirtinkConfigStorageApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkConfigUsageAccounting"):
+		return &virtv1beta1.VirtinkConfigUsageAccountingApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkConfigVCPUResource"):
+		return &virtv1beta1.VirtinkConfigVCPUResourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkNamespaceConfig"):
+		return &virtv1beta1.VirtinkNamespaceConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkNamespaceConfigCloudInit"):
+		return &virtv1beta1.VirtinkNamespaceConfigCloudInitApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkNamespaceConfigResources"):
+		return &virtv1beta1.VirtinkNamespaceConfigResourcesApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkNamespaceConfigSpec"):
+		return &virtv1beta1.VirtinkNamespaceConfigSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtinkNamespaceConfigStorage"):
+		return &virtv1beta1.VirtinkNamespaceConfigStorageApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachine"):
+		return &virtv1beta1.VirtualMachineApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineAction"):
+		return &virtv1beta1.VirtualMachineActionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineActionSpec"):
+		return &virtv1beta1.VirtualMachineActionSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineActionStatus"):
+		return &virtv1beta1.VirtualMachineActionStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineAddress"):
+		return &virtv1beta1.VirtualMachineAddressApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineExport"):
+		return &virtv1beta1.VirtualMachineExportApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineExportOCITarget"):
+		return &virtv1beta1.VirtualMachineExportOCITargetApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineExportSpec"):
+		return &virtv1beta1.VirtualMachineExportSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineExportStatus"):
+		return &virtv1beta1.VirtualMachineExportStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineExportTarget"):
+		return &virtv1beta1.VirtualMachineExportTargetApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineMigration"):
+		return &virtv1beta1.VirtualMachineMigrationApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineMigrationSpec"):
+		return &virtv1beta1.VirtualMachineMigrationSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineMigrationStatus"):
+		return &virtv1beta1.VirtualMachineMigrationStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachinePool"):
+		return &virtv1beta1.VirtualMachinePoolApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachinePoolSpec"):
+		return &virtv1beta1.VirtualMachinePoolSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachinePoolStatus"):
+		return &virtv1beta1.VirtualMachinePoolStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachinePoolTemplate"):
+		return &virtv1beta1.VirtualMachinePoolTemplateApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineQuota"):
+		return &virtv1beta1.VirtualMachineQuotaApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineQuotaResources"):
+		return &virtv1beta1.VirtualMachineQuotaResourcesApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineQuotaSpec"):
+		return &virtv1beta1.VirtualMachineQuotaSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineQuotaStatus"):
+		return &virtv1beta1.VirtualMachineQuotaStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineRollingRestart"):
+		return &virtv1beta1.VirtualMachineRollingRestartApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineRollingRestartSpec"):
+		return &virtv1beta1.VirtualMachineRollingRestartSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineRollingRestartStatus"):
+		return &virtv1beta1.VirtualMachineRollingRestartStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineSecurityGroup"):
+		return &virtv1beta1.VirtualMachineSecurityGroupApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineSecurityGroupSpec"):
+		return &virtv1beta1.VirtualMachineSecurityGroupSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineSpec"):
+		return &virtv1beta1.VirtualMachineSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineStatus"):
+		return &virtv1beta1.VirtualMachineStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineStatusHibernation"):
+		return &virtv1beta1.VirtualMachineStatusHibernationApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineStatusMemoryDump"):
+		return &virtv1beta1.VirtualMachineStatusMemoryDumpApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineStatusMigration"):
+		return &virtv1beta1.VirtualMachineStatusMigrationApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineStatusMigrationVolume"):
+		return &virtv1beta1.VirtualMachineStatusMigrationVolumeApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineStatusSchedule"):
+		return &virtv1beta1.VirtualMachineStatusScheduleApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineTemplate"):
+		return &virtv1beta1.VirtualMachineTemplateApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineTemplateInstance"):
+		return &virtv1beta1.VirtualMachineTemplateInstanceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineTemplateInstanceSpec"):
+		return &virtv1beta1.VirtualMachineTemplateInstanceSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineTemplateInstanceStatus"):
+		return &virtv1beta1.VirtualMachineTemplateInstanceStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineTemplateParameter"):
+		return &virtv1beta1.VirtualMachineTemplateParameterApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineTemplateSpec"):
+		return &virtv1beta1.VirtualMachineTemplateSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineUsage"):
+		return &virtv1beta1.VirtualMachineUsageApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineUsageStatus"):
+		return &virtv1beta1.VirtualMachineUsageStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VirtualMachineUsageVolume"):
+		return &virtv1beta1.VirtualMachineUsageVolumeApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Volume"):
+		return &virtv1beta1.VolumeApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VolumeSource"):
+		return &virtv1beta1.VolumeSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Vsock"):
+		return &virtv1beta1.VsockApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Watchdog"):
+		return &virtv1beta1.WatchdogApplyConfiguration{}
+
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// BandwidthLimitApplyConfiguration represents an declarative configuration of the BandwidthLimit type for use
+// with apply.
+type BandwidthLimitApplyConfiguration struct {
+	Bandwidth *resource.Quantity `json:"bandwidth,omitempty"`
+	Burst     *resource.Quantity `json:"burst,omitempty"`
+}
+
+// BandwidthLimitApplyConfiguration constructs an declarative configuration of the BandwidthLimit type for use with
+// apply.
+func BandwidthLimit() *BandwidthLimitApplyConfiguration {
+	return &BandwidthLimitApplyConfiguration{}
+}
+
+// WithBandwidth sets the Bandwidth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Bandwidth field is set to the value of the last call.
+func (b *BandwidthLimitApplyConfiguration) WithBandwidth(value resource.Quantity) *BandwidthLimitApplyConfiguration {
+	b.Bandwidth = &value
+	return b
+}
+
+// WithBurst sets the Burst field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Burst field is set to the value of the last call.
+func (b *BandwidthLimitApplyConfiguration) WithBurst(value resource.Quantity) *BandwidthLimitApplyConfiguration {
+	b.Burst = &value
+	return b
+}
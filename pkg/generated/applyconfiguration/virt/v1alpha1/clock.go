@@ -0,0 +1,54 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+// ClockApplyConfiguration represents an declarative configuration of the Clock type for use
+// with apply.
+type ClockApplyConfiguration struct {
+	Offset          *v1alpha1.ClockOffset        `json:"offset,omitempty"`
+	Timezone        *string                      `json:"timezone,omitempty"`
+	DisableKVMClock *bool                        `json:"disableKVMClock,omitempty"`
+	Sync            *ClockSyncApplyConfiguration `json:"sync,omitempty"`
+}
+
+// ClockApplyConfiguration constructs an declarative configuration of the Clock type for use with
+// apply.
+func Clock() *ClockApplyConfiguration {
+	return &ClockApplyConfiguration{}
+}
+
+// WithOffset sets the Offset field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Offset field is set to the value of the last call.
+func (b *ClockApplyConfiguration) WithOffset(value v1alpha1.ClockOffset) *ClockApplyConfiguration {
+	b.Offset = &value
+	return b
+}
+
+// WithTimezone sets the Timezone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timezone field is set to the value of the last call.
+func (b *ClockApplyConfiguration) WithTimezone(value string) *ClockApplyConfiguration {
+	b.Timezone = &value
+	return b
+}
+
+// WithDisableKVMClock sets the DisableKVMClock field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisableKVMClock field is set to the value of the last call.
+func (b *ClockApplyConfiguration) WithDisableKVMClock(value bool) *ClockApplyConfiguration {
+	b.DisableKVMClock = &value
+	return b
+}
+
+// WithSync sets the Sync field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Sync field is set to the value of the last call.
+func (b *ClockApplyConfiguration) WithSync(value *ClockSyncApplyConfiguration) *ClockApplyConfiguration {
+	b.Sync = value
+	return b
+}
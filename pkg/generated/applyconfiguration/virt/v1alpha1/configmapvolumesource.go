@@ -0,0 +1,45 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+// ConfigMapVolumeSourceApplyConfiguration represents an declarative configuration of the ConfigMapVolumeSource type for use
+// with apply.
+type ConfigMapVolumeSourceApplyConfiguration struct {
+	Name        *string                        `json:"name,omitempty"`
+	Format      *virtv1alpha1.ConfigDiskFormat `json:"format,omitempty"`
+	VolumeLabel *string                        `json:"volumeLabel,omitempty"`
+}
+
+// ConfigMapVolumeSourceApplyConfiguration constructs an declarative configuration of the ConfigMapVolumeSource type for use with
+// apply.
+func ConfigMapVolumeSource() *ConfigMapVolumeSourceApplyConfiguration {
+	return &ConfigMapVolumeSourceApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ConfigMapVolumeSourceApplyConfiguration) WithName(value string) *ConfigMapVolumeSourceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithFormat sets the Format field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Format field is set to the value of the last call.
+func (b *ConfigMapVolumeSourceApplyConfiguration) WithFormat(value virtv1alpha1.ConfigDiskFormat) *ConfigMapVolumeSourceApplyConfiguration {
+	b.Format = &value
+	return b
+}
+
+// WithVolumeLabel sets the VolumeLabel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeLabel field is set to the value of the last call.
+func (b *ConfigMapVolumeSourceApplyConfiguration) WithVolumeLabel(value string) *ConfigMapVolumeSourceApplyConfiguration {
+	b.VolumeLabel = &value
+	return b
+}
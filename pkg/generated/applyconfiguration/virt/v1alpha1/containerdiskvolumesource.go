@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// ContainerDiskVolumeSourceApplyConfiguration represents an declarative configuration of the ContainerDiskVolumeSource type for use
+// with apply.
+type ContainerDiskVolumeSourceApplyConfiguration struct {
+	Image           *string        `json:"image,omitempty"`
+	ImagePullPolicy *v1.PullPolicy `json:"imagePullPolicy,omitempty"`
+}
+
+// ContainerDiskVolumeSourceApplyConfiguration constructs an declarative configuration of the ContainerDiskVolumeSource type for use with
+// apply.
+func ContainerDiskVolumeSource() *ContainerDiskVolumeSourceApplyConfiguration {
+	return &ContainerDiskVolumeSourceApplyConfiguration{}
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *ContainerDiskVolumeSourceApplyConfiguration) WithImage(value string) *ContainerDiskVolumeSourceApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithImagePullPolicy sets the ImagePullPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImagePullPolicy field is set to the value of the last call.
+func (b *ContainerDiskVolumeSourceApplyConfiguration) WithImagePullPolicy(value v1.PullPolicy) *ContainerDiskVolumeSourceApplyConfiguration {
+	b.ImagePullPolicy = &value
+	return b
+}
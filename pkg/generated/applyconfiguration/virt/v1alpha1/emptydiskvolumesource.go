@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// EmptyDiskVolumeSourceApplyConfiguration represents an declarative configuration of the EmptyDiskVolumeSource type for use
+// with apply.
+type EmptyDiskVolumeSourceApplyConfiguration struct {
+	Capacity    *resource.Quantity                      `json:"capacity,omitempty"`
+	VolumeClaim *EmptyDiskVolumeClaimApplyConfiguration `json:"volumeClaim,omitempty"`
+}
+
+// EmptyDiskVolumeSourceApplyConfiguration constructs an declarative configuration of the EmptyDiskVolumeSource type for use with
+// apply.
+func EmptyDiskVolumeSource() *EmptyDiskVolumeSourceApplyConfiguration {
+	return &EmptyDiskVolumeSourceApplyConfiguration{}
+}
+
+// WithCapacity sets the Capacity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Capacity field is set to the value of the last call.
+func (b *EmptyDiskVolumeSourceApplyConfiguration) WithCapacity(value resource.Quantity) *EmptyDiskVolumeSourceApplyConfiguration {
+	b.Capacity = &value
+	return b
+}
+
+// WithVolumeClaim sets the VolumeClaim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeClaim field is set to the value of the last call.
+func (b *EmptyDiskVolumeSourceApplyConfiguration) WithVolumeClaim(value *EmptyDiskVolumeClaimApplyConfiguration) *EmptyDiskVolumeSourceApplyConfiguration {
+	b.VolumeClaim = value
+	return b
+}
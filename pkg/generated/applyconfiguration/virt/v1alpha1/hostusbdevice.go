@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// HostUSBDeviceApplyConfiguration represents an declarative configuration of the HostUSBDevice type for use
+// with apply.
+type HostUSBDeviceApplyConfiguration struct {
+	Name         *string `json:"name,omitempty"`
+	ResourceName *string `json:"resourceName,omitempty"`
+}
+
+// HostUSBDeviceApplyConfiguration constructs an declarative configuration of the HostUSBDevice type for use with
+// apply.
+func HostUSBDevice() *HostUSBDeviceApplyConfiguration {
+	return &HostUSBDeviceApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *HostUSBDeviceApplyConfiguration) WithName(value string) *HostUSBDeviceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithResourceName sets the ResourceName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceName field is set to the value of the last call.
+func (b *HostUSBDeviceApplyConfiguration) WithResourceName(value string) *HostUSBDeviceApplyConfiguration {
+	b.ResourceName = &value
+	return b
+}
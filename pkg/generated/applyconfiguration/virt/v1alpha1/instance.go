@@ -0,0 +1,191 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+// InstanceApplyConfiguration represents an declarative configuration of the Instance type for use
+// with apply.
+type InstanceApplyConfiguration struct {
+	CPU          *CPUApplyConfiguration          `json:"cpu,omitempty"`
+	Memory       *MemoryApplyConfiguration       `json:"memory,omitempty"`
+	Kernel       *KernelApplyConfiguration       `json:"kernel,omitempty"`
+	Disks        []DiskApplyConfiguration        `json:"disks,omitempty"`
+	FileSystems  []FileSystemApplyConfiguration  `json:"fileSystems,omitempty"`
+	Interfaces   []InterfaceApplyConfiguration   `json:"interfaces,omitempty"`
+	Bonds        []BondApplyConfiguration        `json:"bonds,omitempty"`
+	Realtime     *RealtimeApplyConfiguration     `json:"realtime,omitempty"`
+	Watchdog     *WatchdogApplyConfiguration     `json:"watchdog,omitempty"`
+	Devices      *DevicesApplyConfiguration      `json:"devices,omitempty"`
+	Firmware     *FirmwareApplyConfiguration     `json:"firmware,omitempty"`
+	Hypervisor   *virtv1alpha1.Hypervisor        `json:"hypervisor,omitempty"`
+	Vsock        *VsockApplyConfiguration        `json:"vsock,omitempty"`
+	RNG          *RNGApplyConfiguration          `json:"rng,omitempty"`
+	Downward     *DownwardApplyConfiguration     `json:"downward,omitempty"`
+	Clock        *ClockApplyConfiguration        `json:"clock,omitempty"`
+	GuestMetrics *GuestMetricsApplyConfiguration `json:"guestMetrics,omitempty"`
+}
+
+// InstanceApplyConfiguration constructs an declarative configuration of the Instance type for use with
+// apply.
+func Instance() *InstanceApplyConfiguration {
+	return &InstanceApplyConfiguration{}
+}
+
+// WithCPU sets the CPU field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CPU field is set to the value of the last call.
+func (b *InstanceApplyConfiguration) WithCPU(value *CPUApplyConfiguration) *InstanceApplyConfiguration {
+	b.CPU = value
+	return b
+}
+
+// WithMemory sets the Memory field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Memory field is set to the value of the last call.
+func (b *InstanceApplyConfiguration) WithMemory(value *MemoryApplyConfiguration) *InstanceApplyConfiguration {
+	b.Memory = value
+	return b
+}
+
+// WithKernel sets the Kernel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kernel field is set to the value of the last call.
+func (b *InstanceApplyConfiguration) WithKernel(value *KernelApplyConfiguration) *InstanceApplyConfiguration {
+	b.Kernel = value
+	return b
+}
+
+// WithDisks adds the given value to the Disks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Disks field.
+func (b *InstanceApplyConfiguration) WithDisks(values ...*DiskApplyConfiguration) *InstanceApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDisks")
+		}
+		b.Disks = append(b.Disks, *values[i])
+	}
+	return b
+}
+
+// WithFileSystems adds the given value to the FileSystems field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the FileSystems field.
+func (b *InstanceApplyConfiguration) WithFileSystems(values ...*FileSystemApplyConfiguration) *InstanceApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithFileSystems")
+		}
+		b.FileSystems = append(b.FileSystems, *values[i])
+	}
+	return b
+}
+
+// WithInterfaces adds the given value to the Interfaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Interfaces field.
+func (b *InstanceApplyConfiguration) WithInterfaces(values ...*InterfaceApplyConfiguration) *InstanceApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithInterfaces")
+		}
+		b.Interfaces = append(b.Interfaces, *values[i])
+	}
+	return b
+}
+
+// WithBonds adds the given value to the Bonds field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Bonds field.
+func (b *InstanceApplyConfiguration) WithBonds(values ...*BondApplyConfiguration) *InstanceApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithBonds")
+		}
+		b.Bonds = append(b.Bonds, *values[i])
+	}
+	return b
+}
+
+// WithRealtime sets the Realtime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Realtime field is set to the value of the last call.
+func (b *InstanceApplyConfiguration) WithRealtime(value *RealtimeApplyConfiguration) *InstanceApplyConfiguration {
+	b.Realtime = value
+	return b
+}
+
+// WithWatchdog sets the Watchdog field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Watchdog field is set to the value of the last call.
+func (b *InstanceApplyConfiguration) WithWatchdog(value *WatchdogApplyConfiguration) *InstanceApplyConfiguration {
+	b.Watchdog = value
+	return b
+}
+
+// WithDevices sets the Devices field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Devices field is set to the value of the last call.
+func (b *InstanceApplyConfiguration) WithDevices(value *DevicesApplyConfiguration) *InstanceApplyConfiguration {
+	b.Devices = value
+	return b
+}
+
+// WithFirmware sets the Firmware field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Firmware field is set to the value of the last call.
+func (b *InstanceApplyConfiguration) WithFirmware(value *FirmwareApplyConfiguration) *InstanceApplyConfiguration {
+	b.Firmware = value
+	return b
+}
+
+// WithHypervisor sets the Hypervisor field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Hypervisor field is set to the value of the last call.
+func (b *InstanceApplyConfiguration) WithHypervisor(value virtv1alpha1.Hypervisor) *InstanceApplyConfiguration {
+	b.Hypervisor = &value
+	return b
+}
+
+// WithVsock sets the Vsock field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Vsock field is set to the value of the last call.
+func (b *InstanceApplyConfiguration) WithVsock(value *VsockApplyConfiguration) *InstanceApplyConfiguration {
+	b.Vsock = value
+	return b
+}
+
+// WithRNG sets the RNG field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RNG field is set to the value of the last call.
+func (b *InstanceApplyConfiguration) WithRNG(value *RNGApplyConfiguration) *InstanceApplyConfiguration {
+	b.RNG = value
+	return b
+}
+
+// WithDownward sets the Downward field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Downward field is set to the value of the last call.
+func (b *InstanceApplyConfiguration) WithDownward(value *DownwardApplyConfiguration) *InstanceApplyConfiguration {
+	b.Downward = value
+	return b
+}
+
+// WithClock sets the Clock field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Clock field is set to the value of the last call.
+func (b *InstanceApplyConfiguration) WithClock(value *ClockApplyConfiguration) *InstanceApplyConfiguration {
+	b.Clock = value
+	return b
+}
+
+// WithGuestMetrics sets the GuestMetrics field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GuestMetrics field is set to the value of the last call.
+func (b *InstanceApplyConfiguration) WithGuestMetrics(value *GuestMetricsApplyConfiguration) *InstanceApplyConfiguration {
+	b.GuestMetrics = value
+	return b
+}
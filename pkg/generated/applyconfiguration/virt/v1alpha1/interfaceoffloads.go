@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// InterfaceOffloadsApplyConfiguration represents an declarative configuration of the InterfaceOffloads type for use
+// with apply.
+type InterfaceOffloadsApplyConfiguration struct {
+	DisableChecksum *bool `json:"disableChecksum,omitempty"`
+	DisableTSO      *bool `json:"disableTSO,omitempty"`
+	DisableUFO      *bool `json:"disableUFO,omitempty"`
+}
+
+// InterfaceOffloadsApplyConfiguration constructs an declarative configuration of the InterfaceOffloads type for use with
+// apply.
+func InterfaceOffloads() *InterfaceOffloadsApplyConfiguration {
+	return &InterfaceOffloadsApplyConfiguration{}
+}
+
+// WithDisableChecksum sets the DisableChecksum field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisableChecksum field is set to the value of the last call.
+func (b *InterfaceOffloadsApplyConfiguration) WithDisableChecksum(value bool) *InterfaceOffloadsApplyConfiguration {
+	b.DisableChecksum = &value
+	return b
+}
+
+// WithDisableTSO sets the DisableTSO field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisableTSO field is set to the value of the last call.
+func (b *InterfaceOffloadsApplyConfiguration) WithDisableTSO(value bool) *InterfaceOffloadsApplyConfiguration {
+	b.DisableTSO = &value
+	return b
+}
+
+// WithDisableUFO sets the DisableUFO field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisableUFO field is set to the value of the last call.
+func (b *InterfaceOffloadsApplyConfiguration) WithDisableUFO(value bool) *InterfaceOffloadsApplyConfiguration {
+	b.DisableUFO = &value
+	return b
+}
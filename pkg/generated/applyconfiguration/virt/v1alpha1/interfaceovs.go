@@ -0,0 +1,34 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// InterfaceOVSApplyConfiguration represents an declarative configuration of the InterfaceOVS type for use
+// with apply.
+type InterfaceOVSApplyConfiguration struct {
+	VLAN   *int32  `json:"vlan,omitempty"`
+	Trunks []int32 `json:"trunks,omitempty"`
+}
+
+// InterfaceOVSApplyConfiguration constructs an declarative configuration of the InterfaceOVS type for use with
+// apply.
+func InterfaceOVS() *InterfaceOVSApplyConfiguration {
+	return &InterfaceOVSApplyConfiguration{}
+}
+
+// WithVLAN sets the VLAN field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VLAN field is set to the value of the last call.
+func (b *InterfaceOVSApplyConfiguration) WithVLAN(value int32) *InterfaceOVSApplyConfiguration {
+	b.VLAN = &value
+	return b
+}
+
+// WithTrunks adds the given value to the Trunks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Trunks field.
+func (b *InterfaceOVSApplyConfiguration) WithTrunks(values ...int32) *InterfaceOVSApplyConfiguration {
+	for i := range values {
+		b.Trunks = append(b.Trunks, values[i])
+	}
+	return b
+}
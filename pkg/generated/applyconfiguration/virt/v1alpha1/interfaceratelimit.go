@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// InterfaceRateLimitApplyConfiguration represents an declarative configuration of the InterfaceRateLimit type for use
+// with apply.
+type InterfaceRateLimitApplyConfiguration struct {
+	RX *BandwidthLimitApplyConfiguration `json:"rx,omitempty"`
+	TX *BandwidthLimitApplyConfiguration `json:"tx,omitempty"`
+}
+
+// InterfaceRateLimitApplyConfiguration constructs an declarative configuration of the InterfaceRateLimit type for use with
+// apply.
+func InterfaceRateLimit() *InterfaceRateLimitApplyConfiguration {
+	return &InterfaceRateLimitApplyConfiguration{}
+}
+
+// WithRX sets the RX field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RX field is set to the value of the last call.
+func (b *InterfaceRateLimitApplyConfiguration) WithRX(value *BandwidthLimitApplyConfiguration) *InterfaceRateLimitApplyConfiguration {
+	b.RX = value
+	return b
+}
+
+// WithTX sets the TX field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TX field is set to the value of the last call.
+func (b *InterfaceRateLimitApplyConfiguration) WithTX(value *BandwidthLimitApplyConfiguration) *InterfaceRateLimitApplyConfiguration {
+	b.TX = value
+	return b
+}
@@ -0,0 +1,37 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// InterfaceVLANApplyConfiguration represents an declarative configuration of the InterfaceVLAN type for use
+// with apply.
+type InterfaceVLANApplyConfiguration struct {
+	ID     *int32                        `json:"id,omitempty"`
+	Trunks []VLANRangeApplyConfiguration `json:"trunks,omitempty"`
+}
+
+// InterfaceVLANApplyConfiguration constructs an declarative configuration of the InterfaceVLAN type for use with
+// apply.
+func InterfaceVLAN() *InterfaceVLANApplyConfiguration {
+	return &InterfaceVLANApplyConfiguration{}
+}
+
+// WithID sets the ID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ID field is set to the value of the last call.
+func (b *InterfaceVLANApplyConfiguration) WithID(value int32) *InterfaceVLANApplyConfiguration {
+	b.ID = &value
+	return b
+}
+
+// WithTrunks adds the given value to the Trunks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Trunks field.
+func (b *InterfaceVLANApplyConfiguration) WithTrunks(values ...*VLANRangeApplyConfiguration) *InterfaceVLANApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTrunks")
+		}
+		b.Trunks = append(b.Trunks, *values[i])
+	}
+	return b
+}
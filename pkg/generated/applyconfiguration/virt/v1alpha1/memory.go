@@ -0,0 +1,54 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// MemoryApplyConfiguration represents an declarative configuration of the Memory type for use
+// with apply.
+type MemoryApplyConfiguration struct {
+	Size      *resource.Quantity               `json:"size,omitempty"`
+	Hugepages *HugepagesApplyConfiguration     `json:"hugepages,omitempty"`
+	Balloon   *MemoryBalloonApplyConfiguration `json:"balloon,omitempty"`
+	Swap      *MemorySwapApplyConfiguration    `json:"swap,omitempty"`
+}
+
+// MemoryApplyConfiguration constructs an declarative configuration of the Memory type for use with
+// apply.
+func Memory() *MemoryApplyConfiguration {
+	return &MemoryApplyConfiguration{}
+}
+
+// WithSize sets the Size field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Size field is set to the value of the last call.
+func (b *MemoryApplyConfiguration) WithSize(value resource.Quantity) *MemoryApplyConfiguration {
+	b.Size = &value
+	return b
+}
+
+// WithHugepages sets the Hugepages field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Hugepages field is set to the value of the last call.
+func (b *MemoryApplyConfiguration) WithHugepages(value *HugepagesApplyConfiguration) *MemoryApplyConfiguration {
+	b.Hugepages = value
+	return b
+}
+
+// WithBalloon sets the Balloon field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Balloon field is set to the value of the last call.
+func (b *MemoryApplyConfiguration) WithBalloon(value *MemoryBalloonApplyConfiguration) *MemoryApplyConfiguration {
+	b.Balloon = value
+	return b
+}
+
+// WithSwap sets the Swap field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Swap field is set to the value of the last call.
+func (b *MemoryApplyConfiguration) WithSwap(value *MemorySwapApplyConfiguration) *MemoryApplyConfiguration {
+	b.Swap = value
+	return b
+}
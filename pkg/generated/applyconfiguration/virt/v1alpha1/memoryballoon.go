@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// MemoryBalloonApplyConfiguration represents an declarative configuration of the MemoryBalloon type for use
+// with apply.
+type MemoryBalloonApplyConfiguration struct {
+	MaxReclaimPercent        *int  `json:"maxReclaimPercent,omitempty"`
+	DisableFreePageReporting *bool `json:"disableFreePageReporting,omitempty"`
+}
+
+// MemoryBalloonApplyConfiguration constructs an declarative configuration of the MemoryBalloon type for use with
+// apply.
+func MemoryBalloon() *MemoryBalloonApplyConfiguration {
+	return &MemoryBalloonApplyConfiguration{}
+}
+
+// WithMaxReclaimPercent sets the MaxReclaimPercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxReclaimPercent field is set to the value of the last call.
+func (b *MemoryBalloonApplyConfiguration) WithMaxReclaimPercent(value int) *MemoryBalloonApplyConfiguration {
+	b.MaxReclaimPercent = &value
+	return b
+}
+
+// WithDisableFreePageReporting sets the DisableFreePageReporting field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisableFreePageReporting field is set to the value of the last call.
+func (b *MemoryBalloonApplyConfiguration) WithDisableFreePageReporting(value bool) *MemoryBalloonApplyConfiguration {
+	b.DisableFreePageReporting = &value
+	return b
+}
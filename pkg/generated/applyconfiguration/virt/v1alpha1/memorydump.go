@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// MemoryDumpApplyConfiguration represents an declarative configuration of the MemoryDump type for use
+// with apply.
+type MemoryDumpApplyConfiguration struct {
+	ClaimName *string `json:"claimName,omitempty"`
+	OnCrash   *bool   `json:"onCrash,omitempty"`
+}
+
+// MemoryDumpApplyConfiguration constructs an declarative configuration of the MemoryDump type for use with
+// apply.
+func MemoryDump() *MemoryDumpApplyConfiguration {
+	return &MemoryDumpApplyConfiguration{}
+}
+
+// WithClaimName sets the ClaimName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClaimName field is set to the value of the last call.
+func (b *MemoryDumpApplyConfiguration) WithClaimName(value string) *MemoryDumpApplyConfiguration {
+	b.ClaimName = &value
+	return b
+}
+
+// WithOnCrash sets the OnCrash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OnCrash field is set to the value of the last call.
+func (b *MemoryDumpApplyConfiguration) WithOnCrash(value bool) *MemoryDumpApplyConfiguration {
+	b.OnCrash = &value
+	return b
+}
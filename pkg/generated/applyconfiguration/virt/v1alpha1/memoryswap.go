@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// MemorySwapApplyConfiguration represents an declarative configuration of the MemorySwap type for use
+// with apply.
+type MemorySwapApplyConfiguration struct {
+	Max          *resource.Quantity `json:"max,omitempty"`
+	DisableZswap *bool              `json:"disableZswap,omitempty"`
+}
+
+// MemorySwapApplyConfiguration constructs an declarative configuration of the MemorySwap type for use with
+// apply.
+func MemorySwap() *MemorySwapApplyConfiguration {
+	return &MemorySwapApplyConfiguration{}
+}
+
+// WithMax sets the Max field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Max field is set to the value of the last call.
+func (b *MemorySwapApplyConfiguration) WithMax(value resource.Quantity) *MemorySwapApplyConfiguration {
+	b.Max = &value
+	return b
+}
+
+// WithDisableZswap sets the DisableZswap field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisableZswap field is set to the value of the last call.
+func (b *MemorySwapApplyConfiguration) WithDisableZswap(value bool) *MemorySwapApplyConfiguration {
+	b.DisableZswap = &value
+	return b
+}
@@ -0,0 +1,45 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+// SecretVolumeSourceApplyConfiguration represents an declarative configuration of the SecretVolumeSource type for use
+// with apply.
+type SecretVolumeSourceApplyConfiguration struct {
+	SecretName  *string                        `json:"secretName,omitempty"`
+	Format      *virtv1alpha1.ConfigDiskFormat `json:"format,omitempty"`
+	VolumeLabel *string                        `json:"volumeLabel,omitempty"`
+}
+
+// SecretVolumeSourceApplyConfiguration constructs an declarative configuration of the SecretVolumeSource type for use with
+// apply.
+func SecretVolumeSource() *SecretVolumeSourceApplyConfiguration {
+	return &SecretVolumeSourceApplyConfiguration{}
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *SecretVolumeSourceApplyConfiguration) WithSecretName(value string) *SecretVolumeSourceApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
+
+// WithFormat sets the Format field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Format field is set to the value of the last call.
+func (b *SecretVolumeSourceApplyConfiguration) WithFormat(value virtv1alpha1.ConfigDiskFormat) *SecretVolumeSourceApplyConfiguration {
+	b.Format = &value
+	return b
+}
+
+// WithVolumeLabel sets the VolumeLabel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeLabel field is set to the value of the last call.
+func (b *SecretVolumeSourceApplyConfiguration) WithVolumeLabel(value string) *SecretVolumeSourceApplyConfiguration {
+	b.VolumeLabel = &value
+	return b
+}
@@ -0,0 +1,62 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+// VirtualMachineExportSpecApplyConfiguration represents an declarative configuration of the VirtualMachineExportSpec type for use
+// with apply.
+type VirtualMachineExportSpecApplyConfiguration struct {
+	VMName                                       *string                              `json:"vmName,omitempty"`
+	VolumeName                                   *string                              `json:"volumeName,omitempty"`
+	Format                                       *v1alpha1.VirtualMachineExportFormat `json:"format,omitempty"`
+	VirtualMachineExportTargetApplyConfiguration `json:",inline"`
+}
+
+// VirtualMachineExportSpecApplyConfiguration constructs an declarative configuration of the VirtualMachineExportSpec type for use with
+// apply.
+func VirtualMachineExportSpec() *VirtualMachineExportSpecApplyConfiguration {
+	return &VirtualMachineExportSpecApplyConfiguration{}
+}
+
+// WithVMName sets the VMName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VMName field is set to the value of the last call.
+func (b *VirtualMachineExportSpecApplyConfiguration) WithVMName(value string) *VirtualMachineExportSpecApplyConfiguration {
+	b.VMName = &value
+	return b
+}
+
+// WithVolumeName sets the VolumeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeName field is set to the value of the last call.
+func (b *VirtualMachineExportSpecApplyConfiguration) WithVolumeName(value string) *VirtualMachineExportSpecApplyConfiguration {
+	b.VolumeName = &value
+	return b
+}
+
+// WithFormat sets the Format field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Format field is set to the value of the last call.
+func (b *VirtualMachineExportSpecApplyConfiguration) WithFormat(value v1alpha1.VirtualMachineExportFormat) *VirtualMachineExportSpecApplyConfiguration {
+	b.Format = &value
+	return b
+}
+
+// WithHTTP sets the HTTP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HTTP field is set to the value of the last call.
+func (b *VirtualMachineExportSpecApplyConfiguration) WithHTTP(value v1alpha1.VirtualMachineExportHTTPTarget) *VirtualMachineExportSpecApplyConfiguration {
+	b.HTTP = &value
+	return b
+}
+
+// WithOCI sets the OCI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OCI field is set to the value of the last call.
+func (b *VirtualMachineExportSpecApplyConfiguration) WithOCI(value *VirtualMachineExportOCITargetApplyConfiguration) *VirtualMachineExportSpecApplyConfiguration {
+	b.OCI = value
+	return b
+}
@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+// VirtualMachineExportTargetApplyConfiguration represents an declarative configuration of the VirtualMachineExportTarget type for use
+// with apply.
+type VirtualMachineExportTargetApplyConfiguration struct {
+	HTTP *v1alpha1.VirtualMachineExportHTTPTarget         `json:"http,omitempty"`
+	OCI  *VirtualMachineExportOCITargetApplyConfiguration `json:"oci,omitempty"`
+}
+
+// VirtualMachineExportTargetApplyConfiguration constructs an declarative configuration of the VirtualMachineExportTarget type for use with
+// apply.
+func VirtualMachineExportTarget() *VirtualMachineExportTargetApplyConfiguration {
+	return &VirtualMachineExportTargetApplyConfiguration{}
+}
+
+// WithHTTP sets the HTTP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HTTP field is set to the value of the last call.
+func (b *VirtualMachineExportTargetApplyConfiguration) WithHTTP(value v1alpha1.VirtualMachineExportHTTPTarget) *VirtualMachineExportTargetApplyConfiguration {
+	b.HTTP = &value
+	return b
+}
+
+// WithOCI sets the OCI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OCI field is set to the value of the last call.
+func (b *VirtualMachineExportTargetApplyConfiguration) WithOCI(value *VirtualMachineExportOCITargetApplyConfiguration) *VirtualMachineExportTargetApplyConfiguration {
+	b.OCI = value
+	return b
+}
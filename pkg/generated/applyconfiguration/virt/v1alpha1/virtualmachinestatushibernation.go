@@ -0,0 +1,46 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineStatusHibernationApplyConfiguration represents an declarative configuration of the VirtualMachineStatusHibernation type for use
+// with apply.
+type VirtualMachineStatusHibernationApplyConfiguration struct {
+	Phase           *v1alpha1.VirtualMachineHibernationPhase `json:"phase,omitempty"`
+	SnapshotName    *string                                  `json:"snapshotName,omitempty"`
+	HibernationTime *v1.Time                                 `json:"hibernationTime,omitempty"`
+}
+
+// VirtualMachineStatusHibernationApplyConfiguration constructs an declarative configuration of the VirtualMachineStatusHibernation type for use with
+// apply.
+func VirtualMachineStatusHibernation() *VirtualMachineStatusHibernationApplyConfiguration {
+	return &VirtualMachineStatusHibernationApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *VirtualMachineStatusHibernationApplyConfiguration) WithPhase(value v1alpha1.VirtualMachineHibernationPhase) *VirtualMachineStatusHibernationApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithSnapshotName sets the SnapshotName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SnapshotName field is set to the value of the last call.
+func (b *VirtualMachineStatusHibernationApplyConfiguration) WithSnapshotName(value string) *VirtualMachineStatusHibernationApplyConfiguration {
+	b.SnapshotName = &value
+	return b
+}
+
+// WithHibernationTime sets the HibernationTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HibernationTime field is set to the value of the last call.
+func (b *VirtualMachineStatusHibernationApplyConfiguration) WithHibernationTime(value v1.Time) *VirtualMachineStatusHibernationApplyConfiguration {
+	b.HibernationTime = &value
+	return b
+}
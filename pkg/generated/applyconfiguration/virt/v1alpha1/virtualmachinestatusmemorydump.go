@@ -0,0 +1,46 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineStatusMemoryDumpApplyConfiguration represents an declarative configuration of the VirtualMachineStatusMemoryDump type for use
+// with apply.
+type VirtualMachineStatusMemoryDumpApplyConfiguration struct {
+	Phase          *v1alpha1.VirtualMachineMemoryDumpPhase `json:"phase,omitempty"`
+	FileName       *string                                 `json:"fileName,omitempty"`
+	CompletionTime *v1.Time                                `json:"completionTime,omitempty"`
+}
+
+// VirtualMachineStatusMemoryDumpApplyConfiguration constructs an declarative configuration of the VirtualMachineStatusMemoryDump type for use with
+// apply.
+func VirtualMachineStatusMemoryDump() *VirtualMachineStatusMemoryDumpApplyConfiguration {
+	return &VirtualMachineStatusMemoryDumpApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *VirtualMachineStatusMemoryDumpApplyConfiguration) WithPhase(value v1alpha1.VirtualMachineMemoryDumpPhase) *VirtualMachineStatusMemoryDumpApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithFileName sets the FileName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FileName field is set to the value of the last call.
+func (b *VirtualMachineStatusMemoryDumpApplyConfiguration) WithFileName(value string) *VirtualMachineStatusMemoryDumpApplyConfiguration {
+	b.FileName = &value
+	return b
+}
+
+// WithCompletionTime sets the CompletionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletionTime field is set to the value of the last call.
+func (b *VirtualMachineStatusMemoryDumpApplyConfiguration) WithCompletionTime(value v1.Time) *VirtualMachineStatusMemoryDumpApplyConfiguration {
+	b.CompletionTime = &value
+	return b
+}
@@ -0,0 +1,80 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// VolumeApplyConfiguration represents an declarative configuration of the Volume type for use
+// with apply.
+type VolumeApplyConfiguration struct {
+	Name                           *string `json:"name,omitempty"`
+	VolumeSourceApplyConfiguration `json:",inline"`
+}
+
+// VolumeApplyConfiguration constructs an declarative configuration of the Volume type for use with
+// apply.
+func Volume() *VolumeApplyConfiguration {
+	return &VolumeApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *VolumeApplyConfiguration) WithName(value string) *VolumeApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithContainerDisk sets the ContainerDisk field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ContainerDisk field is set to the value of the last call.
+func (b *VolumeApplyConfiguration) WithContainerDisk(value *ContainerDiskVolumeSourceApplyConfiguration) *VolumeApplyConfiguration {
+	b.ContainerDisk = value
+	return b
+}
+
+// WithCloudInit sets the CloudInit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CloudInit field is set to the value of the last call.
+func (b *VolumeApplyConfiguration) WithCloudInit(value *CloudInitVolumeSourceApplyConfiguration) *VolumeApplyConfiguration {
+	b.CloudInit = value
+	return b
+}
+
+// WithContainerRootfs sets the ContainerRootfs field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ContainerRootfs field is set to the value of the last call.
+func (b *VolumeApplyConfiguration) WithContainerRootfs(value *ContainerRootfsVolumeSourceApplyConfiguration) *VolumeApplyConfiguration {
+	b.ContainerRootfs = value
+	return b
+}
+
+// WithPersistentVolumeClaim sets the PersistentVolumeClaim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PersistentVolumeClaim field is set to the value of the last call.
+func (b *VolumeApplyConfiguration) WithPersistentVolumeClaim(value *PersistentVolumeClaimVolumeSourceApplyConfiguration) *VolumeApplyConfiguration {
+	b.PersistentVolumeClaim = value
+	return b
+}
+
+// WithDataVolume sets the DataVolume field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DataVolume field is set to the value of the last call.
+func (b *VolumeApplyConfiguration) WithDataVolume(value *DataVolumeVolumeSourceApplyConfiguration) *VolumeApplyConfiguration {
+	b.DataVolume = value
+	return b
+}
+
+// WithClusterAPIBootstrap sets the ClusterAPIBootstrap field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterAPIBootstrap field is set to the value of the last call.
+func (b *VolumeApplyConfiguration) WithClusterAPIBootstrap(value *ClusterAPIBootstrapVolumeSourceApplyConfiguration) *VolumeApplyConfiguration {
+	b.ClusterAPIBootstrap = value
+	return b
+}
+
+// WithSysprep sets the Sysprep field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Sysprep field is set to the value of the last call.
+func (b *VolumeApplyConfiguration) WithSysprep(value *SysprepVolumeSourceApplyConfiguration) *VolumeApplyConfiguration {
+	b.Sysprep = value
+	return b
+}
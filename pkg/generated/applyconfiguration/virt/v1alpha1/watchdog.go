@@ -0,0 +1,27 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+// WatchdogApplyConfiguration represents an declarative configuration of the Watchdog type for use
+// with apply.
+type WatchdogApplyConfiguration struct {
+	Action *v1alpha1.WatchdogAction `json:"action,omitempty"`
+}
+
+// WatchdogApplyConfiguration constructs an declarative configuration of the Watchdog type for use with
+// apply.
+func Watchdog() *WatchdogApplyConfiguration {
+	return &WatchdogApplyConfiguration{}
+}
+
+// WithAction sets the Action field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Action field is set to the value of the last call.
+func (b *WatchdogApplyConfiguration) WithAction(value v1alpha1.WatchdogAction) *WatchdogApplyConfiguration {
+	b.Action = &value
+	return b
+}
@@ -0,0 +1,90 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// BondApplyConfiguration represents an declarative configuration of the Bond type for use
+// with apply.
+type BondApplyConfiguration struct {
+	Name               *string                            `json:"name,omitempty"`
+	Interfaces         []string                           `json:"interfaces,omitempty"`
+	Mode               *virtv1beta1.BondMode              `json:"mode,omitempty"`
+	Primary            *string                            `json:"primary,omitempty"`
+	MIIMonitorInterval *uint32                            `json:"miiMonitorInterval,omitempty"`
+	Addresses          []string                           `json:"addresses,omitempty"`
+	Routes             []InterfaceRouteApplyConfiguration `json:"routes,omitempty"`
+}
+
+// BondApplyConfiguration constructs an declarative configuration of the Bond type for use with
+// apply.
+func Bond() *BondApplyConfiguration {
+	return &BondApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *BondApplyConfiguration) WithName(value string) *BondApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithInterfaces adds the given value to the Interfaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Interfaces field.
+func (b *BondApplyConfiguration) WithInterfaces(values ...string) *BondApplyConfiguration {
+	for i := range values {
+		b.Interfaces = append(b.Interfaces, values[i])
+	}
+	return b
+}
+
+// WithMode sets the Mode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mode field is set to the value of the last call.
+func (b *BondApplyConfiguration) WithMode(value virtv1beta1.BondMode) *BondApplyConfiguration {
+	b.Mode = &value
+	return b
+}
+
+// WithPrimary sets the Primary field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Primary field is set to the value of the last call.
+func (b *BondApplyConfiguration) WithPrimary(value string) *BondApplyConfiguration {
+	b.Primary = &value
+	return b
+}
+
+// WithMIIMonitorInterval sets the MIIMonitorInterval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MIIMonitorInterval field is set to the value of the last call.
+func (b *BondApplyConfiguration) WithMIIMonitorInterval(value uint32) *BondApplyConfiguration {
+	b.MIIMonitorInterval = &value
+	return b
+}
+
+// WithAddresses adds the given value to the Addresses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Addresses field.
+func (b *BondApplyConfiguration) WithAddresses(values ...string) *BondApplyConfiguration {
+	for i := range values {
+		b.Addresses = append(b.Addresses, values[i])
+	}
+	return b
+}
+
+// WithRoutes adds the given value to the Routes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Routes field.
+func (b *BondApplyConfiguration) WithRoutes(values ...*InterfaceRouteApplyConfiguration) *BondApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRoutes")
+		}
+		b.Routes = append(b.Routes, *values[i])
+	}
+	return b
+}
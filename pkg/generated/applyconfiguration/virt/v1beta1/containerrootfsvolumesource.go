@@ -0,0 +1,46 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ContainerRootfsVolumeSourceApplyConfiguration represents an declarative configuration of the ContainerRootfsVolumeSource type for use
+// with apply.
+type ContainerRootfsVolumeSourceApplyConfiguration struct {
+	Image           *string            `json:"image,omitempty"`
+	ImagePullPolicy *v1.PullPolicy     `json:"imagePullPolicy,omitempty"`
+	Size            *resource.Quantity `json:"size,omitempty"`
+}
+
+// ContainerRootfsVolumeSourceApplyConfiguration constructs an declarative configuration of the ContainerRootfsVolumeSource type for use with
+// apply.
+func ContainerRootfsVolumeSource() *ContainerRootfsVolumeSourceApplyConfiguration {
+	return &ContainerRootfsVolumeSourceApplyConfiguration{}
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *ContainerRootfsVolumeSourceApplyConfiguration) WithImage(value string) *ContainerRootfsVolumeSourceApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithImagePullPolicy sets the ImagePullPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImagePullPolicy field is set to the value of the last call.
+func (b *ContainerRootfsVolumeSourceApplyConfiguration) WithImagePullPolicy(value v1.PullPolicy) *ContainerRootfsVolumeSourceApplyConfiguration {
+	b.ImagePullPolicy = &value
+	return b
+}
+
+// WithSize sets the Size field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Size field is set to the value of the last call.
+func (b *ContainerRootfsVolumeSourceApplyConfiguration) WithSize(value resource.Quantity) *ContainerRootfsVolumeSourceApplyConfiguration {
+	b.Size = &value
+	return b
+}
@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// CPUApplyConfiguration represents an declarative configuration of the CPU type for use
+// with apply.
+type CPUApplyConfiguration struct {
+	Sockets               *uint32 `json:"sockets,omitempty"`
+	CoresPerSocket        *uint32 `json:"coresPerSocket,omitempty"`
+	DedicatedCPUPlacement *bool   `json:"dedicatedCPUPlacement,omitempty"`
+}
+
+// CPUApplyConfiguration constructs an declarative configuration of the CPU type for use with
+// apply.
+func CPU() *CPUApplyConfiguration {
+	return &CPUApplyConfiguration{}
+}
+
+// WithSockets sets the Sockets field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Sockets field is set to the value of the last call.
+func (b *CPUApplyConfiguration) WithSockets(value uint32) *CPUApplyConfiguration {
+	b.Sockets = &value
+	return b
+}
+
+// WithCoresPerSocket sets the CoresPerSocket field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CoresPerSocket field is set to the value of the last call.
+func (b *CPUApplyConfiguration) WithCoresPerSocket(value uint32) *CPUApplyConfiguration {
+	b.CoresPerSocket = &value
+	return b
+}
+
+// WithDedicatedCPUPlacement sets the DedicatedCPUPlacement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DedicatedCPUPlacement field is set to the value of the last call.
+func (b *CPUApplyConfiguration) WithDedicatedCPUPlacement(value bool) *CPUApplyConfiguration {
+	b.DedicatedCPUPlacement = &value
+	return b
+}
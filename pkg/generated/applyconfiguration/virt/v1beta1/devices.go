@@ -0,0 +1,68 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// DevicesApplyConfiguration represents an declarative configuration of the Devices type for use
+// with apply.
+type DevicesApplyConfiguration struct {
+	Display        *v1beta1.Display                  `json:"display,omitempty"`
+	USB            *v1beta1.USBController            `json:"usb,omitempty"`
+	Tablet         *v1beta1.Tablet                   `json:"tablet,omitempty"`
+	Sound          *v1beta1.Sound                    `json:"sound,omitempty"`
+	HostUSBDevices []HostUSBDeviceApplyConfiguration `json:"hostUSBDevices,omitempty"`
+}
+
+// DevicesApplyConfiguration constructs an declarative configuration of the Devices type for use with
+// apply.
+func Devices() *DevicesApplyConfiguration {
+	return &DevicesApplyConfiguration{}
+}
+
+// WithDisplay sets the Display field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Display field is set to the value of the last call.
+func (b *DevicesApplyConfiguration) WithDisplay(value v1beta1.Display) *DevicesApplyConfiguration {
+	b.Display = &value
+	return b
+}
+
+// WithUSB sets the USB field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the USB field is set to the value of the last call.
+func (b *DevicesApplyConfiguration) WithUSB(value v1beta1.USBController) *DevicesApplyConfiguration {
+	b.USB = &value
+	return b
+}
+
+// WithTablet sets the Tablet field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tablet field is set to the value of the last call.
+func (b *DevicesApplyConfiguration) WithTablet(value v1beta1.Tablet) *DevicesApplyConfiguration {
+	b.Tablet = &value
+	return b
+}
+
+// WithSound sets the Sound field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Sound field is set to the value of the last call.
+func (b *DevicesApplyConfiguration) WithSound(value v1beta1.Sound) *DevicesApplyConfiguration {
+	b.Sound = &value
+	return b
+}
+
+// WithHostUSBDevices adds the given value to the HostUSBDevices field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the HostUSBDevices field.
+func (b *DevicesApplyConfiguration) WithHostUSBDevices(values ...*HostUSBDeviceApplyConfiguration) *DevicesApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithHostUSBDevices")
+		}
+		b.HostUSBDevices = append(b.HostUSBDevices, *values[i])
+	}
+	return b
+}
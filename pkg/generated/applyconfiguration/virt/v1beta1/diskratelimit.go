@@ -0,0 +1,54 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// DiskRateLimitApplyConfiguration represents an declarative configuration of the DiskRateLimit type for use
+// with apply.
+type DiskRateLimitApplyConfiguration struct {
+	Bandwidth      *resource.Quantity `json:"bandwidth,omitempty"`
+	BandwidthBurst *resource.Quantity `json:"bandwidthBurst,omitempty"`
+	IOPS           *int64             `json:"iops,omitempty"`
+	IOPSBurst      *int64             `json:"iopsBurst,omitempty"`
+}
+
+// DiskRateLimitApplyConfiguration constructs an declarative configuration of the DiskRateLimit type for use with
+// apply.
+func DiskRateLimit() *DiskRateLimitApplyConfiguration {
+	return &DiskRateLimitApplyConfiguration{}
+}
+
+// WithBandwidth sets the Bandwidth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Bandwidth field is set to the value of the last call.
+func (b *DiskRateLimitApplyConfiguration) WithBandwidth(value resource.Quantity) *DiskRateLimitApplyConfiguration {
+	b.Bandwidth = &value
+	return b
+}
+
+// WithBandwidthBurst sets the BandwidthBurst field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BandwidthBurst field is set to the value of the last call.
+func (b *DiskRateLimitApplyConfiguration) WithBandwidthBurst(value resource.Quantity) *DiskRateLimitApplyConfiguration {
+	b.BandwidthBurst = &value
+	return b
+}
+
+// WithIOPS sets the IOPS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IOPS field is set to the value of the last call.
+func (b *DiskRateLimitApplyConfiguration) WithIOPS(value int64) *DiskRateLimitApplyConfiguration {
+	b.IOPS = &value
+	return b
+}
+
+// WithIOPSBurst sets the IOPSBurst field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IOPSBurst field is set to the value of the last call.
+func (b *DiskRateLimitApplyConfiguration) WithIOPSBurst(value int64) *DiskRateLimitApplyConfiguration {
+	b.IOPSBurst = &value
+	return b
+}
@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// DownwardApplyConfiguration represents an declarative configuration of the Downward type for use
+// with apply.
+type DownwardApplyConfiguration struct {
+	SMBIOS      *bool   `json:"smbios,omitempty"`
+	MetricsPort *uint32 `json:"metricsPort,omitempty"`
+}
+
+// DownwardApplyConfiguration constructs an declarative configuration of the Downward type for use with
+// apply.
+func Downward() *DownwardApplyConfiguration {
+	return &DownwardApplyConfiguration{}
+}
+
+// WithSMBIOS sets the SMBIOS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SMBIOS field is set to the value of the last call.
+func (b *DownwardApplyConfiguration) WithSMBIOS(value bool) *DownwardApplyConfiguration {
+	b.SMBIOS = &value
+	return b
+}
+
+// WithMetricsPort sets the MetricsPort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MetricsPort field is set to the value of the last call.
+func (b *DownwardApplyConfiguration) WithMetricsPort(value uint32) *DownwardApplyConfiguration {
+	b.MetricsPort = &value
+	return b
+}
@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// HTTPDiskVolumeSourceApplyConfiguration represents an declarative configuration of the HTTPDiskVolumeSource type for use
+// with apply.
+type HTTPDiskVolumeSourceApplyConfiguration struct {
+	URL      *string `json:"url,omitempty"`
+	Checksum *string `json:"checksum,omitempty"`
+}
+
+// HTTPDiskVolumeSourceApplyConfiguration constructs an declarative configuration of the HTTPDiskVolumeSource type for use with
+// apply.
+func HTTPDiskVolumeSource() *HTTPDiskVolumeSourceApplyConfiguration {
+	return &HTTPDiskVolumeSourceApplyConfiguration{}
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *HTTPDiskVolumeSourceApplyConfiguration) WithURL(value string) *HTTPDiskVolumeSourceApplyConfiguration {
+	b.URL = &value
+	return b
+}
+
+// WithChecksum sets the Checksum field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Checksum field is set to the value of the last call.
+func (b *HTTPDiskVolumeSourceApplyConfiguration) WithChecksum(value string) *HTTPDiskVolumeSourceApplyConfiguration {
+	b.Checksum = &value
+	return b
+}
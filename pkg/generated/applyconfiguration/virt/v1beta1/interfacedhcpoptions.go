@@ -0,0 +1,47 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// InterfaceDHCPOptionsApplyConfiguration represents an declarative configuration of the InterfaceDHCPOptions type for use
+// with apply.
+type InterfaceDHCPOptionsApplyConfiguration struct {
+	DNSServers    []string `json:"dnsServers,omitempty"`
+	SearchDomains []string `json:"searchDomains,omitempty"`
+	NTPServers    []string `json:"ntpServers,omitempty"`
+}
+
+// InterfaceDHCPOptionsApplyConfiguration constructs an declarative configuration of the InterfaceDHCPOptions type for use with
+// apply.
+func InterfaceDHCPOptions() *InterfaceDHCPOptionsApplyConfiguration {
+	return &InterfaceDHCPOptionsApplyConfiguration{}
+}
+
+// WithDNSServers adds the given value to the DNSServers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DNSServers field.
+func (b *InterfaceDHCPOptionsApplyConfiguration) WithDNSServers(values ...string) *InterfaceDHCPOptionsApplyConfiguration {
+	for i := range values {
+		b.DNSServers = append(b.DNSServers, values[i])
+	}
+	return b
+}
+
+// WithSearchDomains adds the given value to the SearchDomains field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SearchDomains field.
+func (b *InterfaceDHCPOptionsApplyConfiguration) WithSearchDomains(values ...string) *InterfaceDHCPOptionsApplyConfiguration {
+	for i := range values {
+		b.SearchDomains = append(b.SearchDomains, values[i])
+	}
+	return b
+}
+
+// WithNTPServers adds the given value to the NTPServers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NTPServers field.
+func (b *InterfaceDHCPOptionsApplyConfiguration) WithNTPServers(values ...string) *InterfaceDHCPOptionsApplyConfiguration {
+	for i := range values {
+		b.NTPServers = append(b.NTPServers, values[i])
+	}
+	return b
+}
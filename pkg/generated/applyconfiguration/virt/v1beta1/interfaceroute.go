@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// InterfaceRouteApplyConfiguration represents an declarative configuration of the InterfaceRoute type for use
+// with apply.
+type InterfaceRouteApplyConfiguration struct {
+	To     *string `json:"to,omitempty"`
+	Via    *string `json:"via,omitempty"`
+	Metric *uint32 `json:"metric,omitempty"`
+}
+
+// InterfaceRouteApplyConfiguration constructs an declarative configuration of the InterfaceRoute type for use with
+// apply.
+func InterfaceRoute() *InterfaceRouteApplyConfiguration {
+	return &InterfaceRouteApplyConfiguration{}
+}
+
+// WithTo sets the To field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the To field is set to the value of the last call.
+func (b *InterfaceRouteApplyConfiguration) WithTo(value string) *InterfaceRouteApplyConfiguration {
+	b.To = &value
+	return b
+}
+
+// WithVia sets the Via field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Via field is set to the value of the last call.
+func (b *InterfaceRouteApplyConfiguration) WithVia(value string) *InterfaceRouteApplyConfiguration {
+	b.Via = &value
+	return b
+}
+
+// WithMetric sets the Metric field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Metric field is set to the value of the last call.
+func (b *InterfaceRouteApplyConfiguration) WithMetric(value uint32) *InterfaceRouteApplyConfiguration {
+	b.Metric = &value
+	return b
+}
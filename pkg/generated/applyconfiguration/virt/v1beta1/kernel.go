@@ -0,0 +1,45 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// KernelApplyConfiguration represents an declarative configuration of the Kernel type for use
+// with apply.
+type KernelApplyConfiguration struct {
+	Image           *string        `json:"image,omitempty"`
+	ImagePullPolicy *v1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	Cmdline         *string        `json:"cmdline,omitempty"`
+}
+
+// KernelApplyConfiguration constructs an declarative configuration of the Kernel type for use with
+// apply.
+func Kernel() *KernelApplyConfiguration {
+	return &KernelApplyConfiguration{}
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *KernelApplyConfiguration) WithImage(value string) *KernelApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithImagePullPolicy sets the ImagePullPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImagePullPolicy field is set to the value of the last call.
+func (b *KernelApplyConfiguration) WithImagePullPolicy(value v1.PullPolicy) *KernelApplyConfiguration {
+	b.ImagePullPolicy = &value
+	return b
+}
+
+// WithCmdline sets the Cmdline field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cmdline field is set to the value of the last call.
+func (b *KernelApplyConfiguration) WithCmdline(value string) *KernelApplyConfiguration {
+	b.Cmdline = &value
+	return b
+}
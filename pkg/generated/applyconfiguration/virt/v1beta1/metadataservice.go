@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// MetadataServiceApplyConfiguration represents an declarative configuration of the MetadataService type for use
+// with apply.
+type MetadataServiceApplyConfiguration struct {
+	UserData           *string `json:"userData,omitempty"`
+	UserDataBase64     *string `json:"userDataBase64,omitempty"`
+	UserDataSecretName *string `json:"userDataSecretName,omitempty"`
+}
+
+// MetadataServiceApplyConfiguration constructs an declarative configuration of the MetadataService type for use with
+// apply.
+func MetadataService() *MetadataServiceApplyConfiguration {
+	return &MetadataServiceApplyConfiguration{}
+}
+
+// WithUserData sets the UserData field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UserData field is set to the value of the last call.
+func (b *MetadataServiceApplyConfiguration) WithUserData(value string) *MetadataServiceApplyConfiguration {
+	b.UserData = &value
+	return b
+}
+
+// WithUserDataBase64 sets the UserDataBase64 field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UserDataBase64 field is set to the value of the last call.
+func (b *MetadataServiceApplyConfiguration) WithUserDataBase64(value string) *MetadataServiceApplyConfiguration {
+	b.UserDataBase64 = &value
+	return b
+}
+
+// WithUserDataSecretName sets the UserDataSecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UserDataSecretName field is set to the value of the last call.
+func (b *MetadataServiceApplyConfiguration) WithUserDataSecretName(value string) *MetadataServiceApplyConfiguration {
+	b.UserDataSecretName = &value
+	return b
+}
@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// NetworkSourceApplyConfiguration represents an declarative configuration of the NetworkSource type for use
+// with apply.
+type NetworkSourceApplyConfiguration struct {
+	Pod    *v1beta1.PodNetworkSource              `json:"pod,omitempty"`
+	Multus *MultusNetworkSourceApplyConfiguration `json:"multus,omitempty"`
+}
+
+// NetworkSourceApplyConfiguration constructs an declarative configuration of the NetworkSource type for use with
+// apply.
+func NetworkSource() *NetworkSourceApplyConfiguration {
+	return &NetworkSourceApplyConfiguration{}
+}
+
+// WithPod sets the Pod field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Pod field is set to the value of the last call.
+func (b *NetworkSourceApplyConfiguration) WithPod(value v1beta1.PodNetworkSource) *NetworkSourceApplyConfiguration {
+	b.Pod = &value
+	return b
+}
+
+// WithMultus sets the Multus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Multus field is set to the value of the last call.
+func (b *NetworkSourceApplyConfiguration) WithMultus(value *MultusNetworkSourceApplyConfiguration) *NetworkSourceApplyConfiguration {
+	b.Multus = value
+	return b
+}
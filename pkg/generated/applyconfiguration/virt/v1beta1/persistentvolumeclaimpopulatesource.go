@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// PersistentVolumeClaimPopulateSourceApplyConfiguration represents an declarative configuration of the PersistentVolumeClaimPopulateSource type for use
+// with apply.
+type PersistentVolumeClaimPopulateSourceApplyConfiguration struct {
+	ContainerDisk *ContainerDiskVolumeSourceApplyConfiguration `json:"containerDisk,omitempty"`
+	HTTPDisk      *HTTPDiskVolumeSourceApplyConfiguration      `json:"httpDisk,omitempty"`
+	Grow          *bool                                        `json:"grow,omitempty"`
+}
+
+// PersistentVolumeClaimPopulateSourceApplyConfiguration constructs an declarative configuration of the PersistentVolumeClaimPopulateSource type for use with
+// apply.
+func PersistentVolumeClaimPopulateSource() *PersistentVolumeClaimPopulateSourceApplyConfiguration {
+	return &PersistentVolumeClaimPopulateSourceApplyConfiguration{}
+}
+
+// WithContainerDisk sets the ContainerDisk field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ContainerDisk field is set to the value of the last call.
+func (b *PersistentVolumeClaimPopulateSourceApplyConfiguration) WithContainerDisk(value *ContainerDiskVolumeSourceApplyConfiguration) *PersistentVolumeClaimPopulateSourceApplyConfiguration {
+	b.ContainerDisk = value
+	return b
+}
+
+// WithHTTPDisk sets the HTTPDisk field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HTTPDisk field is set to the value of the last call.
+func (b *PersistentVolumeClaimPopulateSourceApplyConfiguration) WithHTTPDisk(value *HTTPDiskVolumeSourceApplyConfiguration) *PersistentVolumeClaimPopulateSourceApplyConfiguration {
+	b.HTTPDisk = value
+	return b
+}
+
+// WithGrow sets the Grow field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Grow field is set to the value of the last call.
+func (b *PersistentVolumeClaimPopulateSourceApplyConfiguration) WithGrow(value bool) *PersistentVolumeClaimPopulateSourceApplyConfiguration {
+	b.Grow = &value
+	return b
+}
@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// PersistentVolumeClaimVolumeSourceApplyConfiguration represents an declarative configuration of the PersistentVolumeClaimVolumeSource type for use
+// with apply.
+type PersistentVolumeClaimVolumeSourceApplyConfiguration struct {
+	ClaimName *string                                                `json:"claimName,omitempty"`
+	Populate  *PersistentVolumeClaimPopulateSourceApplyConfiguration `json:"populate,omitempty"`
+}
+
+// PersistentVolumeClaimVolumeSourceApplyConfiguration constructs an declarative configuration of the PersistentVolumeClaimVolumeSource type for use with
+// apply.
+func PersistentVolumeClaimVolumeSource() *PersistentVolumeClaimVolumeSourceApplyConfiguration {
+	return &PersistentVolumeClaimVolumeSourceApplyConfiguration{}
+}
+
+// WithClaimName sets the ClaimName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClaimName field is set to the value of the last call.
+func (b *PersistentVolumeClaimVolumeSourceApplyConfiguration) WithClaimName(value string) *PersistentVolumeClaimVolumeSourceApplyConfiguration {
+	b.ClaimName = &value
+	return b
+}
+
+// WithPopulate sets the Populate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Populate field is set to the value of the last call.
+func (b *PersistentVolumeClaimVolumeSourceApplyConfiguration) WithPopulate(value *PersistentVolumeClaimPopulateSourceApplyConfiguration) *PersistentVolumeClaimVolumeSourceApplyConfiguration {
+	b.Populate = value
+	return b
+}
@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// RNGApplyConfiguration represents an declarative configuration of the RNG type for use
+// with apply.
+type RNGApplyConfiguration struct {
+	Source   *string `json:"source,omitempty"`
+	Disabled *bool   `json:"disabled,omitempty"`
+}
+
+// RNGApplyConfiguration constructs an declarative configuration of the RNG type for use with
+// apply.
+func RNG() *RNGApplyConfiguration {
+	return &RNGApplyConfiguration{}
+}
+
+// WithSource sets the Source field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Source field is set to the value of the last call.
+func (b *RNGApplyConfiguration) WithSource(value string) *RNGApplyConfiguration {
+	b.Source = &value
+	return b
+}
+
+// WithDisabled sets the Disabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Disabled field is set to the value of the last call.
+func (b *RNGApplyConfiguration) WithDisabled(value bool) *RNGApplyConfiguration {
+	b.Disabled = &value
+	return b
+}
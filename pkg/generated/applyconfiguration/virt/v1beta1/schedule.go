@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// ScheduleApplyConfiguration represents an declarative configuration of the Schedule type for use
+// with apply.
+type ScheduleApplyConfiguration struct {
+	Start    *string `json:"start,omitempty"`
+	Stop     *string `json:"stop,omitempty"`
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// ScheduleApplyConfiguration constructs an declarative configuration of the Schedule type for use with
+// apply.
+func Schedule() *ScheduleApplyConfiguration {
+	return &ScheduleApplyConfiguration{}
+}
+
+// WithStart sets the Start field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Start field is set to the value of the last call.
+func (b *ScheduleApplyConfiguration) WithStart(value string) *ScheduleApplyConfiguration {
+	b.Start = &value
+	return b
+}
+
+// WithStop sets the Stop field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Stop field is set to the value of the last call.
+func (b *ScheduleApplyConfiguration) WithStop(value string) *ScheduleApplyConfiguration {
+	b.Stop = &value
+	return b
+}
+
+// WithTimeZone sets the TimeZone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeZone field is set to the value of the last call.
+func (b *ScheduleApplyConfiguration) WithTimeZone(value string) *ScheduleApplyConfiguration {
+	b.TimeZone = &value
+	return b
+}
@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// SecurityGroupPortRangeApplyConfiguration represents an declarative configuration of the SecurityGroupPortRange type for use
+// with apply.
+type SecurityGroupPortRangeApplyConfiguration struct {
+	Port    *int32 `json:"port,omitempty"`
+	EndPort *int32 `json:"endPort,omitempty"`
+}
+
+// SecurityGroupPortRangeApplyConfiguration constructs an declarative configuration of the SecurityGroupPortRange type for use with
+// apply.
+func SecurityGroupPortRange() *SecurityGroupPortRangeApplyConfiguration {
+	return &SecurityGroupPortRangeApplyConfiguration{}
+}
+
+// WithPort sets the Port field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Port field is set to the value of the last call.
+func (b *SecurityGroupPortRangeApplyConfiguration) WithPort(value int32) *SecurityGroupPortRangeApplyConfiguration {
+	b.Port = &value
+	return b
+}
+
+// WithEndPort sets the EndPort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EndPort field is set to the value of the last call.
+func (b *SecurityGroupPortRangeApplyConfiguration) WithEndPort(value int32) *SecurityGroupPortRangeApplyConfiguration {
+	b.EndPort = &value
+	return b
+}
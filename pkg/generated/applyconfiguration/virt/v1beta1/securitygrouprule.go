@@ -0,0 +1,52 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// SecurityGroupRuleApplyConfiguration represents an declarative configuration of the SecurityGroupRule type for use
+// with apply.
+type SecurityGroupRuleApplyConfiguration struct {
+	Protocol *v1beta1.SecurityGroupProtocol             `json:"protocol,omitempty"`
+	Ports    []SecurityGroupPortRangeApplyConfiguration `json:"ports,omitempty"`
+	CIDRs    []string                                   `json:"cidrs,omitempty"`
+}
+
+// SecurityGroupRuleApplyConfiguration constructs an declarative configuration of the SecurityGroupRule type for use with
+// apply.
+func SecurityGroupRule() *SecurityGroupRuleApplyConfiguration {
+	return &SecurityGroupRuleApplyConfiguration{}
+}
+
+// WithProtocol sets the Protocol field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Protocol field is set to the value of the last call.
+func (b *SecurityGroupRuleApplyConfiguration) WithProtocol(value v1beta1.SecurityGroupProtocol) *SecurityGroupRuleApplyConfiguration {
+	b.Protocol = &value
+	return b
+}
+
+// WithPorts adds the given value to the Ports field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Ports field.
+func (b *SecurityGroupRuleApplyConfiguration) WithPorts(values ...*SecurityGroupPortRangeApplyConfiguration) *SecurityGroupRuleApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPorts")
+		}
+		b.Ports = append(b.Ports, *values[i])
+	}
+	return b
+}
+
+// WithCIDRs adds the given value to the CIDRs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the CIDRs field.
+func (b *SecurityGroupRuleApplyConfiguration) WithCIDRs(values ...string) *SecurityGroupRuleApplyConfiguration {
+	for i := range values {
+		b.CIDRs = append(b.CIDRs, values[i])
+	}
+	return b
+}
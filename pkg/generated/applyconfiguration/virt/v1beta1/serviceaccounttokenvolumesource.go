@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// ServiceAccountTokenVolumeSourceApplyConfiguration represents an declarative configuration of the ServiceAccountTokenVolumeSource type for use
+// with apply.
+type ServiceAccountTokenVolumeSourceApplyConfiguration struct {
+	ServiceAccountName *string `json:"serviceAccountName,omitempty"`
+	Audience           *string `json:"audience,omitempty"`
+	ExpirationSeconds  *int64  `json:"expirationSeconds,omitempty"`
+}
+
+// ServiceAccountTokenVolumeSourceApplyConfiguration constructs an declarative configuration of the ServiceAccountTokenVolumeSource type for use with
+// apply.
+func ServiceAccountTokenVolumeSource() *ServiceAccountTokenVolumeSourceApplyConfiguration {
+	return &ServiceAccountTokenVolumeSourceApplyConfiguration{}
+}
+
+// WithServiceAccountName sets the ServiceAccountName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountName field is set to the value of the last call.
+func (b *ServiceAccountTokenVolumeSourceApplyConfiguration) WithServiceAccountName(value string) *ServiceAccountTokenVolumeSourceApplyConfiguration {
+	b.ServiceAccountName = &value
+	return b
+}
+
+// WithAudience sets the Audience field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Audience field is set to the value of the last call.
+func (b *ServiceAccountTokenVolumeSourceApplyConfiguration) WithAudience(value string) *ServiceAccountTokenVolumeSourceApplyConfiguration {
+	b.Audience = &value
+	return b
+}
+
+// WithExpirationSeconds sets the ExpirationSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExpirationSeconds field is set to the value of the last call.
+func (b *ServiceAccountTokenVolumeSourceApplyConfiguration) WithExpirationSeconds(value int64) *ServiceAccountTokenVolumeSourceApplyConfiguration {
+	b.ExpirationSeconds = &value
+	return b
+}
@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// SysprepVolumeSourceApplyConfiguration represents an declarative configuration of the SysprepVolumeSource type for use
+// with apply.
+type SysprepVolumeSourceApplyConfiguration struct {
+	ConfigMapName *string `json:"configMapName,omitempty"`
+	SecretName    *string `json:"secretName,omitempty"`
+}
+
+// SysprepVolumeSourceApplyConfiguration constructs an declarative configuration of the SysprepVolumeSource type for use with
+// apply.
+func SysprepVolumeSource() *SysprepVolumeSourceApplyConfiguration {
+	return &SysprepVolumeSourceApplyConfiguration{}
+}
+
+// WithConfigMapName sets the ConfigMapName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMapName field is set to the value of the last call.
+func (b *SysprepVolumeSourceApplyConfiguration) WithConfigMapName(value string) *SysprepVolumeSourceApplyConfiguration {
+	b.ConfigMapName = &value
+	return b
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *SysprepVolumeSourceApplyConfiguration) WithSecretName(value string) *SysprepVolumeSourceApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
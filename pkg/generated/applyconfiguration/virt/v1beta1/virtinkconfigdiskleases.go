@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtinkConfigDiskLeasesApplyConfiguration represents an declarative configuration of the VirtinkConfigDiskLeases type for use
+// with apply.
+type VirtinkConfigDiskLeasesApplyConfiguration struct {
+	Enabled         *bool `json:"enabled,omitempty"`
+	DurationSeconds *int  `json:"durationSeconds,omitempty"`
+}
+
+// VirtinkConfigDiskLeasesApplyConfiguration constructs an declarative configuration of the VirtinkConfigDiskLeases type for use with
+// apply.
+func VirtinkConfigDiskLeases() *VirtinkConfigDiskLeasesApplyConfiguration {
+	return &VirtinkConfigDiskLeasesApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *VirtinkConfigDiskLeasesApplyConfiguration) WithEnabled(value bool) *VirtinkConfigDiskLeasesApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithDurationSeconds sets the DurationSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DurationSeconds field is set to the value of the last call.
+func (b *VirtinkConfigDiskLeasesApplyConfiguration) WithDurationSeconds(value int) *VirtinkConfigDiskLeasesApplyConfiguration {
+	b.DurationSeconds = &value
+	return b
+}
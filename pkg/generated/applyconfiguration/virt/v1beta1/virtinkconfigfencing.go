@@ -0,0 +1,45 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// VirtinkConfigFencingApplyConfiguration represents an declarative configuration of the VirtinkConfigFencing type for use
+// with apply.
+type VirtinkConfigFencingApplyConfiguration struct {
+	Mode                *v1beta1.FencingMode                           `json:"mode,omitempty"`
+	NodeNotReadySeconds *int                                           `json:"nodeNotReadySeconds,omitempty"`
+	Webhook             *VirtinkConfigFencingWebhookApplyConfiguration `json:"webhook,omitempty"`
+}
+
+// VirtinkConfigFencingApplyConfiguration constructs an declarative configuration of the VirtinkConfigFencing type for use with
+// apply.
+func VirtinkConfigFencing() *VirtinkConfigFencingApplyConfiguration {
+	return &VirtinkConfigFencingApplyConfiguration{}
+}
+
+// WithMode sets the Mode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mode field is set to the value of the last call.
+func (b *VirtinkConfigFencingApplyConfiguration) WithMode(value v1beta1.FencingMode) *VirtinkConfigFencingApplyConfiguration {
+	b.Mode = &value
+	return b
+}
+
+// WithNodeNotReadySeconds sets the NodeNotReadySeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeNotReadySeconds field is set to the value of the last call.
+func (b *VirtinkConfigFencingApplyConfiguration) WithNodeNotReadySeconds(value int) *VirtinkConfigFencingApplyConfiguration {
+	b.NodeNotReadySeconds = &value
+	return b
+}
+
+// WithWebhook sets the Webhook field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Webhook field is set to the value of the last call.
+func (b *VirtinkConfigFencingApplyConfiguration) WithWebhook(value *VirtinkConfigFencingWebhookApplyConfiguration) *VirtinkConfigFencingApplyConfiguration {
+	b.Webhook = value
+	return b
+}
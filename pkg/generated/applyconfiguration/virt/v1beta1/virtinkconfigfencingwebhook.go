@@ -0,0 +1,43 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtinkConfigFencingWebhookApplyConfiguration represents an declarative configuration of the VirtinkConfigFencingWebhook type for use
+// with apply.
+type VirtinkConfigFencingWebhookApplyConfiguration struct {
+	URL            *string `json:"url,omitempty"`
+	CABundle       []byte  `json:"caBundle,omitempty"`
+	TimeoutSeconds *int    `json:"timeoutSeconds,omitempty"`
+}
+
+// VirtinkConfigFencingWebhookApplyConfiguration constructs an declarative configuration of the VirtinkConfigFencingWebhook type for use with
+// apply.
+func VirtinkConfigFencingWebhook() *VirtinkConfigFencingWebhookApplyConfiguration {
+	return &VirtinkConfigFencingWebhookApplyConfiguration{}
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *VirtinkConfigFencingWebhookApplyConfiguration) WithURL(value string) *VirtinkConfigFencingWebhookApplyConfiguration {
+	b.URL = &value
+	return b
+}
+
+// WithCABundle adds the given value to the CABundle field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the CABundle field.
+func (b *VirtinkConfigFencingWebhookApplyConfiguration) WithCABundle(values ...byte) *VirtinkConfigFencingWebhookApplyConfiguration {
+	for i := range values {
+		b.CABundle = append(b.CABundle, values[i])
+	}
+	return b
+}
+
+// WithTimeoutSeconds sets the TimeoutSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeoutSeconds field is set to the value of the last call.
+func (b *VirtinkConfigFencingWebhookApplyConfiguration) WithTimeoutSeconds(value int) *VirtinkConfigFencingWebhookApplyConfiguration {
+	b.TimeoutSeconds = &value
+	return b
+}
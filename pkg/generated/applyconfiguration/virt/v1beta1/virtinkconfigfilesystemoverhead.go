@@ -0,0 +1,38 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtinkConfigFilesystemOverheadApplyConfiguration represents an declarative configuration of the VirtinkConfigFilesystemOverhead type for use
+// with apply.
+type VirtinkConfigFilesystemOverheadApplyConfiguration struct {
+	Global         *string           `json:"global,omitempty"`
+	StorageClasses map[string]string `json:"storageClasses,omitempty"`
+}
+
+// VirtinkConfigFilesystemOverheadApplyConfiguration constructs an declarative configuration of the VirtinkConfigFilesystemOverhead type for use with
+// apply.
+func VirtinkConfigFilesystemOverhead() *VirtinkConfigFilesystemOverheadApplyConfiguration {
+	return &VirtinkConfigFilesystemOverheadApplyConfiguration{}
+}
+
+// WithGlobal sets the Global field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Global field is set to the value of the last call.
+func (b *VirtinkConfigFilesystemOverheadApplyConfiguration) WithGlobal(value string) *VirtinkConfigFilesystemOverheadApplyConfiguration {
+	b.Global = &value
+	return b
+}
+
+// WithStorageClasses puts the entries into the StorageClasses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the StorageClasses field,
+// overwriting an existing map entries in StorageClasses field with the same key.
+func (b *VirtinkConfigFilesystemOverheadApplyConfiguration) WithStorageClasses(entries map[string]string) *VirtinkConfigFilesystemOverheadApplyConfiguration {
+	if b.StorageClasses == nil && len(entries) > 0 {
+		b.StorageClasses = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.StorageClasses[k] = v
+	}
+	return b
+}
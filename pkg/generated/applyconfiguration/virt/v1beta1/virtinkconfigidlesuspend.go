@@ -0,0 +1,45 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// VirtinkConfigIdleSuspendApplyConfiguration represents an declarative configuration of the VirtinkConfigIdleSuspend type for use
+// with apply.
+type VirtinkConfigIdleSuspendApplyConfiguration struct {
+	Action              *v1beta1.IdleSuspendAction `json:"action,omitempty"`
+	CPUThresholdPercent *int                       `json:"cpuThresholdPercent,omitempty"`
+	IdleMinutes         *int                       `json:"idleMinutes,omitempty"`
+}
+
+// VirtinkConfigIdleSuspendApplyConfiguration constructs an declarative configuration of the VirtinkConfigIdleSuspend type for use with
+// apply.
+func VirtinkConfigIdleSuspend() *VirtinkConfigIdleSuspendApplyConfiguration {
+	return &VirtinkConfigIdleSuspendApplyConfiguration{}
+}
+
+// WithAction sets the Action field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Action field is set to the value of the last call.
+func (b *VirtinkConfigIdleSuspendApplyConfiguration) WithAction(value v1beta1.IdleSuspendAction) *VirtinkConfigIdleSuspendApplyConfiguration {
+	b.Action = &value
+	return b
+}
+
+// WithCPUThresholdPercent sets the CPUThresholdPercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CPUThresholdPercent field is set to the value of the last call.
+func (b *VirtinkConfigIdleSuspendApplyConfiguration) WithCPUThresholdPercent(value int) *VirtinkConfigIdleSuspendApplyConfiguration {
+	b.CPUThresholdPercent = &value
+	return b
+}
+
+// WithIdleMinutes sets the IdleMinutes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IdleMinutes field is set to the value of the last call.
+func (b *VirtinkConfigIdleSuspendApplyConfiguration) WithIdleMinutes(value int) *VirtinkConfigIdleSuspendApplyConfiguration {
+	b.IdleMinutes = &value
+	return b
+}
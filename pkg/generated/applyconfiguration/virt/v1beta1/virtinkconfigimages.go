@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtinkConfigImagesApplyConfiguration represents an declarative configuration of the VirtinkConfigImages type for use
+// with apply.
+type VirtinkConfigImagesApplyConfiguration struct {
+	Prerunner *string `json:"prerunner,omitempty"`
+	Exporter  *string `json:"exporter,omitempty"`
+}
+
+// VirtinkConfigImagesApplyConfiguration constructs an declarative configuration of the VirtinkConfigImages type for use with
+// apply.
+func VirtinkConfigImages() *VirtinkConfigImagesApplyConfiguration {
+	return &VirtinkConfigImagesApplyConfiguration{}
+}
+
+// WithPrerunner sets the Prerunner field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Prerunner field is set to the value of the last call.
+func (b *VirtinkConfigImagesApplyConfiguration) WithPrerunner(value string) *VirtinkConfigImagesApplyConfiguration {
+	b.Prerunner = &value
+	return b
+}
+
+// WithExporter sets the Exporter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Exporter field is set to the value of the last call.
+func (b *VirtinkConfigImagesApplyConfiguration) WithExporter(value string) *VirtinkConfigImagesApplyConfiguration {
+	b.Exporter = &value
+	return b
+}
@@ -0,0 +1,64 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtinkConfigKSMApplyConfiguration represents an declarative configuration of the VirtinkConfigKSM type for use
+// with apply.
+type VirtinkConfigKSMApplyConfiguration struct {
+	Policy                   *v1beta1.KSMPolicy `json:"policy,omitempty"`
+	NodeSelector             *v1.LabelSelector  `json:"nodeSelector,omitempty"`
+	PagesToScan              *int               `json:"pagesToScan,omitempty"`
+	SleepMilliseconds        *int               `json:"sleepMilliseconds,omitempty"`
+	PressureThresholdPercent *int               `json:"pressureThresholdPercent,omitempty"`
+}
+
+// VirtinkConfigKSMApplyConfiguration constructs an declarative configuration of the VirtinkConfigKSM type for use with
+// apply.
+func VirtinkConfigKSM() *VirtinkConfigKSMApplyConfiguration {
+	return &VirtinkConfigKSMApplyConfiguration{}
+}
+
+// WithPolicy sets the Policy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Policy field is set to the value of the last call.
+func (b *VirtinkConfigKSMApplyConfiguration) WithPolicy(value v1beta1.KSMPolicy) *VirtinkConfigKSMApplyConfiguration {
+	b.Policy = &value
+	return b
+}
+
+// WithNodeSelector sets the NodeSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeSelector field is set to the value of the last call.
+func (b *VirtinkConfigKSMApplyConfiguration) WithNodeSelector(value v1.LabelSelector) *VirtinkConfigKSMApplyConfiguration {
+	b.NodeSelector = &value
+	return b
+}
+
+// WithPagesToScan sets the PagesToScan field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PagesToScan field is set to the value of the last call.
+func (b *VirtinkConfigKSMApplyConfiguration) WithPagesToScan(value int) *VirtinkConfigKSMApplyConfiguration {
+	b.PagesToScan = &value
+	return b
+}
+
+// WithSleepMilliseconds sets the SleepMilliseconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SleepMilliseconds field is set to the value of the last call.
+func (b *VirtinkConfigKSMApplyConfiguration) WithSleepMilliseconds(value int) *VirtinkConfigKSMApplyConfiguration {
+	b.SleepMilliseconds = &value
+	return b
+}
+
+// WithPressureThresholdPercent sets the PressureThresholdPercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PressureThresholdPercent field is set to the value of the last call.
+func (b *VirtinkConfigKSMApplyConfiguration) WithPressureThresholdPercent(value int) *VirtinkConfigKSMApplyConfiguration {
+	b.PressureThresholdPercent = &value
+	return b
+}
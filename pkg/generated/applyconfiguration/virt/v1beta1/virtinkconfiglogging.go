@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtinkConfigLoggingApplyConfiguration represents an declarative configuration of the VirtinkConfigLogging type for use
+// with apply.
+type VirtinkConfigLoggingApplyConfiguration struct {
+	Level  *string `json:"level,omitempty"`
+	Format *string `json:"format,omitempty"`
+}
+
+// VirtinkConfigLoggingApplyConfiguration constructs an declarative configuration of the VirtinkConfigLogging type for use with
+// apply.
+func VirtinkConfigLogging() *VirtinkConfigLoggingApplyConfiguration {
+	return &VirtinkConfigLoggingApplyConfiguration{}
+}
+
+// WithLevel sets the Level field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Level field is set to the value of the last call.
+func (b *VirtinkConfigLoggingApplyConfiguration) WithLevel(value string) *VirtinkConfigLoggingApplyConfiguration {
+	b.Level = &value
+	return b
+}
+
+// WithFormat sets the Format field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Format field is set to the value of the last call.
+func (b *VirtinkConfigLoggingApplyConfiguration) WithFormat(value string) *VirtinkConfigLoggingApplyConfiguration {
+	b.Format = &value
+	return b
+}
@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtinkConfigMemoryOvercommitApplyConfiguration represents an declarative configuration of the VirtinkConfigMemoryOvercommit type for use
+// with apply.
+type VirtinkConfigMemoryOvercommitApplyConfiguration struct {
+	Percent                  *int `json:"percent,omitempty"`
+	PressureThresholdPercent *int `json:"pressureThresholdPercent,omitempty"`
+}
+
+// VirtinkConfigMemoryOvercommitApplyConfiguration constructs an declarative configuration of the VirtinkConfigMemoryOvercommit type for use with
+// apply.
+func VirtinkConfigMemoryOvercommit() *VirtinkConfigMemoryOvercommitApplyConfiguration {
+	return &VirtinkConfigMemoryOvercommitApplyConfiguration{}
+}
+
+// WithPercent sets the Percent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Percent field is set to the value of the last call.
+func (b *VirtinkConfigMemoryOvercommitApplyConfiguration) WithPercent(value int) *VirtinkConfigMemoryOvercommitApplyConfiguration {
+	b.Percent = &value
+	return b
+}
+
+// WithPressureThresholdPercent sets the PressureThresholdPercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PressureThresholdPercent field is set to the value of the last call.
+func (b *VirtinkConfigMemoryOvercommitApplyConfiguration) WithPressureThresholdPercent(value int) *VirtinkConfigMemoryOvercommitApplyConfiguration {
+	b.PressureThresholdPercent = &value
+	return b
+}
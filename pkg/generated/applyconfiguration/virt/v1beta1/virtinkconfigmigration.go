@@ -0,0 +1,50 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtinkConfigMigrationApplyConfiguration represents an declarative configuration of the VirtinkConfigMigration type for use
+// with apply.
+type VirtinkConfigMigrationApplyConfiguration struct {
+	ParallelMigrationsPerCluster      *int   `json:"parallelMigrationsPerCluster,omitempty"`
+	ParallelOutboundMigrationsPerNode *int   `json:"parallelOutboundMigrationsPerNode,omitempty"`
+	TTLSecondsAfterFinished           *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+	HistoryLimit                      *int   `json:"historyLimit,omitempty"`
+}
+
+// VirtinkConfigMigrationApplyConfiguration constructs an declarative configuration of the VirtinkConfigMigration type for use with
+// apply.
+func VirtinkConfigMigration() *VirtinkConfigMigrationApplyConfiguration {
+	return &VirtinkConfigMigrationApplyConfiguration{}
+}
+
+// WithParallelMigrationsPerCluster sets the ParallelMigrationsPerCluster field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ParallelMigrationsPerCluster field is set to the value of the last call.
+func (b *VirtinkConfigMigrationApplyConfiguration) WithParallelMigrationsPerCluster(value int) *VirtinkConfigMigrationApplyConfiguration {
+	b.ParallelMigrationsPerCluster = &value
+	return b
+}
+
+// WithParallelOutboundMigrationsPerNode sets the ParallelOutboundMigrationsPerNode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ParallelOutboundMigrationsPerNode field is set to the value of the last call.
+func (b *VirtinkConfigMigrationApplyConfiguration) WithParallelOutboundMigrationsPerNode(value int) *VirtinkConfigMigrationApplyConfiguration {
+	b.ParallelOutboundMigrationsPerNode = &value
+	return b
+}
+
+// WithTTLSecondsAfterFinished sets the TTLSecondsAfterFinished field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TTLSecondsAfterFinished field is set to the value of the last call.
+func (b *VirtinkConfigMigrationApplyConfiguration) WithTTLSecondsAfterFinished(value int32) *VirtinkConfigMigrationApplyConfiguration {
+	b.TTLSecondsAfterFinished = &value
+	return b
+}
+
+// WithHistoryLimit sets the HistoryLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HistoryLimit field is set to the value of the last call.
+func (b *VirtinkConfigMigrationApplyConfiguration) WithHistoryLimit(value int) *VirtinkConfigMigrationApplyConfiguration {
+	b.HistoryLimit = &value
+	return b
+}
@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtinkConfigNetworkApplyConfiguration represents an declarative configuration of the VirtinkConfigNetwork type for use
+// with apply.
+type VirtinkConfigNetworkApplyConfiguration struct {
+	DefaultInterfaceBinding *string `json:"defaultInterfaceBinding,omitempty"`
+	DefaultMasqueradeCIDR   *string `json:"defaultMasqueradeCIDR,omitempty"`
+}
+
+// VirtinkConfigNetworkApplyConfiguration constructs an declarative configuration of the VirtinkConfigNetwork type for use with
+// apply.
+func VirtinkConfigNetwork() *VirtinkConfigNetworkApplyConfiguration {
+	return &VirtinkConfigNetworkApplyConfiguration{}
+}
+
+// WithDefaultInterfaceBinding sets the DefaultInterfaceBinding field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultInterfaceBinding field is set to the value of the last call.
+func (b *VirtinkConfigNetworkApplyConfiguration) WithDefaultInterfaceBinding(value string) *VirtinkConfigNetworkApplyConfiguration {
+	b.DefaultInterfaceBinding = &value
+	return b
+}
+
+// WithDefaultMasqueradeCIDR sets the DefaultMasqueradeCIDR field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultMasqueradeCIDR field is set to the value of the last call.
+func (b *VirtinkConfigNetworkApplyConfiguration) WithDefaultMasqueradeCIDR(value string) *VirtinkConfigNetworkApplyConfiguration {
+	b.DefaultMasqueradeCIDR = &value
+	return b
+}
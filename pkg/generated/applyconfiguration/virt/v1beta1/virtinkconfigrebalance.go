@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtinkConfigRebalanceApplyConfiguration represents an declarative configuration of the VirtinkConfigRebalance type for use
+// with apply.
+type VirtinkConfigRebalanceApplyConfiguration struct {
+	CPUThresholdPercent    *int `json:"cpuThresholdPercent,omitempty"`
+	MemoryThresholdPercent *int `json:"memoryThresholdPercent,omitempty"`
+}
+
+// VirtinkConfigRebalanceApplyConfiguration constructs an declarative configuration of the VirtinkConfigRebalance type for use with
+// apply.
+func VirtinkConfigRebalance() *VirtinkConfigRebalanceApplyConfiguration {
+	return &VirtinkConfigRebalanceApplyConfiguration{}
+}
+
+// WithCPUThresholdPercent sets the CPUThresholdPercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CPUThresholdPercent field is set to the value of the last call.
+func (b *VirtinkConfigRebalanceApplyConfiguration) WithCPUThresholdPercent(value int) *VirtinkConfigRebalanceApplyConfiguration {
+	b.CPUThresholdPercent = &value
+	return b
+}
+
+// WithMemoryThresholdPercent sets the MemoryThresholdPercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MemoryThresholdPercent field is set to the value of the last call.
+func (b *VirtinkConfigRebalanceApplyConfiguration) WithMemoryThresholdPercent(value int) *VirtinkConfigRebalanceApplyConfiguration {
+	b.MemoryThresholdPercent = &value
+	return b
+}
@@ -0,0 +1,146 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtinkConfigSpecApplyConfiguration represents an declarative configuration of the VirtinkConfigSpec type for use
+// with apply.
+type VirtinkConfigSpecApplyConfiguration struct {
+	FeatureGates     map[string]bool                                  `json:"featureGates,omitempty"`
+	Fencing          *VirtinkConfigFencingApplyConfiguration          `json:"fencing,omitempty"`
+	IdleSuspend      *VirtinkConfigIdleSuspendApplyConfiguration      `json:"idleSuspend,omitempty"`
+	Images           *VirtinkConfigImagesApplyConfiguration           `json:"images,omitempty"`
+	KSM              *VirtinkConfigKSMApplyConfiguration              `json:"ksm,omitempty"`
+	Logging          *VirtinkConfigLoggingApplyConfiguration          `json:"logging,omitempty"`
+	MemoryOvercommit *VirtinkConfigMemoryOvercommitApplyConfiguration `json:"memoryOvercommit,omitempty"`
+	Migration        *VirtinkConfigMigrationApplyConfiguration        `json:"migration,omitempty"`
+	Network          *VirtinkConfigNetworkApplyConfiguration          `json:"network,omitempty"`
+	NodePressure     *VirtinkConfigNodePressureApplyConfiguration     `json:"nodePressure,omitempty"`
+	Rebalance        *VirtinkConfigRebalanceApplyConfiguration        `json:"rebalance,omitempty"`
+	Storage          *VirtinkConfigStorageApplyConfiguration          `json:"storage,omitempty"`
+	UsageAccounting  *VirtinkConfigUsageAccountingApplyConfiguration  `json:"usageAccounting,omitempty"`
+	VCPUResource     *VirtinkConfigVCPUResourceApplyConfiguration     `json:"vcpuResource,omitempty"`
+}
+
+// VirtinkConfigSpecApplyConfiguration constructs an declarative configuration of the VirtinkConfigSpec type for use with
+// apply.
+func VirtinkConfigSpec() *VirtinkConfigSpecApplyConfiguration {
+	return &VirtinkConfigSpecApplyConfiguration{}
+}
+
+// WithFeatureGates puts the entries into the FeatureGates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the FeatureGates field,
+// overwriting an existing map entries in FeatureGates field with the same key.
+func (b *VirtinkConfigSpecApplyConfiguration) WithFeatureGates(entries map[string]bool) *VirtinkConfigSpecApplyConfiguration {
+	if b.FeatureGates == nil && len(entries) > 0 {
+		b.FeatureGates = make(map[string]bool, len(entries))
+	}
+	for k, v := range entries {
+		b.FeatureGates[k] = v
+	}
+	return b
+}
+
+// WithFencing sets the Fencing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Fencing field is set to the value of the last call.
+func (b *VirtinkConfigSpecApplyConfiguration) WithFencing(value *VirtinkConfigFencingApplyConfiguration) *VirtinkConfigSpecApplyConfiguration {
+	b.Fencing = value
+	return b
+}
+
+// WithIdleSuspend sets the IdleSuspend field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IdleSuspend field is set to the value of the last call.
+func (b *VirtinkConfigSpecApplyConfiguration) WithIdleSuspend(value *VirtinkConfigIdleSuspendApplyConfiguration) *VirtinkConfigSpecApplyConfiguration {
+	b.IdleSuspend = value
+	return b
+}
+
+// WithImages sets the Images field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Images field is set to the value of the last call.
+func (b *VirtinkConfigSpecApplyConfiguration) WithImages(value *VirtinkConfigImagesApplyConfiguration) *VirtinkConfigSpecApplyConfiguration {
+	b.Images = value
+	return b
+}
+
+// WithKSM sets the KSM field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KSM field is set to the value of the last call.
+func (b *VirtinkConfigSpecApplyConfiguration) WithKSM(value *VirtinkConfigKSMApplyConfiguration) *VirtinkConfigSpecApplyConfiguration {
+	b.KSM = value
+	return b
+}
+
+// WithLogging sets the Logging field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Logging field is set to the value of the last call.
+func (b *VirtinkConfigSpecApplyConfiguration) WithLogging(value *VirtinkConfigLoggingApplyConfiguration) *VirtinkConfigSpecApplyConfiguration {
+	b.Logging = value
+	return b
+}
+
+// WithMemoryOvercommit sets the MemoryOvercommit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MemoryOvercommit field is set to the value of the last call.
+func (b *VirtinkConfigSpecApplyConfiguration) WithMemoryOvercommit(value *VirtinkConfigMemoryOvercommitApplyConfiguration) *VirtinkConfigSpecApplyConfiguration {
+	b.MemoryOvercommit = value
+	return b
+}
+
+// WithMigration sets the Migration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Migration field is set to the value of the last call.
+func (b *VirtinkConfigSpecApplyConfiguration) WithMigration(value *VirtinkConfigMigrationApplyConfiguration) *VirtinkConfigSpecApplyConfiguration {
+	b.Migration = value
+	return b
+}
+
+// WithNetwork sets the Network field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Network field is set to the value of the last call.
+func (b *VirtinkConfigSpecApplyConfiguration) WithNetwork(value *VirtinkConfigNetworkApplyConfiguration) *VirtinkConfigSpecApplyConfiguration {
+	b.Network = value
+	return b
+}
+
+// WithNodePressure sets the NodePressure field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodePressure field is set to the value of the last call.
+func (b *VirtinkConfigSpecApplyConfiguration) WithNodePressure(value *VirtinkConfigNodePressureApplyConfiguration) *VirtinkConfigSpecApplyConfiguration {
+	b.NodePressure = value
+	return b
+}
+
+// WithRebalance sets the Rebalance field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rebalance field is set to the value of the last call.
+func (b *VirtinkConfigSpecApplyConfiguration) WithRebalance(value *VirtinkConfigRebalanceApplyConfiguration) *VirtinkConfigSpecApplyConfiguration {
+	b.Rebalance = value
+	return b
+}
+
+// WithStorage sets the Storage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Storage field is set to the value of the last call.
+func (b *VirtinkConfigSpecApplyConfiguration) WithStorage(value *VirtinkConfigStorageApplyConfiguration) *VirtinkConfigSpecApplyConfiguration {
+	b.Storage = value
+	return b
+}
+
+// WithUsageAccounting sets the UsageAccounting field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UsageAccounting field is set to the value of the last call.
+func (b *VirtinkConfigSpecApplyConfiguration) WithUsageAccounting(value *VirtinkConfigUsageAccountingApplyConfiguration) *VirtinkConfigSpecApplyConfiguration {
+	b.UsageAccounting = value
+	return b
+}
+
+// WithVCPUResource sets the VCPUResource field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VCPUResource field is set to the value of the last call.
+func (b *VirtinkConfigSpecApplyConfiguration) WithVCPUResource(value *VirtinkConfigVCPUResourceApplyConfiguration) *VirtinkConfigSpecApplyConfiguration {
+	b.VCPUResource = value
+	return b
+}
@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtinkConfigStorageApplyConfiguration represents an declarative configuration of the VirtinkConfigStorage type for use
+// with apply.
+type VirtinkConfigStorageApplyConfiguration struct {
+	FilesystemOverhead *VirtinkConfigFilesystemOverheadApplyConfiguration `json:"filesystemOverhead,omitempty"`
+	DiskLeases         *VirtinkConfigDiskLeasesApplyConfiguration         `json:"diskLeases,omitempty"`
+}
+
+// VirtinkConfigStorageApplyConfiguration constructs an declarative configuration of the VirtinkConfigStorage type for use with
+// apply.
+func VirtinkConfigStorage() *VirtinkConfigStorageApplyConfiguration {
+	return &VirtinkConfigStorageApplyConfiguration{}
+}
+
+// WithFilesystemOverhead sets the FilesystemOverhead field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FilesystemOverhead field is set to the value of the last call.
+func (b *VirtinkConfigStorageApplyConfiguration) WithFilesystemOverhead(value *VirtinkConfigFilesystemOverheadApplyConfiguration) *VirtinkConfigStorageApplyConfiguration {
+	b.FilesystemOverhead = value
+	return b
+}
+
+// WithDiskLeases sets the DiskLeases field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DiskLeases field is set to the value of the last call.
+func (b *VirtinkConfigStorageApplyConfiguration) WithDiskLeases(value *VirtinkConfigDiskLeasesApplyConfiguration) *VirtinkConfigStorageApplyConfiguration {
+	b.DiskLeases = value
+	return b
+}
@@ -0,0 +1,50 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtinkNamespaceConfigSpecApplyConfiguration represents an declarative configuration of the VirtinkNamespaceConfigSpec type for use
+// with apply.
+type VirtinkNamespaceConfigSpecApplyConfiguration struct {
+	CloudInit *VirtinkNamespaceConfigCloudInitApplyConfiguration `json:"cloudInit,omitempty"`
+	Network   *VirtinkConfigNetworkApplyConfiguration            `json:"network,omitempty"`
+	Resources *VirtinkNamespaceConfigResourcesApplyConfiguration `json:"resources,omitempty"`
+	Storage   *VirtinkNamespaceConfigStorageApplyConfiguration   `json:"storage,omitempty"`
+}
+
+// VirtinkNamespaceConfigSpecApplyConfiguration constructs an declarative configuration of the VirtinkNamespaceConfigSpec type for use with
+// apply.
+func VirtinkNamespaceConfigSpec() *VirtinkNamespaceConfigSpecApplyConfiguration {
+	return &VirtinkNamespaceConfigSpecApplyConfiguration{}
+}
+
+// WithCloudInit sets the CloudInit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CloudInit field is set to the value of the last call.
+func (b *VirtinkNamespaceConfigSpecApplyConfiguration) WithCloudInit(value *VirtinkNamespaceConfigCloudInitApplyConfiguration) *VirtinkNamespaceConfigSpecApplyConfiguration {
+	b.CloudInit = value
+	return b
+}
+
+// WithNetwork sets the Network field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Network field is set to the value of the last call.
+func (b *VirtinkNamespaceConfigSpecApplyConfiguration) WithNetwork(value *VirtinkConfigNetworkApplyConfiguration) *VirtinkNamespaceConfigSpecApplyConfiguration {
+	b.Network = value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *VirtinkNamespaceConfigSpecApplyConfiguration) WithResources(value *VirtinkNamespaceConfigResourcesApplyConfiguration) *VirtinkNamespaceConfigSpecApplyConfiguration {
+	b.Resources = value
+	return b
+}
+
+// WithStorage sets the Storage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Storage field is set to the value of the last call.
+func (b *VirtinkNamespaceConfigSpecApplyConfiguration) WithStorage(value *VirtinkNamespaceConfigStorageApplyConfiguration) *VirtinkNamespaceConfigSpecApplyConfiguration {
+	b.Storage = value
+	return b
+}
@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// VirtualMachineActionSpecApplyConfiguration represents an declarative configuration of the VirtualMachineActionSpec type for use
+// with apply.
+type VirtualMachineActionSpecApplyConfiguration struct {
+	VirtualMachineName *string                           `json:"virtualMachineName,omitempty"`
+	Action             *v1beta1.VirtualMachineActionType `json:"action,omitempty"`
+}
+
+// VirtualMachineActionSpecApplyConfiguration constructs an declarative configuration of the VirtualMachineActionSpec type for use with
+// apply.
+func VirtualMachineActionSpec() *VirtualMachineActionSpecApplyConfiguration {
+	return &VirtualMachineActionSpecApplyConfiguration{}
+}
+
+// WithVirtualMachineName sets the VirtualMachineName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VirtualMachineName field is set to the value of the last call.
+func (b *VirtualMachineActionSpecApplyConfiguration) WithVirtualMachineName(value string) *VirtualMachineActionSpecApplyConfiguration {
+	b.VirtualMachineName = &value
+	return b
+}
+
+// WithAction sets the Action field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Action field is set to the value of the last call.
+func (b *VirtualMachineActionSpecApplyConfiguration) WithAction(value v1beta1.VirtualMachineActionType) *VirtualMachineActionSpecApplyConfiguration {
+	b.Action = &value
+	return b
+}
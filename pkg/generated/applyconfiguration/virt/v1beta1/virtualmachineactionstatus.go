@@ -0,0 +1,45 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// VirtualMachineActionStatusApplyConfiguration represents an declarative configuration of the VirtualMachineActionStatus type for use
+// with apply.
+type VirtualMachineActionStatusApplyConfiguration struct {
+	Phase     *v1beta1.VirtualMachineActionPhase `json:"phase,omitempty"`
+	Requester *string                            `json:"requester,omitempty"`
+	Message   *string                            `json:"message,omitempty"`
+}
+
+// VirtualMachineActionStatusApplyConfiguration constructs an declarative configuration of the VirtualMachineActionStatus type for use with
+// apply.
+func VirtualMachineActionStatus() *VirtualMachineActionStatusApplyConfiguration {
+	return &VirtualMachineActionStatusApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *VirtualMachineActionStatusApplyConfiguration) WithPhase(value v1beta1.VirtualMachineActionPhase) *VirtualMachineActionStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithRequester sets the Requester field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Requester field is set to the value of the last call.
+func (b *VirtualMachineActionStatusApplyConfiguration) WithRequester(value string) *VirtualMachineActionStatusApplyConfiguration {
+	b.Requester = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *VirtualMachineActionStatusApplyConfiguration) WithMessage(value string) *VirtualMachineActionStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// VirtualMachineAddressApplyConfiguration represents an declarative configuration of the VirtualMachineAddress type for use
+// with apply.
+type VirtualMachineAddressApplyConfiguration struct {
+	Type    *v1beta1.VirtualMachineAddressType `json:"type,omitempty"`
+	Address *string                            `json:"address,omitempty"`
+}
+
+// VirtualMachineAddressApplyConfiguration constructs an declarative configuration of the VirtualMachineAddress type for use with
+// apply.
+func VirtualMachineAddress() *VirtualMachineAddressApplyConfiguration {
+	return &VirtualMachineAddressApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *VirtualMachineAddressApplyConfiguration) WithType(value v1beta1.VirtualMachineAddressType) *VirtualMachineAddressApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithAddress sets the Address field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Address field is set to the value of the last call.
+func (b *VirtualMachineAddressApplyConfiguration) WithAddress(value string) *VirtualMachineAddressApplyConfiguration {
+	b.Address = &value
+	return b
+}
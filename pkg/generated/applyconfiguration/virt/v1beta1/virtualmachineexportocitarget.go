@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtualMachineExportOCITargetApplyConfiguration represents an declarative configuration of the VirtualMachineExportOCITarget type for use
+// with apply.
+type VirtualMachineExportOCITargetApplyConfiguration struct {
+	Image          *string `json:"image,omitempty"`
+	PushSecretName *string `json:"pushSecretName,omitempty"`
+}
+
+// VirtualMachineExportOCITargetApplyConfiguration constructs an declarative configuration of the VirtualMachineExportOCITarget type for use with
+// apply.
+func VirtualMachineExportOCITarget() *VirtualMachineExportOCITargetApplyConfiguration {
+	return &VirtualMachineExportOCITargetApplyConfiguration{}
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *VirtualMachineExportOCITargetApplyConfiguration) WithImage(value string) *VirtualMachineExportOCITargetApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithPushSecretName sets the PushSecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PushSecretName field is set to the value of the last call.
+func (b *VirtualMachineExportOCITargetApplyConfiguration) WithPushSecretName(value string) *VirtualMachineExportOCITargetApplyConfiguration {
+	b.PushSecretName = &value
+	return b
+}
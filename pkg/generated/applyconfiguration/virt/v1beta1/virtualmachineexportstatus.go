@@ -0,0 +1,54 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// VirtualMachineExportStatusApplyConfiguration represents an declarative configuration of the VirtualMachineExportStatus type for use
+// with apply.
+type VirtualMachineExportStatusApplyConfiguration struct {
+	Phase      *v1beta1.VirtualMachineExportPhase `json:"phase,omitempty"`
+	ClaimName  *string                            `json:"claimName,omitempty"`
+	SecretName *string                            `json:"secretName,omitempty"`
+	URL        *string                            `json:"url,omitempty"`
+}
+
+// VirtualMachineExportStatusApplyConfiguration constructs an declarative configuration of the VirtualMachineExportStatus type for use with
+// apply.
+func VirtualMachineExportStatus() *VirtualMachineExportStatusApplyConfiguration {
+	return &VirtualMachineExportStatusApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *VirtualMachineExportStatusApplyConfiguration) WithPhase(value v1beta1.VirtualMachineExportPhase) *VirtualMachineExportStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithClaimName sets the ClaimName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClaimName field is set to the value of the last call.
+func (b *VirtualMachineExportStatusApplyConfiguration) WithClaimName(value string) *VirtualMachineExportStatusApplyConfiguration {
+	b.ClaimName = &value
+	return b
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *VirtualMachineExportStatusApplyConfiguration) WithSecretName(value string) *VirtualMachineExportStatusApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *VirtualMachineExportStatusApplyConfiguration) WithURL(value string) *VirtualMachineExportStatusApplyConfiguration {
+	b.URL = &value
+	return b
+}
@@ -0,0 +1,70 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// VirtualMachineMigrationStatusApplyConfiguration represents an declarative configuration of the VirtualMachineMigrationStatus type for use
+// with apply.
+type VirtualMachineMigrationStatusApplyConfiguration struct {
+	Phase          *v1beta1.VirtualMachineMigrationPhase `json:"phase,omitempty"`
+	SourceNodeName *string                               `json:"sourceNodeName,omitempty"`
+	TargetNodeName *string                               `json:"targetNodeName,omitempty"`
+	Conditions     []v1.ConditionApplyConfiguration      `json:"conditions,omitempty"`
+	CompletionTime *metav1.Time                          `json:"completionTime,omitempty"`
+}
+
+// VirtualMachineMigrationStatusApplyConfiguration constructs an declarative configuration of the VirtualMachineMigrationStatus type for use with
+// apply.
+func VirtualMachineMigrationStatus() *VirtualMachineMigrationStatusApplyConfiguration {
+	return &VirtualMachineMigrationStatusApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *VirtualMachineMigrationStatusApplyConfiguration) WithPhase(value v1beta1.VirtualMachineMigrationPhase) *VirtualMachineMigrationStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithSourceNodeName sets the SourceNodeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SourceNodeName field is set to the value of the last call.
+func (b *VirtualMachineMigrationStatusApplyConfiguration) WithSourceNodeName(value string) *VirtualMachineMigrationStatusApplyConfiguration {
+	b.SourceNodeName = &value
+	return b
+}
+
+// WithTargetNodeName sets the TargetNodeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetNodeName field is set to the value of the last call.
+func (b *VirtualMachineMigrationStatusApplyConfiguration) WithTargetNodeName(value string) *VirtualMachineMigrationStatusApplyConfiguration {
+	b.TargetNodeName = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *VirtualMachineMigrationStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *VirtualMachineMigrationStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithCompletionTime sets the CompletionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletionTime field is set to the value of the last call.
+func (b *VirtualMachineMigrationStatusApplyConfiguration) WithCompletionTime(value metav1.Time) *VirtualMachineMigrationStatusApplyConfiguration {
+	b.CompletionTime = &value
+	return b
+}
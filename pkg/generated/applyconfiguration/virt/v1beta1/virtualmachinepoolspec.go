@@ -0,0 +1,59 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/core/v1"
+)
+
+// VirtualMachinePoolSpecApplyConfiguration represents an declarative configuration of the VirtualMachinePoolSpec type for use
+// with apply.
+type VirtualMachinePoolSpecApplyConfiguration struct {
+	Replicas             *int32                                        `json:"replicas,omitempty"`
+	ServiceName          *string                                       `json:"serviceName,omitempty"`
+	Template             *VirtualMachinePoolTemplateApplyConfiguration `json:"template,omitempty"`
+	VolumeClaimTemplates []v1.PersistentVolumeClaimApplyConfiguration  `json:"volumeClaimTemplates,omitempty"`
+}
+
+// VirtualMachinePoolSpecApplyConfiguration constructs an declarative configuration of the VirtualMachinePoolSpec type for use with
+// apply.
+func VirtualMachinePoolSpec() *VirtualMachinePoolSpecApplyConfiguration {
+	return &VirtualMachinePoolSpecApplyConfiguration{}
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *VirtualMachinePoolSpecApplyConfiguration) WithReplicas(value int32) *VirtualMachinePoolSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithServiceName sets the ServiceName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceName field is set to the value of the last call.
+func (b *VirtualMachinePoolSpecApplyConfiguration) WithServiceName(value string) *VirtualMachinePoolSpecApplyConfiguration {
+	b.ServiceName = &value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *VirtualMachinePoolSpecApplyConfiguration) WithTemplate(value *VirtualMachinePoolTemplateApplyConfiguration) *VirtualMachinePoolSpecApplyConfiguration {
+	b.Template = value
+	return b
+}
+
+// WithVolumeClaimTemplates adds the given value to the VolumeClaimTemplates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeClaimTemplates field.
+func (b *VirtualMachinePoolSpecApplyConfiguration) WithVolumeClaimTemplates(values ...*v1.PersistentVolumeClaimApplyConfiguration) *VirtualMachinePoolSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVolumeClaimTemplates")
+		}
+		b.VolumeClaimTemplates = append(b.VolumeClaimTemplates, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,59 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtualMachinePoolStatusApplyConfiguration represents an declarative configuration of the VirtualMachinePoolStatus type for use
+// with apply.
+type VirtualMachinePoolStatusApplyConfiguration struct {
+	ObservedGeneration *int64  `json:"observedGeneration,omitempty"`
+	Replicas           *int32  `json:"replicas,omitempty"`
+	ReadyReplicas      *int32  `json:"readyReplicas,omitempty"`
+	ServiceName        *string `json:"serviceName,omitempty"`
+	Selector           *string `json:"selector,omitempty"`
+}
+
+// VirtualMachinePoolStatusApplyConfiguration constructs an declarative configuration of the VirtualMachinePoolStatus type for use with
+// apply.
+func VirtualMachinePoolStatus() *VirtualMachinePoolStatusApplyConfiguration {
+	return &VirtualMachinePoolStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *VirtualMachinePoolStatusApplyConfiguration) WithObservedGeneration(value int64) *VirtualMachinePoolStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *VirtualMachinePoolStatusApplyConfiguration) WithReplicas(value int32) *VirtualMachinePoolStatusApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithReadyReplicas sets the ReadyReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyReplicas field is set to the value of the last call.
+func (b *VirtualMachinePoolStatusApplyConfiguration) WithReadyReplicas(value int32) *VirtualMachinePoolStatusApplyConfiguration {
+	b.ReadyReplicas = &value
+	return b
+}
+
+// WithServiceName sets the ServiceName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceName field is set to the value of the last call.
+func (b *VirtualMachinePoolStatusApplyConfiguration) WithServiceName(value string) *VirtualMachinePoolStatusApplyConfiguration {
+	b.ServiceName = &value
+	return b
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *VirtualMachinePoolStatusApplyConfiguration) WithSelector(value string) *VirtualMachinePoolStatusApplyConfiguration {
+	b.Selector = &value
+	return b
+}
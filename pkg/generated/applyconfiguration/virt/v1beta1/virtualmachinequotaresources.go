@@ -0,0 +1,45 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// VirtualMachineQuotaResourcesApplyConfiguration represents an declarative configuration of the VirtualMachineQuotaResources type for use
+// with apply.
+type VirtualMachineQuotaResourcesApplyConfiguration struct {
+	VirtualMachines *int64             `json:"virtualMachines,omitempty"`
+	VCPUs           *int64             `json:"vcpus,omitempty"`
+	Memory          *resource.Quantity `json:"memory,omitempty"`
+}
+
+// VirtualMachineQuotaResourcesApplyConfiguration constructs an declarative configuration of the VirtualMachineQuotaResources type for use with
+// apply.
+func VirtualMachineQuotaResources() *VirtualMachineQuotaResourcesApplyConfiguration {
+	return &VirtualMachineQuotaResourcesApplyConfiguration{}
+}
+
+// WithVirtualMachines sets the VirtualMachines field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VirtualMachines field is set to the value of the last call.
+func (b *VirtualMachineQuotaResourcesApplyConfiguration) WithVirtualMachines(value int64) *VirtualMachineQuotaResourcesApplyConfiguration {
+	b.VirtualMachines = &value
+	return b
+}
+
+// WithVCPUs sets the VCPUs field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VCPUs field is set to the value of the last call.
+func (b *VirtualMachineQuotaResourcesApplyConfiguration) WithVCPUs(value int64) *VirtualMachineQuotaResourcesApplyConfiguration {
+	b.VCPUs = &value
+	return b
+}
+
+// WithMemory sets the Memory field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Memory field is set to the value of the last call.
+func (b *VirtualMachineQuotaResourcesApplyConfiguration) WithMemory(value resource.Quantity) *VirtualMachineQuotaResourcesApplyConfiguration {
+	b.Memory = &value
+	return b
+}
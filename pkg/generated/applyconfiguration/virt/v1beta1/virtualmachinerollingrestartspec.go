@@ -0,0 +1,47 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// VirtualMachineRollingRestartSpecApplyConfiguration represents an declarative configuration of the VirtualMachineRollingRestartSpec type for use
+// with apply.
+type VirtualMachineRollingRestartSpecApplyConfiguration struct {
+	Selector       *v1.LabelSelector               `json:"selector,omitempty"`
+	MaxUnavailable *intstr.IntOrString             `json:"maxUnavailable,omitempty"`
+	Strategy       *v1beta1.RollingRestartStrategy `json:"strategy,omitempty"`
+}
+
+// VirtualMachineRollingRestartSpecApplyConfiguration constructs an declarative configuration of the VirtualMachineRollingRestartSpec type for use with
+// apply.
+func VirtualMachineRollingRestartSpec() *VirtualMachineRollingRestartSpecApplyConfiguration {
+	return &VirtualMachineRollingRestartSpecApplyConfiguration{}
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *VirtualMachineRollingRestartSpecApplyConfiguration) WithSelector(value v1.LabelSelector) *VirtualMachineRollingRestartSpecApplyConfiguration {
+	b.Selector = &value
+	return b
+}
+
+// WithMaxUnavailable sets the MaxUnavailable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxUnavailable field is set to the value of the last call.
+func (b *VirtualMachineRollingRestartSpecApplyConfiguration) WithMaxUnavailable(value intstr.IntOrString) *VirtualMachineRollingRestartSpecApplyConfiguration {
+	b.MaxUnavailable = &value
+	return b
+}
+
+// WithStrategy sets the Strategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Strategy field is set to the value of the last call.
+func (b *VirtualMachineRollingRestartSpecApplyConfiguration) WithStrategy(value v1beta1.RollingRestartStrategy) *VirtualMachineRollingRestartSpecApplyConfiguration {
+	b.Strategy = &value
+	return b
+}
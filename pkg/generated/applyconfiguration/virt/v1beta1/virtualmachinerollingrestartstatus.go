@@ -0,0 +1,55 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineRollingRestartStatusApplyConfiguration represents an declarative configuration of the VirtualMachineRollingRestartStatus type for use
+// with apply.
+type VirtualMachineRollingRestartStatusApplyConfiguration struct {
+	Phase                    *v1beta1.VirtualMachineRollingRestartPhase `json:"phase,omitempty"`
+	VirtualMachines          *int32                                     `json:"virtualMachines,omitempty"`
+	RestartedVirtualMachines *int32                                     `json:"restartedVirtualMachines,omitempty"`
+	CompletionTime           *v1.Time                                   `json:"completionTime,omitempty"`
+}
+
+// VirtualMachineRollingRestartStatusApplyConfiguration constructs an declarative configuration of the VirtualMachineRollingRestartStatus type for use with
+// apply.
+func VirtualMachineRollingRestartStatus() *VirtualMachineRollingRestartStatusApplyConfiguration {
+	return &VirtualMachineRollingRestartStatusApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *VirtualMachineRollingRestartStatusApplyConfiguration) WithPhase(value v1beta1.VirtualMachineRollingRestartPhase) *VirtualMachineRollingRestartStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithVirtualMachines sets the VirtualMachines field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VirtualMachines field is set to the value of the last call.
+func (b *VirtualMachineRollingRestartStatusApplyConfiguration) WithVirtualMachines(value int32) *VirtualMachineRollingRestartStatusApplyConfiguration {
+	b.VirtualMachines = &value
+	return b
+}
+
+// WithRestartedVirtualMachines sets the RestartedVirtualMachines field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestartedVirtualMachines field is set to the value of the last call.
+func (b *VirtualMachineRollingRestartStatusApplyConfiguration) WithRestartedVirtualMachines(value int32) *VirtualMachineRollingRestartStatusApplyConfiguration {
+	b.RestartedVirtualMachines = &value
+	return b
+}
+
+// WithCompletionTime sets the CompletionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletionTime field is set to the value of the last call.
+func (b *VirtualMachineRollingRestartStatusApplyConfiguration) WithCompletionTime(value v1.Time) *VirtualMachineRollingRestartStatusApplyConfiguration {
+	b.CompletionTime = &value
+	return b
+}
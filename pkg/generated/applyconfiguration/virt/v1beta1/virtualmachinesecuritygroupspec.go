@@ -0,0 +1,42 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtualMachineSecurityGroupSpecApplyConfiguration represents an declarative configuration of the VirtualMachineSecurityGroupSpec type for use
+// with apply.
+type VirtualMachineSecurityGroupSpecApplyConfiguration struct {
+	Ingress []SecurityGroupRuleApplyConfiguration `json:"ingress,omitempty"`
+	Egress  []SecurityGroupRuleApplyConfiguration `json:"egress,omitempty"`
+}
+
+// VirtualMachineSecurityGroupSpecApplyConfiguration constructs an declarative configuration of the VirtualMachineSecurityGroupSpec type for use with
+// apply.
+func VirtualMachineSecurityGroupSpec() *VirtualMachineSecurityGroupSpecApplyConfiguration {
+	return &VirtualMachineSecurityGroupSpecApplyConfiguration{}
+}
+
+// WithIngress adds the given value to the Ingress field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Ingress field.
+func (b *VirtualMachineSecurityGroupSpecApplyConfiguration) WithIngress(values ...*SecurityGroupRuleApplyConfiguration) *VirtualMachineSecurityGroupSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithIngress")
+		}
+		b.Ingress = append(b.Ingress, *values[i])
+	}
+	return b
+}
+
+// WithEgress adds the given value to the Egress field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Egress field.
+func (b *VirtualMachineSecurityGroupSpecApplyConfiguration) WithEgress(values ...*SecurityGroupRuleApplyConfiguration) *VirtualMachineSecurityGroupSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithEgress")
+		}
+		b.Egress = append(b.Egress, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtualMachineStatusMigrationVolumeApplyConfiguration represents an declarative configuration of the VirtualMachineStatusMigrationVolume type for use
+// with apply.
+type VirtualMachineStatusMigrationVolumeApplyConfiguration struct {
+	Name            *string `json:"name,omitempty"`
+	SourceClaimName *string `json:"sourceClaimName,omitempty"`
+	TargetClaimName *string `json:"targetClaimName,omitempty"`
+}
+
+// VirtualMachineStatusMigrationVolumeApplyConfiguration constructs an declarative configuration of the VirtualMachineStatusMigrationVolume type for use with
+// apply.
+func VirtualMachineStatusMigrationVolume() *VirtualMachineStatusMigrationVolumeApplyConfiguration {
+	return &VirtualMachineStatusMigrationVolumeApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *VirtualMachineStatusMigrationVolumeApplyConfiguration) WithName(value string) *VirtualMachineStatusMigrationVolumeApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithSourceClaimName sets the SourceClaimName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SourceClaimName field is set to the value of the last call.
+func (b *VirtualMachineStatusMigrationVolumeApplyConfiguration) WithSourceClaimName(value string) *VirtualMachineStatusMigrationVolumeApplyConfiguration {
+	b.SourceClaimName = &value
+	return b
+}
+
+// WithTargetClaimName sets the TargetClaimName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetClaimName field is set to the value of the last call.
+func (b *VirtualMachineStatusMigrationVolumeApplyConfiguration) WithTargetClaimName(value string) *VirtualMachineStatusMigrationVolumeApplyConfiguration {
+	b.TargetClaimName = &value
+	return b
+}
@@ -0,0 +1,27 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineStatusScheduleApplyConfiguration represents an declarative configuration of the VirtualMachineStatusSchedule type for use
+// with apply.
+type VirtualMachineStatusScheduleApplyConfiguration struct {
+	LastScheduleTime *v1.Time `json:"lastScheduleTime,omitempty"`
+}
+
+// VirtualMachineStatusScheduleApplyConfiguration constructs an declarative configuration of the VirtualMachineStatusSchedule type for use with
+// apply.
+func VirtualMachineStatusSchedule() *VirtualMachineStatusScheduleApplyConfiguration {
+	return &VirtualMachineStatusScheduleApplyConfiguration{}
+}
+
+// WithLastScheduleTime sets the LastScheduleTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastScheduleTime field is set to the value of the last call.
+func (b *VirtualMachineStatusScheduleApplyConfiguration) WithLastScheduleTime(value v1.Time) *VirtualMachineStatusScheduleApplyConfiguration {
+	b.LastScheduleTime = &value
+	return b
+}
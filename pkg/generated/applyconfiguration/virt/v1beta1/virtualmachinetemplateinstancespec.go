@@ -0,0 +1,38 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtualMachineTemplateInstanceSpecApplyConfiguration represents an declarative configuration of the VirtualMachineTemplateInstanceSpec type for use
+// with apply.
+type VirtualMachineTemplateInstanceSpecApplyConfiguration struct {
+	TemplateName *string           `json:"templateName,omitempty"`
+	Parameters   map[string]string `json:"parameters,omitempty"`
+}
+
+// VirtualMachineTemplateInstanceSpecApplyConfiguration constructs an declarative configuration of the VirtualMachineTemplateInstanceSpec type for use with
+// apply.
+func VirtualMachineTemplateInstanceSpec() *VirtualMachineTemplateInstanceSpecApplyConfiguration {
+	return &VirtualMachineTemplateInstanceSpecApplyConfiguration{}
+}
+
+// WithTemplateName sets the TemplateName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TemplateName field is set to the value of the last call.
+func (b *VirtualMachineTemplateInstanceSpecApplyConfiguration) WithTemplateName(value string) *VirtualMachineTemplateInstanceSpecApplyConfiguration {
+	b.TemplateName = &value
+	return b
+}
+
+// WithParameters puts the entries into the Parameters field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Parameters field,
+// overwriting an existing map entries in Parameters field with the same key.
+func (b *VirtualMachineTemplateInstanceSpecApplyConfiguration) WithParameters(entries map[string]string) *VirtualMachineTemplateInstanceSpecApplyConfiguration {
+	if b.Parameters == nil && len(entries) > 0 {
+		b.Parameters = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Parameters[k] = v
+	}
+	return b
+}
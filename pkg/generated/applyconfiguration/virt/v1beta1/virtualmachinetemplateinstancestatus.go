@@ -0,0 +1,54 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// VirtualMachineTemplateInstanceStatusApplyConfiguration represents an declarative configuration of the VirtualMachineTemplateInstanceStatus type for use
+// with apply.
+type VirtualMachineTemplateInstanceStatusApplyConfiguration struct {
+	Phase              *v1beta1.VirtualMachineTemplateInstancePhase `json:"phase,omitempty"`
+	Requester          *string                                      `json:"requester,omitempty"`
+	VirtualMachineName *string                                      `json:"virtualMachineName,omitempty"`
+	Message            *string                                      `json:"message,omitempty"`
+}
+
+// VirtualMachineTemplateInstanceStatusApplyConfiguration constructs an declarative configuration of the VirtualMachineTemplateInstanceStatus type for use with
+// apply.
+func VirtualMachineTemplateInstanceStatus() *VirtualMachineTemplateInstanceStatusApplyConfiguration {
+	return &VirtualMachineTemplateInstanceStatusApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *VirtualMachineTemplateInstanceStatusApplyConfiguration) WithPhase(value v1beta1.VirtualMachineTemplateInstancePhase) *VirtualMachineTemplateInstanceStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithRequester sets the Requester field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Requester field is set to the value of the last call.
+func (b *VirtualMachineTemplateInstanceStatusApplyConfiguration) WithRequester(value string) *VirtualMachineTemplateInstanceStatusApplyConfiguration {
+	b.Requester = &value
+	return b
+}
+
+// WithVirtualMachineName sets the VirtualMachineName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VirtualMachineName field is set to the value of the last call.
+func (b *VirtualMachineTemplateInstanceStatusApplyConfiguration) WithVirtualMachineName(value string) *VirtualMachineTemplateInstanceStatusApplyConfiguration {
+	b.VirtualMachineName = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *VirtualMachineTemplateInstanceStatusApplyConfiguration) WithMessage(value string) *VirtualMachineTemplateInstanceStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -0,0 +1,50 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtualMachineTemplateParameterApplyConfiguration represents an declarative configuration of the VirtualMachineTemplateParameter type for use
+// with apply.
+type VirtualMachineTemplateParameterApplyConfiguration struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Default     *string `json:"default,omitempty"`
+	Required    *bool   `json:"required,omitempty"`
+}
+
+// VirtualMachineTemplateParameterApplyConfiguration constructs an declarative configuration of the VirtualMachineTemplateParameter type for use with
+// apply.
+func VirtualMachineTemplateParameter() *VirtualMachineTemplateParameterApplyConfiguration {
+	return &VirtualMachineTemplateParameterApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *VirtualMachineTemplateParameterApplyConfiguration) WithName(value string) *VirtualMachineTemplateParameterApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *VirtualMachineTemplateParameterApplyConfiguration) WithDescription(value string) *VirtualMachineTemplateParameterApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithDefault sets the Default field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Default field is set to the value of the last call.
+func (b *VirtualMachineTemplateParameterApplyConfiguration) WithDefault(value string) *VirtualMachineTemplateParameterApplyConfiguration {
+	b.Default = &value
+	return b
+}
+
+// WithRequired sets the Required field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Required field is set to the value of the last call.
+func (b *VirtualMachineTemplateParameterApplyConfiguration) WithRequired(value bool) *VirtualMachineTemplateParameterApplyConfiguration {
+	b.Required = &value
+	return b
+}
@@ -0,0 +1,50 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// VirtualMachineTemplateSpecApplyConfiguration represents an declarative configuration of the VirtualMachineTemplateSpec type for use
+// with apply.
+type VirtualMachineTemplateSpecApplyConfiguration struct {
+	Description    *string                                             `json:"description,omitempty"`
+	Parameters     []VirtualMachineTemplateParameterApplyConfiguration `json:"parameters,omitempty"`
+	VirtualMachine *runtime.RawExtension                               `json:"virtualMachine,omitempty"`
+}
+
+// VirtualMachineTemplateSpecApplyConfiguration constructs an declarative configuration of the VirtualMachineTemplateSpec type for use with
+// apply.
+func VirtualMachineTemplateSpec() *VirtualMachineTemplateSpecApplyConfiguration {
+	return &VirtualMachineTemplateSpecApplyConfiguration{}
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *VirtualMachineTemplateSpecApplyConfiguration) WithDescription(value string) *VirtualMachineTemplateSpecApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithParameters adds the given value to the Parameters field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Parameters field.
+func (b *VirtualMachineTemplateSpecApplyConfiguration) WithParameters(values ...*VirtualMachineTemplateParameterApplyConfiguration) *VirtualMachineTemplateSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithParameters")
+		}
+		b.Parameters = append(b.Parameters, *values[i])
+	}
+	return b
+}
+
+// WithVirtualMachine sets the VirtualMachine field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VirtualMachine field is set to the value of the last call.
+func (b *VirtualMachineTemplateSpecApplyConfiguration) WithVirtualMachine(value runtime.RawExtension) *VirtualMachineTemplateSpecApplyConfiguration {
+	b.VirtualMachine = &value
+	return b
+}
@@ -0,0 +1,114 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+)
+
+// VirtualMachineUsageStatusApplyConfiguration represents an declarative configuration of the VirtualMachineUsageStatus type for use
+// with apply.
+type VirtualMachineUsageStatusApplyConfiguration struct {
+	VirtualMachineName *string                                       `json:"virtualMachineName,omitempty"`
+	VirtualMachineUID  *types.UID                                    `json:"virtualMachineUID,omitempty"`
+	StartTime          *metav1.Time                                  `json:"startTime,omitempty"`
+	LastFlushTime      *metav1.Time                                  `json:"lastFlushTime,omitempty"`
+	EndTime            *metav1.Time                                  `json:"endTime,omitempty"`
+	Running            *bool                                         `json:"running,omitempty"`
+	RunningSeconds     *int64                                        `json:"runningSeconds,omitempty"`
+	VCPUSeconds        *int64                                        `json:"vcpuSeconds,omitempty"`
+	MemoryMiBSeconds   *int64                                        `json:"memoryMiBSeconds,omitempty"`
+	Volumes            []VirtualMachineUsageVolumeApplyConfiguration `json:"volumes,omitempty"`
+}
+
+// VirtualMachineUsageStatusApplyConfiguration constructs an declarative configuration of the VirtualMachineUsageStatus type for use with
+// apply.
+func VirtualMachineUsageStatus() *VirtualMachineUsageStatusApplyConfiguration {
+	return &VirtualMachineUsageStatusApplyConfiguration{}
+}
+
+// WithVirtualMachineName sets the VirtualMachineName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VirtualMachineName field is set to the value of the last call.
+func (b *VirtualMachineUsageStatusApplyConfiguration) WithVirtualMachineName(value string) *VirtualMachineUsageStatusApplyConfiguration {
+	b.VirtualMachineName = &value
+	return b
+}
+
+// WithVirtualMachineUID sets the VirtualMachineUID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VirtualMachineUID field is set to the value of the last call.
+func (b *VirtualMachineUsageStatusApplyConfiguration) WithVirtualMachineUID(value types.UID) *VirtualMachineUsageStatusApplyConfiguration {
+	b.VirtualMachineUID = &value
+	return b
+}
+
+// WithStartTime sets the StartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartTime field is set to the value of the last call.
+func (b *VirtualMachineUsageStatusApplyConfiguration) WithStartTime(value metav1.Time) *VirtualMachineUsageStatusApplyConfiguration {
+	b.StartTime = &value
+	return b
+}
+
+// WithLastFlushTime sets the LastFlushTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastFlushTime field is set to the value of the last call.
+func (b *VirtualMachineUsageStatusApplyConfiguration) WithLastFlushTime(value metav1.Time) *VirtualMachineUsageStatusApplyConfiguration {
+	b.LastFlushTime = &value
+	return b
+}
+
+// WithEndTime sets the EndTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EndTime field is set to the value of the last call.
+func (b *VirtualMachineUsageStatusApplyConfiguration) WithEndTime(value metav1.Time) *VirtualMachineUsageStatusApplyConfiguration {
+	b.EndTime = &value
+	return b
+}
+
+// WithRunning sets the Running field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Running field is set to the value of the last call.
+func (b *VirtualMachineUsageStatusApplyConfiguration) WithRunning(value bool) *VirtualMachineUsageStatusApplyConfiguration {
+	b.Running = &value
+	return b
+}
+
+// WithRunningSeconds sets the RunningSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RunningSeconds field is set to the value of the last call.
+func (b *VirtualMachineUsageStatusApplyConfiguration) WithRunningSeconds(value int64) *VirtualMachineUsageStatusApplyConfiguration {
+	b.RunningSeconds = &value
+	return b
+}
+
+// WithVCPUSeconds sets the VCPUSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VCPUSeconds field is set to the value of the last call.
+func (b *VirtualMachineUsageStatusApplyConfiguration) WithVCPUSeconds(value int64) *VirtualMachineUsageStatusApplyConfiguration {
+	b.VCPUSeconds = &value
+	return b
+}
+
+// WithMemoryMiBSeconds sets the MemoryMiBSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MemoryMiBSeconds field is set to the value of the last call.
+func (b *VirtualMachineUsageStatusApplyConfiguration) WithMemoryMiBSeconds(value int64) *VirtualMachineUsageStatusApplyConfiguration {
+	b.MemoryMiBSeconds = &value
+	return b
+}
+
+// WithVolumes adds the given value to the Volumes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Volumes field.
+func (b *VirtualMachineUsageStatusApplyConfiguration) WithVolumes(values ...*VirtualMachineUsageVolumeApplyConfiguration) *VirtualMachineUsageStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVolumes")
+		}
+		b.Volumes = append(b.Volumes, *values[i])
+	}
+	return b
+}
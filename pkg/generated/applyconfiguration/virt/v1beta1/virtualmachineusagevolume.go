@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VirtualMachineUsageVolumeApplyConfiguration represents an declarative configuration of the VirtualMachineUsageVolume type for use
+// with apply.
+type VirtualMachineUsageVolumeApplyConfiguration struct {
+	Name            *string `json:"name,omitempty"`
+	ClaimName       *string `json:"claimName,omitempty"`
+	AttachedSeconds *int64  `json:"attachedSeconds,omitempty"`
+}
+
+// VirtualMachineUsageVolumeApplyConfiguration constructs an declarative configuration of the VirtualMachineUsageVolume type for use with
+// apply.
+func VirtualMachineUsageVolume() *VirtualMachineUsageVolumeApplyConfiguration {
+	return &VirtualMachineUsageVolumeApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *VirtualMachineUsageVolumeApplyConfiguration) WithName(value string) *VirtualMachineUsageVolumeApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithClaimName sets the ClaimName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClaimName field is set to the value of the last call.
+func (b *VirtualMachineUsageVolumeApplyConfiguration) WithClaimName(value string) *VirtualMachineUsageVolumeApplyConfiguration {
+	b.ClaimName = &value
+	return b
+}
+
+// WithAttachedSeconds sets the AttachedSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AttachedSeconds field is set to the value of the last call.
+func (b *VirtualMachineUsageVolumeApplyConfiguration) WithAttachedSeconds(value int64) *VirtualMachineUsageVolumeApplyConfiguration {
+	b.AttachedSeconds = &value
+	return b
+}
@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VLANRangeApplyConfiguration represents an declarative configuration of the VLANRange type for use
+// with apply.
+type VLANRangeApplyConfiguration struct {
+	ID    *int32 `json:"id,omitempty"`
+	EndID *int32 `json:"endID,omitempty"`
+}
+
+// VLANRangeApplyConfiguration constructs an declarative configuration of the VLANRange type for use with
+// apply.
+func VLANRange() *VLANRangeApplyConfiguration {
+	return &VLANRangeApplyConfiguration{}
+}
+
+// WithID sets the ID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ID field is set to the value of the last call.
+func (b *VLANRangeApplyConfiguration) WithID(value int32) *VLANRangeApplyConfiguration {
+	b.ID = &value
+	return b
+}
+
+// WithEndID sets the EndID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EndID field is set to the value of the last call.
+func (b *VLANRangeApplyConfiguration) WithEndID(value int32) *VLANRangeApplyConfiguration {
+	b.EndID = &value
+	return b
+}
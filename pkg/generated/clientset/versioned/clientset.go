@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	virtv1alpha1 "github.com/smartxworks/virtink/pkg/generated/clientset/versioned/typed/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/generated/clientset/versioned/typed/virt/v1beta1"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
@@ -15,6 +16,7 @@ import (
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	VirtV1alpha1() virtv1alpha1.VirtV1alpha1Interface
+	VirtV1beta1() virtv1beta1.VirtV1beta1Interface
 }
 
 // Clientset contains the clients for groups. Each group has exactly one
@@ -22,6 +24,7 @@ type Interface interface {
 type Clientset struct {
 	*discovery.DiscoveryClient
 	virtV1alpha1 *virtv1alpha1.VirtV1alpha1Client
+	virtV1beta1  *virtv1beta1.VirtV1beta1Client
 }
 
 // VirtV1alpha1 retrieves the VirtV1alpha1Client
@@ -29,6 +32,11 @@ func (c *Clientset) VirtV1alpha1() virtv1alpha1.VirtV1alpha1Interface {
 	return c.virtV1alpha1
 }
 
+// VirtV1beta1 retrieves the VirtV1beta1Client
+func (c *Clientset) VirtV1beta1() virtv1beta1.VirtV1beta1Interface {
+	return c.virtV1beta1
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -77,6 +85,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.virtV1beta1, err = virtv1beta1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
@@ -99,6 +111,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.virtV1alpha1 = virtv1alpha1.New(c)
+	cs.virtV1beta1 = virtv1beta1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
@@ -6,6 +6,8 @@ import (
 	clientset "github.com/smartxworks/virtink/pkg/generated/clientset/versioned"
 	virtv1alpha1 "github.com/smartxworks/virtink/pkg/generated/clientset/versioned/typed/virt/v1alpha1"
 	fakevirtv1alpha1 "github.com/smartxworks/virtink/pkg/generated/clientset/versioned/typed/virt/v1alpha1/fake"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/generated/clientset/versioned/typed/virt/v1beta1"
+	fakevirtv1beta1 "github.com/smartxworks/virtink/pkg/generated/clientset/versioned/typed/virt/v1beta1/fake"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
@@ -67,3 +69,8 @@ var (
 func (c *Clientset) VirtV1alpha1() virtv1alpha1.VirtV1alpha1Interface {
 	return &fakevirtv1alpha1.FakeVirtV1alpha1{Fake: &c.Fake}
 }
+
+// VirtV1beta1 retrieves the VirtV1beta1Client
+func (c *Clientset) VirtV1beta1() virtv1beta1.VirtV1beta1Interface {
+	return &fakevirtv1beta1.FakeVirtV1beta1{Fake: &c.Fake}
+}
@@ -16,6 +16,10 @@ func (c *FakeVirtV1alpha1) VirtualMachines(namespace string) v1alpha1.VirtualMac
 	return &FakeVirtualMachines{c, namespace}
 }
 
+func (c *FakeVirtV1alpha1) VirtualMachineExports(namespace string) v1alpha1.VirtualMachineExportInterface {
+	return &FakeVirtualMachineExports{c, namespace}
+}
+
 func (c *FakeVirtV1alpha1) VirtualMachineMigrations(namespace string) v1alpha1.VirtualMachineMigrationInterface {
 	return &FakeVirtualMachineMigrations{c, namespace}
 }
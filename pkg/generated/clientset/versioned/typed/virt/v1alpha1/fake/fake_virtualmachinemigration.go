@@ -4,8 +4,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/generated/applyconfiguration/virt/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -124,3 +127,48 @@ func (c *FakeVirtualMachineMigrations) Patch(ctx context.Context, name string, p
 	}
 	return obj.(*v1alpha1.VirtualMachineMigration), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied virtualMachineMigration.
+func (c *FakeVirtualMachineMigrations) Apply(ctx context.Context, virtualMachineMigration *virtv1alpha1.VirtualMachineMigrationApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VirtualMachineMigration, err error) {
+	if virtualMachineMigration == nil {
+		return nil, fmt.Errorf("virtualMachineMigration provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(virtualMachineMigration)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachineMigration.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineMigration.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachinemigrationsResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.VirtualMachineMigration{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineMigration), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVirtualMachineMigrations) ApplyStatus(ctx context.Context, virtualMachineMigration *virtv1alpha1.VirtualMachineMigrationApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VirtualMachineMigration, err error) {
+	if virtualMachineMigration == nil {
+		return nil, fmt.Errorf("virtualMachineMigration provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(virtualMachineMigration)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachineMigration.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineMigration.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachinemigrationsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha1.VirtualMachineMigration{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineMigration), err
+}
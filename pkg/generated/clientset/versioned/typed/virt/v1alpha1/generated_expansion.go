@@ -2,6 +2,6 @@
 
 package v1alpha1
 
-type VirtualMachineExpansion interface{}
+type VirtualMachineExportExpansion interface{}
 
 type VirtualMachineMigrationExpansion interface{}
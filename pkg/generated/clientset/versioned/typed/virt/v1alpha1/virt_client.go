@@ -13,6 +13,7 @@ import (
 type VirtV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	VirtualMachinesGetter
+	VirtualMachineExportsGetter
 	VirtualMachineMigrationsGetter
 }
 
@@ -25,6 +26,10 @@ func (c *VirtV1alpha1Client) VirtualMachines(namespace string) VirtualMachineInt
 	return newVirtualMachines(c, namespace)
 }
 
+func (c *VirtV1alpha1Client) VirtualMachineExports(namespace string) VirtualMachineExportInterface {
+	return newVirtualMachineExports(c, namespace)
+}
+
 func (c *VirtV1alpha1Client) VirtualMachineMigrations(namespace string) VirtualMachineMigrationInterface {
 	return newVirtualMachineMigrations(c, namespace)
 }
@@ -4,9 +4,12 @@ package v1alpha1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/generated/applyconfiguration/virt/v1alpha1"
 	scheme "github.com/smartxworks/virtink/pkg/generated/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -31,6 +34,8 @@ type VirtualMachineInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.VirtualMachineList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachine, err error)
+	Apply(ctx context.Context, virtualMachine *virtv1alpha1.VirtualMachineApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VirtualMachine, err error)
+	ApplyStatus(ctx context.Context, virtualMachine *virtv1alpha1.VirtualMachineApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VirtualMachine, err error)
 	VirtualMachineExpansion
 }
 
@@ -177,3 +182,59 @@ func (c *virtualMachines) Patch(ctx context.Context, name string, pt types.Patch
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied virtualMachine.
+func (c *virtualMachines) Apply(ctx context.Context, virtualMachine *virtv1alpha1.VirtualMachineApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VirtualMachine, err error) {
+	if virtualMachine == nil {
+		return nil, fmt.Errorf("virtualMachine provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(virtualMachine)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachine.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachine.Name must be provided to Apply")
+	}
+	result = &v1alpha1.VirtualMachine{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("virtualmachines").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *virtualMachines) ApplyStatus(ctx context.Context, virtualMachine *virtv1alpha1.VirtualMachineApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VirtualMachine, err error) {
+	if virtualMachine == nil {
+		return nil, fmt.Errorf("virtualMachine provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(virtualMachine)
+	if err != nil {
+		return nil, err
+	}
+
+	name := virtualMachine.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachine.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.VirtualMachine{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("virtualmachines").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,240 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/generated/applyconfiguration/virt/v1alpha1"
+	scheme "github.com/smartxworks/virtink/pkg/generated/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// VirtualMachineExportsGetter has a method to return a VirtualMachineExportInterface.
+// A group's client should implement this interface.
+type VirtualMachineExportsGetter interface {
+	VirtualMachineExports(namespace string) VirtualMachineExportInterface
+}
+
+// VirtualMachineExportInterface has methods to work with VirtualMachineExport resources.
+type VirtualMachineExportInterface interface {
+	Create(ctx context.Context, virtualMachineExport *v1alpha1.VirtualMachineExport, opts v1.CreateOptions) (*v1alpha1.VirtualMachineExport, error)
+	Update(ctx context.Context, virtualMachineExport *v1alpha1.VirtualMachineExport, opts v1.UpdateOptions) (*v1alpha1.VirtualMachineExport, error)
+	UpdateStatus(ctx context.Context, virtualMachineExport *v1alpha1.VirtualMachineExport, opts v1.UpdateOptions) (*v1alpha1.VirtualMachineExport, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.VirtualMachineExport, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.VirtualMachineExportList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachineExport, err error)
+	Apply(ctx context.Context, virtualMachineExport *virtv1alpha1.VirtualMachineExportApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VirtualMachineExport, err error)
+	ApplyStatus(ctx context.Context, virtualMachineExport *virtv1alpha1.VirtualMachineExportApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VirtualMachineExport, err error)
+	VirtualMachineExportExpansion
+}
+
+// virtualMachineExports implements VirtualMachineExportInterface
+type virtualMachineExports struct {
+	client rest.Interface
+	ns     string
+}
+
+// newVirtualMachineExports returns a VirtualMachineExports
+func newVirtualMachineExports(c *VirtV1alpha1Client, namespace string) *virtualMachineExports {
+	return &virtualMachineExports{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the virtualMachineExport, and returns the corresponding virtualMachineExport object, and an error if there is any.
+func (c *virtualMachineExports) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.VirtualMachineExport, err error) {
+	result = &v1alpha1.VirtualMachineExport{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachineexports").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineExports that match those selectors.
+func (c *virtualMachineExports) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.VirtualMachineExportList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.VirtualMachineExportList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachineexports").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineExports.
+func (c *virtualMachineExports) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachineexports").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a virtualMachineExport and creates it.  Returns the server's representation of the virtualMachineExport, and an error, if there is any.
+func (c *virtualMachineExports) Create(ctx context.Context, virtualMachineExport *v1alpha1.VirtualMachineExport, opts v1.CreateOptions) (result *v1alpha1.VirtualMachineExport, err error) {
+	result = &v1alpha1.VirtualMachineExport{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("virtualmachineexports").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineExport).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a virtualMachineExport and updates it. Returns the server's representation of the virtualMachineExport, and an error, if there is any.
+func (c *virtualMachineExports) Update(ctx context.Context, virtualMachineExport *v1alpha1.VirtualMachineExport, opts v1.UpdateOptions) (result *v1alpha1.VirtualMachineExport, err error) {
+	result = &v1alpha1.VirtualMachineExport{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtualmachineexports").
+		Name(virtualMachineExport.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineExport).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *virtualMachineExports) UpdateStatus(ctx context.Context, virtualMachineExport *v1alpha1.VirtualMachineExport, opts v1.UpdateOptions) (result *v1alpha1.VirtualMachineExport, err error) {
+	result = &v1alpha1.VirtualMachineExport{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtualmachineexports").
+		Name(virtualMachineExport.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineExport).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the virtualMachineExport and deletes it. Returns an error if one occurs.
+func (c *virtualMachineExports) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtualmachineexports").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *virtualMachineExports) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtualmachineexports").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched virtualMachineExport.
+func (c *virtualMachineExports) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachineExport, err error) {
+	result = &v1alpha1.VirtualMachineExport{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("virtualmachineexports").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied virtualMachineExport.
+func (c *virtualMachineExports) Apply(ctx context.Context, virtualMachineExport *virtv1alpha1.VirtualMachineExportApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VirtualMachineExport, err error) {
+	if virtualMachineExport == nil {
+		return nil, fmt.Errorf("virtualMachineExport provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(virtualMachineExport)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachineExport.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineExport.Name must be provided to Apply")
+	}
+	result = &v1alpha1.VirtualMachineExport{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("virtualmachineexports").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *virtualMachineExports) ApplyStatus(ctx context.Context, virtualMachineExport *virtv1alpha1.VirtualMachineExportApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VirtualMachineExport, err error) {
+	if virtualMachineExport == nil {
+		return nil, fmt.Errorf("virtualMachineExport provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(virtualMachineExport)
+	if err != nil {
+		return nil, err
+	}
+
+	name := virtualMachineExport.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineExport.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.VirtualMachineExport{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("virtualmachineexports").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
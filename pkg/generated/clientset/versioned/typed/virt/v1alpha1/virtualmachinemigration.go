@@ -4,9 +4,12 @@ package v1alpha1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/generated/applyconfiguration/virt/v1alpha1"
 	scheme "github.com/smartxworks/virtink/pkg/generated/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -31,6 +34,8 @@ type VirtualMachineMigrationInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.VirtualMachineMigrationList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachineMigration, err error)
+	Apply(ctx context.Context, virtualMachineMigration *virtv1alpha1.VirtualMachineMigrationApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VirtualMachineMigration, err error)
+	ApplyStatus(ctx context.Context, virtualMachineMigration *virtv1alpha1.VirtualMachineMigrationApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VirtualMachineMigration, err error)
 	VirtualMachineMigrationExpansion
 }
 
@@ -177,3 +182,59 @@ func (c *virtualMachineMigrations) Patch(ctx context.Context, name string, pt ty
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied virtualMachineMigration.
+func (c *virtualMachineMigrations) Apply(ctx context.Context, virtualMachineMigration *virtv1alpha1.VirtualMachineMigrationApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VirtualMachineMigration, err error) {
+	if virtualMachineMigration == nil {
+		return nil, fmt.Errorf("virtualMachineMigration provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(virtualMachineMigration)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachineMigration.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineMigration.Name must be provided to Apply")
+	}
+	result = &v1alpha1.VirtualMachineMigration{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("virtualmachinemigrations").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *virtualMachineMigrations) ApplyStatus(ctx context.Context, virtualMachineMigration *virtv1alpha1.VirtualMachineMigrationApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VirtualMachineMigration, err error) {
+	if virtualMachineMigration == nil {
+		return nil, fmt.Errorf("virtualMachineMigration provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(virtualMachineMigration)
+	if err != nil {
+		return nil, err
+	}
+
+	name := virtualMachineMigration.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineMigration.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.VirtualMachineMigration{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("virtualmachinemigrations").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
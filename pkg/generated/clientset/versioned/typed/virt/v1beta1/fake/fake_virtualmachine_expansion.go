@@ -0,0 +1,62 @@
+package fake
+
+import (
+	"context"
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/testing"
+
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+func (c *FakeVirtualMachines) Start(ctx context.Context, name string, opts metav1.PatchOptions) (*v1beta1.VirtualMachine, error) {
+	return c.setPowerAction(ctx, name, v1beta1.VirtualMachinePowerOn, opts)
+}
+
+func (c *FakeVirtualMachines) Stop(ctx context.Context, name string, opts metav1.PatchOptions) (*v1beta1.VirtualMachine, error) {
+	return c.setPowerAction(ctx, name, v1beta1.VirtualMachinePowerOff, opts)
+}
+
+func (c *FakeVirtualMachines) Restart(ctx context.Context, name string, opts metav1.PatchOptions) (*v1beta1.VirtualMachine, error) {
+	return c.setPowerAction(ctx, name, v1beta1.VirtualMachineReboot, opts)
+}
+
+func (c *FakeVirtualMachines) Freeze(ctx context.Context, name string, opts metav1.PatchOptions) (*v1beta1.VirtualMachine, error) {
+	return c.setPowerAction(ctx, name, v1beta1.VirtualMachinePause, opts)
+}
+
+func (c *FakeVirtualMachines) Unfreeze(ctx context.Context, name string, opts metav1.PatchOptions) (*v1beta1.VirtualMachine, error) {
+	return c.setPowerAction(ctx, name, v1beta1.VirtualMachineResume, opts)
+}
+
+func (c *FakeVirtualMachines) setPowerAction(ctx context.Context, name string, action v1beta1.VirtualMachinePowerAction, opts metav1.PatchOptions) (*v1beta1.VirtualMachine, error) {
+	data, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"powerAction": action,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.Patch(ctx, name, types.MergePatchType, data, opts, "status")
+}
+
+func (c *FakeVirtualMachines) Migrate(ctx context.Context, name string, opts metav1.CreateOptions) (*v1beta1.VirtualMachineMigration, error) {
+	vmm := &v1beta1.VirtualMachineMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: name + "-",
+		},
+		Spec: v1beta1.VirtualMachineMigrationSpec{
+			VirtualMachineName: name,
+		},
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(virtualmachinemigrationsResource, c.ns, vmm), &v1beta1.VirtualMachineMigration{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineMigration), err
+}
@@ -0,0 +1,174 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/generated/applyconfiguration/virt/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeVirtualMachineActions implements VirtualMachineActionInterface
+type FakeVirtualMachineActions struct {
+	Fake *FakeVirtV1beta1
+	ns   string
+}
+
+var virtualmachineactionsResource = schema.GroupVersionResource{Group: "virt.virtink.smartx.com", Version: "v1beta1", Resource: "virtualmachineactions"}
+
+var virtualmachineactionsKind = schema.GroupVersionKind{Group: "virt.virtink.smartx.com", Version: "v1beta1", Kind: "VirtualMachineAction"}
+
+// Get takes name of the virtualMachineAction, and returns the corresponding virtualMachineAction object, and an error if there is any.
+func (c *FakeVirtualMachineActions) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.VirtualMachineAction, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(virtualmachineactionsResource, c.ns, name), &v1beta1.VirtualMachineAction{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineAction), err
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineActions that match those selectors.
+func (c *FakeVirtualMachineActions) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.VirtualMachineActionList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(virtualmachineactionsResource, virtualmachineactionsKind, c.ns, opts), &v1beta1.VirtualMachineActionList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.VirtualMachineActionList{ListMeta: obj.(*v1beta1.VirtualMachineActionList).ListMeta}
+	for _, item := range obj.(*v1beta1.VirtualMachineActionList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineActions.
+func (c *FakeVirtualMachineActions) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(virtualmachineactionsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a virtualMachineAction and creates it.  Returns the server's representation of the virtualMachineAction, and an error, if there is any.
+func (c *FakeVirtualMachineActions) Create(ctx context.Context, virtualMachineAction *v1beta1.VirtualMachineAction, opts v1.CreateOptions) (result *v1beta1.VirtualMachineAction, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(virtualmachineactionsResource, c.ns, virtualMachineAction), &v1beta1.VirtualMachineAction{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineAction), err
+}
+
+// Update takes the representation of a virtualMachineAction and updates it. Returns the server's representation of the virtualMachineAction, and an error, if there is any.
+func (c *FakeVirtualMachineActions) Update(ctx context.Context, virtualMachineAction *v1beta1.VirtualMachineAction, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineAction, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(virtualmachineactionsResource, c.ns, virtualMachineAction), &v1beta1.VirtualMachineAction{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineAction), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVirtualMachineActions) UpdateStatus(ctx context.Context, virtualMachineAction *v1beta1.VirtualMachineAction, opts v1.UpdateOptions) (*v1beta1.VirtualMachineAction, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(virtualmachineactionsResource, "status", c.ns, virtualMachineAction), &v1beta1.VirtualMachineAction{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineAction), err
+}
+
+// Delete takes name of the virtualMachineAction and deletes it. Returns an error if one occurs.
+func (c *FakeVirtualMachineActions) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(virtualmachineactionsResource, c.ns, name, opts), &v1beta1.VirtualMachineAction{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVirtualMachineActions) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(virtualmachineactionsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.VirtualMachineActionList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched virtualMachineAction.
+func (c *FakeVirtualMachineActions) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineAction, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachineactionsResource, c.ns, name, pt, data, subresources...), &v1beta1.VirtualMachineAction{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineAction), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied virtualMachineAction.
+func (c *FakeVirtualMachineActions) Apply(ctx context.Context, virtualMachineAction *virtv1beta1.VirtualMachineActionApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineAction, err error) {
+	if virtualMachineAction == nil {
+		return nil, fmt.Errorf("virtualMachineAction provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(virtualMachineAction)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachineAction.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineAction.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachineactionsResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VirtualMachineAction{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineAction), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVirtualMachineActions) ApplyStatus(ctx context.Context, virtualMachineAction *virtv1beta1.VirtualMachineActionApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineAction, err error) {
+	if virtualMachineAction == nil {
+		return nil, fmt.Errorf("virtualMachineAction provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(virtualMachineAction)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachineAction.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineAction.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachineactionsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.VirtualMachineAction{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineAction), err
+}
@@ -0,0 +1,174 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/generated/applyconfiguration/virt/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeVirtualMachineQuotas implements VirtualMachineQuotaInterface
+type FakeVirtualMachineQuotas struct {
+	Fake *FakeVirtV1beta1
+	ns   string
+}
+
+var virtualmachinequotasResource = schema.GroupVersionResource{Group: "virt.virtink.smartx.com", Version: "v1beta1", Resource: "virtualmachinequotas"}
+
+var virtualmachinequotasKind = schema.GroupVersionKind{Group: "virt.virtink.smartx.com", Version: "v1beta1", Kind: "VirtualMachineQuota"}
+
+// Get takes name of the virtualMachineQuota, and returns the corresponding virtualMachineQuota object, and an error if there is any.
+func (c *FakeVirtualMachineQuotas) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.VirtualMachineQuota, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(virtualmachinequotasResource, c.ns, name), &v1beta1.VirtualMachineQuota{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineQuota), err
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineQuotas that match those selectors.
+func (c *FakeVirtualMachineQuotas) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.VirtualMachineQuotaList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(virtualmachinequotasResource, virtualmachinequotasKind, c.ns, opts), &v1beta1.VirtualMachineQuotaList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.VirtualMachineQuotaList{ListMeta: obj.(*v1beta1.VirtualMachineQuotaList).ListMeta}
+	for _, item := range obj.(*v1beta1.VirtualMachineQuotaList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineQuotas.
+func (c *FakeVirtualMachineQuotas) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(virtualmachinequotasResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a virtualMachineQuota and creates it.  Returns the server's representation of the virtualMachineQuota, and an error, if there is any.
+func (c *FakeVirtualMachineQuotas) Create(ctx context.Context, virtualMachineQuota *v1beta1.VirtualMachineQuota, opts v1.CreateOptions) (result *v1beta1.VirtualMachineQuota, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(virtualmachinequotasResource, c.ns, virtualMachineQuota), &v1beta1.VirtualMachineQuota{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineQuota), err
+}
+
+// Update takes the representation of a virtualMachineQuota and updates it. Returns the server's representation of the virtualMachineQuota, and an error, if there is any.
+func (c *FakeVirtualMachineQuotas) Update(ctx context.Context, virtualMachineQuota *v1beta1.VirtualMachineQuota, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineQuota, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(virtualmachinequotasResource, c.ns, virtualMachineQuota), &v1beta1.VirtualMachineQuota{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineQuota), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVirtualMachineQuotas) UpdateStatus(ctx context.Context, virtualMachineQuota *v1beta1.VirtualMachineQuota, opts v1.UpdateOptions) (*v1beta1.VirtualMachineQuota, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(virtualmachinequotasResource, "status", c.ns, virtualMachineQuota), &v1beta1.VirtualMachineQuota{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineQuota), err
+}
+
+// Delete takes name of the virtualMachineQuota and deletes it. Returns an error if one occurs.
+func (c *FakeVirtualMachineQuotas) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(virtualmachinequotasResource, c.ns, name, opts), &v1beta1.VirtualMachineQuota{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVirtualMachineQuotas) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(virtualmachinequotasResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.VirtualMachineQuotaList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched virtualMachineQuota.
+func (c *FakeVirtualMachineQuotas) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineQuota, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachinequotasResource, c.ns, name, pt, data, subresources...), &v1beta1.VirtualMachineQuota{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineQuota), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied virtualMachineQuota.
+func (c *FakeVirtualMachineQuotas) Apply(ctx context.Context, virtualMachineQuota *virtv1beta1.VirtualMachineQuotaApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineQuota, err error) {
+	if virtualMachineQuota == nil {
+		return nil, fmt.Errorf("virtualMachineQuota provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(virtualMachineQuota)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachineQuota.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineQuota.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachinequotasResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VirtualMachineQuota{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineQuota), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVirtualMachineQuotas) ApplyStatus(ctx context.Context, virtualMachineQuota *virtv1beta1.VirtualMachineQuotaApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineQuota, err error) {
+	if virtualMachineQuota == nil {
+		return nil, fmt.Errorf("virtualMachineQuota provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(virtualMachineQuota)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachineQuota.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineQuota.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachinequotasResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.VirtualMachineQuota{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineQuota), err
+}
@@ -0,0 +1,174 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/generated/applyconfiguration/virt/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeVirtualMachineRollingRestarts implements VirtualMachineRollingRestartInterface
+type FakeVirtualMachineRollingRestarts struct {
+	Fake *FakeVirtV1beta1
+	ns   string
+}
+
+var virtualmachinerollingrestartsResource = schema.GroupVersionResource{Group: "virt.virtink.smartx.com", Version: "v1beta1", Resource: "virtualmachinerollingrestarts"}
+
+var virtualmachinerollingrestartsKind = schema.GroupVersionKind{Group: "virt.virtink.smartx.com", Version: "v1beta1", Kind: "VirtualMachineRollingRestart"}
+
+// Get takes name of the virtualMachineRollingRestart, and returns the corresponding virtualMachineRollingRestart object, and an error if there is any.
+func (c *FakeVirtualMachineRollingRestarts) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.VirtualMachineRollingRestart, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(virtualmachinerollingrestartsResource, c.ns, name), &v1beta1.VirtualMachineRollingRestart{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineRollingRestart), err
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineRollingRestarts that match those selectors.
+func (c *FakeVirtualMachineRollingRestarts) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.VirtualMachineRollingRestartList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(virtualmachinerollingrestartsResource, virtualmachinerollingrestartsKind, c.ns, opts), &v1beta1.VirtualMachineRollingRestartList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.VirtualMachineRollingRestartList{ListMeta: obj.(*v1beta1.VirtualMachineRollingRestartList).ListMeta}
+	for _, item := range obj.(*v1beta1.VirtualMachineRollingRestartList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineRollingRestarts.
+func (c *FakeVirtualMachineRollingRestarts) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(virtualmachinerollingrestartsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a virtualMachineRollingRestart and creates it.  Returns the server's representation of the virtualMachineRollingRestart, and an error, if there is any.
+func (c *FakeVirtualMachineRollingRestarts) Create(ctx context.Context, virtualMachineRollingRestart *v1beta1.VirtualMachineRollingRestart, opts v1.CreateOptions) (result *v1beta1.VirtualMachineRollingRestart, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(virtualmachinerollingrestartsResource, c.ns, virtualMachineRollingRestart), &v1beta1.VirtualMachineRollingRestart{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineRollingRestart), err
+}
+
+// Update takes the representation of a virtualMachineRollingRestart and updates it. Returns the server's representation of the virtualMachineRollingRestart, and an error, if there is any.
+func (c *FakeVirtualMachineRollingRestarts) Update(ctx context.Context, virtualMachineRollingRestart *v1beta1.VirtualMachineRollingRestart, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineRollingRestart, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(virtualmachinerollingrestartsResource, c.ns, virtualMachineRollingRestart), &v1beta1.VirtualMachineRollingRestart{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineRollingRestart), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVirtualMachineRollingRestarts) UpdateStatus(ctx context.Context, virtualMachineRollingRestart *v1beta1.VirtualMachineRollingRestart, opts v1.UpdateOptions) (*v1beta1.VirtualMachineRollingRestart, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(virtualmachinerollingrestartsResource, "status", c.ns, virtualMachineRollingRestart), &v1beta1.VirtualMachineRollingRestart{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineRollingRestart), err
+}
+
+// Delete takes name of the virtualMachineRollingRestart and deletes it. Returns an error if one occurs.
+func (c *FakeVirtualMachineRollingRestarts) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(virtualmachinerollingrestartsResource, c.ns, name, opts), &v1beta1.VirtualMachineRollingRestart{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVirtualMachineRollingRestarts) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(virtualmachinerollingrestartsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.VirtualMachineRollingRestartList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched virtualMachineRollingRestart.
+func (c *FakeVirtualMachineRollingRestarts) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineRollingRestart, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachinerollingrestartsResource, c.ns, name, pt, data, subresources...), &v1beta1.VirtualMachineRollingRestart{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineRollingRestart), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied virtualMachineRollingRestart.
+func (c *FakeVirtualMachineRollingRestarts) Apply(ctx context.Context, virtualMachineRollingRestart *virtv1beta1.VirtualMachineRollingRestartApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineRollingRestart, err error) {
+	if virtualMachineRollingRestart == nil {
+		return nil, fmt.Errorf("virtualMachineRollingRestart provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(virtualMachineRollingRestart)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachineRollingRestart.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineRollingRestart.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachinerollingrestartsResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VirtualMachineRollingRestart{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineRollingRestart), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVirtualMachineRollingRestarts) ApplyStatus(ctx context.Context, virtualMachineRollingRestart *virtv1beta1.VirtualMachineRollingRestartApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineRollingRestart, err error) {
+	if virtualMachineRollingRestart == nil {
+		return nil, fmt.Errorf("virtualMachineRollingRestart provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(virtualMachineRollingRestart)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachineRollingRestart.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineRollingRestart.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachinerollingrestartsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.VirtualMachineRollingRestart{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineRollingRestart), err
+}
@@ -0,0 +1,139 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/generated/applyconfiguration/virt/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeVirtualMachineSecurityGroups implements VirtualMachineSecurityGroupInterface
+type FakeVirtualMachineSecurityGroups struct {
+	Fake *FakeVirtV1beta1
+	ns   string
+}
+
+var virtualmachinesecuritygroupsResource = schema.GroupVersionResource{Group: "virt.virtink.smartx.com", Version: "v1beta1", Resource: "virtualmachinesecuritygroups"}
+
+var virtualmachinesecuritygroupsKind = schema.GroupVersionKind{Group: "virt.virtink.smartx.com", Version: "v1beta1", Kind: "VirtualMachineSecurityGroup"}
+
+// Get takes name of the virtualMachineSecurityGroup, and returns the corresponding virtualMachineSecurityGroup object, and an error if there is any.
+func (c *FakeVirtualMachineSecurityGroups) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.VirtualMachineSecurityGroup, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(virtualmachinesecuritygroupsResource, c.ns, name), &v1beta1.VirtualMachineSecurityGroup{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineSecurityGroup), err
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineSecurityGroups that match those selectors.
+func (c *FakeVirtualMachineSecurityGroups) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.VirtualMachineSecurityGroupList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(virtualmachinesecuritygroupsResource, virtualmachinesecuritygroupsKind, c.ns, opts), &v1beta1.VirtualMachineSecurityGroupList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.VirtualMachineSecurityGroupList{ListMeta: obj.(*v1beta1.VirtualMachineSecurityGroupList).ListMeta}
+	for _, item := range obj.(*v1beta1.VirtualMachineSecurityGroupList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineSecurityGroups.
+func (c *FakeVirtualMachineSecurityGroups) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(virtualmachinesecuritygroupsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a virtualMachineSecurityGroup and creates it.  Returns the server's representation of the virtualMachineSecurityGroup, and an error, if there is any.
+func (c *FakeVirtualMachineSecurityGroups) Create(ctx context.Context, virtualMachineSecurityGroup *v1beta1.VirtualMachineSecurityGroup, opts v1.CreateOptions) (result *v1beta1.VirtualMachineSecurityGroup, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(virtualmachinesecuritygroupsResource, c.ns, virtualMachineSecurityGroup), &v1beta1.VirtualMachineSecurityGroup{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineSecurityGroup), err
+}
+
+// Update takes the representation of a virtualMachineSecurityGroup and updates it. Returns the server's representation of the virtualMachineSecurityGroup, and an error, if there is any.
+func (c *FakeVirtualMachineSecurityGroups) Update(ctx context.Context, virtualMachineSecurityGroup *v1beta1.VirtualMachineSecurityGroup, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineSecurityGroup, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(virtualmachinesecuritygroupsResource, c.ns, virtualMachineSecurityGroup), &v1beta1.VirtualMachineSecurityGroup{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineSecurityGroup), err
+}
+
+// Delete takes name of the virtualMachineSecurityGroup and deletes it. Returns an error if one occurs.
+func (c *FakeVirtualMachineSecurityGroups) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(virtualmachinesecuritygroupsResource, c.ns, name, opts), &v1beta1.VirtualMachineSecurityGroup{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVirtualMachineSecurityGroups) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(virtualmachinesecuritygroupsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.VirtualMachineSecurityGroupList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched virtualMachineSecurityGroup.
+func (c *FakeVirtualMachineSecurityGroups) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineSecurityGroup, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachinesecuritygroupsResource, c.ns, name, pt, data, subresources...), &v1beta1.VirtualMachineSecurityGroup{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineSecurityGroup), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied virtualMachineSecurityGroup.
+func (c *FakeVirtualMachineSecurityGroups) Apply(ctx context.Context, virtualMachineSecurityGroup *virtv1beta1.VirtualMachineSecurityGroupApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineSecurityGroup, err error) {
+	if virtualMachineSecurityGroup == nil {
+		return nil, fmt.Errorf("virtualMachineSecurityGroup provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(virtualMachineSecurityGroup)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachineSecurityGroup.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineSecurityGroup.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachinesecuritygroupsResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VirtualMachineSecurityGroup{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineSecurityGroup), err
+}
@@ -0,0 +1,174 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/generated/applyconfiguration/virt/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeVirtualMachineUsages implements VirtualMachineUsageInterface
+type FakeVirtualMachineUsages struct {
+	Fake *FakeVirtV1beta1
+	ns   string
+}
+
+var virtualmachineusagesResource = schema.GroupVersionResource{Group: "virt.virtink.smartx.com", Version: "v1beta1", Resource: "virtualmachineusages"}
+
+var virtualmachineusagesKind = schema.GroupVersionKind{Group: "virt.virtink.smartx.com", Version: "v1beta1", Kind: "VirtualMachineUsage"}
+
+// Get takes name of the virtualMachineUsage, and returns the corresponding virtualMachineUsage object, and an error if there is any.
+func (c *FakeVirtualMachineUsages) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.VirtualMachineUsage, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(virtualmachineusagesResource, c.ns, name), &v1beta1.VirtualMachineUsage{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineUsage), err
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineUsages that match those selectors.
+func (c *FakeVirtualMachineUsages) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.VirtualMachineUsageList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(virtualmachineusagesResource, virtualmachineusagesKind, c.ns, opts), &v1beta1.VirtualMachineUsageList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.VirtualMachineUsageList{ListMeta: obj.(*v1beta1.VirtualMachineUsageList).ListMeta}
+	for _, item := range obj.(*v1beta1.VirtualMachineUsageList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineUsages.
+func (c *FakeVirtualMachineUsages) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(virtualmachineusagesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a virtualMachineUsage and creates it.  Returns the server's representation of the virtualMachineUsage, and an error, if there is any.
+func (c *FakeVirtualMachineUsages) Create(ctx context.Context, virtualMachineUsage *v1beta1.VirtualMachineUsage, opts v1.CreateOptions) (result *v1beta1.VirtualMachineUsage, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(virtualmachineusagesResource, c.ns, virtualMachineUsage), &v1beta1.VirtualMachineUsage{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineUsage), err
+}
+
+// Update takes the representation of a virtualMachineUsage and updates it. Returns the server's representation of the virtualMachineUsage, and an error, if there is any.
+func (c *FakeVirtualMachineUsages) Update(ctx context.Context, virtualMachineUsage *v1beta1.VirtualMachineUsage, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineUsage, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(virtualmachineusagesResource, c.ns, virtualMachineUsage), &v1beta1.VirtualMachineUsage{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineUsage), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVirtualMachineUsages) UpdateStatus(ctx context.Context, virtualMachineUsage *v1beta1.VirtualMachineUsage, opts v1.UpdateOptions) (*v1beta1.VirtualMachineUsage, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(virtualmachineusagesResource, "status", c.ns, virtualMachineUsage), &v1beta1.VirtualMachineUsage{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineUsage), err
+}
+
+// Delete takes name of the virtualMachineUsage and deletes it. Returns an error if one occurs.
+func (c *FakeVirtualMachineUsages) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(virtualmachineusagesResource, c.ns, name, opts), &v1beta1.VirtualMachineUsage{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVirtualMachineUsages) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(virtualmachineusagesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.VirtualMachineUsageList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched virtualMachineUsage.
+func (c *FakeVirtualMachineUsages) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineUsage, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachineusagesResource, c.ns, name, pt, data, subresources...), &v1beta1.VirtualMachineUsage{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineUsage), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied virtualMachineUsage.
+func (c *FakeVirtualMachineUsages) Apply(ctx context.Context, virtualMachineUsage *virtv1beta1.VirtualMachineUsageApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineUsage, err error) {
+	if virtualMachineUsage == nil {
+		return nil, fmt.Errorf("virtualMachineUsage provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(virtualMachineUsage)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachineUsage.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineUsage.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachineusagesResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VirtualMachineUsage{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineUsage), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVirtualMachineUsages) ApplyStatus(ctx context.Context, virtualMachineUsage *virtv1beta1.VirtualMachineUsageApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineUsage, err error) {
+	if virtualMachineUsage == nil {
+		return nil, fmt.Errorf("virtualMachineUsage provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(virtualMachineUsage)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachineUsage.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineUsage.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachineusagesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.VirtualMachineUsage{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VirtualMachineUsage), err
+}
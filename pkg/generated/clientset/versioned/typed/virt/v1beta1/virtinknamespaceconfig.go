@@ -0,0 +1,192 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/generated/applyconfiguration/virt/v1beta1"
+	scheme "github.com/smartxworks/virtink/pkg/generated/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// VirtinkNamespaceConfigsGetter has a method to return a VirtinkNamespaceConfigInterface.
+// A group's client should implement this interface.
+type VirtinkNamespaceConfigsGetter interface {
+	VirtinkNamespaceConfigs(namespace string) VirtinkNamespaceConfigInterface
+}
+
+// VirtinkNamespaceConfigInterface has methods to work with VirtinkNamespaceConfig resources.
+type VirtinkNamespaceConfigInterface interface {
+	Create(ctx context.Context, virtinkNamespaceConfig *v1beta1.VirtinkNamespaceConfig, opts v1.CreateOptions) (*v1beta1.VirtinkNamespaceConfig, error)
+	Update(ctx context.Context, virtinkNamespaceConfig *v1beta1.VirtinkNamespaceConfig, opts v1.UpdateOptions) (*v1beta1.VirtinkNamespaceConfig, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.VirtinkNamespaceConfig, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.VirtinkNamespaceConfigList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtinkNamespaceConfig, err error)
+	Apply(ctx context.Context, virtinkNamespaceConfig *virtv1beta1.VirtinkNamespaceConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtinkNamespaceConfig, err error)
+	VirtinkNamespaceConfigExpansion
+}
+
+// virtinkNamespaceConfigs implements VirtinkNamespaceConfigInterface
+type virtinkNamespaceConfigs struct {
+	client rest.Interface
+	ns     string
+}
+
+// newVirtinkNamespaceConfigs returns a VirtinkNamespaceConfigs
+func newVirtinkNamespaceConfigs(c *VirtV1beta1Client, namespace string) *virtinkNamespaceConfigs {
+	return &virtinkNamespaceConfigs{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the virtinkNamespaceConfig, and returns the corresponding virtinkNamespaceConfig object, and an error if there is any.
+func (c *virtinkNamespaceConfigs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.VirtinkNamespaceConfig, err error) {
+	result = &v1beta1.VirtinkNamespaceConfig{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtinknamespaceconfigs").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of VirtinkNamespaceConfigs that match those selectors.
+func (c *virtinkNamespaceConfigs) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.VirtinkNamespaceConfigList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.VirtinkNamespaceConfigList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtinknamespaceconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested virtinkNamespaceConfigs.
+func (c *virtinkNamespaceConfigs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("virtinknamespaceconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a virtinkNamespaceConfig and creates it.  Returns the server's representation of the virtinkNamespaceConfig, and an error, if there is any.
+func (c *virtinkNamespaceConfigs) Create(ctx context.Context, virtinkNamespaceConfig *v1beta1.VirtinkNamespaceConfig, opts v1.CreateOptions) (result *v1beta1.VirtinkNamespaceConfig, err error) {
+	result = &v1beta1.VirtinkNamespaceConfig{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("virtinknamespaceconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtinkNamespaceConfig).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a virtinkNamespaceConfig and updates it. Returns the server's representation of the virtinkNamespaceConfig, and an error, if there is any.
+func (c *virtinkNamespaceConfigs) Update(ctx context.Context, virtinkNamespaceConfig *v1beta1.VirtinkNamespaceConfig, opts v1.UpdateOptions) (result *v1beta1.VirtinkNamespaceConfig, err error) {
+	result = &v1beta1.VirtinkNamespaceConfig{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtinknamespaceconfigs").
+		Name(virtinkNamespaceConfig.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtinkNamespaceConfig).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the virtinkNamespaceConfig and deletes it. Returns an error if one occurs.
+func (c *virtinkNamespaceConfigs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtinknamespaceconfigs").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *virtinkNamespaceConfigs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtinknamespaceconfigs").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched virtinkNamespaceConfig.
+func (c *virtinkNamespaceConfigs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtinkNamespaceConfig, err error) {
+	result = &v1beta1.VirtinkNamespaceConfig{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("virtinknamespaceconfigs").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied virtinkNamespaceConfig.
+func (c *virtinkNamespaceConfigs) Apply(ctx context.Context, virtinkNamespaceConfig *virtv1beta1.VirtinkNamespaceConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtinkNamespaceConfig, err error) {
+	if virtinkNamespaceConfig == nil {
+		return nil, fmt.Errorf("virtinkNamespaceConfig provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(virtinkNamespaceConfig)
+	if err != nil {
+		return nil, err
+	}
+	name := virtinkNamespaceConfig.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtinkNamespaceConfig.Name must be provided to Apply")
+	}
+	result = &v1beta1.VirtinkNamespaceConfig{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("virtinknamespaceconfigs").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
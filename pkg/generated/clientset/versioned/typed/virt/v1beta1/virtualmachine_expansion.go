@@ -0,0 +1,82 @@
+package v1beta1
+
+import (
+	"context"
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/generated/clientset/versioned/scheme"
+)
+
+// VirtualMachineExpansion has helpers for the imperative VM actions, which
+// are requested by setting status.powerAction or by creating a VMM.
+type VirtualMachineExpansion interface {
+	// Start powers on a powered-off VM.
+	Start(ctx context.Context, name string, opts metav1.PatchOptions) (*v1beta1.VirtualMachine, error)
+	// Stop powers off a running VM. Whether it is started again depends on
+	// the run policy of the VM.
+	Stop(ctx context.Context, name string, opts metav1.PatchOptions) (*v1beta1.VirtualMachine, error)
+	// Restart reboots a running VM.
+	Restart(ctx context.Context, name string, opts metav1.PatchOptions) (*v1beta1.VirtualMachine, error)
+	// Freeze pauses the vCPUs of a running VM.
+	Freeze(ctx context.Context, name string, opts metav1.PatchOptions) (*v1beta1.VirtualMachine, error)
+	// Unfreeze resumes the vCPUs of a paused VM.
+	Unfreeze(ctx context.Context, name string, opts metav1.PatchOptions) (*v1beta1.VirtualMachine, error)
+	// Migrate creates a VMM to live migrate a running VM to another node.
+	Migrate(ctx context.Context, name string, opts metav1.CreateOptions) (*v1beta1.VirtualMachineMigration, error)
+}
+
+func (c *virtualMachines) Start(ctx context.Context, name string, opts metav1.PatchOptions) (*v1beta1.VirtualMachine, error) {
+	return c.setPowerAction(ctx, name, v1beta1.VirtualMachinePowerOn, opts)
+}
+
+func (c *virtualMachines) Stop(ctx context.Context, name string, opts metav1.PatchOptions) (*v1beta1.VirtualMachine, error) {
+	return c.setPowerAction(ctx, name, v1beta1.VirtualMachinePowerOff, opts)
+}
+
+func (c *virtualMachines) Restart(ctx context.Context, name string, opts metav1.PatchOptions) (*v1beta1.VirtualMachine, error) {
+	return c.setPowerAction(ctx, name, v1beta1.VirtualMachineReboot, opts)
+}
+
+func (c *virtualMachines) Freeze(ctx context.Context, name string, opts metav1.PatchOptions) (*v1beta1.VirtualMachine, error) {
+	return c.setPowerAction(ctx, name, v1beta1.VirtualMachinePause, opts)
+}
+
+func (c *virtualMachines) Unfreeze(ctx context.Context, name string, opts metav1.PatchOptions) (*v1beta1.VirtualMachine, error) {
+	return c.setPowerAction(ctx, name, v1beta1.VirtualMachineResume, opts)
+}
+
+func (c *virtualMachines) setPowerAction(ctx context.Context, name string, action v1beta1.VirtualMachinePowerAction, opts metav1.PatchOptions) (*v1beta1.VirtualMachine, error) {
+	data, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"powerAction": action,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.Patch(ctx, name, types.MergePatchType, data, opts, "status")
+}
+
+func (c *virtualMachines) Migrate(ctx context.Context, name string, opts metav1.CreateOptions) (*v1beta1.VirtualMachineMigration, error) {
+	vmm := &v1beta1.VirtualMachineMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: name + "-",
+		},
+		Spec: v1beta1.VirtualMachineMigrationSpec{
+			VirtualMachineName: name,
+		},
+	}
+	result := &v1beta1.VirtualMachineMigration{}
+	err := c.client.Post().
+		Namespace(c.ns).
+		Resource("virtualmachinemigrations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(vmm).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
@@ -0,0 +1,240 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/generated/applyconfiguration/virt/v1beta1"
+	scheme "github.com/smartxworks/virtink/pkg/generated/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// VirtualMachineActionsGetter has a method to return a VirtualMachineActionInterface.
+// A group's client should implement this interface.
+type VirtualMachineActionsGetter interface {
+	VirtualMachineActions(namespace string) VirtualMachineActionInterface
+}
+
+// VirtualMachineActionInterface has methods to work with VirtualMachineAction resources.
+type VirtualMachineActionInterface interface {
+	Create(ctx context.Context, virtualMachineAction *v1beta1.VirtualMachineAction, opts v1.CreateOptions) (*v1beta1.VirtualMachineAction, error)
+	Update(ctx context.Context, virtualMachineAction *v1beta1.VirtualMachineAction, opts v1.UpdateOptions) (*v1beta1.VirtualMachineAction, error)
+	UpdateStatus(ctx context.Context, virtualMachineAction *v1beta1.VirtualMachineAction, opts v1.UpdateOptions) (*v1beta1.VirtualMachineAction, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.VirtualMachineAction, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.VirtualMachineActionList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineAction, err error)
+	Apply(ctx context.Context, virtualMachineAction *virtv1beta1.VirtualMachineActionApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineAction, err error)
+	ApplyStatus(ctx context.Context, virtualMachineAction *virtv1beta1.VirtualMachineActionApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineAction, err error)
+	VirtualMachineActionExpansion
+}
+
+// virtualMachineActions implements VirtualMachineActionInterface
+type virtualMachineActions struct {
+	client rest.Interface
+	ns     string
+}
+
+// newVirtualMachineActions returns a VirtualMachineActions
+func newVirtualMachineActions(c *VirtV1beta1Client, namespace string) *virtualMachineActions {
+	return &virtualMachineActions{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the virtualMachineAction, and returns the corresponding virtualMachineAction object, and an error if there is any.
+func (c *virtualMachineActions) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.VirtualMachineAction, err error) {
+	result = &v1beta1.VirtualMachineAction{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachineactions").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineActions that match those selectors.
+func (c *virtualMachineActions) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.VirtualMachineActionList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.VirtualMachineActionList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachineactions").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineActions.
+func (c *virtualMachineActions) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachineactions").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a virtualMachineAction and creates it.  Returns the server's representation of the virtualMachineAction, and an error, if there is any.
+func (c *virtualMachineActions) Create(ctx context.Context, virtualMachineAction *v1beta1.VirtualMachineAction, opts v1.CreateOptions) (result *v1beta1.VirtualMachineAction, err error) {
+	result = &v1beta1.VirtualMachineAction{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("virtualmachineactions").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineAction).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a virtualMachineAction and updates it. Returns the server's representation of the virtualMachineAction, and an error, if there is any.
+func (c *virtualMachineActions) Update(ctx context.Context, virtualMachineAction *v1beta1.VirtualMachineAction, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineAction, err error) {
+	result = &v1beta1.VirtualMachineAction{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtualmachineactions").
+		Name(virtualMachineAction.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineAction).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *virtualMachineActions) UpdateStatus(ctx context.Context, virtualMachineAction *v1beta1.VirtualMachineAction, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineAction, err error) {
+	result = &v1beta1.VirtualMachineAction{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtualmachineactions").
+		Name(virtualMachineAction.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineAction).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the virtualMachineAction and deletes it. Returns an error if one occurs.
+func (c *virtualMachineActions) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtualmachineactions").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *virtualMachineActions) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtualmachineactions").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched virtualMachineAction.
+func (c *virtualMachineActions) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineAction, err error) {
+	result = &v1beta1.VirtualMachineAction{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("virtualmachineactions").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied virtualMachineAction.
+func (c *virtualMachineActions) Apply(ctx context.Context, virtualMachineAction *virtv1beta1.VirtualMachineActionApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineAction, err error) {
+	if virtualMachineAction == nil {
+		return nil, fmt.Errorf("virtualMachineAction provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(virtualMachineAction)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachineAction.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineAction.Name must be provided to Apply")
+	}
+	result = &v1beta1.VirtualMachineAction{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("virtualmachineactions").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *virtualMachineActions) ApplyStatus(ctx context.Context, virtualMachineAction *virtv1beta1.VirtualMachineActionApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineAction, err error) {
+	if virtualMachineAction == nil {
+		return nil, fmt.Errorf("virtualMachineAction provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(virtualMachineAction)
+	if err != nil {
+		return nil, err
+	}
+
+	name := virtualMachineAction.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineAction.Name must be provided to Apply")
+	}
+
+	result = &v1beta1.VirtualMachineAction{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("virtualmachineactions").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
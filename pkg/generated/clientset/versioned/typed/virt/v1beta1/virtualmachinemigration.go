@@ -0,0 +1,240 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/generated/applyconfiguration/virt/v1beta1"
+	scheme "github.com/smartxworks/virtink/pkg/generated/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// VirtualMachineMigrationsGetter has a method to return a VirtualMachineMigrationInterface.
+// A group's client should implement this interface.
+type VirtualMachineMigrationsGetter interface {
+	VirtualMachineMigrations(namespace string) VirtualMachineMigrationInterface
+}
+
+// VirtualMachineMigrationInterface has methods to work with VirtualMachineMigration resources.
+type VirtualMachineMigrationInterface interface {
+	Create(ctx context.Context, virtualMachineMigration *v1beta1.VirtualMachineMigration, opts v1.CreateOptions) (*v1beta1.VirtualMachineMigration, error)
+	Update(ctx context.Context, virtualMachineMigration *v1beta1.VirtualMachineMigration, opts v1.UpdateOptions) (*v1beta1.VirtualMachineMigration, error)
+	UpdateStatus(ctx context.Context, virtualMachineMigration *v1beta1.VirtualMachineMigration, opts v1.UpdateOptions) (*v1beta1.VirtualMachineMigration, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.VirtualMachineMigration, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.VirtualMachineMigrationList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineMigration, err error)
+	Apply(ctx context.Context, virtualMachineMigration *virtv1beta1.VirtualMachineMigrationApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineMigration, err error)
+	ApplyStatus(ctx context.Context, virtualMachineMigration *virtv1beta1.VirtualMachineMigrationApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineMigration, err error)
+	VirtualMachineMigrationExpansion
+}
+
+// virtualMachineMigrations implements VirtualMachineMigrationInterface
+type virtualMachineMigrations struct {
+	client rest.Interface
+	ns     string
+}
+
+// newVirtualMachineMigrations returns a VirtualMachineMigrations
+func newVirtualMachineMigrations(c *VirtV1beta1Client, namespace string) *virtualMachineMigrations {
+	return &virtualMachineMigrations{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the virtualMachineMigration, and returns the corresponding virtualMachineMigration object, and an error if there is any.
+func (c *virtualMachineMigrations) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.VirtualMachineMigration, err error) {
+	result = &v1beta1.VirtualMachineMigration{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinemigrations").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineMigrations that match those selectors.
+func (c *virtualMachineMigrations) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.VirtualMachineMigrationList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.VirtualMachineMigrationList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinemigrations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineMigrations.
+func (c *virtualMachineMigrations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinemigrations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a virtualMachineMigration and creates it.  Returns the server's representation of the virtualMachineMigration, and an error, if there is any.
+func (c *virtualMachineMigrations) Create(ctx context.Context, virtualMachineMigration *v1beta1.VirtualMachineMigration, opts v1.CreateOptions) (result *v1beta1.VirtualMachineMigration, err error) {
+	result = &v1beta1.VirtualMachineMigration{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("virtualmachinemigrations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineMigration).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a virtualMachineMigration and updates it. Returns the server's representation of the virtualMachineMigration, and an error, if there is any.
+func (c *virtualMachineMigrations) Update(ctx context.Context, virtualMachineMigration *v1beta1.VirtualMachineMigration, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineMigration, err error) {
+	result = &v1beta1.VirtualMachineMigration{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtualmachinemigrations").
+		Name(virtualMachineMigration.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineMigration).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *virtualMachineMigrations) UpdateStatus(ctx context.Context, virtualMachineMigration *v1beta1.VirtualMachineMigration, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineMigration, err error) {
+	result = &v1beta1.VirtualMachineMigration{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtualmachinemigrations").
+		Name(virtualMachineMigration.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineMigration).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the virtualMachineMigration and deletes it. Returns an error if one occurs.
+func (c *virtualMachineMigrations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtualmachinemigrations").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *virtualMachineMigrations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtualmachinemigrations").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched virtualMachineMigration.
+func (c *virtualMachineMigrations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineMigration, err error) {
+	result = &v1beta1.VirtualMachineMigration{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("virtualmachinemigrations").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied virtualMachineMigration.
+func (c *virtualMachineMigrations) Apply(ctx context.Context, virtualMachineMigration *virtv1beta1.VirtualMachineMigrationApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineMigration, err error) {
+	if virtualMachineMigration == nil {
+		return nil, fmt.Errorf("virtualMachineMigration provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(virtualMachineMigration)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachineMigration.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineMigration.Name must be provided to Apply")
+	}
+	result = &v1beta1.VirtualMachineMigration{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("virtualmachinemigrations").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *virtualMachineMigrations) ApplyStatus(ctx context.Context, virtualMachineMigration *virtv1beta1.VirtualMachineMigrationApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineMigration, err error) {
+	if virtualMachineMigration == nil {
+		return nil, fmt.Errorf("virtualMachineMigration provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(virtualMachineMigration)
+	if err != nil {
+		return nil, err
+	}
+
+	name := virtualMachineMigration.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineMigration.Name must be provided to Apply")
+	}
+
+	result = &v1beta1.VirtualMachineMigration{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("virtualmachinemigrations").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
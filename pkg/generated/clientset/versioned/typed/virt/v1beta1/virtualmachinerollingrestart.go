@@ -0,0 +1,240 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/generated/applyconfiguration/virt/v1beta1"
+	scheme "github.com/smartxworks/virtink/pkg/generated/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// VirtualMachineRollingRestartsGetter has a method to return a VirtualMachineRollingRestartInterface.
+// A group's client should implement this interface.
+type VirtualMachineRollingRestartsGetter interface {
+	VirtualMachineRollingRestarts(namespace string) VirtualMachineRollingRestartInterface
+}
+
+// VirtualMachineRollingRestartInterface has methods to work with VirtualMachineRollingRestart resources.
+type VirtualMachineRollingRestartInterface interface {
+	Create(ctx context.Context, virtualMachineRollingRestart *v1beta1.VirtualMachineRollingRestart, opts v1.CreateOptions) (*v1beta1.VirtualMachineRollingRestart, error)
+	Update(ctx context.Context, virtualMachineRollingRestart *v1beta1.VirtualMachineRollingRestart, opts v1.UpdateOptions) (*v1beta1.VirtualMachineRollingRestart, error)
+	UpdateStatus(ctx context.Context, virtualMachineRollingRestart *v1beta1.VirtualMachineRollingRestart, opts v1.UpdateOptions) (*v1beta1.VirtualMachineRollingRestart, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.VirtualMachineRollingRestart, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.VirtualMachineRollingRestartList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineRollingRestart, err error)
+	Apply(ctx context.Context, virtualMachineRollingRestart *virtv1beta1.VirtualMachineRollingRestartApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineRollingRestart, err error)
+	ApplyStatus(ctx context.Context, virtualMachineRollingRestart *virtv1beta1.VirtualMachineRollingRestartApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineRollingRestart, err error)
+	VirtualMachineRollingRestartExpansion
+}
+
+// virtualMachineRollingRestarts implements VirtualMachineRollingRestartInterface
+type virtualMachineRollingRestarts struct {
+	client rest.Interface
+	ns     string
+}
+
+// newVirtualMachineRollingRestarts returns a VirtualMachineRollingRestarts
+func newVirtualMachineRollingRestarts(c *VirtV1beta1Client, namespace string) *virtualMachineRollingRestarts {
+	return &virtualMachineRollingRestarts{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the virtualMachineRollingRestart, and returns the corresponding virtualMachineRollingRestart object, and an error if there is any.
+func (c *virtualMachineRollingRestarts) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.VirtualMachineRollingRestart, err error) {
+	result = &v1beta1.VirtualMachineRollingRestart{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinerollingrestarts").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineRollingRestarts that match those selectors.
+func (c *virtualMachineRollingRestarts) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.VirtualMachineRollingRestartList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.VirtualMachineRollingRestartList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinerollingrestarts").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineRollingRestarts.
+func (c *virtualMachineRollingRestarts) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinerollingrestarts").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a virtualMachineRollingRestart and creates it.  Returns the server's representation of the virtualMachineRollingRestart, and an error, if there is any.
+func (c *virtualMachineRollingRestarts) Create(ctx context.Context, virtualMachineRollingRestart *v1beta1.VirtualMachineRollingRestart, opts v1.CreateOptions) (result *v1beta1.VirtualMachineRollingRestart, err error) {
+	result = &v1beta1.VirtualMachineRollingRestart{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("virtualmachinerollingrestarts").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineRollingRestart).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a virtualMachineRollingRestart and updates it. Returns the server's representation of the virtualMachineRollingRestart, and an error, if there is any.
+func (c *virtualMachineRollingRestarts) Update(ctx context.Context, virtualMachineRollingRestart *v1beta1.VirtualMachineRollingRestart, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineRollingRestart, err error) {
+	result = &v1beta1.VirtualMachineRollingRestart{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtualmachinerollingrestarts").
+		Name(virtualMachineRollingRestart.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineRollingRestart).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *virtualMachineRollingRestarts) UpdateStatus(ctx context.Context, virtualMachineRollingRestart *v1beta1.VirtualMachineRollingRestart, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineRollingRestart, err error) {
+	result = &v1beta1.VirtualMachineRollingRestart{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtualmachinerollingrestarts").
+		Name(virtualMachineRollingRestart.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineRollingRestart).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the virtualMachineRollingRestart and deletes it. Returns an error if one occurs.
+func (c *virtualMachineRollingRestarts) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtualmachinerollingrestarts").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *virtualMachineRollingRestarts) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtualmachinerollingrestarts").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched virtualMachineRollingRestart.
+func (c *virtualMachineRollingRestarts) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineRollingRestart, err error) {
+	result = &v1beta1.VirtualMachineRollingRestart{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("virtualmachinerollingrestarts").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied virtualMachineRollingRestart.
+func (c *virtualMachineRollingRestarts) Apply(ctx context.Context, virtualMachineRollingRestart *virtv1beta1.VirtualMachineRollingRestartApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineRollingRestart, err error) {
+	if virtualMachineRollingRestart == nil {
+		return nil, fmt.Errorf("virtualMachineRollingRestart provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(virtualMachineRollingRestart)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachineRollingRestart.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineRollingRestart.Name must be provided to Apply")
+	}
+	result = &v1beta1.VirtualMachineRollingRestart{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("virtualmachinerollingrestarts").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *virtualMachineRollingRestarts) ApplyStatus(ctx context.Context, virtualMachineRollingRestart *virtv1beta1.VirtualMachineRollingRestartApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineRollingRestart, err error) {
+	if virtualMachineRollingRestart == nil {
+		return nil, fmt.Errorf("virtualMachineRollingRestart provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(virtualMachineRollingRestart)
+	if err != nil {
+		return nil, err
+	}
+
+	name := virtualMachineRollingRestart.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineRollingRestart.Name must be provided to Apply")
+	}
+
+	result = &v1beta1.VirtualMachineRollingRestart{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("virtualmachinerollingrestarts").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
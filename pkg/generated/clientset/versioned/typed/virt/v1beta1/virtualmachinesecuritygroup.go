@@ -0,0 +1,192 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/generated/applyconfiguration/virt/v1beta1"
+	scheme "github.com/smartxworks/virtink/pkg/generated/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// VirtualMachineSecurityGroupsGetter has a method to return a VirtualMachineSecurityGroupInterface.
+// A group's client should implement this interface.
+type VirtualMachineSecurityGroupsGetter interface {
+	VirtualMachineSecurityGroups(namespace string) VirtualMachineSecurityGroupInterface
+}
+
+// VirtualMachineSecurityGroupInterface has methods to work with VirtualMachineSecurityGroup resources.
+type VirtualMachineSecurityGroupInterface interface {
+	Create(ctx context.Context, virtualMachineSecurityGroup *v1beta1.VirtualMachineSecurityGroup, opts v1.CreateOptions) (*v1beta1.VirtualMachineSecurityGroup, error)
+	Update(ctx context.Context, virtualMachineSecurityGroup *v1beta1.VirtualMachineSecurityGroup, opts v1.UpdateOptions) (*v1beta1.VirtualMachineSecurityGroup, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.VirtualMachineSecurityGroup, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.VirtualMachineSecurityGroupList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineSecurityGroup, err error)
+	Apply(ctx context.Context, virtualMachineSecurityGroup *virtv1beta1.VirtualMachineSecurityGroupApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineSecurityGroup, err error)
+	VirtualMachineSecurityGroupExpansion
+}
+
+// virtualMachineSecurityGroups implements VirtualMachineSecurityGroupInterface
+type virtualMachineSecurityGroups struct {
+	client rest.Interface
+	ns     string
+}
+
+// newVirtualMachineSecurityGroups returns a VirtualMachineSecurityGroups
+func newVirtualMachineSecurityGroups(c *VirtV1beta1Client, namespace string) *virtualMachineSecurityGroups {
+	return &virtualMachineSecurityGroups{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the virtualMachineSecurityGroup, and returns the corresponding virtualMachineSecurityGroup object, and an error if there is any.
+func (c *virtualMachineSecurityGroups) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.VirtualMachineSecurityGroup, err error) {
+	result = &v1beta1.VirtualMachineSecurityGroup{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinesecuritygroups").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineSecurityGroups that match those selectors.
+func (c *virtualMachineSecurityGroups) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.VirtualMachineSecurityGroupList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.VirtualMachineSecurityGroupList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinesecuritygroups").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineSecurityGroups.
+func (c *virtualMachineSecurityGroups) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinesecuritygroups").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a virtualMachineSecurityGroup and creates it.  Returns the server's representation of the virtualMachineSecurityGroup, and an error, if there is any.
+func (c *virtualMachineSecurityGroups) Create(ctx context.Context, virtualMachineSecurityGroup *v1beta1.VirtualMachineSecurityGroup, opts v1.CreateOptions) (result *v1beta1.VirtualMachineSecurityGroup, err error) {
+	result = &v1beta1.VirtualMachineSecurityGroup{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("virtualmachinesecuritygroups").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineSecurityGroup).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a virtualMachineSecurityGroup and updates it. Returns the server's representation of the virtualMachineSecurityGroup, and an error, if there is any.
+func (c *virtualMachineSecurityGroups) Update(ctx context.Context, virtualMachineSecurityGroup *v1beta1.VirtualMachineSecurityGroup, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineSecurityGroup, err error) {
+	result = &v1beta1.VirtualMachineSecurityGroup{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtualmachinesecuritygroups").
+		Name(virtualMachineSecurityGroup.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineSecurityGroup).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the virtualMachineSecurityGroup and deletes it. Returns an error if one occurs.
+func (c *virtualMachineSecurityGroups) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtualmachinesecuritygroups").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *virtualMachineSecurityGroups) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtualmachinesecuritygroups").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched virtualMachineSecurityGroup.
+func (c *virtualMachineSecurityGroups) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineSecurityGroup, err error) {
+	result = &v1beta1.VirtualMachineSecurityGroup{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("virtualmachinesecuritygroups").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied virtualMachineSecurityGroup.
+func (c *virtualMachineSecurityGroups) Apply(ctx context.Context, virtualMachineSecurityGroup *virtv1beta1.VirtualMachineSecurityGroupApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineSecurityGroup, err error) {
+	if virtualMachineSecurityGroup == nil {
+		return nil, fmt.Errorf("virtualMachineSecurityGroup provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(virtualMachineSecurityGroup)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachineSecurityGroup.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineSecurityGroup.Name must be provided to Apply")
+	}
+	result = &v1beta1.VirtualMachineSecurityGroup{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("virtualmachinesecuritygroups").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
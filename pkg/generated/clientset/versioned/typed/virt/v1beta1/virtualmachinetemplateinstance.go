@@ -0,0 +1,240 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/generated/applyconfiguration/virt/v1beta1"
+	scheme "github.com/smartxworks/virtink/pkg/generated/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// VirtualMachineTemplateInstancesGetter has a method to return a VirtualMachineTemplateInstanceInterface.
+// A group's client should implement this interface.
+type VirtualMachineTemplateInstancesGetter interface {
+	VirtualMachineTemplateInstances(namespace string) VirtualMachineTemplateInstanceInterface
+}
+
+// VirtualMachineTemplateInstanceInterface has methods to work with VirtualMachineTemplateInstance resources.
+type VirtualMachineTemplateInstanceInterface interface {
+	Create(ctx context.Context, virtualMachineTemplateInstance *v1beta1.VirtualMachineTemplateInstance, opts v1.CreateOptions) (*v1beta1.VirtualMachineTemplateInstance, error)
+	Update(ctx context.Context, virtualMachineTemplateInstance *v1beta1.VirtualMachineTemplateInstance, opts v1.UpdateOptions) (*v1beta1.VirtualMachineTemplateInstance, error)
+	UpdateStatus(ctx context.Context, virtualMachineTemplateInstance *v1beta1.VirtualMachineTemplateInstance, opts v1.UpdateOptions) (*v1beta1.VirtualMachineTemplateInstance, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.VirtualMachineTemplateInstance, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.VirtualMachineTemplateInstanceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineTemplateInstance, err error)
+	Apply(ctx context.Context, virtualMachineTemplateInstance *virtv1beta1.VirtualMachineTemplateInstanceApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineTemplateInstance, err error)
+	ApplyStatus(ctx context.Context, virtualMachineTemplateInstance *virtv1beta1.VirtualMachineTemplateInstanceApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineTemplateInstance, err error)
+	VirtualMachineTemplateInstanceExpansion
+}
+
+// virtualMachineTemplateInstances implements VirtualMachineTemplateInstanceInterface
+type virtualMachineTemplateInstances struct {
+	client rest.Interface
+	ns     string
+}
+
+// newVirtualMachineTemplateInstances returns a VirtualMachineTemplateInstances
+func newVirtualMachineTemplateInstances(c *VirtV1beta1Client, namespace string) *virtualMachineTemplateInstances {
+	return &virtualMachineTemplateInstances{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the virtualMachineTemplateInstance, and returns the corresponding virtualMachineTemplateInstance object, and an error if there is any.
+func (c *virtualMachineTemplateInstances) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.VirtualMachineTemplateInstance, err error) {
+	result = &v1beta1.VirtualMachineTemplateInstance{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinetemplateinstances").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineTemplateInstances that match those selectors.
+func (c *virtualMachineTemplateInstances) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.VirtualMachineTemplateInstanceList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.VirtualMachineTemplateInstanceList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinetemplateinstances").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineTemplateInstances.
+func (c *virtualMachineTemplateInstances) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinetemplateinstances").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a virtualMachineTemplateInstance and creates it.  Returns the server's representation of the virtualMachineTemplateInstance, and an error, if there is any.
+func (c *virtualMachineTemplateInstances) Create(ctx context.Context, virtualMachineTemplateInstance *v1beta1.VirtualMachineTemplateInstance, opts v1.CreateOptions) (result *v1beta1.VirtualMachineTemplateInstance, err error) {
+	result = &v1beta1.VirtualMachineTemplateInstance{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("virtualmachinetemplateinstances").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineTemplateInstance).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a virtualMachineTemplateInstance and updates it. Returns the server's representation of the virtualMachineTemplateInstance, and an error, if there is any.
+func (c *virtualMachineTemplateInstances) Update(ctx context.Context, virtualMachineTemplateInstance *v1beta1.VirtualMachineTemplateInstance, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineTemplateInstance, err error) {
+	result = &v1beta1.VirtualMachineTemplateInstance{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtualmachinetemplateinstances").
+		Name(virtualMachineTemplateInstance.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineTemplateInstance).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *virtualMachineTemplateInstances) UpdateStatus(ctx context.Context, virtualMachineTemplateInstance *v1beta1.VirtualMachineTemplateInstance, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineTemplateInstance, err error) {
+	result = &v1beta1.VirtualMachineTemplateInstance{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtualmachinetemplateinstances").
+		Name(virtualMachineTemplateInstance.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineTemplateInstance).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the virtualMachineTemplateInstance and deletes it. Returns an error if one occurs.
+func (c *virtualMachineTemplateInstances) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtualmachinetemplateinstances").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *virtualMachineTemplateInstances) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtualmachinetemplateinstances").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched virtualMachineTemplateInstance.
+func (c *virtualMachineTemplateInstances) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineTemplateInstance, err error) {
+	result = &v1beta1.VirtualMachineTemplateInstance{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("virtualmachinetemplateinstances").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied virtualMachineTemplateInstance.
+func (c *virtualMachineTemplateInstances) Apply(ctx context.Context, virtualMachineTemplateInstance *virtv1beta1.VirtualMachineTemplateInstanceApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineTemplateInstance, err error) {
+	if virtualMachineTemplateInstance == nil {
+		return nil, fmt.Errorf("virtualMachineTemplateInstance provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(virtualMachineTemplateInstance)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualMachineTemplateInstance.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineTemplateInstance.Name must be provided to Apply")
+	}
+	result = &v1beta1.VirtualMachineTemplateInstance{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("virtualmachinetemplateinstances").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *virtualMachineTemplateInstances) ApplyStatus(ctx context.Context, virtualMachineTemplateInstance *virtv1beta1.VirtualMachineTemplateInstanceApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VirtualMachineTemplateInstance, err error) {
+	if virtualMachineTemplateInstance == nil {
+		return nil, fmt.Errorf("virtualMachineTemplateInstance provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(virtualMachineTemplateInstance)
+	if err != nil {
+		return nil, err
+	}
+
+	name := virtualMachineTemplateInstance.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualMachineTemplateInstance.Name must be provided to Apply")
+	}
+
+	result = &v1beta1.VirtualMachineTemplateInstance{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("virtualmachinetemplateinstances").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
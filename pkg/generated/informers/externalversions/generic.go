@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	v1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -39,9 +40,39 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=virt.virtink.smartx.com, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithResource("virtualmachines"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Virt().V1alpha1().VirtualMachines().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("virtualmachineexports"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virt().V1alpha1().VirtualMachineExports().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("virtualmachinemigrations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Virt().V1alpha1().VirtualMachineMigrations().Informer()}, nil
 
+		// Group=virt.virtink.smartx.com, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("virtinkconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virt().V1beta1().VirtinkConfigs().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("virtinknamespaceconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virt().V1beta1().VirtinkNamespaceConfigs().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("virtualmachines"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virt().V1beta1().VirtualMachines().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("virtualmachineactions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virt().V1beta1().VirtualMachineActions().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("virtualmachineexports"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virt().V1beta1().VirtualMachineExports().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("virtualmachinemigrations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virt().V1beta1().VirtualMachineMigrations().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("virtualmachinepools"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virt().V1beta1().VirtualMachinePools().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("virtualmachinequotas"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virt().V1beta1().VirtualMachineQuotas().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("virtualmachinerollingrestarts"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virt().V1beta1().VirtualMachineRollingRestarts().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("virtualmachinesecuritygroups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virt().V1beta1().VirtualMachineSecurityGroups().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("virtualmachinetemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virt().V1beta1().VirtualMachineTemplates().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("virtualmachinetemplateinstances"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virt().V1beta1().VirtualMachineTemplateInstances().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("virtualmachineusages"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virt().V1beta1().VirtualMachineUsages().Informer()}, nil
+
 	}
 
 	return nil, fmt.Errorf("no informer found for %v", resource)
@@ -10,6 +10,8 @@ import (
 type Interface interface {
 	// VirtualMachines returns a VirtualMachineInformer.
 	VirtualMachines() VirtualMachineInformer
+	// VirtualMachineExports returns a VirtualMachineExportInformer.
+	VirtualMachineExports() VirtualMachineExportInformer
 	// VirtualMachineMigrations returns a VirtualMachineMigrationInformer.
 	VirtualMachineMigrations() VirtualMachineMigrationInformer
 }
@@ -30,6 +32,11 @@ func (v *version) VirtualMachines() VirtualMachineInformer {
 	return &virtualMachineInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// VirtualMachineExports returns a VirtualMachineExportInformer.
+func (v *version) VirtualMachineExports() VirtualMachineExportInformer {
+	return &virtualMachineExportInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // VirtualMachineMigrations returns a VirtualMachineMigrationInformer.
 func (v *version) VirtualMachineMigrations() VirtualMachineMigrationInformer {
 	return &virtualMachineMigrationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -0,0 +1,74 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	time "time"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	versioned "github.com/smartxworks/virtink/pkg/generated/clientset/versioned"
+	internalinterfaces "github.com/smartxworks/virtink/pkg/generated/informers/externalversions/internalinterfaces"
+	v1beta1 "github.com/smartxworks/virtink/pkg/generated/listers/virt/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// VirtinkNamespaceConfigInformer provides access to a shared informer and lister for
+// VirtinkNamespaceConfigs.
+type VirtinkNamespaceConfigInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1beta1.VirtinkNamespaceConfigLister
+}
+
+type virtinkNamespaceConfigInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewVirtinkNamespaceConfigInformer constructs a new informer for VirtinkNamespaceConfig type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewVirtinkNamespaceConfigInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredVirtinkNamespaceConfigInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredVirtinkNamespaceConfigInformer constructs a new informer for VirtinkNamespaceConfig type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredVirtinkNamespaceConfigInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.VirtV1beta1().VirtinkNamespaceConfigs(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.VirtV1beta1().VirtinkNamespaceConfigs(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&virtv1beta1.VirtinkNamespaceConfig{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *virtinkNamespaceConfigInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredVirtinkNamespaceConfigInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *virtinkNamespaceConfigInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&virtv1beta1.VirtinkNamespaceConfig{}, f.defaultInformer)
+}
+
+func (f *virtinkNamespaceConfigInformer) Lister() v1beta1.VirtinkNamespaceConfigLister {
+	return v1beta1.NewVirtinkNamespaceConfigLister(f.Informer().GetIndexer())
+}
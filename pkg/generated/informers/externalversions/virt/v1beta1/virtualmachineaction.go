@@ -0,0 +1,74 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	time "time"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	versioned "github.com/smartxworks/virtink/pkg/generated/clientset/versioned"
+	internalinterfaces "github.com/smartxworks/virtink/pkg/generated/informers/externalversions/internalinterfaces"
+	v1beta1 "github.com/smartxworks/virtink/pkg/generated/listers/virt/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineActionInformer provides access to a shared informer and lister for
+// VirtualMachineActions.
+type VirtualMachineActionInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1beta1.VirtualMachineActionLister
+}
+
+type virtualMachineActionInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewVirtualMachineActionInformer constructs a new informer for VirtualMachineAction type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewVirtualMachineActionInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredVirtualMachineActionInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredVirtualMachineActionInformer constructs a new informer for VirtualMachineAction type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredVirtualMachineActionInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.VirtV1beta1().VirtualMachineActions(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.VirtV1beta1().VirtualMachineActions(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&virtv1beta1.VirtualMachineAction{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *virtualMachineActionInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredVirtualMachineActionInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *virtualMachineActionInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&virtv1beta1.VirtualMachineAction{}, f.defaultInformer)
+}
+
+func (f *virtualMachineActionInformer) Lister() v1beta1.VirtualMachineActionLister {
+	return v1beta1.NewVirtualMachineActionLister(f.Informer().GetIndexer())
+}
@@ -10,6 +10,14 @@ type VirtualMachineListerExpansion interface{}
 // VirtualMachineNamespaceLister.
 type VirtualMachineNamespaceListerExpansion interface{}
 
+// VirtualMachineExportListerExpansion allows custom methods to be added to
+// VirtualMachineExportLister.
+type VirtualMachineExportListerExpansion interface{}
+
+// VirtualMachineExportNamespaceListerExpansion allows custom methods to be added to
+// VirtualMachineExportNamespaceLister.
+type VirtualMachineExportNamespaceListerExpansion interface{}
+
 // VirtualMachineMigrationListerExpansion allows custom methods to be added to
 // VirtualMachineMigrationLister.
 type VirtualMachineMigrationListerExpansion interface{}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineExportLister helps list VirtualMachineExports.
+// All objects returned here must be treated as read-only.
+type VirtualMachineExportLister interface {
+	// List lists all VirtualMachineExports in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.VirtualMachineExport, err error)
+	// VirtualMachineExports returns an object that can list and get VirtualMachineExports.
+	VirtualMachineExports(namespace string) VirtualMachineExportNamespaceLister
+	VirtualMachineExportListerExpansion
+}
+
+// virtualMachineExportLister implements the VirtualMachineExportLister interface.
+type virtualMachineExportLister struct {
+	indexer cache.Indexer
+}
+
+// NewVirtualMachineExportLister returns a new VirtualMachineExportLister.
+func NewVirtualMachineExportLister(indexer cache.Indexer) VirtualMachineExportLister {
+	return &virtualMachineExportLister{indexer: indexer}
+}
+
+// List lists all VirtualMachineExports in the indexer.
+func (s *virtualMachineExportLister) List(selector labels.Selector) (ret []*v1alpha1.VirtualMachineExport, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.VirtualMachineExport))
+	})
+	return ret, err
+}
+
+// VirtualMachineExports returns an object that can list and get VirtualMachineExports.
+func (s *virtualMachineExportLister) VirtualMachineExports(namespace string) VirtualMachineExportNamespaceLister {
+	return virtualMachineExportNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// VirtualMachineExportNamespaceLister helps list and get VirtualMachineExports.
+// All objects returned here must be treated as read-only.
+type VirtualMachineExportNamespaceLister interface {
+	// List lists all VirtualMachineExports in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.VirtualMachineExport, err error)
+	// Get retrieves the VirtualMachineExport from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.VirtualMachineExport, error)
+	VirtualMachineExportNamespaceListerExpansion
+}
+
+// virtualMachineExportNamespaceLister implements the VirtualMachineExportNamespaceLister
+// interface.
+type virtualMachineExportNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all VirtualMachineExports in the indexer for a given namespace.
+func (s virtualMachineExportNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.VirtualMachineExport, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.VirtualMachineExport))
+	})
+	return ret, err
+}
+
+// Get retrieves the VirtualMachineExport from the indexer for a given namespace and name.
+func (s virtualMachineExportNamespaceLister) Get(name string) (*v1alpha1.VirtualMachineExport, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("virtualmachineexport"), name)
+	}
+	return obj.(*v1alpha1.VirtualMachineExport), nil
+}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtinkNamespaceConfigLister helps list VirtinkNamespaceConfigs.
+// All objects returned here must be treated as read-only.
+type VirtinkNamespaceConfigLister interface {
+	// List lists all VirtinkNamespaceConfigs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.VirtinkNamespaceConfig, err error)
+	// VirtinkNamespaceConfigs returns an object that can list and get VirtinkNamespaceConfigs.
+	VirtinkNamespaceConfigs(namespace string) VirtinkNamespaceConfigNamespaceLister
+	VirtinkNamespaceConfigListerExpansion
+}
+
+// virtinkNamespaceConfigLister implements the VirtinkNamespaceConfigLister interface.
+type virtinkNamespaceConfigLister struct {
+	indexer cache.Indexer
+}
+
+// NewVirtinkNamespaceConfigLister returns a new VirtinkNamespaceConfigLister.
+func NewVirtinkNamespaceConfigLister(indexer cache.Indexer) VirtinkNamespaceConfigLister {
+	return &virtinkNamespaceConfigLister{indexer: indexer}
+}
+
+// List lists all VirtinkNamespaceConfigs in the indexer.
+func (s *virtinkNamespaceConfigLister) List(selector labels.Selector) (ret []*v1beta1.VirtinkNamespaceConfig, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.VirtinkNamespaceConfig))
+	})
+	return ret, err
+}
+
+// VirtinkNamespaceConfigs returns an object that can list and get VirtinkNamespaceConfigs.
+func (s *virtinkNamespaceConfigLister) VirtinkNamespaceConfigs(namespace string) VirtinkNamespaceConfigNamespaceLister {
+	return virtinkNamespaceConfigNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// VirtinkNamespaceConfigNamespaceLister helps list and get VirtinkNamespaceConfigs.
+// All objects returned here must be treated as read-only.
+type VirtinkNamespaceConfigNamespaceLister interface {
+	// List lists all VirtinkNamespaceConfigs in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.VirtinkNamespaceConfig, err error)
+	// Get retrieves the VirtinkNamespaceConfig from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.VirtinkNamespaceConfig, error)
+	VirtinkNamespaceConfigNamespaceListerExpansion
+}
+
+// virtinkNamespaceConfigNamespaceLister implements the VirtinkNamespaceConfigNamespaceLister
+// interface.
+type virtinkNamespaceConfigNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all VirtinkNamespaceConfigs in the indexer for a given namespace.
+func (s virtinkNamespaceConfigNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.VirtinkNamespaceConfig, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.VirtinkNamespaceConfig))
+	})
+	return ret, err
+}
+
+// Get retrieves the VirtinkNamespaceConfig from the indexer for a given namespace and name.
+func (s virtinkNamespaceConfigNamespaceLister) Get(name string) (*v1beta1.VirtinkNamespaceConfig, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("virtinknamespaceconfig"), name)
+	}
+	return obj.(*v1beta1.VirtinkNamespaceConfig), nil
+}
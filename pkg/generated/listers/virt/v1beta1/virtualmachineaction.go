@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineActionLister helps list VirtualMachineActions.
+// All objects returned here must be treated as read-only.
+type VirtualMachineActionLister interface {
+	// List lists all VirtualMachineActions in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.VirtualMachineAction, err error)
+	// VirtualMachineActions returns an object that can list and get VirtualMachineActions.
+	VirtualMachineActions(namespace string) VirtualMachineActionNamespaceLister
+	VirtualMachineActionListerExpansion
+}
+
+// virtualMachineActionLister implements the VirtualMachineActionLister interface.
+type virtualMachineActionLister struct {
+	indexer cache.Indexer
+}
+
+// NewVirtualMachineActionLister returns a new VirtualMachineActionLister.
+func NewVirtualMachineActionLister(indexer cache.Indexer) VirtualMachineActionLister {
+	return &virtualMachineActionLister{indexer: indexer}
+}
+
+// List lists all VirtualMachineActions in the indexer.
+func (s *virtualMachineActionLister) List(selector labels.Selector) (ret []*v1beta1.VirtualMachineAction, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.VirtualMachineAction))
+	})
+	return ret, err
+}
+
+// VirtualMachineActions returns an object that can list and get VirtualMachineActions.
+func (s *virtualMachineActionLister) VirtualMachineActions(namespace string) VirtualMachineActionNamespaceLister {
+	return virtualMachineActionNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// VirtualMachineActionNamespaceLister helps list and get VirtualMachineActions.
+// All objects returned here must be treated as read-only.
+type VirtualMachineActionNamespaceLister interface {
+	// List lists all VirtualMachineActions in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.VirtualMachineAction, err error)
+	// Get retrieves the VirtualMachineAction from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.VirtualMachineAction, error)
+	VirtualMachineActionNamespaceListerExpansion
+}
+
+// virtualMachineActionNamespaceLister implements the VirtualMachineActionNamespaceLister
+// interface.
+type virtualMachineActionNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all VirtualMachineActions in the indexer for a given namespace.
+func (s virtualMachineActionNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.VirtualMachineAction, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.VirtualMachineAction))
+	})
+	return ret, err
+}
+
+// Get retrieves the VirtualMachineAction from the indexer for a given namespace and name.
+func (s virtualMachineActionNamespaceLister) Get(name string) (*v1beta1.VirtualMachineAction, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("virtualmachineaction"), name)
+	}
+	return obj.(*v1beta1.VirtualMachineAction), nil
+}
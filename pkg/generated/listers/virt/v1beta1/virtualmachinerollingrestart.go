@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineRollingRestartLister helps list VirtualMachineRollingRestarts.
+// All objects returned here must be treated as read-only.
+type VirtualMachineRollingRestartLister interface {
+	// List lists all VirtualMachineRollingRestarts in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.VirtualMachineRollingRestart, err error)
+	// VirtualMachineRollingRestarts returns an object that can list and get VirtualMachineRollingRestarts.
+	VirtualMachineRollingRestarts(namespace string) VirtualMachineRollingRestartNamespaceLister
+	VirtualMachineRollingRestartListerExpansion
+}
+
+// virtualMachineRollingRestartLister implements the VirtualMachineRollingRestartLister interface.
+type virtualMachineRollingRestartLister struct {
+	indexer cache.Indexer
+}
+
+// NewVirtualMachineRollingRestartLister returns a new VirtualMachineRollingRestartLister.
+func NewVirtualMachineRollingRestartLister(indexer cache.Indexer) VirtualMachineRollingRestartLister {
+	return &virtualMachineRollingRestartLister{indexer: indexer}
+}
+
+// List lists all VirtualMachineRollingRestarts in the indexer.
+func (s *virtualMachineRollingRestartLister) List(selector labels.Selector) (ret []*v1beta1.VirtualMachineRollingRestart, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.VirtualMachineRollingRestart))
+	})
+	return ret, err
+}
+
+// VirtualMachineRollingRestarts returns an object that can list and get VirtualMachineRollingRestarts.
+func (s *virtualMachineRollingRestartLister) VirtualMachineRollingRestarts(namespace string) VirtualMachineRollingRestartNamespaceLister {
+	return virtualMachineRollingRestartNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// VirtualMachineRollingRestartNamespaceLister helps list and get VirtualMachineRollingRestarts.
+// All objects returned here must be treated as read-only.
+type VirtualMachineRollingRestartNamespaceLister interface {
+	// List lists all VirtualMachineRollingRestarts in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.VirtualMachineRollingRestart, err error)
+	// Get retrieves the VirtualMachineRollingRestart from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.VirtualMachineRollingRestart, error)
+	VirtualMachineRollingRestartNamespaceListerExpansion
+}
+
+// virtualMachineRollingRestartNamespaceLister implements the VirtualMachineRollingRestartNamespaceLister
+// interface.
+type virtualMachineRollingRestartNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all VirtualMachineRollingRestarts in the indexer for a given namespace.
+func (s virtualMachineRollingRestartNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.VirtualMachineRollingRestart, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.VirtualMachineRollingRestart))
+	})
+	return ret, err
+}
+
+// Get retrieves the VirtualMachineRollingRestart from the indexer for a given namespace and name.
+func (s virtualMachineRollingRestartNamespaceLister) Get(name string) (*v1beta1.VirtualMachineRollingRestart, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("virtualmachinerollingrestart"), name)
+	}
+	return obj.(*v1beta1.VirtualMachineRollingRestart), nil
+}
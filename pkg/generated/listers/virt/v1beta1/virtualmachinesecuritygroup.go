@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineSecurityGroupLister helps list VirtualMachineSecurityGroups.
+// All objects returned here must be treated as read-only.
+type VirtualMachineSecurityGroupLister interface {
+	// List lists all VirtualMachineSecurityGroups in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.VirtualMachineSecurityGroup, err error)
+	// VirtualMachineSecurityGroups returns an object that can list and get VirtualMachineSecurityGroups.
+	VirtualMachineSecurityGroups(namespace string) VirtualMachineSecurityGroupNamespaceLister
+	VirtualMachineSecurityGroupListerExpansion
+}
+
+// virtualMachineSecurityGroupLister implements the VirtualMachineSecurityGroupLister interface.
+type virtualMachineSecurityGroupLister struct {
+	indexer cache.Indexer
+}
+
+// NewVirtualMachineSecurityGroupLister returns a new VirtualMachineSecurityGroupLister.
+func NewVirtualMachineSecurityGroupLister(indexer cache.Indexer) VirtualMachineSecurityGroupLister {
+	return &virtualMachineSecurityGroupLister{indexer: indexer}
+}
+
+// List lists all VirtualMachineSecurityGroups in the indexer.
+func (s *virtualMachineSecurityGroupLister) List(selector labels.Selector) (ret []*v1beta1.VirtualMachineSecurityGroup, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.VirtualMachineSecurityGroup))
+	})
+	return ret, err
+}
+
+// VirtualMachineSecurityGroups returns an object that can list and get VirtualMachineSecurityGroups.
+func (s *virtualMachineSecurityGroupLister) VirtualMachineSecurityGroups(namespace string) VirtualMachineSecurityGroupNamespaceLister {
+	return virtualMachineSecurityGroupNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// VirtualMachineSecurityGroupNamespaceLister helps list and get VirtualMachineSecurityGroups.
+// All objects returned here must be treated as read-only.
+type VirtualMachineSecurityGroupNamespaceLister interface {
+	// List lists all VirtualMachineSecurityGroups in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.VirtualMachineSecurityGroup, err error)
+	// Get retrieves the VirtualMachineSecurityGroup from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.VirtualMachineSecurityGroup, error)
+	VirtualMachineSecurityGroupNamespaceListerExpansion
+}
+
+// virtualMachineSecurityGroupNamespaceLister implements the VirtualMachineSecurityGroupNamespaceLister
+// interface.
+type virtualMachineSecurityGroupNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all VirtualMachineSecurityGroups in the indexer for a given namespace.
+func (s virtualMachineSecurityGroupNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.VirtualMachineSecurityGroup, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.VirtualMachineSecurityGroup))
+	})
+	return ret, err
+}
+
+// Get retrieves the VirtualMachineSecurityGroup from the indexer for a given namespace and name.
+func (s virtualMachineSecurityGroupNamespaceLister) Get(name string) (*v1beta1.VirtualMachineSecurityGroup, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("virtualmachinesecuritygroup"), name)
+	}
+	return obj.(*v1beta1.VirtualMachineSecurityGroup), nil
+}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineTemplateInstanceLister helps list VirtualMachineTemplateInstances.
+// All objects returned here must be treated as read-only.
+type VirtualMachineTemplateInstanceLister interface {
+	// List lists all VirtualMachineTemplateInstances in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.VirtualMachineTemplateInstance, err error)
+	// VirtualMachineTemplateInstances returns an object that can list and get VirtualMachineTemplateInstances.
+	VirtualMachineTemplateInstances(namespace string) VirtualMachineTemplateInstanceNamespaceLister
+	VirtualMachineTemplateInstanceListerExpansion
+}
+
+// virtualMachineTemplateInstanceLister implements the VirtualMachineTemplateInstanceLister interface.
+type virtualMachineTemplateInstanceLister struct {
+	indexer cache.Indexer
+}
+
+// NewVirtualMachineTemplateInstanceLister returns a new VirtualMachineTemplateInstanceLister.
+func NewVirtualMachineTemplateInstanceLister(indexer cache.Indexer) VirtualMachineTemplateInstanceLister {
+	return &virtualMachineTemplateInstanceLister{indexer: indexer}
+}
+
+// List lists all VirtualMachineTemplateInstances in the indexer.
+func (s *virtualMachineTemplateInstanceLister) List(selector labels.Selector) (ret []*v1beta1.VirtualMachineTemplateInstance, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.VirtualMachineTemplateInstance))
+	})
+	return ret, err
+}
+
+// VirtualMachineTemplateInstances returns an object that can list and get VirtualMachineTemplateInstances.
+func (s *virtualMachineTemplateInstanceLister) VirtualMachineTemplateInstances(namespace string) VirtualMachineTemplateInstanceNamespaceLister {
+	return virtualMachineTemplateInstanceNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// VirtualMachineTemplateInstanceNamespaceLister helps list and get VirtualMachineTemplateInstances.
+// All objects returned here must be treated as read-only.
+type VirtualMachineTemplateInstanceNamespaceLister interface {
+	// List lists all VirtualMachineTemplateInstances in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.VirtualMachineTemplateInstance, err error)
+	// Get retrieves the VirtualMachineTemplateInstance from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.VirtualMachineTemplateInstance, error)
+	VirtualMachineTemplateInstanceNamespaceListerExpansion
+}
+
+// virtualMachineTemplateInstanceNamespaceLister implements the VirtualMachineTemplateInstanceNamespaceLister
+// interface.
+type virtualMachineTemplateInstanceNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all VirtualMachineTemplateInstances in the indexer for a given namespace.
+func (s virtualMachineTemplateInstanceNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.VirtualMachineTemplateInstance, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.VirtualMachineTemplateInstance))
+	})
+	return ret, err
+}
+
+// Get retrieves the VirtualMachineTemplateInstance from the indexer for a given namespace and name.
+func (s virtualMachineTemplateInstanceNamespaceLister) Get(name string) (*v1beta1.VirtualMachineTemplateInstance, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("virtualmachinetemplateinstance"), name)
+	}
+	return obj.(*v1beta1.VirtualMachineTemplateInstance), nil
+}
@@ -0,0 +1,196 @@
+// Package hooks lets sidecars in the VM pod customize VMs beyond what the
+// VirtualMachine API models. Hook sidecars are declared by the
+// HookSidecarsAnnotation of the VM, and serve the Hook gRPC service defined in
+// hooks.proto on a unix socket. Before booting the VM, virt-prerunner calls
+// the hooks one by one, passing each the Cloud Hypervisor config returned by
+// the previous one.
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	corev1 "k8s.io/api/core/v1"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/cloudhypervisor"
+)
+
+// HookSidecarsAnnotation is set on VMs to a JSON list of HookSidecar.
+const HookSidecarsAnnotation = "virtink.io/hook-sidecars"
+
+// SocketDirPath is where the sockets of hook sidecars are, in both the hook
+// sidecars and the VM container.
+const SocketDirPath = "/var/run/virtink-hooks"
+
+// SocketPathEnv is set on hook sidecars to the path of the socket to serve
+// the Hook service on.
+const SocketPathEnv = "HOOK_SOCKET_PATH"
+
+// HookSidecar is a container added to the VM pod to serve the Hook service.
+type HookSidecar struct {
+	Image           string                      `json:"image"`
+	ImagePullPolicy corev1.PullPolicy           `json:"imagePullPolicy,omitempty"`
+	Args            []string                    `json:"args,omitempty"`
+	Resources       corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// GetHookSidecars returns the hook sidecars declared in the annotations of a
+// VM, in the order the hooks are called.
+func GetHookSidecars(annotations map[string]string) ([]HookSidecar, error) {
+	data, ok := annotations[HookSidecarsAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	var sidecars []HookSidecar
+	if err := json.Unmarshal([]byte(data), &sidecars); err != nil {
+		return nil, err
+	}
+	for i, sidecar := range sidecars {
+		if sidecar.Image == "" {
+			return nil, fmt.Errorf("image of hook sidecar %d is empty", i)
+		}
+	}
+	return sidecars, nil
+}
+
+// SocketPath returns the path of the socket of the i-th hook sidecar.
+func SocketPath(i int) string {
+	return filepath.Join(SocketDirPath, fmt.Sprintf("%d.sock", i))
+}
+
+// OnDefineVMRequest is the JSON encoded request of the OnDefineVM method. The
+// response is the JSON encoded Cloud Hypervisor config to boot the VM with.
+type OnDefineVMRequest struct {
+	VM       *virtv1alpha1.VirtualMachine `json:"vm"`
+	VMConfig *cloudhypervisor.VmConfig    `json:"vmConfig"`
+}
+
+// HookServer is the server API for the Hook service.
+type HookServer interface {
+	OnDefineVM(ctx context.Context, req *wrapperspb.BytesValue) (*wrapperspb.BytesValue, error)
+}
+
+const hookServiceName = "virtink.hooks.v1alpha1.Hook"
+
+var hookServiceDesc = grpc.ServiceDesc{
+	ServiceName: hookServiceName,
+	HandlerType: (*HookServer)(nil),
+	Methods: []grpc.MethodDesc{{
+		MethodName: "OnDefineVM",
+		Handler:    handleOnDefineVM,
+	}},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hooks.proto",
+}
+
+func handleOnDefineVM(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := &wrapperspb.BytesValue{}
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnDefineVM(ctx, req)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + hookServiceName + "/OnDefineVM",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnDefineVM(ctx, req.(*wrapperspb.BytesValue))
+	}
+	return interceptor(ctx, req, info, handler)
+}
+
+// RegisterHookServer registers the Hook service to a gRPC server.
+func RegisterHookServer(s *grpc.Server, srv HookServer) {
+	s.RegisterService(&hookServiceDesc, srv)
+}
+
+// OnDefineVMFunc mutates the Cloud Hypervisor config of the VM in place.
+type OnDefineVMFunc func(ctx context.Context, vm *virtv1alpha1.VirtualMachine, vmConfig *cloudhypervisor.VmConfig) error
+
+type hookServer struct {
+	onDefineVM OnDefineVMFunc
+}
+
+func (s *hookServer) OnDefineVM(ctx context.Context, req *wrapperspb.BytesValue) (*wrapperspb.BytesValue, error) {
+	var defineReq OnDefineVMRequest
+	if err := json.Unmarshal(req.Value, &defineReq); err != nil {
+		return nil, fmt.Errorf("unmarshal request: %s", err)
+	}
+	if defineReq.VM == nil || defineReq.VMConfig == nil {
+		return nil, fmt.Errorf("VM and VM config are required")
+	}
+	if err := s.onDefineVM(ctx, defineReq.VM, defineReq.VMConfig); err != nil {
+		return nil, err
+	}
+	vmConfigJSON, err := json.Marshal(defineReq.VMConfig)
+	if err != nil {
+		return nil, fmt.Errorf("marshal VM config: %s", err)
+	}
+	return wrapperspb.Bytes(vmConfigJSON), nil
+}
+
+// Serve serves the Hook service with onDefineVM on the socket given by
+// SocketPathEnv until ctx is done. It's meant to be the main loop of hook
+// sidecars written in Go.
+func Serve(ctx context.Context, onDefineVM OnDefineVMFunc) error {
+	socketPath := os.Getenv(SocketPathEnv)
+	if socketPath == "" {
+		return fmt.Errorf("%s is not set", SocketPathEnv)
+	}
+	if err := os.RemoveAll(socketPath); err != nil {
+		return fmt.Errorf("remove socket: %s", err)
+	}
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return fmt.Errorf("listen: %s", err)
+	}
+
+	server := grpc.NewServer()
+	RegisterHookServer(server, &hookServer{onDefineVM: onDefineVM})
+	go func() {
+		<-ctx.Done()
+		server.GracefulStop()
+	}()
+	return server.Serve(listener)
+}
+
+// OnDefineVM calls the OnDefineVM method of the hook sidecar serving on the
+// socket, and returns the config it mutated. It waits for the hook sidecar to
+// serve until ctx is done.
+func OnDefineVM(ctx context.Context, socketPath string, vm *virtv1alpha1.VirtualMachine, vmConfig *cloudhypervisor.VmConfig) (*cloudhypervisor.VmConfig, error) {
+	reqJSON, err := json.Marshal(OnDefineVMRequest{
+		VM:       vm,
+		VMConfig: vmConfig,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %s", err)
+	}
+
+	conn, err := grpc.DialContext(ctx, "unix://"+socketPath, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	if err != nil {
+		return nil, fmt.Errorf("dial: %s", err)
+	}
+	defer conn.Close()
+
+	resp := &wrapperspb.BytesValue{}
+	if err := conn.Invoke(ctx, "/"+hookServiceName+"/OnDefineVM", wrapperspb.Bytes(reqJSON), resp); err != nil {
+		return nil, err
+	}
+
+	var newVMConfig cloudhypervisor.VmConfig
+	if err := json.Unmarshal(resp.Value, &newVMConfig); err != nil {
+		return nil, fmt.Errorf("unmarshal VM config: %s", err)
+	}
+	return &newVMConfig, nil
+}
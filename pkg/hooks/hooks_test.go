@@ -0,0 +1,83 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/cloudhypervisor"
+)
+
+func TestGetHookSidecars(t *testing.T) {
+	tests := []struct {
+		annotations map[string]string
+		sidecars    []HookSidecar
+		invalid     bool
+	}{{
+		annotations: nil,
+	}, {
+		annotations: map[string]string{HookSidecarsAnnotation: `[{"image":"hook-a"},{"image":"hook-b","args":["--verbose"]}]`},
+		sidecars:    []HookSidecar{{Image: "hook-a"}, {Image: "hook-b", Args: []string{"--verbose"}}},
+	}, {
+		annotations: map[string]string{HookSidecarsAnnotation: `[{"args":["--verbose"]}]`},
+		invalid:     true,
+	}, {
+		annotations: map[string]string{HookSidecarsAnnotation: `hook-a`},
+		invalid:     true,
+	}}
+
+	for _, tc := range tests {
+		sidecars, err := GetHookSidecars(tc.annotations)
+		if tc.invalid {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, tc.sidecars, sidecars)
+	}
+}
+
+func TestOnDefineVM(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "0.sock")
+	t.Setenv(SocketPathEnv, socketPath)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	serveErrCh := make(chan error, 1)
+	go func() {
+		serveErrCh <- Serve(ctx, func(ctx context.Context, vm *virtv1alpha1.VirtualMachine, vmConfig *cloudhypervisor.VmConfig) error {
+			if vm.Name == "bad" {
+				return fmt.Errorf("bad VM")
+			}
+			vmConfig.Pmem = append(vmConfig.Pmem, &cloudhypervisor.PmemConfig{
+				File: "/mnt/" + vm.Name + "/pmem",
+			})
+			return nil
+		})
+	}()
+
+	vm := &virtv1alpha1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: "ubuntu"}}
+	vmConfig := &cloudhypervisor.VmConfig{
+		Memory: &cloudhypervisor.MemoryConfig{Size: 1 << 30},
+	}
+	newVMConfig, err := OnDefineVM(ctx, socketPath, vm, vmConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, vmConfig.Memory, newVMConfig.Memory)
+	assert.Equal(t, []*cloudhypervisor.PmemConfig{{File: "/mnt/ubuntu/pmem"}}, newVMConfig.Pmem)
+
+	vm.Name = "bad"
+	_, err = OnDefineVM(ctx, socketPath, vm, vmConfig)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "bad VM")
+	}
+
+	cancel()
+	assert.NoError(t, <-serveErrCh)
+}
@@ -0,0 +1,224 @@
+// Package linkstate sets the interfaces of a VM down and up by the links that
+// connect them to their networks in the VM pod. virt-daemon can't enter the
+// network namespace of the VM pod, so the links are set by a server run by
+// virt-prerunner in the VM pod, which listens on a unix socket in the
+// directory shared with virt-daemon, next to the API socket of the hypervisor.
+package linkstate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// SocketName is the name of the socket of the server in the socket directory
+// of the VM pod.
+const SocketName = "link-state.sock"
+
+// Link is the link in the VM pod by which an interface is cut off from its
+// network.
+type Link struct {
+	// Name is the name of the link.
+	Name string `json:"name"`
+	// Carrier sets the carrier of the link instead of its administrative
+	// state. Taps are set by their carrier, as hypervisors fail to write to
+	// taps that are down, while a bridge drops the frames of ports without
+	// a carrier.
+	Carrier bool `json:"carrier,omitempty"`
+}
+
+// Links gets and sets the states of links.
+type Links interface {
+	IsUp(link Link) (bool, error)
+	SetUp(link Link, up bool) error
+}
+
+// Server keeps the links of interfaces in the states they were last set to.
+type Server struct {
+	links          Links
+	interfaceLinks map[string]Link
+	states         map[string]bool
+	mutex          sync.Mutex
+}
+
+// NewServer returns a server of the links of interfaces, which are up unless
+// the state of the interface is false in states.
+func NewServer(links Links, interfaceLinks map[string]Link, states map[string]bool) *Server {
+	s := &Server{
+		links:          links,
+		interfaceLinks: interfaceLinks,
+		states:         map[string]bool{},
+	}
+	for name := range interfaceLinks {
+		up, ok := states[name]
+		s.states[name] = up || !ok
+	}
+	return s
+}
+
+// Enforce sets the links of interfaces that are down back down if they've
+// been set up, as hypervisors turn on the carrier of taps when they open them.
+func (s *Server) Enforce() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for name, link := range s.interfaceLinks {
+		if s.states[name] {
+			continue
+		}
+		if err := s.setUp(link, false); err != nil {
+			return fmt.Errorf("set link of interface %q: %s", name, err)
+		}
+	}
+	return nil
+}
+
+func (s *Server) setUp(link Link, up bool) error {
+	linkUp, err := s.links.IsUp(link)
+	if err != nil {
+		return err
+	}
+	if linkUp == up {
+		return nil
+	}
+	return s.links.SetUp(link, up)
+}
+
+// ServeHTTP serves the states of links of interfaces:
+//
+//	GET /interfaces
+//	PUT /interfaces/<name>
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(r.URL.Path, "/")
+	switch {
+	case path == "interfaces" && r.Method == http.MethodGet:
+		s.handleGetStates(w)
+	case strings.HasPrefix(path, "interfaces/") && r.Method == http.MethodPut:
+		s.handleSetState(w, r, strings.TrimPrefix(path, "interfaces/"))
+	case path == "interfaces" || strings.HasPrefix(path, "interfaces/"):
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) handleGetStates(w http.ResponseWriter) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	states := map[string]bool{}
+	for name, link := range s.interfaceLinks {
+		up, err := s.links.IsUp(link)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("get link of interface %q: %s", name, err), http.StatusInternalServerError)
+			return
+		}
+		states[name] = up
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(states)
+}
+
+func (s *Server) handleSetState(w http.ResponseWriter, r *http.Request, name string) {
+	var state State
+	if err := json.NewDecoder(r.Body).Decode(&state); err != nil {
+		http.Error(w, fmt.Sprintf("decode state: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	link, ok := s.interfaceLinks[name]
+	if !ok {
+		http.Error(w, fmt.Sprintf("interface %q not found", name), http.StatusNotFound)
+		return
+	}
+	s.states[name] = state.Up
+	if err := s.setUp(link, state.Up); err != nil {
+		http.Error(w, fmt.Sprintf("set link of interface %q: %s", name, err), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// State is the state of the link of an interface.
+type State struct {
+	Up bool `json:"up"`
+}
+
+// Client talks to the server of a VM pod.
+type Client struct {
+	httpClient *http.Client
+}
+
+func NewClient(socketPath string) *Client {
+	return &Client{
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+					var dialer net.Dialer
+					return dialer.DialContext(ctx, "unix", socketPath)
+				},
+				DisableKeepAlives: true,
+			},
+		},
+	}
+}
+
+// GetStates returns whether the link of each interface is up, by interface
+// name.
+func (c *Client) GetStates(ctx context.Context) (map[string]bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost/interfaces", nil)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %s", err)
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var states map[string]bool
+	if err := json.NewDecoder(resp.Body).Decode(&states); err != nil {
+		return nil, fmt.Errorf("decode response: %s", err)
+	}
+	return states, nil
+}
+
+// SetState sets the link of the interface up or down.
+func (c *Client) SetState(ctx context.Context, name string, up bool) error {
+	reqBody, err := json.Marshal(&State{Up: up})
+	if err != nil {
+		return fmt.Errorf("encode request: %s", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "http://localhost/interfaces/"+name, bytes.NewReader(reqBody))
+	if err != nil {
+		return fmt.Errorf("build request: %s", err)
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %s", err)
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("request failed: %d %s: %s", resp.StatusCode, http.StatusText(resp.StatusCode), strings.TrimSpace(string(body)))
+	}
+	return resp, nil
+}
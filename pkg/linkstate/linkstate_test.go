@@ -0,0 +1,55 @@
+package linkstate
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeLinks map[string]bool
+
+func (l fakeLinks) IsUp(link Link) (bool, error) {
+	return l[link.Name], nil
+}
+
+func (l fakeLinks) SetUp(link Link, up bool) error {
+	l[link.Name] = up
+	return nil
+}
+
+func TestServer(t *testing.T) {
+	links := fakeLinks{"tap-eth0": true, "net1": true}
+	server := NewServer(links, map[string]Link{
+		"pod":  {Name: "tap-eth0", Carrier: true},
+		"data": {Name: "net1"},
+	}, map[string]bool{"data": false})
+
+	require.NoError(t, server.Enforce())
+	assert.Equal(t, fakeLinks{"tap-eth0": true, "net1": false}, links)
+
+	socketPath := filepath.Join(t.TempDir(), SocketName)
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	go http.Serve(listener, server)
+	defer listener.Close()
+
+	client := NewClient(socketPath)
+	states, err := client.GetStates(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"pod": true, "data": false}, states)
+
+	require.NoError(t, client.SetState(context.Background(), "pod", false))
+	require.NoError(t, client.SetState(context.Background(), "data", true))
+	assert.Equal(t, fakeLinks{"tap-eth0": false, "net1": true}, links)
+	assert.Error(t, client.SetState(context.Background(), "unknown", false))
+
+	// e.g. the hypervisor opened the tap again
+	links["tap-eth0"] = true
+	require.NoError(t, server.Enforce())
+	assert.Equal(t, fakeLinks{"tap-eth0": false, "net1": true}, links)
+}
@@ -0,0 +1,122 @@
+// Package logging sets up the structured loggers of Virtink components.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+// levelUpdateInterval is how often the log level of the Virtink config is
+// checked.
+const levelUpdateInterval = 10 * time.Second
+
+// NewLogger builds a logger from the options bound to the --zap-* flags. The
+// returned level is the one the logger starts with, and can be changed while
+// the logger is in use.
+func NewLogger(opts *crzap.Options) (logr.Logger, zap.AtomicLevel) {
+	level := zap.NewAtomicLevelAt(initialLevel(opts))
+	opts.Level = level
+	return crzap.New(crzap.UseFlagOptions(opts)), level
+}
+
+func initialLevel(opts *crzap.Options) zapcore.Level {
+	switch level := opts.Level.(type) {
+	case zap.AtomicLevel:
+		return level.Level()
+	case *zap.AtomicLevel:
+		return level.Level()
+	case zapcore.Level:
+		return level
+	}
+	if opts.Development {
+		return zapcore.DebugLevel
+	}
+	return zapcore.InfoLevel
+}
+
+// ParseLevel parses a log level the way the --zap-log-level flag does. It is
+// one of debug, info and error, or a positive number for more verbose debug
+// logs.
+func ParseLevel(s string) (zapcore.Level, error) {
+	switch s {
+	case "debug":
+		return zapcore.DebugLevel, nil
+	case "info":
+		return zapcore.InfoLevel, nil
+	case "error":
+		return zapcore.ErrorLevel, nil
+	}
+	verbosity, err := strconv.Atoi(s)
+	if err != nil || verbosity <= 0 || verbosity > 127 {
+		return 0, fmt.Errorf("invalid log level %q", s)
+	}
+	return zapcore.Level(-verbosity), nil
+}
+
+// VMKeysAndValues returns the key-value pairs identifying a VM in logs. They
+// match the ones controller-runtime adds when reconciling the VM, plus the
+// UID, so that logs of a VM can be correlated across components.
+func VMKeysAndValues(vm metav1.Object) []interface{} {
+	return []interface{}{
+		"virtualMachine", klog.KObj(vm),
+		"namespace", vm.GetNamespace(),
+		"name", vm.GetName(),
+		"uid", vm.GetUID(),
+	}
+}
+
+// LevelUpdater keeps the level of a logger in sync with the log level of the
+// Virtink config, falling back to DefaultLevel if it isn't set. It runs on
+// every replica, leader or not.
+type LevelUpdater struct {
+	Client       client.Reader
+	Level        zap.AtomicLevel
+	DefaultLevel zapcore.Level
+}
+
+func (u *LevelUpdater) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, u.update, levelUpdateInterval)
+	return nil
+}
+
+func (u *LevelUpdater) NeedLeaderElection() bool {
+	return false
+}
+
+func (u *LevelUpdater) update(ctx context.Context) {
+	log := ctrl.LoggerFrom(ctx).WithName("log-level-updater")
+
+	config, err := virtinkconfig.Get(ctx, u.Client)
+	if err != nil {
+		log.Error(err, "get Virtink config")
+		return
+	}
+
+	level := u.DefaultLevel
+	if config.Spec.Logging.Level != "" {
+		level, err = ParseLevel(config.Spec.Logging.Level)
+		if err != nil {
+			log.Error(err, "parse log level")
+			return
+		}
+	}
+
+	if u.Level.Level() != level {
+		u.Level.SetLevel(level)
+		log.Info("changed log level", "level", level)
+	}
+}
@@ -0,0 +1,82 @@
+package logging
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		level    string
+		expected zapcore.Level
+		invalid  bool
+	}{{
+		level:    "debug",
+		expected: zapcore.DebugLevel,
+	}, {
+		level:    "info",
+		expected: zapcore.InfoLevel,
+	}, {
+		level:    "error",
+		expected: zapcore.ErrorLevel,
+	}, {
+		level:    "3",
+		expected: zapcore.Level(-3),
+	}, {
+		level:   "0",
+		invalid: true,
+	}, {
+		level:   "verbose",
+		invalid: true,
+	}}
+
+	for _, tc := range tests {
+		level, err := ParseLevel(tc.level)
+		if tc.invalid {
+			assert.Error(t, err, tc.level)
+			continue
+		}
+		assert.NoError(t, err, tc.level)
+		assert.Equal(t, tc.expected, level, tc.level)
+	}
+}
+
+func TestLevelUpdater(t *testing.T) {
+	var scheme = runtime.NewScheme()
+	utilruntime.Must(virtv1beta1.AddToScheme(scheme))
+
+	config := &virtv1beta1.VirtinkConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "virtink",
+		},
+		Spec: virtv1beta1.VirtinkConfigSpec{
+			Logging: virtv1beta1.VirtinkConfigLogging{
+				Level: "2",
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(config).Build()
+	u := &LevelUpdater{
+		Client:       c,
+		Level:        zap.NewAtomicLevelAt(zapcore.InfoLevel),
+		DefaultLevel: zapcore.InfoLevel,
+	}
+
+	u.update(context.Background())
+	assert.Equal(t, zapcore.Level(-2), u.Level.Level())
+
+	config.Spec.Logging.Level = ""
+	assert.NoError(t, c.Update(context.Background(), config))
+	u.update(context.Background())
+	assert.Equal(t, zapcore.InfoLevel, u.Level.Level())
+}
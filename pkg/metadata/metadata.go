@@ -0,0 +1,180 @@
+// Package metadata serves the metadata of a VM to its guest in the formats of
+// the EC2 and OpenStack metadata services, which cloud-init and the agents of
+// other cloud images read from 169.254.169.254. The service is run by
+// virt-prerunner in the VM pod and is reached by the guest through the bridge
+// of masquerade interfaces.
+package metadata
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Address is the link-local address the metadata service listens on.
+const Address = "169.254.169.254"
+
+// Metadata is what the metadata service serves to the guest.
+type Metadata struct {
+	InstanceID string
+	Hostname   string
+	PublicKeys []string
+	UserData   []byte
+}
+
+// ec2VersionRegexp matches the versions of the EC2 metadata API, which are
+// all served the same metadata. Guests pin versions such as 2009-04-04.
+var ec2VersionRegexp = regexp.MustCompile(`^(latest|\d{4}-\d{2}-\d{2})$`)
+
+// ec2Token is handed out to guests which use the session tokens of IMDSv2.
+// Tokens are not checked, as the service is only reachable by the guest.
+const ec2Token = "virtink"
+
+// NewHandler returns the handler of the metadata service. The metadata is
+// got on every request, so that the guest sees changes to the secrets it is
+// read from.
+func NewHandler(get func() (*Metadata, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut && r.URL.Path == "/latest/api/token" {
+			w.Write([]byte(ec2Token))
+			return
+		}
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		md, err := get()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		var root *directory
+		path := strings.Trim(r.URL.Path, "/")
+		switch {
+		case path == "":
+			w.Write([]byte("latest\n"))
+			return
+		case path == "openstack" || strings.HasPrefix(path, "openstack/"):
+			root, path = openStackTree(md), strings.TrimPrefix(strings.TrimPrefix(path, "openstack"), "/")
+		default:
+			version, rest, _ := strings.Cut(path, "/")
+			if !ec2VersionRegexp.MatchString(version) {
+				http.NotFound(w, r)
+				return
+			}
+			root, path = ec2Tree(md), rest
+		}
+
+		entry, ok := root.lookup(path)
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		switch entry := entry.(type) {
+		case *directory:
+			w.Write([]byte(strings.Join(entry.list(), "\n")))
+		case []byte:
+			w.Write(entry)
+		}
+	})
+}
+
+// directory is a directory of the metadata tree, of which entries are either
+// directories or files of type []byte.
+type directory struct {
+	entries map[string]interface{}
+	// listing overrides the listing of the entries.
+	listing []string
+}
+
+func (d *directory) lookup(path string) (interface{}, bool) {
+	var entry interface{} = d
+	if path == "" {
+		return entry, true
+	}
+	for _, name := range strings.Split(path, "/") {
+		dir, ok := entry.(*directory)
+		if !ok {
+			return nil, false
+		}
+		if entry, ok = dir.entries[name]; !ok {
+			return nil, false
+		}
+	}
+	return entry, true
+}
+
+func (d *directory) list() []string {
+	if d.listing != nil {
+		return d.listing
+	}
+	var names []string
+	for name, entry := range d.entries {
+		if _, ok := entry.(*directory); ok {
+			name += "/"
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func ec2Tree(md *Metadata) *directory {
+	metaData := &directory{entries: map[string]interface{}{
+		"instance-id":    []byte(md.InstanceID),
+		"hostname":       []byte(md.Hostname),
+		"local-hostname": []byte(md.Hostname),
+	}}
+	if len(md.PublicKeys) > 0 {
+		// keys are listed as <index>=<name> as on EC2
+		publicKeys := &directory{entries: map[string]interface{}{}, listing: []string{}}
+		for i, key := range md.PublicKeys {
+			index := strconv.Itoa(i)
+			publicKeys.entries[index] = &directory{entries: map[string]interface{}{
+				"openssh-key": []byte(key),
+			}}
+			publicKeys.listing = append(publicKeys.listing, index+"=key-"+index)
+		}
+		metaData.entries["public-keys"] = publicKeys
+	}
+
+	version := &directory{entries: map[string]interface{}{
+		"meta-data": metaData,
+	}}
+	if md.UserData != nil {
+		version.entries["user-data"] = md.UserData
+	}
+	return version
+}
+
+func openStackTree(md *Metadata) *directory {
+	metaData := map[string]interface{}{
+		"uuid":         md.InstanceID,
+		"name":         md.Hostname,
+		"hostname":     md.Hostname,
+		"launch_index": 0,
+	}
+	if len(md.PublicKeys) > 0 {
+		publicKeys := map[string]string{}
+		for i, key := range md.PublicKeys {
+			publicKeys["key-"+strconv.Itoa(i)] = key
+		}
+		metaData["public_keys"] = publicKeys
+	}
+	metaDataJSON, _ := json.Marshal(metaData)
+
+	version := &directory{entries: map[string]interface{}{
+		"meta_data.json": metaDataJSON,
+	}}
+	if md.UserData != nil {
+		version.entries["user_data"] = md.UserData
+	}
+	return &directory{entries: map[string]interface{}{
+		"latest": version,
+	}}
+}
@@ -0,0 +1,127 @@
+package metadata
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler(t *testing.T) {
+	md := &Metadata{
+		InstanceID: "c9a1d4b8-5e1f-4d3c-9b6a-2f0e7c8d1a23",
+		Hostname:   "ubuntu",
+		PublicKeys: []string{"ssh-ed25519 AAAA foo", "ssh-ed25519 BBBB bar"},
+		UserData:   []byte("#cloud-config\n"),
+	}
+	handler := NewHandler(func() (*Metadata, error) {
+		return md, nil
+	})
+
+	tests := []struct {
+		method string
+		path   string
+		code   int
+		body   string
+	}{{
+		path: "/",
+		code: http.StatusOK,
+		body: "latest\n",
+	}, {
+		method: http.MethodPut,
+		path:   "/latest/api/token",
+		code:   http.StatusOK,
+		body:   ec2Token,
+	}, {
+		path: "/latest/",
+		code: http.StatusOK,
+		body: "meta-data/\nuser-data",
+	}, {
+		path: "/2009-04-04/meta-data/",
+		code: http.StatusOK,
+		body: "hostname\ninstance-id\nlocal-hostname\npublic-keys/",
+	}, {
+		path: "/2009-04-04/meta-data/instance-id",
+		code: http.StatusOK,
+		body: md.InstanceID,
+	}, {
+		path: "/latest/meta-data/public-keys/",
+		code: http.StatusOK,
+		body: "0=key-0\n1=key-1",
+	}, {
+		path: "/latest/meta-data/public-keys/1/openssh-key",
+		code: http.StatusOK,
+		body: "ssh-ed25519 BBBB bar",
+	}, {
+		path: "/latest/user-data",
+		code: http.StatusOK,
+		body: "#cloud-config\n",
+	}, {
+		path: "/latest/meta-data/instance-id/foo",
+		code: http.StatusNotFound,
+	}, {
+		path: "/foo/meta-data/instance-id",
+		code: http.StatusNotFound,
+	}, {
+		path: "/openstack",
+		code: http.StatusOK,
+		body: "latest/",
+	}, {
+		path: "/openstack/latest/user_data",
+		code: http.StatusOK,
+		body: "#cloud-config\n",
+	}, {
+		method: http.MethodPost,
+		path:   "/latest/user-data",
+		code:   http.StatusMethodNotAllowed,
+	}}
+
+	for _, tc := range tests {
+		method := tc.method
+		if method == "" {
+			method = http.MethodGet
+		}
+		t.Run(fmt.Sprintf("%s %s", method, tc.path), func(t *testing.T) {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(method, tc.path, nil))
+			assert.Equal(t, tc.code, w.Code)
+			if tc.code == http.StatusOK {
+				assert.Equal(t, tc.body, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandlerOpenStackMetaData(t *testing.T) {
+	handler := NewHandler(func() (*Metadata, error) {
+		return &Metadata{
+			InstanceID: "c9a1d4b8-5e1f-4d3c-9b6a-2f0e7c8d1a23",
+			Hostname:   "ubuntu",
+			PublicKeys: []string{"ssh-ed25519 AAAA foo"},
+		}, nil
+	})
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openstack/latest/meta_data.json", nil))
+	if !assert.Equal(t, http.StatusOK, w.Code) {
+		return
+	}
+	var metaData struct {
+		UUID       string            `json:"uuid"`
+		Hostname   string            `json:"hostname"`
+		PublicKeys map[string]string `json:"public_keys"`
+	}
+	if !assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &metaData)) {
+		return
+	}
+	assert.Equal(t, "c9a1d4b8-5e1f-4d3c-9b6a-2f0e7c8d1a23", metaData.UUID)
+	assert.Equal(t, "ubuntu", metaData.Hostname)
+	assert.Equal(t, map[string]string{"key-0": "ssh-ed25519 AAAA foo"}, metaData.PublicKeys)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openstack/latest/user_data", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
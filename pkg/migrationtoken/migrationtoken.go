@@ -0,0 +1,168 @@
+// Package migrationtoken authenticates the connections of a migration between
+// the virt-daemons of its source and target nodes. Every migration has a
+// random token in a Secret in the namespace of Virtink, which users can't
+// read. virt-controller creates the Secret when the migration starts and
+// deletes it once the migration finishes. The source sends the token right
+// after the TLS handshake of every connection, and the target only accepts
+// the connections that present it, so that holding a certificate signed by
+// the virt-daemon CA is not enough to feed a VM to a migration target.
+package migrationtoken
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// Size is the number of bytes of a token.
+	Size = 32
+	// SecretKey is the key of the token in the data of its Secret.
+	SecretKey = "token"
+
+	// exchangeTimeout bounds the TLS handshake and the token exchange of a
+	// connection.
+	exchangeTimeout = 10 * time.Second
+)
+
+// SecretName returns the name of the Secret of the token of the migration.
+func SecretName(migrationUID types.UID) string {
+	return fmt.Sprintf("virtink-migration-%s", migrationUID)
+}
+
+// Create creates the Secret of a new random token for the migration in the
+// namespace.
+func Create(ctx context.Context, c client.Client, namespace string, migrationUID types.UID) error {
+	token := make([]byte, Size)
+	if _, err := rand.Read(token); err != nil {
+		return fmt.Errorf("generate token: %s", err)
+	}
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      SecretName(migrationUID),
+		},
+		Data: map[string][]byte{SecretKey: token},
+	}
+	if err := c.Create(ctx, &secret); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// Delete deletes the Secret of the token of the migration, if any.
+func Delete(ctx context.Context, c client.Client, namespace string, migrationUID types.UID) error {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      SecretName(migrationUID),
+		},
+	}
+	return client.IgnoreNotFound(c.Delete(ctx, &secret))
+}
+
+// Get returns the token of the migration.
+func Get(ctx context.Context, c client.Reader, namespace string, migrationUID types.UID) ([]byte, error) {
+	var secret corev1.Secret
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: SecretName(migrationUID)}, &secret); err != nil {
+		return nil, err
+	}
+	token := secret.Data[SecretKey]
+	if len(token) != Size {
+		return nil, fmt.Errorf("invalid token in Secret %q", secret.Name)
+	}
+	return token, nil
+}
+
+// Send sends the token over a new connection to the migration target.
+func Send(conn net.Conn, token []byte) error {
+	if err := conn.SetWriteDeadline(time.Now().Add(exchangeTimeout)); err != nil {
+		return err
+	}
+	if _, err := conn.Write(token); err != nil {
+		return fmt.Errorf("send migration token: %s", err)
+	}
+	return conn.SetWriteDeadline(time.Time{})
+}
+
+// verify reads the token from a new connection from the migration source
+// and compares it with token in constant time.
+func verify(conn net.Conn, token []byte) error {
+	if err := conn.SetReadDeadline(time.Now().Add(exchangeTimeout)); err != nil {
+		return err
+	}
+	received := make([]byte, len(token))
+	if _, err := io.ReadFull(conn, received); err != nil {
+		return fmt.Errorf("receive migration token: %s", err)
+	}
+	if subtle.ConstantTimeCompare(received, token) != 1 {
+		return errors.New("invalid migration token")
+	}
+	return conn.SetReadDeadline(time.Time{})
+}
+
+// NewListener returns a listener accepting the connections of listener that
+// present the token, which are verified concurrently, so that peers that
+// never send a token don't hold up others. Other connections are closed.
+func NewListener(listener net.Listener, token []byte) net.Listener {
+	l := &listenerWithToken{
+		Listener: listener,
+		token:    token,
+		conns:    make(chan net.Conn),
+		done:     make(chan struct{}),
+	}
+	go l.serve()
+	return l
+}
+
+type listenerWithToken struct {
+	net.Listener
+	token []byte
+	conns chan net.Conn
+	// err is the error the underlying listener failed with, e.g. when it's
+	// closed, valid once done is closed
+	err  error
+	done chan struct{}
+}
+
+func (l *listenerWithToken) serve() {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			l.err = err
+			close(l.done)
+			return
+		}
+		go func() {
+			if err := verify(conn, l.token); err != nil {
+				conn.Close()
+				return
+			}
+			select {
+			case l.conns <- conn:
+			case <-l.done:
+				conn.Close()
+			}
+		}()
+	}
+}
+
+func (l *listenerWithToken) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.done:
+		return nil, l.err
+	}
+}
@@ -0,0 +1,104 @@
+package migrationtoken
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSecret(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	ctx := context.Background()
+
+	_, err := Get(ctx, c, "virtink-system", "migration-uid")
+	assert.Error(t, err)
+
+	require.NoError(t, Create(ctx, c, "virtink-system", "migration-uid"))
+	token, err := Get(ctx, c, "virtink-system", "migration-uid")
+	require.NoError(t, err)
+	assert.Len(t, token, Size)
+
+	// creating the token again keeps the existing one
+	require.NoError(t, Create(ctx, c, "virtink-system", "migration-uid"))
+	sameToken, err := Get(ctx, c, "virtink-system", "migration-uid")
+	require.NoError(t, err)
+	assert.Equal(t, token, sameToken)
+
+	require.NoError(t, Delete(ctx, c, "virtink-system", "migration-uid"))
+	require.NoError(t, Delete(ctx, c, "virtink-system", "migration-uid"))
+	_, err = Get(ctx, c, "virtink-system", "migration-uid")
+	assert.Error(t, err)
+}
+
+func TestListener(t *testing.T) {
+	token := bytes.Repeat([]byte{1}, Size)
+	tcpListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	listener := NewListener(tcpListener, token)
+	defer listener.Close()
+
+	accepted := make(chan string)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				close(accepted)
+				return
+			}
+			data, _ := io.ReadAll(conn)
+			conn.Close()
+			accepted <- string(data)
+		}
+	}()
+
+	dial := func() net.Conn {
+		conn, err := net.Dial("tcp", listener.Addr().String())
+		require.NoError(t, err)
+		return conn
+	}
+
+	// a peer that never sends a token doesn't hold up others
+	silentConn := dial()
+	defer silentConn.Close()
+
+	invalidConn := dial()
+	require.NoError(t, Send(invalidConn, bytes.Repeat([]byte{2}, Size)))
+	_, err = invalidConn.Write([]byte("invalid"))
+	require.NoError(t, err)
+	invalidConn.(*net.TCPConn).CloseWrite()
+	invalidConn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, err = invalidConn.Read(make([]byte, 1))
+	assert.Error(t, err, "connections with an invalid token are closed")
+	invalidConn.Close()
+
+	validConn := dial()
+	require.NoError(t, Send(validConn, token))
+	_, err = validConn.Write([]byte("valid"))
+	require.NoError(t, err)
+	validConn.(*net.TCPConn).CloseWrite()
+	select {
+	case data := <-accepted:
+		assert.Equal(t, "valid", data)
+	case <-time.After(5 * time.Second):
+		t.Fatal("connection with the valid token not accepted")
+	}
+
+	listener.Close()
+	select {
+	case _, ok := <-accepted:
+		assert.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Accept not failing after the listener is closed")
+	}
+}
@@ -0,0 +1,13 @@
+// Package admin holds the RBAC markers of the virtink-admin ClusterRole. On top
+// of virtink-edit, it allows managing the Virtink configuration of the
+// namespace, and the cluster-wide one when bound with a ClusterRoleBinding.
+package admin
+
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines;virtualmachinemigrations;virtualmachineexports;virtualmachineactions;virtualmachinetemplates;virtualmachinetemplateinstances;virtualmachinepools;virtualmachinerollingrestarts;virtualmachinesecuritygroups;virtinknamespaceconfigs,verbs=get;list;watch;create;update;patch;delete;deletecollection
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinequotas;virtualmachineusages,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinepools/scale,verbs=get;update;patch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines/start;virtualmachines/stop;virtualmachines/restart;virtualmachines/pause;virtualmachines/resume,verbs=update
+// +kubebuilder:rbac:groups=subresources.virtink.smartx.com,resources=virtualmachinesummaries,verbs=list
+// +kubebuilder:rbac:groups=subresources.virtink.smartx.com,resources=virtualmachines/console;virtualmachines/portforward;virtualmachines/vsock;virtualmachines/serialconsole;virtualmachines/vnc,verbs=get
+// +kubebuilder:rbac:groups=subresources.virtink.smartx.com,resources=virtualmachines/consoletickets;virtualmachines/start;virtualmachines/stop;virtualmachines/restart;virtualmachines/pause;virtualmachines/resume,verbs=create
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtinkconfigs,verbs=get;list;watch;create;update;patch;delete
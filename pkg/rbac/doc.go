@@ -0,0 +1,5 @@
+// Package rbac holds the RBAC markers of the ClusterRoles shipped for users
+// of Virtink, which are aggregated into the built-in view, edit and admin
+// ClusterRoles. Each subpackage is generated into the ClusterRole of the same
+// name under deploy/rbac.
+package rbac
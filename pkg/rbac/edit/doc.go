@@ -0,0 +1,12 @@
+// Package edit holds the RBAC markers of the virtink-edit ClusterRole, which
+// allows managing Virtink objects in a namespace, requesting VM actions and
+// connecting to VMs through virt-api.
+package edit
+
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines;virtualmachinemigrations;virtualmachineexports;virtualmachineactions;virtualmachinetemplates;virtualmachinetemplateinstances;virtualmachinepools;virtualmachinerollingrestarts;virtualmachinesecuritygroups,verbs=get;list;watch;create;update;patch;delete;deletecollection
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinequotas;virtualmachineusages;virtinknamespaceconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachinepools/scale,verbs=get;update;patch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines/start;virtualmachines/stop;virtualmachines/restart;virtualmachines/pause;virtualmachines/resume,verbs=update
+// +kubebuilder:rbac:groups=subresources.virtink.smartx.com,resources=virtualmachinesummaries,verbs=list
+// +kubebuilder:rbac:groups=subresources.virtink.smartx.com,resources=virtualmachines/console;virtualmachines/portforward;virtualmachines/vsock;virtualmachines/serialconsole;virtualmachines/vnc,verbs=get
+// +kubebuilder:rbac:groups=subresources.virtink.smartx.com,resources=virtualmachines/consoletickets;virtualmachines/start;virtualmachines/stop;virtualmachines/restart;virtualmachines/pause;virtualmachines/resume,verbs=create
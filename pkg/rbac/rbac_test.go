@@ -0,0 +1,140 @@
+package rbac
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/virtapi"
+)
+
+// TestRolesCoverAPI fails when a kind is added to the API without granting it
+// in the ClusterRoles under pkg/rbac.
+func TestRolesCoverAPI(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, virtv1beta1.AddToScheme(scheme))
+
+	clusterScoped := map[string]bool{"virtinkconfigs": true}
+	// quotas are set by cluster administrators, like ResourceQuotas, and
+	// usages are recorded by virt-controller for billing
+	readOnly := map[string]bool{"virtualmachinequotas": true, "virtualmachineusages": true}
+	// namespace configs are set by namespace administrators
+	adminOnly := map[string]bool{"virtinknamespaceconfigs": true}
+	var namespacedResources, clusterResources []string
+	for kind, typ := range scheme.KnownTypes(virtv1beta1.SchemeGroupVersion) {
+		if typ.PkgPath() != reflect.TypeOf(virtv1beta1.VirtualMachine{}).PkgPath() || strings.HasSuffix(kind, "List") {
+			continue
+		}
+		resource := strings.ToLower(kind) + "s"
+		if clusterScoped[resource] {
+			clusterResources = append(clusterResources, resource)
+		} else {
+			namespacedResources = append(namespacedResources, resource)
+		}
+	}
+
+	var actionSubresources []string
+	for _, action := range []virtv1beta1.VirtualMachineActionType{
+		virtv1beta1.VirtualMachineActionStart,
+		virtv1beta1.VirtualMachineActionStop,
+		virtv1beta1.VirtualMachineActionRestart,
+		virtv1beta1.VirtualMachineActionPause,
+		virtv1beta1.VirtualMachineActionResume,
+	} {
+		actionSubresources = append(actionSubresources, "virtualmachines/"+strings.ToLower(string(action)))
+	}
+
+	readVerbs := []string{"get", "list", "watch"}
+	writeVerbs := []string{"get", "list", "watch", "create", "update", "patch", "delete"}
+
+	view := readClusterRole(t, "view")
+	for _, resource := range namespacedResources {
+		assert.Subset(t, getVerbs(view, virtv1beta1.SchemeGroupVersion.Group, resource), readVerbs, resource)
+	}
+
+	edit := readClusterRole(t, "edit")
+	for _, resource := range namespacedResources {
+		if readOnly[resource] || adminOnly[resource] {
+			assert.ElementsMatch(t, getVerbs(edit, virtv1beta1.SchemeGroupVersion.Group, resource), readVerbs, resource)
+		} else {
+			assert.Subset(t, getVerbs(edit, virtv1beta1.SchemeGroupVersion.Group, resource), writeVerbs, resource)
+		}
+	}
+	for _, resource := range actionSubresources {
+		assert.Contains(t, getVerbs(edit, virtv1beta1.SchemeGroupVersion.Group, resource), "update", resource)
+	}
+
+	admin := readClusterRole(t, "admin")
+	for _, resource := range append(namespacedResources, clusterResources...) {
+		if readOnly[resource] {
+			assert.ElementsMatch(t, getVerbs(admin, virtv1beta1.SchemeGroupVersion.Group, resource), readVerbs, resource)
+		} else {
+			assert.Subset(t, getVerbs(admin, virtv1beta1.SchemeGroupVersion.Group, resource), writeVerbs, resource)
+		}
+	}
+	for _, resource := range actionSubresources {
+		assert.Contains(t, getVerbs(admin, virtv1beta1.SchemeGroupVersion.Group, resource), "update", resource)
+	}
+
+	// kube-apiserver authorizes requests to virt-api before proxying them,
+	// so the endpoints of virt-api must be granted as well
+	for _, role := range []*rbacv1.ClusterRole{view, edit, admin} {
+		assert.Contains(t, getVerbs(role, virtapi.GroupName, "virtualmachinesummaries"), "list", role.Name)
+		assert.Contains(t, getVerbs(role, virtapi.GroupName, "virtualmachines/console"), "get", role.Name)
+		assert.Contains(t, getVerbs(role, virtapi.GroupName, "virtualmachines/consoletickets"), "create", role.Name)
+	}
+	for _, role := range []*rbacv1.ClusterRole{edit, admin} {
+		for _, resource := range actionSubresources {
+			assert.Contains(t, getVerbs(role, virtapi.GroupName, resource), "create", role.Name+" "+resource)
+		}
+		// the serial console and VNC take input, unlike the console log
+		for _, resource := range []string{"virtualmachines/portforward", "virtualmachines/vsock", "virtualmachines/serialconsole", "virtualmachines/vnc"} {
+			assert.Contains(t, getVerbs(role, virtapi.GroupName, resource), "get", role.Name+" "+resource)
+		}
+	}
+	for _, resource := range []string{"virtualmachines/serialconsole", "virtualmachines/vnc"} {
+		assert.Empty(t, getVerbs(view, virtapi.GroupName, resource), resource)
+	}
+}
+
+func readClusterRole(t *testing.T, name string) *rbacv1.ClusterRole {
+	data, err := os.ReadFile(filepath.Join("..", "..", "deploy", "rbac", name, "role.yaml"))
+	require.NoError(t, err)
+
+	var role rbacv1.ClusterRole
+	require.NoError(t, yaml.Unmarshal(data, &role))
+	return &role
+}
+
+func getVerbs(role *rbacv1.ClusterRole, group string, resource string) []string {
+	var verbs []string
+	for _, rule := range role.Rules {
+		if !containsString(rule.APIGroups, group) {
+			continue
+		}
+		for _, r := range rule.Resources {
+			if r == resource {
+				verbs = append(verbs, rule.Verbs...)
+			}
+		}
+	}
+	return verbs
+}
+
+func containsString(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
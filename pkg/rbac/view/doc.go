@@ -0,0 +1,8 @@
+// Package view holds the RBAC markers of the virtink-view ClusterRole, which
+// allows reading Virtink objects in a namespace.
+package view
+
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines;virtualmachinemigrations;virtualmachineexports;virtualmachineactions;virtualmachinetemplates;virtualmachinetemplateinstances;virtualmachinepools;virtualmachinerollingrestarts;virtualmachinesecuritygroups;virtualmachinequotas;virtualmachineusages;virtinknamespaceconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=subresources.virtink.smartx.com,resources=virtualmachinesummaries,verbs=list
+// +kubebuilder:rbac:groups=subresources.virtink.smartx.com,resources=virtualmachines/console,verbs=get
+// +kubebuilder:rbac:groups=subresources.virtink.smartx.com,resources=virtualmachines/consoletickets,verbs=create
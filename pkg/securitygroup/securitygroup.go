@@ -0,0 +1,200 @@
+// Package securitygroup applies the rules of the security groups of the
+// interfaces of a running VM as the VirtualMachineSecurityGroups change.
+// virt-prerunner filters the taps of interfaces with nftables rules in the
+// network namespace of the VM pod, which virt-daemon can't enter, so the rules
+// are replaced by a server run by virt-prerunner in the VM pod, which listens
+// on a unix socket in the directory shared with virt-daemon, next to the API
+// socket of the hypervisor.
+package securitygroup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// SocketName is the name of the socket of the server in the socket directory
+// of the VM pod.
+const SocketName = "security-group.sock"
+
+// Get returns the rules of the security groups of each interface of the VM,
+// merged by interface name.
+func Get(ctx context.Context, c client.Reader, vm *virtv1alpha1.VirtualMachine) (map[string]virtv1beta1.VirtualMachineSecurityGroupSpec, error) {
+	securityGroups := map[string]virtv1beta1.VirtualMachineSecurityGroupSpec{}
+	for _, iface := range vm.Spec.Instance.Interfaces {
+		if len(iface.SecurityGroups) == 0 {
+			continue
+		}
+
+		var merged virtv1beta1.VirtualMachineSecurityGroupSpec
+		for _, name := range iface.SecurityGroups {
+			var vmsg virtv1beta1.VirtualMachineSecurityGroup
+			if err := c.Get(ctx, types.NamespacedName{Namespace: vm.Namespace, Name: name}, &vmsg); err != nil {
+				return nil, fmt.Errorf("get VM security group %q: %s", name, err)
+			}
+			merged.Ingress = append(merged.Ingress, vmsg.Spec.Ingress...)
+			merged.Egress = append(merged.Egress, vmsg.Spec.Egress...)
+		}
+		securityGroups[iface.Name] = merged
+	}
+	return securityGroups, nil
+}
+
+// Rules replaces the rules filtering the traffic of taps.
+type Rules interface {
+	Apply(tap string, securityGroup *virtv1beta1.VirtualMachineSecurityGroupSpec) error
+}
+
+// Server keeps the rules of the security groups of interfaces applied to
+// their taps.
+type Server struct {
+	rules          Rules
+	taps           map[string]string
+	securityGroups map[string]virtv1beta1.VirtualMachineSecurityGroupSpec
+	mutex          sync.Mutex
+}
+
+// NewServer returns a server of the rules of the security groups of the
+// interfaces with taps, which virt-prerunner applied already.
+func NewServer(rules Rules, taps map[string]string, securityGroups map[string]virtv1beta1.VirtualMachineSecurityGroupSpec) *Server {
+	s := &Server{
+		rules:          rules,
+		taps:           taps,
+		securityGroups: map[string]virtv1beta1.VirtualMachineSecurityGroupSpec{},
+	}
+	for name := range taps {
+		s.securityGroups[name] = securityGroups[name]
+	}
+	return s
+}
+
+// ServeHTTP serves the rules of the security groups of interfaces:
+//
+//	GET /interfaces
+//	PUT /interfaces/<name>
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(r.URL.Path, "/")
+	switch {
+	case path == "interfaces" && r.Method == http.MethodGet:
+		s.handleGetSecurityGroups(w)
+	case strings.HasPrefix(path, "interfaces/") && r.Method == http.MethodPut:
+		s.handleSetSecurityGroup(w, r, strings.TrimPrefix(path, "interfaces/"))
+	case path == "interfaces" || strings.HasPrefix(path, "interfaces/"):
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) handleGetSecurityGroups(w http.ResponseWriter) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(s.securityGroups)
+}
+
+func (s *Server) handleSetSecurityGroup(w http.ResponseWriter, r *http.Request, name string) {
+	var securityGroup virtv1beta1.VirtualMachineSecurityGroupSpec
+	if err := json.NewDecoder(r.Body).Decode(&securityGroup); err != nil {
+		http.Error(w, fmt.Sprintf("decode security group: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	tap, ok := s.taps[name]
+	if !ok {
+		http.Error(w, fmt.Sprintf("interface %q not found", name), http.StatusNotFound)
+		return
+	}
+	if err := s.rules.Apply(tap, &securityGroup); err != nil {
+		http.Error(w, fmt.Sprintf("apply security group rules of interface %q: %s", name, err), http.StatusInternalServerError)
+		return
+	}
+	s.securityGroups[name] = securityGroup
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Client talks to the server of a VM pod.
+type Client struct {
+	httpClient *http.Client
+}
+
+func NewClient(socketPath string) *Client {
+	return &Client{
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+					var dialer net.Dialer
+					return dialer.DialContext(ctx, "unix", socketPath)
+				},
+				DisableKeepAlives: true,
+			},
+		},
+	}
+}
+
+// GetSecurityGroups returns the rules applied to the tap of each interface
+// with security groups, by interface name.
+func (c *Client) GetSecurityGroups(ctx context.Context) (map[string]virtv1beta1.VirtualMachineSecurityGroupSpec, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost/interfaces", nil)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %s", err)
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var securityGroups map[string]virtv1beta1.VirtualMachineSecurityGroupSpec
+	if err := json.NewDecoder(resp.Body).Decode(&securityGroups); err != nil {
+		return nil, fmt.Errorf("decode response: %s", err)
+	}
+	return securityGroups, nil
+}
+
+// SetSecurityGroup replaces the rules applied to the tap of the interface.
+func (c *Client) SetSecurityGroup(ctx context.Context, name string, securityGroup *virtv1beta1.VirtualMachineSecurityGroupSpec) error {
+	reqBody, err := json.Marshal(securityGroup)
+	if err != nil {
+		return fmt.Errorf("encode request: %s", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "http://localhost/interfaces/"+name, bytes.NewReader(reqBody))
+	if err != nil {
+		return fmt.Errorf("build request: %s", err)
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %s", err)
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("request failed: %d %s: %s", resp.StatusCode, http.StatusText(resp.StatusCode), strings.TrimSpace(string(body)))
+	}
+	return resp, nil
+}
@@ -0,0 +1,100 @@
+package securitygroup
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+type fakeRules map[string]virtv1beta1.VirtualMachineSecurityGroupSpec
+
+func (r fakeRules) Apply(tap string, securityGroup *virtv1beta1.VirtualMachineSecurityGroupSpec) error {
+	if tap == "tap-broken" {
+		return errors.New("nft failed")
+	}
+	r[tap] = *securityGroup
+	return nil
+}
+
+func TestGet(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, virtv1beta1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&virtv1beta1.VirtualMachineSecurityGroup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+		Spec: virtv1beta1.VirtualMachineSecurityGroupSpec{
+			Ingress: []virtv1beta1.SecurityGroupRule{{Protocol: virtv1beta1.SecurityGroupProtocolTCP}},
+		},
+	}, &virtv1beta1.VirtualMachineSecurityGroup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ssh"},
+		Spec: virtv1beta1.VirtualMachineSecurityGroupSpec{
+			Ingress: []virtv1beta1.SecurityGroupRule{{Protocol: virtv1beta1.SecurityGroupProtocolUDP}},
+			Egress:  []virtv1beta1.SecurityGroupRule{{}},
+		},
+	}).Build()
+
+	vm := &virtv1alpha1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ubuntu"}}
+	vm.Spec.Instance.Interfaces = []virtv1alpha1.Interface{{
+		Name:           "pod",
+		SecurityGroups: []string{"web", "ssh"},
+	}, {
+		Name: "data",
+	}}
+	securityGroups, err := Get(context.Background(), c, vm)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]virtv1beta1.VirtualMachineSecurityGroupSpec{
+		"pod": {
+			Ingress: []virtv1beta1.SecurityGroupRule{{Protocol: virtv1beta1.SecurityGroupProtocolTCP}, {Protocol: virtv1beta1.SecurityGroupProtocolUDP}},
+			Egress:  []virtv1beta1.SecurityGroupRule{{}},
+		},
+	}, securityGroups)
+
+	vm.Spec.Instance.Interfaces[1].SecurityGroups = []string{"missing"}
+	_, err = Get(context.Background(), c, vm)
+	assert.Error(t, err)
+}
+
+func TestServer(t *testing.T) {
+	rules := fakeRules{}
+	webSecurityGroup := virtv1beta1.VirtualMachineSecurityGroupSpec{
+		Ingress: []virtv1beta1.SecurityGroupRule{{Protocol: virtv1beta1.SecurityGroupProtocolTCP, Ports: []virtv1beta1.SecurityGroupPortRange{{Port: 80}}}},
+	}
+	server := NewServer(rules, map[string]string{
+		"pod":  "tap-eth0",
+		"data": "tap-broken",
+	}, map[string]virtv1beta1.VirtualMachineSecurityGroupSpec{"pod": webSecurityGroup})
+
+	socketPath := filepath.Join(t.TempDir(), SocketName)
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	go http.Serve(listener, server)
+	defer listener.Close()
+
+	client := NewClient(socketPath)
+	securityGroups, err := client.GetSecurityGroups(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]virtv1beta1.VirtualMachineSecurityGroupSpec{"pod": webSecurityGroup, "data": {}}, securityGroups)
+
+	sshSecurityGroup := virtv1beta1.VirtualMachineSecurityGroupSpec{
+		Ingress: []virtv1beta1.SecurityGroupRule{{Protocol: virtv1beta1.SecurityGroupProtocolTCP, Ports: []virtv1beta1.SecurityGroupPortRange{{Port: 22}}}},
+	}
+	require.NoError(t, client.SetSecurityGroup(context.Background(), "pod", &sshSecurityGroup))
+	assert.Equal(t, fakeRules{"tap-eth0": sshSecurityGroup}, rules)
+	assert.Error(t, client.SetSecurityGroup(context.Background(), "unknown", &sshSecurityGroup))
+	assert.Error(t, client.SetSecurityGroup(context.Background(), "data", &sshSecurityGroup))
+
+	securityGroups, err = client.GetSecurityGroups(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]virtv1beta1.VirtualMachineSecurityGroupSpec{"pod": sshSecurityGroup, "data": {}}, securityGroups)
+}
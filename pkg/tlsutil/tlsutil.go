@@ -1,11 +1,18 @@
 package tlsutil
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"path/filepath"
+	"time"
 )
 
 func LoadCert(certDirPath string) (*tls.Certificate, error) {
@@ -25,3 +32,85 @@ func LoadCACert(certDirPath string) (*x509.CertPool, error) {
 	certPool.AppendCertsFromPEM(caCertData)
 	return certPool, nil
 }
+
+// NewServerConfig returns the TLS config of a server requiring client
+// certificates signed by the CA. The certificate and the CA certificate are
+// reloaded from the dir on every handshake, so rotated certificates are used
+// without restarting the server.
+func NewServerConfig(certDirPath string) *tls.Config {
+	return &tls.Config{
+		GetConfigForClient: func(_ *tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, err := LoadCert(certDirPath)
+			if err != nil {
+				return nil, fmt.Errorf("load cert: %s", err)
+			}
+			clientCACertPool, err := LoadCACert(certDirPath)
+			if err != nil {
+				return nil, fmt.Errorf("load CA cert: %s", err)
+			}
+			return &tls.Config{
+				Certificates: []tls.Certificate{*cert},
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+				ClientCAs:    clientCACertPool,
+			}, nil
+		},
+	}
+}
+
+// NewClientConfig returns the TLS config of a client presenting its
+// certificate and verifying the server certificate against the CA and the
+// server name.
+func NewClientConfig(certDirPath string, serverName string) (*tls.Config, error) {
+	caCertPool, err := LoadCACert(certDirPath)
+	if err != nil {
+		return nil, fmt.Errorf("load CA cert: %s", err)
+	}
+
+	return &tls.Config{
+		GetClientCertificate: func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return LoadCert(certDirPath)
+		},
+		RootCAs:    caCertPool,
+		ServerName: serverName,
+	}, nil
+}
+
+// GenerateSelfSignedCert generates a PEM encoded self-signed certificate and
+// its private key, which are valid for the given DNS names.
+func GenerateSelfSignedCert(dnsNames []string, validity time.Duration) ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generate key: %s", err)
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, fmt.Errorf("generate serial number: %s", err)
+	}
+
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:              dnsNames,
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create certificate: %s", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal key: %s", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, nil
+}
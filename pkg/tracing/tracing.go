@@ -0,0 +1,95 @@
+// Package tracing sets up OpenTelemetry tracing of Virtink components. Spans
+// are exported to an OTLP endpoint, e.g. an OpenTelemetry Collector, and
+// dropped if no endpoint is configured.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TraceContextAnnotation is set on VM pods to the W3C trace context of the
+// reconcile that created them, so that virt-daemon can continue the trace
+// when it starts or migrates the VM.
+const TraceContextAnnotation = "virtink.io/trace-context"
+
+const instrumentationName = "github.com/smartxworks/virtink"
+
+var propagator = propagation.TraceContext{}
+
+// Setup makes the component export spans to the OTLP gRPC endpoint. Nothing
+// is exported if endpoint is empty. The returned function flushes the spans
+// not exported yet, and should be called before exiting.
+func Setup(ctx context.Context, serviceName string, endpoint string, insecure bool) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagator)
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	driverOpts := []otlpgrpc.Option{otlpgrpc.WithEndpoint(endpoint)}
+	if insecure {
+		driverOpts = append(driverOpts, otlpgrpc.WithInsecure())
+	}
+	exporter, err := otlp.NewExporter(ctx, otlpgrpc.NewDriver(driverOpts...))
+	if err != nil {
+		return nil, fmt.Errorf("create OTLP exporter: %s", err)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.ServiceNameKey.String(serviceName))),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	return tracerProvider.Shutdown, nil
+}
+
+// Start starts a span, which is a child of the span in ctx if any.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// Detach returns a context carrying the span in ctx but not its deadline and
+// cancellation, for work outliving ctx that is still part of the trace.
+func Detach(ctx context.Context) context.Context {
+	return trace.ContextWithSpan(context.Background(), trace.SpanFromContext(ctx))
+}
+
+// VMAttributes returns the span attributes identifying a VM.
+func VMAttributes(vm metav1.Object) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("vm.namespace", vm.GetNamespace()),
+		attribute.String("vm.name", vm.GetName()),
+		attribute.String("vm.uid", string(vm.GetUID())),
+	}
+}
+
+// Inject returns the trace context of the span in ctx in the W3C
+// traceparent format, or an empty string if ctx has no span being recorded.
+func Inject(ctx context.Context) string {
+	carrier := propagation.HeaderCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier.Get("traceparent")
+}
+
+// Extract returns a copy of ctx carrying the trace context in the W3C
+// traceparent format, so that spans started from it continue the trace. ctx
+// is returned as is if the trace context is empty or invalid.
+func Extract(ctx context.Context, traceContext string) context.Context {
+	if traceContext == "" {
+		return ctx
+	}
+	carrier := propagation.HeaderCarrier{}
+	carrier.Set("traceparent", traceContext)
+	return propagator.Extract(ctx, carrier)
+}
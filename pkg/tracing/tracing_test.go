@@ -0,0 +1,31 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestInjectExtract(t *testing.T) {
+	assert.Empty(t, Inject(context.Background()))
+	assert.Equal(t, context.Background(), Extract(context.Background(), ""))
+
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "test")
+	defer span.End()
+
+	traceContext := Inject(ctx)
+	assert.NotEmpty(t, traceContext)
+
+	spanContext := trace.SpanContextFromContext(Extract(context.Background(), traceContext))
+	assert.True(t, spanContext.IsRemote())
+	assert.Equal(t, span.SpanContext().TraceID(), spanContext.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), spanContext.SpanID())
+
+	detachedCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.NoError(t, Detach(detachedCtx).Err())
+	assert.Equal(t, span.SpanContext(), trace.SpanContextFromContext(Detach(detachedCtx)))
+}
@@ -0,0 +1,140 @@
+package virtapi
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/request/headerrequest"
+	x509request "k8s.io/apiserver/pkg/authentication/request/x509"
+	"k8s.io/apiserver/pkg/authentication/user"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const requestHeaderConfigInterval = time.Minute
+
+// requestHeaderConfig is how kube-apiserver identifies the users it proxies
+// requests for, published in the extension-apiserver-authentication
+// ConfigMap. Requests must come with a client certificate signed by the
+// client CA, and carry the user in the headers.
+type requestHeaderConfig struct {
+	mutex               sync.RWMutex
+	verifyOptions       *x509.VerifyOptions
+	allowedNames        []string
+	usernameHeaders     []string
+	groupHeaders        []string
+	extraHeaderPrefixes []string
+}
+
+func (c *requestHeaderConfig) load(ctx context.Context, r client.Reader) error {
+	var cm corev1.ConfigMap
+	if err := r.Get(ctx, client.ObjectKey{Namespace: "kube-system", Name: "extension-apiserver-authentication"}, &cm); err != nil {
+		return fmt.Errorf("get ConfigMap: %s", err)
+	}
+	caData := cm.Data["requestheader-client-ca-file"]
+	if caData == "" {
+		return fmt.Errorf("requestheader-client-ca-file not found")
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM([]byte(caData)) {
+		return fmt.Errorf("parse requestheader-client-ca-file")
+	}
+
+	var allowedNames, usernameHeaders, groupHeaders, extraHeaderPrefixes []string
+	for key, value := range map[string]*[]string{
+		"requestheader-allowed-names":        &allowedNames,
+		"requestheader-username-headers":     &usernameHeaders,
+		"requestheader-group-headers":        &groupHeaders,
+		"requestheader-extra-headers-prefix": &extraHeaderPrefixes,
+	} {
+		if cm.Data[key] == "" {
+			continue
+		}
+		if err := json.Unmarshal([]byte(cm.Data[key]), value); err != nil {
+			return fmt.Errorf("parse %s: %s", key, err)
+		}
+	}
+
+	verifyOptions := x509request.DefaultVerifyOptions()
+	verifyOptions.Roots = roots
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.verifyOptions = &verifyOptions
+	c.allowedNames = allowedNames
+	c.usernameHeaders = usernameHeaders
+	c.groupHeaders = groupHeaders
+	c.extraHeaderPrefixes = extraHeaderPrefixes
+	return nil
+}
+
+// run reloads the config periodically, so that rotated client CAs are
+// trusted without restarting virt-api.
+func (c *requestHeaderConfig) run(ctx context.Context, r client.Reader) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := c.load(ctx, r); err != nil {
+			ctrl.LoggerFrom(ctx).Error(err, "load request header config")
+		}
+	}, requestHeaderConfigInterval)
+}
+
+func (c *requestHeaderConfig) getVerifyOptions() (x509.VerifyOptions, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if c.verifyOptions == nil {
+		return x509.VerifyOptions{}, false
+	}
+	return *c.verifyOptions, true
+}
+
+func (c *requestHeaderConfig) stringSliceProvider(field *[]string) headerrequest.StringSliceProvider {
+	return headerrequest.StringSliceProviderFunc(func() []string {
+		c.mutex.RLock()
+		defer c.mutex.RUnlock()
+		return *field
+	})
+}
+
+func (c *requestHeaderConfig) newAuthenticator() authenticator.Request {
+	return headerrequest.NewDynamicVerifyOptionsSecure(c.getVerifyOptions,
+		c.stringSliceProvider(&c.allowedNames),
+		c.stringSliceProvider(&c.usernameHeaders),
+		c.stringSliceProvider(&c.groupHeaders),
+		c.stringSliceProvider(&c.extraHeaderPrefixes))
+}
+
+// authorizer decides whether the user may do what the attributes describe.
+type authorizer func(ctx context.Context, u user.Info, attrs *authorizationv1.ResourceAttributes) (bool, string, error)
+
+// newSubjectAccessReviewAuthorizer asks kube-apiserver through
+// SubjectAccessReviews, so that the RBAC rules of the cluster apply.
+func newSubjectAccessReviewAuthorizer(c client.Client) authorizer {
+	return func(ctx context.Context, u user.Info, attrs *authorizationv1.ResourceAttributes) (bool, string, error) {
+		sar := &authorizationv1.SubjectAccessReview{
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				ResourceAttributes: attrs,
+				User:               u.GetName(),
+				Groups:             u.GetGroups(),
+				UID:                u.GetUID(),
+			},
+		}
+		if extra := u.GetExtra(); len(extra) > 0 {
+			sar.Spec.Extra = map[string]authorizationv1.ExtraValue{}
+			for key, values := range extra {
+				sar.Spec.Extra[key] = values
+			}
+		}
+		if err := c.Create(ctx, sar); err != nil {
+			return false, "", fmt.Errorf("create SubjectAccessReview: %s", err)
+		}
+		return sar.Status.Allowed, sar.Status.Reason, nil
+	}
+}
@@ -0,0 +1,128 @@
+package virtapi
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/smartxworks/virtink/pkg/daemon"
+)
+
+// DialPortForward connects to a TCP port of the guest of the VM through the
+// portforward subresource, authenticating as the config does with
+// kube-apiserver, e.g. to reach a service in the guest from outside the
+// cluster without a Service.
+func DialPortForward(ctx context.Context, config *rest.Config, vmKey client.ObjectKey, port uint16) (net.Conn, error) {
+	return dialVMStream(ctx, config, vmKey, "portforward/"+strconv.FormatUint(uint64(port), 10))
+}
+
+// DialVsock connects to a vsock port of the guest of the VM through the vsock
+// subresource, authenticating as the config does with kube-apiserver.
+func DialVsock(ctx context.Context, config *rest.Config, vmKey client.ObjectKey, port uint32) (net.Conn, error) {
+	return dialVMStream(ctx, config, vmKey, "vsock/"+strconv.FormatUint(uint64(port), 10))
+}
+
+// DialSerialConsole connects to the serial console of the QEMU VM through the
+// serialconsole subresource, authenticating as the config does with
+// kube-apiserver.
+func DialSerialConsole(ctx context.Context, config *rest.Config, vmKey client.ObjectKey) (net.Conn, error) {
+	return dialVMStream(ctx, config, vmKey, "serialconsole")
+}
+
+// DialVNC connects to the VNC server of the display of the QEMU VM through the
+// vnc subresource, authenticating as the config does with kube-apiserver.
+func DialVNC(ctx context.Context, config *rest.Config, vmKey client.ObjectKey) (net.Conn, error) {
+	return dialVMStream(ctx, config, vmKey, "vnc")
+}
+
+func dialVMStream(ctx context.Context, config *rest.Config, vmKey client.ObjectKey, endpoint string) (net.Conn, error) {
+	host := config.Host
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, fmt.Errorf("parse host: %s", err)
+	}
+	u.Path = path.Join(u.Path, apiPath, "namespaces", vmKey.Namespace, "virtualmachines", vmKey.Name, endpoint)
+
+	tlsConfig, err := rest.TLSConfigFor(config)
+	if err != nil {
+		return nil, fmt.Errorf("create TLS config: %s", err)
+	}
+	upgrader := &streamUpgrader{tlsConfig: tlsConfig}
+	// the wrappers add the credentials of the config to the request
+	rt, err := rest.HTTPWrappersForConfig(config, upgrader)
+	if err != nil {
+		return nil, fmt.Errorf("create round tripper: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %s", err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return upgrader.stream, nil
+}
+
+// streamUpgrader is a round tripper that upgrades the connection of the
+// request to a raw stream, which is kept instead of being returned as the
+// body of the response.
+type streamUpgrader struct {
+	tlsConfig *tls.Config
+	stream    net.Conn
+}
+
+func (u *streamUpgrader) RoundTrip(req *http.Request) (*http.Response, error) {
+	addr := req.URL.Host
+	var conn net.Conn
+	var err error
+	if req.URL.Scheme == "http" {
+		if req.URL.Port() == "" {
+			addr = net.JoinHostPort(req.URL.Hostname(), "80")
+		}
+		conn, err = (&net.Dialer{}).DialContext(req.Context(), "tcp", addr)
+	} else {
+		if req.URL.Port() == "" {
+			addr = net.JoinHostPort(req.URL.Hostname(), "443")
+		}
+		tlsConfig := u.tlsConfig
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		}
+		conn, err = (&tls.Dialer{Config: tlsConfig}).DialContext(req.Context(), "tcp", addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dial kube-apiserver: %s", err)
+	}
+
+	stream, err := daemon.UpgradeStream(conn, req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	u.stream = stream
+	return &http.Response{
+		Status:     "101 Switching Protocols",
+		StatusCode: http.StatusSwitchingProtocols,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{},
+		Body:       http.NoBody,
+		Request:    req,
+	}, nil
+}
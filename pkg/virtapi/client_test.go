@@ -0,0 +1,163 @@
+package virtapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/user"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/daemon"
+	"github.com/smartxworks/virtink/pkg/tlsutil"
+)
+
+// serveDaemon serves a virt-daemon of a release before the gRPC API on a
+// local port, which forwards streams of portforward/22 of vm-0 to a TCP port
+// echoing the bytes received back in upper case. It returns the port of the
+// daemon and the dir of the certificates to talk to it with.
+func serveDaemon(t *testing.T) (int, string) {
+	echoListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { echoListener.Close() })
+	go func() {
+		for {
+			conn, err := echoListener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				data, _ := io.ReadAll(conn)
+				conn.Write(bytes.ToUpper(data))
+			}()
+		}
+	}()
+
+	certPEM, keyPEM, err := tlsutil.GenerateSelfSignedCert([]string{daemonServerName}, time.Hour)
+	require.NoError(t, err)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	certDirPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(certDirPath, "ca.crt"), certPEM, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(certDirPath, "tls.crt"), certPEM, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(certDirPath, "tls.key"), keyPEM, 0600))
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/virtualmachines/default/vm-0/portforward/22" {
+			http.NotFound(w, r)
+			return
+		}
+		conn, err := net.Dial("tcp", echoListener.Addr().String())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer conn.Close()
+		daemon.ServeUpgradedStream(w, r, conn)
+	}))
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server.Listener.Addr().(*net.TCPAddr).Port, certDirPath
+}
+
+func TestDialPortForward(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+
+	daemonPort, daemonCertDirPath := serveDaemon(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&virtv1alpha1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "vm-0",
+		},
+		Status: virtv1alpha1.VirtualMachineStatus{
+			NodeName: "node-0",
+		},
+	}, &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "virtink-system",
+			Name:      "virt-daemon-0",
+			Labels:    map[string]string{"name": "virt-daemon"},
+		},
+		Spec: corev1.PodSpec{
+			NodeName: "node-0",
+		},
+		Status: corev1.PodStatus{
+			PodIP: "127.0.0.1",
+		},
+	}).Build()
+	s := &Server{
+		Client:            c,
+		APIReader:         c,
+		DaemonCertDirPath: daemonCertDirPath,
+		DaemonNamespace:   "virtink-system",
+		DaemonPort:        daemonPort,
+		authenticator: authenticator.RequestFunc(func(req *http.Request) (*authenticator.Response, bool, error) {
+			name := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+			return &authenticator.Response{User: &user.DefaultInfo{Name: name}}, name != "", nil
+		}),
+		authorize: func(ctx context.Context, u user.Info, attrs *authorizationv1.ResourceAttributes) (bool, string, error) {
+			return u.GetName() == "admin" && attrs.Subresource == "portforward", "", nil
+		},
+	}
+	server := httptest.NewServer(s.handler())
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	vmKey := client.ObjectKey{Namespace: "default", Name: "vm-0"}
+
+	_, err := DialPortForward(ctx, &rest.Config{Host: server.URL, BearerToken: "user"}, vmKey, 22)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+
+	_, err = DialVsock(ctx, &rest.Config{Host: server.URL, BearerToken: "admin"}, vmKey, 1024)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+
+	_, err = DialSerialConsole(ctx, &rest.Config{Host: server.URL, BearerToken: "admin"}, vmKey)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+
+	_, err = DialPortForward(ctx, &rest.Config{Host: server.URL, BearerToken: "admin"}, client.ObjectKey{Namespace: "default", Name: "vm-1"}, 22)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "404")
+
+	_, err = DialPortForward(ctx, &rest.Config{Host: server.URL, BearerToken: "admin"}, vmKey, 80)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "503")
+
+	conn, err := DialPortForward(ctx, &rest.Config{Host: server.URL, BearerToken: "admin"}, vmKey, 22)
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("hello"))
+	require.NoError(t, err)
+	require.NoError(t, conn.(interface{ CloseWrite() error }).CloseWrite())
+	data, err := io.ReadAll(conn)
+	assert.NoError(t, err)
+	assert.Equal(t, "HELLO", string(data))
+}
@@ -0,0 +1,686 @@
+// Package virtapi implements virt-api, a lightweight aggregated API server
+// registered with kube-apiserver through an APIService. It serves views of
+// VMs across namespaces and streams to VMs for dashboards and other clients,
+// which are authenticated by kube-apiserver and authorized against the RBAC
+// rules of the cluster, so that they don't need to list every object or talk
+// to virt-daemon themselves.
+package virtapi
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/smartxworks/virtink/pkg/apis/virt"
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+	"github.com/smartxworks/virtink/pkg/conditions"
+	"github.com/smartxworks/virtink/pkg/daemon"
+	"github.com/smartxworks/virtink/pkg/daemonapi"
+	daemonv1 "github.com/smartxworks/virtink/pkg/daemonapi/v1"
+	"github.com/smartxworks/virtink/pkg/tlsutil"
+	"github.com/smartxworks/virtink/pkg/virtinkconfig"
+)
+
+// daemonServerName is the DNS name in the certificate of virt-daemon.
+const daemonServerName = "virt-daemon.virtink-system.svc"
+
+var apiPath = "/apis/" + SchemeGroupVersion.String()
+
+// Server serves the API of virt-api. VMs are read from the cache, while the
+// extension-apiserver-authentication ConfigMap, virt-daemon pods and the
+// ticket key are read through APIReader, so that neither all ConfigMaps, pods
+// nor Secrets are cached.
+type Server struct {
+	client.Client
+	APIReader client.Reader
+	// Config is used to act as users, see handleVMAction.
+	Config            *rest.Config
+	Addr              string
+	CertDirPath       string
+	DaemonCertDirPath string
+	DaemonNamespace   string
+	DaemonPort        int
+	// Namespace is the namespace of virt-api, where the ticket key is kept.
+	Namespace string
+
+	authenticator authenticator.Request
+	authorize     authorizer
+	newUserClient func(u user.Info) (client.Client, error)
+	ticketKey     []byte
+}
+
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtualmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=virt.virtink.smartx.com,resources=virtinkconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=list
+// +kubebuilder:rbac:groups="",resources=users;groups;serviceaccounts,verbs=impersonate
+// +kubebuilder:rbac:groups=authentication.k8s.io,resources=userextras/*;uids,verbs=impersonate
+
+func (s *Server) Start(ctx context.Context) error {
+	if s.authenticator == nil {
+		config := &requestHeaderConfig{}
+		go config.run(ctx, s.APIReader)
+		s.authenticator = config.newAuthenticator()
+	}
+	if s.authorize == nil {
+		s.authorize = newSubjectAccessReviewAuthorizer(s.Client)
+	}
+	if s.newUserClient == nil {
+		s.newUserClient = s.newImpersonatingClient
+	}
+	if s.ticketKey == nil {
+		ticketKey, err := s.loadTicketKey(ctx)
+		if err != nil {
+			return err
+		}
+		s.ticketKey = ticketKey
+	}
+
+	listener, err := tls.Listen("tcp", s.Addr, &tls.Config{
+		GetCertificate: func(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return tlsutil.LoadCert(s.CertDirPath)
+		},
+		// client certificates are verified by the authenticator, against the
+		// client CA kube-apiserver currently publishes
+		ClientAuth: tls.RequestClientCert,
+	})
+	if err != nil {
+		return fmt.Errorf("listen: %s", err)
+	}
+
+	server := &http.Server{
+		Handler:           otelhttp.NewHandler(s.handler(), "virt-api"),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+
+	if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+	// discovery is public, as it is for the built-in APIs
+	mux.HandleFunc("/apis", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, &metav1.APIGroupList{
+			TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"},
+			Groups:   []metav1.APIGroup{*newAPIGroup()},
+		})
+	})
+	mux.HandleFunc("/apis/"+GroupName, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, newAPIGroup())
+	})
+	mux.HandleFunc(apiPath, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, newAPIResourceList())
+	})
+	mux.HandleFunc(apiPath+"/", s.handleAPI)
+	// requests with tickets come straight from clients, not through
+	// kube-apiserver
+	mux.HandleFunc(consoleTicketPath, s.handleTicketedConsole)
+	return mux
+}
+
+func newAPIGroup() *metav1.APIGroup {
+	version := metav1.GroupVersionForDiscovery{
+		GroupVersion: SchemeGroupVersion.String(),
+		Version:      SchemeGroupVersion.Version,
+	}
+	return &metav1.APIGroup{
+		TypeMeta:         metav1.TypeMeta{Kind: "APIGroup", APIVersion: "v1"},
+		Name:             GroupName,
+		Versions:         []metav1.GroupVersionForDiscovery{version},
+		PreferredVersion: version,
+	}
+}
+
+func newAPIResourceList() *metav1.APIResourceList {
+	resourceList := &metav1.APIResourceList{
+		TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+		GroupVersion: SchemeGroupVersion.String(),
+		APIResources: []metav1.APIResource{{
+			Name:       "virtualmachinesummaries",
+			Namespaced: true,
+			Kind:       "VirtualMachineSummary",
+			Verbs:      []string{"list"},
+		}, {
+			Name:       "virtualmachines/console",
+			Namespaced: true,
+			Kind:       "VirtualMachine",
+			Verbs:      []string{"get"},
+		}, {
+			Name:       "virtualmachines/portforward",
+			Namespaced: true,
+			Kind:       "VirtualMachine",
+			Verbs:      []string{"get"},
+		}, {
+			Name:       "virtualmachines/vsock",
+			Namespaced: true,
+			Kind:       "VirtualMachine",
+			Verbs:      []string{"get"},
+		}, {
+			Name:       "virtualmachines/serialconsole",
+			Namespaced: true,
+			Kind:       "VirtualMachine",
+			Verbs:      []string{"get"},
+		}, {
+			Name:       "virtualmachines/vnc",
+			Namespaced: true,
+			Kind:       "VirtualMachine",
+			Verbs:      []string{"get"},
+		}, {
+			Name:       "virtualmachines/consoletickets",
+			Namespaced: true,
+			Kind:       "ConsoleTicket",
+			Verbs:      []string{"create"},
+		}},
+	}
+	for _, action := range vmActions {
+		resourceList.APIResources = append(resourceList.APIResources, metav1.APIResource{
+			Name:       "virtualmachines/" + strings.ToLower(string(action)),
+			Namespaced: true,
+			Kind:       "VirtualMachineAction",
+			Verbs:      []string{"create"},
+		})
+	}
+	return resourceList
+}
+
+// handleAPI serves the authenticated endpoints under the API path:
+//
+//	GET  version
+//	GET  [namespaces/<namespace>/]virtualmachinesummaries
+//	GET  namespaces/<namespace>/virtualmachines/<name>/console
+//	GET  namespaces/<namespace>/virtualmachines/<name>/portforward/<port>
+//	GET  namespaces/<namespace>/virtualmachines/<name>/vsock/<port>
+//	GET  namespaces/<namespace>/virtualmachines/<name>/serialconsole
+//	GET  namespaces/<namespace>/virtualmachines/<name>/vnc
+//	POST namespaces/<namespace>/virtualmachines/<name>/consoletickets
+//	POST namespaces/<namespace>/virtualmachines/<name>/<action>
+func (s *Server) handleAPI(w http.ResponseWriter, r *http.Request) {
+	resp, ok, err := s.authenticator.AuthenticateRequest(r)
+	if err != nil || !ok {
+		writeError(w, apierrors.NewUnauthorized("request not authenticated by kube-apiserver"))
+		return
+	}
+	u := resp.User
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, apiPath+"/"), "/")
+	if len(parts) >= 5 && parts[0] == "namespaces" && parts[2] == "virtualmachines" {
+		s.handleVMSubresource(w, r, u, client.ObjectKey{Namespace: parts[1], Name: parts[3]}, parts[4], parts[5:])
+		return
+	}
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+	switch {
+	case len(parts) == 1 && parts[0] == "version":
+		s.handleVersion(w, r)
+	case len(parts) == 1 && parts[0] == "virtualmachinesummaries":
+		s.handleVMSummaries(w, r, u, "")
+	case len(parts) == 3 && parts[0] == "namespaces" && parts[2] == "virtualmachinesummaries":
+		s.handleVMSummaries(w, r, u, parts[1])
+	default:
+		writeError(w, apierrors.NewNotFound(SchemeGroupVersion.WithResource("").GroupResource(), r.URL.Path))
+	}
+}
+
+func (s *Server) handleVMSubresource(w http.ResponseWriter, r *http.Request, u user.Info, vmKey client.ObjectKey, subresource string, args []string) {
+	consoleAttrs := &authorizationv1.ResourceAttributes{
+		Namespace:   vmKey.Namespace,
+		Name:        vmKey.Name,
+		Verb:        "get",
+		Group:       GroupName,
+		Resource:    "virtualmachines",
+		Subresource: "console",
+	}
+	switch {
+	case subresource == "console" && len(args) == 0:
+		if allowMethod(w, r, http.MethodGet) && s.authorized(w, r, u, consoleAttrs) {
+			s.handleConsole(w, r, vmKey)
+		}
+	case (subresource == "portforward" || subresource == "vsock") && len(args) == 1,
+		(subresource == "serialconsole" || subresource == "vnc") && len(args) == 0:
+		if allowMethod(w, r, http.MethodGet) && s.authorized(w, r, u, &authorizationv1.ResourceAttributes{
+			Namespace:   vmKey.Namespace,
+			Name:        vmKey.Name,
+			Verb:        "get",
+			Group:       GroupName,
+			Resource:    "virtualmachines",
+			Subresource: subresource,
+		}) {
+			s.handleForward(w, r, vmKey, strings.Join(append([]string{subresource}, args...), "/"))
+		}
+	case subresource == "consoletickets" && len(args) == 0:
+		// a ticket grants what the console subresource does
+		if allowMethod(w, r, http.MethodPost) && s.authorized(w, r, u, consoleAttrs) {
+			s.handleConsoleTicket(w, r, u, vmKey)
+		}
+	case getVMAction(subresource) != "" && len(args) == 0:
+		if allowMethod(w, r, http.MethodPost) {
+			s.handleVMAction(w, r, u, vmKey, getVMAction(subresource))
+		}
+	default:
+		writeError(w, apierrors.NewNotFound(SchemeGroupVersion.WithResource("virtualmachines/"+subresource).GroupResource(), vmKey.Name))
+	}
+}
+
+// vmActions are the VM actions requested through subresources named after
+// them in lower case.
+var vmActions = []virtv1beta1.VirtualMachineActionType{
+	virtv1beta1.VirtualMachineActionStart,
+	virtv1beta1.VirtualMachineActionStop,
+	virtv1beta1.VirtualMachineActionRestart,
+	virtv1beta1.VirtualMachineActionPause,
+	virtv1beta1.VirtualMachineActionResume,
+}
+
+func getVMAction(subresource string) virtv1beta1.VirtualMachineActionType {
+	for _, action := range vmActions {
+		if strings.ToLower(string(action)) == subresource {
+			return action
+		}
+	}
+	return ""
+}
+
+// handleVMAction requests the action on the VM by creating a VM action as the
+// user, so that the VM action webhook authorizes the user and records them as
+// the requester, as if the user created it.
+func (s *Server) handleVMAction(w http.ResponseWriter, r *http.Request, u user.Info, vmKey client.ObjectKey, action virtv1beta1.VirtualMachineActionType) {
+	userClient, err := s.newUserClient(u)
+	if err != nil {
+		writeError(w, apierrors.NewInternalError(fmt.Errorf("create client: %s", err)))
+		return
+	}
+	vma := &virtv1beta1.VirtualMachineAction{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    vmKey.Namespace,
+			GenerateName: vmKey.Name + "-" + strings.ToLower(string(action)) + "-",
+		},
+		Spec: virtv1beta1.VirtualMachineActionSpec{
+			VirtualMachineName: vmKey.Name,
+			Action:             action,
+		},
+	}
+	if err := userClient.Create(r.Context(), vma); err != nil {
+		writeError(w, err)
+		return
+	}
+	vma.TypeMeta = metav1.TypeMeta{Kind: "VirtualMachineAction", APIVersion: virtv1beta1.SchemeGroupVersion.String()}
+	writeJSON(w, http.StatusCreated, vma)
+}
+
+// newImpersonatingClient returns a client acting as the user.
+func (s *Server) newImpersonatingClient(u user.Info) (client.Client, error) {
+	config := rest.CopyConfig(s.Config)
+	config.Impersonate = rest.ImpersonationConfig{
+		UserName: u.GetName(),
+		UID:      u.GetUID(),
+		Groups:   u.GetGroups(),
+		Extra:    u.GetExtra(),
+	}
+	return client.New(config, client.Options{Scheme: s.Scheme(), Mapper: s.RESTMapper()})
+}
+
+// allowMethod returns whether the request has the method, and writes the
+// error otherwise.
+func allowMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method != method {
+		writeError(w, apierrors.NewMethodNotSupported(SchemeGroupVersion.WithResource("").GroupResource(), r.Method))
+		return false
+	}
+	return true
+}
+
+// authorized returns whether the user may do what the attributes describe,
+// and writes the error otherwise.
+func (s *Server) authorized(w http.ResponseWriter, r *http.Request, u user.Info, attrs *authorizationv1.ResourceAttributes) bool {
+	allowed, reason, err := s.authorize(r.Context(), u, attrs)
+	if err != nil {
+		writeError(w, apierrors.NewInternalError(err))
+		return false
+	}
+	if !allowed {
+		resource := attrs.Resource
+		if attrs.Subresource != "" {
+			resource += "/" + attrs.Subresource
+		}
+		if reason == "" {
+			reason = fmt.Sprintf("user %q cannot %s resource %q in API group %q", u.GetName(), attrs.Verb, resource, attrs.Group)
+		}
+		writeError(w, apierrors.NewForbidden(schema.GroupResource{Group: attrs.Group, Resource: resource}, attrs.Name, errors.New(reason)))
+		return false
+	}
+	return true
+}
+
+func (s *Server) handleVersion(w http.ResponseWriter, r *http.Request) {
+	config, err := virtinkconfig.Get(r.Context(), s.Client)
+	if err != nil {
+		writeError(w, apierrors.NewInternalError(fmt.Errorf("get Virtink config: %s", err)))
+		return
+	}
+	writeJSON(w, http.StatusOK, &Version{
+		TypeMeta:          metav1.TypeMeta{Kind: "Version", APIVersion: SchemeGroupVersion.String()},
+		APIVersions:       []string{virtv1alpha1.SchemeGroupVersion.Version, virtv1beta1.SchemeGroupVersion.Version},
+		DaemonAPIVersions: daemonapi.APIVersions,
+		FeatureGates:      virtinkconfig.FeatureGates(config),
+	})
+}
+
+// handleVMSummaries lists the summaries of VMs in the namespace, or in all
+// namespaces if it's empty. Users who may list VMs may list their summaries.
+func (s *Server) handleVMSummaries(w http.ResponseWriter, r *http.Request, u user.Info, namespace string) {
+	if !s.authorized(w, r, u, &authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      "list",
+		Group:     virt.GroupName,
+		Resource:  "virtualmachines",
+	}) {
+		return
+	}
+
+	query := r.URL.Query()
+	selector, err := labels.Parse(query.Get("labelSelector"))
+	if err != nil {
+		writeError(w, apierrors.NewBadRequest(fmt.Sprintf("invalid label selector: %s", err)))
+		return
+	}
+	limit := 0
+	if query.Get("limit") != "" {
+		if limit, err = strconv.Atoi(query.Get("limit")); err != nil || limit < 0 {
+			writeError(w, apierrors.NewBadRequest(fmt.Sprintf("invalid limit %q", query.Get("limit"))))
+			return
+		}
+	}
+	var continueKey string
+	if query.Get("continue") != "" {
+		data, err := base64.RawURLEncoding.DecodeString(query.Get("continue"))
+		if err != nil {
+			writeError(w, apierrors.NewBadRequest("invalid continue token"))
+			return
+		}
+		continueKey = string(data)
+	}
+	phases := map[virtv1alpha1.VirtualMachinePhase]bool{}
+	for _, phase := range strings.Split(query.Get("phase"), ",") {
+		if phase != "" {
+			phases[virtv1alpha1.VirtualMachinePhase(phase)] = true
+		}
+	}
+	nodeName := query.Get("nodeName")
+	search := strings.ToLower(query.Get("search"))
+
+	var vmList virtv1alpha1.VirtualMachineList
+	if err := s.List(r.Context(), &vmList, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		writeError(w, apierrors.NewInternalError(fmt.Errorf("list VMs: %s", err)))
+		return
+	}
+	sort.Slice(vmList.Items, func(i, j int) bool {
+		return getVMSummaryKey(&vmList.Items[i]) < getVMSummaryKey(&vmList.Items[j])
+	})
+
+	summaryList := VirtualMachineSummaryList{
+		TypeMeta: metav1.TypeMeta{Kind: "VirtualMachineSummaryList", APIVersion: SchemeGroupVersion.String()},
+		Phases:   map[virtv1alpha1.VirtualMachinePhase]int{},
+		Items:    []VirtualMachineSummary{},
+	}
+	var matched []*virtv1alpha1.VirtualMachine
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+		if nodeName != "" && vm.Status.NodeName != nodeName {
+			continue
+		}
+		if search != "" && !strings.Contains(strings.ToLower(vm.Name), search) {
+			continue
+		}
+		// phases are counted regardless of the phase filter, e.g. for tabs
+		// of phases
+		summaryList.Phases[vm.Status.Phase]++
+		if len(phases) > 0 && !phases[vm.Status.Phase] {
+			continue
+		}
+		if continueKey != "" && getVMSummaryKey(vm) <= continueKey {
+			continue
+		}
+		matched = append(matched, vm)
+	}
+	if limit > 0 && len(matched) > limit {
+		summaryList.Continue = base64.RawURLEncoding.EncodeToString([]byte(getVMSummaryKey(matched[limit-1])))
+		remaining := int64(len(matched) - limit)
+		summaryList.RemainingItemCount = &remaining
+		matched = matched[:limit]
+	}
+	for _, vm := range matched {
+		summaryList.Items = append(summaryList.Items, *summarizeVM(vm))
+	}
+	writeJSON(w, http.StatusOK, &summaryList)
+}
+
+// getVMSummaryKey returns what VM summaries are sorted and paginated by.
+func getVMSummaryKey(vm *virtv1alpha1.VirtualMachine) string {
+	return vm.Namespace + "/" + vm.Name
+}
+
+func summarizeVM(vm *virtv1alpha1.VirtualMachine) *VirtualMachineSummary {
+	summary := &VirtualMachineSummary{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         vm.Namespace,
+			Name:              vm.Name,
+			UID:               vm.UID,
+			Labels:            vm.Labels,
+			CreationTimestamp: vm.CreationTimestamp,
+		},
+		Phase:    vm.Status.Phase,
+		NodeName: vm.Status.NodeName,
+		VMPodIP:  vm.Status.VMPodIP,
+		VCPUs:    vm.Spec.Instance.CPU.Sockets * vm.Spec.Instance.CPU.CoresPerSocket,
+		Memory:   vm.Spec.Instance.Memory.Size,
+		Ready:    conditions.IsTrue(vm.Status.Conditions, string(virtv1alpha1.VirtualMachineReady)),
+		Paused:   conditions.IsTrue(vm.Status.Conditions, string(virtv1alpha1.VirtualMachinePaused)),
+	}
+	if migration := vm.Status.Migration; migration != nil {
+		summary.Migrating = migration.Phase != virtv1alpha1.VirtualMachineMigrationSucceeded && migration.Phase != virtv1alpha1.VirtualMachineMigrationFailed
+	}
+	return summary
+}
+
+// handleConsole returns the console output of the VM kept by virt-daemon, or
+// streams it as it's written if the follow parameter is true.
+func (s *Server) handleConsole(w http.ResponseWriter, r *http.Request, vmKey client.ObjectKey) {
+	addr, err := s.getDaemonAddr(r.Context(), vmKey)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	tlsConfig, err := tlsutil.NewClientConfig(s.DaemonCertDirPath, daemonServerName)
+	if err != nil {
+		writeError(w, apierrors.NewInternalError(fmt.Errorf("create TLS config: %s", err)))
+		return
+	}
+	apiClient, err := daemonapi.Dial(r.Context(), addr, tlsConfig)
+	if err != nil {
+		if err == daemonapi.ErrLegacyDaemon {
+			err = errors.New("virt-daemon on the node of the VM predates the virt-daemon API")
+		}
+		writeError(w, apierrors.NewServiceUnavailable(err.Error()))
+		return
+	}
+	defer apiClient.Close()
+
+	if follow, _ := strconv.ParseBool(r.URL.Query().Get("follow")); !follow {
+		consoleLog, err := apiClient.GetConsoleLog(r.Context(), vmKey)
+		if err != nil {
+			writeError(w, fromDaemonError(vmKey, err))
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write(consoleLog)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fw := &flushWriter{w: w}
+	if err := apiClient.StreamConsoleLog(r.Context(), vmKey, fw); err != nil && !fw.written {
+		writeError(w, fromDaemonError(vmKey, err))
+	}
+}
+
+// flushWriter flushes every write, so that streamed output reaches the
+// client as it's written.
+type flushWriter struct {
+	w       http.ResponseWriter
+	written bool
+}
+
+func (fw *flushWriter) Write(p []byte) (int, error) {
+	fw.written = true
+	n, err := fw.w.Write(p)
+	if flusher, ok := fw.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return n, err
+}
+
+// handleForward connects the upgraded client connection to a TCP or vsock
+// port, the serial console or the VNC server of the guest through
+// virt-daemon.
+func (s *Server) handleForward(w http.ResponseWriter, r *http.Request, vmKey client.ObjectKey, endpoint string) {
+	addr, err := s.getDaemonAddr(r.Context(), vmKey)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	tlsConfig, err := tlsutil.NewClientConfig(s.DaemonCertDirPath, daemonServerName)
+	if err != nil {
+		writeError(w, apierrors.NewInternalError(fmt.Errorf("create TLS config: %s", err)))
+		return
+	}
+	protocol, port, err := daemon.ParseVMStreamEndpoint(endpoint)
+	if err != nil {
+		writeError(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+
+	var conn net.Conn
+	apiClient, err := daemonapi.Dial(r.Context(), addr, tlsConfig)
+	switch {
+	case err == daemonapi.ErrLegacyDaemon:
+		if protocol == daemonv1.ForwardProtocol_FORWARD_PROTOCOL_SERIAL || protocol == daemonv1.ForwardProtocol_FORWARD_PROTOCOL_VNC {
+			writeError(w, apierrors.NewServiceUnavailable(fmt.Sprintf("virt-daemon on the node of the VM predates the %s subresource", endpoint)))
+			return
+		}
+		conn, err = daemon.DialLegacyVMStream(r.Context(), addr, tlsConfig, vmKey, endpoint)
+	case err == nil:
+		defer apiClient.Close()
+		conn, err = apiClient.Forward(r.Context(), vmKey, protocol, port)
+	}
+	if err != nil {
+		writeError(w, fromDaemonError(vmKey, err))
+		return
+	}
+	defer conn.Close()
+
+	if err := daemon.ServeUpgradedStream(w, r, conn); err != nil {
+		ctrl.LoggerFrom(r.Context()).Error(err, "forward to guest", "namespace", vmKey.Namespace, "name", vmKey.Name, "endpoint", endpoint)
+	}
+}
+
+// getDaemonAddr returns the address of the virt-daemon on the node of the VM.
+func (s *Server) getDaemonAddr(ctx context.Context, vmKey client.ObjectKey) (string, error) {
+	var vm virtv1alpha1.VirtualMachine
+	if err := s.Get(ctx, vmKey, &vm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", apierrors.NewNotFound(virtv1alpha1.Resource("virtualmachines"), vmKey.Name)
+		}
+		return "", apierrors.NewInternalError(fmt.Errorf("get VM: %s", err))
+	}
+	if vm.Status.NodeName == "" {
+		return "", apierrors.NewConflict(virtv1alpha1.Resource("virtualmachines"), vmKey.Name, errors.New("VM is not on any node"))
+	}
+
+	var podList corev1.PodList
+	if err := s.APIReader.List(ctx, &podList, client.InNamespace(s.DaemonNamespace), client.MatchingLabels{"name": "virt-daemon"}); err != nil {
+		return "", apierrors.NewInternalError(fmt.Errorf("list virt-daemon pods: %s", err))
+	}
+	for _, pod := range podList.Items {
+		if pod.Spec.NodeName == vm.Status.NodeName && pod.Status.PodIP != "" && pod.DeletionTimestamp == nil {
+			return net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(s.DaemonPort)), nil
+		}
+	}
+	return "", apierrors.NewServiceUnavailable(fmt.Sprintf("virt-daemon not found on node %q", vm.Status.NodeName))
+}
+
+// fromDaemonError converts an error of the virt-daemon API to the API error
+// closest to it.
+func fromDaemonError(vmKey client.ObjectKey, err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return apierrors.NewServiceUnavailable(err.Error())
+	}
+	switch st.Code() {
+	case codes.NotFound:
+		return apierrors.NewNotFound(virtv1alpha1.Resource("virtualmachines"), vmKey.Name)
+	case codes.FailedPrecondition:
+		return apierrors.NewConflict(virtv1alpha1.Resource("virtualmachines"), vmKey.Name, errors.New(st.Message()))
+	case codes.InvalidArgument:
+		return apierrors.NewBadRequest(st.Message())
+	case codes.Unavailable:
+		return apierrors.NewServiceUnavailable(st.Message())
+	default:
+		return apierrors.NewInternalError(errors.New(st.Message()))
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var apiStatus apierrors.APIStatus
+	if !errors.As(err, &apiStatus) {
+		apiStatus = apierrors.NewInternalError(err)
+	}
+	st := apiStatus.Status()
+	st.TypeMeta = metav1.TypeMeta{Kind: "Status", APIVersion: "v1"}
+	writeJSON(w, int(st.Code), &st)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(data)
+}
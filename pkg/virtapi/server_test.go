@@ -0,0 +1,256 @@
+package virtapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/user"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+func TestVMSummaries(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(virtv1beta1.AddToScheme(scheme))
+
+	newVM := func(namespace string, name string, phase virtv1alpha1.VirtualMachinePhase) *virtv1alpha1.VirtualMachine {
+		return &virtv1alpha1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+				Labels:    map[string]string{"app": name},
+			},
+			Spec: virtv1alpha1.VirtualMachineSpec{
+				Instance: virtv1alpha1.Instance{
+					CPU: virtv1alpha1.CPU{
+						Sockets:        1,
+						CoresPerSocket: 2,
+					},
+					Memory: virtv1alpha1.Memory{
+						Size: resource.MustParse("1Gi"),
+					},
+				},
+			},
+			Status: virtv1alpha1.VirtualMachineStatus{
+				Phase: phase,
+			},
+		}
+	}
+	runningVM := newVM("ns-0", "vm-0", virtv1alpha1.VirtualMachineRunning)
+	runningVM.Status.NodeName = "node-0"
+	runningVM.Status.Conditions = []metav1.Condition{{
+		Type:   string(virtv1alpha1.VirtualMachineReady),
+		Status: metav1.ConditionTrue,
+	}}
+	runningVM.Status.Migration = &virtv1alpha1.VirtualMachineStatusMigration{
+		Phase: virtv1alpha1.VirtualMachineMigrationRunning,
+	}
+
+	s := &Server{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			runningVM,
+			newVM("ns-0", "vm-1", virtv1alpha1.VirtualMachinePending),
+			newVM("ns-1", "vm-2", virtv1alpha1.VirtualMachineRunning),
+		).Build(),
+		authenticator: authenticator.RequestFunc(func(req *http.Request) (*authenticator.Response, bool, error) {
+			if name := req.Header.Get("X-Remote-User"); name != "" {
+				return &authenticator.Response{User: &user.DefaultInfo{Name: name}}, true, nil
+			}
+			return nil, false, nil
+		}),
+		authorize: func(ctx context.Context, u user.Info, attrs *authorizationv1.ResourceAttributes) (bool, string, error) {
+			return u.GetName() == "admin" || attrs.Namespace == "ns-0", "", nil
+		},
+	}
+	handler := s.handler()
+
+	get := func(path string, userName string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if userName != "" {
+			req.Header.Set("X-Remote-User", userName)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	w := get("/apis/subresources.virtink.smartx.com/v1alpha1/virtualmachinesummaries", "")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = get("/apis/subresources.virtink.smartx.com/v1alpha1/virtualmachinesummaries", "user")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = get("/apis/subresources.virtink.smartx.com/v1alpha1/virtualmachinesummaries", "admin")
+	require.Equal(t, http.StatusOK, w.Code)
+	var summaryList VirtualMachineSummaryList
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &summaryList))
+	assert.Len(t, summaryList.Items, 3)
+	assert.Equal(t, map[virtv1alpha1.VirtualMachinePhase]int{
+		virtv1alpha1.VirtualMachineRunning: 2,
+		virtv1alpha1.VirtualMachinePending: 1,
+	}, summaryList.Phases)
+	summary := summaryList.Items[0]
+	assert.Equal(t, "vm-0", summary.Name)
+	assert.Equal(t, "node-0", summary.NodeName)
+	assert.Equal(t, uint32(2), summary.VCPUs)
+	assert.Equal(t, "1Gi", summary.Memory.String())
+	assert.True(t, summary.Ready)
+	assert.True(t, summary.Migrating)
+
+	w = get("/apis/subresources.virtink.smartx.com/v1alpha1/namespaces/ns-0/virtualmachinesummaries?labelSelector=app%3Dvm-1", "user")
+	require.Equal(t, http.StatusOK, w.Code)
+	summaryList = VirtualMachineSummaryList{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &summaryList))
+	require.Len(t, summaryList.Items, 1)
+	assert.Equal(t, "vm-1", summaryList.Items[0].Name)
+
+	w = get("/apis/subresources.virtink.smartx.com/v1alpha1/virtualmachinesummaries?limit=2", "admin")
+	require.Equal(t, http.StatusOK, w.Code)
+	summaryList = VirtualMachineSummaryList{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &summaryList))
+	require.Len(t, summaryList.Items, 2)
+	assert.Equal(t, "vm-1", summaryList.Items[1].Name)
+	require.NotEmpty(t, summaryList.Continue)
+	assert.Equal(t, int64(1), *summaryList.RemainingItemCount)
+
+	w = get("/apis/subresources.virtink.smartx.com/v1alpha1/virtualmachinesummaries?limit=2&continue="+summaryList.Continue, "admin")
+	require.Equal(t, http.StatusOK, w.Code)
+	summaryList = VirtualMachineSummaryList{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &summaryList))
+	require.Len(t, summaryList.Items, 1)
+	assert.Equal(t, "vm-2", summaryList.Items[0].Name)
+	assert.Empty(t, summaryList.Continue)
+
+	w = get("/apis/subresources.virtink.smartx.com/v1alpha1/virtualmachinesummaries?phase=Running&search=VM-2", "admin")
+	require.Equal(t, http.StatusOK, w.Code)
+	summaryList = VirtualMachineSummaryList{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &summaryList))
+	require.Len(t, summaryList.Items, 1)
+	assert.Equal(t, "vm-2", summaryList.Items[0].Name)
+	assert.Equal(t, map[virtv1alpha1.VirtualMachinePhase]int{
+		virtv1alpha1.VirtualMachineRunning: 1,
+	}, summaryList.Phases)
+
+	w = get("/apis/subresources.virtink.smartx.com/v1alpha1/virtualmachinesummaries?nodeName=node-0", "admin")
+	require.Equal(t, http.StatusOK, w.Code)
+	summaryList = VirtualMachineSummaryList{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &summaryList))
+	require.Len(t, summaryList.Items, 1)
+	assert.Equal(t, "vm-0", summaryList.Items[0].Name)
+
+	w = get("/apis/subresources.virtink.smartx.com/v1alpha1/namespaces/ns-1/virtualmachines/vm-2/console", "user")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = get("/apis/subresources.virtink.smartx.com/v1alpha1", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	var resourceList metav1.APIResourceList
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resourceList))
+	assert.Equal(t, "virtualmachinesummaries", resourceList.APIResources[0].Name)
+
+	w = get("/apis/subresources.virtink.smartx.com/v1alpha1/version", "user")
+	require.Equal(t, http.StatusOK, w.Code)
+	var version Version
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &version))
+	assert.Equal(t, []string{"v1alpha1", "v1beta1"}, version.APIVersions)
+	assert.True(t, version.FeatureGates["LiveMigration"])
+}
+
+func TestVMActionsAndConsoleTickets(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(virtv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(virtv1beta1.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&virtv1alpha1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "vm-0",
+		},
+	}).Build()
+	var userClientName string
+	s := &Server{
+		Client:    c,
+		APIReader: c,
+		authenticator: authenticator.RequestFunc(func(req *http.Request) (*authenticator.Response, bool, error) {
+			return &authenticator.Response{User: &user.DefaultInfo{Name: req.Header.Get("X-Remote-User")}}, true, nil
+		}),
+		authorize: func(ctx context.Context, u user.Info, attrs *authorizationv1.ResourceAttributes) (bool, string, error) {
+			return u.GetName() == "admin", "", nil
+		},
+		newUserClient: func(u user.Info) (client.Client, error) {
+			userClientName = u.GetName()
+			return c, nil
+		},
+		ticketKey: []byte("key"),
+	}
+	handler := s.handler()
+
+	do := func(method string, path string, userName string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("X-Remote-User", userName)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	w := do(http.MethodGet, "/apis/subresources.virtink.smartx.com/v1alpha1/namespaces/default/virtualmachines/vm-0/restart", "user")
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+
+	w = do(http.MethodPost, "/apis/subresources.virtink.smartx.com/v1alpha1/namespaces/default/virtualmachines/vm-0/restart", "user")
+	require.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "user", userClientName)
+	var vma virtv1beta1.VirtualMachineAction
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &vma))
+	assert.Equal(t, "vm-0", vma.Spec.VirtualMachineName)
+	assert.Equal(t, virtv1beta1.VirtualMachineActionRestart, vma.Spec.Action)
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(&vma), &vma))
+
+	w = do(http.MethodPost, "/apis/subresources.virtink.smartx.com/v1alpha1/namespaces/default/virtualmachines/vm-0/consoletickets", "user")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = do(http.MethodPost, "/apis/subresources.virtink.smartx.com/v1alpha1/namespaces/default/virtualmachines/vm-1/consoletickets", "admin")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = do(http.MethodPost, "/apis/subresources.virtink.smartx.com/v1alpha1/namespaces/default/virtualmachines/vm-0/consoletickets", "admin")
+	require.Equal(t, http.StatusCreated, w.Code)
+	var ticket ConsoleTicket
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &ticket))
+	claims, err := s.verifyTicket(ticket.Ticket)
+	require.NoError(t, err)
+	assert.Equal(t, "vm-0", claims.Name)
+	assert.Equal(t, "admin", claims.User)
+
+	w = do(http.MethodGet, "/console?ticket="+ticket.Ticket+"x", "")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	expiredTicket, err := s.signTicket(&consoleTicketClaims{
+		Namespace:      "default",
+		Name:           "vm-0",
+		ExpirationTime: time.Now().Add(-time.Second).Unix(),
+	})
+	require.NoError(t, err)
+	w = do(http.MethodGet, "/console?ticket="+expiredTicket, "")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// the ticket is accepted, but the VM is not on any node
+	w = do(http.MethodGet, ticket.Path, "")
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
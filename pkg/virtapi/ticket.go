@@ -0,0 +1,164 @@
+package virtapi
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+const (
+	// ticketKeySecretName is the Secret of the key tickets are signed with,
+	// shared by the replicas of virt-api.
+	ticketKeySecretName = "virt-api-ticket-key"
+	consoleTicketTTL    = time.Minute
+	// consoleTicketPath is where tickets are used, outside the API path, as
+	// clients with tickets don't go through kube-apiserver.
+	consoleTicketPath = "/console"
+)
+
+// consoleTicketClaims are what a ticket grants, signed by virt-api.
+type consoleTicketClaims struct {
+	Namespace      string `json:"namespace"`
+	Name           string `json:"name"`
+	User           string `json:"user"`
+	ExpirationTime int64  `json:"exp"`
+}
+
+// +kubebuilder:rbac:groups="",namespace=virtink-system,resources=secrets,verbs=get;create
+
+// loadTicketKey returns the key tickets are signed with, generating it if no
+// replica has yet.
+func (s *Server) loadTicketKey(ctx context.Context) ([]byte, error) {
+	secretKey := client.ObjectKey{Namespace: s.Namespace, Name: ticketKeySecretName}
+	var secret corev1.Secret
+	err := s.APIReader.Get(ctx, secretKey, &secret)
+	if apierrors.IsNotFound(err) {
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("generate ticket key: %s", err)
+		}
+		secret = corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: secretKey.Namespace,
+				Name:      secretKey.Name,
+			},
+			Data: map[string][]byte{"key": key},
+		}
+		if err = s.Create(ctx, &secret); apierrors.IsAlreadyExists(err) {
+			// generated by another replica meanwhile
+			err = s.APIReader.Get(ctx, secretKey, &secret)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get ticket key: %s", err)
+	}
+	if len(secret.Data["key"]) == 0 {
+		return nil, fmt.Errorf("ticket key not found in Secret %q", secretKey)
+	}
+	return secret.Data["key"], nil
+}
+
+func (s *Server) signTicket(claims *consoleTicketClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, s.ticketKey)
+	mac.Write(payload)
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+func (s *Server) verifyTicket(ticket string) (*consoleTicketClaims, error) {
+	parts := strings.Split(ticket, ".")
+	if len(parts) != 2 {
+		return nil, errors.New("malformed ticket")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, errors.New("malformed ticket")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errors.New("malformed ticket")
+	}
+	mac := hmac.New(sha256.New, s.ticketKey)
+	mac.Write(payload)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, errors.New("invalid ticket signature")
+	}
+
+	var claims consoleTicketClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, errors.New("malformed ticket")
+	}
+	if time.Now().Unix() > claims.ExpirationTime {
+		return nil, errors.New("ticket expired")
+	}
+	return &claims, nil
+}
+
+// handleConsoleTicket issues a ticket for the console of the VM to a user who
+// may read it, e.g. the backend of a web UI on behalf of a browser.
+func (s *Server) handleConsoleTicket(w http.ResponseWriter, r *http.Request, u user.Info, vmKey client.ObjectKey) {
+	var vm virtv1alpha1.VirtualMachine
+	if err := s.Get(r.Context(), vmKey, &vm); err != nil {
+		if apierrors.IsNotFound(err) {
+			writeError(w, apierrors.NewNotFound(virtv1alpha1.Resource("virtualmachines"), vmKey.Name))
+			return
+		}
+		writeError(w, apierrors.NewInternalError(fmt.Errorf("get VM: %s", err)))
+		return
+	}
+
+	expirationTime := time.Now().Add(consoleTicketTTL)
+	ticket, err := s.signTicket(&consoleTicketClaims{
+		Namespace:      vmKey.Namespace,
+		Name:           vmKey.Name,
+		User:           u.GetName(),
+		ExpirationTime: expirationTime.Unix(),
+	})
+	if err != nil {
+		writeError(w, apierrors.NewInternalError(fmt.Errorf("sign ticket: %s", err)))
+		return
+	}
+	writeJSON(w, http.StatusCreated, &ConsoleTicket{
+		TypeMeta:            metav1.TypeMeta{Kind: "ConsoleTicket", APIVersion: SchemeGroupVersion.String()},
+		Ticket:              ticket,
+		ExpirationTimestamp: metav1.NewTime(expirationTime),
+		Path:                consoleTicketPath + "?ticket=" + url.QueryEscape(ticket),
+	})
+}
+
+// handleTicketedConsole serves the console of the VM of the ticket. The
+// ticket is only checked when the console is opened, so a followed console
+// stays open after the ticket expires.
+func (s *Server) handleTicketedConsole(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+	claims, err := s.verifyTicket(r.URL.Query().Get("ticket"))
+	if err != nil {
+		writeError(w, apierrors.NewUnauthorized(err.Error()))
+		return
+	}
+	ctrl.LoggerFrom(r.Context()).Info("open console with ticket", "namespace", claims.Namespace, "name", claims.Name, "user", claims.User)
+	s.handleConsole(w, r, client.ObjectKey{Namespace: claims.Namespace, Name: claims.Name})
+}
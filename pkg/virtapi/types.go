@@ -0,0 +1,70 @@
+package virtapi
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+)
+
+// GroupName is the API group served by virt-api.
+const GroupName = "subresources.virtink.smartx.com"
+
+// SchemeGroupVersion is the API version served by virt-api.
+var SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1alpha1"}
+
+// VirtualMachineSummary is what dashboards show of a VM in VM lists.
+type VirtualMachineSummary struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Phase    virtv1alpha1.VirtualMachinePhase `json:"phase,omitempty"`
+	NodeName string                           `json:"nodeName,omitempty"`
+	VMPodIP  string                           `json:"vmPodIP,omitempty"`
+	// VCPUs is the number of vCPUs of the VM.
+	VCPUs uint32 `json:"vcpus"`
+	// Memory is the guest memory size of the VM.
+	Memory resource.Quantity `json:"memory"`
+	Ready  bool              `json:"ready"`
+	Paused bool              `json:"paused"`
+	// Migrating is whether a migration of the VM is in progress.
+	Migrating bool `json:"migrating"`
+}
+
+// VirtualMachineSummaryList is the response of listing VM summaries.
+type VirtualMachineSummaryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Phases is the number of VMs in each phase matching the filters other
+	// than the phase filter, across all pages.
+	Phases map[virtv1alpha1.VirtualMachinePhase]int `json:"phases"`
+	Items  []VirtualMachineSummary                  `json:"items"`
+}
+
+// Version tells clients what the cluster supports.
+type Version struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// APIVersions are the versions of virt.virtink.smartx.com served by the
+	// cluster.
+	APIVersions []string `json:"apiVersions"`
+	// DaemonAPIVersions are the versions of the virt-daemon API virt-api
+	// talks.
+	DaemonAPIVersions []string `json:"daemonAPIVersions"`
+	// FeatureGates tells whether each feature gate is enabled by the Virtink
+	// config.
+	FeatureGates map[string]bool `json:"featureGates"`
+}
+
+// ConsoleTicket lets a client without Kubernetes credentials, e.g. a browser,
+// read the console of a VM until it expires.
+type ConsoleTicket struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Ticket              string      `json:"ticket"`
+	ExpirationTimestamp metav1.Time `json:"expirationTimestamp"`
+	// Path is the path on virt-api the ticket is used at, e.g. through a
+	// proxy of the web UI.
+	Path string `json:"path"`
+}
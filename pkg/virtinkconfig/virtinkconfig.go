@@ -0,0 +1,113 @@
+package virtinkconfig
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+// Name is the name of the VirtinkConfig that takes effect.
+const Name = "virtink"
+
+// Feature gates.
+const (
+	// LiveMigration allows VMMs to be created.
+	LiveMigration = "LiveMigration"
+	// VMExport allows VMEs to be created.
+	VMExport = "VMExport"
+	// OrphanVMCleanup lets virt-daemon shut down Cloud Hypervisor processes
+	// not claimed by any VM.
+	OrphanVMCleanup = "OrphanVMCleanup"
+)
+
+var defaultFeatureGates = map[string]bool{
+	LiveMigration:   true,
+	VMExport:        true,
+	OrphanVMCleanup: true,
+}
+
+// DefaultFilesystemOverhead is the filesystem overhead of storage classes
+// not configured, the same as the default of CDI.
+const DefaultFilesystemOverhead = "0.055"
+
+// IsKnownFeatureGate returns whether the feature gate is defined.
+func IsKnownFeatureGate(featureGate string) bool {
+	_, ok := defaultFeatureGates[featureGate]
+	return ok
+}
+
+// Get returns the VirtinkConfig of the cluster. An empty VirtinkConfig is
+// returned if it doesn't exist, so that defaults apply.
+func Get(ctx context.Context, c client.Reader) (*virtv1beta1.VirtinkConfig, error) {
+	var config virtv1beta1.VirtinkConfig
+	if err := c.Get(ctx, client.ObjectKey{Name: Name}, &config); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &virtv1beta1.VirtinkConfig{}, nil
+		}
+		return nil, err
+	}
+	return &config, nil
+}
+
+// GetNamespaceConfig returns the VirtinkNamespaceConfig of the namespace. An
+// empty VirtinkNamespaceConfig is returned if it doesn't exist, so that the
+// VirtinkConfig applies.
+func GetNamespaceConfig(ctx context.Context, c client.Reader, namespace string) (*virtv1beta1.VirtinkNamespaceConfig, error) {
+	var config virtv1beta1.VirtinkNamespaceConfig
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: Name}, &config); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &virtv1beta1.VirtinkNamespaceConfig{}, nil
+		}
+		return nil, err
+	}
+	return &config, nil
+}
+
+// FeatureGateEnabled returns whether the feature gate is enabled by the
+// config, falling back to its default.
+func FeatureGateEnabled(config *virtv1beta1.VirtinkConfig, featureGate string) bool {
+	if enabled, ok := config.Spec.FeatureGates[featureGate]; ok {
+		return enabled
+	}
+	return defaultFeatureGates[featureGate]
+}
+
+// FeatureGates returns whether each known feature gate is enabled by the
+// config.
+func FeatureGates(config *virtv1beta1.VirtinkConfig) map[string]bool {
+	featureGates := map[string]bool{}
+	for featureGate := range defaultFeatureGates {
+		featureGates[featureGate] = FeatureGateEnabled(config, featureGate)
+	}
+	return featureGates
+}
+
+// FilesystemOverhead returns the fraction of Filesystem mode PVCs of the
+// storage class reserved for the file system.
+func FilesystemOverhead(config *virtv1beta1.VirtinkConfig, storageClassName string) (float64, error) {
+	overhead := config.Spec.Storage.FilesystemOverhead.StorageClasses[storageClassName]
+	if overhead == "" {
+		overhead = config.Spec.Storage.FilesystemOverhead.Global
+	}
+	if overhead == "" {
+		overhead = DefaultFilesystemOverhead
+	}
+	return ParseFilesystemOverhead(overhead)
+}
+
+// ParseFilesystemOverhead parses a filesystem overhead between 0 and 1.
+func ParseFilesystemOverhead(overhead string) (float64, error) {
+	value, err := strconv.ParseFloat(overhead, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse filesystem overhead: %s", err)
+	}
+	if value < 0 || value > 1 {
+		return 0, fmt.Errorf("filesystem overhead must be between 0 and 1")
+	}
+	return value, nil
+}
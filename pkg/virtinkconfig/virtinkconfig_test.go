@@ -0,0 +1,64 @@
+package virtinkconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	virtv1beta1 "github.com/smartxworks/virtink/pkg/apis/virt/v1beta1"
+)
+
+func TestFeatureGateEnabled(t *testing.T) {
+	var scheme = runtime.NewScheme()
+	utilruntime.Must(virtv1beta1.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	config, err := Get(context.Background(), c)
+	assert.NoError(t, err)
+	assert.True(t, FeatureGateEnabled(config, LiveMigration))
+	assert.False(t, FeatureGateEnabled(config, "Unknown"))
+
+	c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(&virtv1beta1.VirtinkConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: Name,
+		},
+		Spec: virtv1beta1.VirtinkConfigSpec{
+			FeatureGates: map[string]bool{
+				LiveMigration: false,
+			},
+		},
+	}).Build()
+	config, err = Get(context.Background(), c)
+	assert.NoError(t, err)
+	assert.False(t, FeatureGateEnabled(config, LiveMigration))
+	assert.True(t, FeatureGateEnabled(config, VMExport))
+}
+
+func TestFilesystemOverhead(t *testing.T) {
+	config := &virtv1beta1.VirtinkConfig{}
+	overhead, err := FilesystemOverhead(config, "local-path")
+	assert.NoError(t, err)
+	assert.Equal(t, 0.055, overhead)
+
+	config.Spec.Storage.FilesystemOverhead = virtv1beta1.VirtinkConfigFilesystemOverhead{
+		Global: "0.1",
+		StorageClasses: map[string]string{
+			"local-path": "0",
+		},
+	}
+	overhead, err = FilesystemOverhead(config, "local-path")
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, overhead)
+	overhead, err = FilesystemOverhead(config, "nfs")
+	assert.NoError(t, err)
+	assert.Equal(t, 0.1, overhead)
+
+	config.Spec.Storage.FilesystemOverhead.StorageClasses["nfs"] = "1.5"
+	_, err = FilesystemOverhead(config, "nfs")
+	assert.Error(t, err)
+}
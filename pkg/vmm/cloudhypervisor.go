@@ -0,0 +1,161 @@
+package vmm
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/cloudhypervisor"
+)
+
+// CloudHypervisor is the driver of Cloud Hypervisor, the default VMM.
+type CloudHypervisor struct{}
+
+func (CloudHypervisor) Command(socketDirPath string, vm *virtv1alpha1.VirtualMachine, vmConfig *cloudhypervisor.VmConfig) ([]string, error) {
+	cmd := []string{"cloud-hypervisor", "--api-socket", filepath.Join(socketDirPath, "ch.sock")}
+	return append(cmd, buildCloudHypervisorArgs(vm, vmConfig)...), nil
+}
+
+func (CloudHypervisor) Connect(socketDirPath string) VMM {
+	return cloudhypervisor.NewClient(filepath.Join(socketDirPath, "ch.sock"))
+}
+
+// buildCloudHypervisorArgs returns the arguments for Cloud Hypervisor to boot
+// the VM with the config.
+func buildCloudHypervisorArgs(vm *virtv1alpha1.VirtualMachine, vmConfig *cloudhypervisor.VmConfig) []string {
+	args := []string{"--console", "pty", "--serial", "tty"}
+	args = append(args, "--kernel", vmConfig.Payload.Kernel)
+	if vmConfig.Payload.Cmdline != "" {
+		args = append(args, "--cmdline", fmt.Sprintf("'%s'", vmConfig.Payload.Cmdline))
+	}
+
+	vcpuToPCPU := []string{}
+	for _, affinity := range vmConfig.Cpus.Affinity {
+		vcpuToPCPU = append(vcpuToPCPU, fmt.Sprintf("%d@[%d]", affinity.Vcpu, affinity.HostCpus[0]))
+	}
+	cpuAffinity := ""
+	if len(vcpuToPCPU) > 0 {
+		cpuAffinity = fmt.Sprintf("[%s]", strings.Join(vcpuToPCPU, ","))
+	}
+	args = append(args, "--cpus", fmt.Sprintf("boot=%d,topology=%d:%d:%d:%d,affinity=%s",
+		vmConfig.Cpus.BootVcpus, vmConfig.Cpus.Topology.ThreadsPerCore, vmConfig.Cpus.Topology.CoresPerDie,
+		vmConfig.Cpus.Topology.DiesPerPackage, vmConfig.Cpus.Topology.Packages, cpuAffinity))
+
+	memoryArg := fmt.Sprintf("size=%d", vmConfig.Memory.Size)
+	if vmConfig.Memory.Shared {
+		memoryArg = memoryArg + ",shared=on"
+	}
+	if vmConfig.Memory.Hugepages {
+		memoryArg = memoryArg + ",hugepages=true"
+	}
+	if vmConfig.Memory.Prefault {
+		memoryArg = memoryArg + ",prefault=on"
+	}
+	if vmConfig.Memory.Mergeable {
+		memoryArg = memoryArg + ",mergeable=on"
+	}
+	args = append(args, "--memory", memoryArg)
+
+	if len(vmConfig.Disks) > 0 {
+		diskIOEngines := map[string]virtv1alpha1.DiskIOEngine{}
+		for _, disk := range vm.Spec.Instance.Disks {
+			diskIOEngines[disk.Name] = disk.IOEngine
+		}
+
+		args = append(args, "--disk")
+		for _, disk := range vmConfig.Disks {
+			arg := fmt.Sprintf("id=%s,path=%s", disk.Id, disk.Path)
+			if disk.Readonly {
+				arg = arg + ",readonly=on"
+			}
+			if disk.Direct {
+				arg = arg + ",direct=on"
+			}
+			if disk.NumQueues > 0 {
+				arg = arg + fmt.Sprintf(",num_queues=%d", disk.NumQueues)
+			}
+			if diskIOEngines[disk.Id] == virtv1alpha1.DiskIOEngineThreads {
+				// the option is not in the API of Cloud Hypervisor
+				arg = arg + ",_disable_io_uring=on"
+			}
+			if disk.RateLimiterConfig != nil {
+				arg = arg + "," + strings.Join(disk.RateLimiterConfig.Args(), ",")
+			}
+			args = append(args, arg)
+		}
+	}
+
+	if len(vmConfig.Fs) > 0 {
+		args = append(args, "--fs")
+		for _, fs := range vmConfig.Fs {
+			arg := fmt.Sprintf("id=%s,socket=%s,tag=%s", fs.Id, fs.Socket, fs.Tag)
+			args = append(args, arg)
+		}
+	}
+
+	if len(vmConfig.Net) > 0 {
+		args = append(args, "--net")
+		for _, net := range vmConfig.Net {
+			var arg string
+			if net.VhostUser {
+				arg = fmt.Sprintf("id=%s,mac=%s,mtu=%d,vhost_user=true,vhost_mode=server,socket=%s", net.Id, net.Mac, net.Mtu, net.VhostSocket)
+			} else {
+				arg = fmt.Sprintf("id=%s,mac=%s,tap=%s,mtu=%d", net.Id, net.Mac, net.Tap, net.Mtu)
+				if net.NumQueues > 0 {
+					arg = arg + fmt.Sprintf(",num_queues=%d", net.NumQueues)
+				}
+			}
+			if net.QueueSize > 0 {
+				arg = arg + fmt.Sprintf(",queue_size=%d", net.QueueSize)
+			}
+			args = append(args, arg)
+		}
+	}
+
+	if len(vmConfig.Devices) > 0 {
+		args = append(args, "--device")
+		for _, device := range vmConfig.Devices {
+			args = append(args, fmt.Sprintf("id=%s,path=%s", device.Id, device.Path))
+		}
+	}
+
+	if len(vmConfig.Pmem) > 0 {
+		args = append(args, "--pmem")
+		for _, pmem := range vmConfig.Pmem {
+			arg := fmt.Sprintf("id=%s,file=%s,size=%d", pmem.Id, pmem.File, pmem.Size)
+			if pmem.DiscardWrites {
+				arg = arg + ",discard_writes=on"
+			}
+			args = append(args, arg)
+		}
+	}
+
+	if vmConfig.Watchdog {
+		args = append(args, "--watchdog")
+	}
+
+	if vmConfig.Rng != nil {
+		args = append(args, "--rng", fmt.Sprintf("src=%s", vmConfig.Rng.Src))
+	}
+
+	if vmConfig.Vsock != nil {
+		args = append(args, "--vsock", fmt.Sprintf("cid=%d,socket=%s", vmConfig.Vsock.Cid, vmConfig.Vsock.Socket))
+	}
+
+	if vmConfig.Balloon != nil {
+		balloonArg := fmt.Sprintf("size=%d", vmConfig.Balloon.Size)
+		if vmConfig.Balloon.DeflateOnOom {
+			balloonArg = balloonArg + ",deflate_on_oom=on"
+		}
+		if vmConfig.Balloon.FreePageReporting {
+			balloonArg = balloonArg + ",free_page_reporting=on"
+		}
+		args = append(args, "--balloon", balloonArg)
+	}
+
+	if vmConfig.Platform != nil && len(vmConfig.Platform.OemStrings) > 0 {
+		args = append(args, "--platform", fmt.Sprintf("oem_strings=[%s]", strings.Join(vmConfig.Platform.OemStrings, ",")))
+	}
+	return args
+}
@@ -0,0 +1,276 @@
+package vmm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/cloudhypervisor"
+)
+
+// Firecracker is the driver of Firecracker, for microVMs with minimal
+// overhead. The VM boots the kernel directly, and has only virtio-mmio disks,
+// tap network interfaces and vsock, which can't be hotplugged. It has no
+// virtio-rng device.
+type Firecracker struct{}
+
+// firecrackerBootArgs are prepended to the kernel cmdline for the serial
+// console, and for Firecracker to exit on guest reboot, as it can't reboot.
+const firecrackerBootArgs = "console=ttyS0 reboot=k panic=1 pci=off"
+
+type firecrackerConfig struct {
+	BootSource        firecrackerBootSource         `json:"boot-source"`
+	Drives            []firecrackerDrive            `json:"drives"`
+	MachineConfig     firecrackerMachineConfig      `json:"machine-config"`
+	NetworkInterfaces []firecrackerNetworkInterface `json:"network-interfaces,omitempty"`
+	Vsock             *firecrackerVsock             `json:"vsock,omitempty"`
+	Balloon           *firecrackerBalloon           `json:"balloon,omitempty"`
+}
+
+type firecrackerBootSource struct {
+	KernelImagePath string `json:"kernel_image_path"`
+	BootArgs        string `json:"boot_args,omitempty"`
+}
+
+type firecrackerDrive struct {
+	DriveID      string `json:"drive_id"`
+	PathOnHost   string `json:"path_on_host"`
+	IsRootDevice bool   `json:"is_root_device"`
+	IsReadOnly   bool   `json:"is_read_only"`
+	CacheType    string `json:"cache_type,omitempty"`
+	IOEngine     string `json:"io_engine,omitempty"`
+}
+
+type firecrackerMachineConfig struct {
+	VcpuCount  int   `json:"vcpu_count"`
+	MemSizeMib int64 `json:"mem_size_mib"`
+	SMT        bool  `json:"smt"`
+}
+
+type firecrackerVsock struct {
+	VsockID  string `json:"vsock_id"`
+	GuestCID int64  `json:"guest_cid"`
+	UDSPath  string `json:"uds_path"`
+}
+
+type firecrackerBalloon struct {
+	AmountMib    int64 `json:"amount_mib"`
+	DeflateOnOOM bool  `json:"deflate_on_oom"`
+}
+
+type firecrackerNetworkInterface struct {
+	IfaceID     string `json:"iface_id"`
+	GuestMAC    string `json:"guest_mac,omitempty"`
+	HostDevName string `json:"host_dev_name"`
+}
+
+// Command writes the config of the VM to the socket dir, as Firecracker only
+// takes its config from a file.
+func (Firecracker) Command(socketDirPath string, vm *virtv1alpha1.VirtualMachine, vmConfig *cloudhypervisor.VmConfig) ([]string, error) {
+	if vm.Spec.Instance.Kernel == nil {
+		return nil, fmt.Errorf("Firecracker requires direct kernel boot")
+	}
+	if len(vmConfig.Fs) > 0 || len(vmConfig.Devices) > 0 {
+		return nil, fmt.Errorf("file systems and devices are not supported by Firecracker")
+	}
+	if len(vmConfig.Pmem) > 0 {
+		return nil, fmt.Errorf("pmem disks are not supported by Firecracker")
+	}
+	if vmConfig.Platform != nil && len(vmConfig.Platform.OemStrings) > 0 {
+		return nil, fmt.Errorf("SMBIOS is not supported by Firecracker")
+	}
+
+	config := firecrackerConfig{
+		BootSource: firecrackerBootSource{
+			KernelImagePath: vmConfig.Payload.Kernel,
+			BootArgs:        firecrackerBootArgs,
+		},
+		Drives: []firecrackerDrive{},
+		MachineConfig: firecrackerMachineConfig{
+			VcpuCount:  vmConfig.Cpus.BootVcpus,
+			MemSizeMib: vmConfig.Memory.Size >> 20,
+		},
+	}
+	if vmConfig.Payload.Cmdline != "" {
+		config.BootSource.BootArgs = config.BootSource.BootArgs + " " + vmConfig.Payload.Cmdline
+	}
+
+	disks := map[string]virtv1alpha1.Disk{}
+	for _, disk := range vm.Spec.Instance.Disks {
+		disks[disk.Name] = disk
+	}
+	for _, disk := range vmConfig.Disks {
+		drive := firecrackerDrive{
+			DriveID:    disk.Id,
+			PathOnHost: disk.Path,
+			IsReadOnly: disk.Readonly,
+		}
+		if disks[disk.Id].Cache == virtv1alpha1.DiskCacheWriteback {
+			// flushes of the guest are ignored unless the cache is writeback
+			drive.CacheType = "Writeback"
+		}
+		switch disks[disk.Id].IOEngine {
+		case virtv1alpha1.DiskIOEngineIOURing:
+			drive.IOEngine = "Async"
+		case virtv1alpha1.DiskIOEngineThreads:
+			drive.IOEngine = "Sync"
+		}
+		config.Drives = append(config.Drives, drive)
+	}
+
+	for _, net := range vmConfig.Net {
+		if net.VhostUser {
+			return nil, fmt.Errorf("vhost-user interfaces are not supported by Firecracker")
+		}
+		config.NetworkInterfaces = append(config.NetworkInterfaces, firecrackerNetworkInterface{
+			IfaceID:     net.Id,
+			GuestMAC:    net.Mac,
+			HostDevName: net.Tap,
+		})
+	}
+
+	if vmConfig.Vsock != nil {
+		// Firecracker serves the same hybrid vsock protocol as Cloud Hypervisor
+		config.Vsock = &firecrackerVsock{
+			VsockID:  "vsock",
+			GuestCID: vmConfig.Vsock.Cid,
+			UDSPath:  vmConfig.Vsock.Socket,
+		}
+	}
+
+	if vmConfig.Balloon != nil {
+		// free page reporting is not supported by Firecracker
+		config.Balloon = &firecrackerBalloon{
+			AmountMib:    vmConfig.Balloon.Size >> 20,
+			DeflateOnOOM: vmConfig.Balloon.DeflateOnOom,
+		}
+	}
+
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("marshal Firecracker config: %s", err)
+	}
+	configPath := filepath.Join(socketDirPath, "firecracker.json")
+	if err := os.WriteFile(configPath, configJSON, 0644); err != nil {
+		return nil, fmt.Errorf("write Firecracker config: %s", err)
+	}
+	return []string{"firecracker", "--api-sock", filepath.Join(socketDirPath, "firecracker.sock"), "--config-file", configPath}, nil
+}
+
+func (Firecracker) Connect(socketDirPath string) VMM {
+	return NewFirecrackerClient(filepath.Join(socketDirPath, "firecracker.sock"))
+}
+
+// FirecrackerClient is a client of the Firecracker API.
+type FirecrackerClient struct {
+	httpClient *http.Client
+}
+
+func NewFirecrackerClient(socketPath string) *FirecrackerClient {
+	return &FirecrackerClient{
+		httpClient: &http.Client{
+			Transport: otelhttp.NewTransport(&http.Transport{
+				DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+					var dialer net.Dialer
+					return dialer.DialContext(ctx, "unix", socketPath)
+				},
+				DisableKeepAlives: true,
+			}, otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+				return "firecracker " + r.URL.Path
+			})),
+		},
+	}
+}
+
+func (c *FirecrackerClient) do(ctx context.Context, method string, path string, arg interface{}, ret interface{}) error {
+	var body bytes.Buffer
+	if arg != nil {
+		if err := json.NewEncoder(&body).Encode(arg); err != nil {
+			return fmt.Errorf("encode request: %s", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, "http://localhost"+path, &body)
+	if err != nil {
+		return fmt.Errorf("build request: %s", err)
+	}
+	if arg != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("do request: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("request failed: %d %s: %s", resp.StatusCode, http.StatusText(resp.StatusCode), string(body))
+	}
+
+	if ret != nil {
+		if err := json.NewDecoder(resp.Body).Decode(ret); err != nil {
+			return fmt.Errorf("decode response: %s", err)
+		}
+	}
+	return nil
+}
+
+// VmInfo returns the state of the VM without its config.
+func (c *FirecrackerClient) VmInfo(ctx context.Context) (*cloudhypervisor.VmInfo, error) {
+	var instanceInfo struct {
+		State string `json:"state"`
+	}
+	if err := c.do(ctx, "GET", "/", nil, &instanceInfo); err != nil {
+		return nil, err
+	}
+
+	state := "Created"
+	switch instanceInfo.State {
+	case "Running":
+		state = "Running"
+	case "Paused":
+		state = "Paused"
+	}
+	return &cloudhypervisor.VmInfo{State: state}, nil
+}
+
+// VmShutdown asks the guest to reboot, on which Firecracker exits. It can't
+// power off the VM otherwise.
+func (c *FirecrackerClient) VmShutdown(ctx context.Context) error {
+	return c.sendCtrlAltDel(ctx)
+}
+
+func (c *FirecrackerClient) VmPowerButton(ctx context.Context) error {
+	return c.sendCtrlAltDel(ctx)
+}
+
+func (c *FirecrackerClient) VmReboot(ctx context.Context) error {
+	return fmt.Errorf("reboot is not supported by Firecracker")
+}
+
+func (c *FirecrackerClient) VmPause(ctx context.Context) error {
+	return c.do(ctx, "PATCH", "/vm", map[string]string{"state": "Paused"}, nil)
+}
+
+func (c *FirecrackerClient) VmResume(ctx context.Context) error {
+	return c.do(ctx, "PATCH", "/vm", map[string]string{"state": "Resumed"}, nil)
+}
+
+func (c *FirecrackerClient) VmResizeBalloon(ctx context.Context, size int64) error {
+	return c.do(ctx, "PATCH", "/balloon", map[string]int64{"amount_mib": size >> 20}, nil)
+}
+
+func (c *FirecrackerClient) sendCtrlAltDel(ctx context.Context) error {
+	return c.do(ctx, "PUT", "/actions", map[string]string{"action_type": "SendCtrlAltDel"}, nil)
+}
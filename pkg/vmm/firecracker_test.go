@@ -0,0 +1,121 @@
+package vmm
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/cloudhypervisor"
+)
+
+func TestFirecrackerCommand(t *testing.T) {
+	socketDirPath := t.TempDir()
+	vm := &virtv1alpha1.VirtualMachine{
+		Spec: virtv1alpha1.VirtualMachineSpec{
+			Instance: virtv1alpha1.Instance{
+				Hypervisor: virtv1alpha1.HypervisorFirecracker,
+				Kernel:     &virtv1alpha1.Kernel{},
+				Disks: []virtv1alpha1.Disk{{
+					Name:     "rootfs",
+					IOEngine: virtv1alpha1.DiskIOEngineIOURing,
+					Cache:    virtv1alpha1.DiskCacheWriteback,
+				}},
+			},
+		},
+	}
+	vmConfig := &cloudhypervisor.VmConfig{
+		Payload: &cloudhypervisor.PayloadConfig{Kernel: "/mnt/virtink-kernel/vmlinux", Cmdline: "root=/dev/vda rw"},
+		Cpus:    &cloudhypervisor.CpusConfig{BootVcpus: 2},
+		Memory:  &cloudhypervisor.MemoryConfig{Size: 512 << 20},
+		Disks: []*cloudhypervisor.DiskConfig{
+			{Id: "rootfs", Path: "/mnt/rootfs/rootfs.raw"},
+			{Id: "cloud-init", Path: "/mnt/cloud-init/cloud-init.iso", Readonly: true},
+		},
+		Net: []*cloudhypervisor.NetConfig{
+			{Id: "pod", Mac: "52:54:00:12:34:56", Tap: "tap0"},
+		},
+		Balloon: &cloudhypervisor.BalloonConfig{DeflateOnOom: true},
+	}
+
+	driver := GetDriver(vm)
+	cmd, err := driver.Command(socketDirPath, vm, vmConfig)
+	assert.NoError(t, err)
+	configPath := filepath.Join(socketDirPath, "firecracker.json")
+	assert.Equal(t, []string{"firecracker", "--api-sock", filepath.Join(socketDirPath, "firecracker.sock"), "--config-file", configPath}, cmd)
+
+	configJSON, err := os.ReadFile(configPath)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"boot-source": {"kernel_image_path": "/mnt/virtink-kernel/vmlinux", "boot_args": "console=ttyS0 reboot=k panic=1 pci=off root=/dev/vda rw"},
+		"drives": [
+			{"drive_id": "rootfs", "path_on_host": "/mnt/rootfs/rootfs.raw", "is_root_device": false, "is_read_only": false, "cache_type": "Writeback", "io_engine": "Async"},
+			{"drive_id": "cloud-init", "path_on_host": "/mnt/cloud-init/cloud-init.iso", "is_root_device": false, "is_read_only": true}
+		],
+		"machine-config": {"vcpu_count": 2, "mem_size_mib": 512, "smt": false},
+		"network-interfaces": [{"iface_id": "pod", "guest_mac": "52:54:00:12:34:56", "host_dev_name": "tap0"}],
+		"balloon": {"amount_mib": 0, "deflate_on_oom": true}
+	}`, string(configJSON))
+
+	vm.Spec.Instance.Kernel = nil
+	_, err = driver.Command(socketDirPath, vm, vmConfig)
+	assert.Error(t, err)
+}
+
+func TestFirecrackerClient(t *testing.T) {
+	socketDirPath := t.TempDir()
+	listener, err := net.Listen("unix", filepath.Join(socketDirPath, "firecracker.sock"))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	type request struct {
+		method string
+		path   string
+		body   map[string]string
+	}
+	requestCh := make(chan request, 10)
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := request{method: r.Method, path: r.URL.Path}
+		if body, _ := ioutil.ReadAll(r.Body); len(body) > 0 {
+			json.Unmarshal(body, &req.body)
+		}
+		requestCh <- req
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/":
+			w.Write([]byte(`{"id": "anonymous-instance", "state": "Paused", "vmm_version": "1.1.2", "app_name": "Firecracker"}`))
+		case r.Method == "PATCH" && r.URL.Path == "/vm", r.Method == "PUT" && r.URL.Path == "/actions":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"fault_message": "Invalid request method and/or path"}`))
+		}
+	})}
+	go server.Serve(listener)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client := Firecracker{}.Connect(socketDirPath)
+	vmInfo, err := client.VmInfo(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "Paused", vmInfo.State)
+	assert.Equal(t, request{method: "GET", path: "/"}, <-requestCh)
+
+	assert.NoError(t, client.VmResume(ctx))
+	assert.Equal(t, request{method: "PATCH", path: "/vm", body: map[string]string{"state": "Resumed"}}, <-requestCh)
+
+	assert.NoError(t, client.VmPowerButton(ctx))
+	assert.Equal(t, request{method: "PUT", path: "/actions", body: map[string]string{"action_type": "SendCtrlAltDel"}}, <-requestCh)
+
+	assert.Error(t, client.VmReboot(ctx))
+}
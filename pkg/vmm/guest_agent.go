@@ -0,0 +1,112 @@
+package vmm
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"path/filepath"
+	"time"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/vsock"
+)
+
+// GuestAgentClient is a client of the QEMU guest agent in the guest. It's
+// reached over the virtio-serial channel of QEMU, or over the hybrid vsock of
+// Cloud Hypervisor and Firecracker if port is set. Each command is executed on
+// a new connection.
+type GuestAgentClient struct {
+	socketPath string
+	port       uint32
+}
+
+func NewGuestAgentClient(socketPath string, port uint32) *GuestAgentClient {
+	return &GuestAgentClient{
+		socketPath: socketPath,
+		port:       port,
+	}
+}
+
+// ConnectGuestAgent returns the client of the guest agent of the VM serving
+// its VMM API in the socket dir, or nil if the VM has no guest agent.
+func ConnectGuestAgent(socketDirPath string, vm *virtv1alpha1.VirtualMachine) *GuestAgentClient {
+	clock := vm.Spec.Instance.Clock
+	if clock == nil || clock.Sync == nil {
+		return nil
+	}
+	if vm.Spec.Instance.Hypervisor == virtv1alpha1.HypervisorQEMU {
+		return NewGuestAgentClient(filepath.Join(socketDirPath, "qga.sock"), 0)
+	}
+	return NewGuestAgentClient(filepath.Join(socketDirPath, "vsock.sock"), clock.Sync.GuestAgentPort)
+}
+
+type guestAgentResponse struct {
+	Return json.RawMessage `json:"return,omitempty"`
+	Error  *qmpError       `json:"error,omitempty"`
+}
+
+// Execute executes the guest agent command with the arguments, unless args is
+// nil, and unmarshals its return into ret, unless ret is nil. The channel is
+// synchronized first, as a previous client may have left a response unread.
+func (c *GuestAgentClient) Execute(ctx context.Context, command string, args interface{}, ret interface{}) error {
+	var conn net.Conn
+	var err error
+	if c.port > 0 {
+		conn, err = vsock.Dial(ctx, c.socketPath, c.port)
+	} else {
+		var dialer net.Dialer
+		conn, err = dialer.DialContext(ctx, "unix", c.socketPath)
+	}
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+
+	decoder := json.NewDecoder(bufio.NewReader(conn))
+	encoder := json.NewEncoder(conn)
+	// the ID is only to tell the response apart from stale ones
+	syncID := time.Now().UnixNano() % (1 << 31)
+	if err := encoder.Encode(qmpCommand{Execute: "guest-sync", Arguments: map[string]int64{"id": syncID}}); err != nil {
+		return fmt.Errorf("write command %q: %s", "guest-sync", err)
+	}
+	for {
+		var resp guestAgentResponse
+		if err := decoder.Decode(&resp); err != nil {
+			return fmt.Errorf("read response to %q: %s", "guest-sync", err)
+		}
+		var id int64
+		if json.Unmarshal(resp.Return, &id) == nil && id == syncID {
+			break
+		}
+	}
+
+	if err := encoder.Encode(qmpCommand{Execute: command, Arguments: args}); err != nil {
+		return fmt.Errorf("write command %q: %s", command, err)
+	}
+	var resp guestAgentResponse
+	if err := decoder.Decode(&resp); err != nil {
+		return fmt.Errorf("read response to %q: %s", command, err)
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s: %s", resp.Error.Class, resp.Error.Desc)
+	}
+	if ret != nil {
+		if err := json.Unmarshal(resp.Return, ret); err != nil {
+			return fmt.Errorf("unmarshal return of %q: %s", command, err)
+		}
+	}
+	return nil
+}
+
+// SetTime sets the system clock of the guest to the time, and the real-time
+// clock of the guest to its system clock.
+func (c *GuestAgentClient) SetTime(ctx context.Context, t time.Time) error {
+	return c.Execute(ctx, "guest-set-time", map[string]int64{"time": t.UnixNano()}, nil)
+}
@@ -0,0 +1,66 @@
+package vmm
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuestAgentClient(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "vsock.sock")
+	listener, err := net.Listen("unix", socketPath)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer listener.Close()
+
+	commandCh := make(chan string, 10)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		line, _ := reader.ReadString('\n')
+		commandCh <- line
+		fmt.Fprintln(conn, "OK 1073741824")
+		// a response left by a previous client
+		fmt.Fprintln(conn, `{"return": {}}`)
+
+		decoder := json.NewDecoder(reader)
+		for {
+			var cmd qmpCommand
+			if err := decoder.Decode(&cmd); err != nil {
+				return
+			}
+			args, _ := json.Marshal(cmd.Arguments)
+			commandCh <- cmd.Execute
+			switch cmd.Execute {
+			case "guest-sync":
+				fmt.Fprintf(conn, `{"return": %s}`+"\n", args[len(`{"id":`):len(args)-1])
+			default:
+				commandCh <- string(args)
+				fmt.Fprintln(conn, `{"return": {}}`)
+			}
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client := NewGuestAgentClient(socketPath, 1234)
+	assert.NoError(t, client.SetTime(ctx, time.Unix(1, 0)))
+	assert.Equal(t, "CONNECT 1234\n", <-commandCh)
+	assert.Equal(t, "guest-sync", <-commandCh)
+	assert.Equal(t, "guest-set-time", <-commandCh)
+	assert.JSONEq(t, `{"time": 1000000000}`, <-commandCh)
+}
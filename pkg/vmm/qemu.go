@@ -0,0 +1,335 @@
+package vmm
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/cloudhypervisor"
+)
+
+// QEMU is the driver of QEMU/KVM, for guests that need devices Cloud
+// Hypervisor lacks. The VM boots with SeaBIOS, or OVMF for EFI, on the q35
+// machine, or on the i440fx machine if it has IDE disks.
+type QEMU struct {
+	// HostUSBDevices are the addresses of the host USB devices allocated to
+	// the VM pod, by the name of the host USB device of the VM.
+	HostUSBDevices map[string]USBDeviceAddress
+}
+
+// USBDeviceAddress locates a host USB device by its bus and device numbers.
+type USBDeviceAddress struct {
+	Bus  int
+	Addr int
+}
+
+const ovmfPath = "/usr/share/OVMF/OVMF.fd"
+
+func (q QEMU) Command(socketDirPath string, vm *virtv1alpha1.VirtualMachine, vmConfig *cloudhypervisor.VmConfig) ([]string, error) {
+	if runtime.GOARCH != "amd64" {
+		return nil, fmt.Errorf("QEMU is not supported on %s", runtime.GOARCH)
+	}
+
+	if vmConfig.Vsock != nil {
+		return nil, fmt.Errorf("vsock is not supported by QEMU")
+	}
+
+	// the serial console is served on a socket for the interactive console of
+	// virt-api and copied to stdout for the console log
+	cmd := []string{"qemu-system-x86_64", "-nodefaults", "-no-user-config", "-display", "none",
+		"-chardev", fmt.Sprintf("socket,id=char-serial,path=%s,server=on,wait=off,logfile=/dev/stdout,logappend=on", filepath.Join(socketDirPath, "serial.sock")),
+		"-serial", "chardev:char-serial",
+		"-qmp", fmt.Sprintf("unix:%s,server=on,wait=off", filepath.Join(socketDirPath, "qmp.sock"))}
+
+	disks := map[string]virtv1alpha1.Disk{}
+	ifaces := map[string]virtv1alpha1.Interface{}
+	for _, iface := range vm.Spec.Instance.Interfaces {
+		ifaces[iface.Name] = iface
+	}
+	machine := "q35"
+	for _, disk := range vm.Spec.Instance.Disks {
+		disks[disk.Name] = disk
+		if disk.Bus == virtv1alpha1.DiskBusIDE {
+			// q35 has no IDE controller
+			machine = "pc"
+		}
+	}
+
+	if vmConfig.Memory.Shared || vmConfig.Memory.Hugepages || vmConfig.Memory.Prefault {
+		memoryBackend := fmt.Sprintf("memory-backend-memfd,id=mem,size=%dB", vmConfig.Memory.Size)
+		if vmConfig.Memory.Shared {
+			memoryBackend = memoryBackend + ",share=on"
+		}
+		if vmConfig.Memory.Hugepages {
+			memoryBackend = memoryBackend + ",hugetlb=on"
+		}
+		if vmConfig.Memory.Prefault {
+			memoryBackend = memoryBackend + ",prealloc=on"
+		}
+		cmd = append(cmd, "-object", memoryBackend)
+		machine = machine + ",memory-backend=mem"
+	}
+	memory := fmt.Sprintf("%dB", vmConfig.Memory.Size)
+	if len(vmConfig.Pmem) > 0 {
+		// pmem devices are mapped into the memory space above the RAM
+		maxMemory := vmConfig.Memory.Size
+		for _, pmem := range vmConfig.Pmem {
+			maxMemory += pmem.Size
+		}
+		memory = memory + fmt.Sprintf(",maxmem=%dB", maxMemory)
+	}
+	cpu := "host"
+	if clock := vm.Spec.Instance.Clock; clock != nil && clock.DisableKVMClock {
+		cpu = cpu + ",kvmclock=off"
+	}
+	cmd = append(cmd, "-machine", machine+",accel=kvm", "-cpu", cpu, "-m", memory)
+	if vm.Spec.Instance.Realtime != nil {
+		// guest memory is locked so that it's never swapped out, within the
+		// unlimited memory lock limit virt-prerunner runs realtime VMs with,
+		// though the webhook only allows dedicated CPU placement, and so
+		// realtime, with Cloud Hypervisor for now
+		cmd = append(cmd, "-overcommit", "mem-lock=on")
+	}
+	cmd = append(cmd, "-smp", fmt.Sprintf("%d,sockets=%d,dies=%d,cores=%d,threads=%d", vmConfig.Cpus.BootVcpus,
+		vmConfig.Cpus.Topology.Packages, vmConfig.Cpus.Topology.DiesPerPackage, vmConfig.Cpus.Topology.CoresPerDie, vmConfig.Cpus.Topology.ThreadsPerCore))
+
+	switch {
+	case vm.Spec.Instance.Kernel != nil:
+		cmd = append(cmd, "-kernel", vmConfig.Payload.Kernel)
+		if vmConfig.Payload.Cmdline != "" {
+			cmd = append(cmd, "-append", fmt.Sprintf("'%s'", vmConfig.Payload.Cmdline))
+		}
+	case vm.Spec.Instance.Firmware != nil && vm.Spec.Instance.Firmware.EFI != nil:
+		cmd = append(cmd, "-bios", ovmfPath)
+	}
+
+	// devices are booted from in the order they are in the config
+	bootIndex := 0
+	numSATADisks := 0
+	numIDEDisks := 0
+	for _, disk := range vmConfig.Disks {
+		if disks[disk.Id].Type == virtv1alpha1.DiskTypeCDROM && disk.Path == "" {
+			// the options of the medium are given when it's inserted
+			cmd = append(cmd, "-drive", fmt.Sprintf("id=drive-%s,if=none,media=cdrom", disk.Id))
+		} else {
+			format := "raw"
+			if disks[disk.Id].Ephemeral {
+				// the overlay reads its raw base image as the backing file
+				format = "qcow2"
+			}
+			drive := fmt.Sprintf("id=drive-%s,file=%s,format=%s,if=none", disk.Id, disk.Path, format)
+			if disks[disk.Id].Encryption != nil {
+				// QEMU decrypts the LUKS image with the passphrase mounted from the secret
+				cmd = append(cmd, "-object", fmt.Sprintf("secret,id=secret-%s,file=/mnt/virtink-luks/%s/passphrase", disk.Id, disk.Id))
+				drive = fmt.Sprintf("id=drive-%s,file=%s,format=luks,key-secret=secret-%s,if=none", disk.Id, disk.Path, disk.Id)
+			}
+			if disk.Readonly {
+				drive = drive + ",readonly=on"
+			}
+			if disk.Direct {
+				drive = drive + ",cache.direct=on"
+			}
+			if disks[disk.Id].Cache == virtv1alpha1.DiskCacheWritethrough {
+				drive = drive + ",cache.writeback=off"
+			}
+			if discard := disks[disk.Id].Discard; discard != nil {
+				drive = drive + ",discard=unmap"
+				if discard.DetectZeroes {
+					drive = drive + ",detect-zeroes=unmap"
+				}
+			}
+			if ioEngine := disks[disk.Id].IOEngine; ioEngine != "" {
+				drive = drive + ",aio=" + string(ioEngine)
+			}
+			if disks[disk.Id].Type == virtv1alpha1.DiskTypeCDROM {
+				drive = drive + ",media=cdrom"
+			}
+			// virt-daemon updates the throttling with block_set_io_throttle
+			drive = drive + NewIOThrottle(disks[disk.Id].RateLimit).DriveOptions()
+			cmd = append(cmd, "-drive", drive)
+		}
+
+		ideDevice := "ide-hd"
+		if disks[disk.Id].Type == virtv1alpha1.DiskTypeCDROM {
+			ideDevice = "ide-cd"
+		}
+		var device string
+		switch disks[disk.Id].Bus {
+		case virtv1alpha1.DiskBusSATA:
+			if numSATADisks == 0 {
+				cmd = append(cmd, "-device", "ahci,id=sata")
+			}
+			if numSATADisks == 6 {
+				return nil, fmt.Errorf("at most 6 SATA disks are supported")
+			}
+			device = fmt.Sprintf("%s,bus=sata.%d", ideDevice, numSATADisks)
+			numSATADisks++
+		case virtv1alpha1.DiskBusIDE:
+			if numIDEDisks == 4 {
+				return nil, fmt.Errorf("at most 4 IDE disks are supported")
+			}
+			device = fmt.Sprintf("%s,bus=ide.%d,unit=%d", ideDevice, numIDEDisks/2, numIDEDisks%2)
+			numIDEDisks++
+		default:
+			if disks[disk.Id].Type == virtv1alpha1.DiskTypeCDROM {
+				return nil, fmt.Errorf("cdrom disks are only supported on the sata or ide bus")
+			}
+			device = "virtio-blk-pci"
+			if disk.NumQueues > 0 {
+				device = device + fmt.Sprintf(",num-queues=%d", disk.NumQueues)
+			}
+		}
+		if disks[disk.Id].Shareable {
+			// other VMs may write to the disk at the same time
+			device = device + ",share-rw=on"
+		}
+		cmd = append(cmd, "-device", fmt.Sprintf("%s,id=%s,drive=drive-%s,bootindex=%d", device, disk.Id, disk.Id, bootIndex))
+		bootIndex++
+	}
+
+	for _, pmem := range vmConfig.Pmem {
+		// writes to a private mapping are not written back to the file
+		share := "on"
+		if pmem.DiscardWrites {
+			share = "off"
+		}
+		cmd = append(cmd, "-object", fmt.Sprintf("memory-backend-file,id=mem-%s,mem-path=%s,size=%dB,share=%s", pmem.Id, pmem.File, pmem.Size, share))
+		cmd = append(cmd, "-device", fmt.Sprintf("virtio-pmem-pci,id=%s,memdev=mem-%s", pmem.Id, pmem.Id))
+	}
+
+	for _, fs := range vmConfig.Fs {
+		cmd = append(cmd, "-chardev", fmt.Sprintf("socket,id=char-%s,path=%s", fs.Id, fs.Socket))
+		cmd = append(cmd, "-device", fmt.Sprintf("vhost-user-fs-pci,id=%s,chardev=char-%s,tag=%s", fs.Id, fs.Id, fs.Tag))
+	}
+
+	for _, net := range vmConfig.Net {
+		device := fmt.Sprintf("virtio-net-pci,id=%s,netdev=net-%s,bootindex=%d", net.Id, net.Id, bootIndex)
+		bootIndex++
+		if net.Mac != "" {
+			device = device + ",mac=" + net.Mac
+		}
+		if net.Mtu > 0 {
+			device = device + fmt.Sprintf(",host_mtu=%d", net.Mtu)
+		}
+		iface := ifaces[net.Id]
+		if iface.RXQueueSize > 0 {
+			device = device + fmt.Sprintf(",rx_queue_size=%d", iface.RXQueueSize)
+		}
+		if iface.TXQueueSize > 0 {
+			device = device + fmt.Sprintf(",tx_queue_size=%d", iface.TXQueueSize)
+		}
+		if offloads := iface.Offloads; offloads != nil {
+			// TSO and UFO require checksum offload
+			if offloads.DisableChecksum {
+				device = device + ",csum=off,guest_csum=off"
+			}
+			if offloads.DisableChecksum || offloads.DisableTSO {
+				device = device + ",host_tso4=off,host_tso6=off,guest_tso4=off,guest_tso6=off"
+			}
+			if offloads.DisableChecksum || offloads.DisableUFO {
+				device = device + ",host_ufo=off,guest_ufo=off"
+			}
+		}
+
+		if net.VhostUser {
+			cmd = append(cmd, "-chardev", fmt.Sprintf("socket,id=char-%s,path=%s,server=on,wait=off", net.Id, net.VhostSocket))
+			cmd = append(cmd, "-netdev", fmt.Sprintf("vhost-user,id=net-%s,chardev=char-%s", net.Id, net.Id))
+		} else {
+			netdev := fmt.Sprintf("tap,id=net-%s,ifname=%s,script=no,downscript=no", net.Id, net.Tap)
+			if ifaces[net.Id].Vhost {
+				netdev = netdev + ",vhost=on"
+			}
+			// each queue pair consists of a RX queue and a TX queue
+			if queuePairs := net.NumQueues / 2; queuePairs > 1 {
+				netdev = netdev + fmt.Sprintf(",queues=%d", queuePairs)
+				device = device + fmt.Sprintf(",mq=on,vectors=%d", 2*queuePairs+2)
+			}
+			cmd = append(cmd, "-netdev", netdev)
+		}
+		cmd = append(cmd, "-device", device)
+	}
+
+	for _, device := range vmConfig.Devices {
+		cmd = append(cmd, "-device", fmt.Sprintf("vfio-pci,id=%s,sysfsdev=%s", device.Id, device.Path))
+	}
+
+	if vmConfig.Rng != nil {
+		cmd = append(cmd, "-object", fmt.Sprintf("rng-random,id=rng,filename=%s", vmConfig.Rng.Src), "-device", "virtio-rng-pci,rng=rng")
+	}
+
+	if vmConfig.Balloon != nil {
+		// the balloon is resized over QMP after boot
+		balloon := "virtio-balloon-pci,id=balloon"
+		if vmConfig.Balloon.DeflateOnOom {
+			balloon = balloon + ",deflate-on-oom=on"
+		}
+		if vmConfig.Balloon.FreePageReporting {
+			balloon = balloon + ",free-page-reporting=on"
+		}
+		cmd = append(cmd, "-device", balloon)
+	}
+
+	if vmConfig.Platform != nil {
+		for _, oemString := range vmConfig.Platform.OemStrings {
+			cmd = append(cmd, "-smbios", "type=11,value="+oemString)
+		}
+	}
+
+	if vmConfig.Watchdog {
+		// QEMU performs the watchdog action itself
+		action := "reset"
+		if vm.Spec.Instance.Watchdog != nil {
+			switch vm.Spec.Instance.Watchdog.Action {
+			case virtv1alpha1.WatchdogPowerOff:
+				action = "poweroff"
+			case virtv1alpha1.WatchdogNone:
+				action = "none"
+			}
+		}
+		cmd = append(cmd, "-device", "i6300esb", "-watchdog-action", action)
+	}
+
+	if devices := vm.Spec.Instance.Devices; devices != nil {
+		if devices.Display != nil {
+			cmd = append(cmd, "-device", "VGA,id=display", "-vnc", fmt.Sprintf("unix:%s", filepath.Join(socketDirPath, "vnc.sock")))
+		}
+		if devices.USB != nil {
+			cmd = append(cmd, "-device", "qemu-xhci,id=usb")
+		}
+		if devices.Tablet != nil {
+			cmd = append(cmd, "-device", "usb-tablet,id=tablet,bus=usb.0")
+		}
+		if devices.Sound != nil {
+			// VNC carries no audio, so the output is discarded
+			cmd = append(cmd, "-audiodev", "none,id=snd0", "-device", "virtio-sound-pci,id=sound,audiodev=snd0")
+		}
+		for _, hostUSBDevice := range devices.HostUSBDevices {
+			addr, ok := q.HostUSBDevices[hostUSBDevice.Name]
+			if !ok {
+				return nil, fmt.Errorf("host USB device %q is not allocated", hostUSBDevice.Name)
+			}
+			cmd = append(cmd, "-device", fmt.Sprintf("usb-host,id=usb-%s,bus=usb.0,hostbus=%d,hostaddr=%d", hostUSBDevice.Name, addr.Bus, addr.Addr))
+		}
+	}
+
+	if clock := vm.Spec.Instance.Clock; clock != nil {
+		if clock.Sync != nil {
+			// virt-daemon talks to the guest agent over the channel
+			cmd = append(cmd, "-chardev", fmt.Sprintf("socket,id=char-qga,path=%s,server=on,wait=off", filepath.Join(socketDirPath, "qga.sock")),
+				"-device", "virtio-serial-pci,id=serial", "-device", "virtserialport,chardev=char-qga,name=org.qemu.guest_agent.0")
+		}
+		if clock.Offset == virtv1alpha1.ClockOffsetLocaltime {
+			// QEMU takes the local time from the TZ environment variable
+			cmd = append(cmd, "-rtc", "base=localtime")
+			if clock.Timezone != "" {
+				cmd = append([]string{"env", "TZ=" + clock.Timezone}, cmd...)
+			}
+		}
+	}
+	return cmd, nil
+}
+
+func (QEMU) Connect(socketDirPath string) VMM {
+	return NewQMPClient(filepath.Join(socketDirPath, "qmp.sock"))
+}
@@ -0,0 +1,295 @@
+package vmm
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/cloudhypervisor"
+)
+
+func TestQEMUCommand(t *testing.T) {
+	if runtime.GOARCH != "amd64" {
+		t.Skip("QEMU is only supported on amd64")
+	}
+
+	vm := &virtv1alpha1.VirtualMachine{
+		Spec: virtv1alpha1.VirtualMachineSpec{
+			Instance: virtv1alpha1.Instance{
+				Hypervisor: virtv1alpha1.HypervisorQEMU,
+				Disks: []virtv1alpha1.Disk{{
+					Name:     "root",
+					Bus:      virtv1alpha1.DiskBusIDE,
+					IOEngine: virtv1alpha1.DiskIOEngineIOURing,
+				}, {
+					Name:    "data",
+					Bus:     virtv1alpha1.DiskBusSATA,
+					Cache:   virtv1alpha1.DiskCacheWritethrough,
+					Discard: &virtv1alpha1.DiskDiscard{DetectZeroes: true},
+					RateLimit: &virtv1alpha1.DiskRateLimit{
+						Bandwidth:      resource.NewQuantity(100<<20, resource.BinarySI),
+						BandwidthBurst: resource.NewQuantity(50<<20, resource.BinarySI),
+						IOPS:           1000,
+					},
+				}, {
+					Name:       "secret",
+					Encryption: &virtv1alpha1.DiskEncryption{SecretName: "secret"},
+					Shareable:  true,
+				}, {
+					Name: "cloud-init",
+				}, {
+					Name: "installer",
+					Type: virtv1alpha1.DiskTypeCDROM,
+					Bus:  virtv1alpha1.DiskBusIDE,
+				}, {
+					Name:    "drivers",
+					Type:    virtv1alpha1.DiskTypeCDROM,
+					Bus:     virtv1alpha1.DiskBusSATA,
+					Ejected: true,
+				}},
+			},
+		},
+	}
+	vmConfig := &cloudhypervisor.VmConfig{
+		Payload: &cloudhypervisor.PayloadConfig{Kernel: "/var/lib/cloud-hypervisor/hypervisor-fw"},
+		Cpus: &cloudhypervisor.CpusConfig{
+			BootVcpus: 2,
+			Topology:  &cloudhypervisor.CpuTopology{Packages: 1, DiesPerPackage: 1, CoresPerDie: 2, ThreadsPerCore: 1},
+		},
+		Memory: &cloudhypervisor.MemoryConfig{Size: 1 << 30},
+		Disks: []*cloudhypervisor.DiskConfig{
+			{Id: "root", Path: "/mnt/root/disk.raw", Direct: true},
+			{Id: "data", Path: "/mnt/data/disk.img"},
+			{Id: "secret", Path: "/mnt/secret"},
+			{Id: "cloud-init", Path: "/mnt/cloud-init/cloud-init.iso", Readonly: true, NumQueues: 2},
+			{Id: "installer", Path: "/mnt/installer/disk.raw", Readonly: true},
+			{Id: "drivers", Readonly: true},
+		},
+		Net: []*cloudhypervisor.NetConfig{
+			{Id: "pod", Mac: "52:54:00:12:34:56", Tap: "tap0", Mtu: 1450, NumQueues: 4},
+		},
+		Pmem: []*cloudhypervisor.PmemConfig{
+			{Id: "dax", File: "/mnt/dax/disk.img", Size: 2 << 20, DiscardWrites: true},
+		},
+		Balloon:  &cloudhypervisor.BalloonConfig{DeflateOnOom: true, FreePageReporting: true},
+		Platform: &cloudhypervisor.PlatformConfig{OemStrings: []string{"virtink.io/namespace=default", "virtink.io/name=ubuntu"}},
+	}
+
+	driver := GetDriver(vm)
+	cmd, err := driver.Command("/var/run/virtink", vm, vmConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"qemu-system-x86_64", "-nodefaults", "-no-user-config", "-display", "none",
+		"-chardev", "socket,id=char-serial,path=/var/run/virtink/serial.sock,server=on,wait=off,logfile=/dev/stdout,logappend=on",
+		"-serial", "chardev:char-serial",
+		"-qmp", "unix:/var/run/virtink/qmp.sock,server=on,wait=off",
+		"-machine", "pc,accel=kvm", "-cpu", "host", "-m", "1073741824B,maxmem=1075838976B",
+		"-smp", "2,sockets=1,dies=1,cores=2,threads=1",
+		"-drive", "id=drive-root,file=/mnt/root/disk.raw,format=raw,if=none,cache.direct=on,aio=io_uring",
+		"-device", "ide-hd,bus=ide.0,unit=0,id=root,drive=drive-root,bootindex=0",
+		"-drive", "id=drive-data,file=/mnt/data/disk.img,format=raw,if=none,cache.writeback=off,discard=unmap,detect-zeroes=unmap,throttling.bps-total=104857600,throttling.bps-total-max=157286400,throttling.iops-total=1000",
+		"-device", "ahci,id=sata",
+		"-device", "ide-hd,bus=sata.0,id=data,drive=drive-data,bootindex=1",
+		"-object", "secret,id=secret-secret,file=/mnt/virtink-luks/secret/passphrase",
+		"-drive", "id=drive-secret,file=/mnt/secret,format=luks,key-secret=secret-secret,if=none",
+		"-device", "virtio-blk-pci,share-rw=on,id=secret,drive=drive-secret,bootindex=2",
+		"-drive", "id=drive-cloud-init,file=/mnt/cloud-init/cloud-init.iso,format=raw,if=none,readonly=on",
+		"-device", "virtio-blk-pci,num-queues=2,id=cloud-init,drive=drive-cloud-init,bootindex=3",
+		"-drive", "id=drive-installer,file=/mnt/installer/disk.raw,format=raw,if=none,readonly=on,media=cdrom",
+		"-device", "ide-cd,bus=ide.0,unit=1,id=installer,drive=drive-installer,bootindex=4",
+		"-drive", "id=drive-drivers,if=none,media=cdrom",
+		"-device", "ide-cd,bus=sata.1,id=drivers,drive=drive-drivers,bootindex=5",
+		"-object", "memory-backend-file,id=mem-dax,mem-path=/mnt/dax/disk.img,size=2097152B,share=off",
+		"-device", "virtio-pmem-pci,id=dax,memdev=mem-dax",
+		"-netdev", "tap,id=net-pod,ifname=tap0,script=no,downscript=no,queues=2",
+		"-device", "virtio-net-pci,id=pod,netdev=net-pod,bootindex=6,mac=52:54:00:12:34:56,host_mtu=1450,mq=on,vectors=6",
+		"-device", "virtio-balloon-pci,id=balloon,deflate-on-oom=on,free-page-reporting=on",
+		"-smbios", "type=11,value=virtink.io/namespace=default",
+		"-smbios", "type=11,value=virtink.io/name=ubuntu",
+	}, cmd)
+
+	vm.Spec.Instance.Disks = nil
+	vm.Spec.Instance.Firmware = &virtv1alpha1.Firmware{EFI: &virtv1alpha1.EFIFirmware{}}
+	vmConfig.Disks = nil
+	vmConfig.Net = nil
+	vmConfig.Memory.Shared = true
+	cmd, err = driver.Command("/var/run/virtink", vm, vmConfig)
+	assert.NoError(t, err)
+	assert.Contains(t, cmd, "memory-backend-memfd,id=mem,size=1073741824B,share=on")
+	assert.Contains(t, cmd, "q35,memory-backend=mem,accel=kvm")
+	assert.Contains(t, cmd, ovmfPath)
+	assert.NotContains(t, cmd, "-overcommit")
+
+	vm.Spec.Instance.Realtime = &virtv1alpha1.Realtime{Priority: 1}
+	vmConfig.Memory.Prefault = true
+	cmd, err = driver.Command("/var/run/virtink", vm, vmConfig)
+	assert.NoError(t, err)
+	assert.Contains(t, cmd, "memory-backend-memfd,id=mem,size=1073741824B,share=on,prealloc=on")
+	assert.Contains(t, cmd, "mem-lock=on")
+	vm.Spec.Instance.Realtime = nil
+	vmConfig.Memory.Prefault = false
+
+	vm.Spec.Instance.Devices = &virtv1alpha1.Devices{
+		Display: &virtv1alpha1.Display{},
+		USB:     &virtv1alpha1.USBController{},
+		Tablet:  &virtv1alpha1.Tablet{},
+		Sound:   &virtv1alpha1.Sound{},
+	}
+	cmd, err = driver.Command("/var/run/virtink", vm, vmConfig)
+	assert.NoError(t, err)
+	assert.Contains(t, cmd, "VGA,id=display")
+	assert.Contains(t, cmd, "unix:/var/run/virtink/vnc.sock")
+	assert.Contains(t, cmd, "qemu-xhci,id=usb")
+	assert.Contains(t, cmd, "usb-tablet,id=tablet,bus=usb.0")
+	assert.Contains(t, cmd, "none,id=snd0")
+	assert.Contains(t, cmd, "virtio-sound-pci,id=sound,audiodev=snd0")
+
+	vm.Spec.Instance.Devices.HostUSBDevices = []virtv1alpha1.HostUSBDevice{{
+		Name:         "dongle",
+		ResourceName: "devices.virtink.io/usb-dongle",
+	}}
+	_, err = driver.Command("/var/run/virtink", vm, vmConfig)
+	assert.Error(t, err)
+
+	cmd, err = QEMU{HostUSBDevices: map[string]USBDeviceAddress{"dongle": {Bus: 1, Addr: 4}}}.Command("/var/run/virtink", vm, vmConfig)
+	assert.NoError(t, err)
+	assert.Contains(t, cmd, "usb-host,id=usb-dongle,bus=usb.0,hostbus=1,hostaddr=4")
+	vm.Spec.Instance.Devices = nil
+
+	vm.Spec.Instance.Clock = &virtv1alpha1.Clock{
+		Offset:          virtv1alpha1.ClockOffsetLocaltime,
+		Timezone:        "Asia/Shanghai",
+		DisableKVMClock: true,
+		Sync:            &virtv1alpha1.ClockSync{},
+	}
+	cmd, err = driver.Command("/var/run/virtink", vm, vmConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"env", "TZ=Asia/Shanghai", "qemu-system-x86_64"}, cmd[:3])
+	assert.Contains(t, cmd, "host,kvmclock=off")
+	assert.Contains(t, cmd, "socket,id=char-qga,path=/var/run/virtink/qga.sock,server=on,wait=off")
+	assert.Contains(t, cmd, "virtserialport,chardev=char-qga,name=org.qemu.guest_agent.0")
+	assert.Equal(t, []string{"-rtc", "base=localtime"}, cmd[len(cmd)-2:])
+
+	vm.Spec.Instance.Disks = []virtv1alpha1.Disk{{Name: "golden", Ephemeral: true}}
+	vmConfig.Disks = []*cloudhypervisor.DiskConfig{{Id: "golden", Path: "/var/lib/virtink/ephemeral/golden.qcow2"}}
+	cmd, err = driver.Command("/var/run/virtink", vm, vmConfig)
+	assert.NoError(t, err)
+	assert.Contains(t, cmd, "id=drive-golden,file=/var/lib/virtink/ephemeral/golden.qcow2,format=qcow2,if=none")
+}
+
+func TestQMPClient(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "qmp.sock")
+	listener, err := net.Listen("unix", socketPath)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer listener.Close()
+
+	commandCh := make(chan string, 10)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			fmt.Fprintln(conn, `{"QMP": {"version": {}, "capabilities": []}}`)
+			scanner := bufio.NewScanner(conn)
+			for scanner.Scan() {
+				var cmd qmpCommand
+				if err := json.Unmarshal(scanner.Bytes(), &cmd); err != nil {
+					break
+				}
+				commandCh <- cmd.Execute
+				switch cmd.Execute {
+				case "query-block":
+					fmt.Fprintln(conn, `{"return": [{"device": "drive-installer", "qdev": "installer", "removable": true}, {"device": "drive-data", "qdev": "data", "removable": false, "inserted": {"file": "/mnt/data/disk.img", "bps": 0, "iops": 1000}}]}`)
+				case "query-memory-size-summary":
+					fmt.Fprintln(conn, `{"return": {"base-memory": 1073741824, "plugged-memory": 0}}`)
+				case "balloon", "blockdev-change-medium", "set_link", "block_set_io_throttle":
+					args, _ := json.Marshal(cmd.Arguments)
+					commandCh <- string(args)
+					fmt.Fprintln(conn, `{"return": {}}`)
+				case "qmp_capabilities":
+					fmt.Fprintln(conn, `{"return": {}}`)
+				case "query-status":
+					fmt.Fprintln(conn, `{"event": "RESUME", "timestamp": {}}`)
+					fmt.Fprintln(conn, `{"return": {"status": "running", "running": true}}`)
+				default:
+					fmt.Fprintf(conn, `{"error": {"class": "CommandNotFound", "desc": "The command %s has not been found"}}`+"\n", cmd.Execute)
+				}
+			}
+			conn.Close()
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client := QEMU{}.Connect(filepath.Dir(socketPath))
+	vmInfo, err := client.VmInfo(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "Running", vmInfo.State)
+	assert.Equal(t, "qmp_capabilities", <-commandCh)
+	assert.Equal(t, "query-status", <-commandCh)
+
+	err = client.VmPause(ctx)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "CommandNotFound")
+	}
+	assert.Equal(t, "qmp_capabilities", <-commandCh)
+	assert.Equal(t, "stop", <-commandCh)
+
+	qmpClient := NewQMPClient(socketPath)
+	mediumPath, err := qmpClient.MediumPath(ctx, "installer")
+	assert.NoError(t, err)
+	assert.Equal(t, "", mediumPath)
+	mediumPath, err = qmpClient.MediumPath(ctx, "data")
+	assert.NoError(t, err)
+	assert.Equal(t, "/mnt/data/disk.img", mediumPath)
+	_, err = qmpClient.MediumPath(ctx, "drivers")
+	assert.Error(t, err)
+	for i := 0; i < 6; i++ {
+		<-commandCh
+	}
+
+	assert.NoError(t, qmpClient.ChangeMedium(ctx, "installer", "/mnt/installer/disk.raw"))
+	assert.Equal(t, "qmp_capabilities", <-commandCh)
+	assert.Equal(t, "blockdev-change-medium", <-commandCh)
+	assert.JSONEq(t, `{"id": "installer", "filename": "/mnt/installer/disk.raw", "format": "raw", "read-only-mode": "read-only"}`, <-commandCh)
+
+	assert.NoError(t, client.VmResizeBalloon(ctx, 256<<20))
+	for i := 0; i < 3; i++ {
+		<-commandCh
+	}
+	assert.Equal(t, "balloon", <-commandCh)
+	assert.JSONEq(t, `{"value": 805306368}`, <-commandCh)
+
+	assert.NoError(t, qmpClient.SetLink(ctx, "pod", false))
+	assert.Equal(t, "qmp_capabilities", <-commandCh)
+	assert.Equal(t, "set_link", <-commandCh)
+	assert.JSONEq(t, `{"name": "pod", "up": false}`, <-commandCh)
+
+	changed, err := qmpClient.SetIOThrottle(ctx, "data", IOThrottle{IOPS: 1000})
+	assert.NoError(t, err)
+	assert.False(t, changed)
+	changed, err = qmpClient.SetIOThrottle(ctx, "data", NewIOThrottle(&virtv1alpha1.DiskRateLimit{IOPS: 2000, IOPSBurst: 1000}))
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	for i := 0; i < 5; i++ {
+		<-commandCh
+	}
+	assert.Equal(t, "block_set_io_throttle", <-commandCh)
+	assert.JSONEq(t, `{"id": "data", "bps": 0, "bps_rd": 0, "bps_wr": 0, "iops": 2000, "iops_rd": 0, "iops_wr": 0, "bps_max": 0, "iops_max": 3000}`, <-commandCh)
+	_, err = qmpClient.SetIOThrottle(ctx, "installer", IOThrottle{})
+	assert.Error(t, err)
+}
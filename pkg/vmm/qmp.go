@@ -0,0 +1,292 @@
+package vmm
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/cloudhypervisor"
+)
+
+// QMPClient is a client of the QEMU Machine Protocol. Each command is
+// executed on a new connection, as QMP serves one client at a time.
+type QMPClient struct {
+	socketPath string
+}
+
+func NewQMPClient(socketPath string) *QMPClient {
+	return &QMPClient{
+		socketPath: socketPath,
+	}
+}
+
+type qmpCommand struct {
+	Execute   string      `json:"execute"`
+	Arguments interface{} `json:"arguments,omitempty"`
+}
+
+type qmpResponse struct {
+	Greeting json.RawMessage `json:"QMP,omitempty"`
+	Event    string          `json:"event,omitempty"`
+	Return   json.RawMessage `json:"return,omitempty"`
+	Error    *qmpError       `json:"error,omitempty"`
+}
+
+type qmpError struct {
+	Class string `json:"class"`
+	Desc  string `json:"desc"`
+}
+
+// Execute executes the QMP command with the arguments, unless args is nil, and
+// unmarshals its return into ret, unless ret is nil.
+func (c *QMPClient) Execute(ctx context.Context, command string, args interface{}, ret interface{}) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "unix", c.socketPath)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+
+	decoder := json.NewDecoder(bufio.NewReader(conn))
+	encoder := json.NewEncoder(conn)
+	var greeting qmpResponse
+	if err := decoder.Decode(&greeting); err != nil {
+		return fmt.Errorf("read greeting: %s", err)
+	}
+	if greeting.Greeting == nil {
+		return fmt.Errorf("unexpected greeting")
+	}
+
+	for _, cmd := range []qmpCommand{{Execute: "qmp_capabilities"}, {Execute: command, Arguments: args}} {
+		if err := encoder.Encode(cmd); err != nil {
+			return fmt.Errorf("write command %q: %s", cmd.Execute, err)
+		}
+		for {
+			var resp qmpResponse
+			if err := decoder.Decode(&resp); err != nil {
+				return fmt.Errorf("read response to %q: %s", cmd.Execute, err)
+			}
+			// events may come before the response
+			if resp.Event != "" {
+				continue
+			}
+			if resp.Error != nil {
+				return fmt.Errorf("%s: %s", resp.Error.Class, resp.Error.Desc)
+			}
+			if cmd.Execute == command && ret != nil {
+				if err := json.Unmarshal(resp.Return, ret); err != nil {
+					return fmt.Errorf("unmarshal return of %q: %s", cmd.Execute, err)
+				}
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// VmInfo returns the state of the VM without its config.
+func (c *QMPClient) VmInfo(ctx context.Context) (*cloudhypervisor.VmInfo, error) {
+	var status struct {
+		Status string `json:"status"`
+	}
+	if err := c.Execute(ctx, "query-status", nil, &status); err != nil {
+		return nil, err
+	}
+
+	state := "Created"
+	switch status.Status {
+	case "running":
+		state = "Running"
+	case "paused", "suspended":
+		state = "Paused"
+	case "shutdown", "guest-panicked", "internal-error", "io-error":
+		state = "Shutdown"
+	}
+	return &cloudhypervisor.VmInfo{State: state}, nil
+}
+
+// VmShutdown powers off the VM, which stops QEMU.
+func (c *QMPClient) VmShutdown(ctx context.Context) error {
+	return c.Execute(ctx, "quit", nil, nil)
+}
+
+func (c *QMPClient) VmPowerButton(ctx context.Context) error {
+	return c.Execute(ctx, "system_powerdown", nil, nil)
+}
+
+func (c *QMPClient) VmReboot(ctx context.Context) error {
+	return c.Execute(ctx, "system_reset", nil, nil)
+}
+
+func (c *QMPClient) VmPause(ctx context.Context) error {
+	return c.Execute(ctx, "stop", nil, nil)
+}
+
+func (c *QMPClient) VmResume(ctx context.Context) error {
+	return c.Execute(ctx, "cont", nil, nil)
+}
+
+// VmResizeBalloon sets the logical size of the VM to its memory size less the
+// balloon size.
+func (c *QMPClient) VmResizeBalloon(ctx context.Context, size int64) error {
+	var summary struct {
+		BaseMemory int64 `json:"base-memory"`
+	}
+	if err := c.Execute(ctx, "query-memory-size-summary", nil, &summary); err != nil {
+		return err
+	}
+	return c.Execute(ctx, "balloon", map[string]int64{"value": summary.BaseMemory - size}, nil)
+}
+
+// MediumPath returns the path of the medium in the removable device, or an
+// empty string if the device is empty.
+func (c *QMPClient) MediumPath(ctx context.Context, id string) (string, error) {
+	var blocks []struct {
+		QDev     string `json:"qdev"`
+		Inserted *struct {
+			File string `json:"file"`
+		} `json:"inserted"`
+	}
+	if err := c.Execute(ctx, "query-block", nil, &blocks); err != nil {
+		return "", err
+	}
+
+	for _, block := range blocks {
+		if block.QDev == id {
+			if block.Inserted == nil {
+				return "", nil
+			}
+			return block.Inserted.File, nil
+		}
+	}
+	return "", fmt.Errorf("device %q not found", id)
+}
+
+// ChangeMedium inserts the raw image at the path into the removable device,
+// ejecting the medium in it, if any.
+func (c *QMPClient) ChangeMedium(ctx context.Context, id string, path string) error {
+	return c.Execute(ctx, "blockdev-change-medium", map[string]string{
+		"id":             id,
+		"filename":       path,
+		"format":         "raw",
+		"read-only-mode": "read-only",
+	}, nil)
+}
+
+// EjectMedium ejects the medium in the removable device, even if the guest
+// has locked it.
+func (c *QMPClient) EjectMedium(ctx context.Context, id string) error {
+	return c.Execute(ctx, "eject", map[string]interface{}{
+		"id":    id,
+		"force": true,
+	}, nil)
+}
+
+// SetLink sets the link of the NIC up or down, as seen by the guest. Frames
+// are neither sent nor received while the link is down.
+func (c *QMPClient) SetLink(ctx context.Context, id string, up bool) error {
+	return c.Execute(ctx, "set_link", map[string]interface{}{
+		"name": id,
+		"up":   up,
+	}, nil)
+}
+
+// IOThrottle is the I/O throttling of a drive, as reported by query-block.
+// Zero limits are unlimited.
+type IOThrottle struct {
+	BPS     int64 `json:"bps"`
+	BPSMax  int64 `json:"bps_max,omitempty"`
+	IOPS    int64 `json:"iops"`
+	IOPSMax int64 `json:"iops_max,omitempty"`
+}
+
+// NewIOThrottle builds the I/O throttling of a drive from the rate limit of
+// its disk. A burst lets the drive run at the limit plus the burst for a
+// second, which QEMU allows again once the drive has been below the limit.
+func NewIOThrottle(rateLimit *virtv1alpha1.DiskRateLimit) IOThrottle {
+	var throttle IOThrottle
+	if rateLimit == nil {
+		return throttle
+	}
+	if rateLimit.Bandwidth != nil {
+		throttle.BPS = rateLimit.Bandwidth.Value()
+		if rateLimit.BandwidthBurst != nil && rateLimit.BandwidthBurst.Value() > 0 {
+			throttle.BPSMax = throttle.BPS + rateLimit.BandwidthBurst.Value()
+		}
+	}
+	if rateLimit.IOPS > 0 {
+		throttle.IOPS = rateLimit.IOPS
+		if rateLimit.IOPSBurst > 0 {
+			throttle.IOPSMax = throttle.IOPS + rateLimit.IOPSBurst
+		}
+	}
+	return throttle
+}
+
+// DriveOptions renders the throttling as -drive options.
+func (t IOThrottle) DriveOptions() string {
+	var options string
+	if t.BPS > 0 {
+		options = options + fmt.Sprintf(",throttling.bps-total=%d", t.BPS)
+	}
+	if t.BPSMax > 0 {
+		options = options + fmt.Sprintf(",throttling.bps-total-max=%d", t.BPSMax)
+	}
+	if t.IOPS > 0 {
+		options = options + fmt.Sprintf(",throttling.iops-total=%d", t.IOPS)
+	}
+	if t.IOPSMax > 0 {
+		options = options + fmt.Sprintf(",throttling.iops-total-max=%d", t.IOPSMax)
+	}
+	return options
+}
+
+// SetIOThrottle sets the I/O throttling of the drive of the disk device, if
+// it differs from the current one, and reports whether it was changed.
+// Drives are named drive-<id> by the QEMU driver.
+func (c *QMPClient) SetIOThrottle(ctx context.Context, id string, throttle IOThrottle) (bool, error) {
+	var blocks []struct {
+		Device   string      `json:"device"`
+		QDev     string      `json:"qdev"`
+		Inserted *IOThrottle `json:"inserted"`
+	}
+	if err := c.Execute(ctx, "query-block", nil, &blocks); err != nil {
+		return false, err
+	}
+
+	for _, block := range blocks {
+		if block.Device != "drive-"+id {
+			continue
+		}
+		if block.Inserted == nil {
+			return false, fmt.Errorf("device %q has no medium", id)
+		}
+		if *block.Inserted == throttle {
+			return false, nil
+		}
+		// virtio-blk devices are named by the QOM path of their backend
+		if err := c.Execute(ctx, "block_set_io_throttle", map[string]interface{}{
+			"id":       block.QDev,
+			"bps":      throttle.BPS,
+			"bps_rd":   0,
+			"bps_wr":   0,
+			"iops":     throttle.IOPS,
+			"iops_rd":  0,
+			"iops_wr":  0,
+			"bps_max":  throttle.BPSMax,
+			"iops_max": throttle.IOPSMax,
+		}, nil); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	return false, fmt.Errorf("device %q not found", id)
+}
@@ -0,0 +1,48 @@
+// Package vmm abstracts the virtual machine monitors VMs run on. virt-prerunner
+// builds the command to run the VMM of a VM with its Driver, and virt-daemon
+// controls the running VM through the VMM API the Driver connects to.
+package vmm
+
+import (
+	"context"
+
+	virtv1alpha1 "github.com/smartxworks/virtink/pkg/apis/virt/v1alpha1"
+	"github.com/smartxworks/virtink/pkg/cloudhypervisor"
+)
+
+// VMM controls the lifecycle of a running VM. It's named after the Cloud
+// Hypervisor API, which *cloudhypervisor.Client implements.
+type VMM interface {
+	// VmInfo returns the state of the VM, which is Created, Running, Paused or
+	// Shutdown. Other VMMs than Cloud Hypervisor may not return the config.
+	VmInfo(ctx context.Context) (*cloudhypervisor.VmInfo, error)
+	VmShutdown(ctx context.Context) error
+	VmPowerButton(ctx context.Context) error
+	VmReboot(ctx context.Context) error
+	VmPause(ctx context.Context) error
+	VmResume(ctx context.Context) error
+	// VmResizeBalloon inflates or deflates the balloon of the VM to size
+	// bytes of reclaimed guest memory.
+	VmResizeBalloon(ctx context.Context, size int64) error
+}
+
+// Driver runs VMs on a VMM.
+type Driver interface {
+	// Command returns the command to run the VMM booting the VM with the
+	// config, serving its API in the socket dir.
+	Command(socketDirPath string, vm *virtv1alpha1.VirtualMachine, vmConfig *cloudhypervisor.VmConfig) ([]string, error)
+	// Connect returns the API of the VMM serving in the socket dir.
+	Connect(socketDirPath string) VMM
+}
+
+// GetDriver returns the driver of the hypervisor of the VM.
+func GetDriver(vm *virtv1alpha1.VirtualMachine) Driver {
+	switch vm.Spec.Instance.Hypervisor {
+	case virtv1alpha1.HypervisorQEMU:
+		return QEMU{}
+	case virtv1alpha1.HypervisorFirecracker:
+		return Firecracker{}
+	default:
+		return CloudHypervisor{}
+	}
+}
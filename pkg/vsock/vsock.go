@@ -0,0 +1,60 @@
+// Package vsock connects to agents listening on vsock ports in guests. Cloud
+// Hypervisor and Firecracker expose the vsock device of a VM as a unix socket
+// in the VM pod, on which a connection to a guest port is opened by the
+// "CONNECT <port>" handshake. Components outside the VM pod connect through
+// the "vsock/<port>" endpoint of the virt-daemon on the node of the VM, using
+// daemon.DialVMStream.
+package vsock
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Dial connects to the port of the guest through the vsock socket of the VM.
+func Dial(ctx context.Context, socketPath string, port uint32) (net.Conn, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "unix", socketPath)
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	if _, err := fmt.Fprintf(conn, "CONNECT %d\n", port); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("write handshake: %s", err)
+	}
+
+	// the reply is read byte by byte so that no guest data is consumed
+	var reply []byte
+	buf := make([]byte, 1)
+	for len(reply) < 64 {
+		if _, err := conn.Read(buf); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("read handshake: %s", err)
+		}
+		if buf[0] == '\n' {
+			break
+		}
+		reply = append(reply, buf[0])
+	}
+
+	fields := strings.Fields(string(reply))
+	if len(fields) != 2 || fields[0] != "OK" {
+		conn.Close()
+		return nil, fmt.Errorf("connect to port %d: unexpected reply %q", port, string(reply))
+	}
+	if _, err := strconv.ParseUint(fields[1], 10, 32); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("connect to port %d: unexpected reply %q", port, string(reply))
+	}
+
+	conn.SetDeadline(time.Time{})
+	return conn, nil
+}
@@ -0,0 +1,70 @@
+package vsock
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDial(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "vsock.sock")
+	listener, err := net.Listen("unix", socketPath)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer listener.Close()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				reader := bufio.NewReader(conn)
+				line, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if strings.TrimSpace(line) != "CONNECT 1024" {
+					return
+				}
+				// the guest may write before the client does
+				fmt.Fprint(conn, "OK 1073741824\nhello")
+				io.Copy(conn, reader)
+			}()
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := Dial(ctx, socketPath, 1024)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(conn, buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(buf))
+
+	_, err = conn.Write([]byte("ping"))
+	assert.NoError(t, err)
+	buf = make([]byte, 4)
+	_, err = io.ReadFull(conn, buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "ping", string(buf))
+
+	_, err = Dial(ctx, socketPath, 22)
+	assert.Error(t, err)
+}